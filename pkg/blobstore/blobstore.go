@@ -0,0 +1,157 @@
+package blobstore
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/risingwavelabs/risingwave-console/pkg/config"
+)
+
+const (
+	TypeLocal = "local"
+	TypeS3    = "s3"
+)
+
+var ErrNotFound = errors.New("blob not found")
+
+// BlobStore stores the payloads too large to be kept in postgres, e.g. the diagnose reports and
+// the diagnostic bundles. The keys are slash separated paths, e.g. "diagnostics/1/abc.txt.gz".
+type BlobStore interface {
+	// Put stores the data under the key, an existing blob is overwritten
+	Put(ctx context.Context, key string, data []byte) error
+
+	// Get returns the data stored under the key, or ErrNotFound
+	Get(ctx context.Context, key string) ([]byte, error)
+
+	// Delete removes the blob, deleting a missing blob is not an error
+	Delete(ctx context.Context, key string) error
+
+	// DeleteDir removes all the blobs whose keys start with the dir and a slash, e.g. all the
+	// diagnose reports of a cluster under "diagnostics/1"
+	DeleteDir(ctx context.Context, dir string) error
+}
+
+func NewBlobStore(cfg *config.Config) (BlobStore, error) {
+	switch cfg.BlobStore.Type {
+	case "", TypeLocal:
+		dir := cfg.BlobStore.Dir
+		if dir == "" {
+			dir = filepath.Join(os.Getenv("HOME"), ".risingwave-console", "blobs")
+		}
+		return NewLocalStore(dir)
+	case TypeS3:
+		return NewS3Store(cfg.BlobStore.S3)
+	default:
+		return nil, errors.Errorf("unknown blob store type %s", cfg.BlobStore.Type)
+	}
+}
+
+// Object is the metadata of a payload stored by PutCompressed.
+type Object struct {
+	Key string
+
+	// Size is the size of the payload before compression
+	Size int64
+
+	// StoredSize is the size of the compressed payload in the blob store
+	StoredSize int64
+
+	// SHA256 is the hex encoded checksum of the payload before compression
+	SHA256 string
+}
+
+// NewKey returns a new random key under the prefix, e.g. NewKey("diagnostics/1", ".txt.gz").
+func NewKey(prefix string, ext string) string {
+	b := make([]byte, 16)
+	_, _ = rand.Read(b)
+	return fmt.Sprintf("%s/%s%s", strings.TrimSuffix(prefix, "/"), hex.EncodeToString(b), ext)
+}
+
+// DiagnosticKey returns a new key of a diagnose report of the cluster.
+func DiagnosticKey(clusterID int32) string {
+	return NewKey(fmt.Sprintf("diagnostics/%d", clusterID), ".txt.gz")
+}
+
+// ClusterDirs returns the dirs of all the blobs of the cluster.
+func ClusterDirs(clusterID int32) []string {
+	return []string{
+		fmt.Sprintf("diagnostics/%d", clusterID),
+		fmt.Sprintf("diagnostic-bundles/%d", clusterID),
+	}
+}
+
+// DiagnosticBundleKey returns the key of a diagnostic bundle, the bundles are stored as they are
+// since they are already compressed.
+func DiagnosticBundleKey(clusterID int32, bundleID int32) string {
+	return fmt.Sprintf("diagnostic-bundles/%d/%d.tar.gz", clusterID, bundleID)
+}
+
+// PutCompressed stores the gzip compressed payload under the key.
+func PutCompressed(ctx context.Context, store BlobStore, key string, data []byte) (*Object, error) {
+	var buf bytes.Buffer
+	gz := gzip.NewWriter(&buf)
+	if _, err := gz.Write(data); err != nil {
+		return nil, errors.Wrap(err, "failed to compress payload")
+	}
+	if err := gz.Close(); err != nil {
+		return nil, errors.Wrap(err, "failed to compress payload")
+	}
+	if err := store.Put(ctx, key, buf.Bytes()); err != nil {
+		return nil, errors.Wrapf(err, "failed to put blob %s", key)
+	}
+	sum := sha256.Sum256(data)
+	return &Object{
+		Key:        key,
+		Size:       int64(len(data)),
+		StoredSize: int64(buf.Len()),
+		SHA256:     hex.EncodeToString(sum[:]),
+	}, nil
+}
+
+// GetCompressed returns the payload stored by PutCompressed. If the checksum is not empty, the
+// payload is verified against it.
+func GetCompressed(ctx context.Context, store BlobStore, key string, checksum string) ([]byte, error) {
+	data, err := store.Get(ctx, key)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get blob %s", key)
+	}
+	gz, err := gzip.NewReader(bytes.NewReader(data))
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to decompress blob %s", key)
+	}
+	defer gz.Close()
+	payload, err := io.ReadAll(gz)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to decompress blob %s", key)
+	}
+	if checksum != "" {
+		sum := sha256.Sum256(payload)
+		if hex.EncodeToString(sum[:]) != checksum {
+			return nil, errors.Errorf("checksum mismatch of blob %s", key)
+		}
+	}
+	return payload, nil
+}
+
+// validateKey rejects the keys escaping the root of the store.
+func validateKey(key string) error {
+	if key == "" || strings.HasPrefix(key, "/") {
+		return errors.Errorf("invalid blob key %q", key)
+	}
+	for _, part := range strings.Split(key, "/") {
+		if part == "" || part == "." || part == ".." {
+			return errors.Errorf("invalid blob key %q", key)
+		}
+	}
+	return nil
+}
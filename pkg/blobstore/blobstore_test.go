@@ -0,0 +1,108 @@
+package blobstore
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/risingwavelabs/risingwave-console/pkg/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLocalStore(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	store, err := NewLocalStore(dir)
+	require.NoError(t, err)
+
+	require.NoError(t, store.Put(ctx, "diagnostics/1/a.txt.gz", []byte("hello")))
+	data, err := store.Get(ctx, "diagnostics/1/a.txt.gz")
+	require.NoError(t, err)
+	assert.Equal(t, []byte("hello"), data)
+
+	// overwrite
+	require.NoError(t, store.Put(ctx, "diagnostics/1/a.txt.gz", []byte("world")))
+	data, err = store.Get(ctx, "diagnostics/1/a.txt.gz")
+	require.NoError(t, err)
+	assert.Equal(t, []byte("world"), data)
+
+	// no temporary file is left behind
+	entries, err := os.ReadDir(filepath.Join(dir, "diagnostics", "1"))
+	require.NoError(t, err)
+	assert.Len(t, entries, 1)
+
+	require.NoError(t, store.Delete(ctx, "diagnostics/1/a.txt.gz"))
+	_, err = store.Get(ctx, "diagnostics/1/a.txt.gz")
+	assert.ErrorIs(t, err, ErrNotFound)
+	require.NoError(t, store.Delete(ctx, "diagnostics/1/a.txt.gz"))
+
+	require.NoError(t, store.Put(ctx, "diagnostics/1/b.txt.gz", []byte("x")))
+	require.NoError(t, store.Put(ctx, "diagnostics/10/c.txt.gz", []byte("x")))
+	require.NoError(t, store.DeleteDir(ctx, "diagnostics/1"))
+	_, err = store.Get(ctx, "diagnostics/1/b.txt.gz")
+	assert.ErrorIs(t, err, ErrNotFound)
+	_, err = store.Get(ctx, "diagnostics/10/c.txt.gz")
+	assert.NoError(t, err)
+	require.NoError(t, store.DeleteDir(ctx, "diagnostics/1"))
+	assert.Error(t, store.DeleteDir(ctx, ".."))
+
+	for _, key := range []string{"", "/etc/passwd", "../a", "a/../../b", "a//b"} {
+		assert.Error(t, store.Put(ctx, key, []byte("x")), key)
+		_, err := store.Get(ctx, key)
+		assert.Error(t, err, key)
+	}
+}
+
+func TestCompressed(t *testing.T) {
+	ctx := context.Background()
+	store, err := NewLocalStore(t.TempDir())
+	require.NoError(t, err)
+
+	payload := []byte(string(make([]byte, 4096)) + "diagnose")
+	key := NewKey("diagnostics/1/", ".txt.gz")
+	assert.Regexp(t, `^diagnostics/1/[0-9a-f]{32}\.txt\.gz$`, key)
+
+	obj, err := PutCompressed(ctx, store, key, payload)
+	require.NoError(t, err)
+	assert.Equal(t, key, obj.Key)
+	assert.Equal(t, int64(len(payload)), obj.Size)
+	assert.Less(t, obj.StoredSize, obj.Size)
+
+	got, err := GetCompressed(ctx, store, key, obj.SHA256)
+	require.NoError(t, err)
+	assert.Equal(t, payload, got)
+
+	require.NoError(t, store.Put(ctx, key, mustGzip(t, []byte("tampered"))))
+	_, err = GetCompressed(ctx, store, key, obj.SHA256)
+	assert.ErrorContains(t, err, "checksum mismatch")
+
+	_, err = GetCompressed(ctx, store, "diagnostics/1/missing.txt.gz", "")
+	assert.ErrorIs(t, err, ErrNotFound)
+}
+
+func TestNewBlobStore(t *testing.T) {
+	dir := t.TempDir()
+	store, err := NewBlobStore(&config.Config{BlobStore: config.BlobStore{Dir: dir}})
+	require.NoError(t, err)
+	assert.IsType(t, &LocalStore{}, store)
+
+	store, err = NewBlobStore(&config.Config{BlobStore: config.BlobStore{
+		Type: TypeS3,
+		S3: config.S3{
+			Endpoint:        "http://minio:9000",
+			Bucket:          "console",
+			AccessKeyID:     "ak",
+			SecretAccessKey: "sk",
+		},
+	}})
+	require.NoError(t, err)
+	assert.IsType(t, &S3Store{}, store)
+
+	_, err = NewBlobStore(&config.Config{BlobStore: config.BlobStore{Type: TypeS3}})
+	assert.Error(t, err)
+
+	_, err = NewBlobStore(&config.Config{BlobStore: config.BlobStore{Type: "gcs"}})
+	assert.Error(t, err)
+}
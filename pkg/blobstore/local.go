@@ -0,0 +1,93 @@
+package blobstore
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+
+	"github.com/pkg/errors"
+)
+
+// LocalStore stores the blobs as files in a directory.
+type LocalStore struct {
+	dir string
+}
+
+func NewLocalStore(dir string) (*LocalStore, error) {
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return nil, errors.Wrapf(err, "failed to create blob store dir %s", dir)
+	}
+	return &LocalStore{dir: dir}, nil
+}
+
+func (s *LocalStore) path(key string) (string, error) {
+	if err := validateKey(key); err != nil {
+		return "", err
+	}
+	return filepath.Join(s.dir, filepath.FromSlash(key)), nil
+}
+
+func (s *LocalStore) Put(ctx context.Context, key string, data []byte) error {
+	path, err := s.path(key)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return errors.Wrapf(err, "failed to create dir of blob %s", key)
+	}
+
+	// write to a temporary file first so that a reader never sees a partial blob
+	f, err := os.CreateTemp(filepath.Dir(path), ".tmp-*")
+	if err != nil {
+		return errors.Wrapf(err, "failed to create blob %s", key)
+	}
+	defer os.Remove(f.Name())
+	if _, err := f.Write(data); err != nil {
+		f.Close()
+		return errors.Wrapf(err, "failed to write blob %s", key)
+	}
+	if err := f.Close(); err != nil {
+		return errors.Wrapf(err, "failed to write blob %s", key)
+	}
+	if err := os.Rename(f.Name(), path); err != nil {
+		return errors.Wrapf(err, "failed to write blob %s", key)
+	}
+	return nil
+}
+
+func (s *LocalStore) Get(ctx context.Context, key string) ([]byte, error) {
+	path, err := s.path(key)
+	if err != nil {
+		return nil, err
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, ErrNotFound
+		}
+		return nil, errors.Wrapf(err, "failed to read blob %s", key)
+	}
+	return data, nil
+}
+
+func (s *LocalStore) Delete(ctx context.Context, key string) error {
+	path, err := s.path(key)
+	if err != nil {
+		return err
+	}
+	if err := os.Remove(path); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return errors.Wrapf(err, "failed to delete blob %s", key)
+	}
+	return nil
+}
+
+func (s *LocalStore) DeleteDir(ctx context.Context, dir string) error {
+	path, err := s.path(dir)
+	if err != nil {
+		return err
+	}
+	if err := os.RemoveAll(path); err != nil {
+		return errors.Wrapf(err, "failed to delete blobs under %s", dir)
+	}
+	return nil
+}
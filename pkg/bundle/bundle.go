@@ -0,0 +1,105 @@
+package bundle
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+
+	"github.com/pkg/errors"
+	"github.com/risingwavelabs/risingwave-console/pkg/zgen/apigen"
+)
+
+// FormatVersion is the version of the layout of the archive, it is bumped once a file is moved
+// or its format is changed.
+const FormatVersion = 1
+
+const ManifestPath = "manifest.json"
+
+// Writer builds a diagnostic bundle, a tar.gz archive of the collected files and a manifest
+// listing them. The secrets in every file are redacted before they are written.
+type Writer struct {
+	buf      bytes.Buffer
+	gz       *gzip.Writer
+	tw       *tar.Writer
+	manifest apigen.DiagnosticBundleManifest
+}
+
+func NewWriter(manifest apigen.DiagnosticBundleManifest) *Writer {
+	w := &Writer{}
+	w.gz = gzip.NewWriter(&w.buf)
+	w.tw = tar.NewWriter(w.gz)
+	manifest.FormatVersion = FormatVersion
+	manifest.Files = []apigen.DiagnosticBundleFile{}
+	manifest.Errors = []apigen.DiagnosticBundleError{}
+	w.manifest = manifest
+	return w
+}
+
+// Add redacts the content and writes it to the archive.
+func (w *Writer) Add(path string, description string, content []byte) error {
+	redacted, count := Redact(string(content))
+	data := []byte(redacted)
+	if err := w.write(path, data); err != nil {
+		return err
+	}
+	sum := sha256.Sum256(data)
+	w.manifest.Files = append(w.manifest.Files, apigen.DiagnosticBundleFile{
+		Path:        path,
+		Description: description,
+		SizeBytes:   int64(len(data)),
+		Sha256:      hex.EncodeToString(sum[:]),
+		Redactions:  int32(count),
+	})
+	return nil
+}
+
+// AddJSON marshals the value and adds it to the archive.
+func (w *Writer) AddJSON(path string, description string, v any) error {
+	data, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return errors.Wrapf(err, "failed to marshal %s", path)
+	}
+	return w.Add(path, description, data)
+}
+
+// AddError records an item that could not be collected in the manifest.
+func (w *Writer) AddError(path string, err error) {
+	msg, _ := Redact(err.Error())
+	w.manifest.Errors = append(w.manifest.Errors, apigen.DiagnosticBundleError{Path: path, Error: msg})
+}
+
+// Close writes the manifest and returns the archive.
+func (w *Writer) Close() ([]byte, *apigen.DiagnosticBundleManifest, error) {
+	data, err := json.MarshalIndent(w.manifest, "", "  ")
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "failed to marshal manifest")
+	}
+	if err := w.write(ManifestPath, data); err != nil {
+		return nil, nil, err
+	}
+	if err := w.tw.Close(); err != nil {
+		return nil, nil, errors.Wrap(err, "failed to close tar writer")
+	}
+	if err := w.gz.Close(); err != nil {
+		return nil, nil, errors.Wrap(err, "failed to close gzip writer")
+	}
+	return w.buf.Bytes(), &w.manifest, nil
+}
+
+func (w *Writer) write(path string, data []byte) error {
+	if err := w.tw.WriteHeader(&tar.Header{
+		Name:    path,
+		Mode:    0644,
+		Size:    int64(len(data)),
+		ModTime: w.manifest.CreatedAt,
+	}); err != nil {
+		return errors.Wrapf(err, "failed to write header of %s", path)
+	}
+	if _, err := w.tw.Write(data); err != nil {
+		return errors.Wrapf(err, "failed to write %s", path)
+	}
+	return nil
+}
@@ -21,6 +21,95 @@ type Worker struct {
 	Disable bool `yaml:"disable,omitempty"`
 }
 
+type SQLConsole struct {
+	// (Optional) The maximum number of dependent objects a DROP ... CASCADE statement may affect without a confirmation token, default is 10
+	MaxCascadeDropObjects int `yaml:"maxcascadedropobjects,omitempty"`
+
+	// (Optional) How long the catalog of a database is cached, e.g. 30s, 5m, default is 5m
+	CatalogCacheTTL string `yaml:"catalogcachettl,omitempty"`
+}
+
+type Risectl struct {
+	// (Optional) Comma separated risectl sub commands the owners of an organization may run, e.g. "meta cluster-info,hummock". "*" allows all commands, default is "*"
+	OwnerAllowlist string `yaml:"ownerallowlist,omitempty"`
+
+	// (Optional) Comma separated risectl sub commands the members of an organization may run, default is the commands of the read-only risectl operations
+	MemberAllowlist string `yaml:"memberallowlist,omitempty"`
+
+	// (Optional) Whether to download risectl from releases without checksums, default is false. The checksum is always verified if the release has one.
+	SkipChecksum bool `yaml:"skipchecksum,omitempty"`
+
+	// (Optional) Whether the owners of an organization may upload risectl archives when internet access is disabled, default is false. The uploaded binaries are shared by all organizations and run by the server.
+	AllowUpload bool `yaml:"allowupload,omitempty"`
+
+	// (Optional) The GitHub repository of the risectl releases, default is "risingwavelabs/risingwave"
+	Repository string `yaml:"repository,omitempty"`
+
+	// (Optional) The API URL of a GitHub Enterprise server hosting the releases, e.g. https://github.example.com/api/v3/
+	GitHubURL string `yaml:"githuburl,omitempty"`
+
+	// (Optional) The URL of an HTTP mirror of the releases, it must serve an index.json listing the versions and assets. If set, GitHub is not used.
+	MirrorURL string `yaml:"mirrorurl,omitempty"`
+
+	// (Optional) The token to access GitHub or the mirror, it avoids the rate limit of unauthenticated GitHub requests
+	Token string `yaml:"token,omitempty"`
+
+	// (Optional) The HTTP proxy used to download risectl, e.g. http://proxy:3128, default is the proxy in the environment variables
+	Proxy string `yaml:"proxy,omitempty"`
+
+	// (Optional) The maximum number of risectl processes running at the same time, default is 16
+	MaxConcurrency int `yaml:"maxconcurrency,omitempty"`
+
+	// (Optional) The maximum number of risectl processes running at the same time against one cluster, default is 4
+	MaxConcurrencyPerCluster int `yaml:"maxconcurrencypercluster,omitempty"`
+
+	// (Optional) The timeout of risectl commands run by API requests, e.g. 30s, 5m, default is 5m. Tasks use their own timeouts.
+	Timeout string `yaml:"timeout,omitempty"`
+
+	// (Optional) The maximum size in bytes of the stdout and stderr kept for a risectl command, default is 16777216 (16MB)
+	MaxOutputSize int `yaml:"maxoutputsize,omitempty"`
+
+	// (Optional) The directory holding the temporary working directories of the risectl processes, default is "work" in the risectl dir
+	WorkDir string `yaml:"workdir,omitempty"`
+
+	// (Optional) Comma separated names of the environment variables passed to risectl, e.g. "RUST_LOG,SSL_CERT_FILE". Only PATH, LANG and RW_META_ADDR are set by default, HOME and TMPDIR are a temporary directory of each process.
+	PassEnv string `yaml:"passenv,omitempty"`
+}
+
+type S3 struct {
+	// (Optional) The endpoint of the S3-compatible service, e.g. http://minio:9000, default is https://s3.<region>.amazonaws.com
+	Endpoint string `yaml:"endpoint,omitempty"`
+
+	// (Optional) The region of the bucket, default is "us-east-1"
+	Region string `yaml:"region,omitempty"`
+
+	// (Required) The bucket storing the blobs
+	Bucket string `yaml:"bucket,omitempty"`
+
+	// (Optional) The prefix of the keys of the blobs in the bucket, e.g. "console/"
+	Prefix string `yaml:"prefix,omitempty"`
+
+	// (Optional) The access key ID, default is the AWS_ACCESS_KEY_ID environment variable
+	AccessKeyID string `yaml:"accesskeyid,omitempty"`
+
+	// (Optional) The secret access key, default is the AWS_SECRET_ACCESS_KEY environment variable
+	SecretAccessKey string `yaml:"secretaccesskey,omitempty"`
+
+	// (Optional) Whether to address the bucket in the path instead of the host, it is required by most S3-compatible services, e.g. MinIO. Default is false.
+	PathStyle bool `yaml:"pathstyle,omitempty"`
+}
+
+type BlobStore struct {
+	// (Optional) The backend storing the large payloads, e.g. the diagnose reports and the diagnostic bundles, "local" or "s3", default is "local"
+	Type string `yaml:"type,omitempty"`
+
+	// (Optional) The directory of the local backend, default is "$HOME/.risingwave-console/blobs". The payloads stored in postgres by earlier versions are only moved to a blob store whose type or directory is set, set it to a persistent volume when running in a container
+	Dir string `yaml:"dir,omitempty"`
+
+	// (Optional) The S3-compatible backend configuration
+	S3 S3 `yaml:"s3,omitempty"`
+}
+
 type Debug struct {
 	// (Optional) Whether to enable the debug server, default is false.
 	Enable bool `yaml:"enable,omitempty"`
@@ -55,12 +144,21 @@ type Config struct {
 	// (Optional) The path of the directory to store the risectl files, default is "$HOME/.risectl"
 	RisectlDir string `yaml:"risectldir,omitempty"`
 
+	// (Optional) The risectl configuration
+	Risectl Risectl `yaml:"risectl,omitempty"`
+
 	// The worker configuration
 	Worker Worker `yaml:"worker,omitempty"`
 
 	// The enterprise edition configuration
 	EE EE `yaml:"ee,omitempty"`
 
+	// (Optional) The SQL console configuration
+	SQLConsole SQLConsole `yaml:"sqlconsole,omitempty"`
+
+	// (Optional) The blob store configuration
+	BlobStore BlobStore `yaml:"blobstore,omitempty"`
+
 	// (Optional) The debug configuration
 	Debug Debug `yaml:"debug,omitempty"`
 }
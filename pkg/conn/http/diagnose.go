@@ -0,0 +1,378 @@
+package http
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// DiagnoseReport is the report returned by /api/monitor/diagnose/ split into typed sections.
+// The report is written for humans, so everything not recognized is kept in OtherTables and
+// TextSections instead of being dropped.
+type DiagnoseReport struct {
+	CreatedAt string
+	Version   string
+
+	// Catalog is the number of each kind of object, e.g. "materialized view" -> 3
+	Catalog map[string]int64
+
+	WorkerNodes []WorkerNode
+
+	// Streaming, Batch and Storage are the top lists queried from Prometheus, e.g. the sources
+	// by throughput or the Hummock get operations by duration
+	Streaming []DiagnoseTable
+	Batch     []DiagnoseTable
+	Storage   StorageStats
+
+	AwaitTree []AwaitTreeSection
+
+	EventLogs []DiagnoseTable
+
+	OtherTables  []DiagnoseTable
+	TextSections []TextSection
+}
+
+type DiagnoseTable struct {
+	Title   string
+	Headers []string
+	Rows    [][]string
+}
+
+// Column returns the index of the column, -1 if the table has no such column.
+func (t *DiagnoseTable) Column(name string) int {
+	for i, h := range t.Headers {
+		if strings.EqualFold(h, name) {
+			return i
+		}
+	}
+	return -1
+}
+
+type WorkerNode struct {
+	ID          int64
+	Host        string
+	Type        string
+	State       string
+	Parallelism int64
+	ActorCount  int64
+
+	// Attributes are all the columns of the worker node, e.g. rw_version and started_at
+	Attributes map[string]string
+}
+
+type StorageStats struct {
+	SstCount          *int64
+	SstTotalSizeBytes *int64
+	Tables            []DiagnoseTable
+}
+
+// AwaitTreeSection is a dump of the await trees of one kind, e.g. "Actor Traces".
+type AwaitTreeSection struct {
+	Title   string
+	Entries []AwaitTreeEntry
+}
+
+type AwaitTreeEntry struct {
+	// Key identifies the entry in the section, e.g. "Actor 1"
+	Key   string
+	Trace string
+
+	// SlowSpans are the spans marked as pending for too long, they usually point to the
+	// executors blocked by backpressure
+	SlowSpans []AwaitTreeSpan
+}
+
+type AwaitTreeSpan struct {
+	Span    string
+	Seconds float64
+}
+
+type TextSection struct {
+	Title   string
+	Content string
+}
+
+var (
+	catalogStatRe     = regexp.MustCompile(`^number of (.+): (\d+)$`)
+	awaitTreeHeaderRe = regexp.MustCompile(`^--- (.+) ---$`)
+	awaitTreeSpanRe   = regexp.MustCompile(`^\s*(.*?)\s*\[(!!! )?([0-9.]+)(ms|s)\]`)
+)
+
+const (
+	sstCountPrefix     = "number of SSTables: "
+	sstTotalSizePrefix = "total size of SSTables (byte): "
+)
+
+// ParseDiagnoseReport splits the report into sections. It never fails, the sections it cannot
+// recognize are returned as text sections.
+func ParseDiagnoseReport(content string) *DiagnoseReport {
+	report := &DiagnoseReport{Catalog: map[string]int64{}}
+	lines := strings.Split(strings.ReplaceAll(content, "\r\n", "\n"), "\n")
+
+	for i := 0; i < len(lines); {
+		line := strings.TrimRight(lines[i], " ")
+		trimmed := strings.TrimSpace(line)
+		switch {
+		case trimmed == "":
+			i++
+		case strings.HasPrefix(trimmed, "report created at: "):
+			report.CreatedAt = strings.TrimPrefix(trimmed, "report created at: ")
+			i++
+		case strings.HasPrefix(trimmed, "version: ") && report.Version == "":
+			report.Version = strings.TrimPrefix(trimmed, "version: ")
+			i++
+		case strings.HasPrefix(trimmed, sstCountPrefix):
+			report.Storage.SstCount = parseInt(strings.TrimPrefix(trimmed, sstCountPrefix))
+			i++
+		case strings.HasPrefix(trimmed, sstTotalSizePrefix):
+			report.Storage.SstTotalSizeBytes = parseInt(strings.TrimPrefix(trimmed, sstTotalSizePrefix))
+			i++
+		case catalogStatRe.MatchString(trimmed):
+			m := catalogStatRe.FindStringSubmatch(trimmed)
+			n, _ := strconv.ParseInt(m[2], 10, 64)
+			report.Catalog[m[1]] = n
+			i++
+		case awaitTreeHeaderRe.MatchString(trimmed):
+			var section AwaitTreeSection
+			section, i = parseAwaitTreeSection(lines, i)
+			report.AwaitTree = append(report.AwaitTree, section)
+		case isTableBorder(trimmed):
+			var table DiagnoseTable
+			table, i = parseTable(lines, i)
+			report.addTable(table)
+		default:
+			// a heading followed by a table, or a block of text
+			next := nextNonEmpty(lines, i+1)
+			if next < len(lines) && isTableBorder(strings.TrimSpace(lines[next])) {
+				var table DiagnoseTable
+				table, i = parseTable(lines, next)
+				table.Title = trimmed
+				report.addTable(table)
+				continue
+			}
+			var section TextSection
+			section, i = parseTextSection(lines, i)
+			report.TextSections = append(report.TextSections, section)
+		}
+	}
+	return report
+}
+
+func (r *DiagnoseReport) addTable(table DiagnoseTable) {
+	title := strings.ToLower(table.Title)
+	switch {
+	case title == "" && table.Column("host") >= 0 && table.Column("type") >= 0:
+		r.WorkerNodes = append(r.WorkerNodes, workerNodesFromTable(table)...)
+	case title == "":
+		r.OtherTables = append(r.OtherTables, table)
+	case strings.HasPrefix(title, "latest "):
+		r.EventLogs = append(r.EventLogs, table)
+	case containsAny(title, "hummock", "sstable", "object store", "tombstone", "range delete", "commit flush", "compaction", "storage"):
+		r.Storage.Tables = append(r.Storage.Tables, table)
+	case containsAny(title, "batch"):
+		r.Batch = append(r.Batch, table)
+	case containsAny(title, "source", "materialized view", "join", "actor", "barrier", "backpressure", "sink", "fragment", "streaming", "executor"):
+		r.Streaming = append(r.Streaming, table)
+	default:
+		r.OtherTables = append(r.OtherTables, table)
+	}
+}
+
+func workerNodesFromTable(table DiagnoseTable) []WorkerNode {
+	nodes := make([]WorkerNode, 0, len(table.Rows))
+	for _, row := range table.Rows {
+		attrs := map[string]string{}
+		for i, h := range table.Headers {
+			if i < len(row) {
+				attrs[h] = row[i]
+			}
+		}
+		node := WorkerNode{
+			Host:       attrs["host"],
+			Type:       attrs["type"],
+			State:      attrs["state"],
+			Attributes: attrs,
+		}
+		if id := parseInt(attrs["id"]); id != nil {
+			node.ID = *id
+		}
+		if parallelism := parseInt(attrs["parallelism"]); parallelism != nil {
+			node.Parallelism = *parallelism
+		}
+		if actorCount := parseInt(attrs["actor_count"]); actorCount != nil {
+			node.ActorCount = *actorCount
+		}
+		nodes = append(nodes, node)
+	}
+	return nodes
+}
+
+// isTableBorder reports whether the line is the top border of a table, e.g. "+----+------+".
+func isTableBorder(line string) bool {
+	return strings.HasPrefix(line, "+-") && strings.HasSuffix(line, "+")
+}
+
+// parseTable parses a table drawn with the ASCII_FULL preset of comfy-table, the header is
+// separated by "+===+" and the rows by "|---+---|". A cell spanning multiple lines is joined
+// with "\n".
+func parseTable(lines []string, start int) (DiagnoseTable, int) {
+	var (
+		table     DiagnoseTable
+		rows      [][]string
+		current   []string
+		hasHeader bool
+	)
+	flush := func() {
+		if current != nil {
+			rows = append(rows, current)
+			current = nil
+		}
+	}
+
+	i := start
+loop:
+	for ; i < len(lines); i++ {
+		line := strings.TrimSpace(lines[i])
+		switch {
+		case strings.HasPrefix(line, "+="):
+			flush()
+			if len(rows) > 0 && !hasHeader {
+				table.Headers = rows[len(rows)-1]
+				rows = rows[:len(rows)-1]
+				hasHeader = true
+			}
+		case strings.HasPrefix(line, "+-"), strings.HasPrefix(line, "|-"), strings.HasPrefix(line, "|="):
+			flush()
+		case strings.HasPrefix(line, "|"):
+			cells := splitTableRow(line)
+			if current == nil {
+				current = cells
+				continue
+			}
+			for j := range current {
+				if j < len(cells) && cells[j] != "" {
+					current[j] = strings.TrimLeft(current[j]+"\n"+cells[j], "\n")
+				}
+			}
+		default:
+			break loop
+		}
+	}
+	flush()
+	if !hasHeader && len(rows) > 0 {
+		table.Headers, rows = rows[0], rows[1:]
+	}
+	table.Rows = rows
+	if table.Rows == nil {
+		table.Rows = [][]string{}
+	}
+	return table, i
+}
+
+func splitTableRow(line string) []string {
+	line = strings.TrimSuffix(strings.TrimPrefix(line, "|"), "|")
+	cells := strings.Split(line, "|")
+	for i, cell := range cells {
+		cells[i] = strings.TrimSpace(cell)
+	}
+	return cells
+}
+
+// parseAwaitTreeSection parses the entries of the section, each entry starts with ">> " and is
+// followed by the trace. The section ends at the next section or at the first unindented line
+// after a blank line that does not start a new entry.
+func parseAwaitTreeSection(lines []string, start int) (AwaitTreeSection, int) {
+	section := AwaitTreeSection{Title: awaitTreeHeaderRe.FindStringSubmatch(strings.TrimSpace(lines[start]))[1]}
+	var (
+		current *AwaitTreeEntry
+		trace   []string
+	)
+	flush := func() {
+		if current == nil {
+			return
+		}
+		current.Trace = strings.TrimRight(strings.Join(trace, "\n"), "\n")
+		current.SlowSpans = slowSpans(trace)
+		section.Entries = append(section.Entries, *current)
+		current, trace = nil, nil
+	}
+
+	i := start + 1
+	for ; i < len(lines); i++ {
+		line := strings.TrimRight(lines[i], " ")
+		trimmed := strings.TrimSpace(line)
+		if awaitTreeHeaderRe.MatchString(trimmed) {
+			break
+		}
+		if strings.HasPrefix(trimmed, ">> ") {
+			flush()
+			current = &AwaitTreeEntry{Key: strings.TrimPrefix(trimmed, ">> ")}
+			continue
+		}
+		if trimmed != "" && i > start+1 && strings.TrimSpace(lines[i-1]) == "" && line == trimmed {
+			break
+		}
+		if current != nil {
+			trace = append(trace, line)
+		}
+	}
+	flush()
+	return section, i
+}
+
+func slowSpans(trace []string) []AwaitTreeSpan {
+	var spans []AwaitTreeSpan
+	for _, line := range trace {
+		m := awaitTreeSpanRe.FindStringSubmatch(line)
+		if m == nil || m[2] == "" {
+			continue
+		}
+		seconds, err := strconv.ParseFloat(m[3], 64)
+		if err != nil {
+			continue
+		}
+		if m[4] == "ms" {
+			seconds /= 1000
+		}
+		spans = append(spans, AwaitTreeSpan{Span: m[1], Seconds: seconds})
+	}
+	return spans
+}
+
+// parseTextSection takes the heading and the lines until the next blank line.
+func parseTextSection(lines []string, start int) (TextSection, int) {
+	section := TextSection{Title: strings.TrimSpace(lines[start])}
+	var content []string
+	i := start + 1
+	for ; i < len(lines) && strings.TrimSpace(lines[i]) != ""; i++ {
+		content = append(content, strings.TrimRight(lines[i], " "))
+	}
+	section.Content = strings.Join(content, "\n")
+	return section, i
+}
+
+func nextNonEmpty(lines []string, start int) int {
+	for i := start; i < len(lines); i++ {
+		if strings.TrimSpace(lines[i]) != "" {
+			return i
+		}
+	}
+	return len(lines)
+}
+
+func parseInt(s string) *int64 {
+	n, err := strconv.ParseInt(strings.TrimSpace(s), 10, 64)
+	if err != nil {
+		return nil
+	}
+	return &n
+}
+
+func containsAny(s string, substrs ...string) bool {
+	for _, substr := range substrs {
+		if strings.Contains(s, substr) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,532 @@
+package http
+
+import (
+	"fmt"
+	"math"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/pmezard/go-difflib/difflib"
+)
+
+type SlowActorStatus string
+
+const (
+	SlowActorNew      SlowActorStatus = "new"
+	SlowActorChanged  SlowActorStatus = "changed"
+	SlowActorResolved SlowActorStatus = "resolved"
+)
+
+// DiagnoseDiff is the difference between two reports of the same cluster, from the older one
+// to the newer one.
+type DiagnoseDiff struct {
+	VersionChanged bool
+	FromVersion    string
+	ToVersion      string
+
+	Catalog     []CountChange
+	WorkerNodes WorkerNodesDiff
+	SlowActors  []SlowActorChange
+
+	// Metrics are the changed values of the streaming, batch and storage tables, e.g. the latency
+	// of the Hummock get operations
+	Metrics []MetricChange
+
+	// UnifiedDiff is the line diff of the raw reports, it covers the sections not recognized
+	UnifiedDiff string
+
+	// TooLargeSections are the titles of the sections left out of the unified diff because
+	// either report exceeds maxUnifiedDiffSectionBytes in them
+	TooLargeSections []string
+}
+
+type CountChange struct {
+	Name   string
+	Before int64
+	After  int64
+}
+
+type WorkerNodesDiff struct {
+	Added   []WorkerNode
+	Removed []WorkerNode
+	Changed []WorkerNodeChange
+}
+
+type WorkerNodeChange struct {
+	ID      int64
+	Host    string
+	Type    string
+	Changes []AttributeChange
+}
+
+type AttributeChange struct {
+	Name   string
+	Before string
+	After  string
+}
+
+// SlowActorChange is an await tree entry with slow spans in either report. Seconds is the
+// longest slow span of the entry.
+type SlowActorChange struct {
+	Section       string
+	Key           string
+	Status        SlowActorStatus
+	BeforeSeconds *float64
+	AfterSeconds  *float64
+	SlowSpans     []AwaitTreeSpan
+}
+
+// MetricChange is a cell of a table that differs between the reports. Before or After is nil
+// if the row only exists in one of the reports, Delta is set if both values are numbers.
+type MetricChange struct {
+	Table  string
+	Row    string
+	Column string
+	Before *string
+	After  *string
+	Delta  *float64
+}
+
+// unifiedDiffContext is the number of lines around each change in the unified diff.
+const unifiedDiffContext = 3
+
+// maxUnifiedDiffSectionBytes caps the content of a section compared line by line, the line diff
+// is quadratic in the worst case and the await trees of a large cluster run into megabytes.
+const maxUnifiedDiffSectionBytes = 256 << 10
+
+// preambleSection is the title of the content before the first "--- title ---" heading.
+const preambleSection = "report"
+
+var hunkHeaderRe = regexp.MustCompile(`^@@ -(\d+)(,\d+)? \+(\d+)(,\d+)? @@`)
+
+// DiffDiagnoseReports compares the sections of the reports, the raw content is compared line by
+// line for the unified diff.
+func DiffDiagnoseReports(fromName, fromContent, toName, toContent string) (*DiagnoseDiff, error) {
+	from := ParseDiagnoseReport(fromContent)
+	to := ParseDiagnoseReport(toContent)
+
+	unified, tooLarge, err := unifiedDiff(fromName, fromContent, toName, toContent)
+	if err != nil {
+		return nil, err
+	}
+
+	return &DiagnoseDiff{
+		VersionChanged:   from.Version != to.Version,
+		FromVersion:      from.Version,
+		ToVersion:        to.Version,
+		Catalog:          diffCatalog(from.Catalog, to.Catalog),
+		WorkerNodes:      diffWorkerNodes(from.WorkerNodes, to.WorkerNodes),
+		SlowActors:       diffSlowActors(from.AwaitTree, to.AwaitTree),
+		Metrics:          diffMetrics(from, to),
+		UnifiedDiff:      unified,
+		TooLargeSections: tooLarge,
+	}, nil
+}
+
+// rawSection is the part of the raw report from a "--- title ---" heading to the next one. Start
+// is the zero based line of the heading in the report.
+type rawSection struct {
+	start int
+	lines []string
+	size  int
+}
+
+// splitRawSections keys the sections by the title, a repeated title is keyed by its occurrence.
+func splitRawSections(content string) (map[string]*rawSection, []string) {
+	current := &rawSection{}
+	sections := map[string]*rawSection{preambleSection: current}
+	keys := []string{preambleSection}
+	for i, line := range difflib.SplitLines(content) {
+		if m := awaitTreeHeaderRe.FindStringSubmatch(strings.TrimSpace(line)); m != nil {
+			key := m[1]
+			for n := 2; sections[key] != nil; n++ {
+				key = fmt.Sprintf("%s #%d", m[1], n)
+			}
+			current = &rawSection{start: i}
+			sections[key] = current
+			keys = append(keys, key)
+		}
+		current.lines = append(current.lines, line)
+		current.size += len(line)
+	}
+	return sections, keys
+}
+
+// unifiedDiff diffs the reports section by section so that a section over the size limit is
+// reported as too large instead of being compared. The hunk headers keep the line numbers of
+// the whole reports.
+func unifiedDiff(fromName, fromContent, toName, toContent string) (string, []string, error) {
+	before, beforeKeys := splitRawSections(fromContent)
+	after, afterKeys := splitRawSections(toContent)
+
+	keys := afterKeys
+	for _, key := range beforeKeys {
+		if _, ok := after[key]; !ok {
+			keys = append(keys, key)
+		}
+	}
+
+	var sb strings.Builder
+	tooLarge := []string{}
+	for _, key := range keys {
+		prev, cur := before[key], after[key]
+		if prev == nil {
+			prev = &rawSection{}
+		}
+		if cur == nil {
+			cur = &rawSection{}
+		}
+		if prev.size > maxUnifiedDiffSectionBytes || cur.size > maxUnifiedDiffSectionBytes {
+			tooLarge = append(tooLarge, key)
+			fmt.Fprintf(&sb, "@@ section %q is too large to diff (%d bytes before, %d bytes after) @@\n", key, prev.size, cur.size)
+			continue
+		}
+		diff, err := difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+			A:       prev.lines,
+			B:       cur.lines,
+			Context: unifiedDiffContext,
+		})
+		if err != nil {
+			return "", nil, errors.Wrap(err, "failed to generate unified diff")
+		}
+		sb.WriteString(shiftHunks(diff, prev.start, cur.start))
+	}
+
+	if sb.Len() == 0 {
+		return "", tooLarge, nil
+	}
+	return fmt.Sprintf("--- %s\n+++ %s\n", fromName, toName) + sb.String(), tooLarge, nil
+}
+
+// shiftHunks moves the line numbers of the hunk headers from the section to the report.
+func shiftHunks(diff string, fromOffset, toOffset int) string {
+	if fromOffset == 0 && toOffset == 0 {
+		return diff
+	}
+	lines := strings.SplitAfter(diff, "\n")
+	for i, line := range lines {
+		m := hunkHeaderRe.FindStringSubmatch(line)
+		if m == nil {
+			continue
+		}
+		a, _ := strconv.Atoi(m[1])
+		b, _ := strconv.Atoi(m[3])
+		// an empty range starts at the line before it, the same in the section and the report
+		lines[i] = fmt.Sprintf("@@ -%d%s +%d%s @@", a+fromOffset, m[2], b+toOffset, m[4]) + line[len(m[0]):]
+	}
+	return strings.Join(lines, "")
+}
+
+func diffCatalog(from, to map[string]int64) []CountChange {
+	names := map[string]struct{}{}
+	for name := range from {
+		names[name] = struct{}{}
+	}
+	for name := range to {
+		names[name] = struct{}{}
+	}
+
+	changes := []CountChange{}
+	for _, name := range sortedKeys(names) {
+		if from[name] != to[name] {
+			changes = append(changes, CountChange{Name: name, Before: from[name], After: to[name]})
+		}
+	}
+	return changes
+}
+
+// workerNodeKey identifies a worker node across reports. The ID is reassigned when a node
+// rejoins the cluster, so the host is part of the key.
+func workerNodeKey(node WorkerNode) string {
+	return fmt.Sprintf("%d/%s", node.ID, node.Host)
+}
+
+func diffWorkerNodes(from, to []WorkerNode) WorkerNodesDiff {
+	diff := WorkerNodesDiff{Added: []WorkerNode{}, Removed: []WorkerNode{}, Changed: []WorkerNodeChange{}}
+
+	before := map[string]WorkerNode{}
+	for _, node := range from {
+		before[workerNodeKey(node)] = node
+	}
+	seen := map[string]struct{}{}
+	for _, node := range to {
+		key := workerNodeKey(node)
+		seen[key] = struct{}{}
+		prev, ok := before[key]
+		if !ok {
+			diff.Added = append(diff.Added, node)
+			continue
+		}
+		if changes := diffAttributes(prev.Attributes, node.Attributes); len(changes) > 0 {
+			diff.Changed = append(diff.Changed, WorkerNodeChange{
+				ID:      node.ID,
+				Host:    node.Host,
+				Type:    node.Type,
+				Changes: changes,
+			})
+		}
+	}
+	for _, node := range from {
+		if _, ok := seen[workerNodeKey(node)]; !ok {
+			diff.Removed = append(diff.Removed, node)
+		}
+	}
+	return diff
+}
+
+// volatileWorkerAttributes change in every report and are not part of the topology.
+var volatileWorkerAttributes = map[string]struct{}{
+	"started_at":        {},
+	"uptime":            {},
+	"last_heartbeat_at": {},
+}
+
+func diffAttributes(from, to map[string]string) []AttributeChange {
+	names := map[string]struct{}{}
+	for name := range from {
+		names[name] = struct{}{}
+	}
+	for name := range to {
+		names[name] = struct{}{}
+	}
+
+	var changes []AttributeChange
+	for _, name := range sortedKeys(names) {
+		if _, ok := volatileWorkerAttributes[strings.ToLower(name)]; ok {
+			continue
+		}
+		if from[name] != to[name] {
+			changes = append(changes, AttributeChange{Name: name, Before: from[name], After: to[name]})
+		}
+	}
+	return changes
+}
+
+type slowEntry struct {
+	section string
+	entry   AwaitTreeEntry
+}
+
+func slowEntries(sections []AwaitTreeSection) (map[string]slowEntry, []string) {
+	entries := map[string]slowEntry{}
+	var keys []string
+	for _, section := range sections {
+		for _, entry := range section.Entries {
+			if len(entry.SlowSpans) == 0 {
+				continue
+			}
+			key := section.Title + "\x00" + entry.Key
+			if _, ok := entries[key]; !ok {
+				keys = append(keys, key)
+			}
+			entries[key] = slowEntry{section: section.Title, entry: entry}
+		}
+	}
+	return entries, keys
+}
+
+func longestSpan(spans []AwaitTreeSpan) float64 {
+	var longest float64
+	for _, span := range spans {
+		longest = math.Max(longest, span.Seconds)
+	}
+	return longest
+}
+
+// diffSlowActors reports the entries that became slow, are still slow or are no longer slow.
+// The entries still slow are ordered by the increase of the longest span.
+func diffSlowActors(from, to []AwaitTreeSection) []SlowActorChange {
+	before, beforeKeys := slowEntries(from)
+	after, afterKeys := slowEntries(to)
+
+	var added, changed, resolved []SlowActorChange
+	for _, key := range afterKeys {
+		cur := after[key]
+		seconds := longestSpan(cur.entry.SlowSpans)
+		change := SlowActorChange{
+			Section:      cur.section,
+			Key:          cur.entry.Key,
+			AfterSeconds: &seconds,
+			SlowSpans:    cur.entry.SlowSpans,
+		}
+		prev, ok := before[key]
+		if !ok {
+			change.Status = SlowActorNew
+			added = append(added, change)
+			continue
+		}
+		prevSeconds := longestSpan(prev.entry.SlowSpans)
+		change.Status = SlowActorChanged
+		change.BeforeSeconds = &prevSeconds
+		changed = append(changed, change)
+	}
+	for _, key := range beforeKeys {
+		if _, ok := after[key]; ok {
+			continue
+		}
+		prev := before[key]
+		prevSeconds := longestSpan(prev.entry.SlowSpans)
+		resolved = append(resolved, SlowActorChange{
+			Section:       prev.section,
+			Key:           prev.entry.Key,
+			Status:        SlowActorResolved,
+			BeforeSeconds: &prevSeconds,
+			SlowSpans:     prev.entry.SlowSpans,
+		})
+	}
+
+	sort.SliceStable(changed, func(i, j int) bool {
+		return *changed[i].AfterSeconds-*changed[i].BeforeSeconds > *changed[j].AfterSeconds-*changed[j].BeforeSeconds
+	})
+
+	result := make([]SlowActorChange, 0, len(added)+len(changed)+len(resolved))
+	result = append(result, added...)
+	result = append(result, changed...)
+	return append(result, resolved...)
+}
+
+// diffMetrics compares the tables with the same title. The rows are matched by the ids and the
+// cells that are not numbers, e.g. the id and the name of a source, and the numbers are compared.
+func diffMetrics(from, to *DiagnoseReport) []MetricChange {
+	changes := []MetricChange{}
+	changes = append(changes, diffCounter("storage", "number of SSTables", from.Storage.SstCount, to.Storage.SstCount)...)
+	changes = append(changes, diffCounter("storage", "total size of SSTables (byte)", from.Storage.SstTotalSizeBytes, to.Storage.SstTotalSizeBytes)...)
+
+	tables := func(r *DiagnoseReport) []DiagnoseTable {
+		var all []DiagnoseTable
+		all = append(all, r.Streaming...)
+		all = append(all, r.Batch...)
+		return append(all, r.Storage.Tables...)
+	}
+	before := map[string]DiagnoseTable{}
+	for _, table := range tables(from) {
+		before[table.Title] = table
+	}
+	seen := map[string]struct{}{}
+	for _, table := range tables(to) {
+		seen[table.Title] = struct{}{}
+		prev, ok := before[table.Title]
+		if !ok {
+			prev = DiagnoseTable{Title: table.Title, Headers: table.Headers}
+		}
+		changes = append(changes, diffTable(prev, table)...)
+	}
+	for _, table := range tables(from) {
+		if _, ok := seen[table.Title]; !ok {
+			changes = append(changes, diffTable(table, DiagnoseTable{Title: table.Title, Headers: table.Headers})...)
+		}
+	}
+	return changes
+}
+
+func diffCounter(table, name string, from, to *int64) []MetricChange {
+	if from == nil || to == nil || *from == *to {
+		return nil
+	}
+	delta := float64(*to - *from)
+	return []MetricChange{{
+		Table:  table,
+		Row:    name,
+		Column: "value",
+		Before: strPtr(strconv.FormatInt(*from, 10)),
+		After:  strPtr(strconv.FormatInt(*to, 10)),
+		Delta:  &delta,
+	}}
+}
+
+type tableRow struct {
+	cells map[string]string
+}
+
+// rowsByKey indexes the rows by the ids and the cells that are not numbers, the other cells are
+// returned by column.
+func rowsByKey(table DiagnoseTable) ([]string, map[string]tableRow) {
+	var keys []string
+	rows := map[string]tableRow{}
+	for _, row := range table.Rows {
+		var labels []string
+		cells := map[string]string{}
+		for i, h := range table.Headers {
+			if i >= len(row) {
+				continue
+			}
+			if _, err := strconv.ParseFloat(row[i], 64); err == nil && !isIDColumn(h) {
+				cells[h] = row[i]
+				continue
+			}
+			labels = append(labels, row[i])
+		}
+		key := strings.Join(labels, " / ")
+		if _, ok := rows[key]; !ok {
+			keys = append(keys, key)
+		}
+		rows[key] = tableRow{cells: cells}
+	}
+	return keys, rows
+}
+
+func diffTable(from, to DiagnoseTable) []MetricChange {
+	var changes []MetricChange
+	beforeKeys, before := rowsByKey(from)
+	afterKeys, after := rowsByKey(to)
+
+	for _, key := range afterKeys {
+		cur := after[key]
+		prev, ok := before[key]
+		for _, column := range to.Headers {
+			value, isNumber := cur.cells[column]
+			if !isNumber {
+				continue
+			}
+			change := MetricChange{Table: to.Title, Row: key, Column: column, After: strPtr(value)}
+			if ok {
+				prevValue, hasPrev := prev.cells[column]
+				if hasPrev && prevValue == value {
+					continue
+				}
+				if hasPrev {
+					change.Before = strPtr(prevValue)
+					a, _ := strconv.ParseFloat(value, 64)
+					b, _ := strconv.ParseFloat(prevValue, 64)
+					delta := a - b
+					change.Delta = &delta
+				}
+			}
+			changes = append(changes, change)
+		}
+	}
+
+	for _, key := range beforeKeys {
+		if _, ok := after[key]; ok {
+			continue
+		}
+		prev := before[key]
+		for _, column := range from.Headers {
+			if value, isNumber := prev.cells[column]; isNumber {
+				changes = append(changes, MetricChange{Table: from.Title, Row: key, Column: column, Before: strPtr(value)})
+			}
+		}
+	}
+	return changes
+}
+
+func isIDColumn(header string) bool {
+	header = strings.ToLower(header)
+	return header == "id" || strings.HasSuffix(header, "_id")
+}
+
+func sortedKeys(m map[string]struct{}) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func strPtr(s string) *string {
+	return &s
+}
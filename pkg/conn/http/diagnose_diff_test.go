@@ -0,0 +1,141 @@
+package http
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDiffDiagnoseReports(t *testing.T) {
+	newer := strings.NewReplacer(
+		"version: 2.3.0", "version: 2.3.1",
+		"number of materialized view: 3", "number of materialized view: 4",
+		"| 3  | frontend-0:4566| WORKER_TYPE_FRONTEND          | RUNNING |             |             |",
+		"| 4  | compute-1:5688 | WORKER_TYPE_COMPUTE_NODE      | RUNNING | 4           | 12          |",
+		"| 2  | compute-0:5688 | WORKER_TYPE_COMPUTE_NODE      | RUNNING | 4           | 48          |",
+		"| 2  | compute-0:5688 | WORKER_TYPE_COMPUTE_NODE      | RUNNING | 8           | 48          |",
+		"| 6           | mv_b    | 20    |", "| 6           | mv_b    | 25    |",
+		"number of SSTables: 120", "number of SSTables: 150",
+		"| 5        | 0.02  |", "| 5        | 0.5   |",
+		"[!!! 10.500s]", "[!!! 30.000s]",
+		"Epoch 7781 [20ms]", "Epoch 7781 [!!! 5.000s]",
+	).Replace(testDiagnoseReport)
+
+	diff, err := DiffDiagnoseReports("diagnostic-1", testDiagnoseReport, "diagnostic-2", newer)
+	require.NoError(t, err)
+
+	assert.True(t, diff.VersionChanged)
+	assert.Equal(t, "2.3.0", diff.FromVersion)
+	assert.Equal(t, "2.3.1", diff.ToVersion)
+	assert.Equal(t, []CountChange{{Name: "materialized view", Before: 3, After: 4}}, diff.Catalog)
+
+	require.Len(t, diff.WorkerNodes.Added, 1)
+	assert.Equal(t, "compute-1:5688", diff.WorkerNodes.Added[0].Host)
+	require.Len(t, diff.WorkerNodes.Removed, 1)
+	assert.Equal(t, "frontend-0:4566", diff.WorkerNodes.Removed[0].Host)
+	assert.Equal(t, []WorkerNodeChange{{
+		ID:      2,
+		Host:    "compute-0:5688",
+		Type:    "WORKER_TYPE_COMPUTE_NODE",
+		Changes: []AttributeChange{{Name: "parallelism", Before: "4", After: "8"}},
+	}}, diff.WorkerNodes.Changed)
+
+	require.Len(t, diff.SlowActors, 2)
+	assert.Equal(t, "Actor 2", diff.SlowActors[0].Key)
+	assert.Equal(t, SlowActorNew, diff.SlowActors[0].Status)
+	assert.Nil(t, diff.SlowActors[0].BeforeSeconds)
+	assert.Equal(t, 5.0, *diff.SlowActors[0].AfterSeconds)
+	assert.Equal(t, "Actor 1", diff.SlowActors[1].Key)
+	assert.Equal(t, SlowActorChanged, diff.SlowActors[1].Status)
+	assert.Equal(t, 10.5, *diff.SlowActors[1].BeforeSeconds)
+	assert.Equal(t, 30.0, *diff.SlowActors[1].AfterSeconds)
+
+	metrics := map[string]MetricChange{}
+	for _, m := range diff.Metrics {
+		metrics[m.Table+"|"+m.Row] = m
+	}
+	assert.Len(t, metrics, 3)
+	assert.Equal(t, 30.0, *metrics["storage|number of SSTables"].Delta)
+	assert.Equal(t, 5.0, *metrics["top materialized views by throughput (rows/s)|6 / mv_b"].Delta)
+	latency := metrics["top Hummock Get by duration (second)|5"]
+	assert.Equal(t, "0.02", *latency.Before)
+	assert.Equal(t, "0.5", *latency.After)
+	assert.InDelta(t, 0.48, *latency.Delta, 1e-9)
+
+	assert.Contains(t, diff.UnifiedDiff, "--- diagnostic-1\n+++ diagnostic-2\n")
+	assert.Contains(t, diff.UnifiedDiff, "-version: 2.3.0\n+version: 2.3.1\n")
+}
+
+func TestDiffSlowActorsResolved(t *testing.T) {
+	from := []AwaitTreeSection{{Title: "Actor Traces", Entries: []AwaitTreeEntry{
+		{Key: "Actor 1", SlowSpans: []AwaitTreeSpan{{Span: "a", Seconds: 3}, {Span: "b", Seconds: 4}}},
+	}}}
+	to := []AwaitTreeSection{{Title: "Actor Traces", Entries: []AwaitTreeEntry{{Key: "Actor 1"}}}}
+
+	changes := diffSlowActors(from, to)
+	require.Len(t, changes, 1)
+	assert.Equal(t, SlowActorResolved, changes[0].Status)
+	assert.Equal(t, 4.0, *changes[0].BeforeSeconds)
+	assert.Nil(t, changes[0].AfterSeconds)
+}
+
+func TestDiffMetricsRemovedRow(t *testing.T) {
+	from := &DiagnoseReport{Streaming: []DiagnoseTable{{
+		Title:   "top sources by throughput (rows/s)",
+		Headers: []string{"source_id", "source_name", "value"},
+		Rows:    [][]string{{"1", "orders", "10"}, {"2", "users", "3"}},
+	}}}
+	to := &DiagnoseReport{Streaming: []DiagnoseTable{{
+		Title:   "top sources by throughput (rows/s)",
+		Headers: []string{"source_id", "source_name", "value"},
+		Rows:    [][]string{{"1", "orders", "10"}},
+	}}}
+
+	changes := diffMetrics(from, to)
+	require.Len(t, changes, 1)
+	assert.Equal(t, "2 / users", changes[0].Row)
+	assert.Equal(t, "3", *changes[0].Before)
+	assert.Nil(t, changes[0].After)
+	assert.Nil(t, changes[0].Delta)
+}
+
+func TestDiffDiagnoseReportsTooLargeSection(t *testing.T) {
+	var trace strings.Builder
+	for trace.Len() <= maxUnifiedDiffSectionBytes {
+		trace.WriteString(">> Actor 3\nActor 3: `mv_c` [1.000s]\n")
+	}
+	newer := strings.NewReplacer(
+		"version: 2.3.0", "version: 2.3.1",
+		"--- RPC Traces ---\n", "--- RPC Traces ---\n"+trace.String(),
+		"barrier complete [1.000s]", "barrier complete [2.000s]",
+	).Replace(testDiagnoseReport)
+
+	diff, err := DiffDiagnoseReports("diagnostic-1", testDiagnoseReport, "diagnostic-2", newer)
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"RPC Traces"}, diff.TooLargeSections)
+	assert.Contains(t, diff.UnifiedDiff, "-version: 2.3.0\n+version: 2.3.1\n")
+	assert.Contains(t, diff.UnifiedDiff, `@@ section "RPC Traces" is too large to diff`)
+	assert.NotContains(t, diff.UnifiedDiff, "barrier complete")
+}
+
+func TestDiffDiagnoseReportsSectionLineNumbers(t *testing.T) {
+	newer := strings.Replace(testDiagnoseReport, "barrier complete [1.000s]", "barrier complete [2.000s]", 1)
+
+	diff, err := DiffDiagnoseReports("diagnostic-1", testDiagnoseReport, "diagnostic-2", newer)
+	require.NoError(t, err)
+
+	line := 0
+	for i, l := range strings.Split(testDiagnoseReport, "\n") {
+		if strings.HasPrefix(l, "barrier complete") {
+			line = i + 1
+		}
+	}
+	// the hunk starts at the heading of the section, the changed line is the third one
+	assert.Contains(t, diff.UnifiedDiff, fmt.Sprintf("@@ -%d,", line-2))
+	assert.Contains(t, diff.UnifiedDiff, "-barrier complete [1.000s]\n+barrier complete [2.000s]\n")
+	assert.Empty(t, diff.TooLargeSections)
+}
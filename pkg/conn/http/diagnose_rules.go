@@ -0,0 +1,303 @@
+package http
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+type DiagnoseRule string
+
+const (
+	// RuleBarrierLatency fires once the barrier latency reported exceeds the threshold in seconds
+	RuleBarrierLatency DiagnoseRule = "barrier_latency"
+
+	// RuleActorBlocked fires once a span of the actor traces is pending longer than the threshold
+	// in seconds
+	RuleActorBlocked DiagnoseRule = "actor_blocked"
+
+	// RuleWorkerCountDropped fires once the number of running worker nodes dropped by at least
+	// the threshold since the previous report
+	RuleWorkerCountDropped DiagnoseRule = "worker_count_dropped"
+)
+
+type DiagnoseSeverity string
+
+const (
+	SeverityWarning  DiagnoseSeverity = "warning"
+	SeverityCritical DiagnoseSeverity = "critical"
+)
+
+// DiagnoseRuleDefinition describes a built-in rule, the organizations may override the
+// threshold or disable the rule.
+type DiagnoseRuleDefinition struct {
+	Rule             DiagnoseRule
+	Description      string
+	Unit             string
+	DefaultThreshold float64
+}
+
+// DiagnoseRules are the built-in rules in the order they are evaluated.
+var DiagnoseRules = []DiagnoseRuleDefinition{
+	{
+		Rule:             RuleBarrierLatency,
+		Description:      "Barrier latency is over the threshold",
+		Unit:             "seconds",
+		DefaultThreshold: 60,
+	},
+	{
+		Rule:             RuleActorBlocked,
+		Description:      "An actor is blocked for over the threshold",
+		Unit:             "seconds",
+		DefaultThreshold: 60,
+	},
+	{
+		Rule:             RuleWorkerCountDropped,
+		Description:      "The number of running worker nodes dropped by at least the threshold since the previous diagnostic",
+		Unit:             "workers",
+		DefaultThreshold: 1,
+	},
+}
+
+// GetDiagnoseRule returns the definition of the built-in rule, false if there is no such rule.
+func GetDiagnoseRule(rule DiagnoseRule) (DiagnoseRuleDefinition, bool) {
+	for _, def := range DiagnoseRules {
+		if def.Rule == rule {
+			return def, true
+		}
+	}
+	return DiagnoseRuleDefinition{}, false
+}
+
+// DiagnoseRuleConfig is the setting of a rule of an organization, a nil threshold means the
+// default one.
+type DiagnoseRuleConfig struct {
+	Enabled   bool
+	Threshold *float64
+}
+
+// DiagnoseFinding is a rule fired by a report. Value is what is compared against the threshold,
+// e.g. the longest barrier latency.
+type DiagnoseFinding struct {
+	Rule      DiagnoseRule
+	Severity  DiagnoseSeverity
+	Message   string
+	Value     float64
+	Threshold float64
+	Details   map[string]any
+}
+
+// maxFindingEntries bounds the entries listed in the details of a finding, e.g. the blocked
+// actors.
+const maxFindingEntries = 10
+
+// EvaluateDiagnoseRules runs the built-in rules over the report. The previous report of the
+// cluster is compared against by the rules on changes, it is nil for the first report. The rules
+// missing in the configs run with the default threshold.
+func EvaluateDiagnoseRules(report *DiagnoseReport, previous *DiagnoseReport, configs map[DiagnoseRule]DiagnoseRuleConfig) []DiagnoseFinding {
+	findings := []DiagnoseFinding{}
+	for _, def := range DiagnoseRules {
+		threshold := def.DefaultThreshold
+		if cfg, ok := configs[def.Rule]; ok {
+			if !cfg.Enabled {
+				continue
+			}
+			if cfg.Threshold != nil {
+				threshold = *cfg.Threshold
+			}
+		}
+
+		var finding *DiagnoseFinding
+		switch def.Rule {
+		case RuleBarrierLatency:
+			finding = evaluateBarrierLatency(report, threshold)
+		case RuleActorBlocked:
+			finding = evaluateActorBlocked(report, threshold)
+		case RuleWorkerCountDropped:
+			finding = evaluateWorkerCountDropped(report, previous, threshold)
+		}
+		if finding != nil {
+			finding.Rule = def.Rule
+			finding.Threshold = threshold
+			findings = append(findings, *finding)
+		}
+	}
+	return findings
+}
+
+// severityOf escalates a finding to critical once the value is twice the threshold.
+func severityOf(value, threshold float64) DiagnoseSeverity {
+	if value >= 2*threshold {
+		return SeverityCritical
+	}
+	return SeverityWarning
+}
+
+// barrierLatencyColumns are the columns holding the latency in seconds in the barrier tables.
+var barrierLatencyColumns = []string{"value", "latency", "duration_sec", "duration"}
+
+// evaluateBarrierLatency takes the longest latency of the barrier tables and of the barrier
+// completions logged as JSON lines, e.g. {"epoch": 7781, "duration_sec": 0.5}.
+func evaluateBarrierLatency(report *DiagnoseReport, threshold float64) *DiagnoseFinding {
+	var (
+		latency float64
+		source  string
+	)
+	observe := func(value float64, title string) {
+		if value > latency {
+			latency, source = value, title
+		}
+	}
+
+	var tables []DiagnoseTable
+	tables = append(tables, report.Streaming...)
+	tables = append(tables, report.EventLogs...)
+	tables = append(tables, report.OtherTables...)
+	for _, table := range tables {
+		if !strings.Contains(strings.ToLower(table.Title), "barrier") {
+			continue
+		}
+		for _, name := range barrierLatencyColumns {
+			col := table.Column(name)
+			if col < 0 {
+				continue
+			}
+			for _, row := range table.Rows {
+				if col >= len(row) {
+					continue
+				}
+				if value, err := strconv.ParseFloat(strings.TrimSpace(row[col]), 64); err == nil {
+					observe(value, table.Title)
+				}
+			}
+		}
+	}
+
+	for _, section := range report.TextSections {
+		if !strings.Contains(strings.ToLower(section.Title), "barrier") {
+			continue
+		}
+		for _, line := range strings.Split(section.Content, "\n") {
+			var completion struct {
+				DurationSec *float64 `json:"duration_sec"`
+			}
+			if err := json.Unmarshal([]byte(line), &completion); err == nil && completion.DurationSec != nil {
+				observe(*completion.DurationSec, section.Title)
+			}
+		}
+	}
+
+	if latency <= threshold {
+		return nil
+	}
+	return &DiagnoseFinding{
+		Severity: severityOf(latency, threshold),
+		Message:  fmt.Sprintf("barrier latency is %.1fs, over the threshold of %.1fs", latency, threshold),
+		Value:    latency,
+		Details:  map[string]any{"source": source},
+	}
+}
+
+// evaluateActorBlocked finds the entries of the actor traces with a slow span longer than the
+// threshold, the longest ones are listed in the details.
+func evaluateActorBlocked(report *DiagnoseReport, threshold float64) *DiagnoseFinding {
+	type blocked struct {
+		key     string
+		span    string
+		seconds float64
+	}
+	var actors []blocked
+	for _, section := range report.AwaitTree {
+		if !strings.Contains(strings.ToLower(section.Title), "actor") {
+			continue
+		}
+		for _, entry := range section.Entries {
+			var longest AwaitTreeSpan
+			for _, span := range entry.SlowSpans {
+				if span.Seconds > longest.Seconds {
+					longest = span
+				}
+			}
+			if longest.Seconds > threshold {
+				actors = append(actors, blocked{key: entry.Key, span: longest.Span, seconds: longest.Seconds})
+			}
+		}
+	}
+	if len(actors) == 0 {
+		return nil
+	}
+
+	sort.SliceStable(actors, func(i, j int) bool {
+		return actors[i].seconds > actors[j].seconds
+	})
+	entries := []map[string]any{}
+	for _, actor := range actors[:min(len(actors), maxFindingEntries)] {
+		entries = append(entries, map[string]any{"key": actor.key, "span": actor.span, "seconds": actor.seconds})
+	}
+	longest := actors[0]
+	return &DiagnoseFinding{
+		Severity: severityOf(longest.seconds, threshold),
+		Message: fmt.Sprintf(
+			"%d actor(s) blocked for over %.1fs, %s is blocked at %s for %.1fs",
+			len(actors), threshold, longest.key, longest.span, longest.seconds,
+		),
+		Value:   longest.seconds,
+		Details: map[string]any{"blockedActors": len(actors), "actors": entries},
+	}
+}
+
+// runningWorkers counts the running worker nodes by type, a node without a state is counted as
+// running.
+func runningWorkers(nodes []WorkerNode) map[string]int {
+	counts := map[string]int{}
+	for _, node := range nodes {
+		if node.State == "" || strings.Contains(strings.ToUpper(node.State), "RUNNING") {
+			counts[node.Type]++
+		}
+	}
+	return counts
+}
+
+// evaluateWorkerCountDropped compares the running worker nodes of each type against the previous
+// report. It is critical once no worker node of a type is left running.
+func evaluateWorkerCountDropped(report *DiagnoseReport, previous *DiagnoseReport, threshold float64) *DiagnoseFinding {
+	if previous == nil || len(report.WorkerNodes) == 0 {
+		// the worker nodes are missing if the report failed to list them
+		return nil
+	}
+	before := runningWorkers(previous.WorkerNodes)
+	after := runningWorkers(report.WorkerNodes)
+
+	types := map[string]struct{}{}
+	for typ := range before {
+		types[typ] = struct{}{}
+	}
+	var (
+		dropped  int
+		changes  = []map[string]any{}
+		severity = SeverityWarning
+		summary  []string
+	)
+	for _, typ := range sortedKeys(types) {
+		if after[typ] >= before[typ] {
+			continue
+		}
+		dropped += before[typ] - after[typ]
+		if after[typ] == 0 {
+			severity = SeverityCritical
+		}
+		changes = append(changes, map[string]any{"type": typ, "before": before[typ], "after": after[typ]})
+		summary = append(summary, fmt.Sprintf("%s %d -> %d", typ, before[typ], after[typ]))
+	}
+	if dropped == 0 || float64(dropped) < threshold {
+		return nil
+	}
+	return &DiagnoseFinding{
+		Severity: severity,
+		Message:  fmt.Sprintf("%d running worker node(s) dropped since the previous diagnostic: %s", dropped, strings.Join(summary, ", ")),
+		Value:    float64(dropped),
+		Details:  map[string]any{"workerTypes": changes},
+	}
+}
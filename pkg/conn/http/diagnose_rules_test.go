@@ -0,0 +1,160 @@
+package http
+
+import (
+	"testing"
+
+	"github.com/risingwavelabs/risingwave-console/pkg/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testRulesReport = `+----+----------------+--------------------------+---------+
+| id | host           | type                     | state   |
++===========================================================+
+| 1  | meta-0:5690    | WORKER_TYPE_META         | RUNNING |
+|----+----------------+--------------------------+---------|
+| 2  | compute-0:5688 | WORKER_TYPE_COMPUTE_NODE | RUNNING |
+|----+----------------+--------------------------+---------|
+| 3  | compute-1:5688 | WORKER_TYPE_COMPUTE_NODE | RUNNING |
++----+----------------+--------------------------+---------+
+
+top barrier latency (second)
++-------+-------+
+| epoch | value |
++===============+
+| 7780  | 45.5  |
++-------+-------+
+
+--- Actor Traces ---
+>> Actor 1
+Actor 1: ` + "`mv_a`" + ` [130.000s]
+  MaterializeExecutor 100000003 (actor 1) [!!! 125.000s]
+
+>> Actor 2
+Actor 2: ` + "`mv_b`" + ` [70.000s]
+  HashJoinExecutor 100000002 (actor 2) [!!! 70.000s]
+
+>> Actor 3
+Actor 3: ` + "`mv_c`" + ` [20.000s]
+  HashAggExecutor 100000001 (actor 3) [!!! 20.000s]
+
+latest barrier completions
+{"epoch": 7781, "duration_sec": 90.5}
+{"epoch": 7782, "duration_sec": 0.5}
+`
+
+const testRulesPreviousReport = `+----+----------------+--------------------------+---------+
+| id | host           | type                     | state   |
++===========================================================+
+| 1  | meta-0:5690    | WORKER_TYPE_META         | RUNNING |
+|----+----------------+--------------------------+---------|
+| 2  | compute-0:5688 | WORKER_TYPE_COMPUTE_NODE | RUNNING |
+|----+----------------+--------------------------+---------|
+| 3  | compute-1:5688 | WORKER_TYPE_COMPUTE_NODE | RUNNING |
+|----+----------------+--------------------------+---------|
+| 4  | compute-2:5688 | WORKER_TYPE_COMPUTE_NODE | RUNNING |
+|----+----------------+--------------------------+---------|
+| 5  | compactor-0    | WORKER_TYPE_COMPACTOR    | RUNNING |
++----+----------------+--------------------------+---------+
+`
+
+func TestEvaluateDiagnoseRules(t *testing.T) {
+	report := ParseDiagnoseReport(testRulesReport)
+	previous := ParseDiagnoseReport(testRulesPreviousReport)
+
+	findings := EvaluateDiagnoseRules(report, previous, nil)
+	require.Len(t, findings, 3)
+
+	assert.Equal(t, DiagnoseFinding{
+		Rule:      RuleBarrierLatency,
+		Severity:  SeverityWarning,
+		Message:   "barrier latency is 90.5s, over the threshold of 60.0s",
+		Value:     90.5,
+		Threshold: 60,
+		Details:   map[string]any{"source": "latest barrier completions"},
+	}, findings[0])
+
+	assert.Equal(t, RuleActorBlocked, findings[1].Rule)
+	assert.Equal(t, SeverityCritical, findings[1].Severity)
+	assert.Equal(t, 125.0, findings[1].Value)
+	assert.Equal(t, "2 actor(s) blocked for over 60.0s, Actor 1 is blocked at MaterializeExecutor 100000003 (actor 1) for 125.0s", findings[1].Message)
+	assert.Equal(t, []map[string]any{
+		{"key": "Actor 1", "span": "MaterializeExecutor 100000003 (actor 1)", "seconds": 125.0},
+		{"key": "Actor 2", "span": "HashJoinExecutor 100000002 (actor 2)", "seconds": 70.0},
+	}, findings[1].Details["actors"])
+
+	assert.Equal(t, DiagnoseFinding{
+		Rule:      RuleWorkerCountDropped,
+		Severity:  SeverityCritical,
+		Message:   "2 running worker node(s) dropped since the previous diagnostic: WORKER_TYPE_COMPACTOR 1 -> 0, WORKER_TYPE_COMPUTE_NODE 3 -> 2",
+		Value:     2,
+		Threshold: 1,
+		Details: map[string]any{"workerTypes": []map[string]any{
+			{"type": "WORKER_TYPE_COMPACTOR", "before": 1, "after": 0},
+			{"type": "WORKER_TYPE_COMPUTE_NODE", "before": 3, "after": 2},
+		}},
+	}, findings[2])
+}
+
+func TestEvaluateDiagnoseRulesConfigs(t *testing.T) {
+	report := ParseDiagnoseReport(testRulesReport)
+	previous := ParseDiagnoseReport(testRulesPreviousReport)
+
+	testCases := []struct {
+		name     string
+		previous *DiagnoseReport
+		configs  map[DiagnoseRule]DiagnoseRuleConfig
+		expected []DiagnoseRule
+	}{
+		{
+			name:     "first report",
+			expected: []DiagnoseRule{RuleBarrierLatency, RuleActorBlocked},
+		},
+		{
+			name:     "disabled",
+			previous: previous,
+			configs: map[DiagnoseRule]DiagnoseRuleConfig{
+				RuleBarrierLatency: {Enabled: false},
+				RuleActorBlocked:   {Enabled: false},
+			},
+			expected: []DiagnoseRule{RuleWorkerCountDropped},
+		},
+		{
+			name:     "raised thresholds",
+			previous: previous,
+			configs: map[DiagnoseRule]DiagnoseRuleConfig{
+				RuleBarrierLatency:     {Enabled: true, Threshold: utils.Ptr(100.0)},
+				RuleActorBlocked:       {Enabled: true, Threshold: utils.Ptr(200.0)},
+				RuleWorkerCountDropped: {Enabled: true, Threshold: utils.Ptr(3.0)},
+			},
+			expected: []DiagnoseRule{},
+		},
+		{
+			name:     "lowered threshold",
+			previous: previous,
+			configs: map[DiagnoseRule]DiagnoseRuleConfig{
+				RuleActorBlocked:       {Enabled: true, Threshold: utils.Ptr(10.0)},
+				RuleBarrierLatency:     {Enabled: false},
+				RuleWorkerCountDropped: {Enabled: false},
+			},
+			expected: []DiagnoseRule{RuleActorBlocked},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			findings := EvaluateDiagnoseRules(report, tc.previous, tc.configs)
+			rules := []DiagnoseRule{}
+			for _, f := range findings {
+				rules = append(rules, f.Rule)
+			}
+			assert.Equal(t, tc.expected, rules)
+		})
+	}
+
+	// all three actors are over the lowered threshold
+	findings := EvaluateDiagnoseRules(report, nil, map[DiagnoseRule]DiagnoseRuleConfig{
+		RuleActorBlocked: {Enabled: true, Threshold: utils.Ptr(10.0)},
+	})
+	require.Len(t, findings, 2)
+	assert.Equal(t, 3, findings[1].Details["blockedActors"])
+}
@@ -0,0 +1,168 @@
+package http
+
+import (
+	"sort"
+	"strings"
+	"unicode"
+)
+
+const (
+	// maxSearchTextSize bounds the search text of a report, the tsvector of postgres is limited
+	// to 1MB.
+	maxSearchTextSize = 256 * 1024
+
+	// maxSearchTokenSize skips the tokens unlikely to be searched, e.g. hashes and encoded plans
+	maxSearchTokenSize = 64
+
+	// maxSnippetRunes is the length a snippet line is cut to around its first match
+	maxSnippetRunes = 240
+)
+
+// DiagnoseSnippetPart is a piece of a snippet, Match is set if it matches a search term.
+type DiagnoseSnippetPart struct {
+	Text  string
+	Match bool
+}
+
+// DiagnoseSnippet is a line of a report matching the search terms.
+type DiagnoseSnippet struct {
+	// Line is the 1-based line number in the report
+	Line  int
+	Parts []DiagnoseSnippetPart
+}
+
+// searchTokens splits the text into lowercase words of letters and digits, the same way the
+// text search parser of postgres splits the words of the "simple" configuration.
+func searchTokens(text string) []string {
+	return strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+}
+
+// DiagnoseSearchText returns the distinct words of the report in the order they first appear.
+// It is indexed instead of the report itself, a report of several MB exceeds the size limit of
+// the tsvector, while its vocabulary rarely does.
+func DiagnoseSearchText(content string) string {
+	var b strings.Builder
+	seen := map[string]struct{}{}
+	for _, token := range searchTokens(content) {
+		if len(token) > maxSearchTokenSize {
+			continue
+		}
+		if _, ok := seen[token]; ok {
+			continue
+		}
+		if b.Len()+len(token)+1 > maxSearchTextSize {
+			break
+		}
+		seen[token] = struct{}{}
+		if b.Len() > 0 {
+			b.WriteByte(' ')
+		}
+		b.WriteString(token)
+	}
+	return b.String()
+}
+
+// HighlightDiagnose returns the lines of the report matching most of the search terms, at most
+// limit lines in the order of the report. The matching words are marked in the parts.
+func HighlightDiagnose(content string, query string, limit int) []DiagnoseSnippet {
+	terms := map[string]struct{}{}
+	for _, token := range searchTokens(query) {
+		terms[token] = struct{}{}
+	}
+	if len(terms) == 0 || limit <= 0 {
+		return []DiagnoseSnippet{}
+	}
+
+	type candidate struct {
+		line    int
+		text    string
+		matched int
+	}
+	var candidates []candidate
+	for i, line := range strings.Split(content, "\n") {
+		matched := map[string]struct{}{}
+		for _, token := range searchTokens(line) {
+			if _, ok := terms[token]; ok {
+				matched[token] = struct{}{}
+			}
+		}
+		if len(matched) > 0 {
+			candidates = append(candidates, candidate{line: i + 1, text: line, matched: len(matched)})
+		}
+	}
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return candidates[i].matched > candidates[j].matched
+	})
+	if len(candidates) > limit {
+		candidates = candidates[:limit]
+	}
+	sort.Slice(candidates, func(i, j int) bool {
+		return candidates[i].line < candidates[j].line
+	})
+
+	snippets := make([]DiagnoseSnippet, len(candidates))
+	for i, c := range candidates {
+		snippets[i] = DiagnoseSnippet{Line: c.line, Parts: highlightLine(c.text, terms)}
+	}
+	return snippets
+}
+
+// highlightLine splits the line into the words matching the terms and the text between them.
+// A long line is cut around its first match.
+func highlightLine(line string, terms map[string]struct{}) []DiagnoseSnippetPart {
+	runes := []rune(strings.TrimRight(line, "\r"))
+
+	// find the matching words
+	type span struct{ start, end int }
+	var matches []span
+	for i := 0; i < len(runes); {
+		if !isSearchRune(runes[i]) {
+			i++
+			continue
+		}
+		j := i
+		for j < len(runes) && isSearchRune(runes[j]) {
+			j++
+		}
+		if _, ok := terms[strings.ToLower(string(runes[i:j]))]; ok {
+			matches = append(matches, span{i, j})
+		}
+		i = j
+	}
+
+	start, end := 0, len(runes)
+	if len(runes) > maxSnippetRunes && len(matches) > 0 {
+		start = max(0, matches[0].start-maxSnippetRunes/4)
+		end = min(len(runes), start+maxSnippetRunes)
+	}
+
+	var parts []DiagnoseSnippetPart
+	appendText := func(text string, match bool) {
+		if text != "" {
+			parts = append(parts, DiagnoseSnippetPart{Text: text, Match: match})
+		}
+	}
+	if start > 0 {
+		appendText("…", false)
+	}
+	pos := start
+	for _, m := range matches {
+		if m.start < start || m.end > end {
+			continue
+		}
+		appendText(string(runes[pos:m.start]), false)
+		appendText(string(runes[m.start:m.end]), true)
+		pos = m.end
+	}
+	appendText(string(runes[pos:end]), false)
+	if end < len(runes) {
+		appendText("…", false)
+	}
+	return parts
+}
+
+func isSearchRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r)
+}
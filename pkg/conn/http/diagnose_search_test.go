@@ -0,0 +1,90 @@
+package http
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDiagnoseSearchText(t *testing.T) {
+	content := "Slow Actors:\nfragment_id: 1234, actor_id: 5, Fragment_ID: 1234\n" + strings.Repeat("x", maxSearchTokenSize+1)
+	assert.Equal(t, "slow actors fragment id 1234 actor 5", DiagnoseSearchText(content))
+
+	// the vocabulary is cut at the size limit
+	var b strings.Builder
+	for i := 0; b.Len() < maxSearchTextSize*2; i++ {
+		fmt.Fprintf(&b, "actor %d\n", i)
+	}
+	text := DiagnoseSearchText(b.String())
+	assert.LessOrEqual(t, len(text), maxSearchTextSize)
+	assert.True(t, strings.HasPrefix(text, "actor 0 1 2 "))
+}
+
+func TestHighlightDiagnose(t *testing.T) {
+	content := strings.Join([]string{
+		"version: 2.3.0",
+		"fragment 1234 is running",
+		"slow actor of Fragment 1234: 35.2s",
+		"fragment 99",
+		"slow actor of fragment 99: 10s",
+	}, "\n")
+
+	testCases := []struct {
+		name     string
+		query    string
+		limit    int
+		expected []DiagnoseSnippet
+	}{
+		{
+			name:  "most matched lines first, in report order",
+			query: "slow fragment 1234",
+			limit: 2,
+			expected: []DiagnoseSnippet{
+				{Line: 2, Parts: []DiagnoseSnippetPart{{Text: "fragment", Match: true}, {Text: " "}, {Text: "1234", Match: true}, {Text: " is running"}}},
+				{Line: 3, Parts: []DiagnoseSnippetPart{
+					{Text: "slow", Match: true}, {Text: " actor of "}, {Text: "Fragment", Match: true}, {Text: " "}, {Text: "1234", Match: true}, {Text: ": 35.2s"},
+				}},
+			},
+		},
+		{
+			name:     "no match",
+			query:    "backfill",
+			limit:    3,
+			expected: []DiagnoseSnippet{},
+		},
+		{
+			name:     "no term",
+			query:    "::",
+			limit:    3,
+			expected: []DiagnoseSnippet{},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			snippets := HighlightDiagnose(content, tc.query, tc.limit)
+			if len(tc.expected) == 0 {
+				assert.Empty(t, snippets)
+				return
+			}
+			assert.Equal(t, tc.expected, snippets)
+		})
+	}
+}
+
+func TestHighlightLongLine(t *testing.T) {
+	line := strings.Repeat("a ", 200) + "needle" + strings.Repeat(" b", 200)
+	snippets := HighlightDiagnose(line, "needle", 1)
+	assert.Len(t, snippets, 1)
+
+	parts := snippets[0].Parts
+	assert.Equal(t, DiagnoseSnippetPart{Text: "…"}, parts[0])
+	assert.Equal(t, DiagnoseSnippetPart{Text: "needle", Match: true}, parts[2])
+	assert.Equal(t, DiagnoseSnippetPart{Text: "…"}, parts[len(parts)-1])
+	length := 0
+	for _, p := range parts[1 : len(parts)-1] {
+		length += len([]rune(p.Text))
+	}
+	assert.Equal(t, maxSnippetRunes, length)
+}
@@ -0,0 +1,147 @@
+package http
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testDiagnoseReport = `report created at: 2025-01-10 12:00:00.123456 UTC
+version: 2.3.0
+
+number of fragment: 12
+number of actor: 48
+number of source: 1
+number of table: 2
+number of materialized view: 3
+number of sink: 0
+number of index: 1
+number of function: 0
+
++----+----------------+-------------------------------+---------+-------------+-------------+
+| id | host           | type                          | state   | parallelism | actor_count |
++===========================================================================================+
+| 1  | meta-0:5690    | WORKER_TYPE_META              | RUNNING |             |             |
+|----+----------------+-------------------------------+---------+-------------+-------------|
+| 2  | compute-0:5688 | WORKER_TYPE_COMPUTE_NODE      | RUNNING | 4           | 48          |
+|----+----------------+-------------------------------+---------+-------------+-------------|
+| 3  | frontend-0:4566| WORKER_TYPE_FRONTEND          | RUNNING |             |             |
++----+----------------+-------------------------------+---------+-------------+-------------+
+
+top sources by throughput (rows/s)
++-----------+-------------+-------+
+| source_id | source_name | value |
++=================================+
+| 1         | orders      | 1200  |
++-----------+-------------+-------+
+
+top materialized views by throughput (rows/s)
++-------------+---------+-------+
+| table_id    | name    | value |
++===============================+
+| 5           | mv_a    | 300   |
+|-------------+---------+-------|
+| 6           | mv_b    | 20    |
++-------------+---------+-------+
+
+number of SSTables: 120
+total size of SSTables (byte): 1048576
+
+top Hummock Get by duration (second)
++----------+-------+
+| table_id | value |
++==================+
+| 5        | 0.02  |
++----------+-------+
+
+--- Actor Traces ---
+>> Actor 1
+Actor 1: ` + "`mv_a`" + ` [12.345s]
+  Epoch 7781 [0.100s]
+    MaterializeExecutor 100000003 (actor 1) [!!! 10.500s]
+      HashJoinExecutor 100000002 (actor 1) [!!! 10.400s]
+
+>> Actor 2
+Actor 2: ` + "`mv_b`" + ` [1.000s]
+  Epoch 7781 [20ms]
+
+--- RPC Traces ---
+>> RPC 10.0.0.1:5688
+barrier complete [1.000s]
+
+latest barrier completions
+{"epoch": 7781, "duration_sec": 0.5}
+`
+
+func TestParseDiagnoseReport(t *testing.T) {
+	report := ParseDiagnoseReport(testDiagnoseReport)
+
+	assert.Equal(t, "2025-01-10 12:00:00.123456 UTC", report.CreatedAt)
+	assert.Equal(t, "2.3.0", report.Version)
+	assert.Equal(t, map[string]int64{
+		"fragment":          12,
+		"actor":             48,
+		"source":            1,
+		"table":             2,
+		"materialized view": 3,
+		"sink":              0,
+		"index":             1,
+		"function":          0,
+	}, report.Catalog)
+
+	require.Len(t, report.WorkerNodes, 3)
+	assert.Equal(t, int64(2), report.WorkerNodes[1].ID)
+	assert.Equal(t, "compute-0:5688", report.WorkerNodes[1].Host)
+	assert.Equal(t, "WORKER_TYPE_COMPUTE_NODE", report.WorkerNodes[1].Type)
+	assert.Equal(t, "RUNNING", report.WorkerNodes[1].State)
+	assert.Equal(t, int64(4), report.WorkerNodes[1].Parallelism)
+	assert.Equal(t, int64(48), report.WorkerNodes[1].ActorCount)
+	assert.Equal(t, "frontend-0:4566", report.WorkerNodes[2].Host)
+
+	require.Len(t, report.Streaming, 2)
+	assert.Equal(t, DiagnoseTable{
+		Title:   "top materialized views by throughput (rows/s)",
+		Headers: []string{"table_id", "name", "value"},
+		Rows:    [][]string{{"5", "mv_a", "300"}, {"6", "mv_b", "20"}},
+	}, report.Streaming[1])
+	assert.Empty(t, report.Batch)
+
+	require.NotNil(t, report.Storage.SstCount)
+	assert.Equal(t, int64(120), *report.Storage.SstCount)
+	require.NotNil(t, report.Storage.SstTotalSizeBytes)
+	assert.Equal(t, int64(1048576), *report.Storage.SstTotalSizeBytes)
+	require.Len(t, report.Storage.Tables, 1)
+	assert.Equal(t, "top Hummock Get by duration (second)", report.Storage.Tables[0].Title)
+
+	require.Len(t, report.AwaitTree, 2)
+	assert.Equal(t, "Actor Traces", report.AwaitTree[0].Title)
+	require.Len(t, report.AwaitTree[0].Entries, 2)
+	assert.Equal(t, "Actor 1", report.AwaitTree[0].Entries[0].Key)
+	assert.Equal(t, []AwaitTreeSpan{
+		{Span: "MaterializeExecutor 100000003 (actor 1)", Seconds: 10.5},
+		{Span: "HashJoinExecutor 100000002 (actor 1)", Seconds: 10.4},
+	}, report.AwaitTree[0].Entries[0].SlowSpans)
+	assert.Empty(t, report.AwaitTree[0].Entries[1].SlowSpans)
+	assert.Equal(t, "Actor 2: `mv_b` [1.000s]\n  Epoch 7781 [20ms]", report.AwaitTree[0].Entries[1].Trace)
+	assert.Equal(t, "RPC Traces", report.AwaitTree[1].Title)
+	require.Len(t, report.AwaitTree[1].Entries, 1)
+
+	assert.Equal(t, []TextSection{{
+		Title:   "latest barrier completions",
+		Content: `{"epoch": 7781, "duration_sec": 0.5}`,
+	}}, report.TextSections)
+}
+
+func TestParseDiagnoseReportMultilineCell(t *testing.T) {
+	report := ParseDiagnoseReport(`top join executor by matched rows
++----------+-------+
+| executor | value |
++==================+
+| join     | 10    |
+| (left)   |       |
++----------+-------+
+`)
+	require.Len(t, report.Streaming, 1)
+	assert.Equal(t, [][]string{{"join\n(left)", "10"}}, report.Streaming[0].Rows)
+}
@@ -2,12 +2,15 @@ package http
 
 import (
 	"context"
+	"fmt"
 	"io"
 	"net/http"
 )
 
 type MetaHttpManagerInterface interface {
 	GetDiagnose(ctx context.Context, endpoint string) (string, error)
+
+	Ping(ctx context.Context, endpoint string) error
 }
 
 type MetaHttpManager struct {
@@ -21,6 +24,25 @@ func (m *MetaHttpManager) GetDiagnose(ctx context.Context, endpoint string) (str
 	return get(ctx, endpoint+"/api/monitor/diagnose/")
 }
 
+// Ping checks that the meta HTTP API responds by listing the compute nodes.
+func (m *MetaHttpManager) Ping(ctx context.Context, endpoint string) error {
+	req, err := http.NewRequestWithContext(ctx, "GET", endpoint+"/api/clusters/2", nil)
+	if err != nil {
+		return err
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected status %s", resp.Status)
+	}
+	return nil
+}
+
 func get(ctx context.Context, url string) (string, error) {
 	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
 	if err != nil {
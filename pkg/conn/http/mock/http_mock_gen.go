@@ -54,3 +54,17 @@ func (mr *MockMetaHttpManagerInterfaceMockRecorder) GetDiagnose(ctx, endpoint an
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDiagnose", reflect.TypeOf((*MockMetaHttpManagerInterface)(nil).GetDiagnose), ctx, endpoint)
 }
+
+// Ping mocks base method.
+func (m *MockMetaHttpManagerInterface) Ping(ctx context.Context, endpoint string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Ping", ctx, endpoint)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Ping indicates an expected call of Ping.
+func (mr *MockMetaHttpManagerInterfaceMockRecorder) Ping(ctx, endpoint any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Ping", reflect.TypeOf((*MockMetaHttpManagerInterface)(nil).Ping), ctx, endpoint)
+}
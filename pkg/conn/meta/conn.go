@@ -1,13 +1,13 @@
 package meta
 
 import (
-	"bytes"
 	"context"
 	"fmt"
+	"io"
 	"log"
-	"os/exec"
 	"regexp"
 	"strconv"
+	"time"
 
 	"github.com/risingwavelabs/risingwave-console/pkg/utils"
 	"golang.org/x/mod/semver"
@@ -17,42 +17,30 @@ type RisectlConnection struct {
 	risectlPath string
 	endpoint    string
 	version     string
+	resolution  VersionResolution
+	executor    *executor
 }
 
-func (c *RisectlConnection) prepareCmd(ctx context.Context, args ...string) *exec.Cmd {
-
-	cmd := exec.CommandContext(ctx, c.risectlPath, args...)
-	cmd.Env = append(cmd.Env, fmt.Sprintf("RW_META_ADDR=%s", c.endpoint))
-	return cmd
+// Resolution returns how the risectl version of the connection was chosen.
+func (c *RisectlConnection) Resolution() VersionResolution {
+	return c.resolution
 }
 
 func (c *RisectlConnection) RunCombined(ctx context.Context, args ...string) (string, int, error) {
 	log.Default().Printf("Running risectl (meta addr: %s) command: %s %v", c.endpoint, c.risectlPath, args)
-	cmd := c.prepareCmd(ctx, args...)
-	out, err := cmd.CombinedOutput()
-	exitCode := -2
-	if cmd.ProcessState != nil {
-		exitCode = cmd.ProcessState.ExitCode()
-	}
+	out := newCappedBuffer(c.executor.maxOutputSize)
+	exitCode, err := c.executor.run(ctx, c.risectlPath, c.endpoint, out, out, args...)
 
-	log.Default().Printf("risectl command output: %s, exit code: %d, error: %v", string(out), exitCode, err)
-	return string(out), exitCode, err
+	log.Default().Printf("risectl command output: %s, exit code: %d, error: %v", out.String(), exitCode, err)
+	return out.String(), exitCode, err
 }
 
 func (c *RisectlConnection) Run(ctx context.Context, args ...string) (string, string, int, error) {
 	log.Default().Printf("Running risectl (meta addr: %s) command: %s %v", c.endpoint, c.risectlPath, args)
-	cmd := c.prepareCmd(ctx, args...)
-	stdoutBuf := bytes.NewBuffer(nil)
-	stderrBuf := bytes.NewBuffer(nil)
-	cmd.Stdout = stdoutBuf
-	cmd.Stderr = stderrBuf
-
-	err := cmd.Run()
+	stdoutBuf := newCappedBuffer(c.executor.maxOutputSize)
+	stderrBuf := newCappedBuffer(c.executor.maxOutputSize)
 
-	exitCode := -2
-	if cmd.ProcessState != nil {
-		exitCode = cmd.ProcessState.ExitCode()
-	}
+	exitCode, err := c.executor.run(ctx, c.risectlPath, c.endpoint, stdoutBuf, stderrBuf, args...)
 
 	stdout := stdoutBuf.String()
 	stderr := stderrBuf.String()
@@ -61,6 +49,18 @@ func (c *RisectlConnection) Run(ctx context.Context, args ...string) (string, st
 	return stdout, stderr, exitCode, err
 }
 
+// Stream runs the command and writes its output to stdout and stderr as it is produced. The
+// process and its children are killed when the context is done. The output is not capped. Like
+// Run, the default timeout applies unless the context has a deadline, long running callers set
+// their own.
+func (c *RisectlConnection) Stream(ctx context.Context, stdout io.Writer, stderr io.Writer, args ...string) (int, error) {
+	log.Default().Printf("Streaming risectl (meta addr: %s) command: %s %v", c.endpoint, c.risectlPath, args)
+	exitCode, err := c.executor.run(ctx, c.risectlPath, c.endpoint, stdout, stderr, args...)
+
+	log.Default().Printf("risectl command exit code: %d, error: %v", exitCode, err)
+	return exitCode, err
+}
+
 // sample: backup job succeeded: job 1,
 var regexExtractJobID = regexp.MustCompile(`backup job succeeded: job (\d+)`)
 
@@ -92,3 +92,63 @@ func (c *RisectlConnection) DeleteSnapshot(ctx context.Context, snapshotID int64
 	}
 	return nil
 }
+
+// MetaSnapshot is a meta snapshot in the backup storage of the cluster.
+type MetaSnapshot struct {
+	ID int64
+
+	// CreatedAt is the physical time of the max committed epoch of the snapshot, nil if unknown
+	CreatedAt *time.Time
+}
+
+// risingwaveEpochOrigin is the origin of the physical time of RisingWave epochs.
+var risingwaveEpochOrigin = time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+
+// EpochTime returns the physical time of a RisingWave epoch, the upper 48 bits of an epoch are
+// the milliseconds since 2021-01-01.
+func EpochTime(epoch int64) time.Time {
+	return risingwaveEpochOrigin.Add(time.Duration(epoch>>16) * time.Millisecond)
+}
+
+// sample: error: unrecognized subcommand 'list-meta-snapshots'
+var regexUnrecognizedSubcommand = regexp.MustCompile(`unrecognized subcommand|Found argument '.*' which wasn't expected`)
+
+// ListMetaSnapshots lists the meta snapshots in the backup storage of the cluster.
+// ErrOperationNotSupported is returned if the risectl version can not list them.
+func (c *RisectlConnection) ListMetaSnapshots(ctx context.Context) ([]MetaSnapshot, error) {
+	stdout, stderr, ec, err := c.Run(ctx, "meta", "list-meta-snapshots")
+	if err != nil {
+		if regexUnrecognizedSubcommand.MatchString(stderr) {
+			return nil, fmt.Errorf("%w: meta list-meta-snapshots", ErrOperationNotSupported)
+		}
+		return nil, fmt.Errorf("failed to list meta snapshots: %w, stderr: %s, exit code: %d", err, stderr, ec)
+	}
+	return parseMetaSnapshots(stdout)
+}
+
+// parseMetaSnapshots parses the table of meta snapshots, an output without the table is an
+// error so that it is never taken as a cluster without snapshots.
+func parseMetaSnapshots(out string) ([]MetaSnapshot, error) {
+	for _, table := range parseTables(out) {
+		idIdx := headerIndex(table.Headers, "id", "snapshotid", "metasnapshotid")
+		if idIdx < 0 {
+			continue
+		}
+		epochIdx := headerIndex(table.Headers, "maxcommittedepoch")
+
+		snapshots := make([]MetaSnapshot, 0, len(table.Rows))
+		for _, row := range table.Rows {
+			id, err := strconv.ParseInt(cell(row, idIdx), 10, 64)
+			if err != nil {
+				return nil, fmt.Errorf("unexpected meta snapshot id %q", cell(row, idIdx))
+			}
+			snapshot := MetaSnapshot{ID: id}
+			if epoch, err := strconv.ParseInt(cell(row, epochIdx), 10, 64); err == nil {
+				snapshot.CreatedAt = utils.Ptr(EpochTime(epoch))
+			}
+			snapshots = append(snapshots, snapshot)
+		}
+		return snapshots, nil
+	}
+	return nil, fmt.Errorf("no meta snapshot table in output: %s", out)
+}
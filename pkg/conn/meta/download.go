@@ -0,0 +1,280 @@
+package meta
+
+import (
+	"archive/tar"
+	"bufio"
+	"compress/gzip"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"path"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"sync"
+)
+
+// maxRisectlSize is the maximum size of the extracted risectl binary.
+const maxRisectlSize = 1 << 30
+
+var (
+	ErrChecksumMismatch = errors.New("risectl checksum mismatch")
+	ErrChecksumNotFound = errors.New("risectl checksum not found in release")
+	ErrInvalidArchive   = errors.New("invalid risectl archive")
+	ErrInvalidVersion   = errors.New("invalid risectl version")
+	ErrAlreadyInstalled = errors.New("risectl version is already installed")
+)
+
+// archNames returns the names used in the release assets for the architecture.
+func archNames(goarch string) ([]string, error) {
+	switch goarch {
+	case "amd64":
+		return []string{"x86_64", "amd64"}, nil
+	case "arm64":
+		return []string{"aarch64", "arm64"}, nil
+	default:
+		return nil, fmt.Errorf("unsupported architecture %s", goarch)
+	}
+}
+
+// selectRisectlAsset returns the risectl archive built for the architecture.
+func selectRisectlAsset(assets []releaseAsset, goarch string) (*releaseAsset, error) {
+	arches, err := archNames(goarch)
+	if err != nil {
+		return nil, err
+	}
+	for i, asset := range assets {
+		if !strings.HasPrefix(asset.Name, "risectl") || !strings.HasSuffix(asset.Name, ".tar.gz") {
+			continue
+		}
+		for _, arch := range arches {
+			if strings.Contains(asset.Name, arch) {
+				return &assets[i], nil
+			}
+		}
+	}
+	return nil, fmt.Errorf("no risectl asset found for %s", arches[0])
+}
+
+// selectChecksumAsset returns the asset holding the checksum of the risectl archive, it is
+// either <archive>.sha256 or a checksum list of all assets, e.g. checksums.txt, SHA256SUMS.
+func selectChecksumAsset(assets []releaseAsset, assetName string) *releaseAsset {
+	for i, asset := range assets {
+		if asset.Name == assetName+".sha256" || asset.Name == assetName+".sha256sum" {
+			return &assets[i]
+		}
+	}
+	for i, asset := range assets {
+		name := strings.ToLower(asset.Name)
+		if strings.Contains(name, "checksums") || strings.Contains(name, "sha256sums") {
+			return &assets[i]
+		}
+	}
+	return nil
+}
+
+// parseChecksum finds the hex encoded sha256 of the asset in a checksum file. Each line is
+// either "<checksum>" or "<checksum>  <file name>" as printed by sha256sum.
+func parseChecksum(r io.Reader, assetName string) (string, error) {
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) == 0 || len(fields[0]) != sha256.Size*2 {
+			continue
+		}
+		if _, err := hex.DecodeString(fields[0]); err != nil {
+			continue
+		}
+		if len(fields) == 1 || strings.TrimPrefix(fields[1], "*") == assetName {
+			return strings.ToLower(fields[0]), nil
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return "", fmt.Errorf("failed to read checksum file: %w", err)
+	}
+	return "", fmt.Errorf("%w: %s", ErrChecksumNotFound, assetName)
+}
+
+// validateVersion makes sure the version can be used as a directory name.
+func validateVersion(version string) error {
+	if version == "" || version == "." || version == ".." || strings.ContainsAny(version, `/\`) {
+		return fmt.Errorf("%w: %q", ErrInvalidVersion, version)
+	}
+	return nil
+}
+
+// lockVersion serializes the downloads of the same version, the returned function releases the lock.
+func (m *RisectlManager) lockVersion(version string) func() {
+	v, _ := m.versionLocks.LoadOrStore(version, &sync.Mutex{})
+	lock := v.(*sync.Mutex)
+	lock.Lock()
+	return lock.Unlock
+}
+
+func (m *RisectlManager) downloadRisectl(ctx context.Context, version string) error {
+	source, err := m.getSource()
+	if err != nil {
+		return err
+	}
+
+	assets, err := source.ListAssets(ctx, version)
+	if err != nil {
+		return err
+	}
+
+	asset, err := selectRisectlAsset(assets, runtime.GOARCH)
+	if err != nil {
+		return fmt.Errorf("failed to select risectl asset for version %s: %w", version, err)
+	}
+
+	// Get the expected checksum of the archive
+	expected := asset.SHA256
+	if expected == "" {
+		if checksumAsset := selectChecksumAsset(assets, asset.Name); checksumAsset != nil {
+			rc, err := source.Download(ctx, *checksumAsset)
+			if err != nil {
+				return fmt.Errorf("failed to download checksum asset %s: %w", checksumAsset.Name, err)
+			}
+			expected, err = parseChecksum(rc, asset.Name)
+			rc.Close()
+			if err != nil {
+				return err
+			}
+		} else if !m.skipChecksum {
+			return fmt.Errorf("%w: %s", ErrChecksumNotFound, asset.Name)
+		} else {
+			log.Default().Printf("No checksum found for %s, skipping the verification", asset.Name)
+		}
+	}
+
+	rc, err := source.Download(ctx, *asset)
+	if err != nil {
+		return err
+	}
+	defer rc.Close()
+
+	return m.installRisectl(version, rc, expected)
+}
+
+// ImportRisectl installs the risectl binary of the version from a tar.gz archive. The archive is
+// verified against the checksum, an installed version is never replaced.
+func (m *RisectlManager) ImportRisectl(ctx context.Context, version string, archive io.Reader, checksum string) error {
+	if err := validateVersion(version); err != nil {
+		return err
+	}
+	if _, err := hex.DecodeString(checksum); err != nil || len(checksum) != sha256.Size*2 {
+		return fmt.Errorf("%w: invalid sha256 checksum %q", ErrInvalidArchive, checksum)
+	}
+
+	unlock := m.lockVersion(version)
+	defer unlock()
+
+	if _, err := os.Stat(filepath.Join(m.risectlDir, version, risectlFileName)); err == nil {
+		return fmt.Errorf("%w: %s", ErrAlreadyInstalled, version)
+	}
+	return m.installRisectl(version, archive, strings.ToLower(checksum))
+}
+
+// installRisectl saves the archive to a temporary file, verifies it against the expected
+// checksum if it is not empty, and then extracts the binary into the version directory.
+func (m *RisectlManager) installRisectl(version string, r io.Reader, expected string) error {
+	// Create version directory if it doesn't exist
+	versionDir := filepath.Join(m.risectlDir, version)
+	if err := os.MkdirAll(versionDir, 0755); err != nil {
+		return fmt.Errorf("failed to create directory %s: %w", versionDir, err)
+	}
+
+	archive, err := os.CreateTemp(versionDir, ".risectl-*.tar.gz")
+	if err != nil {
+		return fmt.Errorf("failed to create temporary file in %s: %w", versionDir, err)
+	}
+	defer os.Remove(archive.Name())
+	defer archive.Close()
+
+	hash := sha256.New()
+	if _, err := io.Copy(io.MultiWriter(archive, hash), r); err != nil {
+		return fmt.Errorf("failed to save risectl archive: %w", err)
+	}
+	if expected != "" {
+		if actual := hex.EncodeToString(hash.Sum(nil)); actual != expected {
+			return fmt.Errorf("%w: expected %s, got %s", ErrChecksumMismatch, expected, actual)
+		}
+	}
+
+	if _, err := archive.Seek(0, io.SeekStart); err != nil {
+		return fmt.Errorf("failed to rewind %s: %w", archive.Name(), err)
+	}
+	return extractRisectl(archive, filepath.Join(versionDir, risectlFileName))
+}
+
+// extractRisectl extracts the risectl binary from the tar.gz archive to outPath. The binary is
+// written to a temporary file first and renamed, so outPath is either absent or complete.
+func extractRisectl(r io.Reader, outPath string) error {
+	gzipReader, err := gzip.NewReader(r)
+	if err != nil {
+		return fmt.Errorf("%w: failed to create gzip reader: %w", ErrInvalidArchive, err)
+	}
+	defer gzipReader.Close()
+
+	tarReader := tar.NewReader(gzipReader)
+	for {
+		header, err := tarReader.Next()
+		if err == io.EOF {
+			return fmt.Errorf("%w: risectl binary not found in archive", ErrInvalidArchive)
+		}
+		if err != nil {
+			return fmt.Errorf("%w: failed to read tar archive: %w", ErrInvalidArchive, err)
+		}
+
+		if !isRisectlEntry(header) {
+			continue
+		}
+
+		tmp, err := os.CreateTemp(filepath.Dir(outPath), ".risectl-*")
+		if err != nil {
+			return fmt.Errorf("failed to create temporary file in %s: %w", filepath.Dir(outPath), err)
+		}
+		defer os.Remove(tmp.Name())
+
+		n, err := io.Copy(tmp, io.LimitReader(tarReader, maxRisectlSize+1))
+		if closeErr := tmp.Close(); err == nil {
+			err = closeErr
+		}
+		if err != nil {
+			return fmt.Errorf("failed to save risectl to %s: %w", tmp.Name(), err)
+		}
+		if n > maxRisectlSize {
+			return fmt.Errorf("%w: risectl binary in archive exceeds %d bytes", ErrInvalidArchive, maxRisectlSize)
+		}
+		if err := os.Chmod(tmp.Name(), 0755); err != nil {
+			return fmt.Errorf("failed to chmod %s: %w", tmp.Name(), err)
+		}
+		if err := os.Rename(tmp.Name(), outPath); err != nil {
+			return fmt.Errorf("failed to rename %s to %s: %w", tmp.Name(), outPath, err)
+		}
+		return nil
+	}
+}
+
+// isRisectlEntry reports whether the tar entry is the risectl binary. Only regular files
+// with a relative path inside the archive are accepted.
+func isRisectlEntry(header *tar.Header) bool {
+	if header.Typeflag != tar.TypeReg {
+		return false
+	}
+	name := header.Name
+	if name == "" || strings.HasPrefix(name, "/") || strings.Contains(name, `\`) {
+		return false
+	}
+	for _, part := range strings.Split(name, "/") {
+		if part == ".." {
+			return false
+		}
+	}
+	return path.Base(path.Clean(name)) == risectlFileName
+}
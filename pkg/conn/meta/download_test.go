@@ -0,0 +1,273 @@
+package meta
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/risingwavelabs/risingwave-console/pkg/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type tarEntry struct {
+	name     string
+	typeflag byte
+	content  string
+}
+
+func buildArchive(t *testing.T, entries []tarEntry) []byte {
+	buf := bytes.NewBuffer(nil)
+	gw := gzip.NewWriter(buf)
+	tw := tar.NewWriter(gw)
+	for _, e := range entries {
+		require.NoError(t, tw.WriteHeader(&tar.Header{
+			Name:     e.name,
+			Typeflag: e.typeflag,
+			Mode:     0755,
+			Size:     int64(len(e.content)),
+			Linkname: "/etc/passwd",
+		}))
+		if e.typeflag == tar.TypeReg {
+			_, err := tw.Write([]byte(e.content))
+			require.NoError(t, err)
+		}
+	}
+	require.NoError(t, tw.Close())
+	require.NoError(t, gw.Close())
+	return buf.Bytes()
+}
+
+func TestExtractRisectl(t *testing.T) {
+	testCases := []struct {
+		name     string
+		entries  []tarEntry
+		expected string
+	}{
+		{
+			name:     "nested binary",
+			entries:  []tarEntry{{name: "risectl-v2.2.1/README", typeflag: tar.TypeReg, content: "readme"}, {name: "risectl-v2.2.1/risectl", typeflag: tar.TypeReg, content: "binary"}},
+			expected: "binary",
+		},
+		{
+			name:     "unsafe entries are skipped",
+			entries:  []tarEntry{{name: "../risectl", typeflag: tar.TypeReg, content: "evil"}, {name: "/risectl", typeflag: tar.TypeReg, content: "evil"}, {name: "risectl", typeflag: tar.TypeSymlink}, {name: "bin/risectl", typeflag: tar.TypeReg, content: "binary"}},
+			expected: "binary",
+		},
+		{
+			name:    "suffix is not enough",
+			entries: []tarEntry{{name: "not-risectl", typeflag: tar.TypeReg, content: "evil"}},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			dir := t.TempDir()
+			outPath := filepath.Join(dir, risectlFileName)
+			err := extractRisectl(bytes.NewReader(buildArchive(t, tc.entries)), outPath)
+			if tc.expected == "" {
+				require.Error(t, err)
+				assert.NoFileExists(t, outPath)
+			} else {
+				require.NoError(t, err)
+				raw, err := os.ReadFile(outPath)
+				require.NoError(t, err)
+				assert.Equal(t, tc.expected, string(raw))
+			}
+
+			// no temporary files are left behind
+			files, err := os.ReadDir(dir)
+			require.NoError(t, err)
+			for _, f := range files {
+				assert.False(t, strings.HasPrefix(f.Name(), "."), f.Name())
+			}
+		})
+	}
+}
+
+func TestSelectRisectlAsset(t *testing.T) {
+	assets := []releaseAsset{
+		{Name: "risingwave-v2.2.1-x86_64-unknown-linux.tar.gz"},
+		{Name: "risectl-v2.2.1-x86_64-unknown-linux.tar.gz"},
+		{Name: "risectl-v2.2.1-x86_64-unknown-linux.tar.gz.sha256"},
+		{Name: "risectl-v2.2.1-aarch64-unknown-linux.tar.gz"},
+	}
+
+	amd64, err := selectRisectlAsset(assets, "amd64")
+	require.NoError(t, err)
+	assert.Equal(t, "risectl-v2.2.1-x86_64-unknown-linux.tar.gz", amd64.Name)
+
+	arm64, err := selectRisectlAsset(assets, "arm64")
+	require.NoError(t, err)
+	assert.Equal(t, "risectl-v2.2.1-aarch64-unknown-linux.tar.gz", arm64.Name)
+
+	_, err = selectRisectlAsset(assets, "riscv64")
+	require.Error(t, err)
+
+	checksum := selectChecksumAsset(assets, amd64.Name)
+	require.NotNil(t, checksum)
+	assert.Equal(t, "risectl-v2.2.1-x86_64-unknown-linux.tar.gz.sha256", checksum.Name)
+	assert.Nil(t, selectChecksumAsset(assets, arm64.Name))
+}
+
+func TestParseChecksum(t *testing.T) {
+	sum := strings.Repeat("ab", 32)
+	other := strings.Repeat("cd", 32)
+
+	got, err := parseChecksum(strings.NewReader(sum+"\n"), "a.tar.gz")
+	require.NoError(t, err)
+	assert.Equal(t, sum, got)
+
+	got, err = parseChecksum(strings.NewReader(other+"  b.tar.gz\n"+strings.ToUpper(sum)+" *a.tar.gz\n"), "a.tar.gz")
+	require.NoError(t, err)
+	assert.Equal(t, sum, got)
+
+	_, err = parseChecksum(strings.NewReader(other+"  b.tar.gz\n"), "a.tar.gz")
+	require.ErrorIs(t, err, ErrChecksumNotFound)
+}
+
+func TestValidateVersion(t *testing.T) {
+	require.NoError(t, validateVersion("v2.2.1"))
+	require.ErrorIs(t, validateVersion("../v2.2.1"), ErrInvalidVersion)
+	require.Error(t, validateVersion(".."))
+}
+
+func TestImportRisectl(t *testing.T) {
+	m := &RisectlManager{risectlDir: t.TempDir(), noInternet: true}
+	archive := buildArchive(t, []tarEntry{{name: "risectl", typeflag: tar.TypeReg, content: "binary"}})
+	sum := sha256.Sum256(archive)
+
+	err := m.ImportRisectl(context.Background(), "v2.2.1", bytes.NewReader(archive), "")
+	require.ErrorIs(t, err, ErrInvalidArchive, "the checksum is required")
+
+	err = m.ImportRisectl(context.Background(), "v2.2.1", bytes.NewReader(archive), strings.Repeat("00", 32))
+	require.ErrorIs(t, err, ErrChecksumMismatch)
+	assert.NoFileExists(t, filepath.Join(m.risectlDir, "v2.2.1", risectlFileName))
+
+	err = m.ImportRisectl(context.Background(), "v2.2.1", bytes.NewReader(archive), hex.EncodeToString(sum[:]))
+	require.NoError(t, err)
+	assert.FileExists(t, filepath.Join(m.risectlDir, "v2.2.1", risectlFileName))
+
+	err = m.ImportRisectl(context.Background(), "v2.2.1", bytes.NewReader(archive), hex.EncodeToString(sum[:]))
+	require.ErrorIs(t, err, ErrAlreadyInstalled)
+
+	versions, err := m.ListVersions(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, []string{"v2.2.1"}, versions)
+
+	_, err = m.NewConn(context.Background(), "v2.3.0", "localhost", 5690)
+	require.ErrorIs(t, err, ErrRisectlNotInstalled)
+}
+
+func TestMirrorSource(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer token" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		switch r.URL.Path {
+		case "/releases/index.json":
+			_, _ = w.Write([]byte(`{"versions": [{"version": "v2.2.1", "assets": [{"name": "risectl-v2.2.1-x86_64-unknown-linux.tar.gz", "url": "v2.2.1/risectl.tar.gz", "sha256": "ABCD"}]}]}`))
+		case "/releases/v2.2.1/risectl.tar.gz":
+			_, _ = w.Write([]byte("archive"))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	source, err := newArtifactSource(&config.Risectl{MirrorURL: server.URL + "/releases", Token: "token"})
+	require.NoError(t, err)
+
+	versions, err := source.ListVersions(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, []string{"v2.2.1"}, versions)
+
+	assets, err := source.ListAssets(context.Background(), "v2.2.1")
+	require.NoError(t, err)
+	require.Len(t, assets, 1)
+	assert.Equal(t, "abcd", assets[0].SHA256)
+
+	rc, err := source.Download(context.Background(), assets[0])
+	require.NoError(t, err)
+	defer rc.Close()
+	raw, err := io.ReadAll(rc)
+	require.NoError(t, err)
+	assert.Equal(t, "archive", string(raw))
+
+	_, err = source.ListAssets(context.Background(), "v0.0.1")
+	require.Error(t, err)
+}
+
+func TestGitHubSourceListsAllReleases(t *testing.T) {
+	var server *httptest.Server
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/v3/repos/owner/repo/releases" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		assert.Equal(t, "100", r.URL.Query().Get("per_page"))
+		switch r.URL.Query().Get("page") {
+		case "":
+			w.Header().Set("Link", fmt.Sprintf(`<%s/api/v3/repos/owner/repo/releases?per_page=100&page=2>; rel="next"`, server.URL))
+			_, _ = w.Write([]byte(`[{"tag_name": "v2.3.0"}, {"tag_name": "v2.2.1"}]`))
+		case "2":
+			_, _ = w.Write([]byte(`[{"tag_name": "v1.10.0"}]`))
+		}
+	}))
+	defer server.Close()
+
+	source, err := newArtifactSource(&config.Risectl{Repository: "owner/repo", GitHubURL: server.URL + "/api/v3/"})
+	require.NoError(t, err)
+
+	versions, err := source.ListVersions(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, []string{"v2.3.0", "v2.2.1", "v1.10.0"}, versions)
+}
+
+func TestDownloadRisectlVerifiesAssetDigest(t *testing.T) {
+	archive := buildArchive(t, []tarEntry{{name: "risectl", typeflag: tar.TypeReg, content: "binary"}})
+	sum := sha256.Sum256(archive)
+
+	// the releases have no checksum file, the digests of the assets are verified
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v3/repos/owner/repo/releases/tags/v2.2.1":
+			_, _ = fmt.Fprintf(w, `{"tag_name": "v2.2.1", "assets": [
+				{"id": 1, "name": "risectl-v2.2.1-x86_64-unknown-linux.tar.gz", "digest": "sha256:%[1]s"},
+				{"id": 1, "name": "risectl-v2.2.1-aarch64-unknown-linux.tar.gz", "digest": "sha256:%[1]s"}
+			]}`, strings.ToUpper(hex.EncodeToString(sum[:])))
+		case "/api/v3/repos/owner/repo/releases/tags/v2.3.0":
+			_, _ = fmt.Fprintf(w, `{"tag_name": "v2.3.0", "assets": [
+				{"id": 1, "name": "risectl-v2.3.0-x86_64-unknown-linux.tar.gz", "digest": "sha256:%[1]s"},
+				{"id": 1, "name": "risectl-v2.3.0-aarch64-unknown-linux.tar.gz", "digest": "sha256:%[1]s"}
+			]}`, strings.Repeat("00", 32))
+		case "/api/v3/repos/owner/repo/releases/assets/1":
+			_, _ = w.Write(archive)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	source, err := newArtifactSource(&config.Risectl{Repository: "owner/repo", GitHubURL: server.URL + "/api/v3/"})
+	require.NoError(t, err)
+	m := &RisectlManager{risectlDir: t.TempDir(), source: source}
+
+	require.NoError(t, m.downloadRisectl(context.Background(), "v2.2.1"))
+	assert.FileExists(t, filepath.Join(m.risectlDir, "v2.2.1", risectlFileName))
+
+	require.ErrorIs(t, m.downloadRisectl(context.Background(), "v2.3.0"), ErrChecksumMismatch)
+	assert.NoFileExists(t, filepath.Join(m.risectlDir, "v2.3.0", risectlFileName))
+}
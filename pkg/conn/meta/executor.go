@@ -0,0 +1,227 @@
+package meta
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/risingwavelabs/risingwave-console/pkg/config"
+	"github.com/risingwavelabs/risingwave-console/pkg/utils"
+)
+
+const (
+	defaultMaxConcurrency           = 16
+	defaultMaxConcurrencyPerCluster = 4
+	defaultExecTimeout              = 5 * time.Minute
+	defaultMaxOutputSize            = 16 << 20
+
+	// waitDelay is how long to wait for the output of a killed command to be closed
+	waitDelay = 5 * time.Second
+
+	// defaultPath is the PATH of the risectl processes
+	defaultPath = "/usr/local/sbin:/usr/local/bin:/usr/sbin:/usr/bin:/sbin:/bin"
+)
+
+var ErrExecTimeout = errors.New("risectl command timed out")
+
+// executor runs the risectl processes. It limits the number of processes globally and per
+// cluster, applies a default timeout, caps the buffered output and runs every process in its own
+// temporary directory under the work dir with a controlled environment.
+type executor struct {
+	global          chan struct{}
+	perClusterLimit int
+	clusters        sync.Map
+
+	timeout       time.Duration
+	maxOutputSize int
+	workDir       string
+	env           []string
+}
+
+func newExecutor(cfg *config.Risectl, risectlDir string) (*executor, error) {
+	timeout := defaultExecTimeout
+	if cfg.Timeout != "" {
+		var err error
+		timeout, err = utils.ParseDuration(cfg.Timeout)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse risectl timeout %s: %w", cfg.Timeout, err)
+		}
+	}
+
+	workDir := cfg.WorkDir
+	if workDir == "" {
+		workDir = filepath.Join(risectlDir, "work")
+	}
+	if err := os.MkdirAll(workDir, 0700); err != nil {
+		return nil, fmt.Errorf("failed to create risectl work dir %s: %w", workDir, err)
+	}
+
+	return &executor{
+		global:          make(chan struct{}, utils.IfElse(cfg.MaxConcurrency > 0, cfg.MaxConcurrency, defaultMaxConcurrency)),
+		perClusterLimit: utils.IfElse(cfg.MaxConcurrencyPerCluster > 0, cfg.MaxConcurrencyPerCluster, defaultMaxConcurrencyPerCluster),
+		timeout:         timeout,
+		maxOutputSize:   utils.IfElse(cfg.MaxOutputSize > 0, cfg.MaxOutputSize, defaultMaxOutputSize),
+		workDir:         workDir,
+		env:             buildEnv(cfg.PassEnv),
+	}, nil
+}
+
+// buildEnv returns the environment shared by the risectl processes, only the variables listed
+// in passEnv are inherited from the server. HOME, TMPDIR and RW_META_ADDR are set per process.
+func buildEnv(passEnv string) []string {
+	env := []string{
+		"PATH=" + defaultPath,
+		"LANG=C.UTF-8",
+	}
+	for _, name := range strings.Split(passEnv, ",") {
+		name = strings.TrimSpace(name)
+		switch name {
+		case "", "HOME", "TMPDIR", "RW_META_ADDR":
+			continue
+		}
+		if value, ok := os.LookupEnv(name); ok {
+			env = append(env, name+"="+value)
+		}
+	}
+	return env
+}
+
+// acquire waits for a free slot of the global and the cluster limit, the returned function
+// releases the slots.
+func (e *executor) acquire(ctx context.Context, endpoint string) (func(), error) {
+	v, _ := e.clusters.LoadOrStore(endpoint, make(chan struct{}, e.perClusterLimit))
+	cluster := v.(chan struct{})
+
+	select {
+	case e.global <- struct{}{}:
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+	select {
+	case cluster <- struct{}{}:
+	case <-ctx.Done():
+		<-e.global
+		return nil, ctx.Err()
+	}
+	return func() {
+		<-cluster
+		<-e.global
+	}, nil
+}
+
+// run runs risectl against the meta node at endpoint and returns its exit code. The default
+// timeout only applies if the context has no deadline, e.g. tasks have their own timeouts, it
+// bounds the wait for a free slot together with the process. The process runs in a temporary directory used as its HOME and TMPDIR, it is removed once the
+// process exits so concurrent commands do not share any state.
+func (e *executor) run(ctx context.Context, risectlPath string, endpoint string, stdout io.Writer, stderr io.Writer, args ...string) (int, error) {
+	command := commandLabel(args)
+
+	if _, ok := ctx.Deadline(); !ok && e.timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, e.timeout)
+		defer cancel()
+	}
+
+	waitStart := time.Now()
+	release, err := e.acquire(ctx, endpoint)
+	execWaitDuration.Observe(time.Since(waitStart).Seconds())
+	if err != nil {
+		execTotal.WithLabelValues(command, "rejected").Inc()
+		return -2, fmt.Errorf("failed to wait for a free risectl slot: %w", err)
+	}
+	defer release()
+
+	dir, err := os.MkdirTemp(e.workDir, "exec-")
+	if err != nil {
+		execTotal.WithLabelValues(command, "failed").Inc()
+		return -2, fmt.Errorf("failed to create risectl temporary dir in %s: %w", e.workDir, err)
+	}
+	defer os.RemoveAll(dir)
+
+	cmd := exec.CommandContext(ctx, risectlPath, args...)
+	cmd.Dir = dir
+	cmd.Env = append(append([]string{}, e.env...), "HOME="+dir, "TMPDIR="+dir, fmt.Sprintf("RW_META_ADDR=%s", endpoint))
+	cmd.Stdout = stdout
+	cmd.Stderr = stderr
+	cmd.WaitDelay = waitDelay
+	setProcessGroup(cmd)
+
+	execRunning.Inc()
+	start := time.Now()
+	err = cmd.Run()
+	execDuration.WithLabelValues(command).Observe(time.Since(start).Seconds())
+	execRunning.Dec()
+
+	exitCode := -2
+	if cmd.ProcessState != nil {
+		exitCode = cmd.ProcessState.ExitCode()
+	}
+
+	switch {
+	case errors.Is(ctx.Err(), context.DeadlineExceeded):
+		execTotal.WithLabelValues(command, "timeout").Inc()
+		return exitCode, fmt.Errorf("%w: %w", ErrExecTimeout, err)
+	case errors.Is(ctx.Err(), context.Canceled):
+		execTotal.WithLabelValues(command, "cancelled").Inc()
+	case err != nil:
+		execTotal.WithLabelValues(command, "failed").Inc()
+	default:
+		execTotal.WithLabelValues(command, "succeeded").Inc()
+	}
+	return exitCode, err
+}
+
+// commandLabel returns the leading sub commands, e.g. "meta cluster-info", to keep the
+// cardinality of the metrics low.
+func commandLabel(args []string) string {
+	var words []string
+	for _, arg := range args {
+		if strings.HasPrefix(arg, "-") || len(words) == 2 {
+			break
+		}
+		words = append(words, arg)
+	}
+	if len(words) == 0 {
+		return "unknown"
+	}
+	return strings.Join(words, " ")
+}
+
+// cappedBuffer keeps at most max bytes of the output and drops the rest. Writes never fail,
+// so the process is not blocked or killed by a large output.
+type cappedBuffer struct {
+	buf       bytes.Buffer
+	max       int
+	truncated bool
+}
+
+func newCappedBuffer(max int) *cappedBuffer {
+	return &cappedBuffer{max: max}
+}
+
+func (b *cappedBuffer) Write(p []byte) (int, error) {
+	if remaining := b.max - b.buf.Len(); len(p) > remaining {
+		if !b.truncated {
+			execOutputTruncated.Inc()
+		}
+		b.buf.Write(p[:max(remaining, 0)])
+		b.truncated = true
+		return len(p), nil
+	}
+	return b.buf.Write(p)
+}
+
+func (b *cappedBuffer) String() string {
+	if b.truncated {
+		return b.buf.String() + fmt.Sprintf("\n[output truncated at %d bytes]\n", b.max)
+	}
+	return b.buf.String()
+}
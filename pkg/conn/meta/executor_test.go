@@ -0,0 +1,124 @@
+//go:build unix
+
+package meta
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/risingwavelabs/risingwave-console/pkg/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func writeScript(t *testing.T, content string) string {
+	path := filepath.Join(t.TempDir(), risectlFileName)
+	require.NoError(t, os.WriteFile(path, []byte("#!/bin/sh\n"+content), 0755))
+	return path
+}
+
+func TestExecutorEnv(t *testing.T) {
+	t.Setenv("RCONSOLE_TEST_PASSED", "passed")
+	t.Setenv("RCONSOLE_TEST_SECRET", "secret")
+
+	e, err := newExecutor(&config.Risectl{PassEnv: "RCONSOLE_TEST_PASSED"}, t.TempDir())
+	require.NoError(t, err)
+
+	stdout := bytes.NewBuffer(nil)
+	exitCode, err := e.run(context.Background(), writeScript(t, "pwd\nenv\n"), "http://rw:5690", stdout, nil)
+	require.NoError(t, err)
+	require.Equal(t, 0, exitCode)
+
+	out := stdout.String()
+	dir, _, _ := strings.Cut(out, "\n")
+	assert.Equal(t, e.workDir, filepath.Dir(dir), "the process runs in a temporary dir of the work dir")
+	assert.Contains(t, out, "RW_META_ADDR=http://rw:5690\n")
+	assert.Contains(t, out, "HOME="+dir+"\n")
+	assert.Contains(t, out, "TMPDIR="+dir+"\n")
+	assert.Contains(t, out, "RCONSOLE_TEST_PASSED=passed\n")
+	assert.NotContains(t, out, "RCONSOLE_TEST_SECRET")
+	assert.NoDirExists(t, dir, "the temporary dir is removed")
+}
+
+func TestExecutorTimeout(t *testing.T) {
+	e, err := newExecutor(&config.Risectl{Timeout: "100ms"}, t.TempDir())
+	require.NoError(t, err)
+
+	start := time.Now()
+	_, err = e.run(context.Background(), writeScript(t, "sleep 10\n"), "http://rw:5690", nil, nil)
+	require.ErrorIs(t, err, ErrExecTimeout)
+	assert.Less(t, time.Since(start), 5*time.Second)
+
+	// the deadline of the caller replaces the default timeout
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	exitCode, err := e.run(ctx, writeScript(t, "sleep 0.3\n"), "http://rw:5690", nil, nil)
+	require.NoError(t, err)
+	assert.Equal(t, 0, exitCode)
+
+	// the default timeout bounds the wait for a free slot as well
+	release, err := e.acquire(context.Background(), "http://rw:5690")
+	require.NoError(t, err)
+	defer release()
+	for i := range cap(e.global) - 1 {
+		r, err := e.acquire(context.Background(), fmt.Sprintf("http://other-%d:5690", i))
+		require.NoError(t, err)
+		defer r()
+	}
+	start = time.Now()
+	_, err = e.run(context.Background(), writeScript(t, "true\n"), "http://rw:5690", nil, nil)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.Less(t, time.Since(start), 5*time.Second)
+}
+
+func TestExecutorConcurrencyLimit(t *testing.T) {
+	e, err := newExecutor(&config.Risectl{MaxConcurrency: 2, MaxConcurrencyPerCluster: 1}, t.TempDir())
+	require.NoError(t, err)
+
+	release, err := e.acquire(context.Background(), "http://a:5690")
+	require.NoError(t, err)
+
+	// the cluster limit is reached
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	_, err = e.acquire(ctx, "http://a:5690")
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+
+	// other clusters are not blocked until the global limit is reached
+	releaseB, err := e.acquire(context.Background(), "http://b:5690")
+	require.NoError(t, err)
+	ctx, cancel = context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	_, err = e.acquire(ctx, "http://c:5690")
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+
+	release()
+	releaseB()
+	release, err = e.acquire(context.Background(), "http://a:5690")
+	require.NoError(t, err)
+	release()
+}
+
+func TestCappedBuffer(t *testing.T) {
+	b := newCappedBuffer(5)
+	n, err := b.Write([]byte("abc"))
+	require.NoError(t, err)
+	require.Equal(t, 3, n)
+	n, err = b.Write([]byte("defg"))
+	require.NoError(t, err)
+	require.Equal(t, 4, n)
+	assert.Equal(t, "abcde\n[output truncated at 5 bytes]\n", b.String())
+}
+
+func TestCommandLabel(t *testing.T) {
+	assert.Equal(t, "meta cluster-info", commandLabel([]string{"meta", "cluster-info"}))
+	assert.Equal(t, "hummock list-version", commandLabel([]string{"hummock", "list-version", "extra", "--verbose"}))
+	assert.Equal(t, "meta", commandLabel([]string{"meta", "--help"}))
+	assert.Equal(t, "unknown", commandLabel([]string{"--help"}))
+}
@@ -1,34 +1,57 @@
 package meta
 
 import (
-	"archive/tar"
-	"compress/gzip"
 	"context"
 	"errors"
 	"fmt"
-	"io"
 	"log"
-	"net/http"
 	"os"
 	"path/filepath"
-	"strings"
 	"sync"
 	"time"
 
-	"github.com/google/go-github/v68/github"
 	"github.com/risingwavelabs/risingwave-console/pkg/config"
 )
 
-const risectlFileName = "risectl"
+const (
+	risectlFileName = "risectl"
+
+	// releasesTTL is how long the released versions are cached
+	releasesTTL = 5 * time.Minute
+
+	// releasesFailureTTL is how long a failure to list the released versions is cached, so an
+	// unreachable source does not slow down every connection
+	releasesFailureTTL = 30 * time.Second
+
+	// listReleasesTimeout bounds the listing of the released versions
+	listReleasesTimeout = 15 * time.Second
+)
+
+var (
+	ErrNoInternet          = errors.New("internet access is disabled")
+	ErrRisectlNotInstalled = errors.New("risectl is not installed")
+)
 
 // RisectlManager is a manager for risectl.
 type RisectlManager struct {
-	risectlDir string
-	noInternet bool
+	risectlDir   string
+	noInternet   bool
+	skipChecksum bool
+
+	// source is where risectl is downloaded from, it is nil in NoInternet mode
+	source artifactSource
+
+	// versionLocks serializes the downloads of each version
+	versionLocks sync.Map
+
+	// executor runs the risectl processes of all connections
+	executor *executor
 
-	cache []string
-	mu    sync.RWMutex
-	exp   time.Time
+	// the released versions, or the error listing them, cached until exp
+	mu       sync.Mutex
+	cache    []string
+	cacheErr error
+	exp      time.Time
 }
 
 func NewRisectlManager(cfg *config.Config) (RisectlManagerInterface, error) {
@@ -49,24 +72,68 @@ func NewRisectlManager(cfg *config.Config) (RisectlManagerInterface, error) {
 		}
 	}
 
+	var source artifactSource
+	if !cfg.NoInternet {
+		source, err = newArtifactSource(&cfg.Risectl)
+		if err != nil {
+			return nil, fmt.Errorf("failed to init risectl artifact source: %w", err)
+		}
+	}
+
+	executor, err := newExecutor(&cfg.Risectl, risectlDir)
+	if err != nil {
+		return nil, err
+	}
+
 	return &RisectlManager{
-		risectlDir: risectlDir,
-		noInternet: cfg.NoInternet,
+		risectlDir:   risectlDir,
+		noInternet:   cfg.NoInternet,
+		skipChecksum: cfg.Risectl.SkipChecksum,
+		source:       source,
+		executor:     executor,
 	}, nil
 }
 
-func (m *RisectlManager) NewConn(ctx context.Context, version string, host string, port int32) (RisectlConn, error) {
+// getSource returns the artifact source, it defaults to the public GitHub releases.
+func (m *RisectlManager) getSource() (artifactSource, error) {
+	if m.noInternet {
+		return nil, ErrNoInternet
+	}
+	if m.source == nil {
+		return newArtifactSource(&config.Risectl{})
+	}
+	return m.source, nil
+}
+
+func (m *RisectlManager) NewConn(ctx context.Context, version string, host string, port int32, opts ...ConnOption) (RisectlConn, error) {
 	if version == "" {
 		return nil, fmt.Errorf("version is required")
 	}
 
-	path := filepath.Join(m.risectlDir, version, risectlFileName)
+	resolution, err := m.ResolveVersion(ctx, version, opts...)
+	if err != nil {
+		return nil, err
+	}
+	switch resolution.Reason {
+	case ResolutionNearest, ResolutionOverride:
+		log.Default().Printf("Using risectl %s for version %s (%s)", resolution.Resolved, version, resolution.Reason)
+	case ResolutionUnresolved:
+		log.Default().Printf("No compatible risectl found for version %s, using it as it is", version)
+	}
+
+	path := filepath.Join(m.risectlDir, resolution.Resolved, risectlFileName)
 
-	_, err := os.Stat(path)
+	unlock := m.lockVersion(resolution.Resolved)
+	defer unlock()
+
+	_, err = os.Stat(path)
 	if err != nil {
 		if errors.Is(err, os.ErrNotExist) {
-			log.Default().Printf("Downloading risectl binary for version %s", version)
-			if err := m.downloadRisectl(ctx, version); err != nil {
+			if m.noInternet {
+				return nil, fmt.Errorf("%w: version %s, upload the risectl archive first", ErrRisectlNotInstalled, resolution.Resolved)
+			}
+			log.Default().Printf("Downloading risectl binary for version %s", resolution.Resolved)
+			if err := m.downloadRisectl(ctx, resolution.Resolved); err != nil {
 				return nil, fmt.Errorf("failed to download risectl binary %s: %w", path, err)
 			}
 		} else {
@@ -75,7 +142,9 @@ func (m *RisectlManager) NewConn(ctx context.Context, version string, host strin
 	}
 
 	return &RisectlConnection{
-		version:     version,
+		version:     resolution.Resolved,
+		resolution:  resolution,
+		executor:    m.executor,
 		risectlPath: path,
 		endpoint:    fmt.Sprintf("http://%s:%d", host, port),
 	}, nil
@@ -97,113 +166,41 @@ func (m *RisectlManager) listVersionsNoInternet() ([]string, error) {
 
 	versions := make([]string, 0, len(dirs))
 	for _, dir := range dirs {
-		if dir.IsDir() {
-			versions = append(versions, dir.Name())
+		if !dir.IsDir() {
+			continue
 		}
+		// skip the versions of which the upload failed
+		if _, err := os.Stat(filepath.Join(m.risectlDir, dir.Name(), risectlFileName)); err != nil {
+			continue
+		}
+		versions = append(versions, dir.Name())
 	}
 
 	return versions, nil
 }
 
 func (m *RisectlManager) listVersions(ctx context.Context) ([]string, error) {
-	if time.Now().Before(m.exp) {
-		m.mu.RLock()
-		defer m.mu.RUnlock()
-		return m.cache, nil
-	}
-	client := github.NewClient(nil)
-
-	releases, _, err := client.Repositories.ListReleases(ctx, "risingwavelabs", "risingwave", nil)
-	if err != nil {
-		return nil, err
-	}
-
-	versions := make([]string, 0, len(releases))
-	for _, release := range releases {
-		if release.TagName != nil {
-			versions = append(versions, *release.TagName)
-		}
-	}
-
 	m.mu.Lock()
 	defer m.mu.Unlock()
-	m.cache = versions
-	m.exp = time.Now().Add(5 * time.Minute)
-
-	return versions, nil
-}
-
-func (m *RisectlManager) downloadRisectl(ctx context.Context, version string) error {
-	client := github.NewClient(nil)
-
-	// Get the release by tag
-	release, _, err := client.Repositories.GetReleaseByTag(ctx, "risingwavelabs", "risingwave", version)
-	if err != nil {
-		return fmt.Errorf("failed to get release %s: %w", version, err)
-	}
-
-	// Find the risectl asset for x86_64
-	var assetID int64
-	var assetName string
-	for _, asset := range release.Assets {
-		if asset.GetName() != "" && strings.HasPrefix(asset.GetName(), "risectl") && strings.Contains(asset.GetName(), "x86_64") {
-			assetID = asset.GetID()
-			assetName = asset.GetName()
-			break
-		}
-	}
-	if assetID == 0 {
-		return fmt.Errorf("no risectl x86_64 asset found for version %s", version)
-	}
-
-	// Create version directory if it doesn't exist
-	versionDir := filepath.Join(m.risectlDir, version)
-	if err := os.MkdirAll(versionDir, 0755); err != nil {
-		return fmt.Errorf("failed to create directory %s: %w", versionDir, err)
+	if time.Now().Before(m.exp) {
+		return m.cache, m.cacheErr
 	}
 
-	// Download the asset
-	rc, _, err := client.Repositories.DownloadReleaseAsset(ctx, "risingwavelabs", "risingwave", assetID, http.DefaultClient)
+	source, err := m.getSource()
 	if err != nil {
-		return fmt.Errorf("failed to download asset %s: %w", assetName, err)
+		return nil, err
 	}
-	defer rc.Close()
 
-	// Create a gzip reader
-	gzipReader, err := gzip.NewReader(rc)
+	ctx, cancel := context.WithTimeout(ctx, listReleasesTimeout)
+	defer cancel()
+	versions, err := source.ListVersions(ctx)
 	if err != nil {
-		return fmt.Errorf("failed to create gzip reader: %w", err)
+		m.cache, m.cacheErr = nil, err
+		m.exp = time.Now().Add(releasesFailureTTL)
+		return nil, err
 	}
-	defer gzipReader.Close()
-
-	// Create tar reader
-	tarReader := tar.NewReader(gzipReader)
-
-	// Find and extract the risectl binary
-	outPath := filepath.Join(versionDir, risectlFileName)
-	for {
-		header, err := tarReader.Next()
-		if err == io.EOF {
-			return fmt.Errorf("risectl binary not found in archive")
-		}
-		if err != nil {
-			return fmt.Errorf("failed to read tar archive: %w", err)
-		}
-
-		// Look for the risectl binary in the archive
-		if strings.HasSuffix(header.Name, "risectl") {
-			// Create the output file
-			out, err := os.OpenFile(outPath, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0755)
-			if err != nil {
-				return fmt.Errorf("failed to create file %s: %w", outPath, err)
-			}
-			defer out.Close()
 
-			// Copy the content
-			if _, err := io.Copy(out, tarReader); err != nil {
-				return fmt.Errorf("failed to save asset to %s: %w", outPath, err)
-			}
-			return nil
-		}
-	}
+	m.cache, m.cacheErr = versions, nil
+	m.exp = time.Now().Add(releasesTTL)
+	return versions, nil
 }
@@ -0,0 +1,45 @@
+package meta
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var execTotal = promauto.NewCounterVec(
+	prometheus.CounterOpts{
+		Name: "rconsole_risectl_exec_total",
+		Help: "The number of risectl processes by command and result, the result is one of succeeded, failed, timeout, cancelled and rejected",
+	},
+	[]string{"command", "result"},
+)
+
+var execDuration = promauto.NewHistogramVec(
+	prometheus.HistogramOpts{
+		Name:    "rconsole_risectl_exec_duration_seconds",
+		Help:    "The duration of risectl processes by command",
+		Buckets: prometheus.ExponentialBuckets(0.1, 2, 15),
+	},
+	[]string{"command"},
+)
+
+var execWaitDuration = promauto.NewHistogram(
+	prometheus.HistogramOpts{
+		Name:    "rconsole_risectl_exec_wait_seconds",
+		Help:    "The time risectl processes wait for a free slot of the concurrency limits",
+		Buckets: prometheus.ExponentialBuckets(0.01, 2, 15),
+	},
+)
+
+var execRunning = promauto.NewGauge(
+	prometheus.GaugeOpts{
+		Name: "rconsole_risectl_exec_running",
+		Help: "The number of running risectl processes",
+	},
+)
+
+var execOutputTruncated = promauto.NewCounter(
+	prometheus.CounterOpts{
+		Name: "rconsole_risectl_exec_output_truncated_total",
+		Help: "The number of risectl outputs truncated for exceeding the output size limit",
+	},
+)
@@ -11,6 +11,7 @@ package mock
 
 import (
 	context "context"
+	io "io"
 	reflect "reflect"
 
 	meta "github.com/risingwavelabs/risingwave-console/pkg/conn/meta"
@@ -41,6 +42,36 @@ func (m *MockRisectlConn) EXPECT() *MockRisectlConnMockRecorder {
 	return m.recorder
 }
 
+// ClusterInfo mocks base method.
+func (m *MockRisectlConn) ClusterInfo(ctx context.Context) (*meta.ClusterInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClusterInfo", ctx)
+	ret0, _ := ret[0].(*meta.ClusterInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ClusterInfo indicates an expected call of ClusterInfo.
+func (mr *MockRisectlConnMockRecorder) ClusterInfo(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClusterInfo", reflect.TypeOf((*MockRisectlConn)(nil).ClusterInfo), ctx)
+}
+
+// CompactionStatus mocks base method.
+func (m *MockRisectlConn) CompactionStatus(ctx context.Context) (*meta.CompactionStatus, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CompactionStatus", ctx)
+	ret0, _ := ret[0].(*meta.CompactionStatus)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CompactionStatus indicates an expected call of CompactionStatus.
+func (mr *MockRisectlConnMockRecorder) CompactionStatus(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CompactionStatus", reflect.TypeOf((*MockRisectlConn)(nil).CompactionStatus), ctx)
+}
+
 // DeleteSnapshot mocks base method.
 func (m *MockRisectlConn) DeleteSnapshot(ctx context.Context, snapshotID int64) error {
 	m.ctrl.T.Helper()
@@ -55,6 +86,66 @@ func (mr *MockRisectlConnMockRecorder) DeleteSnapshot(ctx, snapshotID any) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSnapshot", reflect.TypeOf((*MockRisectlConn)(nil).DeleteSnapshot), ctx, snapshotID)
 }
 
+// HummockVersion mocks base method.
+func (m *MockRisectlConn) HummockVersion(ctx context.Context) (*meta.HummockVersion, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HummockVersion", ctx)
+	ret0, _ := ret[0].(*meta.HummockVersion)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// HummockVersion indicates an expected call of HummockVersion.
+func (mr *MockRisectlConnMockRecorder) HummockVersion(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HummockVersion", reflect.TypeOf((*MockRisectlConn)(nil).HummockVersion), ctx)
+}
+
+// ListActors mocks base method.
+func (m *MockRisectlConn) ListActors(ctx context.Context) ([]meta.Actor, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListActors", ctx)
+	ret0, _ := ret[0].([]meta.Actor)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListActors indicates an expected call of ListActors.
+func (mr *MockRisectlConnMockRecorder) ListActors(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListActors", reflect.TypeOf((*MockRisectlConn)(nil).ListActors), ctx)
+}
+
+// ListFragments mocks base method.
+func (m *MockRisectlConn) ListFragments(ctx context.Context) ([]meta.Fragment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListFragments", ctx)
+	ret0, _ := ret[0].([]meta.Fragment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListFragments indicates an expected call of ListFragments.
+func (mr *MockRisectlConnMockRecorder) ListFragments(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListFragments", reflect.TypeOf((*MockRisectlConn)(nil).ListFragments), ctx)
+}
+
+// ListMetaSnapshots mocks base method.
+func (m *MockRisectlConn) ListMetaSnapshots(ctx context.Context) ([]meta.MetaSnapshot, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListMetaSnapshots", ctx)
+	ret0, _ := ret[0].([]meta.MetaSnapshot)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListMetaSnapshots indicates an expected call of ListMetaSnapshots.
+func (mr *MockRisectlConnMockRecorder) ListMetaSnapshots(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListMetaSnapshots", reflect.TypeOf((*MockRisectlConn)(nil).ListMetaSnapshots), ctx)
+}
+
 // MetaBackup mocks base method.
 func (m *MockRisectlConn) MetaBackup(ctx context.Context) (int64, error) {
 	m.ctrl.T.Helper()
@@ -70,6 +161,20 @@ func (mr *MockRisectlConnMockRecorder) MetaBackup(ctx any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MetaBackup", reflect.TypeOf((*MockRisectlConn)(nil).MetaBackup), ctx)
 }
 
+// Resolution mocks base method.
+func (m *MockRisectlConn) Resolution() meta.VersionResolution {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Resolution")
+	ret0, _ := ret[0].(meta.VersionResolution)
+	return ret0
+}
+
+// Resolution indicates an expected call of Resolution.
+func (mr *MockRisectlConnMockRecorder) Resolution() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Resolution", reflect.TypeOf((*MockRisectlConn)(nil).Resolution))
+}
+
 // Run mocks base method.
 func (m *MockRisectlConn) Run(ctx context.Context, args ...string) (string, string, int, error) {
 	m.ctrl.T.Helper()
@@ -113,6 +218,26 @@ func (mr *MockRisectlConnMockRecorder) RunCombined(ctx any, args ...any) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RunCombined", reflect.TypeOf((*MockRisectlConn)(nil).RunCombined), varargs...)
 }
 
+// Stream mocks base method.
+func (m *MockRisectlConn) Stream(ctx context.Context, stdout, stderr io.Writer, args ...string) (int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, stdout, stderr}
+	for _, a := range args {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Stream", varargs...)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Stream indicates an expected call of Stream.
+func (mr *MockRisectlConnMockRecorder) Stream(ctx, stdout, stderr any, args ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, stdout, stderr}, args...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Stream", reflect.TypeOf((*MockRisectlConn)(nil).Stream), varargs...)
+}
+
 // MockRisectlManagerInterface is a mock of RisectlManagerInterface interface.
 type MockRisectlManagerInterface struct {
 	ctrl     *gomock.Controller
@@ -137,6 +262,20 @@ func (m *MockRisectlManagerInterface) EXPECT() *MockRisectlManagerInterfaceMockR
 	return m.recorder
 }
 
+// ImportRisectl mocks base method.
+func (m *MockRisectlManagerInterface) ImportRisectl(ctx context.Context, version string, archive io.Reader, checksum string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ImportRisectl", ctx, version, archive, checksum)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ImportRisectl indicates an expected call of ImportRisectl.
+func (mr *MockRisectlManagerInterfaceMockRecorder) ImportRisectl(ctx, version, archive, checksum any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportRisectl", reflect.TypeOf((*MockRisectlManagerInterface)(nil).ImportRisectl), ctx, version, archive, checksum)
+}
+
 // ListVersions mocks base method.
 func (m *MockRisectlManagerInterface) ListVersions(ctx context.Context) ([]string, error) {
 	m.ctrl.T.Helper()
@@ -153,16 +292,41 @@ func (mr *MockRisectlManagerInterfaceMockRecorder) ListVersions(ctx any) *gomock
 }
 
 // NewConn mocks base method.
-func (m *MockRisectlManagerInterface) NewConn(ctx context.Context, version, host string, metaPort int32) (meta.RisectlConn, error) {
+func (m *MockRisectlManagerInterface) NewConn(ctx context.Context, version, host string, metaPort int32, opts ...meta.ConnOption) (meta.RisectlConn, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "NewConn", ctx, version, host, metaPort)
+	varargs := []any{ctx, version, host, metaPort}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "NewConn", varargs...)
 	ret0, _ := ret[0].(meta.RisectlConn)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // NewConn indicates an expected call of NewConn.
-func (mr *MockRisectlManagerInterfaceMockRecorder) NewConn(ctx, version, host, metaPort any) *gomock.Call {
+func (mr *MockRisectlManagerInterfaceMockRecorder) NewConn(ctx, version, host, metaPort any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, version, host, metaPort}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewConn", reflect.TypeOf((*MockRisectlManagerInterface)(nil).NewConn), varargs...)
+}
+
+// ResolveVersion mocks base method.
+func (m *MockRisectlManagerInterface) ResolveVersion(ctx context.Context, version string, opts ...meta.ConnOption) (meta.VersionResolution, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, version}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ResolveVersion", varargs...)
+	ret0, _ := ret[0].(meta.VersionResolution)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResolveVersion indicates an expected call of ResolveVersion.
+func (mr *MockRisectlManagerInterfaceMockRecorder) ResolveVersion(ctx, version any, opts ...any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewConn", reflect.TypeOf((*MockRisectlManagerInterface)(nil).NewConn), ctx, version, host, metaPort)
+	varargs := append([]any{ctx, version}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResolveVersion", reflect.TypeOf((*MockRisectlManagerInterface)(nil).ResolveVersion), varargs...)
 }
@@ -0,0 +1,432 @@
+package meta
+
+import (
+	"cmp"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+var ErrOperationNotSupported = errors.New("risectl operation is not supported by this version")
+
+type OperationName string
+
+const (
+	OperationClusterInfo      OperationName = "cluster-info"
+	OperationListFragments    OperationName = "list-fragments"
+	OperationListActors       OperationName = "list-actors"
+	OperationHummockVersion   OperationName = "hummock-version"
+	OperationCompactionStatus OperationName = "compaction-status"
+)
+
+// Operation is a supported risectl operation, only the operations in the catalog
+// have typed wrappers and parsed output. The operations run on every version, their
+// parsers accept each output format the sub commands printed across versions.
+type Operation struct {
+	Name        OperationName
+	Description string
+
+	// Command is the risectl sub command, it is matched against the allowlists
+	Command []string
+
+	// ReadOnly operations do not change the state of the cluster
+	ReadOnly bool
+}
+
+var operations = []Operation{
+	{
+		Name:        OperationClusterInfo,
+		Description: "Workers, fragments and actors of the cluster",
+		Command:     []string{"meta", "cluster-info"},
+		ReadOnly:    true,
+	},
+	{
+		Name:        OperationListFragments,
+		Description: "Fragments and the actors on each worker",
+		Command:     []string{"meta", "cluster-info"},
+		ReadOnly:    true,
+	},
+	{
+		Name:        OperationListActors,
+		Description: "Actors and the fragments and workers they belong to",
+		Command:     []string{"meta", "cluster-info"},
+		ReadOnly:    true,
+	},
+	{
+		Name:        OperationHummockVersion,
+		Description: "Current hummock version of the storage",
+		Command:     []string{"hummock", "list-version"},
+		ReadOnly:    true,
+	},
+	{
+		Name:        OperationCompactionStatus,
+		Description: "Compaction status of each compaction group",
+		Command:     []string{"hummock", "list-compaction-status"},
+		ReadOnly:    true,
+	},
+}
+
+// Operations returns the catalog of supported risectl operations.
+func Operations() []Operation {
+	return slices.Clone(operations)
+}
+
+// GetOperation returns the operation in the catalog with the given name.
+func GetOperation(name OperationName) (Operation, bool) {
+	for _, op := range operations {
+		if op.Name == name {
+			return op, true
+		}
+	}
+	return Operation{}, false
+}
+
+// Table is a table printed by risectl.
+type Table struct {
+	Headers []string   `json:"headers"`
+	Rows    [][]string `json:"rows"`
+}
+
+type Fragment struct {
+	ID        int64  `json:"id"`
+	TableID   *int64 `json:"tableId,omitempty"`
+	TableName string `json:"tableName,omitempty"`
+	Type      string `json:"type,omitempty"`
+
+	// WorkerActors maps the worker column header to the actor IDs on the worker
+	WorkerActors map[string][]int64 `json:"workerActors"`
+}
+
+type Actor struct {
+	ID         int64  `json:"id"`
+	FragmentID int64  `json:"fragmentId"`
+	Worker     string `json:"worker"`
+}
+
+type ClusterInfo struct {
+	Revision  *int64     `json:"revision,omitempty"`
+	Tables    []Table    `json:"tables"`
+	Fragments []Fragment `json:"fragments"`
+}
+
+type HummockVersion struct {
+	ID                *int64            `json:"id,omitempty"`
+	MaxCommittedEpoch *int64            `json:"maxCommittedEpoch,omitempty"`
+	SafeEpoch         *int64            `json:"safeEpoch,omitempty"`
+	Fields            map[string]string `json:"fields"`
+}
+
+type CompactionGroupStatus struct {
+	ID     int64             `json:"id"`
+	Fields map[string]string `json:"fields"`
+}
+
+type CompactionStatus struct {
+	Groups []CompactionGroupStatus `json:"groups"`
+}
+
+// runOperation runs the operation and returns its stdout.
+func (c *RisectlConnection) runOperation(ctx context.Context, name OperationName) (string, error) {
+	op, ok := GetOperation(name)
+	if !ok {
+		return "", fmt.Errorf("unknown risectl operation %s", name)
+	}
+	stdout, stderr, ec, err := c.Run(ctx, op.Command...)
+	if err != nil {
+		return "", fmt.Errorf("failed to run %s: %w, stderr: %s, exit code: %d", name, err, stderr, ec)
+	}
+	return stdout, nil
+}
+
+func (c *RisectlConnection) ClusterInfo(ctx context.Context) (*ClusterInfo, error) {
+	out, err := c.runOperation(ctx, OperationClusterInfo)
+	if err != nil {
+		return nil, err
+	}
+	return parseClusterInfo(out), nil
+}
+
+func (c *RisectlConnection) ListFragments(ctx context.Context) ([]Fragment, error) {
+	out, err := c.runOperation(ctx, OperationListFragments)
+	if err != nil {
+		return nil, err
+	}
+	return parseClusterInfo(out).Fragments, nil
+}
+
+func (c *RisectlConnection) ListActors(ctx context.Context) ([]Actor, error) {
+	out, err := c.runOperation(ctx, OperationListActors)
+	if err != nil {
+		return nil, err
+	}
+	return fragmentActors(parseClusterInfo(out).Fragments), nil
+}
+
+func (c *RisectlConnection) HummockVersion(ctx context.Context) (*HummockVersion, error) {
+	out, err := c.runOperation(ctx, OperationHummockVersion)
+	if err != nil {
+		return nil, err
+	}
+	return parseHummockVersion(out), nil
+}
+
+func (c *RisectlConnection) CompactionStatus(ctx context.Context) (*CompactionStatus, error) {
+	out, err := c.runOperation(ctx, OperationCompactionStatus)
+	if err != nil {
+		return nil, err
+	}
+	return parseCompactionStatus(out), nil
+}
+
+// sample: Revision: 42
+var regexRevision = regexp.MustCompile(`(?i)revision:\s*(\d+)`)
+
+var regexInt = regexp.MustCompile(`\d+`)
+
+func parseClusterInfo(out string) *ClusterInfo {
+	info := &ClusterInfo{
+		Tables:    parseTables(out),
+		Fragments: []Fragment{},
+	}
+	if matches := regexRevision.FindStringSubmatch(out); len(matches) == 2 {
+		if revision, err := strconv.ParseInt(matches[1], 10, 64); err == nil {
+			info.Revision = &revision
+		}
+	}
+
+	for _, table := range info.Tables {
+		fragmentCol := headerIndex(table.Headers, "fragmentid", "fragment")
+		if fragmentCol == -1 {
+			continue
+		}
+		tableIDCol := headerIndex(table.Headers, "tableid")
+		tableNameCol := headerIndex(table.Headers, "tablename", "table")
+		typeCol := headerIndex(table.Headers, "type", "fragmenttype")
+		for _, row := range table.Rows {
+			id, ok := firstInt(cell(row, fragmentCol))
+			if !ok {
+				continue
+			}
+			fragment := Fragment{
+				ID:           id,
+				TableName:    cell(row, tableNameCol),
+				Type:         cell(row, typeCol),
+				WorkerActors: map[string][]int64{},
+			}
+			if tableID, ok := firstInt(cell(row, tableIDCol)); ok {
+				fragment.TableID = &tableID
+			}
+			// the remaining columns are the workers, each cell lists the actor IDs on the worker
+			for i, header := range table.Headers {
+				if !strings.HasPrefix(normalizeHeader(header), "worker") {
+					continue
+				}
+				actors := []int64{}
+				for _, s := range regexInt.FindAllString(cell(row, i), -1) {
+					if actorID, err := strconv.ParseInt(s, 10, 64); err == nil {
+						actors = append(actors, actorID)
+					}
+				}
+				fragment.WorkerActors[header] = actors
+			}
+			info.Fragments = append(info.Fragments, fragment)
+		}
+	}
+	return info
+}
+
+func fragmentActors(fragments []Fragment) []Actor {
+	actors := []Actor{}
+	for _, fragment := range fragments {
+		for worker, ids := range fragment.WorkerActors {
+			for _, id := range ids {
+				actors = append(actors, Actor{ID: id, FragmentID: fragment.ID, Worker: worker})
+			}
+		}
+	}
+	slices.SortFunc(actors, func(a, b Actor) int {
+		return cmp.Compare(a.ID, b.ID)
+	})
+	return actors
+}
+
+func parseHummockVersion(out string) *HummockVersion {
+	fields := parseFields(out)
+	version := &HummockVersion{Fields: fields}
+	// older versions print "Version 12", newer ones print "id: 12"
+	for _, key := range []string{"id", "version", "version id"} {
+		if id, ok := firstInt(fields[key]); ok {
+			version.ID = &id
+			break
+		}
+	}
+	if epoch, ok := firstInt(fields["max_committed_epoch"]); ok {
+		version.MaxCommittedEpoch = &epoch
+	}
+	if epoch, ok := firstInt(fields["safe_epoch"]); ok {
+		version.SafeEpoch = &epoch
+	}
+	return version
+}
+
+// sample: compaction group 2 / CompactionGroup 2 / compaction_group_id: 2
+var regexCompactionGroup = regexp.MustCompile(`(?i)^compaction[ _]?group(?:[ _]?id)?[:\s]+(\d+)`)
+
+func parseCompactionStatus(out string) *CompactionStatus {
+	status := &CompactionStatus{Groups: []CompactionGroupStatus{}}
+
+	// newer versions may print JSON
+	var groups []map[string]any
+	if err := json.Unmarshal([]byte(strings.TrimSpace(out)), &groups); err == nil {
+		for _, g := range groups {
+			group := CompactionGroupStatus{Fields: map[string]string{}}
+			for k, v := range g {
+				raw, _ := json.Marshal(v)
+				group.Fields[k] = strings.Trim(string(raw), `"`)
+			}
+			for _, key := range []string{"compaction_group_id", "id"} {
+				if id, ok := firstInt(group.Fields[key]); ok {
+					group.ID = id
+					break
+				}
+			}
+			status.Groups = append(status.Groups, group)
+		}
+		return status
+	}
+
+	var current *CompactionGroupStatus
+	for _, line := range strings.Split(out, "\n") {
+		line = strings.TrimSpace(line)
+		if matches := regexCompactionGroup.FindStringSubmatch(line); len(matches) == 2 {
+			id, _ := strconv.ParseInt(matches[1], 10, 64)
+			status.Groups = append(status.Groups, CompactionGroupStatus{ID: id, Fields: map[string]string{}})
+			current = &status.Groups[len(status.Groups)-1]
+			continue
+		}
+		if current == nil {
+			continue
+		}
+		if key, value, ok := parseField(line); ok {
+			current.Fields[key] = value
+		}
+	}
+	return status
+}
+
+// parseFields parses the "key: value" and "Key value" lines of the output, keys are lower cased.
+func parseFields(out string) map[string]string {
+	fields := map[string]string{}
+	for _, line := range strings.Split(out, "\n") {
+		if key, value, ok := parseField(strings.TrimSpace(line)); ok {
+			if _, exists := fields[key]; !exists {
+				fields[key] = value
+			}
+		}
+	}
+	return fields
+}
+
+func parseField(line string) (string, string, bool) {
+	if line == "" {
+		return "", "", false
+	}
+	if key, value, ok := strings.Cut(line, ":"); ok {
+		key = strings.ToLower(strings.TrimSpace(key))
+		if key != "" {
+			return key, strings.Trim(strings.TrimSpace(value), ","), true
+		}
+	}
+	// e.g. "Version 12", "max_committed_epoch 123"
+	if i := strings.LastIndex(line, " "); i > 0 {
+		value := strings.TrimSpace(line[i+1:])
+		if _, err := strconv.ParseInt(value, 10, 64); err == nil {
+			return strings.ToLower(strings.TrimSpace(line[:i])), value, true
+		}
+	}
+	return "", "", false
+}
+
+// parseTables parses the tables printed in ASCII (+-|) or UTF-8 box drawing style.
+// The first row of each table is the header.
+func parseTables(out string) []Table {
+	tables := []Table{}
+	var current *Table
+	for _, line := range strings.Split(out, "\n") {
+		line = strings.TrimSpace(line)
+		switch {
+		case isTableBorder(line):
+			continue
+		case strings.HasPrefix(line, "|") || strings.HasPrefix(line, "│") || strings.HasPrefix(line, "┃"):
+			cells := splitTableRow(line)
+			if current == nil {
+				tables = append(tables, Table{Headers: cells, Rows: [][]string{}})
+				current = &tables[len(tables)-1]
+			} else {
+				current.Rows = append(current.Rows, cells)
+			}
+		default:
+			current = nil
+		}
+	}
+	return tables
+}
+
+func isTableBorder(line string) bool {
+	if line == "" {
+		return false
+	}
+	for _, r := range line {
+		if !strings.ContainsRune("+-=|:┌┐└┘├┤┬┴┼─═╞╡╪┏┓┗┛┣┫┳┻╋━╭╮╰╯ ", r) {
+			return false
+		}
+	}
+	return strings.ContainsAny(line, "-=─═━")
+}
+
+func splitTableRow(line string) []string {
+	line = strings.NewReplacer("│", "|", "┃", "|").Replace(line)
+	line = strings.TrimPrefix(strings.TrimSuffix(line, "|"), "|")
+	cells := strings.Split(line, "|")
+	for i := range cells {
+		cells[i] = strings.TrimSpace(cells[i])
+	}
+	return cells
+}
+
+func normalizeHeader(header string) string {
+	return strings.NewReplacer(" ", "", "_", "", "-", "").Replace(strings.ToLower(header))
+}
+
+// headerIndex returns the index of the first header matching any of the names, -1 if none.
+func headerIndex(headers []string, names ...string) int {
+	for _, name := range names {
+		for i, header := range headers {
+			if normalizeHeader(header) == name {
+				return i
+			}
+		}
+	}
+	return -1
+}
+
+func cell(row []string, i int) string {
+	if i < 0 || i >= len(row) {
+		return ""
+	}
+	return row[i]
+}
+
+func firstInt(s string) (int64, bool) {
+	match := regexInt.FindString(s)
+	if match == "" {
+		return 0, false
+	}
+	v, err := strconv.ParseInt(match, 10, 64)
+	return v, err == nil
+}
@@ -0,0 +1,109 @@
+package meta
+
+import (
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/risingwavelabs/risingwave-console/pkg/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseClusterInfo(t *testing.T) {
+	out := `Revision: 42
+
++-------------+-----------+----------------+----------------+
+| Fragment Id | Table Id  | Worker 1       | Worker 2       |
++=============+===========+================+================+
+| 1           | 1001 (t1) | 1, 2           | 3              |
+| 2           | 1002 (mv) |                | 4, 5           |
++-------------+-----------+----------------+----------------+
+`
+	info := parseClusterInfo(out)
+	require.NotNil(t, info.Revision)
+	assert.Equal(t, int64(42), *info.Revision)
+	require.Len(t, info.Tables, 1)
+	assert.Equal(t, []string{"Fragment Id", "Table Id", "Worker 1", "Worker 2"}, info.Tables[0].Headers)
+	assert.Len(t, info.Tables[0].Rows, 2)
+
+	assert.Equal(t, []Fragment{
+		{ID: 1, TableID: utils.Ptr(int64(1001)), WorkerActors: map[string][]int64{"Worker 1": {1, 2}, "Worker 2": {3}}},
+		{ID: 2, TableID: utils.Ptr(int64(1002)), WorkerActors: map[string][]int64{"Worker 1": {}, "Worker 2": {4, 5}}},
+	}, info.Fragments)
+
+	assert.Equal(t, []Actor{
+		{ID: 1, FragmentID: 1, Worker: "Worker 1"},
+		{ID: 2, FragmentID: 1, Worker: "Worker 1"},
+		{ID: 3, FragmentID: 1, Worker: "Worker 2"},
+		{ID: 4, FragmentID: 2, Worker: "Worker 2"},
+		{ID: 5, FragmentID: 2, Worker: "Worker 2"},
+	}, fragmentActors(info.Fragments))
+}
+
+func TestParseTablesUTF8(t *testing.T) {
+	out := `┌────┬──────┐
+│ id │ host │
+╞════╪══════╡
+│ 1  │ cn-0 │
+└────┴──────┘`
+	assert.Equal(t, []Table{{Headers: []string{"id", "host"}, Rows: [][]string{{"1", "cn-0"}}}}, parseTables(out))
+}
+
+func TestParseHummockVersion(t *testing.T) {
+	testCases := []struct {
+		name string
+		out  string
+	}{
+		{name: "plain", out: "Version 12\nmax_committed_epoch 7000\nsafe_epoch 6000\n"},
+		{name: "key value", out: "id: 12,\nmax_committed_epoch: 7000,\nsafe_epoch: 6000,\n"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			v := parseHummockVersion(tc.out)
+			assert.Equal(t, utils.Ptr(int64(12)), v.ID)
+			assert.Equal(t, utils.Ptr(int64(7000)), v.MaxCommittedEpoch)
+			assert.Equal(t, utils.Ptr(int64(6000)), v.SafeEpoch)
+		})
+	}
+}
+
+func TestParseCompactionStatus(t *testing.T) {
+	text := parseCompactionStatus("compaction group 2\n  level_handlers: 3\ncompaction group 3\n  pending_tasks: 1\n")
+	assert.Equal(t, []CompactionGroupStatus{
+		{ID: 2, Fields: map[string]string{"level_handlers": "3"}},
+		{ID: 3, Fields: map[string]string{"pending_tasks": "1"}},
+	}, text.Groups)
+
+	js := parseCompactionStatus(`[{"compaction_group_id": 2, "pending_tasks": 1}]`)
+	assert.Equal(t, []CompactionGroupStatus{
+		{ID: 2, Fields: map[string]string{"compaction_group_id": "2", "pending_tasks": "1"}},
+	}, js.Groups)
+}
+
+func TestParseMetaSnapshots(t *testing.T) {
+	epoch := int64(1000) << 16
+	out := `+----+--------------------+
+| Id | Max Committed Epoch |
++====+====================+
+| 1  | ` + strconv.FormatInt(epoch, 10) + ` |
+| 2  |                    |
++----+--------------------+
+`
+	snapshots, err := parseMetaSnapshots(out)
+	require.NoError(t, err)
+	require.Len(t, snapshots, 2)
+	assert.Equal(t, int64(1), snapshots[0].ID)
+	require.NotNil(t, snapshots[0].CreatedAt)
+	assert.Equal(t, time.Date(2021, 1, 1, 0, 0, 1, 0, time.UTC), *snapshots[0].CreatedAt)
+	assert.Equal(t, int64(2), snapshots[1].ID)
+	assert.Nil(t, snapshots[1].CreatedAt)
+
+	// the output without the table is not a cluster without snapshots
+	_, err = parseMetaSnapshots("")
+	require.Error(t, err)
+
+	snapshots, err = parseMetaSnapshots("+----+\n| Id |\n+----+\n")
+	require.NoError(t, err)
+	assert.Empty(t, snapshots)
+}
@@ -0,0 +1,8 @@
+//go:build !unix
+
+package meta
+
+import "os/exec"
+
+// setProcessGroup is a no-op, only the command itself is killed when the context is done.
+func setProcessGroup(cmd *exec.Cmd) {}
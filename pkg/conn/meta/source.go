@@ -0,0 +1,285 @@
+package meta
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/google/go-github/v68/github"
+	"github.com/risingwavelabs/risingwave-console/pkg/config"
+)
+
+const (
+	defaultRepository = "risingwavelabs/risingwave"
+
+	// mirrorIndexFile is the index at the root of an HTTP mirror
+	mirrorIndexFile = "index.json"
+
+	// responseHeaderTimeout bounds the wait for the response of a source, the body of a download
+	// may take longer
+	responseHeaderTimeout = 30 * time.Second
+
+	// releasesPerPage is the page size of the GitHub releases, the maximum GitHub allows
+	releasesPerPage = 100
+)
+
+// releaseAsset is a file of a release in an artifact source.
+type releaseAsset struct {
+	ID   int64
+	Name string
+	URL  string
+
+	// SHA256 is the hex encoded checksum of the asset if the source provides it
+	SHA256 string
+}
+
+// artifactSource is where the risectl releases are downloaded from.
+type artifactSource interface {
+	// ListVersions lists the released versions
+	ListVersions(ctx context.Context) ([]string, error)
+
+	// ListAssets lists the files of the release of the version
+	ListAssets(ctx context.Context, version string) ([]releaseAsset, error)
+
+	// Download downloads an asset listed by ListAssets
+	Download(ctx context.Context, asset releaseAsset) (io.ReadCloser, error)
+}
+
+// newArtifactSource returns the HTTP mirror if it is configured, otherwise GitHub or GitHub Enterprise.
+func newArtifactSource(cfg *config.Risectl) (artifactSource, error) {
+	httpClient, err := newHTTPClient(cfg.Proxy)
+	if err != nil {
+		return nil, err
+	}
+
+	if cfg.MirrorURL != "" {
+		return &mirrorSource{
+			baseURL:    strings.TrimSuffix(cfg.MirrorURL, "/") + "/",
+			token:      cfg.Token,
+			httpClient: httpClient,
+		}, nil
+	}
+
+	repository := defaultRepository
+	if cfg.Repository != "" {
+		repository = cfg.Repository
+	}
+	owner, repo, ok := strings.Cut(repository, "/")
+	if !ok || owner == "" || repo == "" {
+		return nil, fmt.Errorf("invalid risectl repository %s, expected <owner>/<repo>", repository)
+	}
+
+	client := github.NewClient(httpClient)
+	if cfg.Token != "" {
+		client = client.WithAuthToken(cfg.Token)
+	}
+	if cfg.GitHubURL != "" {
+		client, err = client.WithEnterpriseURLs(cfg.GitHubURL, cfg.GitHubURL)
+		if err != nil {
+			return nil, fmt.Errorf("failed to use GitHub Enterprise URL %s: %w", cfg.GitHubURL, err)
+		}
+	}
+	return &githubSource{
+		client:     client,
+		owner:      owner,
+		repo:       repo,
+		httpClient: httpClient,
+	}, nil
+}
+
+// newHTTPClient returns a client using the proxy, or the proxy of the environment if it is empty.
+func newHTTPClient(proxy string) (*http.Client, error) {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.ResponseHeaderTimeout = responseHeaderTimeout
+	if proxy != "" {
+		proxyURL, err := url.Parse(proxy)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse proxy %s: %w", proxy, err)
+		}
+		transport.Proxy = http.ProxyURL(proxyURL)
+	}
+	return &http.Client{Transport: transport}, nil
+}
+
+type githubSource struct {
+	client     *github.Client
+	owner      string
+	repo       string
+	httpClient *http.Client
+}
+
+func (s *githubSource) ListVersions(ctx context.Context) ([]string, error) {
+	var versions []string
+	opts := &github.ListOptions{PerPage: releasesPerPage}
+	for {
+		releases, resp, err := s.client.Repositories.ListReleases(ctx, s.owner, s.repo, opts)
+		if err != nil {
+			return nil, err
+		}
+		for _, release := range releases {
+			if release.TagName != nil {
+				versions = append(versions, *release.TagName)
+			}
+		}
+		if resp.NextPage == 0 {
+			return versions, nil
+		}
+		opts.Page = resp.NextPage
+	}
+}
+
+// githubReleaseAsset is an asset of a GitHub release. The client library does not expose the
+// digest GitHub computes for the assets, so the release is decoded into it.
+type githubReleaseAsset struct {
+	ID                 int64  `json:"id"`
+	Name               string `json:"name"`
+	BrowserDownloadURL string `json:"browser_download_url"`
+
+	// Digest is "sha256:<hex>", it is empty for the assets uploaded before GitHub computed them
+	Digest string `json:"digest"`
+}
+
+func (s *githubSource) ListAssets(ctx context.Context, version string) ([]releaseAsset, error) {
+	req, err := s.client.NewRequest(http.MethodGet, fmt.Sprintf("repos/%s/%s/releases/tags/%s", s.owner, s.repo, url.PathEscape(version)), nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get release %s: %w", version, err)
+	}
+	var release struct {
+		Assets []githubReleaseAsset `json:"assets"`
+	}
+	if _, err := s.client.Do(ctx, req, &release); err != nil {
+		return nil, fmt.Errorf("failed to get release %s: %w", version, err)
+	}
+
+	assets := make([]releaseAsset, 0, len(release.Assets))
+	for _, asset := range release.Assets {
+		sha, ok := strings.CutPrefix(asset.Digest, "sha256:")
+		if !ok {
+			sha = ""
+		}
+		assets = append(assets, releaseAsset{
+			ID:     asset.ID,
+			Name:   asset.Name,
+			URL:    asset.BrowserDownloadURL,
+			SHA256: strings.ToLower(sha),
+		})
+	}
+	return assets, nil
+}
+
+func (s *githubSource) Download(ctx context.Context, asset releaseAsset) (io.ReadCloser, error) {
+	rc, _, err := s.client.Repositories.DownloadReleaseAsset(ctx, s.owner, s.repo, asset.ID, s.httpClient)
+	if err != nil {
+		return nil, fmt.Errorf("failed to download asset %s: %w", asset.Name, err)
+	}
+	return rc, nil
+}
+
+// mirrorIndex is the index.json of an HTTP mirror, e.g.
+//
+//	{"versions": [{"version": "v2.2.1", "assets": [{"name": "risectl-v2.2.1-x86_64-unknown-linux.tar.gz", "url": "v2.2.1/risectl-v2.2.1-x86_64-unknown-linux.tar.gz", "sha256": "..."}]}]}
+//
+// Relative asset URLs are resolved against the mirror URL.
+type mirrorIndex struct {
+	Versions []struct {
+		Version string `json:"version"`
+		Assets  []struct {
+			Name   string `json:"name"`
+			URL    string `json:"url"`
+			SHA256 string `json:"sha256"`
+		} `json:"assets"`
+	} `json:"versions"`
+}
+
+type mirrorSource struct {
+	baseURL    string
+	token      string
+	httpClient *http.Client
+}
+
+func (s *mirrorSource) get(ctx context.Context, rawURL string) (io.ReadCloser, error) {
+	u, err := url.Parse(s.baseURL)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse mirror url %s: %w", s.baseURL, err)
+	}
+	ref, err := url.Parse(rawURL)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse url %s: %w", rawURL, err)
+	}
+	target := u.ResolveReference(ref)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, target.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	// only send the token to the mirror itself
+	if s.token != "" && target.Host == u.Host {
+		req.Header.Set("Authorization", "Bearer "+s.token)
+	}
+	resp, err := s.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get %s: %w", target, err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		resp.Body.Close()
+		return nil, fmt.Errorf("failed to get %s: status %d", target, resp.StatusCode)
+	}
+	return resp.Body, nil
+}
+
+func (s *mirrorSource) index(ctx context.Context) (*mirrorIndex, error) {
+	rc, err := s.get(ctx, mirrorIndexFile)
+	if err != nil {
+		return nil, err
+	}
+	defer rc.Close()
+
+	var index mirrorIndex
+	if err := json.NewDecoder(rc).Decode(&index); err != nil {
+		return nil, fmt.Errorf("failed to decode mirror index: %w", err)
+	}
+	return &index, nil
+}
+
+func (s *mirrorSource) ListVersions(ctx context.Context) ([]string, error) {
+	index, err := s.index(ctx)
+	if err != nil {
+		return nil, err
+	}
+	versions := make([]string, 0, len(index.Versions))
+	for _, v := range index.Versions {
+		versions = append(versions, v.Version)
+	}
+	return versions, nil
+}
+
+func (s *mirrorSource) ListAssets(ctx context.Context, version string) ([]releaseAsset, error) {
+	index, err := s.index(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, v := range index.Versions {
+		if v.Version != version {
+			continue
+		}
+		assets := make([]releaseAsset, 0, len(v.Assets))
+		for _, a := range v.Assets {
+			assets = append(assets, releaseAsset{
+				Name:   a.Name,
+				URL:    a.URL,
+				SHA256: strings.ToLower(a.SHA256),
+			})
+		}
+		return assets, nil
+	}
+	return nil, fmt.Errorf("version %s not found in mirror index", version)
+}
+
+func (s *mirrorSource) Download(ctx context.Context, asset releaseAsset) (io.ReadCloser, error) {
+	return s.get(ctx, asset.URL)
+}
@@ -1,15 +1,28 @@
 package meta
 
-import "context"
+import (
+	"context"
+	"io"
+)
 
 type RisectlConn interface {
 	RunCombined(ctx context.Context, args ...string) (string, int, error)
 	Run(ctx context.Context, args ...string) (string, string, int, error)
+	Stream(ctx context.Context, stdout io.Writer, stderr io.Writer, args ...string) (int, error)
 	MetaBackup(ctx context.Context) (int64, error)
 	DeleteSnapshot(ctx context.Context, snapshotID int64) error
+	ListMetaSnapshots(ctx context.Context) ([]MetaSnapshot, error)
+	ClusterInfo(ctx context.Context) (*ClusterInfo, error)
+	ListFragments(ctx context.Context) ([]Fragment, error)
+	ListActors(ctx context.Context) ([]Actor, error)
+	HummockVersion(ctx context.Context) (*HummockVersion, error)
+	CompactionStatus(ctx context.Context) (*CompactionStatus, error)
+	Resolution() VersionResolution
 }
 
 type RisectlManagerInterface interface {
 	ListVersions(ctx context.Context) ([]string, error)
-	NewConn(ctx context.Context, version string, host string, metaPort int32) (RisectlConn, error)
+	NewConn(ctx context.Context, version string, host string, metaPort int32, opts ...ConnOption) (RisectlConn, error)
+	ResolveVersion(ctx context.Context, version string, opts ...ConnOption) (VersionResolution, error)
+	ImportRisectl(ctx context.Context, version string, archive io.Reader, checksum string) error
 }
@@ -0,0 +1,146 @@
+package meta
+
+import (
+	"context"
+	"log"
+	"strings"
+
+	"golang.org/x/mod/semver"
+)
+
+const (
+	// ResolutionExact means the risectl version is the same as the requested one
+	ResolutionExact = "exact"
+
+	// ResolutionNearest means the nearest version with the same major and minor version is used
+	ResolutionNearest = "nearest"
+
+	// ResolutionOverride means the version is set explicitly for the cluster
+	ResolutionOverride = "override"
+
+	// ResolutionUnresolved means no compatible version is known, the requested version is used as it is
+	ResolutionUnresolved = "unresolved"
+)
+
+// VersionResolution records which risectl version is used for a requested RisingWave version and why.
+type VersionResolution struct {
+	Requested string
+	Resolved  string
+	Reason    string
+}
+
+// ConnOption customizes a risectl connection.
+type ConnOption func(*connOptions)
+
+type connOptions struct {
+	versionOverride string
+}
+
+// WithVersionOverride uses the risectl version as it is instead of resolving it, nil or empty means no override.
+func WithVersionOverride(version *string) ConnOption {
+	return func(o *connOptions) {
+		if version != nil {
+			o.versionOverride = strings.TrimSpace(*version)
+		}
+	}
+}
+
+// NormalizeVersion returns the canonical semantic version, e.g. "2.2" and "v2.2.0+build" both
+// become "v2.2.0". It returns an empty string if the version is not a semantic version.
+func NormalizeVersion(version string) string {
+	version = strings.TrimSpace(version)
+	if version == "" {
+		return ""
+	}
+	if version[0] >= '0' && version[0] <= '9' {
+		version = "v" + version
+	}
+	return semver.Canonical(version)
+}
+
+// SameVersion reports whether two versions are the same once normalized, e.g. "2.3.0" and
+// "v2.3.0". Versions that are not semantic versions are compared as they are.
+func SameVersion(a, b string) bool {
+	na, nb := NormalizeVersion(a), NormalizeVersion(b)
+	if na != "" && nb != "" {
+		return na == nb
+	}
+	return strings.TrimSpace(a) == strings.TrimSpace(b)
+}
+
+// resolveVersion picks the risectl version for the requested version from the candidates. An
+// exact match wins, otherwise the greatest release not newer than the requested version with the
+// same major and minor version is used, then the oldest newer one. Pre-releases are only used
+// when they match exactly. Earlier candidates win over later ones with the same version.
+func resolveVersion(requested string, candidates []string) VersionResolution {
+	target := NormalizeVersion(requested)
+	for _, c := range candidates {
+		if c == requested || (target != "" && NormalizeVersion(c) == target) {
+			return VersionResolution{Requested: requested, Resolved: c, Reason: ResolutionExact}
+		}
+	}
+	if target == "" {
+		return VersionResolution{Requested: requested, Resolved: requested, Reason: ResolutionUnresolved}
+	}
+
+	var older, newer string
+	for _, c := range candidates {
+		v := NormalizeVersion(c)
+		if v == "" || semver.Prerelease(v) != "" || semver.MajorMinor(v) != semver.MajorMinor(target) {
+			continue
+		}
+		if semver.Compare(v, target) < 0 {
+			if older == "" || semver.Compare(v, NormalizeVersion(older)) > 0 {
+				older = c
+			}
+		} else if newer == "" || semver.Compare(v, NormalizeVersion(newer)) < 0 {
+			newer = c
+		}
+	}
+	if older != "" {
+		return VersionResolution{Requested: requested, Resolved: older, Reason: ResolutionNearest}
+	}
+	if newer != "" {
+		return VersionResolution{Requested: requested, Resolved: newer, Reason: ResolutionNearest}
+	}
+	return VersionResolution{Requested: requested, Resolved: requested, Reason: ResolutionUnresolved}
+}
+
+// ResolveVersion returns the risectl version to use for the RisingWave version. The installed
+// versions are preferred, the released ones are also considered unless in NoInternet mode or an
+// installed version matches exactly.
+func (m *RisectlManager) ResolveVersion(ctx context.Context, version string, opts ...ConnOption) (VersionResolution, error) {
+	var o connOptions
+	for _, opt := range opts {
+		opt(&o)
+	}
+	if o.versionOverride != "" {
+		if err := validateVersion(o.versionOverride); err != nil {
+			return VersionResolution{}, err
+		}
+		return VersionResolution{Requested: version, Resolved: o.versionOverride, Reason: ResolutionOverride}, nil
+	}
+
+	candidates, err := m.listVersionsNoInternet()
+	if err != nil {
+		return VersionResolution{}, err
+	}
+	// an installed exact match is used without listing the releases
+	if resolution := resolveVersion(version, candidates); resolution.Reason == ResolutionExact {
+		return resolution, nil
+	}
+	if !m.noInternet {
+		released, err := m.listVersions(ctx)
+		if err != nil {
+			log.Default().Printf("Failed to list risectl releases, only the installed versions are considered: %v", err)
+		} else {
+			candidates = append(candidates, released...)
+		}
+	}
+
+	resolution := resolveVersion(version, candidates)
+	if err := validateVersion(resolution.Resolved); err != nil {
+		return VersionResolution{}, err
+	}
+	return resolution, nil
+}
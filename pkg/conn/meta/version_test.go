@@ -0,0 +1,193 @@
+package meta
+
+import (
+	"archive/tar"
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/risingwavelabs/risingwave-console/pkg/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNormalizeVersion(t *testing.T) {
+	testCases := map[string]string{
+		"v2.2.1":           "v2.2.1",
+		"2.2.1":            "v2.2.1",
+		" v2.2 ":           "v2.2.0",
+		"v2.2.1+build.1":   "v2.2.1",
+		"v2.3.0-rc.1":      "v2.3.0-rc.1",
+		"nightly-20250101": "",
+		"":                 "",
+	}
+	for input, expected := range testCases {
+		assert.Equal(t, expected, NormalizeVersion(input), input)
+	}
+}
+
+func TestSameVersion(t *testing.T) {
+	testCases := []struct {
+		a, b string
+		want bool
+	}{
+		{a: "v2.3.0", b: "v2.3.0", want: true},
+		{a: "2.3.0", b: "v2.3.0", want: true},
+		{a: "v2.3", b: "v2.3.0", want: true},
+		{a: "v2.3.0", b: "v2.3.1", want: false},
+		{a: "v2.4.0-alpha", b: "v2.4.0", want: false},
+		{a: "nightly", b: "nightly", want: true},
+		{a: "nightly", b: "v2.3.0", want: false},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.a+"-"+tc.b, func(t *testing.T) {
+			assert.Equal(t, tc.want, SameVersion(tc.a, tc.b))
+		})
+	}
+}
+
+func TestResolveVersion(t *testing.T) {
+	testCases := []struct {
+		name       string
+		requested  string
+		candidates []string
+		resolved   string
+		reason     string
+	}{
+		{
+			name:       "exact",
+			requested:  "v2.2.1",
+			candidates: []string{"v2.2.0", "v2.2.1", "v2.2.2"},
+			resolved:   "v2.2.1",
+			reason:     ResolutionExact,
+		},
+		{
+			name:       "exact after normalization",
+			requested:  "2.2.1",
+			candidates: []string{"v2.2.1"},
+			resolved:   "v2.2.1",
+			reason:     ResolutionExact,
+		},
+		{
+			name:       "nearest older patch",
+			requested:  "v2.2.5",
+			candidates: []string{"v2.2.0", "v2.2.3", "v2.2.6", "v2.3.0"},
+			resolved:   "v2.2.3",
+			reason:     ResolutionNearest,
+		},
+		{
+			name:       "nearest newer patch",
+			requested:  "v2.2.0",
+			candidates: []string{"v2.1.9", "v2.2.4", "v2.2.2"},
+			resolved:   "v2.2.2",
+			reason:     ResolutionNearest,
+		},
+		{
+			name:       "pre-releases are skipped",
+			requested:  "v2.2.1",
+			candidates: []string{"v2.2.2-rc.1", "v2.2.3"},
+			resolved:   "v2.2.3",
+			reason:     ResolutionNearest,
+		},
+		{
+			name:       "installed version wins",
+			requested:  "v2.2.1",
+			candidates: []string{"2.2.0", "v2.2.0"},
+			resolved:   "2.2.0",
+			reason:     ResolutionNearest,
+		},
+		{
+			name:       "different minor version",
+			requested:  "v2.2.1",
+			candidates: []string{"v2.1.0", "v2.3.0"},
+			resolved:   "v2.2.1",
+			reason:     ResolutionUnresolved,
+		},
+		{
+			name:       "not a semantic version",
+			requested:  "nightly-20250101",
+			candidates: []string{"v2.2.0"},
+			resolved:   "nightly-20250101",
+			reason:     ResolutionUnresolved,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			resolution := resolveVersion(tc.requested, tc.candidates)
+			assert.Equal(t, tc.requested, resolution.Requested)
+			assert.Equal(t, tc.resolved, resolution.Resolved)
+			assert.Equal(t, tc.reason, resolution.Reason)
+		})
+	}
+}
+
+func TestNewConnResolvesVersion(t *testing.T) {
+	m := &RisectlManager{risectlDir: t.TempDir(), noInternet: true}
+	archive := buildArchive(t, []tarEntry{{name: "risectl", typeflag: tar.TypeReg, content: "binary"}})
+	sum := sha256.Sum256(archive)
+	require.NoError(t, m.ImportRisectl(context.Background(), "v2.2.0", bytes.NewReader(archive), hex.EncodeToString(sum[:])))
+
+	conn, err := m.NewConn(context.Background(), "v2.2.3", "localhost", 5690)
+	require.NoError(t, err)
+	assert.Equal(t, VersionResolution{Requested: "v2.2.3", Resolved: "v2.2.0", Reason: ResolutionNearest}, conn.Resolution())
+
+	conn, err = m.NewConn(context.Background(), "v2.2.3", "localhost", 5690, WithVersionOverride(utils.Ptr("v2.2.0")))
+	require.NoError(t, err)
+	assert.Equal(t, ResolutionOverride, conn.Resolution().Reason)
+
+	_, err = m.NewConn(context.Background(), "v2.2.3", "localhost", 5690, WithVersionOverride(utils.Ptr("../v2.2.0")))
+	require.ErrorIs(t, err, ErrInvalidVersion)
+}
+
+type fakeSource struct {
+	artifactSource
+	calls int
+	err   error
+}
+
+func (s *fakeSource) ListVersions(context.Context) ([]string, error) {
+	s.calls++
+	return []string{"v2.3.0"}, s.err
+}
+
+func TestResolveVersionListsReleases(t *testing.T) {
+	source := &fakeSource{}
+	m := &RisectlManager{risectlDir: t.TempDir(), source: source}
+	archive := buildArchive(t, []tarEntry{{name: "risectl", typeflag: tar.TypeReg, content: "binary"}})
+	sum := sha256.Sum256(archive)
+	require.NoError(t, m.ImportRisectl(context.Background(), "v2.2.0", bytes.NewReader(archive), hex.EncodeToString(sum[:])))
+
+	// an installed exact match does not list the releases
+	resolution, err := m.ResolveVersion(context.Background(), "v2.2.0")
+	require.NoError(t, err)
+	assert.Equal(t, ResolutionExact, resolution.Reason)
+	assert.Equal(t, 0, source.calls)
+
+	resolution, err = m.ResolveVersion(context.Background(), "v2.3.0")
+	require.NoError(t, err)
+	assert.Equal(t, VersionResolution{Requested: "v2.3.0", Resolved: "v2.3.0", Reason: ResolutionExact}, resolution)
+	_, err = m.ResolveVersion(context.Background(), "v2.3.0")
+	require.NoError(t, err)
+	assert.Equal(t, 1, source.calls, "the releases are cached")
+}
+
+func TestResolveVersionCachesFailures(t *testing.T) {
+	source := &fakeSource{err: errors.New("rate limited")}
+	m := &RisectlManager{risectlDir: t.TempDir(), source: source}
+
+	for range 3 {
+		resolution, err := m.ResolveVersion(context.Background(), "v2.3.0")
+		require.NoError(t, err)
+		assert.Equal(t, ResolutionUnresolved, resolution.Reason)
+	}
+	assert.Equal(t, 1, source.calls, "the failure is cached")
+
+	m.exp = time.Now()
+	_, err := m.ResolveVersion(context.Background(), "v2.3.0")
+	require.NoError(t, err)
+	assert.Equal(t, 2, source.calls, "the releases are listed again once the failure expires")
+}
@@ -3,6 +3,7 @@ package metricsstore
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/pkg/errors"
 	prom_model "github.com/prometheus/common/model"
@@ -17,6 +18,22 @@ var ErrMetricsStoreNotSupported = errors.New("Metrics store not supported")
 // the connection is only established when the query is made.
 type MetricsConn interface {
 	GetMaterializedViewThroughput(ctx context.Context) (prom_model.Matrix, error)
+
+	// GetKeyMetrics queries the key metrics of the cluster in the range, a failed query is
+	// reported in its result instead of failing the others.
+	GetKeyMetrics(ctx context.Context, start time.Time, end time.Time, step time.Duration) []MetricRange
+
+	// QueryVector runs the instant query at ts with the default selector added to every vector
+	// selector of the expression, a scalar result is returned as a sample without labels.
+	QueryVector(ctx context.Context, expr string, ts time.Time) (prom_model.Vector, error)
+}
+
+// MetricRange is the result of a range query of a key metric.
+type MetricRange struct {
+	Name   string            `json:"name"`
+	Query  string            `json:"query"`
+	Error  string            `json:"error,omitempty"`
+	Result prom_model.Matrix `json:"result"`
 }
 
 type MetricsManager struct {
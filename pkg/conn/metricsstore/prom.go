@@ -19,6 +19,10 @@ var log = logger.NewLogAgent("metricsstore")
 
 var ErrPrometheusEndpointNotFound = errors.New("prometheus endpoint not found")
 
+// queryTimeout bounds every instant query, so that a slow metrics store does not hold the
+// evaluation of the other queries
+const queryTimeout = 30 * time.Second
+
 type PrometheusConn struct {
 	v1api           v1.API
 	defaultSelector string
@@ -73,3 +77,100 @@ func metricWithLabelSelector(metric string, selector string) string {
 	}
 	return fmt.Sprintf("%s{%s}", metric, selector)
 }
+
+// keyMetrics are the queries of the key metrics, each one is formatted with the default selector.
+var keyMetrics = []struct {
+	name  string
+	query func(selector string) string
+}{
+	{
+		name: "materialized_view_throughput",
+		query: func(selector string) string {
+			return fmt.Sprintf(`sum(rate(%s[1m])) by (table_id)`, metricWithLabelSelector("stream_mview_input_row_count", selector))
+		},
+	},
+	{
+		name: "source_throughput",
+		query: func(selector string) string {
+			return fmt.Sprintf(`sum(rate(%s[1m])) by (source_id, source_name)`, metricWithLabelSelector("stream_source_output_rows_counts", selector))
+		},
+	},
+	{
+		name: "barrier_latency_p99",
+		query: func(selector string) string {
+			return fmt.Sprintf(`histogram_quantile(0.99, sum(rate(%s[1m])) by (le))`, metricWithLabelSelector("meta_barrier_duration_seconds_bucket", selector))
+		},
+	},
+	{
+		name: "actor_output_blocking_rate",
+		query: func(selector string) string {
+			return fmt.Sprintf(`sum(rate(%s[1m])) by (fragment_id) / 1000000000`, metricWithLabelSelector("stream_actor_output_buffer_blocking_duration_ns", selector))
+		},
+	},
+	{
+		name: "state_store_get_latency_p99",
+		query: func(selector string) string {
+			return fmt.Sprintf(`histogram_quantile(0.99, sum(rate(%s[1m])) by (le, table_id))`, metricWithLabelSelector("state_store_get_duration_bucket", selector))
+		},
+	},
+	{
+		name: "memory_usage",
+		query: func(selector string) string {
+			return fmt.Sprintf(`sum(%s) by (job, instance)`, metricWithLabelSelector("process_resident_memory_bytes", selector))
+		},
+	},
+	{
+		name: "cpu_usage",
+		query: func(selector string) string {
+			return fmt.Sprintf(`sum(rate(%s[1m])) by (job, instance)`, metricWithLabelSelector("process_cpu_seconds_total", selector))
+		},
+	},
+}
+
+func (c *PrometheusConn) GetKeyMetrics(ctx context.Context, start time.Time, end time.Time, step time.Duration) []MetricRange {
+	results := make([]MetricRange, len(keyMetrics))
+	for i, metric := range keyMetrics {
+		query := metric.query(c.defaultSelector)
+		results[i] = MetricRange{Name: metric.name, Query: query, Result: prom_model.Matrix{}}
+
+		result, warnings, err := c.v1api.QueryRange(ctx, query, v1.Range{Start: start, End: end, Step: step})
+		if err != nil {
+			results[i].Error = err.Error()
+			continue
+		}
+		if len(warnings) > 0 {
+			log.Warn("prometheus query returned warnings", zap.String("query", query), zap.Strings("warnings", warnings))
+		}
+		matrix, ok := result.(prom_model.Matrix)
+		if !ok {
+			results[i].Error = "result is not a matrix"
+			continue
+		}
+		results[i].Result = matrix
+	}
+	return results
+}
+
+func (c *PrometheusConn) QueryVector(ctx context.Context, expr string, ts time.Time) (prom_model.Vector, error) {
+	query, err := withDefaultSelector(expr, c.defaultSelector)
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := context.WithTimeout(ctx, queryTimeout)
+	defer cancel()
+	result, warnings, err := c.v1api.Query(ctx, query, ts, v1.WithTimeout(queryTimeout))
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to query %s", query)
+	}
+	if len(warnings) > 0 {
+		log.Warn("prometheus query returned warnings", zap.String("query", query), zap.Strings("warnings", warnings))
+	}
+	switch v := result.(type) {
+	case prom_model.Vector:
+		return v, nil
+	case *prom_model.Scalar:
+		return prom_model.Vector{{Metric: prom_model.Metric{}, Value: v.Value, Timestamp: v.Timestamp}}, nil
+	default:
+		return nil, errors.Errorf("result of %s is a %s, not a vector", query, result.Type())
+	}
+}
@@ -0,0 +1,251 @@
+package metricsstore
+
+import (
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+var ErrInvalidExpr = errors.New("invalid PromQL expression")
+
+// promqlKeywords are the identifiers of PromQL not naming a metric when not followed by "(".
+var promqlKeywords = map[string]struct{}{
+	"bool":   {},
+	"offset": {},
+	"and":    {},
+	"or":     {},
+	"unless": {},
+	"atan2":  {},
+	"inf":    {},
+	"nan":    {},
+}
+
+// promqlAggregations are the aggregation operators, the grouping may come before the parameters,
+// e.g. "sum by (job) (x)".
+var promqlAggregations = map[string]struct{}{
+	"sum":          {},
+	"min":          {},
+	"max":          {},
+	"avg":          {},
+	"group":        {},
+	"stddev":       {},
+	"stdvar":       {},
+	"count":        {},
+	"count_values": {},
+	"bottomk":      {},
+	"topk":         {},
+	"quantile":     {},
+	"limitk":       {},
+	"limit_ratio":  {},
+}
+
+// promqlLabelListKeywords are followed by a list of label names, e.g. "by (job, instance)".
+var promqlLabelListKeywords = map[string]struct{}{
+	"by":          {},
+	"without":     {},
+	"on":          {},
+	"ignoring":    {},
+	"group_left":  {},
+	"group_right": {},
+}
+
+// ValidateExpr checks the tokens of the PromQL expression, e.g. the strings are terminated and
+// the brackets are balanced. It does not check the functions and the types of the expression,
+// they are checked by the metrics store once the expression is queried.
+func ValidateExpr(expr string) error {
+	if strings.TrimSpace(expr) == "" {
+		return errors.Wrap(ErrInvalidExpr, "expression is empty")
+	}
+	_, err := withDefaultSelector(expr, "")
+	return err
+}
+
+// withDefaultSelector adds the selector to every vector selector of the expression, e.g.
+// `rate(foo{job="a"}[1m]) > bar` with selector `namespace="ns"` becomes
+// `rate(foo{namespace="ns",job="a"}[1m]) > bar{namespace="ns"}`. It scans the tokens of the
+// expression instead of parsing it, so an identifier is taken as a metric name unless it is a
+// keyword, an aggregation, a label name of a grouping or followed by "(".
+func withDefaultSelector(expr string, selector string) (string, error) {
+	var (
+		b      strings.Builder
+		parens int
+		n      = len(expr)
+	)
+	// injectBraces writes the matchers in the braces starting at i with the selector added, and
+	// returns the index after the closing brace
+	injectBraces := func(i int) (int, error) {
+		end, err := scanBraces(expr, i)
+		if err != nil {
+			return 0, err
+		}
+		inner := strings.TrimSpace(expr[i+1 : end])
+		b.WriteByte('{')
+		b.WriteString(selector)
+		if selector != "" && inner != "" {
+			b.WriteByte(',')
+		}
+		b.WriteString(inner)
+		b.WriteByte('}')
+		return end + 1, nil
+	}
+
+	for i := 0; i < n; {
+		c := expr[i]
+		switch {
+		case c == '#':
+			end := strings.IndexByte(expr[i:], '\n')
+			if end < 0 {
+				end = n - i
+			}
+			b.WriteString(expr[i : i+end])
+			i += end
+		case c == '"' || c == '\'' || c == '`':
+			end, err := scanString(expr, i)
+			if err != nil {
+				return "", err
+			}
+			b.WriteString(expr[i:end])
+			i = end
+		case c == '[':
+			end := strings.IndexByte(expr[i:], ']')
+			if end < 0 {
+				return "", errors.Wrapf(ErrInvalidExpr, "unclosed \"[\" at %d", i)
+			}
+			b.WriteString(expr[i : i+end+1])
+			i += end + 1
+		case c == '{':
+			// a vector selector without a metric name, e.g. {__name__=~"foo.*"}
+			end, err := injectBraces(i)
+			if err != nil {
+				return "", err
+			}
+			i = end
+		case c == '}' || c == ']':
+			return "", errors.Wrapf(ErrInvalidExpr, "unexpected %q at %d", c, i)
+		case c == '(':
+			parens++
+			b.WriteByte(c)
+			i++
+		case c == ')':
+			parens--
+			if parens < 0 {
+				return "", errors.Wrapf(ErrInvalidExpr, "unexpected \")\" at %d", i)
+			}
+			b.WriteByte(c)
+			i++
+		case isIdentStart(c):
+			end := i + 1
+			for end < n && isIdentChar(expr[end]) {
+				end++
+			}
+			word := expr[i:end]
+			next := end
+			for next < n && isSpace(expr[next]) {
+				next++
+			}
+			lower := strings.ToLower(word)
+
+			if _, ok := promqlLabelListKeywords[lower]; ok && next < n && expr[next] == '(' {
+				// copy the label names as they are
+				closing := strings.IndexByte(expr[next:], ')')
+				if closing < 0 {
+					return "", errors.Wrapf(ErrInvalidExpr, "unclosed \"(\" at %d", next)
+				}
+				b.WriteString(expr[i : next+closing+1])
+				i = next + closing + 1
+				continue
+			}
+			_, keyword := promqlKeywords[lower]
+			_, aggregation := promqlAggregations[lower]
+			if keyword || aggregation || (next < n && expr[next] == '(') {
+				b.WriteString(word)
+				i = end
+				continue
+			}
+
+			// a metric name
+			b.WriteString(word)
+			if next < n && expr[next] == '{' {
+				b.WriteString(expr[end:next])
+				end, err := injectBraces(next)
+				if err != nil {
+					return "", err
+				}
+				i = end
+				continue
+			}
+			if selector != "" {
+				b.WriteString("{" + selector + "}")
+			}
+			i = end
+		case isDigit(c) || (c == '.' && i+1 < n && isDigit(expr[i+1])):
+			// a number or a duration, e.g. 1.5e+3, 0x1f or 5m
+			end := i + 1
+			for end < n && (isIdentChar(expr[end]) || expr[end] == '.' ||
+				((expr[end] == '+' || expr[end] == '-') && (expr[end-1] == 'e' || expr[end-1] == 'E'))) {
+				end++
+			}
+			b.WriteString(expr[i:end])
+			i = end
+		default:
+			b.WriteByte(c)
+			i++
+		}
+	}
+	if parens != 0 {
+		return "", errors.Wrap(ErrInvalidExpr, "unclosed \"(\"")
+	}
+	return b.String(), nil
+}
+
+// scanString returns the index after the string starting at i, the backquoted strings have no
+// escapes.
+func scanString(expr string, i int) (int, error) {
+	quote := expr[i]
+	for j := i + 1; j < len(expr); j++ {
+		switch {
+		case expr[j] == '\\' && quote != '`':
+			j++
+		case expr[j] == quote:
+			return j + 1, nil
+		}
+	}
+	return 0, errors.Wrapf(ErrInvalidExpr, "unterminated string at %d", i)
+}
+
+// scanBraces returns the index of the brace closing the label matchers starting at i.
+func scanBraces(expr string, i int) (int, error) {
+	for j := i + 1; j < len(expr); {
+		switch expr[j] {
+		case '"', '\'', '`':
+			end, err := scanString(expr, j)
+			if err != nil {
+				return 0, err
+			}
+			j = end
+		case '}':
+			return j, nil
+		case '{':
+			return 0, errors.Wrapf(ErrInvalidExpr, "unexpected \"{\" at %d", j)
+		default:
+			j++
+		}
+	}
+	return 0, errors.Wrapf(ErrInvalidExpr, "unclosed \"{\" at %d", i)
+}
+
+func isIdentStart(c byte) bool {
+	return c == '_' || c == ':' || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z')
+}
+
+func isIdentChar(c byte) bool {
+	return isIdentStart(c) || isDigit(c)
+}
+
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
+
+func isSpace(c byte) bool {
+	return c == ' ' || c == '\t' || c == '\n' || c == '\r'
+}
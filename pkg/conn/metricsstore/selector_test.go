@@ -0,0 +1,112 @@
+package metricsstore
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWithDefaultSelector(t *testing.T) {
+	selector := `namespace="prod"`
+
+	testCases := []struct {
+		name     string
+		expr     string
+		expected string
+	}{
+		{
+			name:     "metric",
+			expr:     `up == 0`,
+			expected: `up{namespace="prod"} == 0`,
+		},
+		{
+			name:     "matchers",
+			expr:     `rate(stream_mview_input_row_count{table_id="5"}[1m]) < 1`,
+			expected: `rate(stream_mview_input_row_count{namespace="prod",table_id="5"}[1m]) < 1`,
+		},
+		{
+			name:     "empty matchers",
+			expr:     `up {}`,
+			expected: `up {namespace="prod"}`,
+		},
+		{
+			name:     "without metric name",
+			expr:     `count({__name__=~"stream_.*"})`,
+			expected: `count({namespace="prod",__name__=~"stream_.*"})`,
+		},
+		{
+			name:     "grouping",
+			expr:     `histogram_quantile(0.99, sum by (le) (rate(meta_barrier_duration_seconds_bucket[1m]))) > 1.5e+1`,
+			expected: `histogram_quantile(0.99, sum by (le) (rate(meta_barrier_duration_seconds_bucket{namespace="prod"}[1m]))) > 1.5e+1`,
+		},
+		{
+			name:     "grouping after the aggregation and vector matching",
+			expr:     `sum(a) without(job) / on(instance) group_left(version) b unless bool c offset 5m`,
+			expected: `sum(a{namespace="prod"}) without(job) / on(instance) group_left(version) b{namespace="prod"} unless bool c{namespace="prod"} offset 5m`,
+		},
+		{
+			name:     "strings and subqueries",
+			expr:     `max_over_time(label_replace(x, "dst", "$1", "src", "(up|down)")[10m:1m])`,
+			expected: `max_over_time(label_replace(x{namespace="prod"}, "dst", "$1", "src", "(up|down)")[10m:1m])`,
+		},
+		{
+			name:     "at modifiers",
+			expr:     `x @ 1609746000 > rate(y[5m] @ end()) + z offset 1m @ start()`,
+			expected: `x{namespace="prod"} @ 1609746000 > rate(y{namespace="prod"}[5m] @ end()) + z{namespace="prod"} offset 1m @ start()`,
+		},
+		{
+			name:     "nested subqueries",
+			expr:     `max_over_time(rate(x{job="a"}[5m])[30m:1m] offset 1h) / min_over_time((a + b)[1h:] @ 100)`,
+			expected: `max_over_time(rate(x{namespace="prod",job="a"}[5m])[30m:1m] offset 1h) / min_over_time((a{namespace="prod"} + b{namespace="prod"})[1h:] @ 100)`,
+		},
+		{
+			name:     "metrics named like keywords",
+			expr:     `sum_total + offset_seconds offset 5m - by_job + on_call > inf_count and end`,
+			expected: `sum_total{namespace="prod"} + offset_seconds{namespace="prod"} offset 5m - by_job{namespace="prod"} + on_call{namespace="prod"} > inf_count{namespace="prod"} and end{namespace="prod"}`,
+		},
+		{
+			name:     "metrics named like label list keywords",
+			expr:     `sum by (on) (by) / ignoring(job) without`,
+			expected: `sum by (on) (by{namespace="prod"}) / ignoring(job) without{namespace="prod"}`,
+		},
+		{
+			name:     "metric named like an aggregation",
+			expr:     `{__name__="sum"} > 0`,
+			expected: `{namespace="prod",__name__="sum"} > 0`,
+		},
+		{
+			name:     "braces in a label value",
+			expr:     `x{path="/a/{id}"} and inf`,
+			expected: `x{namespace="prod",path="/a/{id}"} and inf`,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			result, err := withDefaultSelector(tc.expr, selector)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, result)
+
+			// the expression is kept as it is without a default selector
+			result, err = withDefaultSelector(tc.expr, "")
+			require.NoError(t, err)
+			assert.Equal(t, tc.expr, result)
+		})
+	}
+}
+
+func TestValidateExpr(t *testing.T) {
+	for _, expr := range []string{
+		``,
+		`   `,
+		`sum(rate(x[1m])`,
+		`x)`,
+		`x{job="a"`,
+		`x{job="a}`,
+		`x[5m`,
+		`x}`,
+	} {
+		assert.ErrorIs(t, ValidateExpr(expr), ErrInvalidExpr, expr)
+	}
+	assert.NoError(t, ValidateExpr(`sum(rate(x[1m])) by (job) > 0 # comment with ( and {`))
+}
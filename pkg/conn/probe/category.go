@@ -0,0 +1,92 @@
+package probe
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"net"
+	"os"
+	"syscall"
+
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+// ErrorCategory tells what is likely wrong, e.g. a firewall dropping the packets or a wrong
+// password, so that the user knows what to fix.
+type ErrorCategory string
+
+const (
+	CategoryDNS                ErrorCategory = "dns"
+	CategoryConnectionRefused  ErrorCategory = "connection_refused"
+	CategoryTimeout            ErrorCategory = "timeout"
+	CategoryNetworkUnreachable ErrorCategory = "network_unreachable"
+	CategoryTLS                ErrorCategory = "tls"
+	CategoryAuthentication     ErrorCategory = "authentication"
+	CategoryDatabaseNotFound   ErrorCategory = "database_not_found"
+	CategoryHTTPStatus         ErrorCategory = "http_status"
+	CategoryRisectl            ErrorCategory = "risectl"
+	CategoryUnknown            ErrorCategory = "unknown"
+)
+
+var hints = map[ErrorCategory]string{
+	CategoryDNS:                "The host name cannot be resolved, check the host and the DNS settings of the console.",
+	CategoryConnectionRefused:  "Nothing is listening on the port, check the port and that the component is running.",
+	CategoryTimeout:            "The connection timed out, check the firewall rules and the security groups between the console and the cluster.",
+	CategoryNetworkUnreachable: "The host is unreachable, check the network route between the console and the cluster.",
+	CategoryTLS:                "The TLS handshake failed, check the certificate of the server.",
+	CategoryAuthentication:     "The credentials are rejected, check the username and the password.",
+	CategoryDatabaseNotFound:   "The database does not exist, check the name of the database.",
+	CategoryHTTPStatus:         "The meta HTTP API responds with an error, check that the http port is the dashboard port of the meta node.",
+	CategoryRisectl:            "risectl cannot get the cluster info, check the meta port and the version of the cluster.",
+}
+
+// Hint returns what to check for the category, empty for unknown errors.
+func Hint(category ErrorCategory) string {
+	return hints[category]
+}
+
+// Categorize classifies network and pgwire errors, other errors are classified as the
+// fallback.
+func Categorize(err error, fallback ErrorCategory) ErrorCategory {
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) {
+		switch pgErr.Code {
+		case "28P01", "28000":
+			return CategoryAuthentication
+		case "3D000":
+			return CategoryDatabaseNotFound
+		}
+		return fallback
+	}
+
+	var dnsErr *net.DNSError
+	if errors.As(err, &dnsErr) {
+		if dnsErr.IsTimeout {
+			return CategoryTimeout
+		}
+		return CategoryDNS
+	}
+
+	var certErr *tls.CertificateVerificationError
+	var unknownAuthErr x509.UnknownAuthorityError
+	var hostnameErr x509.HostnameError
+	if errors.As(err, &certErr) || errors.As(err, &unknownAuthErr) || errors.As(err, &hostnameErr) {
+		return CategoryTLS
+	}
+
+	switch {
+	case errors.Is(err, syscall.ECONNREFUSED):
+		return CategoryConnectionRefused
+	case errors.Is(err, syscall.EHOSTUNREACH), errors.Is(err, syscall.ENETUNREACH):
+		return CategoryNetworkUnreachable
+	case errors.Is(err, context.DeadlineExceeded), errors.Is(err, os.ErrDeadlineExceeded):
+		return CategoryTimeout
+	}
+
+	var netErr net.Error
+	if errors.As(err, &netErr) && netErr.Timeout() {
+		return CategoryTimeout
+	}
+	return fallback
+}
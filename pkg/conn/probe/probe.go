@@ -0,0 +1,380 @@
+package probe
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"net"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/pkg/errors"
+	"github.com/risingwavelabs/risingwave-console/pkg/conn/http"
+	"github.com/risingwavelabs/risingwave-console/pkg/conn/meta"
+	"github.com/risingwavelabs/risingwave-console/pkg/conn/sql"
+)
+
+const defaultStageTimeout = 5 * time.Second
+
+type Stage string
+
+const (
+	StageDNS        Stage = "dns"
+	StageTCPMeta    Stage = "tcp_meta"
+	StageTCPSQL     Stage = "tcp_sql"
+	StageTCPHTTP    Stage = "tcp_http"
+	StageTLS        Stage = "tls"
+	StagePgwireAuth Stage = "pgwire_auth"
+	StageDatabase   Stage = "database"
+	StageMetaHTTP   Stage = "meta_http"
+	StageRisectl    Stage = "risectl"
+)
+
+type Status string
+
+const (
+	StatusPassed  Status = "passed"
+	StatusFailed  Status = "failed"
+	StatusSkipped Status = "skipped"
+)
+
+// Target is the cluster to probe. The pgwire stages are skipped without a username and the
+// risectl stage is skipped without a version. Only the given stages are run and reported, all
+// of them if none is given.
+type Target struct {
+	Host     string
+	SqlPort  int32
+	MetaPort int32
+	HttpPort int32
+
+	Username string
+	Password string
+	Database string
+
+	Version        string
+	RisectlVersion *string
+
+	Stages []Stage
+}
+
+// selects reports whether the stage is run for the target.
+func (t *Target) selects(stage Stage) bool {
+	return len(t.Stages) == 0 || slices.Contains(t.Stages, stage)
+}
+
+type StageResult struct {
+	Stage    Stage
+	Status   Status
+	Duration time.Duration
+
+	// Category and Error are only set if the stage failed
+	Category ErrorCategory
+	Error    string
+
+	// Detail describes the outcome, e.g. the resolved addresses or the reason of skipping
+	Detail string
+}
+
+type Result struct {
+	Stages []StageResult
+}
+
+// Success reports whether no stage failed.
+func (r *Result) Success() bool {
+	for _, stage := range r.Stages {
+		if stage.Status == StatusFailed {
+			return false
+		}
+	}
+	return true
+}
+
+// Passed reports whether all the given stages ran and passed.
+func (r *Result) Passed(stages ...Stage) bool {
+	for _, stage := range stages {
+		found := false
+		for _, result := range r.Stages {
+			if result.Stage == stage {
+				found = result.Status == StatusPassed
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// pgwireConn is the part of *pgx.Conn used by the probe.
+type pgwireConn interface {
+	QueryRow(ctx context.Context, sql string, args ...any) pgx.Row
+	Close(ctx context.Context) error
+}
+
+type Prober struct {
+	risectlm meta.RisectlManagerInterface
+	metahttp http.MetaHttpManagerInterface
+
+	stageTimeout time.Duration
+
+	lookupHost    func(ctx context.Context, host string) ([]string, error)
+	dial          func(ctx context.Context, network, address string) (net.Conn, error)
+	connectPgwire func(ctx context.Context, connStr string) (pgwireConn, error)
+}
+
+func NewProber(risectlm meta.RisectlManagerInterface, metahttp http.MetaHttpManagerInterface) *Prober {
+	var d net.Dialer
+	return &Prober{
+		risectlm:     risectlm,
+		metahttp:     metahttp,
+		stageTimeout: defaultStageTimeout,
+		lookupHost:   net.DefaultResolver.LookupHost,
+		dial:         d.DialContext,
+		connectPgwire: func(ctx context.Context, connStr string) (pgwireConn, error) {
+			return pgx.Connect(ctx, connStr)
+		},
+	}
+}
+
+// Probe runs the selected stages in order, a stage is skipped once a stage it depends on fails.
+// A stage that is not selected does not fail the stages depending on it.
+func (p *Prober) Probe(ctx context.Context, target Target) *Result {
+	result := &Result{}
+	add := func(r StageResult) StageResult {
+		if target.selects(r.Stage) {
+			result.Stages = append(result.Stages, r)
+		}
+		return r
+	}
+	skipAll := func(reason string, stages ...Stage) {
+		for _, stage := range stages {
+			add(skipped(stage, reason))
+		}
+	}
+
+	if target.selects(StageDNS) {
+		if dns := add(p.run(ctx, StageDNS, CategoryDNS, p.checkDNS(target.Host))); dns.Status == StatusFailed {
+			skipAll("the host cannot be resolved", StageTCPMeta, StageTCPSQL, StageTCPHTTP, StageTLS, StagePgwireAuth, StageDatabase, StageMetaHTTP, StageRisectl)
+			return result
+		}
+	}
+
+	var tcpMeta, tcpSQL, tcpHTTP StageResult
+	if target.selects(StageTCPMeta) {
+		tcpMeta = add(p.run(ctx, StageTCPMeta, CategoryUnknown, p.checkTCP(target.Host, target.MetaPort)))
+	}
+	if target.selects(StageTCPSQL) {
+		tcpSQL = add(p.run(ctx, StageTCPSQL, CategoryUnknown, p.checkTCP(target.Host, target.SqlPort)))
+	}
+	if target.selects(StageTCPHTTP) {
+		tcpHTTP = add(p.run(ctx, StageTCPHTTP, CategoryUnknown, p.checkTCP(target.Host, target.HttpPort)))
+	}
+
+	if tcpSQL.Status == StatusFailed {
+		skipAll("the sql port is unreachable", StageTLS, StagePgwireAuth, StageDatabase)
+	} else {
+		if target.selects(StageTLS) {
+			add(p.runTLS(ctx, target))
+		}
+		if target.Username == "" {
+			skipAll("no credentials are given", StagePgwireAuth, StageDatabase)
+		} else if target.selects(StagePgwireAuth) || target.selects(StageDatabase) {
+			for _, r := range p.runPgwire(ctx, target) {
+				add(r)
+			}
+		}
+	}
+
+	if tcpHTTP.Status == StatusFailed {
+		skipAll("the http port is unreachable", StageMetaHTTP)
+	} else if target.selects(StageMetaHTTP) {
+		add(p.run(ctx, StageMetaHTTP, CategoryHTTPStatus, func(ctx context.Context) (string, error) {
+			endpoint := fmt.Sprintf("http://%s", net.JoinHostPort(target.Host, strconv.Itoa(int(target.HttpPort))))
+			return "", p.metahttp.Ping(ctx, endpoint)
+		}))
+	}
+
+	if tcpMeta.Status == StatusFailed {
+		skipAll("the meta port is unreachable", StageRisectl)
+	} else if target.Version == "" {
+		skipAll("the version of the cluster is not given", StageRisectl)
+	} else if target.selects(StageRisectl) {
+		add(p.run(ctx, StageRisectl, CategoryRisectl, p.checkRisectl(target)))
+	}
+
+	return result
+}
+
+func skipped(stage Stage, reason string) StageResult {
+	return StageResult{Stage: stage, Status: StatusSkipped, Detail: reason}
+}
+
+// run times the check with the stage timeout, the error is categorized with the fallback if
+// it is not a network or pgwire error.
+func (p *Prober) run(ctx context.Context, stage Stage, fallback ErrorCategory, check func(ctx context.Context) (string, error)) StageResult {
+	ctx, cancel := context.WithTimeout(ctx, p.stageTimeout)
+	defer cancel()
+
+	start := time.Now()
+	detail, err := check(ctx)
+	r := StageResult{Stage: stage, Duration: time.Since(start), Detail: detail}
+	if err != nil {
+		r.Status = StatusFailed
+		r.Category = Categorize(err, fallback)
+		r.Error = err.Error()
+		return r
+	}
+	r.Status = StatusPassed
+	return r
+}
+
+func (p *Prober) checkDNS(host string) func(ctx context.Context) (string, error) {
+	return func(ctx context.Context) (string, error) {
+		if net.ParseIP(host) != nil {
+			return "the host is an IP address", nil
+		}
+		addrs, err := p.lookupHost(ctx, host)
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("resolved to %v", addrs), nil
+	}
+}
+
+func (p *Prober) checkTCP(host string, port int32) func(ctx context.Context) (string, error) {
+	return func(ctx context.Context) (string, error) {
+		conn, err := p.dial(ctx, "tcp", net.JoinHostPort(host, strconv.Itoa(int(port))))
+		if err != nil {
+			return "", err
+		}
+		defer conn.Close()
+		return fmt.Sprintf("connected to %s", conn.RemoteAddr()), nil
+	}
+}
+
+// sslRequestCode is the request code of the SSLRequest message of the PostgreSQL protocol.
+const sslRequestCode = 80877103
+
+// errTLSNotEnabled marks the TLS stage as skipped, the connections of the console do not use
+// TLS.
+var errTLSNotEnabled = errors.New("TLS is not enabled on the server")
+
+// runTLS asks the server to upgrade the connection to TLS the same way the PostgreSQL clients do.
+// The certificate is checked against the system roots for information only, clusters commonly use
+// certificates signed by a private CA, so an unverified certificate does not fail the stage.
+func (p *Prober) runTLS(ctx context.Context, target Target) StageResult {
+	r := p.run(ctx, StageTLS, CategoryTLS, func(ctx context.Context) (string, error) {
+		conn, err := p.dial(ctx, "tcp", net.JoinHostPort(target.Host, strconv.Itoa(int(target.SqlPort))))
+		if err != nil {
+			return "", err
+		}
+		defer conn.Close()
+		if deadline, ok := ctx.Deadline(); ok {
+			_ = conn.SetDeadline(deadline)
+		}
+
+		msg := make([]byte, 8)
+		binary.BigEndian.PutUint32(msg[0:4], 8)
+		binary.BigEndian.PutUint32(msg[4:8], sslRequestCode)
+		if _, err := conn.Write(msg); err != nil {
+			return "", errors.Wrap(err, "failed to send SSL request")
+		}
+		resp := make([]byte, 1)
+		if _, err := io.ReadFull(conn, resp); err != nil {
+			return "", errors.Wrap(err, "failed to read SSL response")
+		}
+		switch resp[0] {
+		case 'N':
+			return "", errTLSNotEnabled
+		case 'S':
+		default:
+			return "", errors.Errorf("unexpected SSL response %q", resp[0])
+		}
+
+		tlsConn := tls.Client(conn, &tls.Config{ServerName: target.Host, InsecureSkipVerify: true})
+		if err := tlsConn.HandshakeContext(ctx); err != nil {
+			return "", errors.Wrap(err, "TLS handshake failed")
+		}
+		state := tlsConn.ConnectionState()
+		detail := fmt.Sprintf("negotiated %s", tls.VersionName(state.Version))
+		if err := verifyCertificate(target.Host, state.PeerCertificates); err != nil {
+			return fmt.Sprintf("%s, certificate not verified: %v", detail, err), nil
+		}
+		return detail + ", certificate verified", nil
+	})
+	if r.Status == StatusFailed && r.Error == errTLSNotEnabled.Error() {
+		return StageResult{Stage: StageTLS, Status: StatusSkipped, Duration: r.Duration, Detail: errTLSNotEnabled.Error()}
+	}
+	return r
+}
+
+// verifyCertificate verifies the certificate chain of the server against the system roots.
+func verifyCertificate(host string, certs []*x509.Certificate) error {
+	if len(certs) == 0 {
+		return errors.New("no certificate presented")
+	}
+	intermediates := x509.NewCertPool()
+	for _, cert := range certs[1:] {
+		intermediates.AddCert(cert)
+	}
+	_, err := certs[0].Verify(x509.VerifyOptions{DNSName: host, Intermediates: intermediates})
+	return err
+}
+
+// runPgwire authenticates through the PostgreSQL protocol, then checks the database. The server
+// rejects a missing database during the startup, in which case the authentication is unknown.
+func (p *Prober) runPgwire(ctx context.Context, target Target) []StageResult {
+	connStr := sql.ConnString(target.Host, target.SqlPort, target.Username, target.Password, target.Database)
+
+	var conn pgwireConn
+	auth := p.run(ctx, StagePgwireAuth, CategoryUnknown, func(ctx context.Context) (string, error) {
+		var err error
+		conn, err = p.connectPgwire(ctx, connStr)
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("authenticated as %s", target.Username), nil
+	})
+	if auth.Status == StatusFailed {
+		if auth.Category == CategoryDatabaseNotFound {
+			return []StageResult{
+				{Stage: StagePgwireAuth, Status: StatusSkipped, Duration: auth.Duration, Detail: "the database is rejected before the authentication completes"},
+				{Stage: StageDatabase, Status: StatusFailed, Category: CategoryDatabaseNotFound, Error: auth.Error},
+			}
+		}
+		return []StageResult{auth, skipped(StageDatabase, "the authentication failed")}
+	}
+	defer conn.Close(context.WithoutCancel(ctx))
+
+	database := p.run(ctx, StageDatabase, CategoryUnknown, func(ctx context.Context) (string, error) {
+		var name string
+		if err := conn.QueryRow(ctx, "SELECT current_database()").Scan(&name); err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("connected to database %s", name), nil
+	})
+	return []StageResult{auth, database}
+}
+
+func (p *Prober) checkRisectl(target Target) func(ctx context.Context) (string, error) {
+	return func(ctx context.Context) (string, error) {
+		conn, err := p.risectlm.NewConn(ctx, target.Version, target.Host, target.MetaPort, meta.WithVersionOverride(target.RisectlVersion))
+		if err != nil {
+			return "", errors.Wrap(err, "failed to get risectl connection")
+		}
+		info, err := conn.ClusterInfo(ctx)
+		if err != nil {
+			return "", err
+		}
+		if info.Revision == nil {
+			return "cluster info fetched", nil
+		}
+		return fmt.Sprintf("cluster info fetched at revision %d", *info.Revision), nil
+	}
+}
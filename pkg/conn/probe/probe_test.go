@@ -0,0 +1,296 @@
+package probe
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http/httptest"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	mock_http "github.com/risingwavelabs/risingwave-console/pkg/conn/http/mock"
+	"github.com/risingwavelabs/risingwave-console/pkg/conn/meta"
+	mock_meta "github.com/risingwavelabs/risingwave-console/pkg/conn/meta/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestCategorize(t *testing.T) {
+	testCases := []struct {
+		name     string
+		err      error
+		expected ErrorCategory
+	}{
+		{name: "dns", err: &net.DNSError{Err: "no such host", Name: "rw", IsNotFound: true}, expected: CategoryDNS},
+		{name: "dns timeout", err: &net.DNSError{Err: "i/o timeout", Name: "rw", IsTimeout: true}, expected: CategoryTimeout},
+		{name: "refused", err: &net.OpError{Op: "dial", Err: syscall.ECONNREFUSED}, expected: CategoryConnectionRefused},
+		{name: "unreachable", err: &net.OpError{Op: "dial", Err: syscall.EHOSTUNREACH}, expected: CategoryNetworkUnreachable},
+		{name: "deadline", err: fmt.Errorf("dial: %w", context.DeadlineExceeded), expected: CategoryTimeout},
+		{name: "password", err: &pgconn.PgError{Code: "28P01"}, expected: CategoryAuthentication},
+		{name: "database", err: fmt.Errorf("connect: %w", &pgconn.PgError{Code: "3D000"}), expected: CategoryDatabaseNotFound},
+		{name: "other pg error", err: &pgconn.PgError{Code: "XX000"}, expected: CategoryHTTPStatus},
+		{name: "fallback", err: errors.New("unexpected status 404 Not Found"), expected: CategoryHTTPStatus},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, Categorize(tc.err, CategoryHTTPStatus))
+		})
+	}
+}
+
+// listen accepts connections and answers the SSL requests with 'N'.
+func listen(t *testing.T) (net.Listener, int32) {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() { l.Close() })
+	go func() {
+		for {
+			conn, err := l.Accept()
+			if err != nil {
+				return
+			}
+			go func() {
+				defer conn.Close()
+				buf := make([]byte, 8)
+				if _, err := conn.Read(buf); err == nil {
+					_, _ = conn.Write([]byte{'N'})
+				}
+			}()
+		}
+	}()
+	return l, int32(l.Addr().(*net.TCPAddr).Port)
+}
+
+// closedPort returns a port nothing listens on.
+func closedPort(t *testing.T) int32 {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	port := int32(l.Addr().(*net.TCPAddr).Port)
+	l.Close()
+	return port
+}
+
+type fakeRow struct {
+	value string
+}
+
+func (r fakeRow) Scan(dest ...any) error {
+	*dest[0].(*string) = r.value
+	return nil
+}
+
+type fakeConn struct{}
+
+func (fakeConn) QueryRow(_ context.Context, _ string, _ ...any) pgx.Row {
+	return fakeRow{value: "dev"}
+}
+
+func (fakeConn) Close(_ context.Context) error {
+	return nil
+}
+
+func statuses(result *Result) map[Stage]Status {
+	m := map[Stage]Status{}
+	for _, stage := range result.Stages {
+		m[stage.Stage] = stage.Status
+	}
+	return m
+}
+
+func TestProbe(t *testing.T) {
+	_, sqlPort := listen(t)
+	_, metaPort := listen(t)
+	_, httpPort := listen(t)
+
+	testCases := []struct {
+		name       string
+		target     Target
+		lookupErr  error
+		connectErr error
+		expected   map[Stage]Status
+		category   ErrorCategory
+	}{
+		{
+			name:   "all passed",
+			target: Target{Host: "rw", SqlPort: sqlPort, MetaPort: metaPort, HttpPort: httpPort, Username: "root", Database: "dev", Version: "v2.3.0"},
+			expected: map[Stage]Status{
+				StageDNS: StatusPassed, StageTCPMeta: StatusPassed, StageTCPSQL: StatusPassed, StageTCPHTTP: StatusPassed,
+				StageTLS: StatusSkipped, StagePgwireAuth: StatusPassed, StageDatabase: StatusPassed,
+				StageMetaHTTP: StatusPassed, StageRisectl: StatusPassed,
+			},
+		},
+		{
+			name:      "host not found",
+			target:    Target{Host: "rw", SqlPort: sqlPort, MetaPort: metaPort, HttpPort: httpPort},
+			lookupErr: &net.DNSError{Err: "no such host", Name: "rw", IsNotFound: true},
+			expected: map[Stage]Status{
+				StageDNS: StatusFailed, StageTCPMeta: StatusSkipped, StageTCPSQL: StatusSkipped, StageTCPHTTP: StatusSkipped,
+				StageTLS: StatusSkipped, StagePgwireAuth: StatusSkipped, StageDatabase: StatusSkipped,
+				StageMetaHTTP: StatusSkipped, StageRisectl: StatusSkipped,
+			},
+			category: CategoryDNS,
+		},
+		{
+			name:   "ports closed",
+			target: Target{Host: "127.0.0.1", SqlPort: closedPort(t), MetaPort: closedPort(t), HttpPort: httpPort, Username: "root", Version: "v2.3.0"},
+			expected: map[Stage]Status{
+				StageDNS: StatusPassed, StageTCPMeta: StatusFailed, StageTCPSQL: StatusFailed, StageTCPHTTP: StatusPassed,
+				StageTLS: StatusSkipped, StagePgwireAuth: StatusSkipped, StageDatabase: StatusSkipped,
+				StageMetaHTTP: StatusPassed, StageRisectl: StatusSkipped,
+			},
+			category: CategoryConnectionRefused,
+		},
+		{
+			name:       "wrong password",
+			target:     Target{Host: "127.0.0.1", SqlPort: sqlPort, MetaPort: metaPort, HttpPort: httpPort, Username: "root", Password: "wrong"},
+			connectErr: &pgconn.PgError{Code: "28P01", Message: "password authentication failed"},
+			expected: map[Stage]Status{
+				StageDNS: StatusPassed, StageTCPMeta: StatusPassed, StageTCPSQL: StatusPassed, StageTCPHTTP: StatusPassed,
+				StageTLS: StatusSkipped, StagePgwireAuth: StatusFailed, StageDatabase: StatusSkipped,
+				StageMetaHTTP: StatusPassed, StageRisectl: StatusSkipped,
+			},
+			category: CategoryAuthentication,
+		},
+		{
+			name:       "database not found",
+			target:     Target{Host: "127.0.0.1", SqlPort: sqlPort, MetaPort: metaPort, HttpPort: httpPort, Username: "root", Database: "prod"},
+			connectErr: &pgconn.PgError{Code: "3D000", Message: "database \"prod\" does not exist"},
+			expected: map[Stage]Status{
+				StageDNS: StatusPassed, StageTCPMeta: StatusPassed, StageTCPSQL: StatusPassed, StageTCPHTTP: StatusPassed,
+				StageTLS: StatusSkipped, StagePgwireAuth: StatusSkipped, StageDatabase: StatusFailed,
+				StageMetaHTTP: StatusPassed, StageRisectl: StatusSkipped,
+			},
+			category: CategoryDatabaseNotFound,
+		},
+		{
+			// the meta and http ports are closed, but their stages are not selected
+			name: "selected stages",
+			target: Target{
+				Host: "rw", SqlPort: sqlPort, MetaPort: closedPort(t), HttpPort: closedPort(t), Username: "root", Database: "dev", Version: "v2.3.0",
+				Stages: []Stage{StageDNS, StageTCPSQL, StageTLS, StagePgwireAuth, StageDatabase},
+			},
+			expected: map[Stage]Status{
+				StageDNS: StatusPassed, StageTCPSQL: StatusPassed,
+				StageTLS: StatusSkipped, StagePgwireAuth: StatusPassed, StageDatabase: StatusPassed,
+			},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			risectlm := mock_meta.NewMockRisectlManagerInterface(ctrl)
+			risectlcm := mock_meta.NewMockRisectlConn(ctrl)
+			metahttp := mock_http.NewMockMetaHttpManagerInterface(ctrl)
+
+			metahttp.EXPECT().Ping(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+			risectlm.EXPECT().NewConn(gomock.Any(), "v2.3.0", "127.0.0.1", metaPort, gomock.Any()).Return(risectlcm, nil).AnyTimes()
+			risectlcm.EXPECT().ClusterInfo(gomock.Any()).Return(&meta.ClusterInfo{}, nil).AnyTimes()
+
+			var d net.Dialer
+			p := &Prober{
+				risectlm:     risectlm,
+				metahttp:     metahttp,
+				stageTimeout: time.Second,
+				lookupHost: func(_ context.Context, host string) ([]string, error) {
+					if tc.lookupErr != nil {
+						return nil, tc.lookupErr
+					}
+					return []string{"127.0.0.1"}, nil
+				},
+				dial: func(ctx context.Context, network, address string) (net.Conn, error) {
+					host, port, _ := net.SplitHostPort(address)
+					if host == "rw" {
+						host = "127.0.0.1"
+					}
+					return d.DialContext(ctx, network, net.JoinHostPort(host, port))
+				},
+				connectPgwire: func(_ context.Context, _ string) (pgwireConn, error) {
+					if tc.connectErr != nil {
+						return nil, tc.connectErr
+					}
+					return fakeConn{}, nil
+				},
+			}
+			if tc.target.Host == "rw" {
+				risectlm.EXPECT().NewConn(gomock.Any(), "v2.3.0", "rw", metaPort, gomock.Any()).Return(risectlcm, nil).AnyTimes()
+			}
+
+			result := p.Probe(context.Background(), tc.target)
+			assert.Equal(t, tc.expected, statuses(result))
+			assert.Len(t, result.Stages, len(tc.expected))
+			assert.Equal(t, tc.category == "", result.Success())
+			if tc.category != "" {
+				var categories []ErrorCategory
+				for _, stage := range result.Stages {
+					if stage.Status == StatusFailed {
+						categories = append(categories, stage.Category)
+					}
+				}
+				assert.Contains(t, categories, tc.category)
+			}
+		})
+	}
+}
+
+func TestResultPassed(t *testing.T) {
+	result := &Result{Stages: []StageResult{
+		{Stage: StageTCPSQL, Status: StatusPassed},
+		{Stage: StageTCPMeta, Status: StatusFailed},
+		{Stage: StagePgwireAuth, Status: StatusPassed},
+		{Stage: StageDatabase, Status: StatusSkipped},
+	}}
+	assert.True(t, result.Passed(StageTCPSQL, StagePgwireAuth))
+	assert.False(t, result.Passed(StageTCPSQL, StageDatabase))
+	assert.False(t, result.Passed(StageRisectl))
+	assert.False(t, result.Success())
+}
+
+// listenTLS accepts the SSL request of the PostgreSQL protocol and completes the handshake with
+// the self-signed certificate of the test server.
+func listenTLS(t *testing.T) int32 {
+	srv := httptest.NewTLSServer(nil)
+	config := srv.TLS.Clone()
+	srv.Close()
+
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() { l.Close() })
+	go func() {
+		for {
+			conn, err := l.Accept()
+			if err != nil {
+				return
+			}
+			go func() {
+				defer conn.Close()
+				if _, err := io.ReadFull(conn, make([]byte, 8)); err != nil {
+					return
+				}
+				if _, err := conn.Write([]byte{'S'}); err != nil {
+					return
+				}
+				_ = tls.Server(conn, config).Handshake()
+			}()
+		}
+	}()
+	return int32(l.Addr().(*net.TCPAddr).Port)
+}
+
+func TestRunTLSUnverifiedCertificate(t *testing.T) {
+	port := listenTLS(t)
+	var d net.Dialer
+	p := &Prober{stageTimeout: time.Second, dial: d.DialContext}
+
+	r := p.runTLS(context.Background(), Target{Host: "127.0.0.1", SqlPort: port})
+	assert.Equal(t, StatusPassed, r.Status)
+	assert.Contains(t, r.Detail, "certificate not verified")
+}
@@ -0,0 +1,244 @@
+package sql
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"slices"
+	"sort"
+
+	"github.com/pkg/errors"
+	"github.com/risingwavelabs/risingwave-console/pkg/zgen/apigen"
+)
+
+const getCatalogRelationsSQL = `SELECT
+    rw_relations.id            AS relation_id,
+    rw_schemas.name            AS schema,
+    rw_relations.name          AS relation_name,
+    rw_relations.relation_type AS relation_type,
+    rw_relations.definition    AS definition
+FROM rw_relations
+JOIN rw_schemas ON rw_schemas.id = rw_relations.schema_id
+WHERE rw_relations.relation_type != 'system table'
+`
+
+const getCatalogColumnsSQL = `SELECT
+    rw_columns.relation_id AS relation_id,
+    rw_columns.name        AS column_name,
+    rw_columns.data_type   AS column_type
+FROM rw_columns
+JOIN rw_relations ON rw_relations.id = rw_columns.relation_id
+WHERE rw_relations.relation_type != 'system table'
+ORDER BY rw_columns.relation_id, rw_columns.position
+`
+
+const getCatalogDependSQL = `SELECT objid, refobjid FROM rw_depend`
+
+// CatalogColumn is a column of a relation in a catalog snapshot.
+type CatalogColumn struct {
+	Name string `json:"name"`
+	Type string `json:"type"`
+}
+
+// CatalogRelation is a relation in a catalog snapshot.
+type CatalogRelation struct {
+	ID           int32           `json:"id"`
+	Schema       string          `json:"schema"`
+	Name         string          `json:"name"`
+	Type         string          `json:"type"`
+	Definition   string          `json:"definition"`
+	Columns      []CatalogColumn `json:"columns"`
+	Dependencies []int32         `json:"dependencies"`
+}
+
+// Catalog is a snapshot of the relations in a database, sorted by relation ID.
+type Catalog struct {
+	Relations []CatalogRelation `json:"relations"`
+}
+
+// CatalogChange is a change of a relation between two catalog snapshots.
+type CatalogChange struct {
+	Relation   CatalogRelation
+	ChangeType apigen.CatalogChangeType
+	Details    apigen.CatalogChangeDetails
+}
+
+// FetchCatalog takes a snapshot of the relations, columns and dependencies in the database.
+func FetchCatalog(ctx context.Context, connStr string) (*Catalog, error) {
+	relations, err := Query(ctx, connStr, getCatalogRelationsSQL, nil)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to query relations")
+	}
+	columns, err := Query(ctx, connStr, getCatalogColumnsSQL, nil)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to query columns")
+	}
+	depend, err := Query(ctx, connStr, getCatalogDependSQL, nil)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to query dependencies")
+	}
+
+	idToColumns := make(map[int32][]CatalogColumn)
+	for _, row := range columns.Rows {
+		id := row["relation_id"].(int32)
+		idToColumns[id] = append(idToColumns[id], CatalogColumn{
+			Name: row["column_name"].(string),
+			Type: row["column_type"].(string),
+		})
+	}
+
+	idToDepends := make(map[int32][]int32)
+	for _, row := range depend.Rows {
+		objid := row["objid"].(int32)
+		idToDepends[objid] = append(idToDepends[objid], row["refobjid"].(int32))
+	}
+
+	catalog := &Catalog{Relations: []CatalogRelation{}}
+	for _, row := range relations.Rows {
+		id := row["relation_id"].(int32)
+		definition, _ := row["definition"].(string)
+		dependencies := idToDepends[id]
+		slices.Sort(dependencies)
+		catalog.Relations = append(catalog.Relations, CatalogRelation{
+			ID:           id,
+			Schema:       row["schema"].(string),
+			Name:         row["relation_name"].(string),
+			Type:         row["relation_type"].(string),
+			Definition:   definition,
+			Columns:      idToColumns[id],
+			Dependencies: dependencies,
+		})
+	}
+	sort.Slice(catalog.Relations, func(i, j int) bool {
+		return catalog.Relations[i].ID < catalog.Relations[j].ID
+	})
+	return catalog, nil
+}
+
+// Hash returns the hex encoded sha256 of the catalog, it is stable since the catalog is sorted.
+func (c *Catalog) Hash() (string, error) {
+	raw, err := json.Marshal(c)
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to marshal catalog")
+	}
+	sum := sha256.Sum256(raw)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// DiffCatalog returns the relations created, dropped and altered from prev to curr,
+// ordered by relation ID. A nil prev means an empty catalog.
+func DiffCatalog(prev *Catalog, curr *Catalog) []CatalogChange {
+	prevRelations := make(map[int32]CatalogRelation)
+	if prev != nil {
+		for _, r := range prev.Relations {
+			prevRelations[r.ID] = r
+		}
+	}
+	currRelations := make(map[int32]CatalogRelation, len(curr.Relations))
+	for _, r := range curr.Relations {
+		currRelations[r.ID] = r
+	}
+
+	changes := []CatalogChange{}
+	for _, r := range curr.Relations {
+		p, ok := prevRelations[r.ID]
+		if !ok {
+			changes = append(changes, CatalogChange{
+				Relation:   r,
+				ChangeType: apigen.Created,
+				Details: apigen.CatalogChangeDetails{
+					Definition: nonEmpty(r.Definition),
+				},
+			})
+			continue
+		}
+		if details, altered := diffRelation(p, r); altered {
+			changes = append(changes, CatalogChange{
+				Relation:   r,
+				ChangeType: apigen.Altered,
+				Details:    details,
+			})
+		}
+	}
+	if prev != nil {
+		for _, r := range prev.Relations {
+			if _, ok := currRelations[r.ID]; !ok {
+				changes = append(changes, CatalogChange{
+					Relation:   r,
+					ChangeType: apigen.Dropped,
+					Details: apigen.CatalogChangeDetails{
+						PreviousDefinition: nonEmpty(r.Definition),
+					},
+				})
+			}
+		}
+	}
+	sort.SliceStable(changes, func(i, j int) bool {
+		return changes[i].Relation.ID < changes[j].Relation.ID
+	})
+	return changes
+}
+
+func diffRelation(prev CatalogRelation, curr CatalogRelation) (apigen.CatalogChangeDetails, bool) {
+	details := apigen.CatalogChangeDetails{}
+	altered := false
+
+	if prev.Schema != curr.Schema || prev.Name != curr.Name {
+		details.PreviousSchema = &prev.Schema
+		details.PreviousName = &prev.Name
+		altered = true
+	}
+	if prev.Definition != curr.Definition {
+		details.PreviousDefinition = nonEmpty(prev.Definition)
+		details.Definition = nonEmpty(curr.Definition)
+		altered = true
+	}
+
+	var added, changed, dropped []string
+	prevColumns := make(map[string]string, len(prev.Columns))
+	for _, c := range prev.Columns {
+		prevColumns[c.Name] = c.Type
+	}
+	currColumns := make(map[string]string, len(curr.Columns))
+	for _, c := range curr.Columns {
+		currColumns[c.Name] = c.Type
+		if t, ok := prevColumns[c.Name]; !ok {
+			added = append(added, c.Name)
+		} else if t != c.Type {
+			changed = append(changed, c.Name)
+		}
+	}
+	for _, c := range prev.Columns {
+		if _, ok := currColumns[c.Name]; !ok {
+			dropped = append(dropped, c.Name)
+		}
+	}
+	if len(added) > 0 {
+		details.AddedColumns = &added
+		altered = true
+	}
+	if len(changed) > 0 {
+		details.ChangedColumns = &changed
+		altered = true
+	}
+	if len(dropped) > 0 {
+		details.DroppedColumns = &dropped
+		altered = true
+	}
+
+	if !slices.Equal(prev.Dependencies, curr.Dependencies) {
+		prevDependencies, currDependencies := prev.Dependencies, curr.Dependencies
+		details.PreviousDependencies = &prevDependencies
+		details.Dependencies = &currDependencies
+		altered = true
+	}
+	return details, altered
+}
+
+func nonEmpty(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
@@ -0,0 +1,60 @@
+package sql
+
+import (
+	"testing"
+
+	"github.com/risingwavelabs/risingwave-console/pkg/utils"
+	"github.com/risingwavelabs/risingwave-console/pkg/zgen/apigen"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDiffCatalog(t *testing.T) {
+	prev := &Catalog{Relations: []CatalogRelation{
+		{ID: 1, Schema: "public", Name: "t1", Type: "table", Definition: "CREATE TABLE t1 (v1 INT)", Columns: []CatalogColumn{{Name: "v1", Type: "integer"}}},
+		{ID: 2, Schema: "public", Name: "mv1", Type: "materialized view", Definition: "CREATE MATERIALIZED VIEW mv1 AS SELECT v1 FROM t1", Dependencies: []int32{1}},
+		{ID: 3, Schema: "public", Name: "s1", Type: "sink"},
+	}}
+	curr := &Catalog{Relations: []CatalogRelation{
+		{ID: 1, Schema: "public", Name: "t1", Type: "table", Definition: "CREATE TABLE t1 (v1 BIGINT, v2 INT)", Columns: []CatalogColumn{{Name: "v1", Type: "bigint"}, {Name: "v2", Type: "integer"}}},
+		{ID: 2, Schema: "public", Name: "mv1", Type: "materialized view", Definition: "CREATE MATERIALIZED VIEW mv1 AS SELECT v1 FROM t1", Dependencies: []int32{1}},
+		{ID: 4, Schema: "public", Name: "mv2", Type: "materialized view", Definition: "CREATE MATERIALIZED VIEW mv2 AS SELECT v2 FROM t1", Dependencies: []int32{1}},
+	}}
+
+	changes := DiffCatalog(prev, curr)
+	require.Len(t, changes, 3)
+
+	assert.Equal(t, apigen.Altered, changes[0].ChangeType)
+	assert.Equal(t, int32(1), changes[0].Relation.ID)
+	assert.Equal(t, apigen.CatalogChangeDetails{
+		PreviousDefinition: utils.Ptr("CREATE TABLE t1 (v1 INT)"),
+		Definition:         utils.Ptr("CREATE TABLE t1 (v1 BIGINT, v2 INT)"),
+		AddedColumns:       &[]string{"v2"},
+		ChangedColumns:     &[]string{"v1"},
+	}, changes[0].Details)
+
+	assert.Equal(t, apigen.Dropped, changes[1].ChangeType)
+	assert.Equal(t, int32(3), changes[1].Relation.ID)
+
+	assert.Equal(t, apigen.Created, changes[2].ChangeType)
+	assert.Equal(t, int32(4), changes[2].Relation.ID)
+	assert.Equal(t, utils.Ptr("CREATE MATERIALIZED VIEW mv2 AS SELECT v2 FROM t1"), changes[2].Details.Definition)
+
+	assert.Empty(t, DiffCatalog(curr, curr))
+	assert.Len(t, DiffCatalog(nil, curr), 3)
+}
+
+func TestCatalogHash(t *testing.T) {
+	c1 := &Catalog{Relations: []CatalogRelation{{ID: 1, Schema: "public", Name: "t1", Type: "table"}}}
+	c2 := &Catalog{Relations: []CatalogRelation{{ID: 1, Schema: "public", Name: "t2", Type: "table"}}}
+
+	h1, err := c1.Hash()
+	require.NoError(t, err)
+	h2, err := c2.Hash()
+	require.NoError(t, err)
+	again, err := c1.Hash()
+	require.NoError(t, err)
+
+	assert.Equal(t, h1, again)
+	assert.NotEqual(t, h1, h2)
+}
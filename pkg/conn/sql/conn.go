@@ -22,27 +22,33 @@ type Result struct {
 }
 
 type SQLConnectionInterface interface {
-	Query(context.Context, string, bool) (*Result, error)
+	Query(context.Context, string, map[string]string) (*Result, error)
 }
 
 type SimpleSQLConnection struct {
 	connStr string
 }
 
-func (s *SimpleSQLConnection) Query(ctx context.Context, query string, backgroundDDL bool) (*Result, error) {
-	return Query(ctx, s.connStr, query, backgroundDDL)
+func (s *SimpleSQLConnection) Query(ctx context.Context, query string, settings map[string]string) (*Result, error) {
+	return Query(ctx, s.connStr, query, settings)
 }
 
-func Query(ctx context.Context, connStr string, query string, backgroundDDL bool) (*Result, error) {
+// Query runs the query in a new session. The session settings are applied
+// with SET statements before the query, nil means no extra settings.
+func Query(ctx context.Context, connStr string, query string, settings map[string]string) (*Result, error) {
+	stmts, err := setStatements(settings)
+	if err != nil {
+		return nil, err
+	}
+
 	conn, err := pgx.Connect(ctx, connStr)
 	if err != nil {
 		return nil, err
 	}
 	defer conn.Close(ctx)
 
-	if backgroundDDL {
-		_, err := conn.Exec(ctx, "SET BACKGROUND_DDL = true")
-		if err != nil {
+	for _, stmt := range stmts {
+		if _, err := conn.Exec(ctx, stmt); err != nil {
 			return nil, errors.Wrap(ErrQueryFailed, err.Error())
 		}
 	}
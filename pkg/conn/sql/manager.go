@@ -2,7 +2,6 @@ package sql
 
 import (
 	"context"
-	"fmt"
 
 	"github.com/risingwavelabs/risingwave-console/pkg/utils"
 	"github.com/risingwavelabs/risingwave-console/pkg/zcore/model"
@@ -33,7 +32,7 @@ func (s *SQLConnectionManager) GetConn(ctx context.Context, databaseID int32) (S
 		return nil, err
 	}
 
-	connStr := fmt.Sprintf("postgres://%s:%s@%s:%d/%s?sslmode=disable", databaseInfo.Username, utils.UnwrapOrDefault(databaseInfo.Password, ""), clusterInfo.Host, clusterInfo.SqlPort, databaseInfo.Database)
+	connStr := ConnString(clusterInfo.Host, clusterInfo.SqlPort, databaseInfo.Username, utils.UnwrapOrDefault(databaseInfo.Password, ""), databaseInfo.Database)
 
 	return &SimpleSQLConnection{
 		connStr: connStr,
@@ -0,0 +1,39 @@
+package sql
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/risingwavelabs/risingwave-console/pkg/conn/meta"
+	"github.com/risingwavelabs/risingwave-console/pkg/utils"
+)
+
+// the meta node exposes the snapshots of its backup storage in the rw_meta_snapshot system
+// table, it is used when risectl can not list them.
+const getMetaSnapshotsSQL = `SELECT *
+FROM rw_catalog.rw_meta_snapshot
+ORDER BY meta_snapshot_id
+`
+
+// ListMetaSnapshots lists the meta snapshots of the cluster the database belongs to.
+func ListMetaSnapshots(ctx context.Context, connStr string) ([]meta.MetaSnapshot, error) {
+	result, err := Query(ctx, connStr, getMetaSnapshotsSQL, nil)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to query meta snapshots")
+	}
+
+	snapshots := make([]meta.MetaSnapshot, 0, len(result.Rows))
+	for _, row := range result.Rows {
+		id, ok := row["meta_snapshot_id"].(int64)
+		if !ok {
+			return nil, errors.Errorf("unexpected meta snapshot id %v", row["meta_snapshot_id"])
+		}
+		snapshot := meta.MetaSnapshot{ID: id}
+		// the column is not available in every version
+		if epoch, ok := row["max_committed_epoch"].(int64); ok {
+			snapshot.CreatedAt = utils.Ptr(meta.EpochTime(epoch))
+		}
+		snapshots = append(snapshots, snapshot)
+	}
+	return snapshots, nil
+}
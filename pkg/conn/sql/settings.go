@@ -0,0 +1,71 @@
+package sql
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+var ErrInvalidSessionSetting = errors.New("invalid session setting")
+
+const (
+	SettingBackgroundDDL    = "background_ddl"
+	SettingStatementTimeout = "statement_timeout"
+	SettingSearchPath       = "search_path"
+)
+
+// supportedSessionSettings are the session variables that can be set from the console.
+var supportedSessionSettings = map[string]struct{}{
+	SettingBackgroundDDL:             {},
+	SettingStatementTimeout:          {},
+	SettingSearchPath:                {},
+	"streaming_parallelism":          {},
+	"query_mode":                     {},
+	"rw_streaming_enable_delta_join": {},
+}
+
+// ValidateSessionSettings checks that all settings are supported and have a non-empty value.
+func ValidateSessionSettings(settings map[string]string) error {
+	for k, v := range settings {
+		if _, ok := supportedSessionSettings[strings.ToLower(k)]; !ok {
+			return errors.Wrapf(ErrInvalidSessionSetting, "unsupported session setting %s", k)
+		}
+		if strings.TrimSpace(v) == "" {
+			return errors.Wrapf(ErrInvalidSessionSetting, "empty value for session setting %s", k)
+		}
+	}
+	return nil
+}
+
+// setStatements builds the SET statements of the settings, ordered by the setting name.
+func setStatements(settings map[string]string) ([]string, error) {
+	if err := ValidateSessionSettings(settings); err != nil {
+		return nil, err
+	}
+
+	keys := make([]string, 0, len(settings))
+	for k := range settings {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	stmts := make([]string, 0, len(keys))
+	for _, k := range keys {
+		values := []string{settings[k]}
+		if strings.ToLower(k) == SettingSearchPath {
+			values = strings.Split(settings[k], ",")
+		}
+		quoted := make([]string, len(values))
+		for i, v := range values {
+			quoted[i] = quoteLiteral(strings.TrimSpace(v))
+		}
+		stmts = append(stmts, fmt.Sprintf("SET %s = %s", strings.ToLower(k), strings.Join(quoted, ", ")))
+	}
+	return stmts, nil
+}
+
+func quoteLiteral(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", "''") + "'"
+}
@@ -1,6 +1,24 @@
 package sql
 
-import "fmt"
+import (
+	"fmt"
+	"net"
+	"net/url"
+	"strconv"
+)
+
+// ConnString builds the postgres:// connection string of a database, the credentials and the
+// database name are escaped.
+func ConnString(host string, port int32, username, password, database string) string {
+	u := url.URL{
+		Scheme:   "postgres",
+		User:     url.UserPassword(username, password),
+		Host:     net.JoinHostPort(host, strconv.Itoa(int(port))),
+		Path:     "/" + database,
+		RawQuery: "sslmode=disable",
+	}
+	return u.String()
+}
 
 func getDataTypeName(oid uint32) string {
 	typeMap := map[uint32]string{
@@ -0,0 +1,23 @@
+package sql
+
+import (
+	"testing"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConnString(t *testing.T) {
+	connStr := ConnString("localhost", 4566, "root", "", "dev")
+	assert.Equal(t, "postgres://root:@localhost:4566/dev?sslmode=disable", connStr)
+
+	connStr = ConnString("::1", 4566, "us@er", "p@ss:w/rd?#", "my db")
+	config, err := pgx.ParseConfig(connStr)
+	require.NoError(t, err)
+	assert.Equal(t, "::1", config.Host)
+	assert.Equal(t, uint16(4566), config.Port)
+	assert.Equal(t, "us@er", config.User)
+	assert.Equal(t, "p@ss:w/rd?#", config.Password)
+	assert.Equal(t, "my db", config.Database)
+}
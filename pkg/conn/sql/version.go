@@ -0,0 +1,42 @@
+package sql
+
+import (
+	"context"
+	"regexp"
+
+	"github.com/pkg/errors"
+)
+
+var ErrUnknownVersion = errors.New("unknown RisingWave version")
+
+const getVersionSQL = `SELECT version() AS version`
+
+// the version of RisingWave is reported as the suffix of the compatible PostgreSQL version,
+// e.g. "PostgreSQL 13.14.0-RisingWave-2.3.0 (0e9d1d7f2a0fb82cc3a6b4d7c2d1a9c3f1b2e3d4)"
+var risingWaveVersionRe = regexp.MustCompile(`RisingWave-v?([0-9]+\.[0-9]+\.[0-9]+(?:-[0-9A-Za-z.]+)?)`)
+
+// ParseRisingWaveVersion extracts the version of RisingWave from the result of version(),
+// e.g. "v2.3.0".
+func ParseRisingWaveVersion(version string) (string, error) {
+	m := risingWaveVersionRe.FindStringSubmatch(version)
+	if m == nil {
+		return "", errors.Wrapf(ErrUnknownVersion, "%q", version)
+	}
+	return "v" + m[1], nil
+}
+
+// GetRisingWaveVersion returns the version of the cluster the database belongs to.
+func GetRisingWaveVersion(ctx context.Context, connStr string) (string, error) {
+	result, err := Query(ctx, connStr, getVersionSQL, nil)
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to query version")
+	}
+	if len(result.Rows) == 0 {
+		return "", errors.Wrapf(ErrUnknownVersion, "no version returned")
+	}
+	version, ok := result.Rows[0]["version"].(string)
+	if !ok {
+		return "", errors.Errorf("unexpected version %v", result.Rows[0]["version"])
+	}
+	return ParseRisingWaveVersion(version)
+}
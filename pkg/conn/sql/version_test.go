@@ -0,0 +1,33 @@
+package sql
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseRisingWaveVersion(t *testing.T) {
+	testCases := []struct {
+		name    string
+		version string
+		want    string
+		err     bool
+	}{
+		{name: "release", version: "PostgreSQL 13.14.0-RisingWave-2.3.0 (0e9d1d7f2a0fb82cc3a6b4d7c2d1a9c3f1b2e3d4)", want: "v2.3.0"},
+		{name: "pre-release", version: "PostgreSQL 13.14.0-RisingWave-2.4.0-alpha (abc)", want: "v2.4.0-alpha"},
+		{name: "prefixed", version: "PostgreSQL 13.14.0-RisingWave-v2.2.1 (abc)", want: "v2.2.1"},
+		{name: "postgres", version: "PostgreSQL 16.2 on x86_64-pc-linux-gnu", err: true},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := ParseRisingWaveVersion(tc.version)
+			if tc.err {
+				require.ErrorIs(t, err, ErrUnknownVersion)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
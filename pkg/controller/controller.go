@@ -1,13 +1,19 @@
 package controller
 
 import (
+	"bufio"
+	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"strconv"
 	"strings"
 
 	"github.com/cloudcarver/anchor/pkg/auth"
+	"github.com/gofiber/contrib/websocket"
 	"github.com/gofiber/fiber/v2"
 	"github.com/risingwavelabs/risingwave-console/pkg/conn/metricsstore"
+	"github.com/risingwavelabs/risingwave-console/pkg/conn/sql"
 	"github.com/risingwavelabs/risingwave-console/pkg/service"
 	"github.com/risingwavelabs/risingwave-console/pkg/utils"
 	"github.com/risingwavelabs/risingwave-console/pkg/zgen/apigen"
@@ -127,6 +133,9 @@ func (controller *Controller) ImportDatabase(c *fiber.Ctx) error {
 
 	database, err := controller.svc.ImportDatabase(c.Context(), params, orgID)
 	if err != nil {
+		if errors.Is(err, sql.ErrInvalidSessionSetting) {
+			return c.Status(fiber.StatusBadRequest).SendString(err.Error())
+		}
 		return err
 	}
 
@@ -147,13 +156,13 @@ func (controller *Controller) DeleteDatabase(c *fiber.Ctx, id int32) error {
 	return c.SendStatus(fiber.StatusNoContent)
 }
 
-func (controller *Controller) GetDatabase(c *fiber.Ctx, id int32) error {
+func (controller *Controller) GetDatabase(c *fiber.Ctx, id int32, params apigen.GetDatabaseParams) error {
 	orgID, err := auth.GetOrgID(c)
 	if err != nil {
 		return c.Status(fiber.StatusUnauthorized).SendString("missing orgID in request context")
 	}
 
-	database, err := controller.svc.GetDatabase(c.Context(), id, orgID)
+	database, err := controller.svc.GetDatabase(c.Context(), id, params, orgID)
 	if err != nil {
 		if errors.Is(err, service.ErrDatabaseNotFound) {
 			return c.SendStatus(fiber.StatusNotFound)
@@ -180,6 +189,9 @@ func (controller *Controller) UpdateDatabase(c *fiber.Ctx, id int32) error {
 		if errors.Is(err, service.ErrDatabaseNotFound) {
 			return c.SendStatus(fiber.StatusNotFound)
 		}
+		if errors.Is(err, sql.ErrInvalidSessionSetting) {
+			return c.Status(fiber.StatusBadRequest).SendString(err.Error())
+		}
 		return err
 	}
 
@@ -200,6 +212,111 @@ func (controller *Controller) ListDatabases(c *fiber.Ctx) error {
 	return c.Status(fiber.StatusOK).JSON(databases)
 }
 
+func (controller *Controller) ListDatabaseRelations(c *fiber.Ctx, id int32, params apigen.ListDatabaseRelationsParams) error {
+	orgID, err := auth.GetOrgID(c)
+	if err != nil {
+		return c.Status(fiber.StatusUnauthorized).SendString("missing orgID in request context")
+	}
+
+	relations, err := controller.svc.ListDatabaseRelations(c.Context(), id, params, orgID)
+	if err != nil {
+		if errors.Is(err, service.ErrDatabaseNotFound) {
+			return c.SendStatus(fiber.StatusNotFound)
+		}
+		return err
+	}
+
+	return c.Status(fiber.StatusOK).JSON(relations)
+}
+
+func (controller *Controller) ListDatabaseCatalogChanges(c *fiber.Ctx, id int32, params apigen.ListDatabaseCatalogChangesParams) error {
+	orgID, err := auth.GetOrgID(c)
+	if err != nil {
+		return c.Status(fiber.StatusUnauthorized).SendString("missing orgID in request context")
+	}
+
+	events, err := controller.svc.ListDatabaseCatalogChanges(c.Context(), id, params, orgID)
+	if err != nil {
+		if errors.Is(err, service.ErrDatabaseNotFound) {
+			return c.SendStatus(fiber.StatusNotFound)
+		}
+		return err
+	}
+
+	return c.Status(fiber.StatusOK).JSON(events)
+}
+
+func (controller *Controller) GetDatabaseLineage(c *fiber.Ctx, id int32, params apigen.GetDatabaseLineageParams) error {
+	orgID, err := auth.GetOrgID(c)
+	if err != nil {
+		return c.Status(fiber.StatusUnauthorized).SendString("missing orgID in request context")
+	}
+
+	graph, err := controller.svc.GetDatabaseLineage(c.Context(), id, params, orgID)
+	if err != nil {
+		if errors.Is(err, service.ErrDatabaseNotFound) || errors.Is(err, service.ErrRelationNotFound) {
+			return c.Status(fiber.StatusNotFound).SendString(err.Error())
+		}
+		if errors.Is(err, service.ErrInvalidLineageParams) {
+			return c.Status(fiber.StatusBadRequest).SendString(err.Error())
+		}
+		return err
+	}
+
+	return c.Status(fiber.StatusOK).JSON(graph)
+}
+
+func (controller *Controller) ExportDatabaseLineage(c *fiber.Ctx, id int32, params apigen.ExportDatabaseLineageParams) error {
+	orgID, err := auth.GetOrgID(c)
+	if err != nil {
+		return c.Status(fiber.StatusUnauthorized).SendString("missing orgID in request context")
+	}
+
+	raw, err := controller.svc.ExportDatabaseLineage(c.Context(), id, params, orgID)
+	if err != nil {
+		if errors.Is(err, service.ErrDatabaseNotFound) || errors.Is(err, service.ErrRelationNotFound) {
+			return c.Status(fiber.StatusNotFound).SendString(err.Error())
+		}
+		if errors.Is(err, service.ErrInvalidLineageParams) {
+			return c.Status(fiber.StatusBadRequest).SendString(err.Error())
+		}
+		return err
+	}
+
+	switch params.Format {
+	case apigen.Dot:
+		c.Set(fiber.HeaderContentType, "text/vnd.graphviz")
+	default:
+		c.Set(fiber.HeaderContentType, fiber.MIMEApplicationJSON)
+	}
+	return c.Status(fiber.StatusOK).Send(raw)
+}
+
+func (controller *Controller) AnalyzeDatabaseImpact(c *fiber.Ctx, id int32) error {
+	var params apigen.ImpactAnalysisRequest
+	if err := c.BodyParser(&params); err != nil {
+		return c.SendStatus(fiber.StatusBadRequest)
+	}
+
+	orgID, err := auth.GetOrgID(c)
+	if err != nil {
+		return c.Status(fiber.StatusUnauthorized).SendString("missing orgID in request context")
+	}
+
+	analysis, err := controller.svc.AnalyzeDatabaseImpact(c.Context(), id, params, orgID)
+	if err != nil {
+		if errors.Is(err, service.ErrDatabaseNotFound) {
+			return c.Status(fiber.StatusNotFound).SendString(err.Error())
+		}
+		if errors.Is(err, sql.ErrInvalidSessionSetting) {
+			return c.Status(fiber.StatusBadRequest).SendString(err.Error())
+		}
+		return err
+	}
+
+	return c.Status(fiber.StatusOK).JSON(analysis)
+}
+
 func (controller *Controller) GetDDLProgress(c *fiber.Ctx, id int32) error {
 	orgID, err := auth.GetOrgID(c)
 	if err != nil {
@@ -258,11 +375,17 @@ func (controller *Controller) QueryDatabase(c *fiber.Ctx, id int32) error {
 		return c.Status(fiber.StatusUnauthorized).SendString("missing orgID in request context")
 	}
 
-	result, err := controller.svc.QueryDatabase(c.Context(), id, params, orgID, utils.UnwrapOrDefault(params.BackgroundDDL, false))
+	result, err := controller.svc.QueryDatabase(c.Context(), id, params, orgID)
 	if err != nil {
 		if errors.Is(err, service.ErrDatabaseNotFound) {
 			return c.Status(fiber.StatusNotFound).SendString(fmt.Sprintf("database %d not found", id))
 		}
+		if errors.Is(err, sql.ErrInvalidSessionSetting) {
+			return c.Status(fiber.StatusBadRequest).SendString(err.Error())
+		}
+		if errors.Is(err, service.ErrDropConfirmationRequired) {
+			return c.Status(fiber.StatusPreconditionRequired).SendString(err.Error())
+		}
 		return err
 	}
 
@@ -357,12 +480,39 @@ func (controller *Controller) UpdateClusterAutoBackupConfig(c *fiber.Ctx, id int
 
 	err = controller.svc.UpdateClusterAutoBackupConfig(c.Context(), id, params, orgID)
 	if err != nil {
+		if errors.Is(err, service.ErrInvalidRetentionPolicy) {
+			return c.Status(fiber.StatusBadRequest).SendString(err.Error())
+		}
 		return err
 	}
 
 	return c.SendStatus(fiber.StatusOK)
 }
 
+func (controller *Controller) PreviewClusterSnapshotRetention(c *fiber.Ctx, id int32) error {
+	orgID, err := auth.GetOrgID(c)
+	if err != nil {
+		return c.Status(fiber.StatusUnauthorized).SendString("missing orgID in request context")
+	}
+
+	var params apigen.SnapshotRetentionPolicy
+	if err := c.BodyParser(&params); err != nil {
+		return c.SendStatus(fiber.StatusBadRequest)
+	}
+
+	decisions, err := controller.svc.PreviewClusterSnapshotRetention(c.Context(), id, params, orgID)
+	if err != nil {
+		if errors.Is(err, service.ErrInvalidRetentionPolicy) {
+			return c.Status(fiber.StatusBadRequest).SendString(err.Error())
+		}
+		if errors.Is(err, service.ErrClusterNotFound) {
+			return c.SendStatus(fiber.StatusNotFound)
+		}
+		return err
+	}
+	return c.Status(fiber.StatusOK).JSON(decisions)
+}
+
 func (controller *Controller) GetClusterAutoDiagnosticConfig(c *fiber.Ctx, id int32) error {
 	orgID, err := auth.GetOrgID(c)
 	if err != nil {
@@ -416,6 +566,9 @@ func (controller *Controller) TestClusterConnection(c *fiber.Ctx) error {
 
 	conn, err := controller.svc.TestClusterConnection(c.Context(), params, orgID)
 	if err != nil {
+		if errors.Is(err, service.ErrInvalidClusterVersion) {
+			return c.Status(fiber.StatusBadRequest).SendString(err.Error())
+		}
 		return err
 	}
 
@@ -428,157 +581,998 @@ func (controller *Controller) RunRisectlCommand(c *fiber.Ctx, id int32) error {
 		return c.Status(fiber.StatusUnauthorized).SendString("missing orgID in request context")
 	}
 
+	userID, err := auth.GetUserID(c)
+	if err != nil {
+		return c.Status(fiber.StatusUnauthorized).SendString("missing userID in request context")
+	}
+
 	var params apigen.RisectlCommand
 	if err := c.BodyParser(&params); err != nil {
 		return c.SendStatus(fiber.StatusBadRequest)
 	}
 
-	result, err := controller.svc.RunRisectlCommand(c.Context(), id, params, orgID)
+	result, err := controller.svc.RunRisectlCommand(c.Context(), id, params, userID, orgID)
 	if err != nil {
+		if errors.Is(err, service.ErrClusterNotFound) {
+			return c.SendStatus(fiber.StatusNotFound)
+		}
+		if errors.Is(err, service.ErrRisectlCommandNotAllowed) {
+			return c.Status(fiber.StatusForbidden).SendString(err.Error())
+		}
 		return err
 	}
 
 	return c.Status(fiber.StatusOK).JSON(result)
 }
 
-func (controller *Controller) CreateClusterDiagnostic(c *fiber.Ctx, id int32) error {
+func (controller *Controller) UploadRisectl(c *fiber.Ctx, version string) error {
 	orgID, err := auth.GetOrgID(c)
 	if err != nil {
 		return c.Status(fiber.StatusUnauthorized).SendString("missing orgID in request context")
 	}
 
-	diagnostic, err := controller.svc.CreateClusterDiagnostic(c.Context(), id, orgID)
+	userID, err := auth.GetUserID(c)
+	if err != nil {
+		return c.Status(fiber.StatusUnauthorized).SendString("missing userID in request context")
+	}
+
+	fh, err := c.FormFile("file")
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).SendString("missing file in form")
+	}
+	file, err := fh.Open()
 	if err != nil {
 		return err
 	}
-	return c.Status(fiber.StatusOK).JSON(diagnostic)
+	defer file.Close()
+
+	if err := controller.svc.UploadRisectl(c.Context(), version, file, c.FormValue("sha256"), userID, orgID); err != nil {
+		if errors.Is(err, service.ErrRisectlUploadDisabled) || errors.Is(err, service.ErrRisectlAlreadyInstalled) {
+			return c.Status(fiber.StatusConflict).SendString(err.Error())
+		}
+		if errors.Is(err, service.ErrOrgOwnerRequired) {
+			return c.Status(fiber.StatusForbidden).SendString(err.Error())
+		}
+		if errors.Is(err, service.ErrInvalidRisectlArchive) {
+			return c.Status(fiber.StatusBadRequest).SendString(err.Error())
+		}
+		return err
+	}
+
+	return c.SendStatus(fiber.StatusNoContent)
 }
 
-func (controller *Controller) GetClusterDiagnostic(c *fiber.Ctx, id int32, diagnosticId int32) error {
+func (controller *Controller) ListRisectlOperations(c *fiber.Ctx, id int32) error {
 	orgID, err := auth.GetOrgID(c)
 	if err != nil {
 		return c.Status(fiber.StatusUnauthorized).SendString("missing orgID in request context")
 	}
 
-	diagnostic, err := controller.svc.GetClusterDiagnostic(c.Context(), id, diagnosticId, orgID)
+	userID, err := auth.GetUserID(c)
 	if err != nil {
-		return err
+		return c.Status(fiber.StatusUnauthorized).SendString("missing userID in request context")
 	}
-	return c.Status(fiber.StatusOK).JSON(diagnostic)
-}
 
-func (controller *Controller) GetMaterializedViewThroughput(c *fiber.Ctx, clusterID int32) error {
-	throughput, err := controller.svc.GetMaterializedViewThroughput(c.Context(), clusterID)
+	operations, err := controller.svc.ListRisectlOperations(c.Context(), id, userID, orgID)
 	if err != nil {
-		if errors.Is(err, metricsstore.ErrMetricsStoreNotSupported) {
-			return c.Status(fiber.StatusNotFound).SendString(err.Error())
+		if errors.Is(err, service.ErrClusterNotFound) {
+			return c.SendStatus(fiber.StatusNotFound)
 		}
 		return err
 	}
-	return c.Status(fiber.StatusOK).JSON(throughput)
-}
 
-func (controller *Controller) ImportMetricsStore(c *fiber.Ctx) error {
-	var req apigen.MetricsStoreImport
-	if err := c.BodyParser(&req); err != nil {
-		return c.Status(fiber.StatusBadRequest).SendString(err.Error())
-	}
+	return c.Status(fiber.StatusOK).JSON(operations)
+}
 
+func (controller *Controller) RunRisectlOperation(c *fiber.Ctx, id int32, operation apigen.RisectlOperationName) error {
 	orgID, err := auth.GetOrgID(c)
 	if err != nil {
 		return c.Status(fiber.StatusUnauthorized).SendString("missing orgID in request context")
 	}
 
-	ms, err := controller.svc.ImportMetricsStore(c.Context(), req, orgID)
+	userID, err := auth.GetUserID(c)
 	if err != nil {
-		return c.Status(fiber.StatusInternalServerError).SendString(err.Error())
+		return c.Status(fiber.StatusUnauthorized).SendString("missing userID in request context")
 	}
 
-	return c.Status(fiber.StatusCreated).JSON(ms)
+	result, err := controller.svc.RunRisectlOperation(c.Context(), id, operation, userID, orgID)
+	if err != nil {
+		if errors.Is(err, service.ErrClusterNotFound) {
+			return c.SendStatus(fiber.StatusNotFound)
+		}
+		if errors.Is(err, service.ErrRisectlCommandNotAllowed) {
+			return c.Status(fiber.StatusForbidden).SendString(err.Error())
+		}
+		if errors.Is(err, service.ErrRisectlOperationNotSupported) {
+			return c.Status(fiber.StatusUnprocessableEntity).SendString(err.Error())
+		}
+		return err
+	}
+
+	return c.Status(fiber.StatusOK).JSON(result)
 }
 
-func (controller *Controller) DeleteMetricsStore(c *fiber.Ctx, id int32, params apigen.DeleteMetricsStoreParams) error {
+func (controller *Controller) CreateRisectlExecution(c *fiber.Ctx, id int32) error {
 	orgID, err := auth.GetOrgID(c)
 	if err != nil {
 		return c.Status(fiber.StatusUnauthorized).SendString("missing orgID in request context")
 	}
 
-	if !params.Force {
-		clusters, err := controller.svc.ListClustersByMetricsStoreID(c.Context(), id)
-		if err != nil {
-			return c.Status(fiber.StatusInternalServerError).SendString(err.Error())
-		}
-		var names []string
-		for _, cluster := range clusters {
-			names = append(names, cluster.Name)
-		}
-		if len(names) > 0 {
-			return c.Status(fiber.StatusConflict).SendString(fmt.Sprintf("Metrics store is in use by clusters: %s", strings.Join(names, ", ")))
-		}
+	userID, err := auth.GetUserID(c)
+	if err != nil {
+		return c.Status(fiber.StatusUnauthorized).SendString("missing userID in request context")
 	}
 
-	if err := controller.svc.DeleteMetricsStore(c.Context(), id, orgID, params.Force); err != nil {
-		return c.Status(fiber.StatusInternalServerError).SendString(err.Error())
+	var params apigen.RisectlCommand
+	if err := c.BodyParser(&params); err != nil {
+		return c.SendStatus(fiber.StatusBadRequest)
 	}
 
-	return c.SendStatus(fiber.StatusNoContent)
+	execution, err := controller.svc.CreateRisectlExecution(c.Context(), id, params, userID, orgID)
+	if err != nil {
+		if errors.Is(err, service.ErrClusterNotFound) {
+			return c.SendStatus(fiber.StatusNotFound)
+		}
+		if errors.Is(err, service.ErrRisectlCommandNotAllowed) {
+			return c.Status(fiber.StatusForbidden).SendString(err.Error())
+		}
+		return err
+	}
+
+	return c.Status(fiber.StatusAccepted).JSON(execution)
 }
 
-func (controller *Controller) GetMetricsStore(c *fiber.Ctx, id int32) error {
+func (controller *Controller) ListRisectlExecutions(c *fiber.Ctx, id int32, params apigen.ListRisectlExecutionsParams) error {
 	orgID, err := auth.GetOrgID(c)
 	if err != nil {
 		return c.Status(fiber.StatusUnauthorized).SendString("missing orgID in request context")
 	}
 
-	ms, err := controller.svc.GetMetricsStore(c.Context(), id, orgID)
+	executions, err := controller.svc.ListRisectlExecutions(c.Context(), id, params, orgID)
 	if err != nil {
-		return c.Status(fiber.StatusInternalServerError).SendString(err.Error())
+		return err
 	}
 
-	return c.Status(fiber.StatusOK).JSON(ms)
+	return c.Status(fiber.StatusOK).JSON(executions)
 }
 
-func (controller *Controller) ListMetricsStores(c *fiber.Ctx) error {
+func (controller *Controller) GetRisectlExecution(c *fiber.Ctx, id int32, executionID int32) error {
 	orgID, err := auth.GetOrgID(c)
 	if err != nil {
 		return c.Status(fiber.StatusUnauthorized).SendString("missing orgID in request context")
 	}
 
-	msList, err := controller.svc.ListMetricsStores(c.Context(), orgID)
+	execution, err := controller.svc.GetRisectlExecution(c.Context(), id, executionID, orgID)
 	if err != nil {
-		return c.Status(fiber.StatusInternalServerError).SendString(err.Error())
+		if errors.Is(err, service.ErrRisectlExecutionNotFound) {
+			return c.SendStatus(fiber.StatusNotFound)
+		}
+		return err
 	}
 
-	return c.Status(fiber.StatusOK).JSON(msList)
+	return c.Status(fiber.StatusOK).JSON(execution)
 }
 
-func (controller *Controller) UpdateMetricsStore(c *fiber.Ctx, id int32) error {
+func (controller *Controller) ListRisectlExecutionOutputs(c *fiber.Ctx, id int32, executionID int32, params apigen.ListRisectlExecutionOutputsParams) error {
 	orgID, err := auth.GetOrgID(c)
 	if err != nil {
 		return c.Status(fiber.StatusUnauthorized).SendString("missing orgID in request context")
 	}
 
-	var req apigen.MetricsStoreImport
-	if err := c.BodyParser(&req); err != nil {
-		return c.Status(fiber.StatusBadRequest).SendString(err.Error())
+	outputs, err := controller.svc.ListRisectlExecutionOutputs(c.Context(), id, executionID, utils.UnwrapOrDefault(params.After, 0), orgID)
+	if err != nil {
+		if errors.Is(err, service.ErrRisectlExecutionNotFound) {
+			return c.SendStatus(fiber.StatusNotFound)
+		}
+		return err
 	}
 
-	ms, err := controller.svc.UpdateMetricsStore(c.Context(), id, req, orgID)
+	return c.Status(fiber.StatusOK).JSON(outputs)
+}
+
+func (controller *Controller) StreamRisectlExecution(c *fiber.Ctx, id int32, executionID int32, params apigen.StreamRisectlExecutionParams) error {
+	orgID, err := auth.GetOrgID(c)
 	if err != nil {
-		return c.Status(fiber.StatusInternalServerError).SendString(err.Error())
+		return c.Status(fiber.StatusUnauthorized).SendString("missing orgID in request context")
 	}
 
-	return c.Status(fiber.StatusOK).JSON(ms)
+	// check the execution before the response is started, so a missing one is a 404
+	if _, err := controller.svc.GetRisectlExecution(c.Context(), id, executionID, orgID); err != nil {
+		if errors.Is(err, service.ErrRisectlExecutionNotFound) {
+			return c.SendStatus(fiber.StatusNotFound)
+		}
+		return err
+	}
+
+	after := utils.UnwrapOrDefault(params.After, 0)
+	if lastEventID, err := strconv.ParseInt(c.Get("Last-Event-ID"), 10, 32); err == nil {
+		after = int32(lastEventID)
+	}
+
+	c.Set(fiber.HeaderContentType, "text/event-stream")
+	c.Set(fiber.HeaderCacheControl, "no-cache")
+	c.Set(fiber.HeaderConnection, "keep-alive")
+	c.Context().SetBodyStreamWriter(func(w *bufio.Writer) {
+		// the request context is released once the handler returns, the stream ends when a write fails
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		execution, err := controller.svc.FollowRisectlExecution(ctx, id, executionID, after, orgID, func(outputs []apigen.RisectlExecutionOutput) error {
+			if len(outputs) == 0 {
+				// keep the connection alive and detect the disconnected client
+				if _, err := w.WriteString(": keep-alive\n\n"); err != nil {
+					return err
+				}
+			}
+			for _, output := range outputs {
+				if err := writeServerSentEvent(w, fmt.Sprintf("%d", output.Seq), "output", output); err != nil {
+					return err
+				}
+			}
+			return w.Flush()
+		})
+		if err != nil {
+			// the stream ends without the final state, e.g. the worker running the execution stopped
+			if errors.Is(err, service.ErrRisectlExecutionInterrupted) || errors.Is(err, service.ErrRisectlExecutionFollowTimeout) {
+				if err := writeServerSentEvent(w, "", "error", map[string]string{"message": err.Error()}); err == nil {
+					_ = w.Flush()
+				}
+			}
+			return
+		}
+		if err := writeServerSentEvent(w, "", "done", execution); err != nil {
+			return
+		}
+		_ = w.Flush()
+	})
+	return nil
 }
 
-func (controller *Controller) ListTasks(c *fiber.Ctx) error {
-	return c.SendStatus(fiber.StatusNotImplemented)
+// writeServerSentEvent writes a server-sent event with the JSON encoded data.
+func writeServerSentEvent(w *bufio.Writer, id string, event string, data any) error {
+	raw, err := json.Marshal(data)
+	if err != nil {
+		return err
+	}
+	if id != "" {
+		if _, err := fmt.Fprintf(w, "id: %s\n", id); err != nil {
+			return err
+		}
+	}
+	_, err = fmt.Fprintf(w, "event: %s\ndata: %s\n\n", event, raw)
+	return err
 }
 
-func (controller *Controller) ListEvents(c *fiber.Ctx) error {
-	return c.SendStatus(fiber.StatusNotImplemented)
+func (controller *Controller) CancelRisectlExecution(c *fiber.Ctx, id int32, executionID int32) error {
+	orgID, err := auth.GetOrgID(c)
+	if err != nil {
+		return c.Status(fiber.StatusUnauthorized).SendString("missing orgID in request context")
+	}
+
+	userID, err := auth.GetUserID(c)
+	if err != nil {
+		return c.Status(fiber.StatusUnauthorized).SendString("missing userID in request context")
+	}
+
+	execution, err := controller.svc.CancelRisectlExecution(c.Context(), id, executionID, userID, orgID)
+	if err != nil {
+		if errors.Is(err, service.ErrRisectlExecutionNotFound) {
+			return c.SendStatus(fiber.StatusNotFound)
+		}
+		if errors.Is(err, service.ErrRisectlExecutionFinished) {
+			return c.Status(fiber.StatusConflict).SendString(err.Error())
+		}
+		if errors.Is(err, service.ErrOrgOwnerRequired) {
+			return c.Status(fiber.StatusForbidden).SendString(err.Error())
+		}
+		return err
+	}
+
+	return c.Status(fiber.StatusAccepted).JSON(execution)
 }
 
-func (controller *Controller) CreateCluster(c *fiber.Ctx) error {
-	return c.SendStatus(fiber.StatusNotImplemented)
+func (controller *Controller) ListClusterEvents(c *fiber.Ctx, id int32, params apigen.ListClusterEventsParams) error {
+	orgID, err := auth.GetOrgID(c)
+	if err != nil {
+		return c.Status(fiber.StatusUnauthorized).SendString("missing orgID in request context")
+	}
+
+	events, err := controller.svc.ListClusterEvents(c.Context(), id, params, orgID)
+	if err != nil {
+		if errors.Is(err, service.ErrClusterNotFound) {
+			return c.SendStatus(fiber.StatusNotFound)
+		}
+		return err
+	}
+
+	return c.Status(fiber.StatusOK).JSON(events)
+}
+
+func (controller *Controller) ListClusterHealthHistory(c *fiber.Ctx, id int32, params apigen.ListClusterHealthHistoryParams) error {
+	orgID, err := auth.GetOrgID(c)
+	if err != nil {
+		return c.Status(fiber.StatusUnauthorized).SendString("missing orgID in request context")
+	}
+
+	history, err := controller.svc.ListClusterHealthHistory(c.Context(), id, params, orgID)
+	if err != nil {
+		if errors.Is(err, service.ErrClusterNotFound) {
+			return c.SendStatus(fiber.StatusNotFound)
+		}
+		return err
+	}
+
+	return c.Status(fiber.StatusOK).JSON(history)
+}
+
+func (controller *Controller) ListClusterVersionHistory(c *fiber.Ctx, id int32) error {
+	orgID, err := auth.GetOrgID(c)
+	if err != nil {
+		return c.Status(fiber.StatusUnauthorized).SendString("missing orgID in request context")
+	}
+
+	history, err := controller.svc.ListClusterVersionHistory(c.Context(), id, orgID)
+	if err != nil {
+		if errors.Is(err, service.ErrClusterNotFound) {
+			return c.SendStatus(fiber.StatusNotFound)
+		}
+		return err
+	}
+
+	return c.Status(fiber.StatusOK).JSON(history)
+}
+
+func (controller *Controller) CreateClusterUpgrade(c *fiber.Ctx, id int32) error {
+	orgID, err := auth.GetOrgID(c)
+	if err != nil {
+		return c.Status(fiber.StatusUnauthorized).SendString("missing orgID in request context")
+	}
+
+	userID, err := auth.GetUserID(c)
+	if err != nil {
+		return c.Status(fiber.StatusUnauthorized).SendString("missing userID in request context")
+	}
+
+	var params apigen.ClusterUpgradeCreate
+	if err := c.BodyParser(&params); err != nil {
+		return c.SendStatus(fiber.StatusBadRequest)
+	}
+
+	upgrade, err := controller.svc.CreateClusterUpgrade(c.Context(), id, params, userID, orgID)
+	if err != nil {
+		if errors.Is(err, service.ErrClusterNotFound) {
+			return c.SendStatus(fiber.StatusNotFound)
+		}
+		if errors.Is(err, service.ErrInvalidClusterUpgrade) {
+			return c.Status(fiber.StatusBadRequest).SendString(err.Error())
+		}
+		if errors.Is(err, service.ErrClusterUpgradeInProgress) {
+			return c.Status(fiber.StatusConflict).SendString(err.Error())
+		}
+		return err
+	}
+
+	return c.Status(fiber.StatusAccepted).JSON(upgrade)
+}
+
+func (controller *Controller) ListClusterUpgrades(c *fiber.Ctx, id int32) error {
+	orgID, err := auth.GetOrgID(c)
+	if err != nil {
+		return c.Status(fiber.StatusUnauthorized).SendString("missing orgID in request context")
+	}
+
+	upgrades, err := controller.svc.ListClusterUpgrades(c.Context(), id, orgID)
+	if err != nil {
+		if errors.Is(err, service.ErrClusterNotFound) {
+			return c.SendStatus(fiber.StatusNotFound)
+		}
+		return err
+	}
+
+	return c.Status(fiber.StatusOK).JSON(upgrades)
+}
+
+func (controller *Controller) GetClusterUpgrade(c *fiber.Ctx, id int32, upgradeID int32) error {
+	orgID, err := auth.GetOrgID(c)
+	if err != nil {
+		return c.Status(fiber.StatusUnauthorized).SendString("missing orgID in request context")
+	}
+
+	upgrade, err := controller.svc.GetClusterUpgrade(c.Context(), id, upgradeID, orgID)
+	if err != nil {
+		if errors.Is(err, service.ErrClusterUpgradeNotFound) {
+			return c.SendStatus(fiber.StatusNotFound)
+		}
+		return err
+	}
+
+	return c.Status(fiber.StatusOK).JSON(upgrade)
+}
+
+func (controller *Controller) CancelClusterUpgrade(c *fiber.Ctx, id int32, upgradeID int32) error {
+	orgID, err := auth.GetOrgID(c)
+	if err != nil {
+		return c.Status(fiber.StatusUnauthorized).SendString("missing orgID in request context")
+	}
+
+	userID, err := auth.GetUserID(c)
+	if err != nil {
+		return c.Status(fiber.StatusUnauthorized).SendString("missing userID in request context")
+	}
+
+	upgrade, err := controller.svc.CancelClusterUpgrade(c.Context(), id, upgradeID, userID, orgID)
+	if err != nil {
+		if errors.Is(err, service.ErrClusterUpgradeNotFound) {
+			return c.SendStatus(fiber.StatusNotFound)
+		}
+		if errors.Is(err, service.ErrClusterUpgradeFinished) {
+			return c.Status(fiber.StatusConflict).SendString(err.Error())
+		}
+		return err
+	}
+
+	return c.Status(fiber.StatusOK).JSON(upgrade)
+}
+
+func (controller *Controller) OpenRisectlShell(c *fiber.Ctx, id int32) error {
+	orgID, err := auth.GetOrgID(c)
+	if err != nil {
+		return c.Status(fiber.StatusUnauthorized).SendString("missing orgID in request context")
+	}
+
+	userID, err := auth.GetUserID(c)
+	if err != nil {
+		return c.Status(fiber.StatusUnauthorized).SendString("missing userID in request context")
+	}
+
+	if !websocket.IsWebSocketUpgrade(c) {
+		return c.SendStatus(fiber.StatusUpgradeRequired)
+	}
+
+	shell, err := controller.svc.OpenRisectlShell(c.Context(), id, userID, orgID)
+	if err != nil {
+		if errors.Is(err, service.ErrClusterNotFound) {
+			return c.SendStatus(fiber.StatusNotFound)
+		}
+		return err
+	}
+
+	// only the shell subprotocol is selected, the token passed in the subprotocols is never echoed back
+	if err := websocket.New(func(conn *websocket.Conn) {
+		serveRisectlShell(conn, shell)
+	}, websocket.Config{Subprotocols: []string{risectlShellProtocol}})(c); err != nil {
+		_ = shell.Close(context.Background())
+		return err
+	}
+	return nil
+}
+
+func (controller *Controller) ListRisectlShellSessions(c *fiber.Ctx, id int32) error {
+	orgID, err := auth.GetOrgID(c)
+	if err != nil {
+		return c.Status(fiber.StatusUnauthorized).SendString("missing orgID in request context")
+	}
+
+	sessions, err := controller.svc.ListRisectlShellSessions(c.Context(), id, orgID)
+	if err != nil {
+		return err
+	}
+
+	return c.Status(fiber.StatusOK).JSON(sessions)
+}
+
+func (controller *Controller) ListRisectlShellCommands(c *fiber.Ctx, id int32, sessionID int32) error {
+	orgID, err := auth.GetOrgID(c)
+	if err != nil {
+		return c.Status(fiber.StatusUnauthorized).SendString("missing orgID in request context")
+	}
+
+	commands, err := controller.svc.ListRisectlShellCommands(c.Context(), id, sessionID, orgID)
+	if err != nil {
+		if errors.Is(err, service.ErrRisectlShellSessionNotFound) {
+			return c.SendStatus(fiber.StatusNotFound)
+		}
+		return err
+	}
+
+	return c.Status(fiber.StatusOK).JSON(commands)
+}
+
+func (controller *Controller) CreateClusterDiagnostic(c *fiber.Ctx, id int32) error {
+	orgID, err := auth.GetOrgID(c)
+	if err != nil {
+		return c.Status(fiber.StatusUnauthorized).SendString("missing orgID in request context")
+	}
+
+	diagnostic, err := controller.svc.CreateClusterDiagnostic(c.Context(), id, orgID)
+	if err != nil {
+		return err
+	}
+	return c.Status(fiber.StatusOK).JSON(diagnostic)
+}
+
+func (controller *Controller) GetClusterDiagnostic(c *fiber.Ctx, id int32, diagnosticId int32) error {
+	orgID, err := auth.GetOrgID(c)
+	if err != nil {
+		return c.Status(fiber.StatusUnauthorized).SendString("missing orgID in request context")
+	}
+
+	diagnostic, err := controller.svc.GetClusterDiagnostic(c.Context(), id, diagnosticId, orgID)
+	if err != nil {
+		return err
+	}
+	return c.Status(fiber.StatusOK).JSON(diagnostic)
+}
+
+func (controller *Controller) GetClusterDiagnosticReport(c *fiber.Ctx, id int32, diagnosticId int32) error {
+	orgID, err := auth.GetOrgID(c)
+	if err != nil {
+		return c.Status(fiber.StatusUnauthorized).SendString("missing orgID in request context")
+	}
+
+	report, err := controller.svc.GetClusterDiagnosticReport(c.Context(), id, diagnosticId, orgID)
+	if err != nil {
+		if errors.Is(err, service.ErrDiagnosticNotFound) {
+			return c.SendStatus(fiber.StatusNotFound)
+		}
+		return err
+	}
+	return c.Status(fiber.StatusOK).JSON(report)
+}
+
+func (controller *Controller) DiffClusterDiagnostics(c *fiber.Ctx, id int32, params apigen.DiffClusterDiagnosticsParams) error {
+	orgID, err := auth.GetOrgID(c)
+	if err != nil {
+		return c.Status(fiber.StatusUnauthorized).SendString("missing orgID in request context")
+	}
+
+	diff, err := controller.svc.DiffClusterDiagnostics(c.Context(), id, params.From, params.To, orgID)
+	if err != nil {
+		if errors.Is(err, service.ErrDiagnosticNotFound) {
+			return c.SendStatus(fiber.StatusNotFound)
+		}
+		return err
+	}
+	return c.Status(fiber.StatusOK).JSON(diff)
+}
+
+func (controller *Controller) SearchClusterDiagnostics(c *fiber.Ctx, id int32, params apigen.SearchClusterDiagnosticsParams) error {
+	orgID, err := auth.GetOrgID(c)
+	if err != nil {
+		return c.Status(fiber.StatusUnauthorized).SendString("missing orgID in request context")
+	}
+
+	results, err := controller.svc.SearchClusterDiagnostics(c.Context(), id, params, orgID)
+	if err != nil {
+		if errors.Is(err, service.ErrClusterNotFound) {
+			return c.SendStatus(fiber.StatusNotFound)
+		}
+		return err
+	}
+	return c.Status(fiber.StatusOK).JSON(results)
+}
+
+func (controller *Controller) SearchDiagnostics(c *fiber.Ctx, params apigen.SearchDiagnosticsParams) error {
+	orgID, err := auth.GetOrgID(c)
+	if err != nil {
+		return c.Status(fiber.StatusUnauthorized).SendString("missing orgID in request context")
+	}
+
+	results, err := controller.svc.SearchDiagnostics(c.Context(), params, orgID)
+	if err != nil {
+		return err
+	}
+	return c.Status(fiber.StatusOK).JSON(results)
+}
+
+func (controller *Controller) ListClusterDiagnosticFindings(c *fiber.Ctx, id int32, diagnosticId int32) error {
+	orgID, err := auth.GetOrgID(c)
+	if err != nil {
+		return c.Status(fiber.StatusUnauthorized).SendString("missing orgID in request context")
+	}
+
+	findings, err := controller.svc.ListClusterDiagnosticFindings(c.Context(), id, diagnosticId, orgID)
+	if err != nil {
+		if errors.Is(err, service.ErrDiagnosticNotFound) {
+			return c.SendStatus(fiber.StatusNotFound)
+		}
+		return err
+	}
+	return c.Status(fiber.StatusOK).JSON(findings)
+}
+
+func (controller *Controller) ListDiagnosticRules(c *fiber.Ctx) error {
+	orgID, err := auth.GetOrgID(c)
+	if err != nil {
+		return c.Status(fiber.StatusUnauthorized).SendString("missing orgID in request context")
+	}
+
+	rules, err := controller.svc.ListDiagnosticRules(c.Context(), orgID)
+	if err != nil {
+		return err
+	}
+	return c.Status(fiber.StatusOK).JSON(rules)
+}
+
+func (controller *Controller) UpdateDiagnosticRule(c *fiber.Ctx, rule apigen.DiagnosticRuleName) error {
+	var params apigen.DiagnosticRuleUpdate
+	if err := c.BodyParser(&params); err != nil {
+		return c.SendStatus(fiber.StatusBadRequest)
+	}
+
+	orgID, err := auth.GetOrgID(c)
+	if err != nil {
+		return c.Status(fiber.StatusUnauthorized).SendString("missing orgID in request context")
+	}
+
+	result, err := controller.svc.UpdateDiagnosticRule(c.Context(), rule, params, orgID)
+	if err != nil {
+		if errors.Is(err, service.ErrDiagnosticRuleNotFound) {
+			return c.SendStatus(fiber.StatusNotFound)
+		}
+		if errors.Is(err, service.ErrInvalidDiagnosticRule) {
+			return c.Status(fiber.StatusBadRequest).SendString(err.Error())
+		}
+		return err
+	}
+	return c.Status(fiber.StatusOK).JSON(result)
+}
+
+func (controller *Controller) ListAlertRules(c *fiber.Ctx, params apigen.ListAlertRulesParams) error {
+	orgID, err := auth.GetOrgID(c)
+	if err != nil {
+		return c.Status(fiber.StatusUnauthorized).SendString("missing orgID in request context")
+	}
+
+	rules, err := controller.svc.ListAlertRules(c.Context(), params, orgID)
+	if err != nil {
+		return err
+	}
+	return c.Status(fiber.StatusOK).JSON(rules)
+}
+
+func (controller *Controller) CreateAlertRule(c *fiber.Ctx) error {
+	var params apigen.AlertRuleCreate
+	if err := c.BodyParser(&params); err != nil {
+		return c.SendStatus(fiber.StatusBadRequest)
+	}
+
+	orgID, err := auth.GetOrgID(c)
+	if err != nil {
+		return c.Status(fiber.StatusUnauthorized).SendString("missing orgID in request context")
+	}
+
+	rule, err := controller.svc.CreateAlertRule(c.Context(), params, orgID)
+	if err != nil {
+		if errors.Is(err, service.ErrClusterNotFound) {
+			return c.SendStatus(fiber.StatusNotFound)
+		}
+		if errors.Is(err, service.ErrInvalidAlertRule) {
+			return c.Status(fiber.StatusBadRequest).SendString(err.Error())
+		}
+		return err
+	}
+	return c.Status(fiber.StatusCreated).JSON(rule)
+}
+
+func (controller *Controller) GetAlertRule(c *fiber.Ctx, id int32) error {
+	orgID, err := auth.GetOrgID(c)
+	if err != nil {
+		return c.Status(fiber.StatusUnauthorized).SendString("missing orgID in request context")
+	}
+
+	rule, err := controller.svc.GetAlertRule(c.Context(), id, orgID)
+	if err != nil {
+		if errors.Is(err, service.ErrAlertRuleNotFound) {
+			return c.SendStatus(fiber.StatusNotFound)
+		}
+		return err
+	}
+	return c.Status(fiber.StatusOK).JSON(rule)
+}
+
+func (controller *Controller) UpdateAlertRule(c *fiber.Ctx, id int32) error {
+	var params apigen.AlertRuleCreate
+	if err := c.BodyParser(&params); err != nil {
+		return c.SendStatus(fiber.StatusBadRequest)
+	}
+
+	orgID, err := auth.GetOrgID(c)
+	if err != nil {
+		return c.Status(fiber.StatusUnauthorized).SendString("missing orgID in request context")
+	}
+
+	rule, err := controller.svc.UpdateAlertRule(c.Context(), id, params, orgID)
+	if err != nil {
+		if errors.Is(err, service.ErrAlertRuleNotFound) || errors.Is(err, service.ErrClusterNotFound) {
+			return c.SendStatus(fiber.StatusNotFound)
+		}
+		if errors.Is(err, service.ErrInvalidAlertRule) {
+			return c.Status(fiber.StatusBadRequest).SendString(err.Error())
+		}
+		return err
+	}
+	return c.Status(fiber.StatusOK).JSON(rule)
+}
+
+func (controller *Controller) DeleteAlertRule(c *fiber.Ctx, id int32) error {
+	orgID, err := auth.GetOrgID(c)
+	if err != nil {
+		return c.Status(fiber.StatusUnauthorized).SendString("missing orgID in request context")
+	}
+
+	if err := controller.svc.DeleteAlertRule(c.Context(), id, orgID); err != nil {
+		if errors.Is(err, service.ErrAlertRuleNotFound) {
+			return c.SendStatus(fiber.StatusNotFound)
+		}
+		return err
+	}
+	return c.SendStatus(fiber.StatusNoContent)
+}
+
+func (controller *Controller) ListAlerts(c *fiber.Ctx, params apigen.ListAlertsParams) error {
+	orgID, err := auth.GetOrgID(c)
+	if err != nil {
+		return c.Status(fiber.StatusUnauthorized).SendString("missing orgID in request context")
+	}
+
+	alerts, err := controller.svc.ListAlerts(c.Context(), params, orgID)
+	if err != nil {
+		return err
+	}
+	return c.Status(fiber.StatusOK).JSON(alerts)
+}
+
+func (controller *Controller) ListAlertHistory(c *fiber.Ctx, params apigen.ListAlertHistoryParams) error {
+	orgID, err := auth.GetOrgID(c)
+	if err != nil {
+		return c.Status(fiber.StatusUnauthorized).SendString("missing orgID in request context")
+	}
+
+	history, err := controller.svc.ListAlertHistory(c.Context(), params, orgID)
+	if err != nil {
+		return err
+	}
+	return c.Status(fiber.StatusOK).JSON(history)
+}
+
+func (controller *Controller) CreateClusterDiagnosticBundle(c *fiber.Ctx, id int32) error {
+	orgID, err := auth.GetOrgID(c)
+	if err != nil {
+		return c.Status(fiber.StatusUnauthorized).SendString("missing orgID in request context")
+	}
+
+	userID, err := auth.GetUserID(c)
+	if err != nil {
+		return c.Status(fiber.StatusUnauthorized).SendString("missing userID in request context")
+	}
+
+	bundle, err := controller.svc.CreateClusterDiagnosticBundle(c.Context(), id, userID, orgID)
+	if err != nil {
+		if errors.Is(err, service.ErrClusterNotFound) {
+			return c.SendStatus(fiber.StatusNotFound)
+		}
+		return err
+	}
+	return c.Status(fiber.StatusAccepted).JSON(bundle)
+}
+
+func (controller *Controller) ListClusterDiagnosticBundles(c *fiber.Ctx, id int32) error {
+	orgID, err := auth.GetOrgID(c)
+	if err != nil {
+		return c.Status(fiber.StatusUnauthorized).SendString("missing orgID in request context")
+	}
+
+	bundles, err := controller.svc.ListClusterDiagnosticBundles(c.Context(), id, orgID)
+	if err != nil {
+		return err
+	}
+	return c.Status(fiber.StatusOK).JSON(bundles)
+}
+
+func (controller *Controller) GetClusterDiagnosticBundle(c *fiber.Ctx, id int32, bundleId int32) error {
+	orgID, err := auth.GetOrgID(c)
+	if err != nil {
+		return c.Status(fiber.StatusUnauthorized).SendString("missing orgID in request context")
+	}
+
+	bundle, err := controller.svc.GetClusterDiagnosticBundle(c.Context(), id, bundleId, orgID)
+	if err != nil {
+		if errors.Is(err, service.ErrDiagnosticBundleNotFound) {
+			return c.SendStatus(fiber.StatusNotFound)
+		}
+		return err
+	}
+	return c.Status(fiber.StatusOK).JSON(bundle)
+}
+
+func (controller *Controller) DownloadClusterDiagnosticBundle(c *fiber.Ctx, id int32, bundleId int32) error {
+	orgID, err := auth.GetOrgID(c)
+	if err != nil {
+		return c.Status(fiber.StatusUnauthorized).SendString("missing orgID in request context")
+	}
+
+	name, content, err := controller.svc.DownloadClusterDiagnosticBundle(c.Context(), id, bundleId, orgID)
+	if err != nil {
+		if errors.Is(err, service.ErrDiagnosticBundleNotFound) {
+			return c.SendStatus(fiber.StatusNotFound)
+		}
+		if errors.Is(err, service.ErrDiagnosticBundleNotCompleted) {
+			return c.Status(fiber.StatusConflict).SendString(err.Error())
+		}
+		return err
+	}
+	c.Attachment(name)
+	c.Set(fiber.HeaderContentType, "application/gzip")
+	return c.Status(fiber.StatusOK).Send(content)
+}
+
+func (controller *Controller) DeleteClusterDiagnosticBundle(c *fiber.Ctx, id int32, bundleId int32) error {
+	orgID, err := auth.GetOrgID(c)
+	if err != nil {
+		return c.Status(fiber.StatusUnauthorized).SendString("missing orgID in request context")
+	}
+
+	if err := controller.svc.DeleteClusterDiagnosticBundle(c.Context(), id, bundleId, orgID); err != nil {
+		if errors.Is(err, service.ErrDiagnosticBundleNotFound) {
+			return c.SendStatus(fiber.StatusNotFound)
+		}
+		if errors.Is(err, service.ErrDiagnosticBundleInProgress) {
+			return c.Status(fiber.StatusConflict).SendString(err.Error())
+		}
+		return err
+	}
+	return c.SendStatus(fiber.StatusNoContent)
+}
+
+func (controller *Controller) GetMaterializedViewThroughput(c *fiber.Ctx, clusterID int32) error {
+	throughput, err := controller.svc.GetMaterializedViewThroughput(c.Context(), clusterID)
+	if err != nil {
+		if errors.Is(err, metricsstore.ErrMetricsStoreNotSupported) {
+			return c.Status(fiber.StatusNotFound).SendString(err.Error())
+		}
+		return err
+	}
+	return c.Status(fiber.StatusOK).JSON(throughput)
+}
+
+func (controller *Controller) ImportMetricsStore(c *fiber.Ctx) error {
+	var req apigen.MetricsStoreImport
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).SendString(err.Error())
+	}
+
+	orgID, err := auth.GetOrgID(c)
+	if err != nil {
+		return c.Status(fiber.StatusUnauthorized).SendString("missing orgID in request context")
+	}
+
+	ms, err := controller.svc.ImportMetricsStore(c.Context(), req, orgID)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).SendString(err.Error())
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(ms)
+}
+
+func (controller *Controller) DeleteMetricsStore(c *fiber.Ctx, id int32, params apigen.DeleteMetricsStoreParams) error {
+	orgID, err := auth.GetOrgID(c)
+	if err != nil {
+		return c.Status(fiber.StatusUnauthorized).SendString("missing orgID in request context")
+	}
+
+	if !params.Force {
+		clusters, err := controller.svc.ListClustersByMetricsStoreID(c.Context(), id)
+		if err != nil {
+			return c.Status(fiber.StatusInternalServerError).SendString(err.Error())
+		}
+		var names []string
+		for _, cluster := range clusters {
+			names = append(names, cluster.Name)
+		}
+		if len(names) > 0 {
+			return c.Status(fiber.StatusConflict).SendString(fmt.Sprintf("Metrics store is in use by clusters: %s", strings.Join(names, ", ")))
+		}
+	}
+
+	if err := controller.svc.DeleteMetricsStore(c.Context(), id, orgID, params.Force); err != nil {
+		return c.Status(fiber.StatusInternalServerError).SendString(err.Error())
+	}
+
+	return c.SendStatus(fiber.StatusNoContent)
+}
+
+func (controller *Controller) GetMetricsStore(c *fiber.Ctx, id int32) error {
+	orgID, err := auth.GetOrgID(c)
+	if err != nil {
+		return c.Status(fiber.StatusUnauthorized).SendString("missing orgID in request context")
+	}
+
+	ms, err := controller.svc.GetMetricsStore(c.Context(), id, orgID)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).SendString(err.Error())
+	}
+
+	return c.Status(fiber.StatusOK).JSON(ms)
+}
+
+func (controller *Controller) ListMetricsStores(c *fiber.Ctx) error {
+	orgID, err := auth.GetOrgID(c)
+	if err != nil {
+		return c.Status(fiber.StatusUnauthorized).SendString("missing orgID in request context")
+	}
+
+	msList, err := controller.svc.ListMetricsStores(c.Context(), orgID)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).SendString(err.Error())
+	}
+
+	return c.Status(fiber.StatusOK).JSON(msList)
+}
+
+func (controller *Controller) UpdateMetricsStore(c *fiber.Ctx, id int32) error {
+	orgID, err := auth.GetOrgID(c)
+	if err != nil {
+		return c.Status(fiber.StatusUnauthorized).SendString("missing orgID in request context")
+	}
+
+	var req apigen.MetricsStoreImport
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).SendString(err.Error())
+	}
+
+	ms, err := controller.svc.UpdateMetricsStore(c.Context(), id, req, orgID)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).SendString(err.Error())
+	}
+
+	return c.Status(fiber.StatusOK).JSON(ms)
+}
+
+func (controller *Controller) ListTasks(c *fiber.Ctx) error {
+	return c.SendStatus(fiber.StatusNotImplemented)
+}
+
+func (controller *Controller) ListEvents(c *fiber.Ctx) error {
+	return c.SendStatus(fiber.StatusNotImplemented)
+}
+
+func (controller *Controller) CreateCluster(c *fiber.Ctx) error {
+	return c.SendStatus(fiber.StatusNotImplemented)
+}
+
+func (controller *Controller) GetOrgSettings(c *fiber.Ctx) error {
+	orgID, err := auth.GetOrgID(c)
+	if err != nil {
+		return c.Status(fiber.StatusUnauthorized).SendString("missing orgID in request context")
+	}
+
+	orgSettings, err := controller.svc.GetOrgSettings(c.Context(), orgID)
+	if err != nil {
+		return err
+	}
+
+	return c.Status(fiber.StatusOK).JSON(orgSettings)
+}
+
+func (controller *Controller) UpdateOrgSettings(c *fiber.Ctx) error {
+	var params apigen.OrgSettings
+	if err := c.BodyParser(&params); err != nil {
+		return c.SendStatus(fiber.StatusBadRequest)
+	}
+
+	orgID, err := auth.GetOrgID(c)
+	if err != nil {
+		return c.Status(fiber.StatusUnauthorized).SendString("missing orgID in request context")
+	}
+
+	orgSettings, err := controller.svc.UpdateOrgSettings(c.Context(), params, orgID)
+	if err != nil {
+		if errors.Is(err, service.ErrInvalidOrgSettings) {
+			return c.Status(fiber.StatusBadRequest).SendString(err.Error())
+		}
+		return err
+	}
+
+	return c.Status(fiber.StatusOK).JSON(orgSettings)
 }
@@ -0,0 +1,225 @@
+package controller
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gofiber/contrib/websocket"
+	"github.com/risingwavelabs/risingwave-console/pkg/service"
+	"github.com/risingwavelabs/risingwave-console/pkg/utils"
+	"github.com/risingwavelabs/risingwave-console/pkg/zgen/apigen"
+)
+
+const (
+	// risectlShellProtocol is the only subprotocol selected by the server
+	risectlShellProtocol = "risectl-shell"
+
+	// risectlShellTokenProtocolPrefix prefixes the unpadded base64url encoded access token passed
+	// in the Sec-WebSocket-Protocol header, the raw token has characters not allowed in subprotocols
+	risectlShellTokenProtocolPrefix = "base64url.bearer."
+
+	// risectlShellIdleTimeout closes the sessions without input for a while, a running command
+	// keeps the session open
+	risectlShellIdleTimeout = 30 * time.Minute
+
+	// risectlShellPingInterval is how often the client is pinged, it is gone if a pong is
+	// not received within two intervals
+	risectlShellPingInterval = 30 * time.Second
+
+	risectlShellWriteTimeout = 10 * time.Second
+)
+
+// risectlShellToken returns the access token passed in the Sec-WebSocket-Protocol header.
+func risectlShellToken(header string) (string, bool) {
+	for _, protocol := range strings.Split(header, ",") {
+		encoded, ok := strings.CutPrefix(strings.TrimSpace(protocol), risectlShellTokenProtocolPrefix)
+		if !ok {
+			continue
+		}
+		token, err := base64.RawURLEncoding.DecodeString(encoded)
+		if err != nil || len(token) == 0 {
+			return "", false
+		}
+		return string(token), true
+	}
+	return "", false
+}
+
+// risectlShellConn serializes the writes to the WebSocket connection, the output of stdout and
+// stderr is written concurrently.
+type risectlShellConn struct {
+	mu   sync.Mutex
+	conn *websocket.Conn
+	seq  int32
+}
+
+func (sc *risectlShellConn) send(msg apigen.RisectlShellMessage) error {
+	sc.mu.Lock()
+	defer sc.mu.Unlock()
+	if err := sc.conn.SetWriteDeadline(time.Now().Add(risectlShellWriteTimeout)); err != nil {
+		return err
+	}
+	return sc.conn.WriteJSON(msg)
+}
+
+func (sc *risectlShellConn) close(code int, text string) {
+	_ = sc.conn.WriteControl(websocket.CloseMessage, websocket.FormatCloseMessage(code, text), time.Now().Add(risectlShellWriteTimeout))
+}
+
+// risectlShellOutput sends the output of the running command as stdout or stderr messages.
+type risectlShellOutput struct {
+	sc  *risectlShellConn
+	typ apigen.RisectlShellMessageType
+}
+
+func (o *risectlShellOutput) Write(p []byte) (int, error) {
+	o.sc.mu.Lock()
+	seq := o.sc.seq
+	o.sc.mu.Unlock()
+	if err := o.sc.send(apigen.RisectlShellMessage{Type: o.typ, Seq: &seq, Data: utils.Ptr(string(p))}); err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
+
+// serveRisectlShell runs the command lines received from the WebSocket connection one at a
+// time until the client leaves, sends "exit" or is idle for too long. The running command is
+// killed once the connection is closed.
+func serveRisectlShell(conn *websocket.Conn, shell *service.RisectlShell) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	defer func() {
+		_ = shell.Close(context.Background())
+	}()
+
+	sc := &risectlShellConn{conn: conn}
+	if err := sc.send(apigen.RisectlShellMessage{Type: apigen.RisectlShellMessageTypeReady, Session: shell.Session()}); err != nil {
+		return
+	}
+
+	lines := make(chan string, 64)
+	go readRisectlShellLines(ctx, cancel, conn, lines)
+	go pingRisectlShell(ctx, conn)
+
+	idle := time.NewTimer(risectlShellIdleTimeout)
+	defer idle.Stop()
+	resetIdle := func() {
+		if !idle.Stop() {
+			select {
+			case <-idle.C:
+			default:
+			}
+		}
+		idle.Reset(risectlShellIdleTimeout)
+	}
+
+	stdout := &risectlShellOutput{sc: sc, typ: apigen.RisectlShellMessageTypeStdout}
+	stderr := &risectlShellOutput{sc: sc, typ: apigen.RisectlShellMessageTypeStderr}
+	for {
+		var line string
+		select {
+		case <-idle.C:
+			sc.close(websocket.CloseNormalClosure, "idle timeout")
+			return
+		case l, ok := <-lines:
+			if !ok {
+				return
+			}
+			line = l
+		}
+		resetIdle()
+
+		switch strings.TrimSpace(line) {
+		case "exit", "quit":
+			sc.close(websocket.CloseNormalClosure, "")
+			return
+		case "history":
+			commands, err := shell.History(ctx)
+			if err != nil {
+				_ = sc.send(apigen.RisectlShellMessage{Type: apigen.RisectlShellMessageTypeError, Message: utils.Ptr(err.Error())})
+				continue
+			}
+			if err := sc.send(apigen.RisectlShellMessage{Type: apigen.RisectlShellMessageTypeHistory, Commands: &commands}); err != nil {
+				return
+			}
+			continue
+		}
+
+		command, err := shell.Run(ctx, line, func(command apigen.RisectlShellCommand) error {
+			sc.mu.Lock()
+			sc.seq = command.Seq
+			sc.mu.Unlock()
+			return sc.send(apigen.RisectlShellMessage{Type: apigen.RisectlShellMessageTypeStart, Seq: &command.Seq, Command: &command})
+		}, stdout, stderr)
+		// the timer may have fired while the command was running
+		resetIdle()
+		if err != nil {
+			if ctx.Err() != nil {
+				return
+			}
+			if !errors.Is(err, service.ErrInvalidRisectlCommand) {
+				err = errors.New("failed to run the command")
+			}
+			if err := sc.send(apigen.RisectlShellMessage{Type: apigen.RisectlShellMessageTypeError, Message: utils.Ptr(err.Error())}); err != nil {
+				return
+			}
+			continue
+		}
+		if command == nil {
+			continue
+		}
+		if err := sc.send(apigen.RisectlShellMessage{Type: apigen.RisectlShellMessageTypeExit, Seq: &command.Seq, Command: command}); err != nil {
+			return
+		}
+	}
+}
+
+// readRisectlShellLines splits the received text frames into lines, the lines are queued while
+// a command is running. The session is cancelled once the connection is closed.
+func readRisectlShellLines(ctx context.Context, cancel context.CancelFunc, conn *websocket.Conn, lines chan<- string) {
+	defer cancel()
+	defer close(lines)
+
+	pongWait := 2 * risectlShellPingInterval
+	_ = conn.SetReadDeadline(time.Now().Add(pongWait))
+	conn.SetPongHandler(func(string) error {
+		return conn.SetReadDeadline(time.Now().Add(pongWait))
+	})
+
+	for {
+		_, data, err := conn.ReadMessage()
+		if err != nil {
+			return
+		}
+		_ = conn.SetReadDeadline(time.Now().Add(pongWait))
+		for _, line := range strings.Split(string(data), "\n") {
+			if strings.TrimSpace(line) == "" {
+				continue
+			}
+			select {
+			case lines <- line:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}
+}
+
+func pingRisectlShell(ctx context.Context, conn *websocket.Conn) {
+	ticker := time.NewTicker(risectlShellPingInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := conn.WriteControl(websocket.PingMessage, nil, time.Now().Add(risectlShellWriteTimeout)); err != nil {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,35 @@
+package controller
+
+import (
+	"encoding/base64"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRisectlShellToken(t *testing.T) {
+	token := "MDAxY2xvY2F0aW9u/Zm9v+YmFy=.c2ln"
+	encoded := risectlShellTokenProtocolPrefix + base64.RawURLEncoding.EncodeToString([]byte(token))
+
+	testCases := []struct {
+		name   string
+		header string
+		token  string
+		ok     bool
+	}{
+		{name: "token after the shell protocol", header: risectlShellProtocol + ", " + encoded, token: token, ok: true},
+		{name: "token only", header: encoded, token: token, ok: true},
+		{name: "no token", header: risectlShellProtocol, ok: false},
+		{name: "empty header", header: "", ok: false},
+		{name: "padded token", header: risectlShellTokenProtocolPrefix + base64.URLEncoding.EncodeToString([]byte("ab")), ok: false},
+		{name: "empty token", header: risectlShellTokenProtocolPrefix, ok: false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, ok := risectlShellToken(tc.header)
+			require.Equal(t, tc.ok, ok)
+			require.Equal(t, tc.token, got)
+		})
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"errors"
 
 	"github.com/cloudcarver/anchor/pkg/auth"
+	"github.com/gofiber/contrib/websocket"
 	"github.com/gofiber/fiber/v2"
 	"github.com/risingwavelabs/risingwave-console/pkg/zcore/model"
 	"github.com/risingwavelabs/risingwave-console/pkg/zgen/apigen"
@@ -35,6 +36,13 @@ func (v *Validator) OwnDatabase(c *fiber.Ctx, orgID int32, databaseID int32) err
 }
 
 func (v *Validator) AuthFunc(c *fiber.Ctx) error {
+	// browsers can not set the Authorization header of WebSocket requests, so the token of
+	// WebSocket upgrades may be passed in the subprotocols instead
+	if websocket.IsWebSocketUpgrade(c) && c.Get(fiber.HeaderAuthorization) == "" {
+		if token, ok := risectlShellToken(c.Get(fiber.HeaderSecWebSocketProtocol)); ok {
+			c.Request().Header.Set(fiber.HeaderAuthorization, "Bearer "+token)
+		}
+	}
 	return v.auth.Authfunc(c)
 }
 
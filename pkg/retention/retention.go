@@ -0,0 +1,119 @@
+package retention
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/risingwavelabs/risingwave-console/pkg/utils"
+	"github.com/risingwavelabs/risingwave-console/pkg/zgen/querier"
+)
+
+// Policy decides which snapshots to keep, a snapshot is kept if any rule keeps it. The
+// daily, weekly and monthly rules keep the newest snapshot of each of the N most recent
+// days, ISO weeks and months that have snapshots (grandfather-father-son).
+type Policy struct {
+	KeepLast    int
+	KeepDaily   int
+	KeepWeekly  int
+	KeepMonthly int
+}
+
+// IsEmpty returns true if the policy has no rule, nothing is pruned by an empty policy.
+func (p Policy) IsEmpty() bool {
+	return p.KeepLast <= 0 && p.KeepDaily <= 0 && p.KeepWeekly <= 0 && p.KeepMonthly <= 0
+}
+
+// PolicyFromConfig returns the retention policy of the auto backup config.
+func PolicyFromConfig(cfg *querier.AutoBackupConfig) Policy {
+	if cfg == nil {
+		return Policy{}
+	}
+	return Policy{
+		KeepLast:    int(utils.UnwrapOrDefault(cfg.KeepLast, 0)),
+		KeepDaily:   int(utils.UnwrapOrDefault(cfg.KeepDaily, 0)),
+		KeepWeekly:  int(utils.UnwrapOrDefault(cfg.KeepWeekly, 0)),
+		KeepMonthly: int(utils.UnwrapOrDefault(cfg.KeepMonthly, 0)),
+	}
+}
+
+type Snapshot struct {
+	ID        int64
+	CreatedAt time.Time
+}
+
+type Decision struct {
+	Snapshot
+	Keep bool
+	// Reasons are the rules keeping the snapshot, e.g. "last 1", "daily 2025-01-02"
+	Reasons []string
+}
+
+type bucketRule struct {
+	name   string
+	keep   int
+	bucket func(t time.Time) string
+}
+
+// Evaluate decides which snapshots are kept by the policy, the buckets are computed in the
+// given location. The decisions are ordered from the newest snapshot to the oldest.
+func Evaluate(policy Policy, snapshots []Snapshot, loc *time.Location) []Decision {
+	if loc == nil {
+		loc = time.UTC
+	}
+
+	decisions := make([]Decision, len(snapshots))
+	for i, snapshot := range snapshots {
+		decisions[i] = Decision{Snapshot: snapshot}
+	}
+	sort.SliceStable(decisions, func(i, j int) bool {
+		if decisions[i].CreatedAt.Equal(decisions[j].CreatedAt) {
+			return decisions[i].ID > decisions[j].ID
+		}
+		return decisions[i].CreatedAt.After(decisions[j].CreatedAt)
+	})
+
+	if policy.IsEmpty() {
+		for i := range decisions {
+			decisions[i].Keep = true
+			decisions[i].Reasons = []string{"no retention policy"}
+		}
+		return decisions
+	}
+
+	for i := 0; i < policy.KeepLast && i < len(decisions); i++ {
+		decisions[i].Reasons = append(decisions[i].Reasons, fmt.Sprintf("last %d", i+1))
+	}
+
+	rules := []bucketRule{
+		{name: "daily", keep: policy.KeepDaily, bucket: func(t time.Time) string {
+			return t.Format("2006-01-02")
+		}},
+		{name: "weekly", keep: policy.KeepWeekly, bucket: func(t time.Time) string {
+			year, week := t.ISOWeek()
+			return fmt.Sprintf("%d-W%02d", year, week)
+		}},
+		{name: "monthly", keep: policy.KeepMonthly, bucket: func(t time.Time) string {
+			return t.Format("2006-01")
+		}},
+	}
+	for _, rule := range rules {
+		seen := map[string]bool{}
+		for i := range decisions {
+			if len(seen) >= rule.keep {
+				break
+			}
+			bucket := rule.bucket(decisions[i].CreatedAt.In(loc))
+			if seen[bucket] {
+				continue
+			}
+			seen[bucket] = true
+			decisions[i].Reasons = append(decisions[i].Reasons, fmt.Sprintf("%s %s", rule.name, bucket))
+		}
+	}
+
+	for i := range decisions {
+		decisions[i].Keep = len(decisions[i].Reasons) > 0
+	}
+	return decisions
+}
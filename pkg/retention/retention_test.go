@@ -0,0 +1,111 @@
+package retention
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEvaluate(t *testing.T) {
+	at := func(day, hour int) time.Time {
+		return time.Date(2025, 1, day, hour, 0, 0, 0, time.UTC)
+	}
+
+	// two snapshots a day from Jan 1 (Wednesday) to Jan 14
+	var snapshots []Snapshot
+	id := int64(1)
+	for day := 1; day <= 14; day++ {
+		for _, hour := range []int{6, 18} {
+			snapshots = append(snapshots, Snapshot{ID: id, CreatedAt: at(day, hour)})
+			id++
+		}
+	}
+
+	kept := func(decisions []Decision) map[int64][]string {
+		result := map[int64][]string{}
+		for _, d := range decisions {
+			if d.Keep {
+				result[d.ID] = d.Reasons
+			}
+		}
+		return result
+	}
+
+	testCases := []struct {
+		name   string
+		policy Policy
+		loc    *time.Location
+		kept   map[int64][]string
+	}{
+		{
+			name:   "keep last",
+			policy: Policy{KeepLast: 3},
+			kept: map[int64][]string{
+				28: {"last 1"},
+				27: {"last 2"},
+				26: {"last 3"},
+			},
+		},
+		{
+			name:   "keep daily",
+			policy: Policy{KeepDaily: 2},
+			kept: map[int64][]string{
+				28: {"daily 2025-01-14"},
+				26: {"daily 2025-01-13"},
+			},
+		},
+		{
+			name:   "keep weekly",
+			policy: Policy{KeepWeekly: 3},
+			kept: map[int64][]string{
+				28: {"weekly 2025-W03"},
+				24: {"weekly 2025-W02"},
+				10: {"weekly 2025-W01"},
+			},
+		},
+		{
+			name:   "keep monthly beyond available months",
+			policy: Policy{KeepMonthly: 12},
+			kept: map[int64][]string{
+				28: {"monthly 2025-01"},
+			},
+		},
+		{
+			name:   "overlapping rules",
+			policy: Policy{KeepLast: 1, KeepDaily: 1, KeepWeekly: 2},
+			kept: map[int64][]string{
+				28: {"last 1", "daily 2025-01-14", "weekly 2025-W03"},
+				24: {"weekly 2025-W02"},
+			},
+		},
+		{
+			name:   "days in the location",
+			policy: Policy{KeepDaily: 2},
+			loc:    time.FixedZone("UTC+8", 8*60*60),
+			kept: map[int64][]string{
+				// 18:00 UTC of Jan 14 is Jan 15 in UTC+8
+				28: {"daily 2025-01-15"},
+				27: {"daily 2025-01-14"},
+			},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			decisions := Evaluate(tc.policy, snapshots, tc.loc)
+			assert.Len(t, decisions, len(snapshots))
+			assert.Equal(t, int64(28), decisions[0].ID)
+			assert.Equal(t, tc.kept, kept(decisions))
+		})
+	}
+}
+
+func TestEvaluateEmptyPolicy(t *testing.T) {
+	decisions := Evaluate(Policy{}, []Snapshot{
+		{ID: 1, CreatedAt: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)},
+		{ID: 2, CreatedAt: time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC)},
+	}, nil)
+	for _, d := range decisions {
+		assert.True(t, d.Keep)
+	}
+}
@@ -0,0 +1,284 @@
+package service
+
+import (
+	"context"
+	"strings"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/pkg/errors"
+	"github.com/risingwavelabs/risingwave-console/pkg/conn/metricsstore"
+	"github.com/risingwavelabs/risingwave-console/pkg/utils"
+	"github.com/risingwavelabs/risingwave-console/pkg/zcore/model"
+	"github.com/risingwavelabs/risingwave-console/pkg/zgen/apigen"
+	"github.com/risingwavelabs/risingwave-console/pkg/zgen/querier"
+)
+
+const (
+	defaultAlertForDuration  = "0s"
+	defaultAlertHistoryLimit = 100
+)
+
+func alertRuleToApi(r *querier.AlertRule) apigen.AlertRule {
+	return apigen.AlertRule{
+		ID:        r.ID,
+		ClusterID: r.ClusterID,
+		Name:      r.Name,
+		Expr:      r.Expr,
+		For:       r.ForDuration,
+		Severity:  apigen.AlertSeverity(r.Severity),
+		Labels:    r.Labels,
+		Enabled:   r.Enabled,
+		LastError: r.LastError,
+		CreatedAt: r.CreatedAt,
+		UpdatedAt: r.UpdatedAt,
+	}
+}
+
+func alertToApi(s *querier.ListOrgAlertStatesRow) apigen.Alert {
+	return apigen.Alert{
+		RuleID:      s.RuleID,
+		RuleName:    s.RuleName,
+		ClusterID:   s.ClusterID,
+		Status:      apigen.AlertStatus(s.Status),
+		Severity:    apigen.AlertSeverity(s.Severity),
+		Labels:      s.Labels,
+		Value:       utils.FiniteOrNil(s.Value),
+		ActiveAt:    s.ActiveAt,
+		FiredAt:     s.FiredAt,
+		EvaluatedAt: s.EvaluatedAt,
+	}
+}
+
+func alertHistoryToApi(h *querier.AlertHistory) apigen.AlertHistoryEntry {
+	return apigen.AlertHistoryEntry{
+		ID:        h.ID,
+		RuleID:    h.RuleID,
+		RuleName:  h.RuleName,
+		ClusterID: h.ClusterID,
+		Status:    apigen.AlertStatus(h.Status),
+		Severity:  apigen.AlertSeverity(h.Severity),
+		Labels:    h.Labels,
+		Value:     utils.FiniteOrNil(h.Value),
+		ActiveAt:  h.ActiveAt,
+		CreatedAt: h.CreatedAt,
+	}
+}
+
+// validateAlertRule checks the alert rule and returns its for duration, the cluster of the rule
+// must belong to the organization.
+func (s *Service) validateAlertRule(ctx context.Context, params apigen.AlertRuleCreate, orgID int32) (string, error) {
+	if strings.TrimSpace(params.Name) == "" {
+		return "", errors.Wrap(ErrInvalidAlertRule, "name is required")
+	}
+	if err := metricsstore.ValidateExpr(params.Expr); err != nil {
+		return "", errors.Wrap(ErrInvalidAlertRule, err.Error())
+	}
+	switch params.Severity {
+	case apigen.AlertSeverityInfo, apigen.AlertSeverityWarning, apigen.AlertSeverityCritical:
+	default:
+		return "", errors.Wrapf(ErrInvalidAlertRule, "unknown severity %q", params.Severity)
+	}
+	forDuration := utils.UnwrapOrDefault(params.For, defaultAlertForDuration)
+	d, err := utils.ParseDuration(forDuration)
+	if err != nil {
+		return "", errors.Wrapf(ErrInvalidAlertRule, "invalid for duration %q: %v", forDuration, err)
+	}
+	if d < 0 {
+		return "", errors.Wrapf(ErrInvalidAlertRule, "for duration must not be negative, got %s", forDuration)
+	}
+	if params.ClusterID != nil {
+		if _, err := s.getOrgCluster(ctx, *params.ClusterID, orgID); err != nil {
+			return "", err
+		}
+	}
+	return forDuration, nil
+}
+
+func (s *Service) getOrgAlertRule(ctx context.Context, id int32, orgID int32) (*querier.AlertRule, error) {
+	rule, err := s.m.GetOrgAlertRule(ctx, querier.GetOrgAlertRuleParams{
+		ID:    id,
+		OrgID: orgID,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrAlertRuleNotFound
+		}
+		return nil, errors.Wrapf(err, "failed to get alert rule")
+	}
+	return rule, nil
+}
+
+func (s *Service) ListAlertRules(ctx context.Context, params apigen.ListAlertRulesParams, orgID int32) ([]apigen.AlertRule, error) {
+	rules, err := s.m.ListOrgAlertRules(ctx, querier.ListOrgAlertRulesParams{
+		OrgID:     orgID,
+		ClusterID: params.ClusterID,
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to list alert rules")
+	}
+
+	result := make([]apigen.AlertRule, len(rules))
+	for i, r := range rules {
+		result[i] = alertRuleToApi(r)
+	}
+	return result, nil
+}
+
+func (s *Service) CreateAlertRule(ctx context.Context, params apigen.AlertRuleCreate, orgID int32) (*apigen.AlertRule, error) {
+	forDuration, err := s.validateAlertRule(ctx, params, orgID)
+	if err != nil {
+		return nil, err
+	}
+
+	rule, err := s.m.CreateAlertRule(ctx, querier.CreateAlertRuleParams{
+		OrgID:       orgID,
+		ClusterID:   params.ClusterID,
+		Name:        params.Name,
+		Expr:        params.Expr,
+		ForDuration: forDuration,
+		Severity:    string(params.Severity),
+		Labels:      utils.UnwrapOrDefault(params.Labels, apigen.AlertLabels{}),
+		Enabled:     utils.UnwrapOrDefault(params.Enabled, true),
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to create alert rule")
+	}
+	result := alertRuleToApi(rule)
+	return &result, nil
+}
+
+func (s *Service) GetAlertRule(ctx context.Context, id int32, orgID int32) (*apigen.AlertRule, error) {
+	rule, err := s.getOrgAlertRule(ctx, id, orgID)
+	if err != nil {
+		return nil, err
+	}
+	result := alertRuleToApi(rule)
+	return &result, nil
+}
+
+func (s *Service) UpdateAlertRule(ctx context.Context, id int32, params apigen.AlertRuleCreate, orgID int32) (*apigen.AlertRule, error) {
+	prev, err := s.getOrgAlertRule(ctx, id, orgID)
+	if err != nil {
+		return nil, err
+	}
+	forDuration, err := s.validateAlertRule(ctx, params, orgID)
+	if err != nil {
+		return nil, err
+	}
+
+	var rule *querier.AlertRule
+	if err := s.m.RunTransaction(ctx, func(txm model.ModelInterface) error {
+		rule, err = txm.UpdateOrgAlertRule(ctx, querier.UpdateOrgAlertRuleParams{
+			ID:          id,
+			OrgID:       orgID,
+			ClusterID:   params.ClusterID,
+			Name:        params.Name,
+			Expr:        params.Expr,
+			ForDuration: forDuration,
+			Severity:    string(params.Severity),
+			Labels:      utils.UnwrapOrDefault(params.Labels, apigen.AlertLabels{}),
+			Enabled:     utils.UnwrapOrDefault(params.Enabled, true),
+		})
+		if err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return ErrAlertRuleNotFound
+			}
+			return errors.Wrapf(err, "failed to update alert rule")
+		}
+		if prev.Expr == rule.Expr && equalClusterID(prev.ClusterID, rule.ClusterID) {
+			return nil
+		}
+		// the series of the previous expression or cluster are not comparable with the new ones,
+		// the alerts start over and the firing ones are resolved
+		return clearAlertStates(ctx, txm, prev)
+	}); err != nil {
+		return nil, err
+	}
+	result := alertRuleToApi(rule)
+	return &result, nil
+}
+
+// clearAlertStates deletes the alerts of the rule, the firing ones are recorded as resolved in
+// the history.
+func clearAlertStates(ctx context.Context, txm model.ModelInterface, rule *querier.AlertRule) error {
+	states, err := txm.DeleteAlertStatesByRule(ctx, rule.ID)
+	if err != nil {
+		return errors.Wrapf(err, "failed to delete alert states")
+	}
+	for _, state := range states {
+		if state.Status != string(apigen.AlertStatusFiring) {
+			continue
+		}
+		if err := txm.CreateAlertHistory(ctx, querier.CreateAlertHistoryParams{
+			OrgID:     rule.OrgID,
+			RuleID:    &rule.ID,
+			RuleName:  rule.Name,
+			ClusterID: state.ClusterID,
+			Status:    string(apigen.AlertStatusResolved),
+			Severity:  rule.Severity,
+			Labels:    state.Labels,
+			Value:     state.Value,
+			ActiveAt:  state.ActiveAt,
+		}); err != nil {
+			return errors.Wrapf(err, "failed to create alert history")
+		}
+	}
+	return nil
+}
+
+func equalClusterID(a, b *int32) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
+
+func (s *Service) DeleteAlertRule(ctx context.Context, id int32, orgID int32) error {
+	deleted, err := s.m.DeleteOrgAlertRule(ctx, querier.DeleteOrgAlertRuleParams{
+		ID:    id,
+		OrgID: orgID,
+	})
+	if err != nil {
+		return errors.Wrapf(err, "failed to delete alert rule")
+	}
+	if deleted == 0 {
+		return ErrAlertRuleNotFound
+	}
+	return nil
+}
+
+func (s *Service) ListAlerts(ctx context.Context, params apigen.ListAlertsParams, orgID int32) ([]apigen.Alert, error) {
+	states, err := s.m.ListOrgAlertStates(ctx, querier.ListOrgAlertStatesParams{
+		OrgID:     orgID,
+		ClusterID: params.ClusterID,
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to list alerts")
+	}
+
+	result := make([]apigen.Alert, len(states))
+	for i, state := range states {
+		result[i] = alertToApi(state)
+	}
+	return result, nil
+}
+
+func (s *Service) ListAlertHistory(ctx context.Context, params apigen.ListAlertHistoryParams, orgID int32) ([]apigen.AlertHistoryEntry, error) {
+	history, err := s.m.ListOrgAlertHistory(ctx, querier.ListOrgAlertHistoryParams{
+		OrgID:     orgID,
+		Limit:     utils.UnwrapOrDefault(params.Limit, defaultAlertHistoryLimit),
+		ClusterID: params.ClusterID,
+		RuleID:    params.RuleID,
+		From:      params.From,
+		To:        params.To,
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to list alert history")
+	}
+
+	result := make([]apigen.AlertHistoryEntry, len(history))
+	for i, h := range history {
+		result[i] = alertHistoryToApi(h)
+	}
+	return result, nil
+}
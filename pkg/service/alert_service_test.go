@@ -0,0 +1,263 @@
+package service
+
+import (
+	"context"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/risingwavelabs/risingwave-console/pkg/utils"
+	"github.com/risingwavelabs/risingwave-console/pkg/zcore/model"
+	"github.com/risingwavelabs/risingwave-console/pkg/zgen/apigen"
+	"github.com/risingwavelabs/risingwave-console/pkg/zgen/querier"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestCreateAlertRule(t *testing.T) {
+	var (
+		orgID     = int32(201)
+		clusterID = int32(101)
+	)
+
+	testCases := []struct {
+		name        string
+		params      apigen.AlertRuleCreate
+		clusterErr  error
+		forDuration string
+		err         error
+	}{
+		{
+			name:        "all clusters",
+			params:      apigen.AlertRuleCreate{Name: "node down", Expr: "up == 0", Severity: apigen.AlertSeverityCritical},
+			forDuration: "0s",
+		},
+		{
+			name: "cluster",
+			params: apigen.AlertRuleCreate{
+				Name:      "no throughput",
+				ClusterID: &clusterID,
+				Expr:      "sum(rate(stream_mview_input_row_count[1m])) == 0",
+				For:       utils.Ptr("10m"),
+				Severity:  apigen.AlertSeverityWarning,
+				Labels:    &apigen.AlertLabels{"team": "streaming"},
+				Enabled:   utils.Ptr(false),
+			},
+			forDuration: "10m",
+		},
+		{
+			name:       "cluster of another organization",
+			params:     apigen.AlertRuleCreate{Name: "node down", ClusterID: &clusterID, Expr: "up == 0", Severity: apigen.AlertSeverityInfo},
+			clusterErr: pgx.ErrNoRows,
+			err:        ErrClusterNotFound,
+		},
+		{
+			name:   "empty name",
+			params: apigen.AlertRuleCreate{Name: " ", Expr: "up == 0", Severity: apigen.AlertSeverityInfo},
+			err:    ErrInvalidAlertRule,
+		},
+		{
+			name:   "invalid expression",
+			params: apigen.AlertRuleCreate{Name: "node down", Expr: "sum(up", Severity: apigen.AlertSeverityInfo},
+			err:    ErrInvalidAlertRule,
+		},
+		{
+			name:   "unknown severity",
+			params: apigen.AlertRuleCreate{Name: "node down", Expr: "up == 0", Severity: "fatal"},
+			err:    ErrInvalidAlertRule,
+		},
+		{
+			name:   "invalid for duration",
+			params: apigen.AlertRuleCreate{Name: "node down", Expr: "up == 0", For: utils.Ptr("soon"), Severity: apigen.AlertSeverityInfo},
+			err:    ErrInvalidAlertRule,
+		},
+		{
+			name:   "negative for duration",
+			params: apigen.AlertRuleCreate{Name: "node down", Expr: "up == 0", For: utils.Ptr("-5m"), Severity: apigen.AlertSeverityInfo},
+			err:    ErrInvalidAlertRule,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockModel := model.NewMockModelInterfaceWithTransaction(ctrl)
+			service := &Service{m: mockModel}
+
+			if tc.params.ClusterID != nil {
+				mockModel.EXPECT().GetOrgCluster(gomock.Any(), querier.GetOrgClusterParams{
+					ID:    clusterID,
+					OrgID: orgID,
+				}).Return(&querier.Cluster{ID: clusterID, OrgID: orgID}, tc.clusterErr)
+			}
+			if tc.err == nil {
+				mockModel.EXPECT().CreateAlertRule(gomock.Any(), querier.CreateAlertRuleParams{
+					OrgID:       orgID,
+					ClusterID:   tc.params.ClusterID,
+					Name:        tc.params.Name,
+					Expr:        tc.params.Expr,
+					ForDuration: tc.forDuration,
+					Severity:    string(tc.params.Severity),
+					Labels:      utils.UnwrapOrDefault(tc.params.Labels, apigen.AlertLabels{}),
+					Enabled:     utils.UnwrapOrDefault(tc.params.Enabled, true),
+				}).DoAndReturn(func(_ context.Context, arg querier.CreateAlertRuleParams) (*querier.AlertRule, error) {
+					return &querier.AlertRule{
+						ID:          301,
+						OrgID:       arg.OrgID,
+						ClusterID:   arg.ClusterID,
+						Name:        arg.Name,
+						Expr:        arg.Expr,
+						ForDuration: arg.ForDuration,
+						Severity:    arg.Severity,
+						Labels:      arg.Labels,
+						Enabled:     arg.Enabled,
+					}, nil
+				})
+			}
+
+			rule, err := service.CreateAlertRule(context.Background(), tc.params, orgID)
+			if tc.err != nil {
+				assert.ErrorIs(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, int32(301), rule.ID)
+			assert.Equal(t, tc.forDuration, rule.For)
+			assert.Equal(t, tc.params.Severity, rule.Severity)
+			assert.Equal(t, utils.UnwrapOrDefault(tc.params.Enabled, true), rule.Enabled)
+		})
+	}
+}
+
+func TestUpdateAlertRuleNotFound(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockModel := model.NewMockModelInterfaceWithTransaction(ctrl)
+	service := &Service{m: mockModel}
+
+	mockModel.EXPECT().GetOrgAlertRule(gomock.Any(), querier.GetOrgAlertRuleParams{ID: 301, OrgID: 201}).Return(nil, pgx.ErrNoRows)
+
+	_, err := service.UpdateAlertRule(context.Background(), 301, apigen.AlertRuleCreate{Name: "node down", Expr: "up == 0", Severity: apigen.AlertSeverityInfo}, 201)
+	assert.ErrorIs(t, err, ErrAlertRuleNotFound)
+}
+
+func TestUpdateAlertRuleClearsAlerts(t *testing.T) {
+	var (
+		orgID     = int32(201)
+		ruleID    = int32(301)
+		clusterID = int32(101)
+		activeAt  = time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)
+	)
+	prev := &querier.AlertRule{ID: ruleID, OrgID: orgID, ClusterID: &clusterID, Name: "node down", Expr: "up == 0", ForDuration: "0s", Severity: "critical"}
+
+	testCases := []struct {
+		name    string
+		params  apigen.AlertRuleCreate
+		cleared bool
+	}{
+		{
+			name:   "name changed",
+			params: apigen.AlertRuleCreate{Name: "nodes down", ClusterID: &clusterID, Expr: "up == 0", Severity: apigen.AlertSeverityWarning},
+		},
+		{
+			name:    "expression changed",
+			params:  apigen.AlertRuleCreate{Name: "node down", ClusterID: &clusterID, Expr: "up < 1", Severity: apigen.AlertSeverityCritical},
+			cleared: true,
+		},
+		{
+			name:    "cluster changed",
+			params:  apigen.AlertRuleCreate{Name: "node down", Expr: "up == 0", Severity: apigen.AlertSeverityCritical},
+			cleared: true,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockModel := model.NewMockModelInterfaceWithTransaction(ctrl)
+			service := &Service{m: mockModel}
+
+			mockModel.EXPECT().GetOrgAlertRule(gomock.Any(), querier.GetOrgAlertRuleParams{ID: ruleID, OrgID: orgID}).Return(prev, nil)
+			if tc.params.ClusterID != nil {
+				mockModel.EXPECT().GetOrgCluster(gomock.Any(), gomock.Any()).Return(&querier.Cluster{ID: clusterID, OrgID: orgID}, nil)
+			}
+			mockModel.EXPECT().UpdateOrgAlertRule(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, params querier.UpdateOrgAlertRuleParams) (*querier.AlertRule, error) {
+				return &querier.AlertRule{ID: params.ID, OrgID: params.OrgID, ClusterID: params.ClusterID, Name: params.Name, Expr: params.Expr, ForDuration: params.ForDuration, Severity: params.Severity}, nil
+			})
+			if tc.cleared {
+				mockModel.EXPECT().DeleteAlertStatesByRule(gomock.Any(), ruleID).Return([]*querier.AlertState{
+					{RuleID: ruleID, ClusterID: clusterID, Series: `up{instance="a"}`, Status: "firing", Value: 0, ActiveAt: activeAt},
+					{RuleID: ruleID, ClusterID: clusterID, Series: `up{instance="b"}`, Status: "pending", Value: 0, ActiveAt: activeAt},
+				}, nil)
+				// only the firing alert is resolved, with the name of the rule it fired with
+				mockModel.EXPECT().CreateAlertHistory(gomock.Any(), querier.CreateAlertHistoryParams{
+					OrgID:     orgID,
+					RuleID:    &ruleID,
+					RuleName:  "node down",
+					ClusterID: clusterID,
+					Status:    string(apigen.AlertStatusResolved),
+					Severity:  "critical",
+					Value:     0,
+					ActiveAt:  activeAt,
+				}).Return(nil)
+			}
+
+			rule, err := service.UpdateAlertRule(context.Background(), ruleID, tc.params, orgID)
+			require.NoError(t, err)
+			assert.Equal(t, tc.params.Expr, rule.Expr)
+		})
+	}
+}
+
+func TestDeleteAlertRule(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockModel := model.NewMockModelInterfaceWithTransaction(ctrl)
+	service := &Service{m: mockModel}
+
+	mockModel.EXPECT().DeleteOrgAlertRule(gomock.Any(), querier.DeleteOrgAlertRuleParams{ID: 301, OrgID: 201}).Return(int64(1), nil)
+	mockModel.EXPECT().DeleteOrgAlertRule(gomock.Any(), querier.DeleteOrgAlertRuleParams{ID: 302, OrgID: 201}).Return(int64(0), nil)
+
+	require.NoError(t, service.DeleteAlertRule(context.Background(), 301, 201))
+	assert.ErrorIs(t, service.DeleteAlertRule(context.Background(), 302, 201), ErrAlertRuleNotFound)
+}
+
+func TestListAlertHistory(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	var (
+		orgID     = int32(201)
+		clusterID = int32(101)
+		from      = time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)
+	)
+	mockModel := model.NewMockModelInterfaceWithTransaction(ctrl)
+	service := &Service{m: mockModel}
+
+	mockModel.EXPECT().ListOrgAlertHistory(gomock.Any(), querier.ListOrgAlertHistoryParams{
+		OrgID:     orgID,
+		Limit:     defaultAlertHistoryLimit,
+		ClusterID: &clusterID,
+		From:      &from,
+	}).Return([]*querier.AlertHistory{
+		{ID: 2, OrgID: orgID, RuleName: "node down", ClusterID: clusterID, Status: "resolved", Severity: "critical", Value: math.NaN()},
+		{ID: 1, OrgID: orgID, RuleID: utils.Ptr(int32(301)), RuleName: "node down", ClusterID: clusterID, Status: "firing", Severity: "critical", Value: 0},
+	}, nil)
+
+	history, err := service.ListAlertHistory(context.Background(), apigen.ListAlertHistoryParams{ClusterID: &clusterID, From: &from}, orgID)
+	require.NoError(t, err)
+	require.Len(t, history, 2)
+
+	assert.Equal(t, apigen.AlertStatusResolved, history[0].Status)
+	assert.Nil(t, history[0].RuleID, "the rule is deleted")
+	assert.Nil(t, history[0].Value, "NaN cannot be encoded in JSON")
+	assert.Equal(t, apigen.AlertStatusFiring, history[1].Status)
+	assert.Equal(t, apigen.AlertSeverityCritical, history[1].Severity)
+	assert.Equal(t, 0.0, *history[1].Value)
+}
@@ -17,7 +17,7 @@ func (s *Service) GetDDLProgress(ctx context.Context, id int32, orgID int32) ([]
 		return nil, errors.Wrapf(err, "failed to get database connection")
 	}
 
-	result, err := conn.Query(ctx, getDDLProgressSQL, false)
+	result, err := conn.Query(ctx, getDDLProgressSQL, nil)
 	if err != nil {
 		return nil, errors.Wrapf(err, "failed to get DDL progress")
 	}
@@ -43,7 +43,7 @@ func (s *Service) CancelDDLProgress(ctx context.Context, id int32, ddlID int64,
 		return errors.Wrapf(err, "failed to get database connection")
 	}
 
-	_, err = conn.Query(ctx, fmt.Sprintf("CANCEL JOB %d", ddlID), false)
+	_, err = conn.Query(ctx, fmt.Sprintf("CANCEL JOB %d", ddlID), nil)
 	if err != nil {
 		return errors.Wrapf(err, "failed to cancel DDL progress")
 	}
@@ -8,6 +8,8 @@ import (
 	"github.com/cloudcarver/anchor/pkg/taskcore"
 	"github.com/jackc/pgx/v5"
 	"github.com/pkg/errors"
+	"github.com/risingwavelabs/risingwave-console/pkg/retention"
+	"github.com/risingwavelabs/risingwave-console/pkg/utils"
 	"github.com/risingwavelabs/risingwave-console/pkg/zcore/model"
 	"github.com/risingwavelabs/risingwave-console/pkg/zgen/apigen"
 	"github.com/risingwavelabs/risingwave-console/pkg/zgen/querier"
@@ -29,15 +31,20 @@ func (s *Service) CreateClusterSnapshot(ctx context.Context, id int32, name stri
 		ClusterID:  id,
 		SnapshotID: snapshotID,
 		Name:       name,
+		Source:     string(apigen.SnapshotSourceConsole),
 	}); err != nil {
 		return nil, errors.Wrapf(err, "failed to create snapshot")
 	}
 
+	now := time.Now()
 	return &apigen.Snapshot{
-		ID:        snapshotID,
-		Name:      name,
-		ClusterID: id,
-		CreatedAt: time.Now(),
+		ID:         snapshotID,
+		Name:       name,
+		ClusterID:  id,
+		CreatedAt:  now,
+		Source:     apigen.SnapshotSourceConsole,
+		SyncStatus: apigen.Synced,
+		SyncedAt:   &now,
 	}, nil
 }
 
@@ -49,17 +56,24 @@ func (s *Service) ListClusterSnapshots(ctx context.Context, id int32, orgID int3
 
 	result := make([]apigen.Snapshot, len(snapshots))
 	for i, snapshot := range snapshots {
-		result[i] = apigen.Snapshot{
-			ID:        snapshot.SnapshotID,
-			Name:      snapshot.Name,
-			ClusterID: snapshot.ClusterID,
-			CreatedAt: snapshot.CreatedAt,
-		}
+		result[i] = snapshotToApi(snapshot)
 	}
 
 	return result, nil
 }
 
+func snapshotToApi(snapshot *querier.ClusterSnapshot) apigen.Snapshot {
+	return apigen.Snapshot{
+		ID:         snapshot.SnapshotID,
+		Name:       snapshot.Name,
+		ClusterID:  snapshot.ClusterID,
+		CreatedAt:  snapshot.CreatedAt,
+		Source:     apigen.SnapshotSource(snapshot.Source),
+		SyncStatus: apigen.SnapshotSyncStatus(snapshot.SyncStatus),
+		SyncedAt:   snapshot.SyncedAt,
+	}
+}
+
 func (s *Service) DeleteClusterSnapshot(ctx context.Context, id int32, snapshotID int64, orgID int32) error {
 	conn, err := s.getRisectlConn(ctx, id)
 	if err != nil {
@@ -70,6 +84,13 @@ func (s *Service) DeleteClusterSnapshot(ctx context.Context, id int32, snapshotI
 		return errors.Wrapf(err, "failed to delete snapshot")
 	}
 
+	if err := s.m.DeleteClusterSnapshot(ctx, querier.DeleteClusterSnapshotParams{
+		ClusterID:  id,
+		SnapshotID: snapshotID,
+	}); err != nil {
+		return errors.Wrapf(err, "failed to delete snapshot record")
+	}
+
 	return nil
 }
 
@@ -88,6 +109,11 @@ func (s *Service) UpdateClusterAutoBackupConfig(ctx context.Context, id int32, p
 	}
 	cronExpression := fmt.Sprintf("CRON_TZ=%s %s", orgSettings.Timezone, params.CronExpression)
 
+	if err := validateRetentionPolicy(params.RetentionPolicy); err != nil {
+		return err
+	}
+	retentionParams := retentionPolicyToParams(cluster.ID, params.RetentionPolicy)
+
 	c, err := s.m.GetAutoBackupConfig(ctx, cluster.ID)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
@@ -108,6 +134,9 @@ func (s *Service) UpdateClusterAutoBackupConfig(ctx context.Context, id int32, p
 				}); err != nil {
 					return errors.Wrapf(err, "failed to create auto backup config")
 				}
+				if err := txm.UpdateAutoBackupRetentionPolicy(ctx, retentionParams); err != nil {
+					return errors.Wrapf(err, "failed to update retention policy")
+				}
 				return nil
 			}); err != nil {
 				return errors.Wrapf(err, "failed to create new cluster auto backup config")
@@ -136,6 +165,9 @@ func (s *Service) UpdateClusterAutoBackupConfig(ctx context.Context, id int32, p
 		}); err != nil {
 			return errors.Wrapf(err, "failed to update auto backup config")
 		}
+		if err := txm.UpdateAutoBackupRetentionPolicy(ctx, retentionParams); err != nil {
+			return errors.Wrapf(err, "failed to update retention policy")
+		}
 
 		taskParams := taskgen.AutoBackupParameters{
 			ClusterID:         cluster.ID,
@@ -177,9 +209,105 @@ func (s *Service) GetClusterAutoBackupConfig(ctx context.Context, id int32, orgI
 		return nil, errors.Wrapf(err, "failed to unmarshal task spec")
 	}
 
+	var retentionPolicy *apigen.SnapshotRetentionPolicy
+	if !retention.PolicyFromConfig(c).IsEmpty() {
+		retentionPolicy = &apigen.SnapshotRetentionPolicy{
+			KeepLast:    c.KeepLast,
+			KeepDaily:   c.KeepDaily,
+			KeepWeekly:  c.KeepWeekly,
+			KeepMonthly: c.KeepMonthly,
+		}
+	}
+
 	return &apigen.AutoBackupConfig{
 		Enabled:           c.Enabled,
 		CronExpression:    task.Attributes.Cronjob.CronExpression,
 		RetentionDuration: params.RetentionDuration,
+		RetentionPolicy:   retentionPolicy,
 	}, nil
 }
+
+func validateRetentionPolicy(policy *apigen.SnapshotRetentionPolicy) error {
+	if policy == nil {
+		return nil
+	}
+	for _, v := range []*int32{policy.KeepLast, policy.KeepDaily, policy.KeepWeekly, policy.KeepMonthly} {
+		if v != nil && *v < 0 {
+			return errors.Wrapf(ErrInvalidRetentionPolicy, "the number of snapshots to keep must not be negative")
+		}
+	}
+	return nil
+}
+
+// retentionPolicyToParams clears the rules keeping no snapshot, so the policy without rules
+// is stored as no policy.
+func retentionPolicyToParams(clusterID int32, policy *apigen.SnapshotRetentionPolicy) querier.UpdateAutoBackupRetentionPolicyParams {
+	params := querier.UpdateAutoBackupRetentionPolicyParams{ClusterID: clusterID}
+	if policy == nil {
+		return params
+	}
+	positive := func(v *int32) *int32 {
+		if v == nil || *v <= 0 {
+			return nil
+		}
+		return v
+	}
+	params.KeepLast = positive(policy.KeepLast)
+	params.KeepDaily = positive(policy.KeepDaily)
+	params.KeepWeekly = positive(policy.KeepWeekly)
+	params.KeepMonthly = positive(policy.KeepMonthly)
+	return params
+}
+
+func (s *Service) PreviewClusterSnapshotRetention(ctx context.Context, id int32, policy apigen.SnapshotRetentionPolicy, orgID int32) ([]apigen.SnapshotRetentionDecision, error) {
+	if err := validateRetentionPolicy(&policy); err != nil {
+		return nil, err
+	}
+
+	cluster, err := s.getOrgCluster(ctx, id, orgID)
+	if err != nil {
+		return nil, err
+	}
+
+	orgSettings, err := s.m.GetOrgSettings(ctx, orgID)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get organization settings")
+	}
+	loc, err := time.LoadLocation(orgSettings.Timezone)
+	if err != nil {
+		loc = time.UTC
+	}
+
+	snapshots, err := s.m.ListClusterSnapshots(ctx, cluster.ID)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to list cluster snapshots")
+	}
+
+	// only the automatic snapshots are subject to the policy
+	bySnapshotID := map[int64]*querier.ClusterSnapshot{}
+	var candidates []retention.Snapshot
+	for _, snapshot := range snapshots {
+		if snapshot.Source != string(apigen.SnapshotSourceAuto) {
+			continue
+		}
+		bySnapshotID[snapshot.SnapshotID] = snapshot
+		candidates = append(candidates, retention.Snapshot{ID: snapshot.SnapshotID, CreatedAt: snapshot.CreatedAt})
+	}
+
+	decisions := retention.Evaluate(retention.PolicyFromConfig(&querier.AutoBackupConfig{
+		KeepLast:    policy.KeepLast,
+		KeepDaily:   policy.KeepDaily,
+		KeepWeekly:  policy.KeepWeekly,
+		KeepMonthly: policy.KeepMonthly,
+	}), candidates, loc)
+
+	result := make([]apigen.SnapshotRetentionDecision, len(decisions))
+	for i, decision := range decisions {
+		result[i] = apigen.SnapshotRetentionDecision{
+			Snapshot: snapshotToApi(bySnapshotID[decision.ID]),
+			Keep:     decision.Keep,
+			Reasons:  utils.IfElse(decision.Reasons == nil, []string{}, decision.Reasons),
+		}
+	}
+	return result, nil
+}
@@ -5,9 +5,11 @@ import (
 	"encoding/json"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/cloudcarver/anchor/pkg/taskcore"
 	"github.com/jackc/pgx/v5"
+	"github.com/risingwavelabs/risingwave-console/pkg/utils"
 	"github.com/risingwavelabs/risingwave-console/pkg/zcore/model"
 	"github.com/risingwavelabs/risingwave-console/pkg/zgen/apigen"
 	"github.com/risingwavelabs/risingwave-console/pkg/zgen/querier"
@@ -44,6 +46,8 @@ func TestUpdateClusterAutoBackupConfig(t *testing.T) {
 		err     error
 		cfg     *querier.AutoBackupConfig
 		enabled bool
+		policy  *apigen.SnapshotRetentionPolicy
+		keep    querier.UpdateAutoBackupRetentionPolicyParams
 	}
 
 	testCases := []testCase{
@@ -75,6 +79,30 @@ func TestUpdateClusterAutoBackupConfig(t *testing.T) {
 			},
 			enabled: false,
 		},
+		{
+			name: "retention policy",
+			err:  nil,
+			cfg: &querier.AutoBackupConfig{
+				TaskID: taskID,
+			},
+			enabled: true,
+			policy: &apigen.SnapshotRetentionPolicy{
+				KeepLast:  utils.Ptr(int32(7)),
+				KeepDaily: utils.Ptr(int32(0)),
+			},
+			keep: querier.UpdateAutoBackupRetentionPolicyParams{
+				KeepLast: utils.Ptr(int32(7)),
+			},
+		},
+		{
+			name:   "retention policy of new auto backup config",
+			err:    pgx.ErrNoRows,
+			cfg:    nil,
+			policy: &apigen.SnapshotRetentionPolicy{KeepWeekly: utils.Ptr(int32(4))},
+			keep: querier.UpdateAutoBackupRetentionPolicyParams{
+				KeepWeekly: utils.Ptr(int32(4)),
+			},
+		},
 	}
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
@@ -124,13 +152,59 @@ func TestUpdateClusterAutoBackupConfig(t *testing.T) {
 					Enabled:   true,
 				}).Return(nil)
 			}
+			keep := tc.keep
+			keep.ClusterID = clusterID
+			mockModel.EXPECT().UpdateAutoBackupRetentionPolicy(gomock.Any(), keep).Return(nil)
 
 			err := service.UpdateClusterAutoBackupConfig(ctx, clusterID, apigen.AutoBackupConfig{
 				CronExpression:    cronExpression,
 				RetentionDuration: retentionDuration,
 				Enabled:           tc.enabled,
+				RetentionPolicy:   tc.policy,
 			}, orgID)
 			assert.NoError(t, err)
 		})
 	}
 }
+
+func TestPreviewClusterSnapshotRetention(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	var (
+		orgID     = int32(201)
+		clusterID = int32(101)
+		day       = time.Date(2025, 1, 10, 12, 0, 0, 0, time.UTC)
+	)
+
+	mockModel := model.NewMockModelInterface(ctrl)
+	service := &Service{m: mockModel}
+
+	mockModel.EXPECT().GetOrgCluster(gomock.Any(), querier.GetOrgClusterParams{ID: clusterID, OrgID: orgID}).Return(&querier.Cluster{ID: clusterID}, nil)
+	mockModel.EXPECT().GetOrgSettings(gomock.Any(), orgID).Return(&querier.OrgSetting{OrgID: orgID, Timezone: "UTC"}, nil)
+	mockModel.EXPECT().ListClusterSnapshots(gomock.Any(), clusterID).Return([]*querier.ClusterSnapshot{
+		{ClusterID: clusterID, SnapshotID: 3, Source: "auto", CreatedAt: day},
+		{ClusterID: clusterID, SnapshotID: 2, Source: "console", CreatedAt: day.Add(-time.Hour)},
+		{ClusterID: clusterID, SnapshotID: 1, Source: "auto", CreatedAt: day.Add(-2 * time.Hour)},
+	}, nil)
+
+	decisions, err := service.PreviewClusterSnapshotRetention(context.Background(), clusterID, apigen.SnapshotRetentionPolicy{
+		KeepLast: utils.Ptr(int32(1)),
+	}, orgID)
+	require.NoError(t, err)
+	require.Len(t, decisions, 2)
+	assert.Equal(t, int64(3), decisions[0].Snapshot.ID)
+	assert.True(t, decisions[0].Keep)
+	assert.Equal(t, []string{"last 1"}, decisions[0].Reasons)
+	assert.Equal(t, int64(1), decisions[1].Snapshot.ID)
+	assert.False(t, decisions[1].Keep)
+	assert.Equal(t, []string{}, decisions[1].Reasons)
+}
+
+func TestPreviewClusterSnapshotRetentionInvalidPolicy(t *testing.T) {
+	service := &Service{}
+	_, err := service.PreviewClusterSnapshotRetention(context.Background(), 101, apigen.SnapshotRetentionPolicy{
+		KeepDaily: utils.Ptr(int32(-1)),
+	}, 201)
+	require.ErrorIs(t, err, ErrInvalidRetentionPolicy)
+}
@@ -0,0 +1,177 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/risingwavelabs/risingwave-console/pkg/utils"
+	"github.com/risingwavelabs/risingwave-console/pkg/zgen/apigen"
+	"github.com/risingwavelabs/risingwave-console/pkg/zgen/querier"
+	"golang.org/x/sync/singleflight"
+)
+
+const (
+	DefaultCatalogCacheTTL = 5 * time.Minute
+
+	// catalogLoadTimeout bounds a load of the catalog, the load is shared by all the callers
+	// waiting for it so it does not run with the context of any of them.
+	catalogLoadTimeout = time.Minute
+
+	defaultCatalogChangeLimit = 100
+	maxCatalogChangeLimit     = 1000
+)
+
+type catalogEntry struct {
+	schemas   []apigen.Schema
+	fetchedAt time.Time
+}
+
+// catalogCache caches the catalog of each database for ttl, concurrent
+// loads of the same database share one query. Every invalidation bumps the
+// generation of the database, so a load started before it is not cached.
+type catalogCache struct {
+	ttl         time.Duration
+	mu          sync.RWMutex
+	entries     map[int32]*catalogEntry
+	generations map[int32]uint64
+	group       singleflight.Group
+}
+
+func newCatalogCache(ttl time.Duration) *catalogCache {
+	return &catalogCache{
+		ttl:         ttl,
+		entries:     make(map[int32]*catalogEntry),
+		generations: make(map[int32]uint64),
+	}
+}
+
+func (c *catalogCache) get(databaseID int32, now time.Time) (*catalogEntry, bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	entry, ok := c.entries[databaseID]
+	if !ok || now.Sub(entry.fetchedAt) >= c.ttl {
+		return nil, false
+	}
+	return entry, true
+}
+
+func (c *catalogCache) generation(databaseID int32) uint64 {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.generations[databaseID]
+}
+
+// set caches the entry loaded at the given generation, it is dropped if the database has been
+// invalidated since.
+func (c *catalogCache) set(databaseID int32, generation uint64, entry *catalogEntry) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.generations[databaseID] != generation {
+		return false
+	}
+	c.entries[databaseID] = entry
+	return true
+}
+
+func (c *catalogCache) invalidate(databaseID int32) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.entries, databaseID)
+	c.generations[databaseID]++
+}
+
+// load returns the cached catalog of the database, it is fetched again if it is expired or
+// refresh is set. The callers loading the same generation share one fetch, which runs detached
+// from their contexts so a cancelled caller does not fail the others.
+func (c *catalogCache) load(ctx context.Context, databaseID int32, refresh bool, now func() time.Time, fetch func(ctx context.Context) ([]apigen.Schema, error)) (*catalogEntry, error) {
+	if !refresh {
+		if entry, ok := c.get(databaseID, now()); ok {
+			return entry, nil
+		}
+	}
+
+	generation := c.generation(databaseID)
+	key := fmt.Sprintf("%d/%d", databaseID, generation)
+	ch := c.group.DoChan(key, func() (any, error) {
+		loadCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), catalogLoadTimeout)
+		defer cancel()
+
+		schemas, err := fetch(loadCtx)
+		if err != nil {
+			return nil, err
+		}
+		entry := &catalogEntry{
+			schemas:   schemas,
+			fetchedAt: now(),
+		}
+		c.set(databaseID, generation, entry)
+		return entry, nil
+	})
+
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case result := <-ch:
+		if result.Err != nil {
+			return nil, result.Err
+		}
+		return result.Val.(*catalogEntry), nil
+	}
+}
+
+// getCatalog returns the cached catalog of the database, it is fetched again
+// if it is expired or refresh is set.
+func (s *Service) getCatalog(ctx context.Context, db *querier.DatabaseConnection, refresh bool) (*catalogEntry, error) {
+	return s.catalogCache.load(ctx, db.ID, refresh, s.now, func(ctx context.Context) ([]apigen.Schema, error) {
+		return s.fetchCatalog(ctx, db)
+	})
+}
+
+// isDDLQuery reports whether any statement in the query may change the catalog.
+func isDDLQuery(query string) bool {
+	statements, _ := tokenizeSQL(query)
+	for _, tokens := range statements {
+		switch tokens[0].word() {
+		case "create", "drop", "alter":
+			return true
+		}
+	}
+	return false
+}
+
+func (s *Service) ListDatabaseCatalogChanges(ctx context.Context, id int32, params apigen.ListDatabaseCatalogChangesParams, orgID int32) ([]apigen.CatalogChangeEvent, error) {
+	db, err := s.getDb(ctx, id, orgID)
+	if err != nil {
+		return nil, err
+	}
+
+	events, err := s.m.ListCatalogChangeEvents(ctx, querier.ListCatalogChangeEventsParams{
+		DatabaseID:   db.ID,
+		Limit:        utils.ClampLimit(params.Limit, defaultCatalogChangeLimit, maxCatalogChangeLimit),
+		RelationID:   params.RelationID,
+		RelationName: params.RelationName,
+		Before:       params.Before,
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to list catalog change events")
+	}
+
+	result := make([]apigen.CatalogChangeEvent, len(events))
+	for i, event := range events {
+		result[i] = apigen.CatalogChangeEvent{
+			ID:           event.ID,
+			DatabaseID:   event.DatabaseID,
+			RelationID:   event.RelationID,
+			Schema:       event.SchemaName,
+			Name:         event.RelationName,
+			RelationType: event.RelationType,
+			ChangeType:   apigen.CatalogChangeType(event.ChangeType),
+			Details:      event.Details,
+			CreatedAt:    event.CreatedAt,
+		}
+	}
+	return result, nil
+}
@@ -0,0 +1,285 @@
+package service
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/pkg/errors"
+	"github.com/risingwavelabs/risingwave-console/pkg/conn/sql"
+	"github.com/risingwavelabs/risingwave-console/pkg/utils"
+	"github.com/risingwavelabs/risingwave-console/pkg/zcore/model"
+	"github.com/risingwavelabs/risingwave-console/pkg/zgen/apigen"
+	"github.com/risingwavelabs/risingwave-console/pkg/zgen/querier"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func testCatalog() []apigen.Schema {
+	return []apigen.Schema{
+		{Name: "public", Relations: []apigen.Relation{
+			{ID: 1, Schema: "public", Name: "t1", Type: apigen.Table, Dependencies: []int32{}},
+			{ID: 2, Schema: "public", Name: "mv1", Type: apigen.MaterializedView, Dependencies: []int32{1}},
+		}},
+	}
+}
+
+func TestCatalogCacheLoad(t *testing.T) {
+	var (
+		ctx     = context.Background()
+		now     = time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+		fetches atomic.Int32
+	)
+	cache := newCatalogCache(time.Minute)
+	clock := func() time.Time { return now }
+	fetch := func(context.Context) ([]apigen.Schema, error) {
+		fetches.Add(1)
+		return testCatalog(), nil
+	}
+
+	entry, err := cache.load(ctx, 1, false, clock, fetch)
+	require.NoError(t, err)
+	assert.Equal(t, testCatalog(), entry.schemas)
+	assert.Equal(t, now, entry.fetchedAt)
+
+	_, err = cache.load(ctx, 1, false, clock, fetch)
+	require.NoError(t, err)
+	assert.Equal(t, int32(1), fetches.Load(), "the cached catalog is returned")
+
+	_, err = cache.load(ctx, 1, true, clock, fetch)
+	require.NoError(t, err)
+	assert.Equal(t, int32(2), fetches.Load(), "a refresh fetches the catalog")
+
+	now = now.Add(time.Minute)
+	_, err = cache.load(ctx, 1, false, clock, fetch)
+	require.NoError(t, err)
+	assert.Equal(t, int32(3), fetches.Load(), "an expired catalog is fetched")
+
+	cache.invalidate(1)
+	_, ok := cache.get(1, now)
+	assert.False(t, ok)
+
+	// a failed fetch is not cached
+	_, err = cache.load(ctx, 1, false, clock, func(context.Context) ([]apigen.Schema, error) {
+		return nil, errors.New("connection refused")
+	})
+	require.Error(t, err)
+	_, ok = cache.get(1, now)
+	assert.False(t, ok)
+}
+
+func TestCatalogCacheLoadSurvivesCancelledCaller(t *testing.T) {
+	var (
+		now     = time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+		clock   = func() time.Time { return now }
+		started = make(chan struct{})
+		release = make(chan struct{})
+		fetches atomic.Int32
+	)
+	cache := newCatalogCache(time.Minute)
+	fetch := func(ctx context.Context) ([]apigen.Schema, error) {
+		fetches.Add(1)
+		close(started)
+		<-release
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		return testCatalog(), nil
+	}
+
+	// the first caller starts the load and gives up
+	firstCtx, cancel := context.WithCancel(context.Background())
+	firstErr := make(chan error)
+	go func() {
+		_, err := cache.load(firstCtx, 1, false, clock, fetch)
+		firstErr <- err
+	}()
+	<-started
+
+	secondResult := make(chan *catalogEntry)
+	go func() {
+		entry, err := cache.load(context.Background(), 1, false, clock, fetch)
+		assert.NoError(t, err)
+		secondResult <- entry
+	}()
+
+	cancel()
+	assert.ErrorIs(t, <-firstErr, context.Canceled)
+
+	close(release)
+	entry := <-secondResult
+	require.NotNil(t, entry)
+	assert.Equal(t, testCatalog(), entry.schemas)
+	assert.Equal(t, int32(1), fetches.Load(), "the callers share the load")
+
+	_, ok := cache.get(1, now)
+	assert.True(t, ok)
+}
+
+func TestCatalogCacheInvalidateDuringLoad(t *testing.T) {
+	var (
+		now     = time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+		clock   = func() time.Time { return now }
+		started = make(chan struct{})
+		release = make(chan struct{})
+	)
+	cache := newCatalogCache(time.Minute)
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		entry, err := cache.load(context.Background(), 1, false, clock, func(context.Context) ([]apigen.Schema, error) {
+			close(started)
+			<-release
+			return testCatalog(), nil
+		})
+		assert.NoError(t, err)
+		assert.NotNil(t, entry)
+	}()
+	<-started
+
+	// a DDL runs while the catalog is loaded
+	cache.invalidate(1)
+	close(release)
+	<-done
+
+	_, ok := cache.get(1, now)
+	assert.False(t, ok, "the catalog loaded before the invalidation is stale")
+
+	// the next load fetches the catalog again
+	var fetched bool
+	_, err := cache.load(context.Background(), 1, false, clock, func(context.Context) ([]apigen.Schema, error) {
+		fetched = true
+		return testCatalog(), nil
+	})
+	require.NoError(t, err)
+	assert.True(t, fetched)
+	_, ok = cache.get(1, now)
+	assert.True(t, ok)
+}
+
+func TestListDatabaseRelationsFromCache(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	var (
+		ctx   = context.Background()
+		orgID = int32(201)
+		now   = time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	)
+	mockModel := model.NewMockModelInterfaceWithTransaction(ctrl)
+	mockModel.EXPECT().GetOrgDatabaseByID(ctx, querier.GetOrgDatabaseByIDParams{ID: 1, OrgID: orgID}).Return(&querier.DatabaseConnection{ID: 1, OrgID: orgID}, nil)
+
+	s := &Service{
+		m:            mockModel,
+		catalogCache: newCatalogCache(time.Minute),
+		now:          func() time.Time { return now },
+	}
+	s.catalogCache.set(1, 0, &catalogEntry{schemas: testCatalog(), fetchedAt: now})
+
+	relations, err := s.ListDatabaseRelations(ctx, 1, apigen.ListDatabaseRelationsParams{}, orgID)
+	require.NoError(t, err)
+	assert.Equal(t, []apigen.RelationSummary{
+		{ID: 1, Schema: "public", Name: "t1", Type: apigen.Table, Dependencies: []int32{}},
+		{ID: 2, Schema: "public", Name: "mv1", Type: apigen.MaterializedView, Dependencies: []int32{1}},
+	}, relations)
+}
+
+type fakeSQLConn struct {
+	queries []string
+}
+
+func (c *fakeSQLConn) Query(_ context.Context, query string, _ map[string]string) (*sql.Result, error) {
+	c.queries = append(c.queries, query)
+	return &sql.Result{}, nil
+}
+
+type fakeSQLConnManager struct {
+	conn *fakeSQLConn
+}
+
+func (m *fakeSQLConnManager) GetConn(context.Context, int32) (sql.SQLConnectionInterface, error) {
+	return m.conn, nil
+}
+
+func TestQueryDatabaseInvalidatesCatalog(t *testing.T) {
+	var (
+		ctx   = context.Background()
+		orgID = int32(201)
+		now   = time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	)
+
+	for _, tc := range []struct {
+		query       string
+		invalidated bool
+	}{
+		{query: "SELECT * FROM t1", invalidated: false},
+		{query: "CREATE TABLE t2 (v INT)", invalidated: true},
+		{query: "SELECT 1; ALTER TABLE t1 ADD COLUMN w INT", invalidated: true},
+	} {
+		t.Run(tc.query, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockModel := model.NewMockModelInterfaceWithTransaction(ctrl)
+			mockModel.EXPECT().GetOrgDatabaseByID(ctx, querier.GetOrgDatabaseByIDParams{ID: 1, OrgID: orgID}).Return(&querier.DatabaseConnection{ID: 1, OrgID: orgID}, nil)
+			mockModel.EXPECT().GetOrgSettings(ctx, orgID).Return(nil, pgx.ErrNoRows)
+
+			conn := &fakeSQLConn{}
+			s := &Service{
+				m:            mockModel,
+				sqlm:         &fakeSQLConnManager{conn: conn},
+				catalogCache: newCatalogCache(time.Minute),
+				now:          func() time.Time { return now },
+			}
+			s.catalogCache.set(1, 0, &catalogEntry{schemas: testCatalog(), fetchedAt: now})
+
+			_, err := s.QueryDatabase(ctx, 1, apigen.QueryRequest{Query: tc.query}, orgID)
+			require.NoError(t, err)
+			assert.Equal(t, []string{tc.query}, conn.queries)
+
+			_, ok := s.catalogCache.get(1, now)
+			assert.Equal(t, !tc.invalidated, ok)
+		})
+	}
+}
+
+func TestListDatabaseCatalogChangesPaging(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	var (
+		ctx   = context.Background()
+		orgID = int32(201)
+	)
+	mockModel := model.NewMockModelInterfaceWithTransaction(ctrl)
+	mockModel.EXPECT().GetOrgDatabaseByID(ctx, querier.GetOrgDatabaseByIDParams{ID: 1, OrgID: orgID}).Return(&querier.DatabaseConnection{ID: 1, OrgID: orgID}, nil).Times(2)
+	mockModel.EXPECT().ListCatalogChangeEvents(ctx, querier.ListCatalogChangeEventsParams{
+		DatabaseID: 1,
+		Limit:      defaultCatalogChangeLimit,
+	}).Return([]*querier.CatalogChangeEvent{
+		{ID: 12, DatabaseID: 1, RelationID: 2, SchemaName: "public", RelationName: "mv1", RelationType: "materialized view", ChangeType: "created"},
+	}, nil)
+	mockModel.EXPECT().ListCatalogChangeEvents(ctx, querier.ListCatalogChangeEventsParams{
+		DatabaseID: 1,
+		Limit:      maxCatalogChangeLimit,
+		Before:     utils.Ptr(int32(12)),
+	}).Return(nil, nil)
+
+	s := &Service{m: mockModel}
+
+	events, err := s.ListDatabaseCatalogChanges(ctx, 1, apigen.ListDatabaseCatalogChangesParams{}, orgID)
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+	assert.Equal(t, int32(12), events[0].ID)
+
+	events, err = s.ListDatabaseCatalogChanges(ctx, 1, apigen.ListDatabaseCatalogChangesParams{
+		Before: utils.Ptr(int32(12)),
+		Limit:  utils.Ptr(int32(100000)),
+	}, orgID)
+	require.NoError(t, err)
+	assert.Empty(t, events)
+}
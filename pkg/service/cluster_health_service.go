@@ -0,0 +1,41 @@
+package service
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/risingwavelabs/risingwave-console/pkg/utils"
+	"github.com/risingwavelabs/risingwave-console/pkg/zgen/apigen"
+	"github.com/risingwavelabs/risingwave-console/pkg/zgen/querier"
+)
+
+const defaultClusterHealthRecordLimit = 100
+
+func (s *Service) ListClusterHealthHistory(ctx context.Context, id int32, params apigen.ListClusterHealthHistoryParams, orgID int32) ([]apigen.ClusterHealthRecord, error) {
+	cluster, err := s.getOrgCluster(ctx, id, orgID)
+	if err != nil {
+		return nil, err
+	}
+
+	records, err := s.m.ListClusterHealthRecords(ctx, querier.ListClusterHealthRecordsParams{
+		ClusterID: cluster.ID,
+		Limit:     utils.UnwrapOrDefault(params.Limit, defaultClusterHealthRecordLimit),
+		From:      params.From,
+		To:        params.To,
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to list cluster health records")
+	}
+
+	result := make([]apigen.ClusterHealthRecord, len(records))
+	for i, record := range records {
+		result[i] = apigen.ClusterHealthRecord{
+			ID:        record.ID,
+			Status:    apigen.ClusterHealthStatus(record.Status),
+			Reasons:   record.Reasons,
+			Details:   record.Details,
+			CreatedAt: record.CreatedAt,
+		}
+	}
+	return result, nil
+}
@@ -2,38 +2,76 @@ package service
 
 import (
 	"context"
-	"fmt"
+	"slices"
 	"sort"
 	"strings"
-	"time"
 
 	"github.com/jackc/pgx/v5"
 	"github.com/pkg/errors"
+	"github.com/risingwavelabs/risingwave-console/pkg/conn/meta"
+	"github.com/risingwavelabs/risingwave-console/pkg/conn/probe"
 	"github.com/risingwavelabs/risingwave-console/pkg/utils"
 	"github.com/risingwavelabs/risingwave-console/pkg/zcore/model"
 	"github.com/risingwavelabs/risingwave-console/pkg/zgen/apigen"
 	"github.com/risingwavelabs/risingwave-console/pkg/zgen/querier"
+	"github.com/risingwavelabs/risingwave-console/pkg/zgen/taskgen"
 	"golang.org/x/mod/semver"
 )
 
 func (s *Service) TestClusterConnection(ctx context.Context, params apigen.TestClusterConnectionPayload, orgID int32) (*apigen.TestClusterConnectionResult, error) {
-	errMsg := ""
-	if err := utils.TestTCPConnection(ctx, params.Host, params.MetaPort, 5*time.Second); err != nil {
-		errMsg += fmt.Sprintf("Failed to connect to meta port: %s\n", err.Error())
-	}
-	if err := utils.TestTCPConnection(ctx, params.Host, params.SqlPort, 5*time.Second); err != nil {
-		errMsg += fmt.Sprintf("Failed to connect to sql port: %s\n", err.Error())
-	}
-	if err := utils.TestTCPConnection(ctx, params.Host, params.HttpPort, 5*time.Second); err != nil {
-		errMsg += fmt.Sprintf("Failed to connect to http port: %s\n", err.Error())
+	version, err := s.resolveTestVersion(ctx, utils.UnwrapOrDefault(params.Version, ""), params.RisectlVersion)
+	if err != nil {
+		return nil, err
 	}
 
+	result := s.prober.Probe(ctx, probe.Target{
+		Host:     params.Host,
+		SqlPort:  params.SqlPort,
+		MetaPort: params.MetaPort,
+		HttpPort: params.HttpPort,
+		Username: utils.UnwrapOrDefault(params.Username, ""),
+		Password: utils.UnwrapOrDefault(params.Password, ""),
+		Database: utils.UnwrapOrDefault(params.Database, "dev"),
+		Version:  version,
+	})
+
+	success := result.Success()
 	return &apigen.TestClusterConnectionResult{
-		Success: errMsg == "",
-		Result:  utils.IfElse(errMsg == "", "Connection successful", errMsg),
+		Success: success,
+		Result:  connectionTestSummary(result, success),
+		Stages:  connectionTestStagesToApi(result),
 	}, nil
 }
 
+// resolveTestVersion resolves the risectl version to test the connection with before the probe
+// touches the risectl binaries. The override must be one of the listed versions and the cluster
+// version must resolve to one of them, an empty version skips the risectl stage.
+func (s *Service) resolveTestVersion(ctx context.Context, version string, risectlVersion *string) (string, error) {
+	if version == "" {
+		return "", nil
+	}
+	if risectlVersion != nil && *risectlVersion != "" {
+		versions, err := s.risectlm.ListVersions(ctx)
+		if err != nil {
+			return "", errors.Wrap(err, "failed to list risectl versions")
+		}
+		if !slices.Contains(versions, *risectlVersion) {
+			return "", errors.Wrapf(ErrInvalidClusterVersion, "risectl version %q is not available", *risectlVersion)
+		}
+	}
+	resolution, err := s.risectlm.ResolveVersion(ctx, version, meta.WithVersionOverride(risectlVersion))
+	if err != nil {
+		if errors.Is(err, meta.ErrInvalidVersion) {
+			return "", errors.Wrapf(ErrInvalidClusterVersion, "%v", err)
+		}
+		return "", errors.Wrap(err, "failed to resolve risectl version")
+	}
+	if resolution.Reason == meta.ResolutionUnresolved {
+		return "", errors.Wrapf(ErrInvalidClusterVersion, "no risectl version matches %q", version)
+	}
+	return resolution.Resolved, nil
+}
+
 func (s *Service) ListClusterVersions(ctx context.Context) ([]string, error) {
 	versions, err := s.risectlm.ListVersions(ctx)
 	if err != nil {
@@ -46,18 +84,26 @@ func (s *Service) ListClusterVersions(ctx context.Context) ([]string, error) {
 }
 
 func (s *Service) ImportCluster(ctx context.Context, params apigen.ClusterImport, orgID int32) (*apigen.Cluster, error) {
-	cluster, err := s.m.CreateCluster(ctx, querier.CreateClusterParams{
-		OrgID:          orgID,
-		Name:           params.Name,
-		Host:           params.Host,
-		SqlPort:        params.SqlPort,
-		MetaPort:       params.MetaPort,
-		HttpPort:       params.HttpPort,
-		Version:        params.Version,
-		MetricsStoreID: params.MetricsStoreID,
-	})
-	if err != nil {
-		return nil, errors.Wrapf(err, "failed to create cluster")
+	var cluster *querier.Cluster
+	if err := s.m.RunTransactionWithTx(ctx, func(tx pgx.Tx, txm model.ModelInterface) error {
+		var err error
+		cluster, err = txm.CreateCluster(ctx, querier.CreateClusterParams{
+			OrgID:          orgID,
+			Name:           params.Name,
+			Host:           params.Host,
+			SqlPort:        params.SqlPort,
+			MetaPort:       params.MetaPort,
+			HttpPort:       params.HttpPort,
+			Version:        params.Version,
+			MetricsStoreID: params.MetricsStoreID,
+			RisectlVersion: params.RisectlVersion,
+		})
+		if err != nil {
+			return errors.Wrapf(err, "failed to create cluster")
+		}
+		return s.recordClusterVersionChange(ctx, tx, txm, cluster, nil)
+	}); err != nil {
+		return nil, err
 	}
 
 	return clusterToApi(cluster), nil
@@ -95,22 +141,34 @@ func (s *Service) ListClusters(ctx context.Context, orgID int32) ([]*apigen.Clus
 }
 
 func (s *Service) UpdateCluster(ctx context.Context, id int32, params apigen.ClusterImport, orgID int32) (*apigen.Cluster, error) {
-	cluster, err := s.m.UpdateOrgCluster(ctx, querier.UpdateOrgClusterParams{
-		ID:             id,
-		OrgID:          orgID,
-		Name:           params.Name,
-		Host:           params.Host,
-		Version:        params.Version,
-		SqlPort:        int32(params.SqlPort),
-		MetaPort:       int32(params.MetaPort),
-		HttpPort:       int32(params.HttpPort),
-		MetricsStoreID: params.MetricsStoreID,
-	})
+	prev, err := s.getOrgCluster(ctx, id, orgID)
 	if err != nil {
-		if err == pgx.ErrNoRows {
-			return nil, ErrClusterNotFound
+		return nil, err
+	}
+
+	var cluster *querier.Cluster
+	if err := s.m.RunTransactionWithTx(ctx, func(tx pgx.Tx, txm model.ModelInterface) error {
+		cluster, err = txm.UpdateOrgCluster(ctx, querier.UpdateOrgClusterParams{
+			ID:             id,
+			OrgID:          orgID,
+			Name:           params.Name,
+			Host:           params.Host,
+			Version:        params.Version,
+			SqlPort:        int32(params.SqlPort),
+			MetaPort:       int32(params.MetaPort),
+			HttpPort:       int32(params.HttpPort),
+			MetricsStoreID: params.MetricsStoreID,
+			RisectlVersion: params.RisectlVersion,
+		})
+		if err != nil {
+			if err == pgx.ErrNoRows {
+				return ErrClusterNotFound
+			}
+			return errors.Wrapf(err, "failed to update cluster")
 		}
-		return nil, errors.Wrapf(err, "failed to update cluster")
+		return s.recordClusterVersionChange(ctx, tx, txm, cluster, prev)
+	}); err != nil {
+		return nil, err
 	}
 
 	return clusterToApi(cluster), nil
@@ -124,21 +182,14 @@ func (s *Service) DeleteCluster(ctx context.Context, id int32, cascade bool, org
 }
 
 func (s *Service) deleteClusterCacasde(ctx context.Context, id int32, orgID int32) error {
-	return s.m.RunTransaction(ctx, func(txm model.ModelInterface) error {
+	return s.m.RunTransactionWithTx(ctx, func(tx pgx.Tx, txm model.ModelInterface) error {
 		if err := txm.DeleteAllOrgDatabaseConnectionsByClusterID(ctx, querier.DeleteAllOrgDatabaseConnectionsByClusterIDParams{
 			ClusterID: id,
 			OrgID:     orgID,
 		}); err != nil {
 			return errors.Wrapf(err, "failed to delete associated database connections")
 		}
-
-		if err := txm.DeleteOrgCluster(ctx, querier.DeleteOrgClusterParams{
-			ID:    id,
-			OrgID: orgID,
-		}); err != nil {
-			return errors.Wrapf(err, "failed to delete cluster")
-		}
-		return nil
+		return s.deleteOrgCluster(ctx, tx, txm, id, orgID)
 	})
 }
 
@@ -159,12 +210,33 @@ func (s *Service) deleteClusterNonCacasde(ctx context.Context, id int32, orgID i
 		return errors.Wrapf(ErrClusterHasDatabaseConnections, "cluster has %d database connections: %s", len(dbConnections), strings.Join(names, ", "))
 	}
 
-	err = s.m.DeleteOrgCluster(ctx, querier.DeleteOrgClusterParams{
+	return s.m.RunTransactionWithTx(ctx, func(tx pgx.Tx, txm model.ModelInterface) error {
+		return s.deleteOrgCluster(ctx, tx, txm, id, orgID)
+	})
+}
+
+// deleteOrgCluster deletes the cluster and schedules the deletion of its blobs, the rows of the
+// cluster are deleted by the cascades. Deleting a missing cluster is not an error.
+func (s *Service) deleteOrgCluster(ctx context.Context, tx pgx.Tx, txm model.ModelInterface, id int32, orgID int32) error {
+	if _, err := txm.GetOrgCluster(ctx, querier.GetOrgClusterParams{
 		ID:    id,
 		OrgID: orgID,
-	})
-	if err != nil {
+	}); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil
+		}
+		return errors.Wrapf(err, "failed to get cluster")
+	}
+	if err := txm.DeleteOrgCluster(ctx, querier.DeleteOrgClusterParams{
+		ID:    id,
+		OrgID: orgID,
+	}); err != nil {
 		return errors.Wrapf(err, "failed to delete cluster")
 	}
+	if _, err := s.taskRunner.RunDeleteClusterBlobsWithTx(ctx, tx, &taskgen.DeleteClusterBlobsParameters{
+		ClusterID: id,
+	}); err != nil {
+		return errors.Wrapf(err, "failed to create cluster blob deletion task")
+	}
 	return nil
 }
@@ -0,0 +1,206 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/pkg/errors"
+	"github.com/risingwavelabs/risingwave-console/pkg/conn/meta"
+	"github.com/risingwavelabs/risingwave-console/pkg/utils"
+	"github.com/risingwavelabs/risingwave-console/pkg/zcore/model"
+	"github.com/risingwavelabs/risingwave-console/pkg/zgen/apigen"
+	"github.com/risingwavelabs/risingwave-console/pkg/zgen/querier"
+	"github.com/risingwavelabs/risingwave-console/pkg/zgen/taskgen"
+)
+
+const (
+	defaultClusterUpgradeTimeout = 2 * time.Hour
+
+	// maxClusterUpgradeTimeout is below the timeout of the upgrade task
+	maxClusterUpgradeTimeout = 24 * time.Hour
+
+	defaultClusterEventLimit = 100
+)
+
+func clusterUpgradeToApi(upgrade *querier.ClusterUpgrade) *apigen.ClusterUpgrade {
+	return &apigen.ClusterUpgrade{
+		ID:              upgrade.ID,
+		ClusterID:       upgrade.ClusterID,
+		UserID:          upgrade.UserID,
+		FromVersion:     upgrade.FromVersion,
+		TargetVersion:   upgrade.TargetVersion,
+		Status:          apigen.ClusterUpgradeStatus(upgrade.Status),
+		SnapshotID:      upgrade.SnapshotID,
+		DetectedVersion: upgrade.DetectedVersion,
+		DiagnosticID:    upgrade.DiagnosticID,
+		Error:           upgrade.Error,
+		DeadlineAt:      upgrade.DeadlineAt,
+		CreatedAt:       upgrade.CreatedAt,
+		UpdatedAt:       upgrade.UpdatedAt,
+		FinishedAt:      upgrade.FinishedAt,
+	}
+}
+
+func (s *Service) ListClusterEvents(ctx context.Context, id int32, params apigen.ListClusterEventsParams, orgID int32) ([]apigen.ClusterEvent, error) {
+	cluster, err := s.getOrgCluster(ctx, id, orgID)
+	if err != nil {
+		return nil, err
+	}
+
+	events, err := s.m.ListClusterEvents(ctx, querier.ListClusterEventsParams{
+		ClusterID: cluster.ID,
+		Limit:     utils.UnwrapOrDefault(params.Limit, defaultClusterEventLimit),
+		Type:      (*string)(params.Type),
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to list cluster events")
+	}
+
+	result := make([]apigen.ClusterEvent, len(events))
+	for i, event := range events {
+		result[i] = apigen.ClusterEvent{
+			ID:        event.ID,
+			ClusterID: event.ClusterID,
+			Type:      apigen.ClusterEventType(event.Type),
+			Message:   event.Message,
+			Details:   event.Details,
+			CreatedAt: event.CreatedAt,
+		}
+	}
+	return result, nil
+}
+
+func (s *Service) CreateClusterUpgrade(ctx context.Context, id int32, params apigen.ClusterUpgradeCreate, userID int32, orgID int32) (*apigen.ClusterUpgrade, error) {
+	cluster, err := s.getOrgCluster(ctx, id, orgID)
+	if err != nil {
+		return nil, err
+	}
+
+	targetVersion := meta.NormalizeVersion(params.TargetVersion)
+	if targetVersion == "" {
+		return nil, errors.Wrapf(ErrInvalidClusterUpgrade, "invalid target version %q", params.TargetVersion)
+	}
+	if meta.SameVersion(targetVersion, cluster.Version) {
+		return nil, errors.Wrapf(ErrInvalidClusterUpgrade, "the cluster is already at version %s", targetVersion)
+	}
+
+	timeout := defaultClusterUpgradeTimeout
+	if params.Timeout != nil {
+		timeout, err = utils.ParseDuration(*params.Timeout)
+		if err != nil {
+			return nil, errors.Wrapf(ErrInvalidClusterUpgrade, "invalid timeout %q", *params.Timeout)
+		}
+		if timeout <= 0 || timeout > maxClusterUpgradeTimeout {
+			return nil, errors.Wrapf(ErrInvalidClusterUpgrade, "the timeout must be positive and at most %s", maxClusterUpgradeTimeout)
+		}
+	}
+
+	if _, err := s.m.GetInProgressClusterUpgrade(ctx, cluster.ID); err == nil {
+		return nil, ErrClusterUpgradeInProgress
+	} else if !errors.Is(err, pgx.ErrNoRows) {
+		return nil, errors.Wrapf(err, "failed to get the upgrade in progress")
+	}
+
+	var upgrade *querier.ClusterUpgrade
+	if err := s.m.RunTransactionWithTx(ctx, func(tx pgx.Tx, txm model.ModelInterface) error {
+		upgrade, err = txm.CreateClusterUpgrade(ctx, querier.CreateClusterUpgradeParams{
+			ClusterID:     cluster.ID,
+			OrgID:         orgID,
+			UserID:        userID,
+			FromVersion:   cluster.Version,
+			TargetVersion: targetVersion,
+			DeadlineAt:    s.now().Add(timeout),
+		})
+		if err != nil {
+			return errors.Wrapf(err, "failed to create cluster upgrade")
+		}
+		if _, err := s.taskRunner.RunClusterUpgradeWithTx(ctx, tx, &taskgen.ClusterUpgradeParameters{
+			UpgradeID: upgrade.ID,
+		}); err != nil {
+			return errors.Wrapf(err, "failed to create cluster upgrade task")
+		}
+		return nil
+	}); err != nil {
+		// another upgrade is created after the check above
+		if model.IsUniqueViolation(err, "cluster_upgrades_cluster_id_in_progress_idx") {
+			return nil, ErrClusterUpgradeInProgress
+		}
+		return nil, err
+	}
+
+	model.RecordClusterEvent(ctx, s.m, cluster.ID, apigen.UpgradeStarted, fmt.Sprintf("upgrade from %s to %s started", cluster.Version, targetVersion), apigen.ClusterEventDetails{
+		"upgradeID":     upgrade.ID,
+		"userID":        userID,
+		"fromVersion":   cluster.Version,
+		"targetVersion": targetVersion,
+	})
+	return clusterUpgradeToApi(upgrade), nil
+}
+
+func (s *Service) ListClusterUpgrades(ctx context.Context, id int32, orgID int32) ([]apigen.ClusterUpgrade, error) {
+	cluster, err := s.getOrgCluster(ctx, id, orgID)
+	if err != nil {
+		return nil, err
+	}
+
+	upgrades, err := s.m.ListClusterUpgrades(ctx, cluster.ID)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to list cluster upgrades")
+	}
+
+	result := make([]apigen.ClusterUpgrade, len(upgrades))
+	for i, upgrade := range upgrades {
+		result[i] = *clusterUpgradeToApi(upgrade)
+	}
+	return result, nil
+}
+
+func (s *Service) getOrgClusterUpgrade(ctx context.Context, id int32, upgradeID int32, orgID int32) (*querier.ClusterUpgrade, error) {
+	upgrade, err := s.m.GetOrgClusterUpgrade(ctx, querier.GetOrgClusterUpgradeParams{
+		ID:        upgradeID,
+		ClusterID: id,
+		OrgID:     orgID,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrClusterUpgradeNotFound
+		}
+		return nil, errors.Wrapf(err, "failed to get cluster upgrade")
+	}
+	return upgrade, nil
+}
+
+func (s *Service) GetClusterUpgrade(ctx context.Context, id int32, upgradeID int32, orgID int32) (*apigen.ClusterUpgrade, error) {
+	upgrade, err := s.getOrgClusterUpgrade(ctx, id, upgradeID, orgID)
+	if err != nil {
+		return nil, err
+	}
+	return clusterUpgradeToApi(upgrade), nil
+}
+
+func (s *Service) CancelClusterUpgrade(ctx context.Context, id int32, upgradeID int32, userID int32, orgID int32) (*apigen.ClusterUpgrade, error) {
+	upgrade, err := s.getOrgClusterUpgrade(ctx, id, upgradeID, orgID)
+	if err != nil {
+		return nil, err
+	}
+
+	// the task stops once it finds the upgrade finished
+	upgrade, err = s.m.FinishClusterUpgrade(ctx, querier.FinishClusterUpgradeParams{
+		ID:     upgrade.ID,
+		Status: string(apigen.ClusterUpgradeStatusCancelled),
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrClusterUpgradeFinished
+		}
+		return nil, errors.Wrapf(err, "failed to cancel cluster upgrade")
+	}
+
+	model.RecordClusterEvent(ctx, s.m, upgrade.ClusterID, apigen.UpgradeCancelled, fmt.Sprintf("upgrade to %s cancelled", upgrade.TargetVersion), apigen.ClusterEventDetails{
+		"upgradeID": upgrade.ID,
+		"userID":    userID,
+	})
+	return clusterUpgradeToApi(upgrade), nil
+}
@@ -0,0 +1,162 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/risingwavelabs/risingwave-console/pkg/utils"
+	"github.com/risingwavelabs/risingwave-console/pkg/zcore/model"
+	"github.com/risingwavelabs/risingwave-console/pkg/zgen/apigen"
+	"github.com/risingwavelabs/risingwave-console/pkg/zgen/querier"
+	"github.com/risingwavelabs/risingwave-console/pkg/zgen/taskgen"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestCreateClusterUpgrade(t *testing.T) {
+	var (
+		orgID     = int32(201)
+		clusterID = int32(101)
+		userID    = int32(401)
+		upgradeID = int32(301)
+		currTime  = time.Date(2025, 1, 10, 12, 0, 0, 0, time.UTC)
+	)
+
+	testCases := []struct {
+		name       string
+		params     apigen.ClusterUpgradeCreate
+		inProgress bool
+		deadline   time.Time
+		err        error
+	}{
+		{name: "default timeout", params: apigen.ClusterUpgradeCreate{TargetVersion: "2.3.0"}, deadline: currTime.Add(2 * time.Hour)},
+		{name: "timeout", params: apigen.ClusterUpgradeCreate{TargetVersion: "v2.3.0", Timeout: utils.Ptr("30m")}, deadline: currTime.Add(30 * time.Minute)},
+		{name: "invalid version", params: apigen.ClusterUpgradeCreate{TargetVersion: "latest"}, err: ErrInvalidClusterUpgrade},
+		{name: "same version", params: apigen.ClusterUpgradeCreate{TargetVersion: "v2.2.1"}, err: ErrInvalidClusterUpgrade},
+		{name: "timeout too long", params: apigen.ClusterUpgradeCreate{TargetVersion: "v2.3.0", Timeout: utils.Ptr("2d")}, err: ErrInvalidClusterUpgrade},
+		{name: "in progress", params: apigen.ClusterUpgradeCreate{TargetVersion: "v2.3.0"}, inProgress: true, err: ErrClusterUpgradeInProgress},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockModel := model.NewMockModelInterfaceWithTransaction(ctrl)
+			taskRunner := taskgen.NewMockTaskRunner(ctrl)
+			service := &Service{
+				m:          mockModel,
+				taskRunner: taskRunner,
+				now:        func() time.Time { return currTime },
+			}
+
+			mockModel.EXPECT().GetOrgCluster(gomock.Any(), querier.GetOrgClusterParams{ID: clusterID, OrgID: orgID}).Return(&querier.Cluster{
+				ID:      clusterID,
+				OrgID:   orgID,
+				Version: "v2.2.1",
+			}, nil)
+
+			if tc.err == nil || tc.inProgress {
+				if tc.inProgress {
+					mockModel.EXPECT().GetInProgressClusterUpgrade(gomock.Any(), clusterID).Return(&querier.ClusterUpgrade{ID: upgradeID}, nil)
+				} else {
+					mockModel.EXPECT().GetInProgressClusterUpgrade(gomock.Any(), clusterID).Return(nil, pgx.ErrNoRows)
+				}
+			}
+			if tc.err == nil {
+				upgrade := &querier.ClusterUpgrade{
+					ID:            upgradeID,
+					ClusterID:     clusterID,
+					UserID:        userID,
+					FromVersion:   "v2.2.1",
+					TargetVersion: "v2.3.0",
+					Status:        "pending",
+					DeadlineAt:    tc.deadline,
+				}
+				mockModel.EXPECT().CreateClusterUpgrade(gomock.Any(), querier.CreateClusterUpgradeParams{
+					ClusterID:     clusterID,
+					OrgID:         orgID,
+					UserID:        userID,
+					FromVersion:   "v2.2.1",
+					TargetVersion: "v2.3.0",
+					DeadlineAt:    tc.deadline,
+				}).Return(upgrade, nil)
+				taskRunner.EXPECT().RunClusterUpgradeWithTx(gomock.Any(), gomock.Any(), &taskgen.ClusterUpgradeParameters{
+					UpgradeID: upgradeID,
+				}).Return(int32(1), nil)
+				mockModel.EXPECT().CreateClusterEvent(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, arg querier.CreateClusterEventParams) error {
+					assert.Equal(t, "upgrade_started", arg.Type)
+					return nil
+				})
+			}
+
+			upgrade, err := service.CreateClusterUpgrade(context.Background(), clusterID, tc.params, userID, orgID)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, apigen.ClusterUpgradeStatusPending, upgrade.Status)
+			assert.Equal(t, tc.deadline, upgrade.DeadlineAt)
+		})
+	}
+}
+
+func TestCreateClusterUpgradeVersionPrefix(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	var (
+		orgID     = int32(201)
+		clusterID = int32(101)
+	)
+
+	mockModel := model.NewMockModelInterfaceWithTransaction(ctrl)
+	service := &Service{m: mockModel}
+
+	// the version of the cluster may be recorded without the "v" prefix
+	mockModel.EXPECT().GetOrgCluster(gomock.Any(), querier.GetOrgClusterParams{ID: clusterID, OrgID: orgID}).Return(&querier.Cluster{
+		ID:      clusterID,
+		OrgID:   orgID,
+		Version: "2.2.1",
+	}, nil)
+
+	_, err := service.CreateClusterUpgrade(context.Background(), clusterID, apigen.ClusterUpgradeCreate{TargetVersion: "v2.2.1"}, int32(401), orgID)
+	require.ErrorIs(t, err, ErrInvalidClusterUpgrade)
+}
+
+func TestCreateClusterUpgradeRace(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	var (
+		orgID     = int32(201)
+		clusterID = int32(101)
+		currTime  = time.Date(2025, 1, 10, 12, 0, 0, 0, time.UTC)
+	)
+
+	mockModel := model.NewMockModelInterfaceWithTransaction(ctrl)
+	service := &Service{
+		m:   mockModel,
+		now: func() time.Time { return currTime },
+	}
+
+	mockModel.EXPECT().GetOrgCluster(gomock.Any(), querier.GetOrgClusterParams{ID: clusterID, OrgID: orgID}).Return(&querier.Cluster{
+		ID:      clusterID,
+		OrgID:   orgID,
+		Version: "v2.2.1",
+	}, nil)
+	mockModel.EXPECT().GetInProgressClusterUpgrade(gomock.Any(), clusterID).Return(nil, pgx.ErrNoRows)
+
+	// another upgrade is created between the check and the insert
+	mockModel.EXPECT().CreateClusterUpgrade(gomock.Any(), gomock.Any()).Return(nil, &pgconn.PgError{
+		Code:           "23505",
+		ConstraintName: "cluster_upgrades_cluster_id_in_progress_idx",
+	})
+
+	_, err := service.CreateClusterUpgrade(context.Background(), clusterID, apigen.ClusterUpgradeCreate{TargetVersion: "v2.3.0"}, int32(401), orgID)
+	require.ErrorIs(t, err, ErrClusterUpgradeInProgress)
+}
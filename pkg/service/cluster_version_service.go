@@ -0,0 +1,62 @@
+package service
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/pkg/errors"
+	"github.com/risingwavelabs/risingwave-console/pkg/zcore/model"
+	"github.com/risingwavelabs/risingwave-console/pkg/zgen/apigen"
+	"github.com/risingwavelabs/risingwave-console/pkg/zgen/querier"
+	"github.com/risingwavelabs/risingwave-console/pkg/zgen/taskgen"
+)
+
+// recordClusterVersionChange records the version entered by the user if it differs from the
+// previous one, prev is nil for a new cluster. The version of the cluster is detected again
+// since the connection of the cluster may be changed as well.
+func (s *Service) recordClusterVersionChange(ctx context.Context, tx pgx.Tx, txm model.ModelInterface, cluster *querier.Cluster, prev *querier.Cluster) error {
+	var previousVersion *string
+	if prev != nil {
+		previousVersion = &prev.Version
+	}
+	if prev == nil || prev.Version != cluster.Version {
+		if err := txm.CreateClusterVersionHistory(ctx, querier.CreateClusterVersionHistoryParams{
+			ClusterID:       cluster.ID,
+			Version:         cluster.Version,
+			PreviousVersion: previousVersion,
+			Source:          string(apigen.ClusterVersionSourceUser),
+		}); err != nil {
+			return errors.Wrapf(err, "failed to record cluster version history")
+		}
+	}
+	if _, err := s.taskRunner.RunDetectClusterVersionWithTx(ctx, tx, &taskgen.DetectClusterVersionParameters{
+		ClusterID: cluster.ID,
+	}); err != nil {
+		return errors.Wrapf(err, "failed to create detect cluster version task")
+	}
+	return nil
+}
+
+func (s *Service) ListClusterVersionHistory(ctx context.Context, id int32, orgID int32) ([]apigen.ClusterVersionChange, error) {
+	cluster, err := s.getOrgCluster(ctx, id, orgID)
+	if err != nil {
+		return nil, err
+	}
+
+	history, err := s.m.ListClusterVersionHistory(ctx, cluster.ID)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to list cluster version history")
+	}
+
+	result := make([]apigen.ClusterVersionChange, len(history))
+	for i, change := range history {
+		result[i] = apigen.ClusterVersionChange{
+			ID:              change.ID,
+			Version:         change.Version,
+			PreviousVersion: change.PreviousVersion,
+			Source:          apigen.ClusterVersionSource(change.Source),
+			CreatedAt:       change.CreatedAt,
+		}
+	}
+	return result, nil
+}
@@ -0,0 +1,217 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/risingwavelabs/risingwave-console/pkg/conn/meta"
+	"github.com/risingwavelabs/risingwave-console/pkg/conn/meta/mock"
+	"github.com/risingwavelabs/risingwave-console/pkg/utils"
+	"github.com/risingwavelabs/risingwave-console/pkg/zcore/model"
+	"github.com/risingwavelabs/risingwave-console/pkg/zgen/apigen"
+	"github.com/risingwavelabs/risingwave-console/pkg/zgen/querier"
+	"github.com/risingwavelabs/risingwave-console/pkg/zgen/taskgen"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestImportClusterRecordsVersion(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	var (
+		orgID     = int32(201)
+		clusterID = int32(101)
+	)
+
+	mockModel := model.NewMockModelInterfaceWithTransaction(ctrl)
+	taskRunner := taskgen.NewMockTaskRunner(ctrl)
+	service := &Service{m: mockModel, taskRunner: taskRunner}
+
+	mockModel.EXPECT().CreateCluster(gomock.Any(), gomock.Any()).Return(&querier.Cluster{
+		ID:      clusterID,
+		OrgID:   orgID,
+		Version: "v2.3.0",
+	}, nil)
+	mockModel.EXPECT().CreateClusterVersionHistory(gomock.Any(), querier.CreateClusterVersionHistoryParams{
+		ClusterID: clusterID,
+		Version:   "v2.3.0",
+		Source:    "user",
+	}).Return(nil)
+	taskRunner.EXPECT().RunDetectClusterVersionWithTx(gomock.Any(), gomock.Any(), &taskgen.DetectClusterVersionParameters{
+		ClusterID: clusterID,
+	}).Return(int32(1), nil)
+
+	cluster, err := service.ImportCluster(context.Background(), apigen.ClusterImport{Version: "v2.3.0"}, orgID)
+	require.NoError(t, err)
+	assert.Equal(t, clusterID, cluster.ID)
+	assert.False(t, cluster.VersionMismatch)
+}
+
+func TestUpdateClusterRecordsVersion(t *testing.T) {
+	var (
+		orgID     = int32(201)
+		clusterID = int32(101)
+	)
+
+	testCases := []struct {
+		name    string
+		version string
+		history bool
+	}{
+		{name: "version changed", version: "v2.3.0", history: true},
+		{name: "version unchanged", version: "v2.2.1"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockModel := model.NewMockModelInterfaceWithTransaction(ctrl)
+			taskRunner := taskgen.NewMockTaskRunner(ctrl)
+			service := &Service{m: mockModel, taskRunner: taskRunner}
+
+			mockModel.EXPECT().GetOrgCluster(gomock.Any(), querier.GetOrgClusterParams{ID: clusterID, OrgID: orgID}).Return(&querier.Cluster{
+				ID:      clusterID,
+				OrgID:   orgID,
+				Version: "v2.2.1",
+			}, nil)
+			mockModel.EXPECT().UpdateOrgCluster(gomock.Any(), gomock.Any()).Return(&querier.Cluster{
+				ID:              clusterID,
+				OrgID:           orgID,
+				Version:         tc.version,
+				DetectedVersion: utils.Ptr("v2.2.1"),
+			}, nil)
+			if tc.history {
+				mockModel.EXPECT().CreateClusterVersionHistory(gomock.Any(), querier.CreateClusterVersionHistoryParams{
+					ClusterID:       clusterID,
+					Version:         tc.version,
+					PreviousVersion: utils.Ptr("v2.2.1"),
+					Source:          "user",
+				}).Return(nil)
+			}
+			taskRunner.EXPECT().RunDetectClusterVersionWithTx(gomock.Any(), gomock.Any(), &taskgen.DetectClusterVersionParameters{
+				ClusterID: clusterID,
+			}).Return(int32(1), nil)
+
+			cluster, err := service.UpdateCluster(context.Background(), clusterID, apigen.ClusterImport{Version: tc.version}, orgID)
+			require.NoError(t, err)
+			assert.Equal(t, tc.history, cluster.VersionMismatch)
+		})
+	}
+}
+
+func TestResolveTestVersion(t *testing.T) {
+	testCases := []struct {
+		name           string
+		version        string
+		risectlVersion *string
+		resolution     *meta.VersionResolution
+		expected       string
+		err            error
+	}{
+		{
+			name:     "no version",
+			expected: "",
+		},
+		{
+			name:       "nearest release",
+			version:    "v2.3.1",
+			resolution: &meta.VersionResolution{Requested: "v2.3.1", Resolved: "v2.3.0", Reason: meta.ResolutionNearest},
+			expected:   "v2.3.0",
+		},
+		{
+			name:       "unresolved",
+			version:    "latest",
+			resolution: &meta.VersionResolution{Requested: "latest", Resolved: "latest", Reason: meta.ResolutionUnresolved},
+			err:        ErrInvalidClusterVersion,
+		},
+		{
+			name:           "listed override",
+			version:        "v2.3.1",
+			risectlVersion: utils.Ptr("v2.3.0"),
+			resolution:     &meta.VersionResolution{Requested: "v2.3.1", Resolved: "v2.3.0", Reason: meta.ResolutionOverride},
+			expected:       "v2.3.0",
+		},
+		{
+			name:           "unknown override",
+			version:        "v2.3.1",
+			risectlVersion: utils.Ptr("v9.9.9"),
+			err:            ErrInvalidClusterVersion,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			risectlm := mock.NewMockRisectlManagerInterface(ctrl)
+			service := &Service{risectlm: risectlm}
+
+			if tc.risectlVersion != nil {
+				risectlm.EXPECT().ListVersions(gomock.Any()).Return([]string{"v2.2.0", "v2.3.0"}, nil)
+			}
+			if tc.resolution != nil {
+				risectlm.EXPECT().ResolveVersion(gomock.Any(), tc.version, gomock.Any()).Return(*tc.resolution, nil)
+			}
+
+			version, err := service.resolveTestVersion(context.Background(), tc.version, tc.risectlVersion)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, version)
+		})
+	}
+}
+
+func TestDeleteClusterSchedulesBlobDeletion(t *testing.T) {
+	var (
+		orgID     = int32(201)
+		clusterID = int32(101)
+	)
+
+	testCases := []struct {
+		name     string
+		cascade  bool
+		notFound bool
+	}{
+		{name: "cascade", cascade: true},
+		{name: "no cascade"},
+		{name: "not found", notFound: true},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockModel := model.NewMockModelInterfaceWithTransaction(ctrl)
+			taskRunner := taskgen.NewMockTaskRunner(ctrl)
+			service := &Service{m: mockModel, taskRunner: taskRunner}
+
+			if tc.cascade {
+				mockModel.EXPECT().DeleteAllOrgDatabaseConnectionsByClusterID(gomock.Any(), querier.DeleteAllOrgDatabaseConnectionsByClusterIDParams{
+					ClusterID: clusterID,
+					OrgID:     orgID,
+				}).Return(nil)
+			} else {
+				mockModel.EXPECT().GetAllOrgDatabseConnectionsByClusterID(gomock.Any(), gomock.Any()).Return(nil, nil)
+			}
+			get := mockModel.EXPECT().GetOrgCluster(gomock.Any(), querier.GetOrgClusterParams{ID: clusterID, OrgID: orgID})
+			if tc.notFound {
+				get.Return(nil, pgx.ErrNoRows)
+			} else {
+				get.Return(&querier.Cluster{ID: clusterID, OrgID: orgID}, nil)
+				mockModel.EXPECT().DeleteOrgCluster(gomock.Any(), querier.DeleteOrgClusterParams{ID: clusterID, OrgID: orgID}).Return(nil)
+				taskRunner.EXPECT().RunDeleteClusterBlobsWithTx(gomock.Any(), gomock.Any(), &taskgen.DeleteClusterBlobsParameters{
+					ClusterID: clusterID,
+				}).Return(int32(1), nil)
+			}
+
+			require.NoError(t, service.DeleteCluster(context.Background(), clusterID, tc.cascade, orgID))
+		})
+	}
+}
@@ -2,7 +2,7 @@ package service
 
 import (
 	"context"
-	"fmt"
+	"sort"
 
 	"github.com/jackc/pgx/v5"
 	"github.com/pkg/errors"
@@ -13,28 +13,34 @@ import (
 )
 
 func (s *Service) ImportDatabase(ctx context.Context, params apigen.DatabaseConnectInfo, orgID int32) (*apigen.Database, error) {
+	if err := sql.ValidateSessionSettings(utils.Unwrap(params.SessionSettings)); err != nil {
+		return nil, err
+	}
+
 	cluster, err := s.m.CreateDatabaseConnection(ctx, querier.CreateDatabaseConnectionParams{
-		ClusterID: params.ClusterID,
-		Name:      params.Name,
-		Username:  params.Username,
-		Password:  params.Password,
-		Database:  params.Database,
-		OrgID:     orgID,
+		ClusterID:       params.ClusterID,
+		Name:            params.Name,
+		Username:        params.Username,
+		Password:        params.Password,
+		Database:        params.Database,
+		OrgID:           orgID,
+		SessionSettings: params.SessionSettings,
 	})
 	if err != nil {
 		return nil, errors.Wrapf(err, "failed to create database")
 	}
 
 	return &apigen.Database{
-		ID:        cluster.ID,
-		Name:      cluster.Name,
-		ClusterID: cluster.ClusterID,
-		OrgID:     cluster.OrgID,
-		Username:  cluster.Username,
-		Password:  cluster.Password,
-		Database:  cluster.Database,
-		CreatedAt: cluster.CreatedAt,
-		UpdatedAt: cluster.UpdatedAt,
+		ID:              cluster.ID,
+		Name:            cluster.Name,
+		ClusterID:       cluster.ClusterID,
+		OrgID:           cluster.OrgID,
+		Username:        cluster.Username,
+		Password:        cluster.Password,
+		Database:        cluster.Database,
+		CreatedAt:       cluster.CreatedAt,
+		UpdatedAt:       cluster.UpdatedAt,
+		SessionSettings: cluster.SessionSettings,
 	}, nil
 }
 
@@ -46,7 +52,7 @@ func (s *Service) getConnStr(ctx context.Context, db *querier.DatabaseConnection
 	if err != nil {
 		return "", errors.Wrapf(err, "failed to get cluster")
 	}
-	return fmt.Sprintf("postgres://%s:%s@%s:%d/%s", db.Username, utils.UnwrapOrDefault(db.Password, ""), cluster.Host, cluster.SqlPort, db.Database), nil
+	return sql.ConnString(cluster.Host, cluster.SqlPort, db.Username, utils.UnwrapOrDefault(db.Password, ""), db.Database), nil
 }
 
 const getRelationsSQL = `SELECT 
@@ -61,6 +67,17 @@ const getRelationsSQL = `SELECT
 FROM rw_columns
 JOIN rw_relations ON rw_relations.id = rw_columns.relation_id
 JOIN rw_schemas   ON rw_schemas.id = rw_relations.schema_id
+ORDER BY rw_columns.relation_id, rw_columns.position
+`
+
+const getRelationListSQL = `SELECT
+    rw_relations.id            AS relation_id,
+    rw_schemas.name            AS schema,
+    rw_relations.name          AS relation_name,
+    rw_relations.relation_type AS relation_type
+FROM rw_relations
+JOIN rw_schemas ON rw_schemas.id = rw_relations.schema_id
+ORDER BY rw_schemas.name, rw_relations.name
 `
 
 const getRwDependSQL = `SELECT * FROM rw_depend`
@@ -79,38 +96,52 @@ func (s *Service) getDb(ctx context.Context, id int32, orgID int32) (*querier.Da
 	return db, nil
 }
 
-func (s *Service) GetDatabase(ctx context.Context, id int32, orgID int32) (*apigen.Database, error) {
+func (s *Service) GetDatabase(ctx context.Context, id int32, params apigen.GetDatabaseParams, orgID int32) (*apigen.Database, error) {
 	db, err := s.getDb(ctx, id, orgID)
 	if err != nil {
 		return nil, err
 	}
 
+	catalog, err := s.getCatalog(ctx, db, utils.UnwrapOrDefault(params.Refresh, false))
+	if err != nil {
+		return nil, err
+	}
+
+	return &apigen.Database{
+		ID:               db.ID,
+		Name:             db.Name,
+		ClusterID:        db.ClusterID,
+		OrgID:            db.OrgID,
+		Username:         db.Username,
+		Password:         db.Password,
+		Database:         db.Database,
+		CreatedAt:        db.CreatedAt,
+		UpdatedAt:        db.UpdatedAt,
+		SessionSettings:  db.SessionSettings,
+		Schemas:          &catalog.schemas,
+		CatalogFetchedAt: &catalog.fetchedAt,
+	}, nil
+}
+
+// fetchCatalog queries the relations, columns and dependencies of the database,
+// schemas and relations are sorted by name, columns by their position.
+func (s *Service) fetchCatalog(ctx context.Context, db *querier.DatabaseConnection) ([]apigen.Schema, error) {
 	connStr, err := s.getConnStr(ctx, db)
 	if err != nil {
 		return nil, errors.Wrapf(err, "failed to get connection string")
 	}
 
-	result, err := sql.Query(ctx, connStr, getRelationsSQL, false)
+	result, err := sql.Query(ctx, connStr, getRelationsSQL, nil)
 	if err != nil {
 		return nil, errors.Wrapf(err, "failed to query database")
 	}
 
-	data := make(map[string]map[string]apigen.Relation)
-
-	idToDepends := make(map[int32][]int32)
-	depend, err := sql.Query(ctx, connStr, getRwDependSQL, false)
+	idToDepends, err := queryDependencies(ctx, connStr)
 	if err != nil {
-		return nil, errors.Wrapf(err, "failed to query database")
-	}
-	for _, row := range depend.Rows {
-		objid := row["objid"].(int32)
-		refobjid := row["refobjid"].(int32)
-		if _, ok := idToDepends[objid]; !ok {
-			idToDepends[objid] = []int32{}
-		}
-		idToDepends[objid] = append(idToDepends[objid], refobjid)
+		return nil, err
 	}
 
+	data := make(map[string]map[string]apigen.Relation)
 	for _, row := range result.Rows {
 		schemaName := row["schema"].(string)
 		if _, ok := data[schemaName]; !ok {
@@ -149,21 +180,84 @@ func (s *Service) GetDatabase(ctx context.Context, id int32, orgID int32) (*apig
 		for _, relation := range schema {
 			s.Relations = append(s.Relations, relation)
 		}
+		sort.Slice(s.Relations, func(i, j int) bool {
+			return s.Relations[i].Name < s.Relations[j].Name
+		})
 		schemas = append(schemas, s)
 	}
+	sort.Slice(schemas, func(i, j int) bool {
+		return schemas[i].Name < schemas[j].Name
+	})
+	return schemas, nil
+}
 
-	return &apigen.Database{
-		ID:        db.ID,
-		Name:      db.Name,
-		ClusterID: db.ClusterID,
-		OrgID:     db.OrgID,
-		Username:  db.Username,
-		Password:  db.Password,
-		Database:  db.Database,
-		CreatedAt: db.CreatedAt,
-		UpdatedAt: db.UpdatedAt,
-		Schemas:   &schemas,
-	}, nil
+// queryDependencies returns the IDs of the relations each relation depends on, sorted.
+func queryDependencies(ctx context.Context, connStr string) (map[int32][]int32, error) {
+	depend, err := sql.Query(ctx, connStr, getRwDependSQL, nil)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to query database")
+	}
+	idToDepends := make(map[int32][]int32)
+	for _, row := range depend.Rows {
+		objid := row["objid"].(int32)
+		refobjid := row["refobjid"].(int32)
+		idToDepends[objid] = append(idToDepends[objid], refobjid)
+	}
+	for _, depends := range idToDepends {
+		sort.Slice(depends, func(i, j int) bool { return depends[i] < depends[j] })
+	}
+	return idToDepends, nil
+}
+
+func (s *Service) ListDatabaseRelations(ctx context.Context, id int32, params apigen.ListDatabaseRelationsParams, orgID int32) ([]apigen.RelationSummary, error) {
+	db, err := s.getDb(ctx, id, orgID)
+	if err != nil {
+		return nil, err
+	}
+
+	// the cached catalog already has everything needed, no need to query the database
+	if catalog, ok := s.catalogCache.get(db.ID, s.now()); ok && !utils.UnwrapOrDefault(params.Refresh, false) {
+		result := []apigen.RelationSummary{}
+		for _, schema := range catalog.schemas {
+			for _, relation := range schema.Relations {
+				result = append(result, apigen.RelationSummary{
+					ID:           relation.ID,
+					Schema:       relation.Schema,
+					Name:         relation.Name,
+					Type:         relation.Type,
+					Dependencies: relation.Dependencies,
+				})
+			}
+		}
+		return result, nil
+	}
+
+	connStr, err := s.getConnStr(ctx, db)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get connection string")
+	}
+
+	relations, err := sql.Query(ctx, connStr, getRelationListSQL, nil)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to query database")
+	}
+
+	idToDepends, err := queryDependencies(ctx, connStr)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]apigen.RelationSummary, 0, len(relations.Rows))
+	for _, row := range relations.Rows {
+		result = append(result, apigen.RelationSummary{
+			ID:           row["relation_id"].(int32),
+			Schema:       row["schema"].(string),
+			Name:         row["relation_name"].(string),
+			Type:         apigen.RelationType(row["relation_type"].(string)),
+			Dependencies: idToDepends[row["relation_id"].(int32)],
+		})
+	}
+	return result, nil
 }
 
 func (s *Service) ListDatabases(ctx context.Context, orgID int32) ([]apigen.Database, error) {
@@ -178,30 +272,36 @@ func (s *Service) ListDatabases(ctx context.Context, orgID int32) ([]apigen.Data
 	result := make([]apigen.Database, len(dbs))
 	for i, db := range dbs {
 		result[i] = apigen.Database{
-			ID:        db.ID,
-			Name:      db.Name,
-			ClusterID: db.ClusterID,
-			OrgID:     db.OrgID,
-			Username:  db.Username,
-			Password:  db.Password,
-			Database:  db.Database,
-			CreatedAt: db.CreatedAt,
-			UpdatedAt: db.UpdatedAt,
+			ID:              db.ID,
+			Name:            db.Name,
+			ClusterID:       db.ClusterID,
+			OrgID:           db.OrgID,
+			Username:        db.Username,
+			Password:        db.Password,
+			Database:        db.Database,
+			CreatedAt:       db.CreatedAt,
+			UpdatedAt:       db.UpdatedAt,
+			SessionSettings: db.SessionSettings,
 		}
 	}
 	return result, nil
 }
 
 func (s *Service) UpdateDatabase(ctx context.Context, id int32, params apigen.DatabaseConnectInfo, orgID int32) (*apigen.Database, error) {
+	if err := sql.ValidateSessionSettings(utils.Unwrap(params.SessionSettings)); err != nil {
+		return nil, err
+	}
+
 	db, err := s.m.UpdateOrgDatabaseConnection(ctx, querier.UpdateOrgDatabaseConnectionParams{
-		ID:        id,
-		ClusterID: params.ClusterID,
-		Name:      params.Name,
-		Username:  params.Username,
-		Password:  params.Password,
-		Database:  params.Database,
-		OrgID:     orgID,
-		OrgID_2:   orgID,
+		ID:              id,
+		ClusterID:       params.ClusterID,
+		Name:            params.Name,
+		Username:        params.Username,
+		Password:        params.Password,
+		Database:        params.Database,
+		OrgID:           orgID,
+		OrgID_2:         orgID,
+		SessionSettings: params.SessionSettings,
 	})
 	if err != nil {
 		if err == pgx.ErrNoRows {
@@ -209,17 +309,19 @@ func (s *Service) UpdateDatabase(ctx context.Context, id int32, params apigen.Da
 		}
 		return nil, errors.Wrapf(err, "failed to update database")
 	}
+	s.catalogCache.invalidate(db.ID)
 
 	return &apigen.Database{
-		ID:        db.ID,
-		Name:      db.Name,
-		ClusterID: db.ClusterID,
-		Database:  db.Database,
-		OrgID:     db.OrgID,
-		Username:  db.Username,
-		Password:  db.Password,
-		CreatedAt: db.CreatedAt,
-		UpdatedAt: db.UpdatedAt,
+		ID:              db.ID,
+		Name:            db.Name,
+		ClusterID:       db.ClusterID,
+		Database:        db.Database,
+		OrgID:           db.OrgID,
+		Username:        db.Username,
+		Password:        db.Password,
+		CreatedAt:       db.CreatedAt,
+		UpdatedAt:       db.UpdatedAt,
+		SessionSettings: db.SessionSettings,
 	}, nil
 }
 
@@ -231,5 +333,6 @@ func (s *Service) DeleteDatabase(ctx context.Context, id int32, orgID int32) err
 	if err != nil {
 		return errors.Wrapf(err, "failed to delete database")
 	}
+	s.catalogCache.invalidate(id)
 	return nil
 }
@@ -0,0 +1,140 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/pkg/errors"
+	"github.com/risingwavelabs/risingwave-console/pkg/zcore/model"
+	"github.com/risingwavelabs/risingwave-console/pkg/zgen/apigen"
+	"github.com/risingwavelabs/risingwave-console/pkg/zgen/querier"
+	"github.com/risingwavelabs/risingwave-console/pkg/zgen/taskgen"
+)
+
+func diagnosticBundleToApi(b *querier.ClusterDiagnosticBundle) *apigen.DiagnosticBundle {
+	return &apigen.DiagnosticBundle{
+		ID:         b.ID,
+		ClusterID:  b.ClusterID,
+		UserID:     b.UserID,
+		Status:     apigen.DiagnosticBundleStatus(b.Status),
+		Error:      b.Error,
+		Manifest:   b.Manifest,
+		SizeBytes:  b.SizeBytes,
+		CreatedAt:  b.CreatedAt,
+		FinishedAt: b.FinishedAt,
+	}
+}
+
+func (s *Service) getOrgClusterDiagnosticBundle(ctx context.Context, id int32, bundleID int32, orgID int32) (*querier.ClusterDiagnosticBundle, error) {
+	b, err := s.m.GetOrgClusterDiagnosticBundle(ctx, querier.GetOrgClusterDiagnosticBundleParams{
+		ID:        bundleID,
+		ClusterID: id,
+		OrgID:     orgID,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrDiagnosticBundleNotFound
+		}
+		return nil, errors.Wrapf(err, "failed to get diagnostic bundle")
+	}
+	return b, nil
+}
+
+func (s *Service) CreateClusterDiagnosticBundle(ctx context.Context, id int32, userID int32, orgID int32) (*apigen.DiagnosticBundle, error) {
+	cluster, err := s.getOrgCluster(ctx, id, orgID)
+	if err != nil {
+		return nil, err
+	}
+
+	var b *querier.ClusterDiagnosticBundle
+	if err := s.m.RunTransactionWithTx(ctx, func(tx pgx.Tx, txm model.ModelInterface) error {
+		b, err = txm.CreateClusterDiagnosticBundle(ctx, querier.CreateClusterDiagnosticBundleParams{
+			ClusterID: cluster.ID,
+			OrgID:     orgID,
+			UserID:    userID,
+			Status:    string(apigen.DiagnosticBundleStatusPending),
+		})
+		if err != nil {
+			return errors.Wrapf(err, "failed to create diagnostic bundle")
+		}
+		if _, err := s.taskRunner.RunDiagnosticBundleWithTx(ctx, tx, &taskgen.DiagnosticBundleParameters{
+			BundleID: b.ID,
+		}); err != nil {
+			return errors.Wrapf(err, "failed to create diagnostic bundle task")
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	return diagnosticBundleToApi(b), nil
+}
+
+func (s *Service) ListClusterDiagnosticBundles(ctx context.Context, id int32, orgID int32) ([]apigen.DiagnosticBundle, error) {
+	bundles, err := s.m.ListOrgClusterDiagnosticBundles(ctx, querier.ListOrgClusterDiagnosticBundlesParams{
+		ClusterID: id,
+		OrgID:     orgID,
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to list diagnostic bundles")
+	}
+
+	result := make([]apigen.DiagnosticBundle, len(bundles))
+	for i, b := range bundles {
+		result[i] = *diagnosticBundleToApi(b)
+	}
+	return result, nil
+}
+
+func (s *Service) GetClusterDiagnosticBundle(ctx context.Context, id int32, bundleID int32, orgID int32) (*apigen.DiagnosticBundle, error) {
+	b, err := s.getOrgClusterDiagnosticBundle(ctx, id, bundleID, orgID)
+	if err != nil {
+		return nil, err
+	}
+	return diagnosticBundleToApi(b), nil
+}
+
+func (s *Service) DownloadClusterDiagnosticBundle(ctx context.Context, id int32, bundleID int32, orgID int32) (string, []byte, error) {
+	b, err := s.getOrgClusterDiagnosticBundle(ctx, id, bundleID, orgID)
+	if err != nil {
+		return "", nil, err
+	}
+	if b.Status != string(apigen.DiagnosticBundleStatusCompleted) {
+		return "", nil, ErrDiagnosticBundleNotCompleted
+	}
+
+	var content []byte
+	if b.ContentRef != nil {
+		content, err = s.blobs.Get(ctx, *b.ContentRef)
+	} else {
+		// the bundle is not moved to the blob store yet
+		content, err = s.m.GetClusterDiagnosticBundleContent(ctx, b.ID)
+	}
+	if err != nil {
+		return "", nil, errors.Wrapf(err, "failed to get diagnostic bundle content")
+	}
+	name := fmt.Sprintf("diagnostic-bundle-%d-%d-%s.tar.gz", b.ClusterID, b.ID, b.CreatedAt.UTC().Format("20060102-150405"))
+	return name, content, nil
+}
+
+func (s *Service) DeleteClusterDiagnosticBundle(ctx context.Context, id int32, bundleID int32, orgID int32) error {
+	b, err := s.getOrgClusterDiagnosticBundle(ctx, id, bundleID, orgID)
+	if err != nil {
+		return err
+	}
+	switch apigen.DiagnosticBundleStatus(b.Status) {
+	case apigen.DiagnosticBundleStatusPending, apigen.DiagnosticBundleStatusRunning:
+		return ErrDiagnosticBundleInProgress
+	}
+
+	// the archive is deleted first, a failed row deletion leaves the bundle to delete again
+	if b.ContentRef != nil {
+		if err := s.blobs.Delete(ctx, *b.ContentRef); err != nil {
+			return errors.Wrapf(err, "failed to delete diagnostic bundle content")
+		}
+	}
+	if err := s.m.DeleteClusterDiagnosticBundle(ctx, b.ID); err != nil {
+		return errors.Wrapf(err, "failed to delete diagnostic bundle")
+	}
+	return nil
+}
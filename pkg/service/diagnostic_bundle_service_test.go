@@ -0,0 +1,128 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/risingwavelabs/risingwave-console/pkg/blobstore"
+	"github.com/risingwavelabs/risingwave-console/pkg/utils"
+	"github.com/risingwavelabs/risingwave-console/pkg/zcore/model"
+	"github.com/risingwavelabs/risingwave-console/pkg/zgen/querier"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestDownloadClusterDiagnosticBundle(t *testing.T) {
+	var (
+		orgID     = int32(201)
+		clusterID = int32(101)
+		bundleID  = int32(301)
+		createdAt = time.Date(2025, 1, 10, 12, 0, 0, 0, time.UTC)
+	)
+
+	testCases := []struct {
+		name       string
+		status     string
+		contentRef *string
+		notFound   bool
+		err        error
+	}{
+		{name: "completed", status: "completed", contentRef: utils.Ptr("diagnostic-bundles/101/301.tar.gz")},
+		{name: "not moved to the blob store", status: "completed"},
+		{name: "running", status: "running", err: ErrDiagnosticBundleNotCompleted},
+		{name: "not found", notFound: true, err: ErrDiagnosticBundleNotFound},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockModel := model.NewMockModelInterfaceWithTransaction(ctrl)
+			blobs, err := blobstore.NewLocalStore(t.TempDir())
+			require.NoError(t, err)
+			require.NoError(t, blobs.Put(context.Background(), "diagnostic-bundles/101/301.tar.gz", []byte("archive")))
+			service := &Service{m: mockModel, blobs: blobs}
+
+			get := mockModel.EXPECT().GetOrgClusterDiagnosticBundle(gomock.Any(), querier.GetOrgClusterDiagnosticBundleParams{
+				ID:        bundleID,
+				ClusterID: clusterID,
+				OrgID:     orgID,
+			})
+			if tc.notFound {
+				get.Return(nil, pgx.ErrNoRows)
+			} else {
+				get.Return(&querier.ClusterDiagnosticBundle{ID: bundleID, ClusterID: clusterID, Status: tc.status, ContentRef: tc.contentRef, CreatedAt: createdAt}, nil)
+			}
+			if tc.err == nil && tc.contentRef == nil {
+				mockModel.EXPECT().GetClusterDiagnosticBundleContent(gomock.Any(), bundleID).Return([]byte("archive"), nil)
+			}
+
+			name, content, err := service.DownloadClusterDiagnosticBundle(context.Background(), clusterID, bundleID, orgID)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, "diagnostic-bundle-101-301-20250110-120000.tar.gz", name)
+			assert.Equal(t, []byte("archive"), content)
+		})
+	}
+}
+
+func TestDeleteClusterDiagnosticBundle(t *testing.T) {
+	var (
+		orgID     = int32(201)
+		clusterID = int32(101)
+		bundleID  = int32(301)
+		key       = "diagnostic-bundles/101/301.tar.gz"
+	)
+
+	testCases := []struct {
+		name       string
+		status     string
+		contentRef *string
+		err        error
+	}{
+		{name: "completed", status: "completed", contentRef: utils.Ptr(key)},
+		{name: "failed", status: "failed"},
+		{name: "running", status: "running", err: ErrDiagnosticBundleInProgress},
+		{name: "pending", status: "pending", err: ErrDiagnosticBundleInProgress},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockModel := model.NewMockModelInterfaceWithTransaction(ctrl)
+			blobs, err := blobstore.NewLocalStore(t.TempDir())
+			require.NoError(t, err)
+			require.NoError(t, blobs.Put(context.Background(), key, []byte("archive")))
+			service := &Service{m: mockModel, blobs: blobs}
+
+			mockModel.EXPECT().GetOrgClusterDiagnosticBundle(gomock.Any(), querier.GetOrgClusterDiagnosticBundleParams{
+				ID:        bundleID,
+				ClusterID: clusterID,
+				OrgID:     orgID,
+			}).Return(&querier.ClusterDiagnosticBundle{ID: bundleID, ClusterID: clusterID, Status: tc.status, ContentRef: tc.contentRef}, nil)
+			if tc.err == nil {
+				mockModel.EXPECT().DeleteClusterDiagnosticBundle(gomock.Any(), bundleID).Return(nil)
+			}
+
+			err = service.DeleteClusterDiagnosticBundle(context.Background(), clusterID, bundleID, orgID)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+			_, err = blobs.Get(context.Background(), key)
+			if tc.contentRef != nil {
+				assert.ErrorIs(t, err, blobstore.ErrNotFound)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
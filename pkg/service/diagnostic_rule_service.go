@@ -0,0 +1,100 @@
+package service
+
+import (
+	"context"
+	"math"
+
+	"github.com/pkg/errors"
+	"github.com/risingwavelabs/risingwave-console/pkg/conn/http"
+	"github.com/risingwavelabs/risingwave-console/pkg/zgen/apigen"
+	"github.com/risingwavelabs/risingwave-console/pkg/zgen/querier"
+)
+
+func diagnosticRuleToApi(def http.DiagnoseRuleDefinition, setting *querier.OrgDiagnosticRule) apigen.DiagnosticRule {
+	rule := apigen.DiagnosticRule{
+		Name:             apigen.DiagnosticRuleName(def.Rule),
+		Description:      def.Description,
+		Unit:             def.Unit,
+		Enabled:          true,
+		Threshold:        def.DefaultThreshold,
+		DefaultThreshold: def.DefaultThreshold,
+	}
+	if setting != nil {
+		rule.Enabled = setting.Enabled
+		if setting.Threshold != nil {
+			rule.Threshold = *setting.Threshold
+		}
+	}
+	return rule
+}
+
+func diagnosticFindingToApi(f *querier.ClusterDiagnosticFinding) apigen.ClusterDiagnosticFinding {
+	return apigen.ClusterDiagnosticFinding{
+		ID:           f.ID,
+		DiagnosticID: f.DiagnosticID,
+		ClusterID:    f.ClusterID,
+		Rule:         apigen.DiagnosticRuleName(f.Rule),
+		Severity:     apigen.DiagnosticFindingSeverity(f.Severity),
+		Message:      f.Message,
+		Value:        f.Value,
+		Threshold:    f.Threshold,
+		Details:      f.Details,
+		CreatedAt:    f.CreatedAt,
+	}
+}
+
+func (s *Service) ListDiagnosticRules(ctx context.Context, orgID int32) ([]apigen.DiagnosticRule, error) {
+	settings, err := s.m.ListOrgDiagnosticRules(ctx, orgID)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to list diagnostic rules")
+	}
+	byRule := map[string]*querier.OrgDiagnosticRule{}
+	for _, setting := range settings {
+		byRule[setting.Rule] = setting
+	}
+
+	result := make([]apigen.DiagnosticRule, len(http.DiagnoseRules))
+	for i, def := range http.DiagnoseRules {
+		result[i] = diagnosticRuleToApi(def, byRule[string(def.Rule)])
+	}
+	return result, nil
+}
+
+func (s *Service) UpdateDiagnosticRule(ctx context.Context, rule apigen.DiagnosticRuleName, params apigen.DiagnosticRuleUpdate, orgID int32) (*apigen.DiagnosticRule, error) {
+	def, ok := http.GetDiagnoseRule(http.DiagnoseRule(rule))
+	if !ok {
+		return nil, ErrDiagnosticRuleNotFound
+	}
+	if params.Threshold != nil && (*params.Threshold <= 0 || math.IsInf(*params.Threshold, 0) || math.IsNaN(*params.Threshold)) {
+		return nil, errors.Wrapf(ErrInvalidDiagnosticRule, "threshold must be positive, got %v", *params.Threshold)
+	}
+
+	setting, err := s.m.UpsertOrgDiagnosticRule(ctx, querier.UpsertOrgDiagnosticRuleParams{
+		OrgID:     orgID,
+		Rule:      string(def.Rule),
+		Enabled:   params.Enabled,
+		Threshold: params.Threshold,
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to update diagnostic rule")
+	}
+	result := diagnosticRuleToApi(def, setting)
+	return &result, nil
+}
+
+func (s *Service) ListClusterDiagnosticFindings(ctx context.Context, id int32, diagnosticID int32, orgID int32) ([]apigen.ClusterDiagnosticFinding, error) {
+	diagnostic, err := s.getOrgClusterDiagnostic(ctx, id, diagnosticID, orgID)
+	if err != nil {
+		return nil, err
+	}
+	findings, err := s.m.ListClusterDiagnosticFindings(ctx, diagnostic.ID)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to list diagnostic findings")
+	}
+
+	result := make([]apigen.ClusterDiagnosticFinding, len(findings))
+	for i, f := range findings {
+		result[i] = diagnosticFindingToApi(f)
+	}
+	return result, nil
+}
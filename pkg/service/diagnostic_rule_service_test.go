@@ -0,0 +1,111 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/risingwavelabs/risingwave-console/pkg/utils"
+	"github.com/risingwavelabs/risingwave-console/pkg/zcore/model"
+	"github.com/risingwavelabs/risingwave-console/pkg/zgen/apigen"
+	"github.com/risingwavelabs/risingwave-console/pkg/zgen/querier"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestListDiagnosticRules(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	orgID := int32(201)
+	mockModel := model.NewMockModelInterfaceWithTransaction(ctrl)
+	service := &Service{m: mockModel}
+
+	mockModel.EXPECT().ListOrgDiagnosticRules(gomock.Any(), orgID).Return([]*querier.OrgDiagnosticRule{
+		{OrgID: orgID, Rule: "actor_blocked", Enabled: true, Threshold: utils.Ptr(30.0)},
+		{OrgID: orgID, Rule: "worker_count_dropped", Enabled: false},
+	}, nil)
+
+	rules, err := service.ListDiagnosticRules(context.Background(), orgID)
+	require.NoError(t, err)
+	require.Len(t, rules, 3)
+
+	assert.Equal(t, apigen.BarrierLatency, rules[0].Name)
+	assert.True(t, rules[0].Enabled)
+	assert.Equal(t, rules[0].DefaultThreshold, rules[0].Threshold)
+
+	assert.Equal(t, apigen.ActorBlocked, rules[1].Name)
+	assert.Equal(t, 30.0, rules[1].Threshold)
+	assert.Equal(t, 60.0, rules[1].DefaultThreshold)
+
+	assert.Equal(t, apigen.WorkerCountDropped, rules[2].Name)
+	assert.False(t, rules[2].Enabled)
+	assert.Equal(t, 1.0, rules[2].Threshold)
+}
+
+func TestUpdateDiagnosticRule(t *testing.T) {
+	orgID := int32(201)
+
+	testCases := []struct {
+		name   string
+		rule   apigen.DiagnosticRuleName
+		params apigen.DiagnosticRuleUpdate
+		err    error
+	}{
+		{
+			name:   "override threshold",
+			rule:   apigen.BarrierLatency,
+			params: apigen.DiagnosticRuleUpdate{Enabled: true, Threshold: utils.Ptr(10.0)},
+		},
+		{
+			name:   "disable",
+			rule:   apigen.ActorBlocked,
+			params: apigen.DiagnosticRuleUpdate{Enabled: false},
+		},
+		{
+			name:   "unknown rule",
+			rule:   apigen.DiagnosticRuleName("cpu_usage"),
+			params: apigen.DiagnosticRuleUpdate{Enabled: true},
+			err:    ErrDiagnosticRuleNotFound,
+		},
+		{
+			name:   "non-positive threshold",
+			rule:   apigen.BarrierLatency,
+			params: apigen.DiagnosticRuleUpdate{Enabled: true, Threshold: utils.Ptr(0.0)},
+			err:    ErrInvalidDiagnosticRule,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockModel := model.NewMockModelInterfaceWithTransaction(ctrl)
+			service := &Service{m: mockModel}
+
+			if tc.err == nil {
+				mockModel.EXPECT().UpsertOrgDiagnosticRule(gomock.Any(), querier.UpsertOrgDiagnosticRuleParams{
+					OrgID:     orgID,
+					Rule:      string(tc.rule),
+					Enabled:   tc.params.Enabled,
+					Threshold: tc.params.Threshold,
+				}).Return(&querier.OrgDiagnosticRule{
+					OrgID:     orgID,
+					Rule:      string(tc.rule),
+					Enabled:   tc.params.Enabled,
+					Threshold: tc.params.Threshold,
+				}, nil)
+			}
+
+			rule, err := service.UpdateDiagnosticRule(context.Background(), tc.rule, tc.params, orgID)
+			if tc.err != nil {
+				assert.ErrorIs(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.rule, rule.Name)
+			assert.Equal(t, tc.params.Enabled, rule.Enabled)
+			assert.Equal(t, utils.UnwrapOrDefault(tc.params.Threshold, rule.DefaultThreshold), rule.Threshold)
+		})
+	}
+}
@@ -3,14 +3,27 @@ package service
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/cloudcarver/anchor/pkg/taskcore"
 	"github.com/jackc/pgx/v5"
 	"github.com/pkg/errors"
+	"github.com/risingwavelabs/risingwave-console/pkg/blobstore"
+	"github.com/risingwavelabs/risingwave-console/pkg/conn/http"
+	"github.com/risingwavelabs/risingwave-console/pkg/utils"
 	"github.com/risingwavelabs/risingwave-console/pkg/zcore/model"
 	"github.com/risingwavelabs/risingwave-console/pkg/zgen/apigen"
 	"github.com/risingwavelabs/risingwave-console/pkg/zgen/querier"
 	"github.com/risingwavelabs/risingwave-console/pkg/zgen/taskgen"
+	"go.uber.org/zap"
+)
+
+const (
+	defaultDiagnosticSearchLimit = 20
+	maxDiagnosticSearchLimit     = 100
+
+	// diagnosticSearchSnippets is the number of lines highlighted in each search result
+	diagnosticSearchSnippets = 3
 )
 
 func (s *Service) CreateClusterDiagnostic(ctx context.Context, id int32, orgID int32) (*apigen.DiagnosticData, error) {
@@ -26,17 +39,34 @@ func (s *Service) CreateClusterDiagnostic(ctx context.Context, id int32, orgID i
 	if err != nil {
 		return nil, errors.Wrapf(err, "failed to get diagnose")
 	}
+	obj, err := blobstore.PutCompressed(ctx, s.blobs, blobstore.DiagnosticKey(cluster.ID), []byte(content))
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to store diagnose report")
+	}
 	diag, err := s.m.CreateClusterDiagnostic(ctx, querier.CreateClusterDiagnosticParams{
-		ClusterID: cluster.ID,
-		Content:   content,
+		ClusterID:         cluster.ID,
+		ContentRef:        &obj.Key,
+		ContentSize:       &obj.Size,
+		ContentStoredSize: &obj.StoredSize,
+		ContentSha256:     &obj.SHA256,
 	})
 	if err != nil {
+		if err := s.blobs.Delete(context.WithoutCancel(ctx), obj.Key); err != nil {
+			log.Warn("failed to delete orphan diagnose report", zap.String("key", obj.Key), zap.Error(err))
+		}
 		return nil, errors.Wrapf(err, "failed to create cluster diagnostic")
 	}
+	// a diagnostic failed to be indexed is indexed later by the diagnostic indexing task
+	if err := s.m.CreateClusterDiagnosticSearch(ctx, querier.CreateClusterDiagnosticSearchParams{
+		DiagnosticID: diag.ID,
+		SearchText:   http.DiagnoseSearchText(content),
+	}); err != nil {
+		log.Warn("failed to index cluster diagnostic", zap.Int32("diagnostic_id", diag.ID), zap.Error(err))
+	}
 	return &apigen.DiagnosticData{
 		ID:        diag.ID,
 		CreatedAt: diag.CreatedAt,
-		Content:   diag.Content,
+		Content:   content,
 	}, nil
 }
 
@@ -64,7 +94,7 @@ func (s *Service) ListClusterDiagnostics(ctx context.Context, id int32, orgID in
 	return result, nil
 }
 
-func (s *Service) GetClusterDiagnostic(ctx context.Context, id int32, diagnosticID int32, orgID int32) (*apigen.DiagnosticData, error) {
+func (s *Service) getOrgClusterDiagnostic(ctx context.Context, id int32, diagnosticID int32, orgID int32) (*querier.ClusterDiagnostic, error) {
 	cluster, err := s.m.GetOrgCluster(ctx, querier.GetOrgClusterParams{
 		ID:    id,
 		OrgID: orgID,
@@ -81,14 +111,95 @@ func (s *Service) GetClusterDiagnostic(ctx context.Context, id int32, diagnostic
 	if diagnostic.ClusterID != cluster.ID {
 		return nil, ErrDiagnosticNotFound
 	}
+	return diagnostic, nil
+}
+
+// getClusterDiagnosticContent returns the diagnose report of the diagnostic from the blob store,
+// or from postgres if it is not moved to the blob store yet.
+func (s *Service) getClusterDiagnosticContent(ctx context.Context, diagnostic *querier.ClusterDiagnostic) (string, error) {
+	if diagnostic.ContentRef == nil {
+		if diagnostic.Content == nil {
+			return "", errors.Errorf("diagnostic %d has no content", diagnostic.ID)
+		}
+		return *diagnostic.Content, nil
+	}
+	checksum := ""
+	if diagnostic.ContentSha256 != nil {
+		checksum = *diagnostic.ContentSha256
+	}
+	content, err := blobstore.GetCompressed(ctx, s.blobs, *diagnostic.ContentRef, checksum)
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to get diagnose report of diagnostic %d", diagnostic.ID)
+	}
+	return string(content), nil
+}
+
+func (s *Service) GetClusterDiagnostic(ctx context.Context, id int32, diagnosticID int32, orgID int32) (*apigen.DiagnosticData, error) {
+	diagnostic, err := s.getOrgClusterDiagnostic(ctx, id, diagnosticID, orgID)
+	if err != nil {
+		return nil, err
+	}
+	content, err := s.getClusterDiagnosticContent(ctx, diagnostic)
+	if err != nil {
+		return nil, err
+	}
 
 	return &apigen.DiagnosticData{
 		ID:        diagnostic.ID,
 		CreatedAt: diagnostic.CreatedAt,
-		Content:   diagnostic.Content,
+		Content:   content,
 	}, nil
 }
 
+func (s *Service) GetClusterDiagnosticReport(ctx context.Context, id int32, diagnosticID int32, orgID int32) (*apigen.DiagnosticReport, error) {
+	diagnostic, err := s.getOrgClusterDiagnostic(ctx, id, diagnosticID, orgID)
+	if err != nil {
+		return nil, err
+	}
+
+	content, err := s.getClusterDiagnosticContent(ctx, diagnostic)
+	if err != nil {
+		return nil, err
+	}
+
+	report := diagnoseReportToApi(http.ParseDiagnoseReport(content))
+	report.DiagnosticID = diagnostic.ID
+	report.CreatedAt = diagnostic.CreatedAt
+	return report, nil
+}
+
+func (s *Service) DiffClusterDiagnostics(ctx context.Context, id int32, fromID int32, toID int32, orgID int32) (*apigen.DiagnosticDiff, error) {
+	from, err := s.getOrgClusterDiagnostic(ctx, id, fromID, orgID)
+	if err != nil {
+		return nil, err
+	}
+	to, err := s.getOrgClusterDiagnostic(ctx, id, toID, orgID)
+	if err != nil {
+		return nil, err
+	}
+	fromContent, err := s.getClusterDiagnosticContent(ctx, from)
+	if err != nil {
+		return nil, err
+	}
+	toContent, err := s.getClusterDiagnosticContent(ctx, to)
+	if err != nil {
+		return nil, err
+	}
+
+	diff, err := http.DiffDiagnoseReports(
+		fmt.Sprintf("diagnostic-%d (%s)", from.ID, from.CreatedAt.Format(time.RFC3339)), fromContent,
+		fmt.Sprintf("diagnostic-%d (%s)", to.ID, to.CreatedAt.Format(time.RFC3339)), toContent,
+	)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to diff cluster diagnostics")
+	}
+
+	result := diagnoseDiffToApi(diff)
+	result.FromDiagnosticID = from.ID
+	result.ToDiagnosticID = to.ID
+	return result, nil
+}
+
 func (s *Service) UpdateClusterAutoDiagnosticConfig(ctx context.Context, id int32, params apigen.AutoDiagnosticConfig, orgID int32) error {
 	cluster, err := s.m.GetOrgCluster(ctx, querier.GetOrgClusterParams{
 		ID:    id,
@@ -202,3 +313,73 @@ func (s *Service) GetClusterAutoDiagnosticConfig(ctx context.Context, id int32,
 		RetentionDuration: params.RetentionDuration,
 	}, nil
 }
+
+func (s *Service) SearchClusterDiagnostics(ctx context.Context, id int32, params apigen.SearchClusterDiagnosticsParams, orgID int32) ([]apigen.DiagnosticSearchResult, error) {
+	cluster, err := s.getOrgCluster(ctx, id, orgID)
+	if err != nil {
+		return nil, err
+	}
+	return s.searchDiagnostics(ctx, querier.SearchOrgClusterDiagnosticsParams{
+		OrgID:       orgID,
+		Limit:       utils.ClampLimit(params.Limit, defaultDiagnosticSearchLimit, maxDiagnosticSearchLimit),
+		Query:       params.Q,
+		ClusterID:   &cluster.ID,
+		From:        params.From,
+		To:          params.To,
+		OldestFirst: params.Order != nil && *params.Order == apigen.Oldest,
+	})
+}
+
+func (s *Service) SearchDiagnostics(ctx context.Context, params apigen.SearchDiagnosticsParams, orgID int32) ([]apigen.DiagnosticSearchResult, error) {
+	return s.searchDiagnostics(ctx, querier.SearchOrgClusterDiagnosticsParams{
+		OrgID:       orgID,
+		Limit:       utils.ClampLimit(params.Limit, defaultDiagnosticSearchLimit, maxDiagnosticSearchLimit),
+		Query:       params.Q,
+		ClusterID:   params.ClusterID,
+		From:        params.From,
+		To:          params.To,
+		OldestFirst: params.Order != nil && *params.Order == apigen.Oldest,
+	})
+}
+
+// searchDiagnostics finds the diagnostics with postgres full-text search, then highlights the
+// matching lines of their reports. A hit whose report is missing from the blob store is returned
+// without snippets.
+func (s *Service) searchDiagnostics(ctx context.Context, params querier.SearchOrgClusterDiagnosticsParams) ([]apigen.DiagnosticSearchResult, error) {
+	rows, err := s.m.SearchOrgClusterDiagnostics(ctx, params)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to search cluster diagnostics")
+	}
+
+	result := make([]apigen.DiagnosticSearchResult, len(rows))
+	for i, row := range rows {
+		content, err := s.getClusterDiagnosticContent(ctx, &querier.ClusterDiagnostic{
+			ID:            row.ID,
+			Content:       row.Content,
+			ContentRef:    row.ContentRef,
+			ContentSha256: row.ContentSha256,
+		})
+		if err != nil {
+			if !errors.Is(err, blobstore.ErrNotFound) {
+				return nil, err
+			}
+			log.Warn("diagnose report is missing, the search result has no snippets", zap.Int32("diagnostic_id", row.ID), zap.Error(err))
+		}
+		snippets := http.HighlightDiagnose(content, params.Query, diagnosticSearchSnippets)
+		result[i] = apigen.DiagnosticSearchResult{
+			DiagnosticID: row.ID,
+			ClusterID:    row.ClusterID,
+			ClusterName:  row.ClusterName,
+			CreatedAt:    row.CreatedAt,
+			Snippets:     make([]apigen.DiagnosticSnippet, len(snippets)),
+		}
+		for j, snippet := range snippets {
+			parts := make([]apigen.DiagnosticSnippetPart, len(snippet.Parts))
+			for k, part := range snippet.Parts {
+				parts[k] = apigen.DiagnosticSnippetPart{Text: part.Text, Match: part.Match}
+			}
+			result[i].Snippets[j] = apigen.DiagnosticSnippet{Line: int32(snippet.Line), Parts: parts}
+		}
+	}
+	return result, nil
+}
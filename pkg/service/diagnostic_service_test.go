@@ -4,15 +4,19 @@ import (
 	"context"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/cloudcarver/anchor/pkg/taskcore"
 	"github.com/jackc/pgx/v5"
+	"github.com/risingwavelabs/risingwave-console/pkg/blobstore"
 	mock_http "github.com/risingwavelabs/risingwave-console/pkg/conn/http/mock"
+	"github.com/risingwavelabs/risingwave-console/pkg/utils"
 	"github.com/risingwavelabs/risingwave-console/pkg/zcore/model"
 	"github.com/risingwavelabs/risingwave-console/pkg/zgen/apigen"
 	"github.com/risingwavelabs/risingwave-console/pkg/zgen/querier"
 	"github.com/risingwavelabs/risingwave-console/pkg/zgen/taskgen"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
 )
 
@@ -151,9 +155,12 @@ func TestCreateClusterDiagnostic(t *testing.T) {
 
 	metahttp := mock_http.NewMockMetaHttpManagerInterface(ctrl)
 	model := model.NewMockModelInterfaceWithTransaction(ctrl)
+	blobs, err := blobstore.NewLocalStore(t.TempDir())
+	require.NoError(t, err)
 	service := &Service{
 		m:        model,
 		metahttp: metahttp,
+		blobs:    blobs,
 	}
 
 	model.EXPECT().GetOrgCluster(ctx, querier.GetOrgClusterParams{
@@ -171,16 +178,227 @@ func TestCreateClusterDiagnostic(t *testing.T) {
 		GetDiagnose(ctx, fmt.Sprintf("http://%s:%d", clusterHost, clusterHttpPort)).
 		Return(diagnosticContent, nil)
 
-	model.EXPECT().CreateClusterDiagnostic(ctx, querier.CreateClusterDiagnosticParams{
-		ClusterID: clusterID,
-		Content:   diagnosticContent,
-	}).Return(&querier.ClusterDiagnostic{
-		ID:      diagnosticID,
-		Content: diagnosticContent,
-	}, nil)
+	var created querier.CreateClusterDiagnosticParams
+	model.EXPECT().CreateClusterDiagnostic(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, arg querier.CreateClusterDiagnosticParams) (*querier.ClusterDiagnostic, error) {
+		created = arg
+		return &querier.ClusterDiagnostic{
+			ID:         diagnosticID,
+			ContentRef: arg.ContentRef,
+		}, nil
+	})
+	model.EXPECT().CreateClusterDiagnosticSearch(ctx, querier.CreateClusterDiagnosticSearchParams{
+		DiagnosticID: diagnosticID,
+		SearchText:   "diagnostic content",
+	}).Return(nil)
 
 	diagnostic, err := service.CreateClusterDiagnostic(ctx, clusterID, orgID)
 	assert.NoError(t, err)
 	assert.Equal(t, diagnosticID, diagnostic.ID)
 	assert.Equal(t, diagnosticContent, diagnostic.Content)
+
+	// only the reference is kept in postgres
+	assert.Equal(t, clusterID, created.ClusterID)
+	assert.Equal(t, int64(len(diagnosticContent)), *created.ContentSize)
+	stored, err := blobstore.GetCompressed(ctx, blobs, *created.ContentRef, *created.ContentSha256)
+	require.NoError(t, err)
+	assert.Equal(t, diagnosticContent, string(stored))
+}
+
+func TestGetClusterDiagnosticReport(t *testing.T) {
+	var (
+		orgID        = int32(201)
+		clusterID    = int32(101)
+		diagnosticID = int32(301)
+	)
+
+	testCases := []struct {
+		name      string
+		clusterID int32
+		err       error
+	}{
+		{name: "parsed", clusterID: clusterID},
+		{name: "diagnostic of another cluster", clusterID: clusterID + 1, err: ErrDiagnosticNotFound},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockModel := model.NewMockModelInterfaceWithTransaction(ctrl)
+			service := &Service{m: mockModel}
+
+			mockModel.EXPECT().GetOrgCluster(gomock.Any(), querier.GetOrgClusterParams{ID: clusterID, OrgID: orgID}).Return(&querier.Cluster{ID: clusterID}, nil)
+			mockModel.EXPECT().GetClusterDiagnostic(gomock.Any(), diagnosticID).Return(&querier.ClusterDiagnostic{
+				ID:        diagnosticID,
+				ClusterID: tc.clusterID,
+				Content:   utils.Ptr("version: 2.3.0\n\nnumber of materialized view: 3\n"),
+			}, nil)
+
+			report, err := service.GetClusterDiagnosticReport(context.Background(), clusterID, diagnosticID, orgID)
+			if tc.err != nil {
+				assert.ErrorIs(t, err, tc.err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, diagnosticID, report.DiagnosticID)
+			assert.Equal(t, "2.3.0", *report.Version)
+			assert.Equal(t, map[string]int64{"materialized view": 3}, report.Catalog)
+			assert.NotNil(t, report.WorkerNodes)
+			assert.NotNil(t, report.Storage.Tables)
+		})
+	}
+}
+
+func TestDiffClusterDiagnostics(t *testing.T) {
+	var (
+		orgID     = int32(201)
+		clusterID = int32(101)
+		fromID    = int32(301)
+		toID      = int32(302)
+	)
+
+	testCases := []struct {
+		name        string
+		toClusterID int32
+		err         error
+	}{
+		{name: "diffed", toClusterID: clusterID},
+		{name: "diagnostic of another cluster", toClusterID: clusterID + 1, err: ErrDiagnosticNotFound},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockModel := model.NewMockModelInterfaceWithTransaction(ctrl)
+			blobs, err := blobstore.NewLocalStore(t.TempDir())
+			require.NoError(t, err)
+			service := &Service{m: mockModel, blobs: blobs}
+
+			obj, err := blobstore.PutCompressed(context.Background(), blobs, blobstore.DiagnosticKey(clusterID), []byte("version: 2.3.0\n\nnumber of materialized view: 4\n"))
+			require.NoError(t, err)
+			mockModel.EXPECT().GetOrgCluster(gomock.Any(), querier.GetOrgClusterParams{ID: clusterID, OrgID: orgID}).Return(&querier.Cluster{ID: clusterID}, nil).Times(2)
+			// the older diagnostic is not moved to the blob store yet
+			mockModel.EXPECT().GetClusterDiagnostic(gomock.Any(), fromID).Return(&querier.ClusterDiagnostic{
+				ID:        fromID,
+				ClusterID: clusterID,
+				Content:   utils.Ptr("version: 2.3.0\n\nnumber of materialized view: 3\n"),
+			}, nil)
+			mockModel.EXPECT().GetClusterDiagnostic(gomock.Any(), toID).Return(&querier.ClusterDiagnostic{
+				ID:            toID,
+				ClusterID:     tc.toClusterID,
+				ContentRef:    &obj.Key,
+				ContentSha256: &obj.SHA256,
+			}, nil)
+
+			diff, err := service.DiffClusterDiagnostics(context.Background(), clusterID, fromID, toID, orgID)
+			if tc.err != nil {
+				assert.ErrorIs(t, err, tc.err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, fromID, diff.FromDiagnosticID)
+			assert.Equal(t, toID, diff.ToDiagnosticID)
+			assert.False(t, diff.VersionChanged)
+			assert.Equal(t, []apigen.DiagnosticCountChange{{Name: "materialized view", Before: 3, After: 4}}, diff.Catalog)
+			assert.Contains(t, diff.UnifiedDiff, "-number of materialized view: 3\n+number of materialized view: 4\n")
+			assert.NotNil(t, diff.WorkerNodes.Added)
+			assert.NotNil(t, diff.SlowActors)
+		})
+	}
+}
+
+func TestSearchClusterDiagnostics(t *testing.T) {
+	var (
+		ctx       = context.Background()
+		orgID     = int32(201)
+		clusterID = int32(101)
+		query     = "slow actor"
+	)
+
+	t.Run("cluster not found", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockModel := model.NewMockModelInterfaceWithTransaction(ctrl)
+		service := &Service{m: mockModel}
+
+		mockModel.EXPECT().GetOrgCluster(ctx, querier.GetOrgClusterParams{ID: clusterID, OrgID: orgID}).Return(nil, pgx.ErrNoRows)
+
+		_, err := service.SearchClusterDiagnostics(ctx, clusterID, apigen.SearchClusterDiagnosticsParams{Q: query}, orgID)
+		assert.ErrorIs(t, err, ErrClusterNotFound)
+	})
+
+	t.Run("highlighted", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockModel := model.NewMockModelInterfaceWithTransaction(ctrl)
+		blobs, err := blobstore.NewLocalStore(t.TempDir())
+		require.NoError(t, err)
+		service := &Service{m: mockModel, blobs: blobs}
+
+		stored, err := blobstore.PutCompressed(ctx, blobs, blobstore.DiagnosticKey(clusterID), []byte("version: 2.3.0\nslow actor 5: 35.2s"))
+		require.NoError(t, err)
+		from := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+		mockModel.EXPECT().GetOrgCluster(ctx, querier.GetOrgClusterParams{ID: clusterID, OrgID: orgID}).Return(&querier.Cluster{ID: clusterID}, nil)
+		mockModel.EXPECT().SearchOrgClusterDiagnostics(ctx, querier.SearchOrgClusterDiagnosticsParams{
+			OrgID:       orgID,
+			Limit:       5,
+			Query:       query,
+			ClusterID:   &clusterID,
+			From:        &from,
+			OldestFirst: true,
+		}).Return([]*querier.SearchOrgClusterDiagnosticsRow{
+			{ID: 301, ClusterID: clusterID, ClusterName: "prod", ContentRef: &stored.Key, ContentSha256: &stored.SHA256},
+			{ID: 302, ClusterID: clusterID, ClusterName: "prod", Content: utils.Ptr("no actor is slow")},
+		}, nil)
+
+		results, err := service.SearchClusterDiagnostics(ctx, clusterID, apigen.SearchClusterDiagnosticsParams{
+			Q:     query,
+			From:  &from,
+			Order: utils.Ptr(apigen.Oldest),
+			Limit: utils.Ptr(int32(5)),
+		}, orgID)
+		require.NoError(t, err)
+		require.Len(t, results, 2)
+		assert.Equal(t, int32(301), results[0].DiagnosticID)
+		assert.Equal(t, "prod", results[0].ClusterName)
+		assert.Equal(t, []apigen.DiagnosticSnippet{{Line: 2, Parts: []apigen.DiagnosticSnippetPart{
+			{Text: "slow", Match: true}, {Text: " "}, {Text: "actor", Match: true}, {Text: " 5: 35.2s"},
+		}}}, results[0].Snippets)
+		assert.Equal(t, []apigen.DiagnosticSnippet{{Line: 1, Parts: []apigen.DiagnosticSnippetPart{
+			{Text: "no "}, {Text: "actor", Match: true}, {Text: " is "}, {Text: "slow", Match: true},
+		}}}, results[1].Snippets)
+	})
+
+	t.Run("missing report", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockModel := model.NewMockModelInterfaceWithTransaction(ctrl)
+		blobs, err := blobstore.NewLocalStore(t.TempDir())
+		require.NoError(t, err)
+		service := &Service{m: mockModel, blobs: blobs}
+
+		mockModel.EXPECT().SearchOrgClusterDiagnostics(ctx, querier.SearchOrgClusterDiagnosticsParams{
+			OrgID: orgID,
+			Limit: maxDiagnosticSearchLimit,
+			Query: query,
+		}).Return([]*querier.SearchOrgClusterDiagnosticsRow{
+			{ID: 301, ClusterID: clusterID, ClusterName: "prod", ContentRef: utils.Ptr("diagnostics/101/missing.txt.gz")},
+			{ID: 302, ClusterID: clusterID, ClusterName: "prod", Content: utils.Ptr("slow actor")},
+		}, nil)
+
+		results, err := service.SearchDiagnostics(ctx, apigen.SearchDiagnosticsParams{
+			Q:     query,
+			Limit: utils.Ptr(int32(1000)),
+		}, orgID)
+		require.NoError(t, err)
+		require.Len(t, results, 2)
+		assert.Equal(t, int32(301), results[0].DiagnosticID)
+		assert.Empty(t, results[0].Snippets)
+		assert.Len(t, results[1].Snippets, 1)
+	})
 }
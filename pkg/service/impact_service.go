@@ -0,0 +1,441 @@
+package service
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/pkg/errors"
+	"github.com/risingwavelabs/risingwave-console/pkg/conn/sql"
+	"github.com/risingwavelabs/risingwave-console/pkg/utils"
+	"github.com/risingwavelabs/risingwave-console/pkg/zgen/apigen"
+	"github.com/risingwavelabs/risingwave-console/pkg/zgen/querier"
+)
+
+const DefaultMaxCascadeDropObjects = 10
+
+type ddlAction string
+
+const (
+	ddlActionDrop  ddlAction = "drop"
+	ddlActionAlter ddlAction = "alter"
+)
+
+// ddlObjectTypes maps the object keywords of DROP and ALTER to the relation types in rw_relations.
+var ddlObjectTypes = map[string]string{
+	"table":             "table",
+	"materialized view": "materialized view",
+	"view":              "view",
+	"source":            "source",
+	"sink":              "sink",
+	"index":             "index",
+	"subscription":      "subscription",
+	"schema":            "schema",
+}
+
+type qualifiedName struct {
+	Schema string
+	Name   string
+}
+
+func (q qualifiedName) String() string {
+	if q.Schema == "" {
+		return q.Name
+	}
+	return q.Schema + "." + q.Name
+}
+
+type ddlStatement struct {
+	Text       string
+	Action     ddlAction
+	ObjectType string
+	Names      []qualifiedName
+	Cascade    bool
+}
+
+type sqlToken struct {
+	text   string
+	quoted bool
+}
+
+// word returns the lowercased keyword of the token, or an empty string if it is quoted.
+func (t sqlToken) word() string {
+	if t.quoted {
+		return ""
+	}
+	return strings.ToLower(t.text)
+}
+
+// tokenizeSQL splits the query into statements of tokens. Comments and string literals are
+// skipped, unquoted identifiers are folded to lower case like Postgres does.
+func tokenizeSQL(query string) ([][]sqlToken, []string) {
+	var (
+		statements [][]sqlToken
+		texts      []string
+		tokens     []sqlToken
+		start      = 0
+	)
+	runes := []rune(query)
+	flush := func(end int) {
+		if len(tokens) > 0 {
+			statements = append(statements, tokens)
+			texts = append(texts, strings.TrimSpace(string(runes[start:end])))
+		}
+		tokens = nil
+		start = end + 1
+	}
+
+	for i := 0; i < len(runes); i++ {
+		r := runes[i]
+		switch {
+		case unicode.IsSpace(r):
+		case r == '-' && i+1 < len(runes) && runes[i+1] == '-':
+			for i < len(runes) && runes[i] != '\n' {
+				i++
+			}
+		case r == '/' && i+1 < len(runes) && runes[i+1] == '*':
+			i += 2
+			for i+1 < len(runes) && !(runes[i] == '*' && runes[i+1] == '/') {
+				i++
+			}
+			i++
+		case r == '\'':
+			i++
+			for i < len(runes) {
+				if runes[i] == '\'' {
+					if i+1 < len(runes) && runes[i+1] == '\'' {
+						i += 2
+						continue
+					}
+					break
+				}
+				i++
+			}
+			tokens = append(tokens, sqlToken{text: "'"})
+		case r == '"':
+			var b strings.Builder
+			i++
+			for i < len(runes) {
+				if runes[i] == '"' {
+					if i+1 < len(runes) && runes[i+1] == '"' {
+						b.WriteRune('"')
+						i += 2
+						continue
+					}
+					break
+				}
+				b.WriteRune(runes[i])
+				i++
+			}
+			tokens = append(tokens, sqlToken{text: b.String(), quoted: true})
+		case r == ';':
+			flush(i)
+		case unicode.IsLetter(r) || r == '_':
+			j := i
+			for j < len(runes) && (unicode.IsLetter(runes[j]) || unicode.IsDigit(runes[j]) || runes[j] == '_' || runes[j] == '$') {
+				j++
+			}
+			tokens = append(tokens, sqlToken{text: strings.ToLower(string(runes[i:j]))})
+			i = j - 1
+		default:
+			tokens = append(tokens, sqlToken{text: string(r)})
+		}
+	}
+	flush(len(runes))
+	return statements, texts
+}
+
+// parseDDLStatements returns the DROP and ALTER statements on relations and schemas in the query,
+// other statements are ignored.
+func parseDDLStatements(query string) []ddlStatement {
+	statements, texts := tokenizeSQL(query)
+	result := []ddlStatement{}
+	for i, tokens := range statements {
+		if stmt, ok := parseDDLStatement(tokens); ok {
+			stmt.Text = texts[i]
+			result = append(result, stmt)
+		}
+	}
+	return result
+}
+
+func parseDDLStatement(tokens []sqlToken) (ddlStatement, bool) {
+	pos := 0
+	peek := func(offset int) string {
+		if pos+offset < len(tokens) {
+			return tokens[pos+offset].word()
+		}
+		return ""
+	}
+
+	stmt := ddlStatement{}
+	switch peek(0) {
+	case "drop":
+		stmt.Action = ddlActionDrop
+	case "alter":
+		stmt.Action = ddlActionAlter
+	default:
+		return stmt, false
+	}
+	pos++
+
+	if peek(0) == "materialized" && peek(1) == "view" {
+		stmt.ObjectType = "materialized view"
+		pos += 2
+	} else if _, ok := ddlObjectTypes[peek(0)]; ok {
+		stmt.ObjectType = peek(0)
+		pos++
+	} else {
+		return stmt, false
+	}
+
+	if peek(0) == "if" && peek(1) == "exists" {
+		pos += 2
+	}
+
+	for pos < len(tokens) {
+		name, ok := parseQualifiedName(tokens, &pos)
+		if !ok {
+			break
+		}
+		stmt.Names = append(stmt.Names, name)
+		// ALTER works on a single object, DROP accepts a list of objects
+		if stmt.Action == ddlActionAlter || peek(0) != "," {
+			break
+		}
+		pos++
+	}
+	if len(stmt.Names) == 0 {
+		return stmt, false
+	}
+
+	if stmt.Action == ddlActionDrop && peek(0) == "cascade" {
+		stmt.Cascade = true
+	}
+	return stmt, true
+}
+
+func parseQualifiedName(tokens []sqlToken, pos *int) (qualifiedName, bool) {
+	isIdent := func(i int) bool {
+		if i >= len(tokens) {
+			return false
+		}
+		t := tokens[i]
+		return t.quoted || (t.text != "" && (unicode.IsLetter([]rune(t.text)[0]) || t.text[0] == '_'))
+	}
+	if !isIdent(*pos) {
+		return qualifiedName{}, false
+	}
+	first := tokens[*pos].text
+	*pos++
+	if *pos+1 < len(tokens) && tokens[*pos].text == "." && !tokens[*pos].quoted && isIdent(*pos+1) {
+		second := tokens[*pos+1].text
+		*pos += 2
+		return qualifiedName{Schema: first, Name: second}, true
+	}
+	return qualifiedName{Name: first}, true
+}
+
+// analyzeImpact resolves the targets of the statements in the lineage graph and collects all
+// the objects depending on them transitively.
+func analyzeImpact(graph *apigen.LineageGraph, stmts []ddlStatement, searchPath []string) ([]apigen.StatementImpact, error) {
+	byName := make(map[qualifiedName]apigen.LineageNode, len(graph.Nodes))
+	for _, node := range graph.Nodes {
+		byName[qualifiedName{Schema: node.Schema, Name: node.Name}] = node
+	}
+
+	result := []apigen.StatementImpact{}
+	for _, stmt := range stmts {
+		impact := apigen.StatementImpact{
+			Statement:  stmt.Text,
+			Action:     apigen.StatementImpactAction(stmt.Action),
+			ObjectType: stmt.ObjectType,
+			Cascade:    stmt.Cascade,
+			Targets:    []apigen.LineageNode{},
+			Affected:   []apigen.LineageNode{},
+			Unresolved: []string{},
+		}
+
+		targets := map[int32]struct{}{}
+		for _, name := range stmt.Names {
+			found := false
+			if stmt.ObjectType == "schema" {
+				for _, node := range graph.Nodes {
+					if node.Schema == name.Name {
+						targets[node.ID] = struct{}{}
+						found = true
+					}
+				}
+			} else {
+				candidates := []qualifiedName{name}
+				if name.Schema == "" {
+					candidates = candidates[:0]
+					for _, schema := range searchPath {
+						candidates = append(candidates, qualifiedName{Schema: schema, Name: name.Name})
+					}
+				}
+				for _, candidate := range candidates {
+					if node, ok := byName[candidate]; ok && string(node.Type) == ddlObjectTypes[stmt.ObjectType] {
+						targets[node.ID] = struct{}{}
+						found = true
+						break
+					}
+				}
+			}
+			if !found {
+				impact.Unresolved = append(impact.Unresolved, name.String())
+			}
+		}
+
+		affected := map[int32]struct{}{}
+		for id := range targets {
+			sub, err := traverseLineage(graph, id, apigen.Downstream, 0)
+			if err != nil {
+				return nil, err
+			}
+			for _, node := range sub.Nodes {
+				if _, ok := targets[node.ID]; !ok {
+					affected[node.ID] = struct{}{}
+				}
+			}
+		}
+
+		for _, node := range graph.Nodes {
+			if _, ok := targets[node.ID]; ok {
+				impact.Targets = append(impact.Targets, node)
+			}
+			if _, ok := affected[node.ID]; ok {
+				impact.Affected = append(impact.Affected, node)
+			}
+		}
+		result = append(result, impact)
+	}
+	return result, nil
+}
+
+// droppedObjects returns the objects dropped along with the targets of the statement, the
+// objects of a schema are dropped with it.
+func droppedObjects(impact apigen.StatementImpact) []apigen.LineageNode {
+	if impact.ObjectType != "schema" {
+		return impact.Affected
+	}
+	return append(append([]apigen.LineageNode{}, impact.Targets...), impact.Affected...)
+}
+
+// confirmationToken identifies the statements and the objects they affect, it changes
+// once the statements or the dependencies change so a stale confirmation is rejected.
+func confirmationToken(databaseID int32, impacts []apigen.StatementImpact) string {
+	h := sha256.New()
+	fmt.Fprintf(h, "%d\n", databaseID)
+	for _, impact := range impacts {
+		fmt.Fprintf(h, "%s\n", impact.Statement)
+		dropped := droppedObjects(impact)
+		ids := make([]int, 0, len(dropped))
+		for _, node := range dropped {
+			ids = append(ids, int(node.ID))
+		}
+		sort.Ints(ids)
+		fmt.Fprintf(h, "%v\n", ids)
+	}
+	return hex.EncodeToString(h.Sum(nil))[:32]
+}
+
+// requiresConfirmation reports whether any CASCADE drop affects more objects than allowed. A
+// CASCADE drop of a name that is not resolved in the lineage requires a confirmation as its
+// impact is unknown.
+func requiresConfirmation(impacts []apigen.StatementImpact, maxObjects int) bool {
+	for _, impact := range impacts {
+		if impact.Action != apigen.Drop || !impact.Cascade {
+			continue
+		}
+		if len(impact.Unresolved) > 0 || len(droppedObjects(impact)) > maxObjects {
+			return true
+		}
+	}
+	return false
+}
+
+// searchPathOf returns the schemas resolving the unqualified names with the session settings,
+// public by default.
+func searchPathOf(settings map[string]string) []string {
+	searchPath := []string{"public"}
+	if v, ok := settings[sql.SettingSearchPath]; ok {
+		searchPath = searchPath[:0]
+		for _, schema := range strings.Split(v, ",") {
+			schema = strings.Trim(strings.TrimSpace(schema), `"`)
+			if schema != "" && schema != "$user" {
+				searchPath = append(searchPath, schema)
+			}
+		}
+	}
+	return searchPath
+}
+
+func (s *Service) analyzeDatabaseImpact(ctx context.Context, db *querier.DatabaseConnection, stmts []ddlStatement, settings map[string]string) (*apigen.ImpactAnalysis, error) {
+	analysis := &apigen.ImpactAnalysis{
+		Statements:        []apigen.StatementImpact{},
+		MaxCascadeObjects: s.maxCascadeDropObjects,
+	}
+	if len(stmts) == 0 {
+		return analysis, nil
+	}
+
+	graph, err := s.getLineage(ctx, db, nil, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	impacts, err := analyzeImpact(graph, stmts, searchPathOf(settings))
+	if err != nil {
+		return nil, err
+	}
+	analysis.Statements = impacts
+	analysis.RequiresConfirmation = requiresConfirmation(impacts, s.maxCascadeDropObjects)
+	if analysis.RequiresConfirmation {
+		analysis.ConfirmationToken = utils.Ptr(confirmationToken(db.ID, impacts))
+	}
+	return analysis, nil
+}
+
+func (s *Service) AnalyzeDatabaseImpact(ctx context.Context, id int32, params apigen.ImpactAnalysisRequest, orgID int32) (*apigen.ImpactAnalysis, error) {
+	db, err := s.getDb(ctx, id, orgID)
+	if err != nil {
+		return nil, err
+	}
+	settings, err := s.resolveSessionSettings(ctx, db, params.SessionSettings, orgID)
+	if err != nil {
+		return nil, err
+	}
+	return s.analyzeDatabaseImpact(ctx, db, parseDDLStatements(params.Statement), settings)
+}
+
+// checkDropConfirmation rejects CASCADE drops affecting too many objects unless the
+// confirmation token of the impact analysis is provided. The names are resolved with the
+// session settings the query runs with.
+func (s *Service) checkDropConfirmation(ctx context.Context, db *querier.DatabaseConnection, query string, token *string, settings map[string]string) error {
+	stmts := parseDDLStatements(query)
+	hasCascadeDrop := false
+	for _, stmt := range stmts {
+		if stmt.Action == ddlActionDrop && stmt.Cascade {
+			hasCascadeDrop = true
+		}
+	}
+	if !hasCascadeDrop {
+		return nil
+	}
+
+	analysis, err := s.analyzeDatabaseImpact(ctx, db, stmts, settings)
+	if err != nil {
+		return errors.Wrapf(err, "failed to analyze the impact of the query")
+	}
+	if !analysis.RequiresConfirmation {
+		return nil
+	}
+	if token != nil && *token == *analysis.ConfirmationToken {
+		return nil
+	}
+	return errors.Wrapf(ErrDropConfirmationRequired, "the query drops more than %d dependent objects", s.maxCascadeDropObjects)
+}
@@ -0,0 +1,123 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/risingwavelabs/risingwave-console/pkg/zgen/apigen"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseDDLStatements(t *testing.T) {
+	testCases := []struct {
+		name     string
+		query    string
+		expected []ddlStatement
+	}{
+		{
+			name:  "drop materialized view cascade",
+			query: "DROP MATERIALIZED VIEW IF EXISTS Public.MV1 CASCADE;",
+			expected: []ddlStatement{
+				{Text: "DROP MATERIALIZED VIEW IF EXISTS Public.MV1 CASCADE", Action: ddlActionDrop, ObjectType: "materialized view", Names: []qualifiedName{{Schema: "public", Name: "mv1"}}, Cascade: true},
+			},
+		},
+		{
+			name:  "drop multiple tables with quoted identifier",
+			query: `drop table t1, "Weird;Name" restrict`,
+			expected: []ddlStatement{
+				{Text: `drop table t1, "Weird;Name" restrict`, Action: ddlActionDrop, ObjectType: "table", Names: []qualifiedName{{Name: "t1"}, {Name: "Weird;Name"}}},
+			},
+		},
+		{
+			name:  "alter and other statements",
+			query: "SELECT 'drop table t1 cascade'; -- drop table t2 cascade\nALTER SOURCE s1 RENAME TO s2; /* drop sink k1 */ CREATE TABLE t3 (v int)",
+			expected: []ddlStatement{
+				{Text: "-- drop table t2 cascade\nALTER SOURCE s1 RENAME TO s2", Action: ddlActionAlter, ObjectType: "source", Names: []qualifiedName{{Name: "s1"}}},
+			},
+		},
+		{
+			name:     "unsupported object",
+			query:    "DROP FUNCTION f1 CASCADE",
+			expected: []ddlStatement{},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, parseDDLStatements(tc.query))
+		})
+	}
+}
+
+func TestAnalyzeImpact(t *testing.T) {
+	graph := testLineageGraph()
+	for i := range graph.Nodes {
+		if graph.Nodes[i].Type == apigen.MaterializedView {
+			graph.Nodes[i].Type = "materialized view"
+		}
+	}
+
+	impacts, err := analyzeImpact(graph, parseDDLStatements("DROP TABLE t1, missing CASCADE; ALTER MATERIALIZED VIEW public.mv2 SET PARALLELISM = 2"), []string{"public"})
+	require.NoError(t, err)
+	require.Len(t, impacts, 2)
+
+	drop := impacts[0]
+	assert.True(t, drop.Cascade)
+	assert.Equal(t, []int32{2}, nodeIDs(&apigen.LineageGraph{Nodes: drop.Targets}))
+	assert.Equal(t, []int32{4, 5, 6}, nodeIDs(&apigen.LineageGraph{Nodes: drop.Affected}))
+	assert.Equal(t, []string{"missing"}, drop.Unresolved)
+
+	alter := impacts[1]
+	assert.Equal(t, apigen.Alter, alter.Action)
+	assert.Equal(t, []int32{6}, nodeIDs(&apigen.LineageGraph{Nodes: alter.Affected}))
+
+	// the impact of the unresolved name is unknown
+	assert.True(t, requiresConfirmation(impacts, 3))
+
+	impacts, err = analyzeImpact(graph, parseDDLStatements("DROP TABLE t1 CASCADE"), []string{"public"})
+	require.NoError(t, err)
+	assert.True(t, requiresConfirmation(impacts, 2))
+	assert.False(t, requiresConfirmation(impacts, 3))
+
+	token := confirmationToken(1, impacts)
+	assert.Equal(t, token, confirmationToken(1, impacts))
+	assert.NotEqual(t, token, confirmationToken(2, impacts))
+}
+
+func TestAnalyzeImpactOfSchema(t *testing.T) {
+	graph := testLineageGraph()
+
+	impacts, err := analyzeImpact(graph, parseDDLStatements("DROP SCHEMA public CASCADE"), []string{"public"})
+	require.NoError(t, err)
+	require.Len(t, impacts, 1)
+	assert.Len(t, impacts[0].Targets, 7)
+	assert.Empty(t, impacts[0].Affected)
+
+	// the objects of the schema are dropped with it
+	assert.Len(t, droppedObjects(impacts[0]), 7)
+	assert.True(t, requiresConfirmation(impacts, 6))
+	assert.False(t, requiresConfirmation(impacts, 7))
+
+	impacts, err = analyzeImpact(graph, parseDDLStatements("DROP SCHEMA public"), []string{"public"})
+	require.NoError(t, err)
+	assert.False(t, requiresConfirmation(impacts, 0), "a drop without CASCADE fails if the schema is not empty")
+}
+
+func TestAnalyzeImpactWithSearchPath(t *testing.T) {
+	graph := buildLineageGraph([]apigen.LineageNode{
+		{ID: 1, Schema: "public", Name: "t1", Type: apigen.Table},
+		{ID: 2, Schema: "analytics", Name: "t1", Type: apigen.Table},
+		{ID: 3, Schema: "analytics", Name: "sink1", Type: apigen.Sink},
+	}, []apigen.LineageEdge{
+		{From: 2, To: 3},
+	})
+
+	searchPath := searchPathOf(map[string]string{"search_path": `"$user", analytics, public`})
+	assert.Equal(t, []string{"analytics", "public"}, searchPath)
+	assert.Equal(t, []string{"public"}, searchPathOf(map[string]string{}))
+
+	impacts, err := analyzeImpact(graph, parseDDLStatements("DROP TABLE t1 CASCADE"), searchPath)
+	require.NoError(t, err)
+	assert.Equal(t, []int32{2}, nodeIDs(&apigen.LineageGraph{Nodes: impacts[0].Targets}))
+	assert.Equal(t, []int32{3}, nodeIDs(&apigen.LineageGraph{Nodes: impacts[0].Affected}))
+}
@@ -13,19 +13,38 @@ import (
 	"github.com/pkg/errors"
 	root "github.com/risingwavelabs/risingwave-console"
 	"github.com/risingwavelabs/risingwave-console/pkg/config"
+	"github.com/risingwavelabs/risingwave-console/pkg/conn/sql"
 	"github.com/risingwavelabs/risingwave-console/pkg/utils"
 	"github.com/risingwavelabs/risingwave-console/pkg/zcore/model"
 	"github.com/risingwavelabs/risingwave-console/pkg/zgen/apigen"
 	"github.com/risingwavelabs/risingwave-console/pkg/zgen/querier"
+	"github.com/risingwavelabs/risingwave-console/pkg/zgen/taskgen"
 	"gopkg.in/yaml.v3"
 
 	anchor_app "github.com/cloudcarver/anchor/pkg/app"
 	anchor_svc "github.com/cloudcarver/anchor/pkg/service"
+	"github.com/cloudcarver/anchor/pkg/taskcore"
+)
+
+const (
+	catalogSnapshotTaskTag    = "catalog-snapshot"
+	reconcileSnapshotsTaskTag = "reconcile-snapshots"
+	detectClusterVersionsTag  = "detect-cluster-versions"
+	checkClusterHealthTag     = "check-cluster-health"
+	pruneClusterHealthTag     = "prune-cluster-health-records"
+	pruneDiagnosticBundlesTag = "prune-diagnostic-bundles"
+	pruneRisectlExecutionsTag = "prune-risectl-executions"
+	migrateBlobPayloadsTag    = "migrate-blob-payloads"
+	indexDiagnosticsTag       = "index-diagnostics"
+	evaluateAlertRulesTag     = "evaluate-alert-rules"
+	pruneAlertHistoryTag      = "prune-alert-history"
+	pruneClusterEventsTag     = "prune-cluster-events"
 )
 
 type InitService struct {
-	m         model.ModelInterface
-	anchorSvc anchor_svc.ServiceInterface
+	m          model.ModelInterface
+	anchorSvc  anchor_svc.ServiceInterface
+	taskRunner taskgen.TaskRunner
 }
 
 type ClusterConnections struct {
@@ -36,18 +55,20 @@ type ClusterConnections struct {
 }
 
 type Cluster struct {
-	Name         string              `yaml:"name" validate:"required"`
-	Version      string              `yaml:"version" validate:"required"`
-	Connections  *ClusterConnections `yaml:"connections" validate:"required"`
-	MetricsStore string              `yaml:"metricsStore" validate:"required"`
+	Name           string              `yaml:"name" validate:"required"`
+	Version        string              `yaml:"version" validate:"required"`
+	RisectlVersion *string             `yaml:"risectlVersion"`
+	Connections    *ClusterConnections `yaml:"connections" validate:"required"`
+	MetricsStore   string              `yaml:"metricsStore" validate:"required"`
 }
 
 type Database struct {
-	Name     string  `yaml:"name" validate:"required"`
-	Cluster  string  `yaml:"cluster" validate:"required"`
-	Username string  `yaml:"username" validate:"required"`
-	Password *string `yaml:"password"`
-	Database string  `yaml:"database" validate:"required"`
+	Name            string            `yaml:"name" validate:"required"`
+	Cluster         string            `yaml:"cluster" validate:"required"`
+	Username        string            `yaml:"username" validate:"required"`
+	Password        *string           `yaml:"password"`
+	Database        string            `yaml:"database" validate:"required"`
+	SessionSettings map[string]string `yaml:"sessionSettings"`
 }
 
 type Query struct {
@@ -62,10 +83,11 @@ type InitConfig struct {
 	MetricsStores []apigen.MetricsStore `yaml:"metricsStores"`
 }
 
-func NewInitService(m model.ModelInterface, anchor_svc anchor_svc.ServiceInterface) *InitService {
+func NewInitService(m model.ModelInterface, anchor_svc anchor_svc.ServiceInterface, taskRunner taskgen.TaskRunner) *InitService {
 	return &InitService{
-		m:         m,
-		anchorSvc: anchor_svc,
+		m:          m,
+		anchorSvc:  anchor_svc,
+		taskRunner: taskRunner,
 	}
 }
 
@@ -96,6 +118,70 @@ func (s *InitService) Init(ctx context.Context, cfg *config.Config, anchorApp *a
 		return nil
 	})
 
+	// init the catalog snapshot cronjob, the unique tag makes it created only once
+	if _, err := s.taskRunner.RunCatalogSnapshot(ctx, &taskgen.CatalogSnapshotParameters{}, taskcore.WithUniqueTag(catalogSnapshotTaskTag)); err != nil {
+		return errors.Wrapf(err, "failed to create catalog snapshot task")
+	}
+
+	// init the snapshot reconcile cronjob
+	if _, err := s.taskRunner.RunReconcileSnapshots(ctx, &taskgen.ReconcileSnapshotsParameters{}, taskcore.WithUniqueTag(reconcileSnapshotsTaskTag)); err != nil {
+		return errors.Wrapf(err, "failed to create snapshot reconcile task")
+	}
+
+	// init the cluster version detection cronjob
+	if _, err := s.taskRunner.RunDetectClusterVersions(ctx, &taskgen.DetectClusterVersionsParameters{}, taskcore.WithUniqueTag(detectClusterVersionsTag)); err != nil {
+		return errors.Wrapf(err, "failed to create cluster version detection task")
+	}
+
+	// init the cluster health check cronjob
+	if _, err := s.taskRunner.RunCheckClusterHealth(ctx, &taskgen.CheckClusterHealthParameters{}, taskcore.WithUniqueTag(checkClusterHealthTag)); err != nil {
+		return errors.Wrapf(err, "failed to create cluster health check task")
+	}
+
+	// init the cluster health record retention cronjob
+	if _, err := s.taskRunner.RunPruneClusterHealthRecords(ctx, &taskgen.PruneClusterHealthRecordsParameters{}, taskcore.WithUniqueTag(pruneClusterHealthTag)); err != nil {
+		return errors.Wrapf(err, "failed to create cluster health record retention task")
+	}
+
+	// init the risectl execution retention cronjob
+	if _, err := s.taskRunner.RunPruneRisectlExecutions(ctx, &taskgen.PruneRisectlExecutionsParameters{}, taskcore.WithUniqueTag(pruneRisectlExecutionsTag)); err != nil {
+		return errors.Wrapf(err, "failed to create risectl execution retention task")
+	}
+
+	// init the diagnostic bundle retention cronjob
+	if _, err := s.taskRunner.RunPruneDiagnosticBundles(ctx, &taskgen.PruneDiagnosticBundlesParameters{}, taskcore.WithUniqueTag(pruneDiagnosticBundlesTag)); err != nil {
+		return errors.Wrapf(err, "failed to create diagnostic bundle retention task")
+	}
+
+	// move the payloads stored in postgres to the blob store, the unique tag makes it run once.
+	// The default directory may not outlive a container, so the payloads are only moved to a
+	// blob store that is configured.
+	if cfg.BlobStore.Type != "" || cfg.BlobStore.Dir != "" {
+		if _, err := s.taskRunner.RunMigrateBlobPayloads(ctx, &taskgen.MigrateBlobPayloadsParameters{}, taskcore.WithUniqueTag(migrateBlobPayloadsTag)); err != nil {
+			return errors.Wrapf(err, "failed to create blob payload migration task")
+		}
+	}
+
+	// init the diagnose report indexing cronjob, it indexes the reports without a search vector
+	if _, err := s.taskRunner.RunIndexDiagnostics(ctx, &taskgen.IndexDiagnosticsParameters{}, taskcore.WithUniqueTag(indexDiagnosticsTag)); err != nil {
+		return errors.Wrapf(err, "failed to create diagnose report indexing task")
+	}
+
+	// init the alert rule evaluation cronjob
+	if _, err := s.taskRunner.RunEvaluateAlertRules(ctx, &taskgen.EvaluateAlertRulesParameters{}, taskcore.WithUniqueTag(evaluateAlertRulesTag)); err != nil {
+		return errors.Wrapf(err, "failed to create alert rule evaluation task")
+	}
+
+	// init the alert history retention cronjob
+	if _, err := s.taskRunner.RunPruneAlertHistory(ctx, &taskgen.PruneAlertHistoryParameters{}, taskcore.WithUniqueTag(pruneAlertHistoryTag)); err != nil {
+		return errors.Wrapf(err, "failed to create alert history retention task")
+	}
+
+	// init the cluster event retention cronjob
+	if _, err := s.taskRunner.RunPruneClusterEvents(ctx, &taskgen.PruneClusterEventsParameters{}, taskcore.WithUniqueTag(pruneClusterEventsTag)); err != nil {
+		return errors.Wrapf(err, "failed to create cluster event retention task")
+	}
+
 	// remove the root user if it is not set in the config
 	if cfg.Root == nil {
 		if err := s.anchorSvc.DeleteUserByName(ctx, "root"); err != nil {
@@ -188,6 +274,7 @@ func (s *InitService) initDatabase(ctx context.Context, cfg *InitConfig, orgID i
 				HttpPort:       cluster.Connections.HttpPort,
 				Version:        cluster.Version,
 				MetricsStoreID: utils.IfElse(ok, &msid, nil),
+				RisectlVersion: cluster.RisectlVersion,
 			})
 			if err != nil {
 				return errors.Wrapf(err, "failed to create cluster: %s", cluster.Name)
@@ -200,13 +287,21 @@ func (s *InitService) initDatabase(ctx context.Context, cfg *InitConfig, orgID i
 				return errors.Errorf("cluster %s not found", database.Cluster)
 			}
 			clusterID := clusterNameToID[database.Cluster]
+			var sessionSettings *apigen.SessionSettings
+			if len(database.SessionSettings) > 0 {
+				if err := sql.ValidateSessionSettings(database.SessionSettings); err != nil {
+					return errors.Wrapf(err, "invalid session settings of database: %s", database.Name)
+				}
+				sessionSettings = utils.Ptr(apigen.SessionSettings(database.SessionSettings))
+			}
 			if _, err := s.m.InitDatabaseConnection(ctx, querier.InitDatabaseConnectionParams{
-				Name:      database.Name,
-				OrgID:     orgID,
-				ClusterID: clusterID,
-				Username:  database.Username,
-				Password:  database.Password,
-				Database:  database.Database,
+				Name:            database.Name,
+				OrgID:           orgID,
+				ClusterID:       clusterID,
+				Username:        database.Username,
+				Password:        database.Password,
+				Database:        database.Database,
+				SessionSettings: sessionSettings,
 			}); err != nil {
 				return errors.Wrapf(err, "failed to init cluster: %s", database.Cluster)
 			}
@@ -0,0 +1,369 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/risingwavelabs/risingwave-console/pkg/conn/sql"
+	"github.com/risingwavelabs/risingwave-console/pkg/utils"
+	"github.com/risingwavelabs/risingwave-console/pkg/zgen/apigen"
+	"github.com/risingwavelabs/risingwave-console/pkg/zgen/querier"
+)
+
+const getLineageRelationsSQL = `SELECT
+    rw_relations.id                                AS relation_id,
+    rw_schemas.name                                AS schema,
+    rw_relations.name                              AS relation_name,
+    rw_relations.relation_type                     AS relation_type,
+    COALESCE(rw_sources.connector, rw_sinks.connector) AS connector
+FROM rw_relations
+JOIN rw_schemas      ON rw_schemas.id = rw_relations.schema_id
+LEFT JOIN rw_sources ON rw_sources.id = rw_relations.id
+LEFT JOIN rw_sinks   ON rw_sinks.id = rw_relations.id
+WHERE rw_relations.relation_type != 'system table'
+`
+
+const (
+	openLineageProducer       = "https://github.com/risingwavelabs/risingwave-console"
+	openLineageJobEventSchema = "https://openlineage.io/spec/2-0-2/OpenLineage.json#/$defs/JobEvent"
+	openLineageJobTypeSchema  = "https://openlineage.io/spec/facets/2-0-3/JobTypeJobFacet.json#/$defs/JobTypeJobFacet"
+	openLineageDatasourceURL  = "https://openlineage.io/spec/facets/1-0-1/DatasourceDatasetFacet.json#/$defs/DatasourceDatasetFacet"
+)
+
+func (s *Service) GetDatabaseLineage(ctx context.Context, id int32, params apigen.GetDatabaseLineageParams, orgID int32) (*apigen.LineageGraph, error) {
+	db, err := s.getDb(ctx, id, orgID)
+	if err != nil {
+		return nil, err
+	}
+	return s.getLineage(ctx, db, params.RelationID, params.Direction, params.Depth)
+}
+
+func (s *Service) ExportDatabaseLineage(ctx context.Context, id int32, params apigen.ExportDatabaseLineageParams, orgID int32) ([]byte, error) {
+	db, err := s.getDb(ctx, id, orgID)
+	if err != nil {
+		return nil, err
+	}
+	graph, err := s.getLineage(ctx, db, params.RelationID, params.Direction, params.Depth)
+	if err != nil {
+		return nil, err
+	}
+
+	switch params.Format {
+	case apigen.Dot:
+		return lineageToDOT(graph), nil
+	case apigen.Openlineage:
+		cluster, err := s.m.GetOrgCluster(ctx, querier.GetOrgClusterParams{
+			ID:    db.ClusterID,
+			OrgID: orgID,
+		})
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to get cluster")
+		}
+		namespace := fmt.Sprintf("risingwave://%s:%d", cluster.Host, cluster.SqlPort)
+		events := lineageToOpenLineage(graph, namespace, db.Database, s.now())
+		raw, err := json.Marshal(events)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to marshal openlineage events")
+		}
+		return raw, nil
+	default:
+		return nil, errors.Wrapf(ErrInvalidLineageParams, "unsupported format %s", params.Format)
+	}
+}
+
+func (s *Service) getLineage(ctx context.Context, db *querier.DatabaseConnection, relationID *int32, direction *apigen.LineageDirection, depth *int) (*apigen.LineageGraph, error) {
+	connStr, err := s.getConnStr(ctx, db)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get connection string")
+	}
+
+	relations, err := sql.Query(ctx, connStr, getLineageRelationsSQL, nil)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to query relations")
+	}
+	depend, err := sql.Query(ctx, connStr, getRwDependSQL, nil)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to query dependencies")
+	}
+
+	nodes := make([]apigen.LineageNode, 0, len(relations.Rows))
+	for _, row := range relations.Rows {
+		node := apigen.LineageNode{
+			ID:     row["relation_id"].(int32),
+			Schema: row["schema"].(string),
+			Name:   row["relation_name"].(string),
+			Type:   apigen.RelationType(row["relation_type"].(string)),
+		}
+		if connector, ok := row["connector"].(string); ok && connector != "" {
+			node.Connector = utils.Ptr(connector)
+		}
+		nodes = append(nodes, node)
+	}
+
+	edges := make([]apigen.LineageEdge, 0, len(depend.Rows))
+	for _, row := range depend.Rows {
+		// objid depends on refobjid, so the data flows from refobjid to objid
+		edges = append(edges, apigen.LineageEdge{
+			From: row["refobjid"].(int32),
+			To:   row["objid"].(int32),
+		})
+	}
+
+	graph := buildLineageGraph(nodes, edges)
+	if relationID == nil {
+		return graph, nil
+	}
+	return traverseLineage(graph, *relationID, utils.UnwrapOrDefault(direction, apigen.Both), utils.UnwrapOrDefault(depth, 0))
+}
+
+// buildLineageGraph drops the edges referring to unknown relations, e.g. internal tables,
+// and sorts the nodes and edges so that the output is deterministic.
+func buildLineageGraph(nodes []apigen.LineageNode, edges []apigen.LineageEdge) *apigen.LineageGraph {
+	known := make(map[int32]struct{}, len(nodes))
+	for _, node := range nodes {
+		known[node.ID] = struct{}{}
+	}
+
+	seen := make(map[apigen.LineageEdge]struct{}, len(edges))
+	filtered := make([]apigen.LineageEdge, 0, len(edges))
+	for _, edge := range edges {
+		if _, ok := known[edge.From]; !ok {
+			continue
+		}
+		if _, ok := known[edge.To]; !ok {
+			continue
+		}
+		if _, ok := seen[edge]; ok {
+			continue
+		}
+		seen[edge] = struct{}{}
+		filtered = append(filtered, edge)
+	}
+
+	sorted := append([]apigen.LineageNode{}, nodes...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].ID < sorted[j].ID })
+	sort.Slice(filtered, func(i, j int) bool {
+		if filtered[i].From != filtered[j].From {
+			return filtered[i].From < filtered[j].From
+		}
+		return filtered[i].To < filtered[j].To
+	})
+
+	return &apigen.LineageGraph{
+		Nodes: sorted,
+		Edges: filtered,
+	}
+}
+
+// traverseLineage returns the subgraph reachable from the relation within maxDepth hops,
+// maxDepth 0 means unlimited. Each node carries its distance from the relation.
+func traverseLineage(graph *apigen.LineageGraph, relationID int32, direction apigen.LineageDirection, maxDepth int) (*apigen.LineageGraph, error) {
+	nodes := make(map[int32]apigen.LineageNode, len(graph.Nodes))
+	for _, node := range graph.Nodes {
+		nodes[node.ID] = node
+	}
+	if _, ok := nodes[relationID]; !ok {
+		return nil, errors.Wrapf(ErrRelationNotFound, "relation %d not found", relationID)
+	}
+
+	upstream := make(map[int32][]int32)
+	downstream := make(map[int32][]int32)
+	for _, edge := range graph.Edges {
+		upstream[edge.To] = append(upstream[edge.To], edge.From)
+		downstream[edge.From] = append(downstream[edge.From], edge.To)
+	}
+
+	depths := map[int32]int{relationID: 0}
+	walk := func(next map[int32][]int32) {
+		visited := map[int32]int{relationID: 0}
+		queue := []int32{relationID}
+		for len(queue) > 0 {
+			cur := queue[0]
+			queue = queue[1:]
+			if maxDepth > 0 && visited[cur] >= maxDepth {
+				continue
+			}
+			for _, n := range next[cur] {
+				if _, ok := visited[n]; ok {
+					continue
+				}
+				visited[n] = visited[cur] + 1
+				queue = append(queue, n)
+			}
+		}
+		for id, d := range visited {
+			if prev, ok := depths[id]; !ok || d < prev {
+				depths[id] = d
+			}
+		}
+	}
+
+	switch direction {
+	case apigen.Upstream:
+		walk(upstream)
+	case apigen.Downstream:
+		walk(downstream)
+	case apigen.Both:
+		walk(upstream)
+		walk(downstream)
+	default:
+		return nil, errors.Wrapf(ErrInvalidLineageParams, "unsupported direction %s", direction)
+	}
+
+	result := &apigen.LineageGraph{
+		Nodes: []apigen.LineageNode{},
+		Edges: []apigen.LineageEdge{},
+	}
+	for _, node := range graph.Nodes {
+		if d, ok := depths[node.ID]; ok {
+			node.Depth = utils.Ptr(d)
+			result.Nodes = append(result.Nodes, node)
+		}
+	}
+	for _, edge := range graph.Edges {
+		_, fromOk := depths[edge.From]
+		_, toOk := depths[edge.To]
+		if !fromOk || !toOk {
+			continue
+		}
+		result.Edges = append(result.Edges, edge)
+	}
+	return result, nil
+}
+
+func lineageNodeLabel(node apigen.LineageNode) string {
+	return fmt.Sprintf("%s.%s", node.Schema, node.Name)
+}
+
+func lineageNodeShape(t apigen.RelationType) string {
+	switch t {
+	case apigen.Source:
+		return "invhouse"
+	case apigen.Sink:
+		return "house"
+	case apigen.Table:
+		return "cylinder"
+	default:
+		return "box"
+	}
+}
+
+// lineageToDOT renders the graph in Graphviz DOT, data flows from left to right.
+func lineageToDOT(graph *apigen.LineageGraph) []byte {
+	var buf bytes.Buffer
+	buf.WriteString("digraph lineage {\n")
+	buf.WriteString("  rankdir=LR;\n")
+	for _, node := range graph.Nodes {
+		kind := string(node.Type)
+		if node.Connector != nil {
+			kind = fmt.Sprintf("%s (%s)", kind, *node.Connector)
+		}
+		// the line break is a DOT escape, it is added after the names are escaped
+		label := fmt.Sprintf(`"%s\n%s"`, dotEscape(lineageNodeLabel(node)), dotEscape(kind))
+		fmt.Fprintf(&buf, "  %d [label=%s, shape=%s];\n", node.ID, label, lineageNodeShape(node.Type))
+	}
+	for _, edge := range graph.Edges {
+		fmt.Fprintf(&buf, "  %d -> %d;\n", edge.From, edge.To)
+	}
+	buf.WriteString("}\n")
+	return buf.Bytes()
+}
+
+// dotEscape escapes the string to be quoted in DOT, the backslashes first so that the escapes
+// of the quotes are kept.
+func dotEscape(s string) string {
+	return strings.ReplaceAll(strings.ReplaceAll(s, `\`, `\\`), `"`, `\"`)
+}
+
+type openLineageDataset struct {
+	Namespace string         `json:"namespace"`
+	Name      string         `json:"name"`
+	Facets    map[string]any `json:"facets,omitempty"`
+}
+
+type openLineageJob struct {
+	Namespace string         `json:"namespace"`
+	Name      string         `json:"name"`
+	Facets    map[string]any `json:"facets,omitempty"`
+}
+
+type openLineageJobEvent struct {
+	EventTime string               `json:"eventTime"`
+	Producer  string               `json:"producer"`
+	SchemaURL string               `json:"schemaURL"`
+	Job       openLineageJob       `json:"job"`
+	Inputs    []openLineageDataset `json:"inputs"`
+	Outputs   []openLineageDataset `json:"outputs"`
+}
+
+// lineageToOpenLineage converts the graph to OpenLineage static lineage, i.e. one job event
+// for each relation that has upstream relations. The relation is the job and its output dataset,
+// the upstream relations are the input datasets. Sinks have no output dataset in the database.
+func lineageToOpenLineage(graph *apigen.LineageGraph, namespace string, database string, eventTime time.Time) []openLineageJobEvent {
+	nodes := make(map[int32]apigen.LineageNode, len(graph.Nodes))
+	for _, node := range graph.Nodes {
+		nodes[node.ID] = node
+	}
+	inputs := make(map[int32][]int32)
+	for _, edge := range graph.Edges {
+		inputs[edge.To] = append(inputs[edge.To], edge.From)
+	}
+
+	dataset := func(node apigen.LineageNode) openLineageDataset {
+		ds := openLineageDataset{
+			Namespace: namespace,
+			Name:      fmt.Sprintf("%s.%s", database, lineageNodeLabel(node)),
+		}
+		if node.Connector != nil {
+			ds.Facets = map[string]any{
+				"datasource": map[string]any{
+					"_producer":  openLineageProducer,
+					"_schemaURL": openLineageDatasourceURL,
+					"name":       *node.Connector,
+				},
+			}
+		}
+		return ds
+	}
+
+	events := []openLineageJobEvent{}
+	for _, node := range graph.Nodes {
+		if len(inputs[node.ID]) == 0 {
+			continue
+		}
+		event := openLineageJobEvent{
+			EventTime: eventTime.UTC().Format(time.RFC3339),
+			Producer:  openLineageProducer,
+			SchemaURL: openLineageJobEventSchema,
+			Job: openLineageJob{
+				Namespace: namespace,
+				Name:      fmt.Sprintf("%s.%s", database, lineageNodeLabel(node)),
+				Facets: map[string]any{
+					"jobType": map[string]any{
+						"_producer":      openLineageProducer,
+						"_schemaURL":     openLineageJobTypeSchema,
+						"processingType": "STREAMING",
+						"integration":    "RISINGWAVE",
+						"jobType":        strings.ToUpper(strings.ReplaceAll(string(node.Type), " ", "_")),
+					},
+				},
+			},
+			Inputs:  []openLineageDataset{},
+			Outputs: []openLineageDataset{},
+		}
+		for _, id := range inputs[node.ID] {
+			event.Inputs = append(event.Inputs, dataset(nodes[id]))
+		}
+		if node.Type != apigen.Sink {
+			event.Outputs = append(event.Outputs, dataset(node))
+		}
+		events = append(events, event)
+	}
+	return events
+}
@@ -0,0 +1,128 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/risingwavelabs/risingwave-console/pkg/utils"
+	"github.com/risingwavelabs/risingwave-console/pkg/zgen/apigen"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testLineageGraph is kafka_src -> t1 -> mv1 -> mv2 -> sink1, t2 -> mv2, plus an unrelated t3.
+func testLineageGraph() *apigen.LineageGraph {
+	return buildLineageGraph([]apigen.LineageNode{
+		{ID: 5, Schema: "public", Name: "mv2", Type: apigen.MaterializedView},
+		{ID: 1, Schema: "public", Name: "kafka_src", Type: apigen.Source, Connector: utils.Ptr("kafka")},
+		{ID: 2, Schema: "public", Name: "t1", Type: apigen.Table},
+		{ID: 3, Schema: "public", Name: "t2", Type: apigen.Table},
+		{ID: 4, Schema: "public", Name: "mv1", Type: apigen.MaterializedView},
+		{ID: 6, Schema: "public", Name: "sink1", Type: apigen.Sink, Connector: utils.Ptr("iceberg")},
+		{ID: 7, Schema: "public", Name: "t3", Type: apigen.Table},
+	}, []apigen.LineageEdge{
+		{From: 1, To: 2},
+		{From: 2, To: 4},
+		{From: 4, To: 5},
+		{From: 3, To: 5},
+		{From: 5, To: 6},
+		{From: 5, To: 6},
+		{From: 100, To: 5}, // internal table
+	})
+}
+
+func nodeIDs(graph *apigen.LineageGraph) []int32 {
+	ids := []int32{}
+	for _, node := range graph.Nodes {
+		ids = append(ids, node.ID)
+	}
+	return ids
+}
+
+func TestBuildLineageGraph(t *testing.T) {
+	graph := testLineageGraph()
+	assert.Equal(t, []int32{1, 2, 3, 4, 5, 6, 7}, nodeIDs(graph))
+	assert.Equal(t, []apigen.LineageEdge{
+		{From: 1, To: 2},
+		{From: 2, To: 4},
+		{From: 3, To: 5},
+		{From: 4, To: 5},
+		{From: 5, To: 6},
+	}, graph.Edges)
+}
+
+func TestTraverseLineage(t *testing.T) {
+	testCases := []struct {
+		name      string
+		relation  int32
+		direction apigen.LineageDirection
+		depth     int
+		expected  []int32
+		edges     int
+	}{
+		{name: "upstream unlimited", relation: 5, direction: apigen.Upstream, expected: []int32{1, 2, 3, 4, 5}, edges: 4},
+		{name: "upstream one hop", relation: 5, direction: apigen.Upstream, depth: 1, expected: []int32{3, 4, 5}, edges: 2},
+		{name: "downstream", relation: 2, direction: apigen.Downstream, expected: []int32{2, 4, 5, 6}, edges: 3},
+		{name: "both two hops", relation: 4, direction: apigen.Both, depth: 2, expected: []int32{1, 2, 4, 5, 6}, edges: 4},
+		{name: "isolated", relation: 7, direction: apigen.Both, expected: []int32{7}, edges: 0},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			graph, err := traverseLineage(testLineageGraph(), tc.relation, tc.direction, tc.depth)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, nodeIDs(graph))
+			assert.Len(t, graph.Edges, tc.edges)
+			for _, node := range graph.Nodes {
+				if node.ID == tc.relation {
+					assert.Equal(t, 0, *node.Depth)
+				}
+			}
+		})
+	}
+
+	_, err := traverseLineage(testLineageGraph(), 42, apigen.Both, 0)
+	assert.ErrorIs(t, err, ErrRelationNotFound)
+
+	_, err = traverseLineage(testLineageGraph(), 5, apigen.LineageDirection("sideways"), 0)
+	assert.ErrorIs(t, err, ErrInvalidLineageParams)
+}
+
+func TestLineageToDOT(t *testing.T) {
+	graph, err := traverseLineage(testLineageGraph(), 2, apigen.Upstream, 0)
+	require.NoError(t, err)
+
+	expected := "digraph lineage {\n" +
+		"  rankdir=LR;\n" +
+		"  1 [label=\"public.kafka_src\\nsource (kafka)\", shape=invhouse];\n" +
+		"  2 [label=\"public.t1\\ntable\", shape=cylinder];\n" +
+		"  1 -> 2;\n" +
+		"}\n"
+	assert.Equal(t, expected, string(lineageToDOT(graph)))
+
+	// the quotes and the backslashes of the names are escaped
+	graph = buildLineageGraph([]apigen.LineageNode{
+		{ID: 1, Schema: "public", Name: `a"b\`, Type: apigen.Table},
+	}, nil)
+	assert.Equal(t, "digraph lineage {\n"+
+		"  rankdir=LR;\n"+
+		"  1 [label=\"public.a\\\"b\\\\\\ntable\", shape=cylinder];\n"+
+		"}\n", string(lineageToDOT(graph)))
+}
+
+func TestLineageToOpenLineage(t *testing.T) {
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	events := lineageToOpenLineage(testLineageGraph(), "risingwave://rw:4566", "dev", now)
+
+	// t1, mv1, mv2 and sink1 have upstream relations
+	require.Len(t, events, 4)
+	assert.Equal(t, "dev.public.t1", events[0].Job.Name)
+	assert.Equal(t, "2025-01-01T00:00:00Z", events[0].EventTime)
+	require.Len(t, events[0].Inputs, 1)
+	assert.Equal(t, "dev.public.kafka_src", events[0].Inputs[0].Name)
+	assert.NotNil(t, events[0].Inputs[0].Facets["datasource"])
+
+	sink := events[3]
+	assert.Equal(t, "dev.public.sink1", sink.Job.Name)
+	assert.Empty(t, sink.Outputs)
+}
@@ -0,0 +1,229 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/pkg/errors"
+	"github.com/risingwavelabs/risingwave-console/pkg/utils"
+	"github.com/risingwavelabs/risingwave-console/pkg/zcore/model"
+	"github.com/risingwavelabs/risingwave-console/pkg/zgen/apigen"
+	"github.com/risingwavelabs/risingwave-console/pkg/zgen/querier"
+	"github.com/risingwavelabs/risingwave-console/pkg/zgen/taskgen"
+)
+
+const (
+	// risectlExecutionPollInterval is how often a followed execution is checked for new output.
+	risectlExecutionPollInterval = time.Second
+
+	// risectlExecutionTimeout is the timeout of the RisectlExecution task plus a grace period, an
+	// execution running for longer was interrupted and is finished by the retry of its task.
+	risectlExecutionTimeout = time.Hour + time.Minute
+
+	// risectlExecutionFollowTimeout bounds following an execution, including the wait for it to start.
+	risectlExecutionFollowTimeout = 2 * time.Hour
+
+	defaultRisectlExecutionLimit = 50
+	maxRisectlExecutionLimit     = 200
+)
+
+func risectlExecutionToApi(execution *querier.RisectlExecution) *apigen.RisectlExecution {
+	return &apigen.RisectlExecution{
+		ID:              execution.ID,
+		ClusterID:       execution.ClusterID,
+		UserID:          execution.UserID,
+		Args:            execution.Args,
+		Status:          apigen.RisectlExecutionStatus(execution.Status),
+		ExitCode:        execution.ExitCode,
+		Error:           execution.Error,
+		RisectlVersion:  execution.RisectlVersion,
+		CancelRequested: execution.CancelRequested,
+		CreatedAt:       execution.CreatedAt,
+		StartedAt:       execution.StartedAt,
+		FinishedAt:      execution.FinishedAt,
+	}
+}
+
+func isRisectlExecutionFinished(status string) bool {
+	switch apigen.RisectlExecutionStatus(status) {
+	case apigen.RisectlExecutionStatusSucceeded, apigen.RisectlExecutionStatusFailed, apigen.RisectlExecutionStatusCancelled:
+		return true
+	default:
+		return false
+	}
+}
+
+func (s *Service) getOrgRisectlExecution(ctx context.Context, id int32, executionID int32, orgID int32) (*querier.RisectlExecution, error) {
+	execution, err := s.m.GetOrgRisectlExecution(ctx, querier.GetOrgRisectlExecutionParams{
+		ID:        executionID,
+		ClusterID: id,
+		OrgID:     orgID,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrRisectlExecutionNotFound
+		}
+		return nil, errors.Wrapf(err, "failed to get risectl execution")
+	}
+	return execution, nil
+}
+
+func (s *Service) CreateRisectlExecution(ctx context.Context, id int32, params apigen.RisectlCommand, userID int32, orgID int32) (*apigen.RisectlExecution, error) {
+	cluster, err := s.getOrgCluster(ctx, id, orgID)
+	if err != nil {
+		return nil, err
+	}
+
+	allowed, err := s.authorizeRisectl(ctx, "execution", cluster.ID, params.Args, userID, orgID)
+	if err != nil {
+		return nil, err
+	}
+	if !allowed {
+		return nil, errors.Wrapf(ErrRisectlCommandNotAllowed, "risectl %s", strings.Join(params.Args, " "))
+	}
+
+	var execution *querier.RisectlExecution
+	if err := s.m.RunTransactionWithTx(ctx, func(tx pgx.Tx, txm model.ModelInterface) error {
+		execution, err = txm.CreateRisectlExecution(ctx, querier.CreateRisectlExecutionParams{
+			ClusterID: cluster.ID,
+			OrgID:     orgID,
+			UserID:    userID,
+			Args:      params.Args,
+			Status:    string(apigen.RisectlExecutionStatusPending),
+		})
+		if err != nil {
+			return errors.Wrapf(err, "failed to create risectl execution")
+		}
+		if _, err := s.taskRunner.RunRisectlExecutionWithTx(ctx, tx, &taskgen.RisectlExecutionParameters{
+			ExecutionID: execution.ID,
+		}); err != nil {
+			return errors.Wrapf(err, "failed to create risectl execution task")
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	return risectlExecutionToApi(execution), nil
+}
+
+func (s *Service) ListRisectlExecutions(ctx context.Context, id int32, params apigen.ListRisectlExecutionsParams, orgID int32) ([]apigen.RisectlExecution, error) {
+	executions, err := s.m.ListOrgRisectlExecutions(ctx, querier.ListOrgRisectlExecutionsParams{
+		ClusterID: id,
+		OrgID:     orgID,
+		Limit:     utils.ClampLimit(params.Limit, defaultRisectlExecutionLimit, maxRisectlExecutionLimit),
+		Before:    params.Before,
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to list risectl executions")
+	}
+
+	result := make([]apigen.RisectlExecution, len(executions))
+	for i, execution := range executions {
+		result[i] = *risectlExecutionToApi(execution)
+	}
+	return result, nil
+}
+
+func (s *Service) GetRisectlExecution(ctx context.Context, id int32, executionID int32, orgID int32) (*apigen.RisectlExecution, error) {
+	execution, err := s.getOrgRisectlExecution(ctx, id, executionID, orgID)
+	if err != nil {
+		return nil, err
+	}
+	return risectlExecutionToApi(execution), nil
+}
+
+func (s *Service) listRisectlExecutionOutputs(ctx context.Context, executionID int32, after int32) ([]apigen.RisectlExecutionOutput, error) {
+	outputs, err := s.m.ListRisectlExecutionOutputs(ctx, querier.ListRisectlExecutionOutputsParams{
+		ExecutionID: executionID,
+		Seq:         after,
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to list risectl execution outputs")
+	}
+
+	result := make([]apigen.RisectlExecutionOutput, len(outputs))
+	for i, output := range outputs {
+		result[i] = apigen.RisectlExecutionOutput{
+			Seq:       output.Seq,
+			Stream:    apigen.RisectlExecutionOutputStream(output.Stream),
+			Content:   output.Content,
+			CreatedAt: output.CreatedAt,
+		}
+	}
+	return result, nil
+}
+
+func (s *Service) ListRisectlExecutionOutputs(ctx context.Context, id int32, executionID int32, after int32, orgID int32) ([]apigen.RisectlExecutionOutput, error) {
+	if _, err := s.getOrgRisectlExecution(ctx, id, executionID, orgID); err != nil {
+		return nil, err
+	}
+	return s.listRisectlExecutionOutputs(ctx, executionID, after)
+}
+
+func (s *Service) FollowRisectlExecution(ctx context.Context, id int32, executionID int32, after int32, orgID int32, onOutputs func([]apigen.RisectlExecutionOutput) error) (*apigen.RisectlExecution, error) {
+	ctx, cancel := context.WithTimeout(ctx, risectlExecutionFollowTimeout)
+	defer cancel()
+
+	ticker := time.NewTicker(risectlExecutionPollInterval)
+	defer ticker.Stop()
+
+	for {
+		// check the status before the output, the output is complete once it is finished
+		execution, err := s.getOrgRisectlExecution(ctx, id, executionID, orgID)
+		if err != nil {
+			return nil, err
+		}
+		outputs, err := s.listRisectlExecutionOutputs(ctx, executionID, after)
+		if err != nil {
+			return nil, err
+		}
+		if err := onOutputs(outputs); err != nil {
+			return nil, err
+		}
+		if len(outputs) > 0 {
+			after = outputs[len(outputs)-1].Seq
+		}
+		if isRisectlExecutionFinished(execution.Status) {
+			return risectlExecutionToApi(execution), nil
+		}
+		if execution.StartedAt != nil && s.now().Sub(*execution.StartedAt) > risectlExecutionTimeout {
+			return nil, errors.Wrapf(ErrRisectlExecutionInterrupted, "running since %s", execution.StartedAt.Format(time.RFC3339))
+		}
+
+		select {
+		case <-ctx.Done():
+			if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+				return nil, errors.Wrapf(ErrRisectlExecutionFollowTimeout, "followed for %s", risectlExecutionFollowTimeout)
+			}
+			return nil, ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+func (s *Service) CancelRisectlExecution(ctx context.Context, id int32, executionID int32, userID int32, orgID int32) (*apigen.RisectlExecution, error) {
+	execution, err := s.getOrgRisectlExecution(ctx, id, executionID, orgID)
+	if err != nil {
+		return nil, err
+	}
+	if isRisectlExecutionFinished(execution.Status) {
+		return nil, ErrRisectlExecutionFinished
+	}
+	if execution.UserID != userID {
+		role, err := s.getRisectlRole(ctx, userID, orgID)
+		if err != nil {
+			return nil, err
+		}
+		if role != risectlRoleOwner {
+			return nil, ErrOrgOwnerRequired
+		}
+	}
+
+	if err := s.m.RequestRisectlExecutionCancel(ctx, execution.ID); err != nil {
+		return nil, errors.Wrapf(err, "failed to cancel risectl execution")
+	}
+	execution.CancelRequested = true
+	return risectlExecutionToApi(execution), nil
+}
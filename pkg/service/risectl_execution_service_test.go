@@ -0,0 +1,184 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/risingwavelabs/risingwave-console/pkg/utils"
+	"github.com/risingwavelabs/risingwave-console/pkg/zcore/model"
+	"github.com/risingwavelabs/risingwave-console/pkg/zgen/apigen"
+	"github.com/risingwavelabs/risingwave-console/pkg/zgen/querier"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestCancelRisectlExecution(t *testing.T) {
+	var (
+		orgID       = int32(201)
+		clusterID   = int32(101)
+		executionID = int32(301)
+		creatorID   = int32(401)
+		otherUserID = int32(402)
+	)
+
+	testCases := []struct {
+		name    string
+		status  apigen.RisectlExecutionStatus
+		userID  int32
+		isOwner bool
+		err     error
+	}{
+		{name: "creator", status: apigen.RisectlExecutionStatusRunning, userID: creatorID},
+		{name: "org owner", status: apigen.RisectlExecutionStatusPending, userID: otherUserID, isOwner: true},
+		{name: "other member", status: apigen.RisectlExecutionStatusRunning, userID: otherUserID, err: ErrOrgOwnerRequired},
+		{name: "finished", status: apigen.RisectlExecutionStatusSucceeded, userID: creatorID, err: ErrRisectlExecutionFinished},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockModel := model.NewMockModelInterface(ctrl)
+			service := &Service{m: mockModel}
+
+			mockModel.EXPECT().GetOrgRisectlExecution(gomock.Any(), querier.GetOrgRisectlExecutionParams{
+				ID:        executionID,
+				ClusterID: clusterID,
+				OrgID:     orgID,
+			}).Return(&querier.RisectlExecution{
+				ID:        executionID,
+				ClusterID: clusterID,
+				OrgID:     orgID,
+				UserID:    creatorID,
+				Status:    string(tc.status),
+			}, nil)
+			if tc.userID != creatorID && tc.status != apigen.RisectlExecutionStatusSucceeded {
+				mockModel.EXPECT().IsOrgOwner(gomock.Any(), querier.IsOrgOwnerParams{OrgID: orgID, UserID: tc.userID}).Return(tc.isOwner, nil)
+			}
+			if tc.err == nil {
+				mockModel.EXPECT().RequestRisectlExecutionCancel(gomock.Any(), executionID).Return(nil)
+			}
+
+			execution, err := service.CancelRisectlExecution(context.Background(), clusterID, executionID, tc.userID, orgID)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+			assert.True(t, execution.CancelRequested)
+		})
+	}
+}
+
+func TestFollowRisectlExecution(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	var (
+		orgID       = int32(201)
+		clusterID   = int32(101)
+		executionID = int32(301)
+	)
+
+	mockModel := model.NewMockModelInterface(ctrl)
+	service := &Service{m: mockModel}
+
+	getParams := querier.GetOrgRisectlExecutionParams{ID: executionID, ClusterID: clusterID, OrgID: orgID}
+	gomock.InOrder(
+		mockModel.EXPECT().GetOrgRisectlExecution(gomock.Any(), getParams).Return(&querier.RisectlExecution{
+			ID:     executionID,
+			Status: string(apigen.RisectlExecutionStatusRunning),
+		}, nil),
+		mockModel.EXPECT().ListRisectlExecutionOutputs(gomock.Any(), querier.ListRisectlExecutionOutputsParams{
+			ExecutionID: executionID,
+			Seq:         2,
+		}).Return([]*querier.RisectlExecutionOutput{
+			{ExecutionID: executionID, Seq: 3, Stream: "stdout", Content: "a"},
+		}, nil),
+		mockModel.EXPECT().GetOrgRisectlExecution(gomock.Any(), getParams).Return(&querier.RisectlExecution{
+			ID:     executionID,
+			Status: string(apigen.RisectlExecutionStatusSucceeded),
+		}, nil),
+		mockModel.EXPECT().ListRisectlExecutionOutputs(gomock.Any(), querier.ListRisectlExecutionOutputsParams{
+			ExecutionID: executionID,
+			Seq:         3,
+		}).Return([]*querier.RisectlExecutionOutput{
+			{ExecutionID: executionID, Seq: 4, Stream: "stderr", Content: "b"},
+		}, nil),
+	)
+
+	var content string
+	execution, err := service.FollowRisectlExecution(context.Background(), clusterID, executionID, 2, orgID, func(outputs []apigen.RisectlExecutionOutput) error {
+		for _, output := range outputs {
+			content += output.Content
+		}
+		return nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, apigen.RisectlExecutionStatusSucceeded, execution.Status)
+	assert.Equal(t, "ab", content)
+}
+
+func TestFollowInterruptedRisectlExecution(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	var (
+		orgID     = int32(201)
+		clusterID = int32(101)
+		now       = time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+		startedAt = now.Add(-2 * time.Hour)
+	)
+
+	mockModel := model.NewMockModelInterface(ctrl)
+	service := &Service{m: mockModel, now: func() time.Time { return now }}
+
+	mockModel.EXPECT().GetOrgRisectlExecution(gomock.Any(), querier.GetOrgRisectlExecutionParams{ID: 301, ClusterID: clusterID, OrgID: orgID}).Return(&querier.RisectlExecution{
+		ID:        301,
+		Status:    string(apigen.RisectlExecutionStatusRunning),
+		StartedAt: &startedAt,
+	}, nil)
+	mockModel.EXPECT().ListRisectlExecutionOutputs(gomock.Any(), querier.ListRisectlExecutionOutputsParams{ExecutionID: 301}).Return(nil, nil)
+
+	_, err := service.FollowRisectlExecution(context.Background(), clusterID, 301, 0, orgID, func([]apigen.RisectlExecutionOutput) error {
+		return nil
+	})
+	assert.ErrorIs(t, err, ErrRisectlExecutionInterrupted)
+}
+
+func TestListRisectlExecutions(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	var (
+		ctx       = context.Background()
+		orgID     = int32(201)
+		clusterID = int32(101)
+	)
+	mockModel := model.NewMockModelInterfaceWithTransaction(ctrl)
+	service := &Service{m: mockModel}
+
+	mockModel.EXPECT().ListOrgRisectlExecutions(ctx, querier.ListOrgRisectlExecutionsParams{
+		ClusterID: clusterID,
+		OrgID:     orgID,
+		Limit:     defaultRisectlExecutionLimit,
+	}).Return([]*querier.RisectlExecution{{ID: 9, ClusterID: clusterID}, {ID: 8, ClusterID: clusterID}}, nil)
+	mockModel.EXPECT().ListOrgRisectlExecutions(ctx, querier.ListOrgRisectlExecutionsParams{
+		ClusterID: clusterID,
+		OrgID:     orgID,
+		Limit:     maxRisectlExecutionLimit,
+		Before:    utils.Ptr(int32(8)),
+	}).Return([]*querier.RisectlExecution{}, nil)
+
+	executions, err := service.ListRisectlExecutions(ctx, clusterID, apigen.ListRisectlExecutionsParams{}, orgID)
+	require.NoError(t, err)
+	require.Len(t, executions, 2)
+	assert.Equal(t, int32(9), executions[0].ID)
+
+	// the limit is clamped
+	executions, err = service.ListRisectlExecutions(ctx, clusterID, apigen.ListRisectlExecutionsParams{Before: &executions[1].ID, Limit: utils.Ptr(int32(5000))}, orgID)
+	require.NoError(t, err)
+	assert.Empty(t, executions)
+}
@@ -2,23 +2,158 @@ package service
 
 import (
 	"context"
+	"io"
+	"slices"
+	"strings"
 
+	"github.com/jackc/pgx/v5"
 	"github.com/pkg/errors"
 	"github.com/risingwavelabs/risingwave-console/pkg/conn/meta"
+	"github.com/risingwavelabs/risingwave-console/pkg/utils"
 	"github.com/risingwavelabs/risingwave-console/pkg/zgen/apigen"
+	"github.com/risingwavelabs/risingwave-console/pkg/zgen/querier"
+	"go.uber.org/zap"
 )
 
+const (
+	risectlRoleOwner  = "owner"
+	risectlRoleMember = "member"
+)
+
+// risectlAllowlist is a list of risectl sub commands, e.g. [["meta", "cluster-info"], ["hummock"]].
+// A command is allowed if its leading sub commands match any entry, ["*"] matches every command.
+type risectlAllowlist [][]string
+
+// parseRisectlAllowlist parses a comma separated allowlist, e.g. "meta cluster-info,hummock".
+func parseRisectlAllowlist(raw string) risectlAllowlist {
+	allowlist := risectlAllowlist{}
+	for _, entry := range strings.Split(raw, ",") {
+		if words := strings.Fields(entry); len(words) > 0 {
+			allowlist = append(allowlist, words)
+		}
+	}
+	return allowlist
+}
+
+// defaultMemberAllowlist allows the commands of the read-only operations in the catalog.
+func defaultMemberAllowlist() risectlAllowlist {
+	allowlist := risectlAllowlist{}
+	for _, op := range meta.Operations() {
+		if op.ReadOnly && !slices.ContainsFunc(allowlist, func(entry []string) bool { return slices.Equal(entry, op.Command) }) {
+			allowlist = append(allowlist, op.Command)
+		}
+	}
+	return allowlist
+}
+
+func newRisectlAllowlists(ownerRaw string, memberRaw string) map[string]risectlAllowlist {
+	return map[string]risectlAllowlist{
+		risectlRoleOwner:  utils.IfElse(ownerRaw == "", risectlAllowlist{{"*"}}, parseRisectlAllowlist(ownerRaw)),
+		risectlRoleMember: utils.IfElse(memberRaw == "", defaultMemberAllowlist(), parseRisectlAllowlist(memberRaw)),
+	}
+}
+
+func (a risectlAllowlist) allows(args []string) bool {
+	// only the sub commands are matched, they come before the first flag
+	var words []string
+	for _, arg := range args {
+		if strings.HasPrefix(arg, "-") {
+			break
+		}
+		words = append(words, arg)
+	}
+	if len(words) == 0 {
+		return false
+	}
+	for _, entry := range a {
+		if len(entry) == 1 && entry[0] == "*" {
+			return true
+		}
+		if len(entry) <= len(words) && slices.Equal(entry, words[:len(entry)]) {
+			return true
+		}
+	}
+	return false
+}
+
+// getRisectlRole returns the role of the user in the organization, the owner of the
+// organization is the owner and everyone else is a member.
+func (s *Service) getRisectlRole(ctx context.Context, userID int32, orgID int32) (string, error) {
+	isOwner, err := s.m.IsOrgOwner(ctx, querier.IsOrgOwnerParams{
+		OrgID:  orgID,
+		UserID: userID,
+	})
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to check org owner")
+	}
+	return utils.IfElse(isOwner, risectlRoleOwner, risectlRoleMember), nil
+}
+
+func (s *Service) isRisectlAllowed(ctx context.Context, args []string, userID int32, orgID int32) (bool, error) {
+	role, err := s.getRisectlRole(ctx, userID, orgID)
+	if err != nil {
+		return false, err
+	}
+	return s.risectlAllowlists[role].allows(args), nil
+}
+
+// authorizeRisectl checks the allowlist of the user's role and writes an audit log of the
+// decision, source is the API the command comes from, e.g. "command" or "shell".
+func (s *Service) authorizeRisectl(ctx context.Context, source string, clusterID int32, args []string, userID int32, orgID int32) (bool, error) {
+	allowed, err := s.isRisectlAllowed(ctx, args, userID, orgID)
+	if err != nil {
+		return false, err
+	}
+	log.Info(
+		"risectl command audit",
+		zap.String("source", source),
+		zap.Int32("org_id", orgID),
+		zap.Int32("user_id", userID),
+		zap.Int32("cluster_id", clusterID),
+		zap.Strings("args", args),
+		zap.Bool("allowed", allowed),
+	)
+	return allowed, nil
+}
+
 func (s *Service) getRisectlConn(ctx context.Context, id int32) (meta.RisectlConn, error) {
 	cluster, err := s.m.GetClusterByID(ctx, id)
 	if err != nil {
 		return nil, errors.Wrapf(err, "failed to get cluster")
 	}
 
-	return s.risectlm.NewConn(ctx, cluster.Version, cluster.Host, cluster.MetaPort)
+	return s.risectlm.NewConn(ctx, cluster.Version, cluster.Host, cluster.MetaPort, meta.WithVersionOverride(cluster.RisectlVersion))
 }
 
-func (s *Service) RunRisectlCommand(ctx context.Context, id int32, params apigen.RisectlCommand, orgID int32) (*apigen.RisectlCommandResult, error) {
-	conn, err := s.getRisectlConn(ctx, id)
+func (s *Service) getOrgCluster(ctx context.Context, id int32, orgID int32) (*querier.Cluster, error) {
+	cluster, err := s.m.GetOrgCluster(ctx, querier.GetOrgClusterParams{
+		ID:    id,
+		OrgID: orgID,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrClusterNotFound
+		}
+		return nil, errors.Wrapf(err, "failed to get cluster")
+	}
+	return cluster, nil
+}
+
+func (s *Service) RunRisectlCommand(ctx context.Context, id int32, params apigen.RisectlCommand, userID int32, orgID int32) (*apigen.RisectlCommandResult, error) {
+	cluster, err := s.getOrgCluster(ctx, id, orgID)
+	if err != nil {
+		return nil, err
+	}
+
+	allowed, err := s.authorizeRisectl(ctx, "command", cluster.ID, params.Args, userID, orgID)
+	if err != nil {
+		return nil, err
+	}
+	if !allowed {
+		return nil, errors.Wrapf(ErrRisectlCommandNotAllowed, "risectl %s", strings.Join(params.Args, " "))
+	}
+
+	conn, err := s.risectlm.NewConn(ctx, cluster.Version, cluster.Host, cluster.MetaPort, meta.WithVersionOverride(cluster.RisectlVersion))
 	if err != nil {
 		return nil, errors.Wrapf(err, "failed to get risectl connection")
 	}
@@ -30,9 +165,138 @@ func (s *Service) RunRisectlCommand(ctx context.Context, id int32, params apigen
 	}
 
 	return &apigen.RisectlCommandResult{
-		Stdout:   stdout,
-		Stderr:   stderr,
-		ExitCode: int32(exitCode),
-		Err:      errMsg,
+		Stdout:         stdout,
+		Stderr:         stderr,
+		ExitCode:       int32(exitCode),
+		Err:            errMsg,
+		RisectlVersion: utils.Ptr(conn.Resolution().Resolved),
 	}, nil
 }
+
+func (s *Service) UploadRisectl(ctx context.Context, version string, archive io.Reader, checksum string, userID int32, orgID int32) error {
+	if !s.noInternet || !s.allowRisectlUpload {
+		return ErrRisectlUploadDisabled
+	}
+	// the binary is shared by all organizations and executed by the server
+	role, err := s.getRisectlRole(ctx, userID, orgID)
+	if err != nil {
+		return err
+	}
+	if role != risectlRoleOwner {
+		return ErrOrgOwnerRequired
+	}
+
+	if err := s.risectlm.ImportRisectl(ctx, version, archive, checksum); err != nil {
+		if errors.Is(err, meta.ErrInvalidArchive) || errors.Is(err, meta.ErrInvalidVersion) || errors.Is(err, meta.ErrChecksumMismatch) {
+			return errors.Wrapf(ErrInvalidRisectlArchive, "%v", err)
+		}
+		if errors.Is(err, meta.ErrAlreadyInstalled) {
+			return errors.Wrapf(ErrRisectlAlreadyInstalled, "%s", version)
+		}
+		return errors.Wrapf(err, "failed to import risectl %s", version)
+	}
+	return nil
+}
+
+func (s *Service) ListRisectlOperations(ctx context.Context, id int32, userID int32, orgID int32) ([]apigen.RisectlOperation, error) {
+	if _, err := s.getOrgCluster(ctx, id, orgID); err != nil {
+		return nil, err
+	}
+	role, err := s.getRisectlRole(ctx, userID, orgID)
+	if err != nil {
+		return nil, err
+	}
+
+	result := []apigen.RisectlOperation{}
+	for _, op := range meta.Operations() {
+		result = append(result, apigen.RisectlOperation{
+			Name:        apigen.RisectlOperationName(op.Name),
+			Description: op.Description,
+			Command:     strings.Join(op.Command, " "),
+			Allowed:     s.risectlAllowlists[role].allows(op.Command),
+		})
+	}
+	return result, nil
+}
+
+func (s *Service) RunRisectlOperation(ctx context.Context, id int32, operation apigen.RisectlOperationName, userID int32, orgID int32) (*apigen.RisectlOperationResult, error) {
+	op, ok := meta.GetOperation(meta.OperationName(operation))
+	if !ok {
+		return nil, errors.Wrapf(ErrRisectlOperationNotSupported, "unknown operation %s", operation)
+	}
+	cluster, err := s.getOrgCluster(ctx, id, orgID)
+	if err != nil {
+		return nil, err
+	}
+
+	allowed, err := s.authorizeRisectl(ctx, "operation", cluster.ID, op.Command, userID, orgID)
+	if err != nil {
+		return nil, err
+	}
+	if !allowed {
+		return nil, errors.Wrapf(ErrRisectlCommandNotAllowed, "risectl %s", strings.Join(op.Command, " "))
+	}
+
+	conn, err := s.risectlm.NewConn(ctx, cluster.Version, cluster.Host, cluster.MetaPort, meta.WithVersionOverride(cluster.RisectlVersion))
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get risectl connection")
+	}
+
+	result := &apigen.RisectlOperationResult{
+		Operation:      operation,
+		RisectlVersion: utils.Ptr(conn.Resolution().Resolved),
+	}
+	switch op.Name {
+	case meta.OperationClusterInfo:
+		info, err := conn.ClusterInfo(ctx)
+		if err != nil {
+			return nil, err
+		}
+		result.ClusterInfo = clusterInfoToApi(info)
+	case meta.OperationListFragments:
+		fragments, err := conn.ListFragments(ctx)
+		if err != nil {
+			return nil, err
+		}
+		result.Fragments = utils.Ptr(fragmentsToApi(fragments))
+	case meta.OperationListActors:
+		actors, err := conn.ListActors(ctx)
+		if err != nil {
+			return nil, err
+		}
+		apiActors := make([]apigen.RisectlActor, len(actors))
+		for i, actor := range actors {
+			apiActors[i] = apigen.RisectlActor{
+				Id:         actor.ID,
+				FragmentId: actor.FragmentID,
+				Worker:     actor.Worker,
+			}
+		}
+		result.Actors = &apiActors
+	case meta.OperationHummockVersion:
+		version, err := conn.HummockVersion(ctx)
+		if err != nil {
+			return nil, err
+		}
+		result.HummockVersion = &apigen.RisectlHummockVersion{
+			Id:                version.ID,
+			MaxCommittedEpoch: version.MaxCommittedEpoch,
+			SafeEpoch:         version.SafeEpoch,
+			Fields:            version.Fields,
+		}
+	case meta.OperationCompactionStatus:
+		status, err := conn.CompactionStatus(ctx)
+		if err != nil {
+			return nil, err
+		}
+		groups := make([]apigen.RisectlCompactionGroupStatus, len(status.Groups))
+		for i, group := range status.Groups {
+			groups[i] = apigen.RisectlCompactionGroupStatus{
+				Id:     group.ID,
+				Fields: group.Fields,
+			}
+		}
+		result.CompactionGroups = &groups
+	}
+	return result, nil
+}
@@ -0,0 +1,96 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/risingwavelabs/risingwave-console/pkg/conn/meta"
+	"github.com/risingwavelabs/risingwave-console/pkg/conn/meta/mock"
+	"github.com/risingwavelabs/risingwave-console/pkg/zcore/model"
+	"github.com/risingwavelabs/risingwave-console/pkg/zgen/querier"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestRisectlAllowlist(t *testing.T) {
+	allowlists := newRisectlAllowlists("", "")
+	owner, member := allowlists[risectlRoleOwner], allowlists[risectlRoleMember]
+
+	testCases := []struct {
+		name   string
+		args   []string
+		owner  bool
+		member bool
+	}{
+		{name: "read only operation", args: []string{"meta", "cluster-info"}, owner: true, member: true},
+		{name: "read only operation with flags", args: []string{"hummock", "list-version", "--verbose"}, owner: true, member: true},
+		{name: "mutating command", args: []string{"meta", "backup-meta"}, owner: true, member: false},
+		{name: "flag before sub command", args: []string{"--help", "meta", "cluster-info"}, owner: false, member: false},
+		{name: "empty", args: []string{}, owner: false, member: false},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.owner, owner.allows(tc.args))
+			assert.Equal(t, tc.member, member.allows(tc.args))
+		})
+	}
+
+	custom := newRisectlAllowlists("meta, hummock list-version", "")[risectlRoleOwner]
+	assert.True(t, custom.allows([]string{"meta", "backup-meta"}))
+	assert.True(t, custom.allows([]string{"hummock", "list-version"}))
+	assert.False(t, custom.allows([]string{"hummock", "trigger-manual-compaction"}))
+}
+
+func TestUploadRisectl(t *testing.T) {
+	var (
+		orgID    = int32(201)
+		userID   = int32(1)
+		checksum = strings.Repeat("ab", 32)
+	)
+
+	testCases := []struct {
+		name        string
+		noInternet  bool
+		allowUpload bool
+		owner       bool
+		importErr   error
+		err         error
+	}{
+		{name: "internet access enabled", noInternet: false, allowUpload: true, err: ErrRisectlUploadDisabled},
+		{name: "uploads not allowed", noInternet: true, allowUpload: false, err: ErrRisectlUploadDisabled},
+		{name: "member", noInternet: true, allowUpload: true, owner: false, err: ErrOrgOwnerRequired},
+		{name: "owner", noInternet: true, allowUpload: true, owner: true},
+		{name: "installed version", noInternet: true, allowUpload: true, owner: true, importErr: meta.ErrAlreadyInstalled, err: ErrRisectlAlreadyInstalled},
+		{name: "checksum mismatch", noInternet: true, allowUpload: true, owner: true, importErr: meta.ErrChecksumMismatch, err: ErrInvalidRisectlArchive},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockModel := model.NewMockModelInterfaceWithTransaction(ctrl)
+			mockRisectlm := mock.NewMockRisectlManagerInterface(ctrl)
+			s := &Service{
+				m:                  mockModel,
+				risectlm:           mockRisectlm,
+				noInternet:         tc.noInternet,
+				allowRisectlUpload: tc.allowUpload,
+			}
+
+			if tc.noInternet && tc.allowUpload {
+				mockModel.EXPECT().IsOrgOwner(gomock.Any(), querier.IsOrgOwnerParams{OrgID: orgID, UserID: userID}).Return(tc.owner, nil)
+			}
+			if tc.owner {
+				mockRisectlm.EXPECT().ImportRisectl(gomock.Any(), "v2.2.1", gomock.Any(), checksum).Return(tc.importErr)
+			}
+
+			err := s.UploadRisectl(context.Background(), "v2.2.1", strings.NewReader("archive"), checksum, userID, orgID)
+			if tc.err != nil {
+				assert.ErrorIs(t, err, tc.err)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
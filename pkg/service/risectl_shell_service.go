@@ -0,0 +1,258 @@
+package service
+
+import (
+	"context"
+	"io"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/pkg/errors"
+	"github.com/risingwavelabs/risingwave-console/pkg/conn/meta"
+	"github.com/risingwavelabs/risingwave-console/pkg/utils"
+	"github.com/risingwavelabs/risingwave-console/pkg/zgen/apigen"
+	"github.com/risingwavelabs/risingwave-console/pkg/zgen/querier"
+)
+
+// RisectlShell is an interactive risectl session on a cluster. The commands run one at a time
+// with the allowlist of the user's role, every command is recorded in the session history.
+type RisectlShell struct {
+	s       *Service
+	conn    meta.RisectlConn
+	session *querier.RisectlShellSession
+	seq     int32
+}
+
+func risectlShellSessionToApi(session *querier.RisectlShellSession) *apigen.RisectlShellSession {
+	return &apigen.RisectlShellSession{
+		ID:             session.ID,
+		ClusterID:      session.ClusterID,
+		UserID:         session.UserID,
+		RisectlVersion: session.RisectlVersion,
+		CreatedAt:      session.CreatedAt,
+		ClosedAt:       session.ClosedAt,
+	}
+}
+
+func risectlShellCommandToApi(command *querier.RisectlShellCommand) *apigen.RisectlShellCommand {
+	return &apigen.RisectlShellCommand{
+		Seq:        command.Seq,
+		Command:    command.Command,
+		Args:       command.Args,
+		Allowed:    command.Allowed,
+		ExitCode:   command.ExitCode,
+		Error:      command.Error,
+		CreatedAt:  command.CreatedAt,
+		FinishedAt: command.FinishedAt,
+	}
+}
+
+// splitRisectlCommandLine splits a command line into arguments like a shell without expansions,
+// e.g. `meta "a b" 'c'` is [meta, a b, c]. A backslash escapes the next character outside of
+// single quotes and the leading "risectl" is optional. An empty line has no arguments.
+func splitRisectlCommandLine(line string) ([]string, error) {
+	var (
+		args    = []string{}
+		current strings.Builder
+		inArg   bool
+		quote   rune
+		escaped bool
+	)
+	for _, r := range line {
+		switch {
+		case escaped:
+			current.WriteRune(r)
+			escaped = false
+		case quote == '\'':
+			if r == '\'' {
+				quote = 0
+			} else {
+				current.WriteRune(r)
+			}
+		case quote == '"':
+			switch r {
+			case '"':
+				quote = 0
+			case '\\':
+				escaped = true
+			default:
+				current.WriteRune(r)
+			}
+		case r == '\\':
+			escaped, inArg = true, true
+		case r == '\'' || r == '"':
+			quote, inArg = r, true
+		case unicode.IsSpace(r):
+			if inArg {
+				args = append(args, current.String())
+				current.Reset()
+				inArg = false
+			}
+		default:
+			current.WriteRune(r)
+			inArg = true
+		}
+	}
+	if quote != 0 {
+		return nil, errors.Wrapf(ErrInvalidRisectlCommand, "unterminated %c quote", quote)
+	}
+	if escaped {
+		return nil, errors.Wrap(ErrInvalidRisectlCommand, "trailing backslash")
+	}
+	if inArg {
+		args = append(args, current.String())
+	}
+
+	if len(args) > 0 && args[0] == "risectl" {
+		if len(args) == 1 {
+			return nil, errors.Wrap(ErrInvalidRisectlCommand, "missing risectl sub command")
+		}
+		args = args[1:]
+	}
+	return args, nil
+}
+
+func (s *Service) OpenRisectlShell(ctx context.Context, id int32, userID int32, orgID int32) (*RisectlShell, error) {
+	cluster, err := s.getOrgCluster(ctx, id, orgID)
+	if err != nil {
+		return nil, err
+	}
+
+	conn, err := s.risectlm.NewConn(ctx, cluster.Version, cluster.Host, cluster.MetaPort, meta.WithVersionOverride(cluster.RisectlVersion))
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get risectl connection")
+	}
+
+	session, err := s.m.CreateRisectlShellSession(ctx, querier.CreateRisectlShellSessionParams{
+		ClusterID:      cluster.ID,
+		OrgID:          orgID,
+		UserID:         userID,
+		RisectlVersion: utils.Ptr(conn.Resolution().Resolved),
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to create risectl shell session")
+	}
+	return &RisectlShell{s: s, conn: conn, session: session}, nil
+}
+
+// Session returns the persisted session.
+func (sh *RisectlShell) Session() *apigen.RisectlShellSession {
+	return risectlShellSessionToApi(sh.session)
+}
+
+// Run runs a command line, onStart is called before the allowed commands start and their
+// output is written to stdout and stderr as it is produced. The returned command has the exit
+// code, or the error if the command is denied by the allowlist. ErrInvalidRisectlCommand is
+// returned if the line can not be parsed, an empty line returns nil.
+func (sh *RisectlShell) Run(ctx context.Context, line string, onStart func(apigen.RisectlShellCommand) error, stdout io.Writer, stderr io.Writer) (*apigen.RisectlShellCommand, error) {
+	args, err := splitRisectlCommandLine(line)
+	if err != nil {
+		return nil, err
+	}
+	if len(args) == 0 {
+		return nil, nil
+	}
+
+	allowed, err := sh.s.authorizeRisectl(ctx, "shell", sh.session.ClusterID, args, sh.session.UserID, sh.session.OrgID)
+	if err != nil {
+		return nil, err
+	}
+
+	sh.seq++
+	command, err := sh.s.m.CreateRisectlShellCommand(ctx, querier.CreateRisectlShellCommandParams{
+		SessionID: sh.session.ID,
+		Seq:       sh.seq,
+		Command:   strings.TrimSpace(line),
+		Args:      args,
+		Allowed:   allowed,
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to create risectl shell command")
+	}
+
+	var startErr error
+	if !allowed {
+		command.Error = utils.Ptr(errors.Wrapf(ErrRisectlCommandNotAllowed, "risectl %s", strings.Join(args, " ")).Error())
+	} else if startErr = onStart(*risectlShellCommandToApi(command)); startErr != nil {
+		command.Error = utils.Ptr(startErr.Error())
+	} else {
+		exitCode, runErr := sh.conn.Stream(ctx, stdout, stderr, args...)
+		command.ExitCode = utils.Ptr(int32(exitCode))
+		if runErr != nil {
+			command.Error = utils.Ptr(runErr.Error())
+		}
+	}
+
+	// the command is recorded even if the session is closed while it is running
+	if err := sh.s.m.FinishRisectlShellCommand(context.WithoutCancel(ctx), querier.FinishRisectlShellCommandParams{
+		SessionID: sh.session.ID,
+		Seq:       command.Seq,
+		ExitCode:  command.ExitCode,
+		Error:     command.Error,
+	}); err != nil {
+		return nil, errors.Wrapf(err, "failed to finish risectl shell command")
+	}
+	if startErr != nil {
+		return nil, startErr
+	}
+	command.FinishedAt = utils.Ptr(time.Now())
+	return risectlShellCommandToApi(command), nil
+}
+
+// History lists the commands of the session.
+func (sh *RisectlShell) History(ctx context.Context) ([]apigen.RisectlShellCommand, error) {
+	return sh.s.listRisectlShellCommands(ctx, sh.session.ID)
+}
+
+// Close marks the session as closed.
+func (sh *RisectlShell) Close(ctx context.Context) error {
+	if err := sh.s.m.CloseRisectlShellSession(ctx, sh.session.ID); err != nil {
+		return errors.Wrapf(err, "failed to close risectl shell session")
+	}
+	return nil
+}
+
+func (s *Service) listRisectlShellCommands(ctx context.Context, sessionID int32) ([]apigen.RisectlShellCommand, error) {
+	commands, err := s.m.ListRisectlShellCommands(ctx, sessionID)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to list risectl shell commands")
+	}
+
+	result := make([]apigen.RisectlShellCommand, len(commands))
+	for i, command := range commands {
+		result[i] = *risectlShellCommandToApi(command)
+	}
+	return result, nil
+}
+
+func (s *Service) ListRisectlShellSessions(ctx context.Context, id int32, orgID int32) ([]apigen.RisectlShellSession, error) {
+	sessions, err := s.m.ListOrgRisectlShellSessions(ctx, querier.ListOrgRisectlShellSessionsParams{
+		ClusterID: id,
+		OrgID:     orgID,
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to list risectl shell sessions")
+	}
+
+	result := make([]apigen.RisectlShellSession, len(sessions))
+	for i, session := range sessions {
+		result[i] = *risectlShellSessionToApi(session)
+	}
+	return result, nil
+}
+
+func (s *Service) ListRisectlShellCommands(ctx context.Context, id int32, sessionID int32, orgID int32) ([]apigen.RisectlShellCommand, error) {
+	session, err := s.m.GetOrgRisectlShellSession(ctx, querier.GetOrgRisectlShellSessionParams{
+		ID:        sessionID,
+		ClusterID: id,
+		OrgID:     orgID,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrRisectlShellSessionNotFound
+		}
+		return nil, errors.Wrapf(err, "failed to get risectl shell session")
+	}
+	return s.listRisectlShellCommands(ctx, session.ID)
+}
@@ -0,0 +1,126 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"testing"
+
+	"github.com/risingwavelabs/risingwave-console/pkg/conn/meta/mock"
+	"github.com/risingwavelabs/risingwave-console/pkg/zcore/model"
+	"github.com/risingwavelabs/risingwave-console/pkg/zgen/apigen"
+	"github.com/risingwavelabs/risingwave-console/pkg/zgen/querier"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestSplitRisectlCommandLine(t *testing.T) {
+	testCases := []struct {
+		name string
+		line string
+		args []string
+		err  bool
+	}{
+		{name: "plain", line: "meta cluster-info", args: []string{"meta", "cluster-info"}},
+		{name: "leading risectl", line: "  risectl hummock list-version --verbose ", args: []string{"hummock", "list-version", "--verbose"}},
+		{name: "quotes", line: `meta "a b" 'c "d"' e"f"g`, args: []string{"meta", "a b", `c "d"`, "efg"}},
+		{name: "escapes", line: `meta a\ b "c\"d" 'e\f'`, args: []string{"meta", "a b", `c"d`, `e\f`}},
+		{name: "empty quotes", line: `meta ""`, args: []string{"meta", ""}},
+		{name: "empty", line: "   ", args: []string{}},
+		{name: "unterminated quote", line: `meta "a`, err: true},
+		{name: "trailing backslash", line: `meta a\`, err: true},
+		{name: "risectl only", line: "risectl", err: true},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			args, err := splitRisectlCommandLine(tc.line)
+			if tc.err {
+				require.ErrorIs(t, err, ErrInvalidRisectlCommand)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.args, args)
+		})
+	}
+}
+
+func TestRisectlShellRun(t *testing.T) {
+	var (
+		orgID     = int32(201)
+		clusterID = int32(101)
+		sessionID = int32(301)
+		userID    = int32(401)
+	)
+
+	testCases := []struct {
+		name    string
+		line    string
+		args    []string
+		isOwner bool
+		allowed bool
+	}{
+		{name: "allowed", line: "meta cluster-info", args: []string{"meta", "cluster-info"}, allowed: true},
+		{name: "denied", line: "risectl meta backup-meta", args: []string{"meta", "backup-meta"}},
+		{name: "owner", line: "meta backup-meta", args: []string{"meta", "backup-meta"}, isOwner: true, allowed: true},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockModel := model.NewMockModelInterface(ctrl)
+			mockConn := mock.NewMockRisectlConn(ctrl)
+			service := &Service{m: mockModel, risectlAllowlists: newRisectlAllowlists("", "")}
+			shell := &RisectlShell{
+				s:       service,
+				conn:    mockConn,
+				session: &querier.RisectlShellSession{ID: sessionID, ClusterID: clusterID, OrgID: orgID, UserID: userID},
+			}
+
+			mockModel.EXPECT().IsOrgOwner(gomock.Any(), querier.IsOrgOwnerParams{OrgID: orgID, UserID: userID}).Return(tc.isOwner, nil)
+			mockModel.EXPECT().CreateRisectlShellCommand(gomock.Any(), querier.CreateRisectlShellCommandParams{
+				SessionID: sessionID,
+				Seq:       1,
+				Command:   tc.line,
+				Args:      tc.args,
+				Allowed:   tc.allowed,
+			}).Return(&querier.RisectlShellCommand{
+				SessionID: sessionID,
+				Seq:       1,
+				Command:   tc.line,
+				Args:      tc.args,
+				Allowed:   tc.allowed,
+			}, nil)
+			if tc.allowed {
+				mockConn.EXPECT().Stream(gomock.Any(), gomock.Any(), gomock.Any(), tc.args[0], tc.args[1]).DoAndReturn(func(_ context.Context, stdout io.Writer, _ io.Writer, _ ...string) (int, error) {
+					_, _ = stdout.Write([]byte("ok\n"))
+					return 0, nil
+				})
+			}
+			mockModel.EXPECT().FinishRisectlShellCommand(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, arg querier.FinishRisectlShellCommandParams) error {
+				assert.Equal(t, sessionID, arg.SessionID)
+				assert.Equal(t, int32(1), arg.Seq)
+				assert.Equal(t, tc.allowed, arg.Error == nil)
+				return nil
+			})
+
+			started := false
+			stdout := bytes.NewBuffer(nil)
+			command, err := shell.Run(context.Background(), tc.line, func(command apigen.RisectlShellCommand) error {
+				started = true
+				return nil
+			}, stdout, io.Discard)
+			require.NoError(t, err)
+			assert.Equal(t, tc.allowed, started)
+			assert.Equal(t, tc.allowed, command.Allowed)
+			if tc.allowed {
+				assert.Equal(t, int32(0), *command.ExitCode)
+				assert.Equal(t, "ok\n", stdout.String())
+			} else {
+				assert.Nil(t, command.ExitCode)
+				assert.Contains(t, *command.Error, ErrRisectlCommandNotAllowed.Error())
+			}
+		})
+	}
+}
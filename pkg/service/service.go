@@ -2,17 +2,21 @@ package service
 
 import (
 	"context"
+	"io"
 	"time"
 
 	"github.com/cloudcarver/anchor/pkg/auth"
 	anchor_svc "github.com/cloudcarver/anchor/pkg/service"
 	"github.com/cloudcarver/anchor/pkg/taskcore"
 	"github.com/pkg/errors"
+	"github.com/risingwavelabs/risingwave-console/pkg/blobstore"
 	"github.com/risingwavelabs/risingwave-console/pkg/config"
 	"github.com/risingwavelabs/risingwave-console/pkg/conn/http"
 	"github.com/risingwavelabs/risingwave-console/pkg/conn/meta"
 	"github.com/risingwavelabs/risingwave-console/pkg/conn/metricsstore"
+	"github.com/risingwavelabs/risingwave-console/pkg/conn/probe"
 	"github.com/risingwavelabs/risingwave-console/pkg/conn/sql"
+	"github.com/risingwavelabs/risingwave-console/pkg/logger"
 	"github.com/risingwavelabs/risingwave-console/pkg/utils"
 	"github.com/risingwavelabs/risingwave-console/pkg/zcore/model"
 	"github.com/risingwavelabs/risingwave-console/pkg/zgen/apigen"
@@ -35,8 +39,39 @@ var (
 	ErrClusterNotFound               = errors.New("cluster not found")
 	ErrClusterHasDatabaseConnections = errors.New("cluster has database connections")
 	ErrDiagnosticNotFound            = errors.New("diagnostic not found")
+	ErrInvalidOrgSettings            = errors.New("invalid organization settings")
+	ErrRelationNotFound              = errors.New("relation not found")
+	ErrInvalidLineageParams          = errors.New("invalid lineage parameters")
+	ErrDropConfirmationRequired      = errors.New("drop confirmation required")
+	ErrRisectlCommandNotAllowed      = errors.New("risectl command is not allowed")
+	ErrRisectlOperationNotSupported  = errors.New("risectl operation is not supported")
+	ErrRisectlUploadDisabled         = errors.New("risectl upload is only available when internet access is disabled and uploads are allowed")
+	ErrRisectlAlreadyInstalled       = errors.New("risectl version is already installed")
+	ErrInvalidRisectlArchive         = errors.New("invalid risectl archive")
+	ErrOrgOwnerRequired              = errors.New("only the owner of the organization can do this")
+	ErrRisectlExecutionNotFound      = errors.New("risectl execution not found")
+	ErrRisectlExecutionFinished      = errors.New("risectl execution has already finished")
+	ErrRisectlExecutionInterrupted   = errors.New("risectl execution is interrupted")
+	ErrRisectlExecutionFollowTimeout = errors.New("risectl execution did not finish in time")
+	ErrRisectlShellSessionNotFound   = errors.New("risectl shell session not found")
+	ErrInvalidRisectlCommand         = errors.New("invalid risectl command")
+	ErrInvalidRetentionPolicy        = errors.New("invalid retention policy")
+	ErrInvalidClusterUpgrade         = errors.New("invalid cluster upgrade")
+	ErrClusterUpgradeInProgress      = errors.New("another upgrade of the cluster is in progress")
+	ErrClusterUpgradeNotFound        = errors.New("cluster upgrade not found")
+	ErrClusterUpgradeFinished        = errors.New("cluster upgrade has already finished")
+	ErrDiagnosticBundleNotFound      = errors.New("diagnostic bundle not found")
+	ErrDiagnosticBundleNotCompleted  = errors.New("diagnostic bundle is not completed")
+	ErrDiagnosticBundleInProgress    = errors.New("diagnostic bundle is still being collected")
+	ErrDiagnosticRuleNotFound        = errors.New("diagnostic rule not found")
+	ErrInvalidClusterVersion         = errors.New("invalid cluster version")
+	ErrInvalidDiagnosticRule         = errors.New("invalid diagnostic rule")
+	ErrAlertRuleNotFound             = errors.New("alert rule not found")
+	ErrInvalidAlertRule              = errors.New("invalid alert rule")
 )
 
+var log = logger.NewLogAgent("service")
+
 const (
 	ExpireDuration             = 2 * time.Minute
 	DefaultMaxRetries          = 3
@@ -62,8 +97,11 @@ type ServiceInterface interface {
 	// Database management
 	ImportDatabase(ctx context.Context, params apigen.DatabaseConnectInfo, orgID int32) (*apigen.Database, error)
 
-	// GetDatabase gets a database by its ID and organization ID
-	GetDatabase(ctx context.Context, id int32, orgID int32) (*apigen.Database, error)
+	// GetDatabase gets a database by its ID and organization ID, the catalog is cached
+	GetDatabase(ctx context.Context, id int32, params apigen.GetDatabaseParams, orgID int32) (*apigen.Database, error)
+
+	// ListDatabaseRelations lists the relations of a database without columns
+	ListDatabaseRelations(ctx context.Context, id int32, params apigen.ListDatabaseRelationsParams, orgID int32) ([]apigen.RelationSummary, error)
 
 	// ListDatabases lists all databases in an organization
 	ListDatabases(ctx context.Context, orgID int32) ([]apigen.Database, error)
@@ -74,11 +112,23 @@ type ServiceInterface interface {
 	// DeleteDatabase deletes a database
 	DeleteDatabase(ctx context.Context, id int32, orgID int32) error
 
+	// ListDatabaseCatalogChanges lists the relations created, dropped and altered in a database, newest first
+	ListDatabaseCatalogChanges(ctx context.Context, id int32, params apigen.ListDatabaseCatalogChangesParams, orgID int32) ([]apigen.CatalogChangeEvent, error)
+
+	// GetDatabaseLineage gets the lineage graph of a database, optionally traversed from a relation
+	GetDatabaseLineage(ctx context.Context, id int32, params apigen.GetDatabaseLineageParams, orgID int32) (*apigen.LineageGraph, error)
+
+	// ExportDatabaseLineage exports the lineage graph of a database to Graphviz DOT or OpenLineage JSON
+	ExportDatabaseLineage(ctx context.Context, id int32, params apigen.ExportDatabaseLineageParams, orgID int32) ([]byte, error)
+
+	// AnalyzeDatabaseImpact lists the objects affected by the DROP and ALTER statements
+	AnalyzeDatabaseImpact(ctx context.Context, id int32, params apigen.ImpactAnalysisRequest, orgID int32) (*apigen.ImpactAnalysis, error)
+
 	// TestDatabaseConnection tests a database connection
 	TestDatabaseConnection(ctx context.Context, params apigen.TestDatabaseConnectionPayload, orgID int32) (*apigen.TestDatabaseConnectionResult, error)
 
 	// QueryDatabase executes a query on a database
-	QueryDatabase(ctx context.Context, id int32, params apigen.QueryRequest, orgID int32, backgroundDDL bool) (*apigen.QueryResponse, error)
+	QueryDatabase(ctx context.Context, id int32, params apigen.QueryRequest, orgID int32) (*apigen.QueryResponse, error)
 
 	// GetDDLProgress gets the progress of DDL operations
 	GetDDLProgress(ctx context.Context, id int32, orgID int32) ([]apigen.DDLProgress, error)
@@ -102,18 +152,132 @@ type ServiceInterface interface {
 	// TestClusterConnection tests the connection to a cluster
 	TestClusterConnection(ctx context.Context, params apigen.TestClusterConnectionPayload, orgID int32) (*apigen.TestClusterConnectionResult, error)
 
-	// RunRisectlCommand executes a risectl command on a cluster
-	RunRisectlCommand(ctx context.Context, id int32, params apigen.RisectlCommand, orgID int32) (*apigen.RisectlCommandResult, error)
+	// RunRisectlCommand executes a risectl command on a cluster if the allowlist of the user's role allows it
+	RunRisectlCommand(ctx context.Context, id int32, params apigen.RisectlCommand, userID int32, orgID int32) (*apigen.RisectlCommandResult, error)
+
+	// UploadRisectl installs the risectl binary of a version from an uploaded archive in NoInternet mode if uploads are allowed
+	UploadRisectl(ctx context.Context, version string, archive io.Reader, checksum string, userID int32, orgID int32) error
+
+	// ListRisectlOperations lists the risectl operation catalog with the support and permission of each operation
+	ListRisectlOperations(ctx context.Context, id int32, userID int32, orgID int32) ([]apigen.RisectlOperation, error)
+
+	// RunRisectlOperation runs a risectl operation from the catalog on a cluster and parses the output
+	RunRisectlOperation(ctx context.Context, id int32, operation apigen.RisectlOperationName, userID int32, orgID int32) (*apigen.RisectlOperationResult, error)
+
+	// CreateRisectlExecution schedules a risectl command to run in the background if the allowlist of the user's role allows it
+	CreateRisectlExecution(ctx context.Context, id int32, params apigen.RisectlCommand, userID int32, orgID int32) (*apigen.RisectlExecution, error)
+
+	// ListRisectlExecutions lists a page of the risectl executions of a cluster, the latest first
+	ListRisectlExecutions(ctx context.Context, id int32, params apigen.ListRisectlExecutionsParams, orgID int32) ([]apigen.RisectlExecution, error)
+
+	// GetRisectlExecution gets a risectl execution of a cluster
+	GetRisectlExecution(ctx context.Context, id int32, executionID int32, orgID int32) (*apigen.RisectlExecution, error)
+
+	// ListRisectlExecutionOutputs lists the output chunks of a risectl execution after the sequence number
+	ListRisectlExecutionOutputs(ctx context.Context, id int32, executionID int32, after int32, orgID int32) ([]apigen.RisectlExecutionOutput, error)
+
+	// FollowRisectlExecution passes the new output chunks to onOutputs until the execution finishes and returns its final state
+	FollowRisectlExecution(ctx context.Context, id int32, executionID int32, after int32, orgID int32, onOutputs func([]apigen.RisectlExecutionOutput) error) (*apigen.RisectlExecution, error)
+
+	// CancelRisectlExecution kills a running risectl execution, only its creator or the owner of the organization can cancel it
+	CancelRisectlExecution(ctx context.Context, id int32, executionID int32, userID int32, orgID int32) (*apigen.RisectlExecution, error)
+
+	// OpenRisectlShell opens an interactive risectl session on a cluster, the caller must close it
+	OpenRisectlShell(ctx context.Context, id int32, userID int32, orgID int32) (*RisectlShell, error)
+
+	// ListRisectlShellSessions lists the interactive risectl sessions of a cluster, the latest first
+	ListRisectlShellSessions(ctx context.Context, id int32, orgID int32) ([]apigen.RisectlShellSession, error)
+
+	// ListRisectlShellCommands lists the command history of an interactive risectl session
+	ListRisectlShellCommands(ctx context.Context, id int32, sessionID int32, orgID int32) ([]apigen.RisectlShellCommand, error)
+
+	// ListClusterEvents lists the events recorded for a cluster, the latest first
+	ListClusterEvents(ctx context.Context, id int32, params apigen.ListClusterEventsParams, orgID int32) ([]apigen.ClusterEvent, error)
+
+	// ListClusterHealthHistory lists the health timeline of a cluster, the latest first
+	ListClusterHealthHistory(ctx context.Context, id int32, params apigen.ListClusterHealthHistoryParams, orgID int32) ([]apigen.ClusterHealthRecord, error)
+
+	// ListClusterVersionHistory lists the changes of the version of a cluster, the latest first
+	ListClusterVersionHistory(ctx context.Context, id int32, orgID int32) ([]apigen.ClusterVersionChange, error)
+
+	// CreateClusterUpgrade starts the upgrade workflow of a cluster
+	CreateClusterUpgrade(ctx context.Context, id int32, params apigen.ClusterUpgradeCreate, userID int32, orgID int32) (*apigen.ClusterUpgrade, error)
+
+	// ListClusterUpgrades lists the upgrades of a cluster, the latest first
+	ListClusterUpgrades(ctx context.Context, id int32, orgID int32) ([]apigen.ClusterUpgrade, error)
+
+	// GetClusterUpgrade gets an upgrade of a cluster
+	GetClusterUpgrade(ctx context.Context, id int32, upgradeID int32, orgID int32) (*apigen.ClusterUpgrade, error)
+
+	// CancelClusterUpgrade stops waiting for a cluster to be upgraded
+	CancelClusterUpgrade(ctx context.Context, id int32, upgradeID int32, userID int32, orgID int32) (*apigen.ClusterUpgrade, error)
 
 	// GetClusterDiagnostic gets diagnostic information dump for a cluster by ID
 	GetClusterDiagnostic(ctx context.Context, id int32, diagnosticID int32, orgID int32) (*apigen.DiagnosticData, error)
 
+	// GetClusterDiagnosticReport gets diagnostic information dump for a cluster parsed into typed sections
+	GetClusterDiagnosticReport(ctx context.Context, id int32, diagnosticID int32, orgID int32) (*apigen.DiagnosticReport, error)
+
+	// DiffClusterDiagnostics compares two diagnostic information dumps of a cluster
+	DiffClusterDiagnostics(ctx context.Context, id int32, fromID int32, toID int32, orgID int32) (*apigen.DiagnosticDiff, error)
+
+	// CreateClusterDiagnosticBundle starts collecting a diagnostic bundle of a cluster
+	CreateClusterDiagnosticBundle(ctx context.Context, id int32, userID int32, orgID int32) (*apigen.DiagnosticBundle, error)
+
+	// ListClusterDiagnosticBundles lists the diagnostic bundles of a cluster, the latest first
+	ListClusterDiagnosticBundles(ctx context.Context, id int32, orgID int32) ([]apigen.DiagnosticBundle, error)
+
+	// GetClusterDiagnosticBundle gets the status and the manifest of a diagnostic bundle
+	GetClusterDiagnosticBundle(ctx context.Context, id int32, bundleID int32, orgID int32) (*apigen.DiagnosticBundle, error)
+
+	// DownloadClusterDiagnosticBundle returns the file name and the archive of a completed diagnostic bundle
+	DownloadClusterDiagnosticBundle(ctx context.Context, id int32, bundleID int32, orgID int32) (string, []byte, error)
+
+	// DeleteClusterDiagnosticBundle deletes a finished diagnostic bundle and its archive
+	DeleteClusterDiagnosticBundle(ctx context.Context, id int32, bundleID int32, orgID int32) error
+
 	// ListClusterDiagnostics lists all diagnostic information dumps for a cluster
 	ListClusterDiagnostics(ctx context.Context, id int32, orgID int32) ([]apigen.DiagnosticData, error)
 
 	// CreateClusterDiagnostic creates a new diagnostic information dump for a cluster
 	CreateClusterDiagnostic(ctx context.Context, id int32, orgID int32) (*apigen.DiagnosticData, error)
 
+	// SearchClusterDiagnostics searches the diagnose reports of a cluster with full-text search
+	SearchClusterDiagnostics(ctx context.Context, id int32, params apigen.SearchClusterDiagnosticsParams, orgID int32) ([]apigen.DiagnosticSearchResult, error)
+
+	// SearchDiagnostics searches the diagnose reports of all the clusters of an organization with full-text search
+	SearchDiagnostics(ctx context.Context, params apigen.SearchDiagnosticsParams, orgID int32) ([]apigen.DiagnosticSearchResult, error)
+
+	// ListClusterDiagnosticFindings lists the findings of the diagnostic rules fired by a diagnostic
+	ListClusterDiagnosticFindings(ctx context.Context, id int32, diagnosticID int32, orgID int32) ([]apigen.ClusterDiagnosticFinding, error)
+
+	// ListDiagnosticRules lists the built-in diagnostic rules with the thresholds of an organization
+	ListDiagnosticRules(ctx context.Context, orgID int32) ([]apigen.DiagnosticRule, error)
+
+	// UpdateDiagnosticRule enables or disables a diagnostic rule for an organization, or overrides its threshold
+	UpdateDiagnosticRule(ctx context.Context, rule apigen.DiagnosticRuleName, params apigen.DiagnosticRuleUpdate, orgID int32) (*apigen.DiagnosticRule, error)
+
+	// ListAlertRules lists the metric alert rules of an organization
+	ListAlertRules(ctx context.Context, params apigen.ListAlertRulesParams, orgID int32) ([]apigen.AlertRule, error)
+
+	// CreateAlertRule creates a metric alert rule for a cluster or all the clusters of an organization
+	CreateAlertRule(ctx context.Context, params apigen.AlertRuleCreate, orgID int32) (*apigen.AlertRule, error)
+
+	// GetAlertRule gets a metric alert rule
+	GetAlertRule(ctx context.Context, id int32, orgID int32) (*apigen.AlertRule, error)
+
+	// UpdateAlertRule replaces the definition of a metric alert rule
+	UpdateAlertRule(ctx context.Context, id int32, params apigen.AlertRuleCreate, orgID int32) (*apigen.AlertRule, error)
+
+	// DeleteAlertRule deletes a metric alert rule, its history is kept
+	DeleteAlertRule(ctx context.Context, id int32, orgID int32) error
+
+	// ListAlerts lists the pending and firing alerts of an organization
+	ListAlerts(ctx context.Context, params apigen.ListAlertsParams, orgID int32) ([]apigen.Alert, error)
+
+	// ListAlertHistory lists the alerts fired and resolved in an organization
+	ListAlertHistory(ctx context.Context, params apigen.ListAlertHistoryParams, orgID int32) ([]apigen.AlertHistoryEntry, error)
+
 	// UpdateClusterAutoBackupConfig updates the auto-backup configuration for a cluster
 	UpdateClusterAutoBackupConfig(ctx context.Context, id int32, params apigen.AutoBackupConfig, orgID int32) error
 
@@ -123,6 +287,9 @@ type ServiceInterface interface {
 	// GetClusterAutoBackupConfig gets the auto-backup configuration for a cluster
 	GetClusterAutoBackupConfig(ctx context.Context, id int32, orgID int32) (*apigen.AutoBackupConfig, error)
 
+	// PreviewClusterSnapshotRetention evaluates a retention policy against the automatic snapshots of a cluster without deleting them
+	PreviewClusterSnapshotRetention(ctx context.Context, id int32, policy apigen.SnapshotRetentionPolicy, orgID int32) ([]apigen.SnapshotRetentionDecision, error)
+
 	// GetClusterAutoDiagnosticConfig gets the auto-diagnostic configuration for a cluster
 	GetClusterAutoDiagnosticConfig(ctx context.Context, id int32, orgID int32) (*apigen.AutoDiagnosticConfig, error)
 
@@ -146,6 +313,12 @@ type ServiceInterface interface {
 
 	// ListClustersByMetricsStoreID lists all clusters by metrics store ID
 	ListClustersByMetricsStoreID(ctx context.Context, id int32) ([]*apigen.Cluster, error)
+
+	// GetOrgSettings gets the settings of an organization
+	GetOrgSettings(ctx context.Context, orgID int32) (*apigen.OrgSettings, error)
+
+	// UpdateOrgSettings updates the settings of an organization
+	UpdateOrgSettings(ctx context.Context, params apigen.OrgSettings, orgID int32) (*apigen.OrgSettings, error)
 }
 
 type Service struct {
@@ -154,10 +327,18 @@ type Service struct {
 	sqlm               sql.SQLConnectionManegerInterface
 	risectlm           meta.RisectlManagerInterface
 	metahttp           http.MetaHttpManagerInterface
+	prober             *probe.Prober
 	metricsConnManager *metricsstore.MetricsManager
 	taskRunner         taskgen.TaskRunner
 	taskstore          taskcore.TaskStoreInterface
 	anchorSvc          anchor_svc.ServiceInterface
+	blobs              blobstore.BlobStore
+
+	maxCascadeDropObjects int
+	catalogCache          *catalogCache
+	risectlAllowlists     map[string]risectlAllowlist
+	noInternet            bool
+	allowRisectlUpload    bool
 
 	now                 func() time.Time
 	generateHashAndSalt func(password string) (string, string, error)
@@ -174,19 +355,41 @@ func NewService(
 	taskRunner taskgen.TaskRunner,
 	taskstore taskcore.TaskStoreInterface,
 	anchorSvc anchor_svc.ServiceInterface,
+	blobs blobstore.BlobStore,
 ) (ServiceInterface, error) {
+	maxCascadeDropObjects := DefaultMaxCascadeDropObjects
+	if cfg.SQLConsole.MaxCascadeDropObjects > 0 {
+		maxCascadeDropObjects = cfg.SQLConsole.MaxCascadeDropObjects
+	}
+
+	catalogCacheTTL := DefaultCatalogCacheTTL
+	if cfg.SQLConsole.CatalogCacheTTL != "" {
+		ttl, err := utils.ParseDuration(cfg.SQLConsole.CatalogCacheTTL)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to parse catalog cache ttl %s", cfg.SQLConsole.CatalogCacheTTL)
+		}
+		catalogCacheTTL = ttl
+	}
+
 	s := &Service{
-		m:                   m,
-		now:                 time.Now,
-		generateHashAndSalt: utils.GenerateHashAndSalt,
-		auth:                auth,
-		sqlm:                sqlm,
-		risectlm:            risectlm,
-		metahttp:            metahttp,
-		metricsConnManager:  metricsConnManager,
-		taskRunner:          taskRunner,
-		taskstore:           taskstore,
-		anchorSvc:           anchorSvc,
+		m:                     m,
+		now:                   time.Now,
+		generateHashAndSalt:   utils.GenerateHashAndSalt,
+		auth:                  auth,
+		sqlm:                  sqlm,
+		risectlm:              risectlm,
+		metahttp:              metahttp,
+		prober:                probe.NewProber(risectlm, metahttp),
+		metricsConnManager:    metricsConnManager,
+		taskRunner:            taskRunner,
+		taskstore:             taskstore,
+		anchorSvc:             anchorSvc,
+		blobs:                 blobs,
+		maxCascadeDropObjects: maxCascadeDropObjects,
+		catalogCache:          newCatalogCache(catalogCacheTTL),
+		risectlAllowlists:     newRisectlAllowlists(cfg.Risectl.OwnerAllowlist, cfg.Risectl.MemberAllowlist),
+		noInternet:            cfg.NoInternet,
+		allowRisectlUpload:    cfg.Risectl.AllowUpload,
 	}
 	return s, nil
 }
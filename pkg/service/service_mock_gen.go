@@ -11,6 +11,7 @@ package service
 
 import (
 	context "context"
+	io "io"
 	reflect "reflect"
 
 	model "github.com/prometheus/common/model"
@@ -42,6 +43,36 @@ func (m *MockServiceInterface) EXPECT() *MockServiceInterfaceMockRecorder {
 	return m.recorder
 }
 
+// AnalyzeDatabaseImpact mocks base method.
+func (m *MockServiceInterface) AnalyzeDatabaseImpact(ctx context.Context, id int32, params apigen.ImpactAnalysisRequest, orgID int32) (*apigen.ImpactAnalysis, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AnalyzeDatabaseImpact", ctx, id, params, orgID)
+	ret0, _ := ret[0].(*apigen.ImpactAnalysis)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AnalyzeDatabaseImpact indicates an expected call of AnalyzeDatabaseImpact.
+func (mr *MockServiceInterfaceMockRecorder) AnalyzeDatabaseImpact(ctx, id, params, orgID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AnalyzeDatabaseImpact", reflect.TypeOf((*MockServiceInterface)(nil).AnalyzeDatabaseImpact), ctx, id, params, orgID)
+}
+
+// CancelClusterUpgrade mocks base method.
+func (m *MockServiceInterface) CancelClusterUpgrade(ctx context.Context, id, upgradeID, userID, orgID int32) (*apigen.ClusterUpgrade, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelClusterUpgrade", ctx, id, upgradeID, userID, orgID)
+	ret0, _ := ret[0].(*apigen.ClusterUpgrade)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CancelClusterUpgrade indicates an expected call of CancelClusterUpgrade.
+func (mr *MockServiceInterfaceMockRecorder) CancelClusterUpgrade(ctx, id, upgradeID, userID, orgID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelClusterUpgrade", reflect.TypeOf((*MockServiceInterface)(nil).CancelClusterUpgrade), ctx, id, upgradeID, userID, orgID)
+}
+
 // CancelDDLProgress mocks base method.
 func (m *MockServiceInterface) CancelDDLProgress(ctx context.Context, id int32, ddlID int64, orgID int32) error {
 	m.ctrl.T.Helper()
@@ -56,6 +87,36 @@ func (mr *MockServiceInterfaceMockRecorder) CancelDDLProgress(ctx, id, ddlID, or
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelDDLProgress", reflect.TypeOf((*MockServiceInterface)(nil).CancelDDLProgress), ctx, id, ddlID, orgID)
 }
 
+// CancelRisectlExecution mocks base method.
+func (m *MockServiceInterface) CancelRisectlExecution(ctx context.Context, id, executionID, userID, orgID int32) (*apigen.RisectlExecution, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelRisectlExecution", ctx, id, executionID, userID, orgID)
+	ret0, _ := ret[0].(*apigen.RisectlExecution)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CancelRisectlExecution indicates an expected call of CancelRisectlExecution.
+func (mr *MockServiceInterfaceMockRecorder) CancelRisectlExecution(ctx, id, executionID, userID, orgID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelRisectlExecution", reflect.TypeOf((*MockServiceInterface)(nil).CancelRisectlExecution), ctx, id, executionID, userID, orgID)
+}
+
+// CreateAlertRule mocks base method.
+func (m *MockServiceInterface) CreateAlertRule(ctx context.Context, params apigen.AlertRuleCreate, orgID int32) (*apigen.AlertRule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAlertRule", ctx, params, orgID)
+	ret0, _ := ret[0].(*apigen.AlertRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateAlertRule indicates an expected call of CreateAlertRule.
+func (mr *MockServiceInterfaceMockRecorder) CreateAlertRule(ctx, params, orgID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAlertRule", reflect.TypeOf((*MockServiceInterface)(nil).CreateAlertRule), ctx, params, orgID)
+}
+
 // CreateClusterDiagnostic mocks base method.
 func (m *MockServiceInterface) CreateClusterDiagnostic(ctx context.Context, id, orgID int32) (*apigen.DiagnosticData, error) {
 	m.ctrl.T.Helper()
@@ -71,6 +132,21 @@ func (mr *MockServiceInterfaceMockRecorder) CreateClusterDiagnostic(ctx, id, org
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateClusterDiagnostic", reflect.TypeOf((*MockServiceInterface)(nil).CreateClusterDiagnostic), ctx, id, orgID)
 }
 
+// CreateClusterDiagnosticBundle mocks base method.
+func (m *MockServiceInterface) CreateClusterDiagnosticBundle(ctx context.Context, id, userID, orgID int32) (*apigen.DiagnosticBundle, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateClusterDiagnosticBundle", ctx, id, userID, orgID)
+	ret0, _ := ret[0].(*apigen.DiagnosticBundle)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateClusterDiagnosticBundle indicates an expected call of CreateClusterDiagnosticBundle.
+func (mr *MockServiceInterfaceMockRecorder) CreateClusterDiagnosticBundle(ctx, id, userID, orgID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateClusterDiagnosticBundle", reflect.TypeOf((*MockServiceInterface)(nil).CreateClusterDiagnosticBundle), ctx, id, userID, orgID)
+}
+
 // CreateClusterSnapshot mocks base method.
 func (m *MockServiceInterface) CreateClusterSnapshot(ctx context.Context, id int32, name string, orgID int32) (*apigen.Snapshot, error) {
 	m.ctrl.T.Helper()
@@ -86,6 +162,50 @@ func (mr *MockServiceInterfaceMockRecorder) CreateClusterSnapshot(ctx, id, name,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateClusterSnapshot", reflect.TypeOf((*MockServiceInterface)(nil).CreateClusterSnapshot), ctx, id, name, orgID)
 }
 
+// CreateClusterUpgrade mocks base method.
+func (m *MockServiceInterface) CreateClusterUpgrade(ctx context.Context, id int32, params apigen.ClusterUpgradeCreate, userID, orgID int32) (*apigen.ClusterUpgrade, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateClusterUpgrade", ctx, id, params, userID, orgID)
+	ret0, _ := ret[0].(*apigen.ClusterUpgrade)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateClusterUpgrade indicates an expected call of CreateClusterUpgrade.
+func (mr *MockServiceInterfaceMockRecorder) CreateClusterUpgrade(ctx, id, params, userID, orgID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateClusterUpgrade", reflect.TypeOf((*MockServiceInterface)(nil).CreateClusterUpgrade), ctx, id, params, userID, orgID)
+}
+
+// CreateRisectlExecution mocks base method.
+func (m *MockServiceInterface) CreateRisectlExecution(ctx context.Context, id int32, params apigen.RisectlCommand, userID, orgID int32) (*apigen.RisectlExecution, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateRisectlExecution", ctx, id, params, userID, orgID)
+	ret0, _ := ret[0].(*apigen.RisectlExecution)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateRisectlExecution indicates an expected call of CreateRisectlExecution.
+func (mr *MockServiceInterfaceMockRecorder) CreateRisectlExecution(ctx, id, params, userID, orgID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRisectlExecution", reflect.TypeOf((*MockServiceInterface)(nil).CreateRisectlExecution), ctx, id, params, userID, orgID)
+}
+
+// DeleteAlertRule mocks base method.
+func (m *MockServiceInterface) DeleteAlertRule(ctx context.Context, id, orgID int32) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteAlertRule", ctx, id, orgID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteAlertRule indicates an expected call of DeleteAlertRule.
+func (mr *MockServiceInterfaceMockRecorder) DeleteAlertRule(ctx, id, orgID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAlertRule", reflect.TypeOf((*MockServiceInterface)(nil).DeleteAlertRule), ctx, id, orgID)
+}
+
 // DeleteCluster mocks base method.
 func (m *MockServiceInterface) DeleteCluster(ctx context.Context, id int32, cascade bool, orgID int32) error {
 	m.ctrl.T.Helper()
@@ -100,6 +220,20 @@ func (mr *MockServiceInterfaceMockRecorder) DeleteCluster(ctx, id, cascade, orgI
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCluster", reflect.TypeOf((*MockServiceInterface)(nil).DeleteCluster), ctx, id, cascade, orgID)
 }
 
+// DeleteClusterDiagnosticBundle mocks base method.
+func (m *MockServiceInterface) DeleteClusterDiagnosticBundle(ctx context.Context, id, bundleID, orgID int32) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteClusterDiagnosticBundle", ctx, id, bundleID, orgID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteClusterDiagnosticBundle indicates an expected call of DeleteClusterDiagnosticBundle.
+func (mr *MockServiceInterfaceMockRecorder) DeleteClusterDiagnosticBundle(ctx, id, bundleID, orgID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteClusterDiagnosticBundle", reflect.TypeOf((*MockServiceInterface)(nil).DeleteClusterDiagnosticBundle), ctx, id, bundleID, orgID)
+}
+
 // DeleteClusterSnapshot mocks base method.
 func (m *MockServiceInterface) DeleteClusterSnapshot(ctx context.Context, id int32, snapshotID int64, orgID int32) error {
 	m.ctrl.T.Helper()
@@ -142,6 +276,82 @@ func (mr *MockServiceInterfaceMockRecorder) DeleteMetricsStore(ctx, id, OrgID, f
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteMetricsStore", reflect.TypeOf((*MockServiceInterface)(nil).DeleteMetricsStore), ctx, id, OrgID, force)
 }
 
+// DiffClusterDiagnostics mocks base method.
+func (m *MockServiceInterface) DiffClusterDiagnostics(ctx context.Context, id, fromID, toID, orgID int32) (*apigen.DiagnosticDiff, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DiffClusterDiagnostics", ctx, id, fromID, toID, orgID)
+	ret0, _ := ret[0].(*apigen.DiagnosticDiff)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DiffClusterDiagnostics indicates an expected call of DiffClusterDiagnostics.
+func (mr *MockServiceInterfaceMockRecorder) DiffClusterDiagnostics(ctx, id, fromID, toID, orgID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DiffClusterDiagnostics", reflect.TypeOf((*MockServiceInterface)(nil).DiffClusterDiagnostics), ctx, id, fromID, toID, orgID)
+}
+
+// DownloadClusterDiagnosticBundle mocks base method.
+func (m *MockServiceInterface) DownloadClusterDiagnosticBundle(ctx context.Context, id, bundleID, orgID int32) (string, []byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DownloadClusterDiagnosticBundle", ctx, id, bundleID, orgID)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].([]byte)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// DownloadClusterDiagnosticBundle indicates an expected call of DownloadClusterDiagnosticBundle.
+func (mr *MockServiceInterfaceMockRecorder) DownloadClusterDiagnosticBundle(ctx, id, bundleID, orgID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DownloadClusterDiagnosticBundle", reflect.TypeOf((*MockServiceInterface)(nil).DownloadClusterDiagnosticBundle), ctx, id, bundleID, orgID)
+}
+
+// ExportDatabaseLineage mocks base method.
+func (m *MockServiceInterface) ExportDatabaseLineage(ctx context.Context, id int32, params apigen.ExportDatabaseLineageParams, orgID int32) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExportDatabaseLineage", ctx, id, params, orgID)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExportDatabaseLineage indicates an expected call of ExportDatabaseLineage.
+func (mr *MockServiceInterfaceMockRecorder) ExportDatabaseLineage(ctx, id, params, orgID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportDatabaseLineage", reflect.TypeOf((*MockServiceInterface)(nil).ExportDatabaseLineage), ctx, id, params, orgID)
+}
+
+// FollowRisectlExecution mocks base method.
+func (m *MockServiceInterface) FollowRisectlExecution(ctx context.Context, id, executionID, after, orgID int32, onOutputs func([]apigen.RisectlExecutionOutput) error) (*apigen.RisectlExecution, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FollowRisectlExecution", ctx, id, executionID, after, orgID, onOutputs)
+	ret0, _ := ret[0].(*apigen.RisectlExecution)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FollowRisectlExecution indicates an expected call of FollowRisectlExecution.
+func (mr *MockServiceInterfaceMockRecorder) FollowRisectlExecution(ctx, id, executionID, after, orgID, onOutputs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FollowRisectlExecution", reflect.TypeOf((*MockServiceInterface)(nil).FollowRisectlExecution), ctx, id, executionID, after, orgID, onOutputs)
+}
+
+// GetAlertRule mocks base method.
+func (m *MockServiceInterface) GetAlertRule(ctx context.Context, id, orgID int32) (*apigen.AlertRule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAlertRule", ctx, id, orgID)
+	ret0, _ := ret[0].(*apigen.AlertRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAlertRule indicates an expected call of GetAlertRule.
+func (mr *MockServiceInterfaceMockRecorder) GetAlertRule(ctx, id, orgID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAlertRule", reflect.TypeOf((*MockServiceInterface)(nil).GetAlertRule), ctx, id, orgID)
+}
+
 // GetCluster mocks base method.
 func (m *MockServiceInterface) GetCluster(ctx context.Context, id, orgID int32) (*apigen.Cluster, error) {
 	m.ctrl.T.Helper()
@@ -202,6 +412,51 @@ func (mr *MockServiceInterfaceMockRecorder) GetClusterDiagnostic(ctx, id, diagno
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetClusterDiagnostic", reflect.TypeOf((*MockServiceInterface)(nil).GetClusterDiagnostic), ctx, id, diagnosticID, orgID)
 }
 
+// GetClusterDiagnosticBundle mocks base method.
+func (m *MockServiceInterface) GetClusterDiagnosticBundle(ctx context.Context, id, bundleID, orgID int32) (*apigen.DiagnosticBundle, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetClusterDiagnosticBundle", ctx, id, bundleID, orgID)
+	ret0, _ := ret[0].(*apigen.DiagnosticBundle)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetClusterDiagnosticBundle indicates an expected call of GetClusterDiagnosticBundle.
+func (mr *MockServiceInterfaceMockRecorder) GetClusterDiagnosticBundle(ctx, id, bundleID, orgID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetClusterDiagnosticBundle", reflect.TypeOf((*MockServiceInterface)(nil).GetClusterDiagnosticBundle), ctx, id, bundleID, orgID)
+}
+
+// GetClusterDiagnosticReport mocks base method.
+func (m *MockServiceInterface) GetClusterDiagnosticReport(ctx context.Context, id, diagnosticID, orgID int32) (*apigen.DiagnosticReport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetClusterDiagnosticReport", ctx, id, diagnosticID, orgID)
+	ret0, _ := ret[0].(*apigen.DiagnosticReport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetClusterDiagnosticReport indicates an expected call of GetClusterDiagnosticReport.
+func (mr *MockServiceInterfaceMockRecorder) GetClusterDiagnosticReport(ctx, id, diagnosticID, orgID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetClusterDiagnosticReport", reflect.TypeOf((*MockServiceInterface)(nil).GetClusterDiagnosticReport), ctx, id, diagnosticID, orgID)
+}
+
+// GetClusterUpgrade mocks base method.
+func (m *MockServiceInterface) GetClusterUpgrade(ctx context.Context, id, upgradeID, orgID int32) (*apigen.ClusterUpgrade, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetClusterUpgrade", ctx, id, upgradeID, orgID)
+	ret0, _ := ret[0].(*apigen.ClusterUpgrade)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetClusterUpgrade indicates an expected call of GetClusterUpgrade.
+func (mr *MockServiceInterfaceMockRecorder) GetClusterUpgrade(ctx, id, upgradeID, orgID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetClusterUpgrade", reflect.TypeOf((*MockServiceInterface)(nil).GetClusterUpgrade), ctx, id, upgradeID, orgID)
+}
+
 // GetDDLProgress mocks base method.
 func (m *MockServiceInterface) GetDDLProgress(ctx context.Context, id, orgID int32) ([]apigen.DDLProgress, error) {
 	m.ctrl.T.Helper()
@@ -218,18 +473,33 @@ func (mr *MockServiceInterfaceMockRecorder) GetDDLProgress(ctx, id, orgID any) *
 }
 
 // GetDatabase mocks base method.
-func (m *MockServiceInterface) GetDatabase(ctx context.Context, id, orgID int32) (*apigen.Database, error) {
+func (m *MockServiceInterface) GetDatabase(ctx context.Context, id int32, params apigen.GetDatabaseParams, orgID int32) (*apigen.Database, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetDatabase", ctx, id, orgID)
+	ret := m.ctrl.Call(m, "GetDatabase", ctx, id, params, orgID)
 	ret0, _ := ret[0].(*apigen.Database)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetDatabase indicates an expected call of GetDatabase.
-func (mr *MockServiceInterfaceMockRecorder) GetDatabase(ctx, id, orgID any) *gomock.Call {
+func (mr *MockServiceInterfaceMockRecorder) GetDatabase(ctx, id, params, orgID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDatabase", reflect.TypeOf((*MockServiceInterface)(nil).GetDatabase), ctx, id, orgID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDatabase", reflect.TypeOf((*MockServiceInterface)(nil).GetDatabase), ctx, id, params, orgID)
+}
+
+// GetDatabaseLineage mocks base method.
+func (m *MockServiceInterface) GetDatabaseLineage(ctx context.Context, id int32, params apigen.GetDatabaseLineageParams, orgID int32) (*apigen.LineageGraph, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDatabaseLineage", ctx, id, params, orgID)
+	ret0, _ := ret[0].(*apigen.LineageGraph)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDatabaseLineage indicates an expected call of GetDatabaseLineage.
+func (mr *MockServiceInterfaceMockRecorder) GetDatabaseLineage(ctx, id, params, orgID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDatabaseLineage", reflect.TypeOf((*MockServiceInterface)(nil).GetDatabaseLineage), ctx, id, params, orgID)
 }
 
 // GetMaterializedViewThroughput mocks base method.
@@ -262,6 +532,36 @@ func (mr *MockServiceInterfaceMockRecorder) GetMetricsStore(ctx, id, OrgID any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMetricsStore", reflect.TypeOf((*MockServiceInterface)(nil).GetMetricsStore), ctx, id, OrgID)
 }
 
+// GetOrgSettings mocks base method.
+func (m *MockServiceInterface) GetOrgSettings(ctx context.Context, orgID int32) (*apigen.OrgSettings, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOrgSettings", ctx, orgID)
+	ret0, _ := ret[0].(*apigen.OrgSettings)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOrgSettings indicates an expected call of GetOrgSettings.
+func (mr *MockServiceInterfaceMockRecorder) GetOrgSettings(ctx, orgID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrgSettings", reflect.TypeOf((*MockServiceInterface)(nil).GetOrgSettings), ctx, orgID)
+}
+
+// GetRisectlExecution mocks base method.
+func (m *MockServiceInterface) GetRisectlExecution(ctx context.Context, id, executionID, orgID int32) (*apigen.RisectlExecution, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRisectlExecution", ctx, id, executionID, orgID)
+	ret0, _ := ret[0].(*apigen.RisectlExecution)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRisectlExecution indicates an expected call of GetRisectlExecution.
+func (mr *MockServiceInterfaceMockRecorder) GetRisectlExecution(ctx, id, executionID, orgID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRisectlExecution", reflect.TypeOf((*MockServiceInterface)(nil).GetRisectlExecution), ctx, id, executionID, orgID)
+}
+
 // ImportCluster mocks base method.
 func (m *MockServiceInterface) ImportCluster(ctx context.Context, params apigen.ClusterImport, orgID int32) (*apigen.Cluster, error) {
 	m.ctrl.T.Helper()
@@ -307,6 +607,81 @@ func (mr *MockServiceInterfaceMockRecorder) ImportMetricsStore(arg0, arg1, arg2
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportMetricsStore", reflect.TypeOf((*MockServiceInterface)(nil).ImportMetricsStore), arg0, arg1, arg2)
 }
 
+// ListAlertHistory mocks base method.
+func (m *MockServiceInterface) ListAlertHistory(ctx context.Context, params apigen.ListAlertHistoryParams, orgID int32) ([]apigen.AlertHistoryEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAlertHistory", ctx, params, orgID)
+	ret0, _ := ret[0].([]apigen.AlertHistoryEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAlertHistory indicates an expected call of ListAlertHistory.
+func (mr *MockServiceInterfaceMockRecorder) ListAlertHistory(ctx, params, orgID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAlertHistory", reflect.TypeOf((*MockServiceInterface)(nil).ListAlertHistory), ctx, params, orgID)
+}
+
+// ListAlertRules mocks base method.
+func (m *MockServiceInterface) ListAlertRules(ctx context.Context, params apigen.ListAlertRulesParams, orgID int32) ([]apigen.AlertRule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAlertRules", ctx, params, orgID)
+	ret0, _ := ret[0].([]apigen.AlertRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAlertRules indicates an expected call of ListAlertRules.
+func (mr *MockServiceInterfaceMockRecorder) ListAlertRules(ctx, params, orgID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAlertRules", reflect.TypeOf((*MockServiceInterface)(nil).ListAlertRules), ctx, params, orgID)
+}
+
+// ListAlerts mocks base method.
+func (m *MockServiceInterface) ListAlerts(ctx context.Context, params apigen.ListAlertsParams, orgID int32) ([]apigen.Alert, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAlerts", ctx, params, orgID)
+	ret0, _ := ret[0].([]apigen.Alert)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAlerts indicates an expected call of ListAlerts.
+func (mr *MockServiceInterfaceMockRecorder) ListAlerts(ctx, params, orgID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAlerts", reflect.TypeOf((*MockServiceInterface)(nil).ListAlerts), ctx, params, orgID)
+}
+
+// ListClusterDiagnosticBundles mocks base method.
+func (m *MockServiceInterface) ListClusterDiagnosticBundles(ctx context.Context, id, orgID int32) ([]apigen.DiagnosticBundle, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListClusterDiagnosticBundles", ctx, id, orgID)
+	ret0, _ := ret[0].([]apigen.DiagnosticBundle)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListClusterDiagnosticBundles indicates an expected call of ListClusterDiagnosticBundles.
+func (mr *MockServiceInterfaceMockRecorder) ListClusterDiagnosticBundles(ctx, id, orgID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListClusterDiagnosticBundles", reflect.TypeOf((*MockServiceInterface)(nil).ListClusterDiagnosticBundles), ctx, id, orgID)
+}
+
+// ListClusterDiagnosticFindings mocks base method.
+func (m *MockServiceInterface) ListClusterDiagnosticFindings(ctx context.Context, id, diagnosticID, orgID int32) ([]apigen.ClusterDiagnosticFinding, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListClusterDiagnosticFindings", ctx, id, diagnosticID, orgID)
+	ret0, _ := ret[0].([]apigen.ClusterDiagnosticFinding)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListClusterDiagnosticFindings indicates an expected call of ListClusterDiagnosticFindings.
+func (mr *MockServiceInterfaceMockRecorder) ListClusterDiagnosticFindings(ctx, id, diagnosticID, orgID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListClusterDiagnosticFindings", reflect.TypeOf((*MockServiceInterface)(nil).ListClusterDiagnosticFindings), ctx, id, diagnosticID, orgID)
+}
+
 // ListClusterDiagnostics mocks base method.
 func (m *MockServiceInterface) ListClusterDiagnostics(ctx context.Context, id, orgID int32) ([]apigen.DiagnosticData, error) {
 	m.ctrl.T.Helper()
@@ -322,6 +697,36 @@ func (mr *MockServiceInterfaceMockRecorder) ListClusterDiagnostics(ctx, id, orgI
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListClusterDiagnostics", reflect.TypeOf((*MockServiceInterface)(nil).ListClusterDiagnostics), ctx, id, orgID)
 }
 
+// ListClusterEvents mocks base method.
+func (m *MockServiceInterface) ListClusterEvents(ctx context.Context, id int32, params apigen.ListClusterEventsParams, orgID int32) ([]apigen.ClusterEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListClusterEvents", ctx, id, params, orgID)
+	ret0, _ := ret[0].([]apigen.ClusterEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListClusterEvents indicates an expected call of ListClusterEvents.
+func (mr *MockServiceInterfaceMockRecorder) ListClusterEvents(ctx, id, params, orgID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListClusterEvents", reflect.TypeOf((*MockServiceInterface)(nil).ListClusterEvents), ctx, id, params, orgID)
+}
+
+// ListClusterHealthHistory mocks base method.
+func (m *MockServiceInterface) ListClusterHealthHistory(ctx context.Context, id int32, params apigen.ListClusterHealthHistoryParams, orgID int32) ([]apigen.ClusterHealthRecord, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListClusterHealthHistory", ctx, id, params, orgID)
+	ret0, _ := ret[0].([]apigen.ClusterHealthRecord)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListClusterHealthHistory indicates an expected call of ListClusterHealthHistory.
+func (mr *MockServiceInterfaceMockRecorder) ListClusterHealthHistory(ctx, id, params, orgID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListClusterHealthHistory", reflect.TypeOf((*MockServiceInterface)(nil).ListClusterHealthHistory), ctx, id, params, orgID)
+}
+
 // ListClusterSnapshots mocks base method.
 func (m *MockServiceInterface) ListClusterSnapshots(ctx context.Context, id, orgID int32) ([]apigen.Snapshot, error) {
 	m.ctrl.T.Helper()
@@ -337,6 +742,36 @@ func (mr *MockServiceInterfaceMockRecorder) ListClusterSnapshots(ctx, id, orgID
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListClusterSnapshots", reflect.TypeOf((*MockServiceInterface)(nil).ListClusterSnapshots), ctx, id, orgID)
 }
 
+// ListClusterUpgrades mocks base method.
+func (m *MockServiceInterface) ListClusterUpgrades(ctx context.Context, id, orgID int32) ([]apigen.ClusterUpgrade, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListClusterUpgrades", ctx, id, orgID)
+	ret0, _ := ret[0].([]apigen.ClusterUpgrade)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListClusterUpgrades indicates an expected call of ListClusterUpgrades.
+func (mr *MockServiceInterfaceMockRecorder) ListClusterUpgrades(ctx, id, orgID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListClusterUpgrades", reflect.TypeOf((*MockServiceInterface)(nil).ListClusterUpgrades), ctx, id, orgID)
+}
+
+// ListClusterVersionHistory mocks base method.
+func (m *MockServiceInterface) ListClusterVersionHistory(ctx context.Context, id, orgID int32) ([]apigen.ClusterVersionChange, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListClusterVersionHistory", ctx, id, orgID)
+	ret0, _ := ret[0].([]apigen.ClusterVersionChange)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListClusterVersionHistory indicates an expected call of ListClusterVersionHistory.
+func (mr *MockServiceInterfaceMockRecorder) ListClusterVersionHistory(ctx, id, orgID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListClusterVersionHistory", reflect.TypeOf((*MockServiceInterface)(nil).ListClusterVersionHistory), ctx, id, orgID)
+}
+
 // ListClusterVersions mocks base method.
 func (m *MockServiceInterface) ListClusterVersions(ctx context.Context) ([]string, error) {
 	m.ctrl.T.Helper()
@@ -382,6 +817,36 @@ func (mr *MockServiceInterfaceMockRecorder) ListClustersByMetricsStoreID(ctx, id
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListClustersByMetricsStoreID", reflect.TypeOf((*MockServiceInterface)(nil).ListClustersByMetricsStoreID), ctx, id)
 }
 
+// ListDatabaseCatalogChanges mocks base method.
+func (m *MockServiceInterface) ListDatabaseCatalogChanges(ctx context.Context, id int32, params apigen.ListDatabaseCatalogChangesParams, orgID int32) ([]apigen.CatalogChangeEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDatabaseCatalogChanges", ctx, id, params, orgID)
+	ret0, _ := ret[0].([]apigen.CatalogChangeEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDatabaseCatalogChanges indicates an expected call of ListDatabaseCatalogChanges.
+func (mr *MockServiceInterfaceMockRecorder) ListDatabaseCatalogChanges(ctx, id, params, orgID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDatabaseCatalogChanges", reflect.TypeOf((*MockServiceInterface)(nil).ListDatabaseCatalogChanges), ctx, id, params, orgID)
+}
+
+// ListDatabaseRelations mocks base method.
+func (m *MockServiceInterface) ListDatabaseRelations(ctx context.Context, id int32, params apigen.ListDatabaseRelationsParams, orgID int32) ([]apigen.RelationSummary, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDatabaseRelations", ctx, id, params, orgID)
+	ret0, _ := ret[0].([]apigen.RelationSummary)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDatabaseRelations indicates an expected call of ListDatabaseRelations.
+func (mr *MockServiceInterfaceMockRecorder) ListDatabaseRelations(ctx, id, params, orgID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDatabaseRelations", reflect.TypeOf((*MockServiceInterface)(nil).ListDatabaseRelations), ctx, id, params, orgID)
+}
+
 // ListDatabases mocks base method.
 func (m *MockServiceInterface) ListDatabases(ctx context.Context, orgID int32) ([]apigen.Database, error) {
 	m.ctrl.T.Helper()
@@ -397,6 +862,21 @@ func (mr *MockServiceInterfaceMockRecorder) ListDatabases(ctx, orgID any) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDatabases", reflect.TypeOf((*MockServiceInterface)(nil).ListDatabases), ctx, orgID)
 }
 
+// ListDiagnosticRules mocks base method.
+func (m *MockServiceInterface) ListDiagnosticRules(ctx context.Context, orgID int32) ([]apigen.DiagnosticRule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDiagnosticRules", ctx, orgID)
+	ret0, _ := ret[0].([]apigen.DiagnosticRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDiagnosticRules indicates an expected call of ListDiagnosticRules.
+func (mr *MockServiceInterfaceMockRecorder) ListDiagnosticRules(ctx, orgID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDiagnosticRules", reflect.TypeOf((*MockServiceInterface)(nil).ListDiagnosticRules), ctx, orgID)
+}
+
 // ListMetricsStores mocks base method.
 func (m *MockServiceInterface) ListMetricsStores(ctx context.Context, OrgID int32) ([]*apigen.MetricsStore, error) {
 	m.ctrl.T.Helper()
@@ -412,34 +892,184 @@ func (mr *MockServiceInterfaceMockRecorder) ListMetricsStores(ctx, OrgID any) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListMetricsStores", reflect.TypeOf((*MockServiceInterface)(nil).ListMetricsStores), ctx, OrgID)
 }
 
+// ListRisectlExecutionOutputs mocks base method.
+func (m *MockServiceInterface) ListRisectlExecutionOutputs(ctx context.Context, id, executionID, after, orgID int32) ([]apigen.RisectlExecutionOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListRisectlExecutionOutputs", ctx, id, executionID, after, orgID)
+	ret0, _ := ret[0].([]apigen.RisectlExecutionOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListRisectlExecutionOutputs indicates an expected call of ListRisectlExecutionOutputs.
+func (mr *MockServiceInterfaceMockRecorder) ListRisectlExecutionOutputs(ctx, id, executionID, after, orgID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRisectlExecutionOutputs", reflect.TypeOf((*MockServiceInterface)(nil).ListRisectlExecutionOutputs), ctx, id, executionID, after, orgID)
+}
+
+// ListRisectlExecutions mocks base method.
+func (m *MockServiceInterface) ListRisectlExecutions(ctx context.Context, id int32, params apigen.ListRisectlExecutionsParams, orgID int32) ([]apigen.RisectlExecution, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListRisectlExecutions", ctx, id, params, orgID)
+	ret0, _ := ret[0].([]apigen.RisectlExecution)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListRisectlExecutions indicates an expected call of ListRisectlExecutions.
+func (mr *MockServiceInterfaceMockRecorder) ListRisectlExecutions(ctx, id, params, orgID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRisectlExecutions", reflect.TypeOf((*MockServiceInterface)(nil).ListRisectlExecutions), ctx, id, params, orgID)
+}
+
+// ListRisectlOperations mocks base method.
+func (m *MockServiceInterface) ListRisectlOperations(ctx context.Context, id, userID, orgID int32) ([]apigen.RisectlOperation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListRisectlOperations", ctx, id, userID, orgID)
+	ret0, _ := ret[0].([]apigen.RisectlOperation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListRisectlOperations indicates an expected call of ListRisectlOperations.
+func (mr *MockServiceInterfaceMockRecorder) ListRisectlOperations(ctx, id, userID, orgID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRisectlOperations", reflect.TypeOf((*MockServiceInterface)(nil).ListRisectlOperations), ctx, id, userID, orgID)
+}
+
+// ListRisectlShellCommands mocks base method.
+func (m *MockServiceInterface) ListRisectlShellCommands(ctx context.Context, id, sessionID, orgID int32) ([]apigen.RisectlShellCommand, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListRisectlShellCommands", ctx, id, sessionID, orgID)
+	ret0, _ := ret[0].([]apigen.RisectlShellCommand)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListRisectlShellCommands indicates an expected call of ListRisectlShellCommands.
+func (mr *MockServiceInterfaceMockRecorder) ListRisectlShellCommands(ctx, id, sessionID, orgID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRisectlShellCommands", reflect.TypeOf((*MockServiceInterface)(nil).ListRisectlShellCommands), ctx, id, sessionID, orgID)
+}
+
+// ListRisectlShellSessions mocks base method.
+func (m *MockServiceInterface) ListRisectlShellSessions(ctx context.Context, id, orgID int32) ([]apigen.RisectlShellSession, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListRisectlShellSessions", ctx, id, orgID)
+	ret0, _ := ret[0].([]apigen.RisectlShellSession)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListRisectlShellSessions indicates an expected call of ListRisectlShellSessions.
+func (mr *MockServiceInterfaceMockRecorder) ListRisectlShellSessions(ctx, id, orgID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRisectlShellSessions", reflect.TypeOf((*MockServiceInterface)(nil).ListRisectlShellSessions), ctx, id, orgID)
+}
+
+// OpenRisectlShell mocks base method.
+func (m *MockServiceInterface) OpenRisectlShell(ctx context.Context, id, userID, orgID int32) (*RisectlShell, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OpenRisectlShell", ctx, id, userID, orgID)
+	ret0, _ := ret[0].(*RisectlShell)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// OpenRisectlShell indicates an expected call of OpenRisectlShell.
+func (mr *MockServiceInterfaceMockRecorder) OpenRisectlShell(ctx, id, userID, orgID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OpenRisectlShell", reflect.TypeOf((*MockServiceInterface)(nil).OpenRisectlShell), ctx, id, userID, orgID)
+}
+
+// PreviewClusterSnapshotRetention mocks base method.
+func (m *MockServiceInterface) PreviewClusterSnapshotRetention(ctx context.Context, id int32, policy apigen.SnapshotRetentionPolicy, orgID int32) ([]apigen.SnapshotRetentionDecision, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PreviewClusterSnapshotRetention", ctx, id, policy, orgID)
+	ret0, _ := ret[0].([]apigen.SnapshotRetentionDecision)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PreviewClusterSnapshotRetention indicates an expected call of PreviewClusterSnapshotRetention.
+func (mr *MockServiceInterfaceMockRecorder) PreviewClusterSnapshotRetention(ctx, id, policy, orgID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PreviewClusterSnapshotRetention", reflect.TypeOf((*MockServiceInterface)(nil).PreviewClusterSnapshotRetention), ctx, id, policy, orgID)
+}
+
 // QueryDatabase mocks base method.
-func (m *MockServiceInterface) QueryDatabase(ctx context.Context, id int32, params apigen.QueryRequest, orgID int32, backgroundDDL bool) (*apigen.QueryResponse, error) {
+func (m *MockServiceInterface) QueryDatabase(ctx context.Context, id int32, params apigen.QueryRequest, orgID int32) (*apigen.QueryResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "QueryDatabase", ctx, id, params, orgID, backgroundDDL)
+	ret := m.ctrl.Call(m, "QueryDatabase", ctx, id, params, orgID)
 	ret0, _ := ret[0].(*apigen.QueryResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // QueryDatabase indicates an expected call of QueryDatabase.
-func (mr *MockServiceInterfaceMockRecorder) QueryDatabase(ctx, id, params, orgID, backgroundDDL any) *gomock.Call {
+func (mr *MockServiceInterfaceMockRecorder) QueryDatabase(ctx, id, params, orgID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QueryDatabase", reflect.TypeOf((*MockServiceInterface)(nil).QueryDatabase), ctx, id, params, orgID, backgroundDDL)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QueryDatabase", reflect.TypeOf((*MockServiceInterface)(nil).QueryDatabase), ctx, id, params, orgID)
 }
 
 // RunRisectlCommand mocks base method.
-func (m *MockServiceInterface) RunRisectlCommand(ctx context.Context, id int32, params apigen.RisectlCommand, orgID int32) (*apigen.RisectlCommandResult, error) {
+func (m *MockServiceInterface) RunRisectlCommand(ctx context.Context, id int32, params apigen.RisectlCommand, userID, orgID int32) (*apigen.RisectlCommandResult, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "RunRisectlCommand", ctx, id, params, orgID)
+	ret := m.ctrl.Call(m, "RunRisectlCommand", ctx, id, params, userID, orgID)
 	ret0, _ := ret[0].(*apigen.RisectlCommandResult)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // RunRisectlCommand indicates an expected call of RunRisectlCommand.
-func (mr *MockServiceInterfaceMockRecorder) RunRisectlCommand(ctx, id, params, orgID any) *gomock.Call {
+func (mr *MockServiceInterfaceMockRecorder) RunRisectlCommand(ctx, id, params, userID, orgID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RunRisectlCommand", reflect.TypeOf((*MockServiceInterface)(nil).RunRisectlCommand), ctx, id, params, orgID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RunRisectlCommand", reflect.TypeOf((*MockServiceInterface)(nil).RunRisectlCommand), ctx, id, params, userID, orgID)
+}
+
+// RunRisectlOperation mocks base method.
+func (m *MockServiceInterface) RunRisectlOperation(ctx context.Context, id int32, operation apigen.RisectlOperationName, userID, orgID int32) (*apigen.RisectlOperationResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RunRisectlOperation", ctx, id, operation, userID, orgID)
+	ret0, _ := ret[0].(*apigen.RisectlOperationResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RunRisectlOperation indicates an expected call of RunRisectlOperation.
+func (mr *MockServiceInterfaceMockRecorder) RunRisectlOperation(ctx, id, operation, userID, orgID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RunRisectlOperation", reflect.TypeOf((*MockServiceInterface)(nil).RunRisectlOperation), ctx, id, operation, userID, orgID)
+}
+
+// SearchClusterDiagnostics mocks base method.
+func (m *MockServiceInterface) SearchClusterDiagnostics(ctx context.Context, id int32, params apigen.SearchClusterDiagnosticsParams, orgID int32) ([]apigen.DiagnosticSearchResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchClusterDiagnostics", ctx, id, params, orgID)
+	ret0, _ := ret[0].([]apigen.DiagnosticSearchResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchClusterDiagnostics indicates an expected call of SearchClusterDiagnostics.
+func (mr *MockServiceInterfaceMockRecorder) SearchClusterDiagnostics(ctx, id, params, orgID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchClusterDiagnostics", reflect.TypeOf((*MockServiceInterface)(nil).SearchClusterDiagnostics), ctx, id, params, orgID)
+}
+
+// SearchDiagnostics mocks base method.
+func (m *MockServiceInterface) SearchDiagnostics(ctx context.Context, params apigen.SearchDiagnosticsParams, orgID int32) ([]apigen.DiagnosticSearchResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchDiagnostics", ctx, params, orgID)
+	ret0, _ := ret[0].([]apigen.DiagnosticSearchResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchDiagnostics indicates an expected call of SearchDiagnostics.
+func (mr *MockServiceInterfaceMockRecorder) SearchDiagnostics(ctx, params, orgID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchDiagnostics", reflect.TypeOf((*MockServiceInterface)(nil).SearchDiagnostics), ctx, params, orgID)
 }
 
 // TestClusterConnection mocks base method.
@@ -472,6 +1102,21 @@ func (mr *MockServiceInterfaceMockRecorder) TestDatabaseConnection(ctx, params,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TestDatabaseConnection", reflect.TypeOf((*MockServiceInterface)(nil).TestDatabaseConnection), ctx, params, orgID)
 }
 
+// UpdateAlertRule mocks base method.
+func (m *MockServiceInterface) UpdateAlertRule(ctx context.Context, id int32, params apigen.AlertRuleCreate, orgID int32) (*apigen.AlertRule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateAlertRule", ctx, id, params, orgID)
+	ret0, _ := ret[0].(*apigen.AlertRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateAlertRule indicates an expected call of UpdateAlertRule.
+func (mr *MockServiceInterfaceMockRecorder) UpdateAlertRule(ctx, id, params, orgID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAlertRule", reflect.TypeOf((*MockServiceInterface)(nil).UpdateAlertRule), ctx, id, params, orgID)
+}
+
 // UpdateCluster mocks base method.
 func (m *MockServiceInterface) UpdateCluster(ctx context.Context, id int32, params apigen.ClusterImport, orgID int32) (*apigen.Cluster, error) {
 	m.ctrl.T.Helper()
@@ -530,6 +1175,21 @@ func (mr *MockServiceInterfaceMockRecorder) UpdateDatabase(ctx, id, params, orgI
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateDatabase", reflect.TypeOf((*MockServiceInterface)(nil).UpdateDatabase), ctx, id, params, orgID)
 }
 
+// UpdateDiagnosticRule mocks base method.
+func (m *MockServiceInterface) UpdateDiagnosticRule(ctx context.Context, rule apigen.DiagnosticRuleName, params apigen.DiagnosticRuleUpdate, orgID int32) (*apigen.DiagnosticRule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateDiagnosticRule", ctx, rule, params, orgID)
+	ret0, _ := ret[0].(*apigen.DiagnosticRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateDiagnosticRule indicates an expected call of UpdateDiagnosticRule.
+func (mr *MockServiceInterfaceMockRecorder) UpdateDiagnosticRule(ctx, rule, params, orgID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateDiagnosticRule", reflect.TypeOf((*MockServiceInterface)(nil).UpdateDiagnosticRule), ctx, rule, params, orgID)
+}
+
 // UpdateMetricsStore mocks base method.
 func (m *MockServiceInterface) UpdateMetricsStore(ctx context.Context, id int32, req apigen.MetricsStoreImport, OrgID int32) (*apigen.MetricsStore, error) {
 	m.ctrl.T.Helper()
@@ -544,3 +1204,32 @@ func (mr *MockServiceInterfaceMockRecorder) UpdateMetricsStore(ctx, id, req, Org
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateMetricsStore", reflect.TypeOf((*MockServiceInterface)(nil).UpdateMetricsStore), ctx, id, req, OrgID)
 }
+
+// UpdateOrgSettings mocks base method.
+func (m *MockServiceInterface) UpdateOrgSettings(ctx context.Context, params apigen.OrgSettings, orgID int32) (*apigen.OrgSettings, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateOrgSettings", ctx, params, orgID)
+	ret0, _ := ret[0].(*apigen.OrgSettings)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateOrgSettings indicates an expected call of UpdateOrgSettings.
+func (mr *MockServiceInterfaceMockRecorder) UpdateOrgSettings(ctx, params, orgID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateOrgSettings", reflect.TypeOf((*MockServiceInterface)(nil).UpdateOrgSettings), ctx, params, orgID)
+}
+
+// UploadRisectl mocks base method.
+func (m *MockServiceInterface) UploadRisectl(ctx context.Context, version string, archive io.Reader, checksum string, userID, orgID int32) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UploadRisectl", ctx, version, archive, checksum, userID, orgID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UploadRisectl indicates an expected call of UploadRisectl.
+func (mr *MockServiceInterfaceMockRecorder) UploadRisectl(ctx, version, archive, checksum, userID, orgID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UploadRisectl", reflect.TypeOf((*MockServiceInterface)(nil).UploadRisectl), ctx, version, archive, checksum, userID, orgID)
+}
@@ -2,10 +2,14 @@ package service
 
 import (
 	"context"
-	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/jackc/pgx/v5"
 	"github.com/pkg/errors"
+	"github.com/risingwavelabs/risingwave-console/pkg/conn/probe"
 	"github.com/risingwavelabs/risingwave-console/pkg/conn/sql"
 	"github.com/risingwavelabs/risingwave-console/pkg/utils"
 	"github.com/risingwavelabs/risingwave-console/pkg/zgen/apigen"
@@ -21,23 +25,25 @@ func (s *Service) TestDatabaseConnection(ctx context.Context, params apigen.Test
 		return nil, errors.Wrapf(err, "failed to get cluster")
 	}
 
-	connStr := fmt.Sprintf("postgres://%s:%s@%s:%d/%s", params.Username, utils.UnwrapOrDefault(params.Password, ""), cluster.Host, cluster.SqlPort, params.Database)
-
-	_, err = sql.Query(ctx, connStr, "SELECT 1", false)
-	if err != nil {
-		return &apigen.TestDatabaseConnectionResult{
-			Success: false,
-			Result:  err.Error(),
-		}, nil
-	}
+	result := s.prober.Probe(ctx, probe.Target{
+		Host:     cluster.Host,
+		SqlPort:  cluster.SqlPort,
+		Username: params.Username,
+		Password: utils.UnwrapOrDefault(params.Password, ""),
+		Database: params.Database,
+		// the database connection only depends on the SQL port
+		Stages: []probe.Stage{probe.StageDNS, probe.StageTCPSQL, probe.StageTLS, probe.StagePgwireAuth, probe.StageDatabase},
+	})
 
+	success := result.Passed(probe.StageTCPSQL, probe.StagePgwireAuth, probe.StageDatabase)
 	return &apigen.TestDatabaseConnectionResult{
-		Success: true,
-		Result:  "Connection successful",
+		Success: success,
+		Result:  connectionTestSummary(result, success),
+		Stages:  connectionTestStagesToApi(result),
 	}, nil
 }
 
-func (s *Service) QueryDatabase(ctx context.Context, id int32, params apigen.QueryRequest, orgID int32, backgroundDDL bool) (*apigen.QueryResponse, error) {
+func (s *Service) QueryDatabase(ctx context.Context, id int32, params apigen.QueryRequest, orgID int32) (*apigen.QueryResponse, error) {
 	db, err := s.m.GetOrgDatabaseByID(ctx, querier.GetOrgDatabaseByIDParams{
 		ID:    id,
 		OrgID: orgID,
@@ -54,12 +60,24 @@ func (s *Service) QueryDatabase(ctx context.Context, id int32, params apigen.Que
 		return nil, errors.Wrapf(err, "failed to get database connection")
 	}
 
+	settings, err := s.resolveSessionSettings(ctx, db, params.SessionSettings, orgID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.checkDropConfirmation(ctx, db, params.Query, params.ConfirmationToken, settings); err != nil {
+		return nil, err
+	}
+
 	conn, err := s.sqlm.GetConn(ctx, db.ID)
 	if err != nil {
 		return nil, errors.Wrapf(err, "failed to get database connection")
 	}
 
-	result, err := conn.Query(ctx, params.Query, backgroundDDL)
+	result, err := conn.Query(ctx, params.Query, settings)
+	if isDDLQuery(params.Query) {
+		s.catalogCache.invalidate(db.ID)
+	}
 	if err != nil {
 		if errors.Is(err, sql.ErrQueryFailed) {
 			return &apigen.QueryResponse{
@@ -82,3 +100,98 @@ func (s *Service) QueryDatabase(ctx context.Context, id int32, params apigen.Que
 		Rows:    result.Rows,
 	}, nil
 }
+
+// resolveSessionSettings merges the default session settings of the database with the
+// settings of the request, the latter takes precedence. statement_timeout is normalized
+// to seconds and capped by the max statement timeout of the organization.
+func (s *Service) resolveSessionSettings(ctx context.Context, db *querier.DatabaseConnection, reqSettings *apigen.SessionSettings, orgID int32) (map[string]string, error) {
+	settings := make(map[string]string)
+	for _, src := range []*apigen.SessionSettings{db.SessionSettings, reqSettings} {
+		for k, v := range utils.Unwrap(src) {
+			settings[strings.ToLower(k)] = v
+		}
+	}
+	if err := sql.ValidateSessionSettings(settings); err != nil {
+		return nil, err
+	}
+
+	if v, ok := settings[sql.SettingStatementTimeout]; ok {
+		timeout, err := parseStatementTimeout(v)
+		if err != nil {
+			return nil, err
+		}
+		settings[sql.SettingStatementTimeout] = formatStatementTimeout(timeout)
+	}
+
+	orgSettings, err := s.m.GetOrgSettings(ctx, orgID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return settings, nil
+		}
+		return nil, errors.Wrapf(err, "failed to get organization settings")
+	}
+	if orgSettings.MaxStatementTimeout == nil || *orgSettings.MaxStatementTimeout == "" {
+		return settings, nil
+	}
+	maxTimeout, err := utils.ParseDuration(*orgSettings.MaxStatementTimeout)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to parse max statement timeout of organization %d", orgID)
+	}
+	if v, ok := settings[sql.SettingStatementTimeout]; ok {
+		// 0 disables the timeout, it exceeds any cap
+		timeout, _ := parseStatementTimeout(v)
+		if timeout > 0 && timeout <= maxTimeout {
+			return settings, nil
+		}
+	}
+	settings[sql.SettingStatementTimeout] = formatStatementTimeout(maxTimeout)
+	return settings, nil
+}
+
+// parseStatementTimeout parses a statement timeout, which is either a duration like 30s, 5m, 1d
+// or a number of seconds.
+func parseStatementTimeout(v string) (time.Duration, error) {
+	if seconds, err := strconv.ParseInt(v, 10, 64); err == nil {
+		if seconds < 0 {
+			return 0, errors.Wrapf(sql.ErrInvalidSessionSetting, "negative statement_timeout %s", v)
+		}
+		return time.Duration(seconds) * time.Second, nil
+	}
+	d, err := utils.ParseDuration(v)
+	if err != nil || d < 0 {
+		return 0, errors.Wrapf(sql.ErrInvalidSessionSetting, "invalid statement_timeout %s", v)
+	}
+	return d, nil
+}
+
+// formatStatementTimeout formats the timeout in seconds, which is the unit of statement_timeout in RisingWave.
+func formatStatementTimeout(d time.Duration) string {
+	seconds := int64(math.Ceil(d.Seconds()))
+	return strconv.FormatInt(seconds, 10)
+}
+
+func (s *Service) GetOrgSettings(ctx context.Context, orgID int32) (*apigen.OrgSettings, error) {
+	orgSettings, err := s.m.GetOrgSettings(ctx, orgID)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get organization settings")
+	}
+	return orgSettingsToApi(orgSettings), nil
+}
+
+func (s *Service) UpdateOrgSettings(ctx context.Context, params apigen.OrgSettings, orgID int32) (*apigen.OrgSettings, error) {
+	if params.MaxStatementTimeout != nil && *params.MaxStatementTimeout != "" {
+		d, err := utils.ParseDuration(*params.MaxStatementTimeout)
+		if err != nil || d <= 0 {
+			return nil, errors.Wrapf(ErrInvalidOrgSettings, "invalid max statement timeout %s", *params.MaxStatementTimeout)
+		}
+	}
+	orgSettings, err := s.m.UpdateOrgSettings(ctx, querier.UpdateOrgSettingsParams{
+		OrgID:                    orgID,
+		MaxStatementTimeout:      params.MaxStatementTimeout,
+		AutoUpdateClusterVersion: params.AutoUpdateClusterVersion,
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to update organization settings")
+	}
+	return orgSettingsToApi(orgSettings), nil
+}
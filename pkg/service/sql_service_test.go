@@ -0,0 +1,171 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/risingwavelabs/risingwave-console/pkg/conn/sql"
+	"github.com/risingwavelabs/risingwave-console/pkg/utils"
+	"github.com/risingwavelabs/risingwave-console/pkg/zcore/model"
+	"github.com/risingwavelabs/risingwave-console/pkg/zgen/apigen"
+	"github.com/risingwavelabs/risingwave-console/pkg/zgen/querier"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestResolveSessionSettings(t *testing.T) {
+	ctx := context.Background()
+	orgID := int32(201)
+
+	type testCase struct {
+		name        string
+		dbSettings  *apigen.SessionSettings
+		reqSettings *apigen.SessionSettings
+		maxTimeout  *string
+		orgErr      error
+		expected    map[string]string
+		expectedErr error
+	}
+
+	testCases := []testCase{
+		{
+			name:       "request settings override database defaults",
+			dbSettings: &apigen.SessionSettings{"background_ddl": "true", "search_path": "public"},
+			reqSettings: &apigen.SessionSettings{
+				"BACKGROUND_DDL": "false",
+			},
+			orgErr:   pgx.ErrNoRows,
+			expected: map[string]string{"background_ddl": "false", "search_path": "public"},
+		},
+		{
+			name:        "statement timeout is normalized to seconds",
+			reqSettings: &apigen.SessionSettings{"statement_timeout": "5m"},
+			orgErr:      pgx.ErrNoRows,
+			expected:    map[string]string{"statement_timeout": "300"},
+		},
+		{
+			name:        "statement timeout is capped by organization",
+			reqSettings: &apigen.SessionSettings{"statement_timeout": "3600"},
+			maxTimeout:  utils.Ptr("10m"),
+			expected:    map[string]string{"statement_timeout": "600"},
+		},
+		{
+			name:        "statement timeout within the cap is kept",
+			reqSettings: &apigen.SessionSettings{"statement_timeout": "30s"},
+			maxTimeout:  utils.Ptr("10m"),
+			expected:    map[string]string{"statement_timeout": "30"},
+		},
+		{
+			name:        "disabled statement timeout is capped",
+			reqSettings: &apigen.SessionSettings{"statement_timeout": "0"},
+			maxTimeout:  utils.Ptr("10m"),
+			expected:    map[string]string{"statement_timeout": "600"},
+		},
+		{
+			name:       "disabled statement timeout as a duration is capped",
+			dbSettings: &apigen.SessionSettings{"statement_timeout": "0s"},
+			maxTimeout: utils.Ptr("10m"),
+			expected:   map[string]string{"statement_timeout": "600"},
+		},
+		{
+			name:        "disabled statement timeout without cap is kept",
+			reqSettings: &apigen.SessionSettings{"statement_timeout": "0"},
+			orgErr:      pgx.ErrNoRows,
+			expected:    map[string]string{"statement_timeout": "0"},
+		},
+		{
+			name:       "missing statement timeout defaults to the cap",
+			maxTimeout: utils.Ptr("1m"),
+			expected:   map[string]string{"statement_timeout": "60"},
+		},
+		{
+			name:        "unknown setting",
+			reqSettings: &apigen.SessionSettings{"unknown": "1"},
+			expectedErr: sql.ErrInvalidSessionSetting,
+		},
+		{
+			name:        "negative statement timeout",
+			reqSettings: &apigen.SessionSettings{"statement_timeout": "-1"},
+			expectedErr: sql.ErrInvalidSessionSetting,
+		},
+		{
+			name:        "invalid statement timeout",
+			reqSettings: &apigen.SessionSettings{"statement_timeout": "soon"},
+			expectedErr: sql.ErrInvalidSessionSetting,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockModel := model.NewMockModelInterfaceWithTransaction(ctrl)
+			if tc.expectedErr == nil {
+				mockModel.EXPECT().GetOrgSettings(ctx, orgID).Return(&querier.OrgSetting{
+					OrgID:               orgID,
+					MaxStatementTimeout: tc.maxTimeout,
+				}, tc.orgErr)
+			}
+
+			s := &Service{m: mockModel}
+
+			settings, err := s.resolveSessionSettings(ctx, &querier.DatabaseConnection{SessionSettings: tc.dbSettings}, tc.reqSettings, orgID)
+			if tc.expectedErr != nil {
+				assert.ErrorIs(t, err, tc.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, settings)
+		})
+	}
+}
+
+func TestUpdateOrgSettingsKeepsAutoUpdate(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	orgID := int32(1)
+	mockModel := model.NewMockModelInterface(ctrl)
+	s := &Service{m: mockModel}
+
+	// the omitted field is passed as nil, so the stored value is kept
+	mockModel.EXPECT().UpdateOrgSettings(gomock.Any(), querier.UpdateOrgSettingsParams{
+		OrgID:               orgID,
+		MaxStatementTimeout: utils.Ptr("10m"),
+	}).Return(&querier.OrgSetting{
+		OrgID:                    orgID,
+		MaxStatementTimeout:      utils.Ptr("10m"),
+		AutoUpdateClusterVersion: true,
+	}, nil)
+
+	settings, err := s.UpdateOrgSettings(context.Background(), apigen.OrgSettings{MaxStatementTimeout: utils.Ptr("10m")}, orgID)
+	require.NoError(t, err)
+	require.NotNil(t, settings.AutoUpdateClusterVersion)
+	assert.True(t, *settings.AutoUpdateClusterVersion)
+}
+
+func TestUpdateOrgSettingsKeepsMaxStatementTimeout(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	orgID := int32(1)
+	mockModel := model.NewMockModelInterface(ctrl)
+	s := &Service{m: mockModel}
+
+	// the omitted timeout is passed as nil, so the stored cap is kept
+	mockModel.EXPECT().UpdateOrgSettings(gomock.Any(), querier.UpdateOrgSettingsParams{
+		OrgID:                    orgID,
+		AutoUpdateClusterVersion: utils.Ptr(false),
+	}).Return(&querier.OrgSetting{
+		OrgID:               orgID,
+		MaxStatementTimeout: utils.Ptr("10m"),
+	}, nil)
+
+	settings, err := s.UpdateOrgSettings(context.Background(), apigen.OrgSettings{AutoUpdateClusterVersion: utils.Ptr(false)}, orgID)
+	require.NoError(t, err)
+	require.NotNil(t, settings.MaxStatementTimeout)
+	assert.Equal(t, "10m", *settings.MaxStatementTimeout)
+}
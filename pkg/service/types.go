@@ -1,6 +1,12 @@
 package service
 
 import (
+	"fmt"
+
+	"github.com/risingwavelabs/risingwave-console/pkg/conn/http"
+	"github.com/risingwavelabs/risingwave-console/pkg/conn/meta"
+	"github.com/risingwavelabs/risingwave-console/pkg/conn/probe"
+	"github.com/risingwavelabs/risingwave-console/pkg/utils"
 	"github.com/risingwavelabs/risingwave-console/pkg/zgen/apigen"
 	"github.com/risingwavelabs/risingwave-console/pkg/zgen/querier"
 )
@@ -18,5 +24,212 @@ func clusterToApi(cluster *querier.Cluster) *apigen.Cluster {
 		CreatedAt:      cluster.CreatedAt,
 		UpdatedAt:      cluster.UpdatedAt,
 		MetricsStoreID: cluster.MetricsStoreID,
+		RisectlVersion: cluster.RisectlVersion,
+
+		DetectedVersion:   cluster.DetectedVersion,
+		VersionDetectedAt: cluster.VersionDetectedAt,
+		VersionMismatch:   cluster.DetectedVersion != nil && !meta.SameVersion(*cluster.DetectedVersion, cluster.Version),
+
+		HealthStatus:    (*apigen.ClusterHealthStatus)(cluster.HealthStatus),
+		HealthCheckedAt: cluster.HealthCheckedAt,
+		LastSeenAt:      cluster.LastSeenAt,
+	}
+}
+
+func orgSettingsToApi(orgSettings *querier.OrgSetting) *apigen.OrgSettings {
+	return &apigen.OrgSettings{
+		Timezone:            orgSettings.Timezone,
+		MaxStatementTimeout: orgSettings.MaxStatementTimeout,
+
+		AutoUpdateClusterVersion: &orgSettings.AutoUpdateClusterVersion,
+	}
+}
+
+func connectionTestStagesToApi(result *probe.Result) []apigen.ConnectionTestStage {
+	stages := make([]apigen.ConnectionTestStage, len(result.Stages))
+	for i, stage := range result.Stages {
+		stages[i] = apigen.ConnectionTestStage{
+			Name:       apigen.ConnectionTestStageName(stage.Stage),
+			Status:     apigen.ConnectionTestStageStatus(stage.Status),
+			DurationMs: stage.Duration.Milliseconds(),
+			Detail:     utils.IfElse(stage.Detail == "", nil, &stage.Detail),
+		}
+		if stage.Status == probe.StatusFailed {
+			stages[i].ErrorCategory = utils.Ptr(apigen.ConnectionErrorCategory(stage.Category))
+			stages[i].Error = &stage.Error
+			if hint := probe.Hint(stage.Category); hint != "" {
+				stages[i].Hint = &hint
+			}
+		}
+	}
+	return stages
+}
+
+// connectionTestSummary lists the failed stages one per line unless the test succeeded.
+func connectionTestSummary(result *probe.Result, success bool) string {
+	if success {
+		return "Connection successful"
+	}
+	summary := ""
+	for _, stage := range result.Stages {
+		if stage.Status == probe.StatusFailed {
+			summary += fmt.Sprintf("%s failed: %s\n", stage.Stage, stage.Error)
+		}
+	}
+	return summary
+}
+
+func diagnoseTablesToApi(tables []http.DiagnoseTable) []apigen.DiagnosticTable {
+	result := make([]apigen.DiagnosticTable, len(tables))
+	for i, table := range tables {
+		result[i] = apigen.DiagnosticTable{
+			Title:   table.Title,
+			Headers: table.Headers,
+			Rows:    table.Rows,
+		}
+	}
+	return result
+}
+
+func workerNodesToApi(nodes []http.WorkerNode) []apigen.DiagnosticWorkerNode {
+	result := make([]apigen.DiagnosticWorkerNode, len(nodes))
+	for i, node := range nodes {
+		result[i] = apigen.DiagnosticWorkerNode{
+			Id:          node.ID,
+			Host:        node.Host,
+			Type:        node.Type,
+			State:       node.State,
+			Parallelism: node.Parallelism,
+			ActorCount:  node.ActorCount,
+			Attributes:  node.Attributes,
+		}
+	}
+	return result
+}
+
+func awaitTreeSpansToApi(spans []http.AwaitTreeSpan) []apigen.DiagnosticAwaitTreeSpan {
+	result := make([]apigen.DiagnosticAwaitTreeSpan, len(spans))
+	for i, span := range spans {
+		result[i] = apigen.DiagnosticAwaitTreeSpan{Span: span.Span, Seconds: span.Seconds}
+	}
+	return result
+}
+
+func diagnoseDiffToApi(diff *http.DiagnoseDiff) *apigen.DiagnosticDiff {
+	catalog := make([]apigen.DiagnosticCountChange, len(diff.Catalog))
+	for i, change := range diff.Catalog {
+		catalog[i] = apigen.DiagnosticCountChange{Name: change.Name, Before: change.Before, After: change.After}
+	}
+
+	changedNodes := make([]apigen.DiagnosticWorkerNodeChange, len(diff.WorkerNodes.Changed))
+	for i, node := range diff.WorkerNodes.Changed {
+		changes := make([]apigen.DiagnosticAttributeChange, len(node.Changes))
+		for j, change := range node.Changes {
+			changes[j] = apigen.DiagnosticAttributeChange{Name: change.Name, Before: change.Before, After: change.After}
+		}
+		changedNodes[i] = apigen.DiagnosticWorkerNodeChange{Id: node.ID, Host: node.Host, Type: node.Type, Changes: changes}
+	}
+
+	slowActors := make([]apigen.DiagnosticSlowActorChange, len(diff.SlowActors))
+	for i, actor := range diff.SlowActors {
+		slowActors[i] = apigen.DiagnosticSlowActorChange{
+			Section:       actor.Section,
+			Key:           actor.Key,
+			Status:        apigen.DiagnosticSlowActorStatus(actor.Status),
+			BeforeSeconds: actor.BeforeSeconds,
+			AfterSeconds:  actor.AfterSeconds,
+			SlowSpans:     awaitTreeSpansToApi(actor.SlowSpans),
+		}
+	}
+
+	metrics := make([]apigen.DiagnosticMetricChange, len(diff.Metrics))
+	for i, metric := range diff.Metrics {
+		metrics[i] = apigen.DiagnosticMetricChange{
+			Table:  metric.Table,
+			Row:    metric.Row,
+			Column: metric.Column,
+			Before: metric.Before,
+			After:  metric.After,
+			Delta:  metric.Delta,
+		}
+	}
+
+	return &apigen.DiagnosticDiff{
+		VersionChanged: diff.VersionChanged,
+		FromVersion:    utils.IfElse(diff.FromVersion == "", nil, &diff.FromVersion),
+		ToVersion:      utils.IfElse(diff.ToVersion == "", nil, &diff.ToVersion),
+		Catalog:        catalog,
+		WorkerNodes: apigen.DiagnosticWorkerNodesDiff{
+			Added:   workerNodesToApi(diff.WorkerNodes.Added),
+			Removed: workerNodesToApi(diff.WorkerNodes.Removed),
+			Changed: changedNodes,
+		},
+		SlowActors:       slowActors,
+		Metrics:          metrics,
+		UnifiedDiff:      diff.UnifiedDiff,
+		TooLargeSections: diff.TooLargeSections,
+	}
+}
+
+func diagnoseReportToApi(report *http.DiagnoseReport) *apigen.DiagnosticReport {
+	awaitTree := make([]apigen.DiagnosticAwaitTreeSection, len(report.AwaitTree))
+	for i, section := range report.AwaitTree {
+		entries := make([]apigen.DiagnosticAwaitTreeEntry, len(section.Entries))
+		for j, entry := range section.Entries {
+			entries[j] = apigen.DiagnosticAwaitTreeEntry{Key: entry.Key, Trace: entry.Trace, SlowSpans: awaitTreeSpansToApi(entry.SlowSpans)}
+		}
+		awaitTree[i] = apigen.DiagnosticAwaitTreeSection{Title: section.Title, Entries: entries}
+	}
+
+	textSections := make([]apigen.DiagnosticTextSection, len(report.TextSections))
+	for i, section := range report.TextSections {
+		textSections[i] = apigen.DiagnosticTextSection{Title: section.Title, Content: section.Content}
+	}
+
+	return &apigen.DiagnosticReport{
+		ReportCreatedAt: utils.IfElse(report.CreatedAt == "", nil, &report.CreatedAt),
+		Version:         utils.IfElse(report.Version == "", nil, &report.Version),
+		Catalog:         report.Catalog,
+		WorkerNodes:     workerNodesToApi(report.WorkerNodes),
+		Streaming:       diagnoseTablesToApi(report.Streaming),
+		Batch:           diagnoseTablesToApi(report.Batch),
+		Storage: apigen.DiagnosticStorageStats{
+			SstCount:          report.Storage.SstCount,
+			SstTotalSizeBytes: report.Storage.SstTotalSizeBytes,
+			Tables:            diagnoseTablesToApi(report.Storage.Tables),
+		},
+		AwaitTree:    awaitTree,
+		EventLogs:    diagnoseTablesToApi(report.EventLogs),
+		OtherTables:  diagnoseTablesToApi(report.OtherTables),
+		TextSections: textSections,
+	}
+}
+
+func fragmentsToApi(fragments []meta.Fragment) []apigen.RisectlFragment {
+	result := make([]apigen.RisectlFragment, len(fragments))
+	for i, fragment := range fragments {
+		result[i] = apigen.RisectlFragment{
+			Id:           fragment.ID,
+			TableId:      fragment.TableID,
+			TableName:    utils.IfElse(fragment.TableName == "", nil, &fragment.TableName),
+			Type:         utils.IfElse(fragment.Type == "", nil, &fragment.Type),
+			WorkerActors: fragment.WorkerActors,
+		}
+	}
+	return result
+}
+
+func clusterInfoToApi(info *meta.ClusterInfo) *apigen.RisectlClusterInfo {
+	tables := make([]apigen.RisectlTable, len(info.Tables))
+	for i, table := range info.Tables {
+		tables[i] = apigen.RisectlTable{
+			Headers: table.Headers,
+			Rows:    table.Rows,
+		}
+	}
+	return &apigen.RisectlClusterInfo{
+		Revision:  info.Revision,
+		Tables:    tables,
+		Fragments: fragmentsToApi(info.Fragments),
 	}
 }
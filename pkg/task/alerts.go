@@ -0,0 +1,368 @@
+package task
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/pkg/errors"
+	prom_model "github.com/prometheus/common/model"
+	"github.com/risingwavelabs/risingwave-console/pkg/utils"
+	"github.com/risingwavelabs/risingwave-console/pkg/zcore/model"
+	"github.com/risingwavelabs/risingwave-console/pkg/zgen/apigen"
+	"github.com/risingwavelabs/risingwave-console/pkg/zgen/querier"
+	"github.com/risingwavelabs/risingwave-console/pkg/zgen/taskgen"
+	"go.uber.org/zap"
+	"golang.org/x/sync/errgroup"
+)
+
+const (
+	// alertEvaluationConcurrency is the number of clusters evaluated at the same time
+	alertEvaluationConcurrency = 8
+
+	// maxAlertSeriesPerRule bounds the alerts of a rule on a cluster, a rule returning more series
+	// is not evaluated, e.g. an expression missing a comparison returns every series it selects
+	maxAlertSeriesPerRule = 500
+
+	// alertHistoryRetention is how long the fired and resolved alerts are kept
+	alertHistoryRetention = 90 * 24 * time.Hour
+)
+
+// alertKey identifies the evaluation of an alert rule against a cluster.
+type alertKey struct {
+	ruleID    int32
+	clusterID int32
+}
+
+// alertTransition is an alert fired or resolved by an evaluation, it is reported as a cluster
+// event once the evaluation is committed.
+type alertTransition struct {
+	status   apigen.AlertStatus
+	ruleID   int32
+	ruleName string
+	severity string
+	series   string
+	value    float64
+}
+
+// ExecuteEvaluateAlertRules evaluates every enabled alert rule against the metrics stores of its
+// clusters. A series returned by the expression is pending until it has been returned for the for
+// duration of the rule, then it fires. A series no longer returned is resolved, so are the alerts
+// of the rules disabled, deleted or no longer targeting the cluster. The alerts of a cluster whose
+// metrics store cannot be queried are kept as they are until the next evaluation, so are the ones
+// of a rule failing to be evaluated, its error is recorded in the rule.
+func (e *TaskExecutor) ExecuteEvaluateAlertRules(ctx context.Context, params *taskgen.EvaluateAlertRulesParameters) error {
+	rules, err := e.model.ListEnabledAlertRules(ctx)
+	if err != nil {
+		return errors.Wrap(err, "failed to list alert rules")
+	}
+	clusters, err := e.model.ListAllClusters(ctx)
+	if err != nil {
+		return errors.Wrap(err, "failed to list clusters")
+	}
+	states, err := e.model.ListAlertStates(ctx)
+	if err != nil {
+		return errors.Wrap(err, "failed to list alert states")
+	}
+	statesByKey := map[alertKey][]*querier.ListAlertStatesRow{}
+	for _, state := range states {
+		key := alertKey{ruleID: state.RuleID, clusterID: state.ClusterID}
+		statesByKey[key] = append(statesByKey[key], state)
+	}
+
+	// the rules are grouped by cluster, so that the metrics store of a cluster is connected once
+	type clusterRule struct {
+		rule        *querier.AlertRule
+		forDuration time.Duration
+	}
+	rulesByCluster := map[int32][]clusterRule{}
+	evaluated := map[alertKey]bool{}
+
+	// the first error of every rule, the rules are evaluated against the clusters concurrently
+	var mu sync.Mutex
+	ruleErrors := map[int32]string{}
+	recordRuleError := func(ruleID int32, err error) {
+		mu.Lock()
+		defer mu.Unlock()
+		if _, ok := ruleErrors[ruleID]; !ok {
+			ruleErrors[ruleID] = err.Error()
+		}
+	}
+
+	for _, rule := range rules {
+		forDuration, err := utils.ParseDuration(rule.ForDuration)
+		if err != nil {
+			log.Warn("invalid for duration of alert rule", zap.Int32("rule_id", rule.ID), zap.String("for", rule.ForDuration), zap.Error(err))
+			recordRuleError(rule.ID, errors.Wrapf(err, "invalid for duration %s", rule.ForDuration))
+			continue
+		}
+		for _, cluster := range clusters {
+			if cluster.OrgID != rule.OrgID || (rule.ClusterID != nil && *rule.ClusterID != cluster.ID) {
+				continue
+			}
+			evaluated[alertKey{ruleID: rule.ID, clusterID: cluster.ID}] = true
+			rulesByCluster[cluster.ID] = append(rulesByCluster[cluster.ID], clusterRule{rule: rule, forDuration: forDuration})
+		}
+	}
+
+	now := e.now()
+	var g errgroup.Group
+	g.SetLimit(alertEvaluationConcurrency)
+	for clusterID, clusterRules := range rulesByCluster {
+		g.Go(func() error {
+			// a cluster failing to be evaluated should not block the others
+			conn, err := e.getMetricsConn(ctx, clusterID)
+			if err != nil {
+				if !errors.Is(err, pgx.ErrNoRows) {
+					log.Warn("failed to get metrics connection", zap.Int32("cluster_id", clusterID), zap.Error(err))
+				}
+				return nil
+			}
+			for _, r := range clusterRules {
+				vector, err := conn.QueryVector(ctx, r.rule.Expr, now)
+				if err == nil && len(vector) > maxAlertSeriesPerRule {
+					err = errors.Errorf("the expression returned %d series, more than the limit %d", len(vector), maxAlertSeriesPerRule)
+				}
+				if err != nil {
+					log.Warn("failed to evaluate alert rule", zap.Int32("rule_id", r.rule.ID), zap.Int32("cluster_id", clusterID), zap.Error(err))
+					recordRuleError(r.rule.ID, errors.Wrapf(err, "cluster %d", clusterID))
+					continue
+				}
+				key := alertKey{ruleID: r.rule.ID, clusterID: clusterID}
+				if err := e.evaluateAlertRule(ctx, r.rule, clusterID, r.forDuration, vector, statesByKey[key], now); err != nil {
+					log.Error("failed to record alerts", zap.Int32("rule_id", r.rule.ID), zap.Int32("cluster_id", clusterID), zap.Error(err))
+				}
+			}
+			return nil
+		})
+	}
+	if err := g.Wait(); err != nil {
+		return err
+	}
+
+	// the error is only written once it changes
+	for _, rule := range rules {
+		var lastError *string
+		if msg, ok := ruleErrors[rule.ID]; ok {
+			lastError = &msg
+		}
+		if utils.UnwrapOrDefault(lastError, "") == utils.UnwrapOrDefault(rule.LastError, "") {
+			continue
+		}
+		if err := e.model.UpdateAlertRuleLastError(ctx, querier.UpdateAlertRuleLastErrorParams{
+			ID:        rule.ID,
+			LastError: lastError,
+		}); err != nil {
+			log.Error("failed to record the error of alert rule", zap.Int32("rule_id", rule.ID), zap.Error(err))
+		}
+	}
+
+	// resolve the alerts of the rules not evaluated against their clusters anymore
+	for key, states := range statesByKey {
+		if evaluated[key] {
+			continue
+		}
+		if err := e.resolveAlerts(ctx, key.clusterID, states); err != nil {
+			log.Error("failed to resolve alerts", zap.Int32("rule_id", key.ruleID), zap.Int32("cluster_id", key.clusterID), zap.Error(err))
+		}
+	}
+	return nil
+}
+
+// evaluateAlertRule moves the alerts of the rule on the cluster forward with the series returned
+// by the expression, the states of the previous evaluation are the current alerts.
+func (e *TaskExecutor) evaluateAlertRule(ctx context.Context, rule *querier.AlertRule, clusterID int32, forDuration time.Duration, vector prom_model.Vector, states []*querier.ListAlertStatesRow, now time.Time) error {
+	previous := map[string]*querier.ListAlertStatesRow{}
+	for _, state := range states {
+		previous[state.Series] = state
+	}
+
+	var transitions []alertTransition
+	if err := e.model.RunTransaction(ctx, func(txm model.ModelInterface) error {
+		transitions = nil
+		seen := map[string]bool{}
+		for _, sample := range vector {
+			series := sample.Metric.String()
+			if seen[series] {
+				continue
+			}
+			seen[series] = true
+
+			var (
+				labels   = alertLabels(rule.Labels, sample.Metric)
+				value    = float64(sample.Value)
+				status   = apigen.AlertStatusPending
+				activeAt = now
+				firedAt  *time.Time
+			)
+			if prev, ok := previous[series]; ok {
+				status = apigen.AlertStatus(prev.Status)
+				activeAt = prev.ActiveAt
+				firedAt = prev.FiredAt
+			}
+			if status == apigen.AlertStatusPending && now.Sub(activeAt) >= forDuration {
+				status = apigen.AlertStatusFiring
+				firedAt = &now
+				if err := txm.CreateAlertHistory(ctx, querier.CreateAlertHistoryParams{
+					OrgID:     rule.OrgID,
+					RuleID:    &rule.ID,
+					RuleName:  rule.Name,
+					ClusterID: clusterID,
+					Status:    string(apigen.AlertStatusFiring),
+					Severity:  rule.Severity,
+					Labels:    labels,
+					Value:     value,
+					ActiveAt:  activeAt,
+				}); err != nil {
+					return errors.Wrap(err, "failed to create alert history")
+				}
+				transitions = append(transitions, alertTransition{
+					status:   apigen.AlertStatusFiring,
+					ruleID:   rule.ID,
+					ruleName: rule.Name,
+					severity: rule.Severity,
+					series:   series,
+					value:    value,
+				})
+			}
+			if err := txm.UpsertAlertState(ctx, querier.UpsertAlertStateParams{
+				RuleID:      rule.ID,
+				ClusterID:   clusterID,
+				Series:      series,
+				Labels:      labels,
+				Status:      string(status),
+				Value:       value,
+				ActiveAt:    activeAt,
+				FiredAt:     firedAt,
+				EvaluatedAt: now,
+			}); err != nil {
+				return errors.Wrap(err, "failed to upsert alert state")
+			}
+		}
+
+		for _, state := range states {
+			if seen[state.Series] {
+				continue
+			}
+			resolved, err := resolveAlert(ctx, txm, state)
+			if err != nil {
+				return err
+			}
+			if resolved != nil {
+				transitions = append(transitions, *resolved)
+			}
+		}
+		return nil
+	}); err != nil {
+		return err
+	}
+
+	e.recordAlertTransitions(ctx, clusterID, transitions)
+	return nil
+}
+
+// resolveAlerts resolves all the given alerts of the cluster.
+func (e *TaskExecutor) resolveAlerts(ctx context.Context, clusterID int32, states []*querier.ListAlertStatesRow) error {
+	var transitions []alertTransition
+	if err := e.model.RunTransaction(ctx, func(txm model.ModelInterface) error {
+		transitions = nil
+		for _, state := range states {
+			resolved, err := resolveAlert(ctx, txm, state)
+			if err != nil {
+				return err
+			}
+			if resolved != nil {
+				transitions = append(transitions, *resolved)
+			}
+		}
+		return nil
+	}); err != nil {
+		return err
+	}
+
+	e.recordAlertTransitions(ctx, clusterID, transitions)
+	return nil
+}
+
+// resolveAlert removes the state of the alert, a firing alert is recorded as resolved in the
+// history and returned, a pending one is dropped silently.
+func resolveAlert(ctx context.Context, txm model.ModelInterface, state *querier.ListAlertStatesRow) (*alertTransition, error) {
+	if err := txm.DeleteAlertState(ctx, querier.DeleteAlertStateParams{
+		RuleID:    state.RuleID,
+		ClusterID: state.ClusterID,
+		Series:    state.Series,
+	}); err != nil {
+		return nil, errors.Wrap(err, "failed to delete alert state")
+	}
+	if state.Status != string(apigen.AlertStatusFiring) {
+		return nil, nil
+	}
+	if err := txm.CreateAlertHistory(ctx, querier.CreateAlertHistoryParams{
+		OrgID:     state.OrgID,
+		RuleID:    &state.RuleID,
+		RuleName:  state.RuleName,
+		ClusterID: state.ClusterID,
+		Status:    string(apigen.AlertStatusResolved),
+		Severity:  state.Severity,
+		Labels:    state.Labels,
+		Value:     state.Value,
+		ActiveAt:  state.ActiveAt,
+	}); err != nil {
+		return nil, errors.Wrap(err, "failed to create alert history")
+	}
+	return &alertTransition{
+		status:   apigen.AlertStatusResolved,
+		ruleID:   state.RuleID,
+		ruleName: state.RuleName,
+		severity: state.Severity,
+		series:   state.Series,
+		value:    state.Value,
+	}, nil
+}
+
+func (e *TaskExecutor) recordAlertTransitions(ctx context.Context, clusterID int32, transitions []alertTransition) {
+	for _, t := range transitions {
+		typ := apigen.AlertFiring
+		if t.status == apigen.AlertStatusResolved {
+			typ = apigen.AlertResolved
+		}
+		model.RecordClusterEvent(ctx, e.model, clusterID, typ, fmt.Sprintf("%s alert %s is %s: %s", t.severity, t.ruleName, t.status, t.series), apigen.ClusterEventDetails{
+			"ruleID":   t.ruleID,
+			"severity": t.severity,
+			"series":   t.series,
+			"value":    utils.FiniteOrNil(t.value),
+		})
+	}
+	if len(transitions) > 0 {
+		log.Info("alerts changed", zap.Int32("cluster_id", clusterID), zap.Int("transitions", len(transitions)))
+	}
+}
+
+// alertLabels merges the labels of the series with the labels of the rule, the labels of the rule
+// take precedence.
+func alertLabels(ruleLabels apigen.AlertLabels, metric prom_model.Metric) apigen.AlertLabels {
+	labels := apigen.AlertLabels{}
+	for name, value := range metric {
+		if name == prom_model.MetricNameLabel {
+			continue
+		}
+		labels[string(name)] = string(value)
+	}
+	for name, value := range ruleLabels {
+		labels[name] = value
+	}
+	return labels
+}
+
+// ExecutePruneAlertHistory deletes the alert history recorded before the retention, the pending
+// and firing alerts are kept in their states regardless.
+func (e *TaskExecutor) ExecutePruneAlertHistory(ctx context.Context, params *taskgen.PruneAlertHistoryParameters) error {
+	deleted, err := e.model.DeleteOldAlertHistory(ctx, e.now().Add(-alertHistoryRetention))
+	if err != nil {
+		return errors.Wrap(err, "failed to delete old alert history")
+	}
+	log.Info("alert history pruned", zap.Int64("deleted", deleted))
+	return nil
+}
@@ -0,0 +1,255 @@
+package task
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	prom_model "github.com/prometheus/common/model"
+	"github.com/risingwavelabs/risingwave-console/pkg/conn/metricsstore"
+	"github.com/risingwavelabs/risingwave-console/pkg/utils"
+	"github.com/risingwavelabs/risingwave-console/pkg/zcore/model"
+	"github.com/risingwavelabs/risingwave-console/pkg/zgen/apigen"
+	"github.com/risingwavelabs/risingwave-console/pkg/zgen/querier"
+	"github.com/risingwavelabs/risingwave-console/pkg/zgen/taskgen"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestExecuteEvaluateAlertRules(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	var (
+		ctx   = context.Background()
+		orgID = int32(201)
+		now   = time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+		fired = now.Add(-time.Hour)
+	)
+
+	mockModel := model.NewMockModelInterfaceWithTransaction(ctrl)
+	mockModel.EXPECT().ListEnabledAlertRules(ctx).Return([]*querier.AlertRule{
+		// evaluated against all the clusters of the organization
+		{ID: 1, OrgID: orgID, Name: "node down", Expr: "up == 0", ForDuration: "5m", Severity: "critical", Labels: apigen.AlertLabels{"team": "db"}},
+		{ID: 2, OrgID: orgID, ClusterID: utils.Ptr(int32(102)), Name: "lagging", Expr: "lag > 1", ForDuration: "0s", Severity: "warning", Labels: apigen.AlertLabels{}},
+	}, nil)
+	mockModel.EXPECT().ListAllClusters(ctx).Return([]*querier.Cluster{
+		{ID: 101, OrgID: orgID},
+		{ID: 102, OrgID: orgID},
+		{ID: 103, OrgID: orgID + 1},
+	}, nil)
+	mockModel.EXPECT().ListAlertStates(ctx).Return([]*querier.ListAlertStatesRow{
+		// pending for longer than the for duration, fires
+		{RuleID: 1, ClusterID: 101, Series: `up{instance="a"}`, Status: "pending", ActiveAt: now.Add(-10 * time.Minute), OrgID: orgID, RuleName: "node down", Severity: "critical"},
+		// no longer returned, resolved
+		{RuleID: 1, ClusterID: 101, Series: `up{instance="c"}`, Status: "firing", Value: 0, ActiveAt: fired, FiredAt: &fired, OrgID: orgID, RuleName: "node down", Severity: "critical", Labels: apigen.AlertLabels{"instance": "c", "team": "db"}},
+		// the metrics store of the cluster is unavailable, kept
+		{RuleID: 1, ClusterID: 102, Series: `up{instance="d"}`, Status: "firing", ActiveAt: fired, FiredAt: &fired, OrgID: orgID, RuleName: "node down", Severity: "critical"},
+		// the rule is disabled, resolved
+		{RuleID: 3, ClusterID: 101, Series: `{}`, Status: "firing", Value: 7, ActiveAt: fired, FiredAt: &fired, OrgID: orgID, RuleName: "disabled", Severity: "info"},
+	}, nil)
+
+	// the clusters are evaluated concurrently
+	var mu sync.Mutex
+	conns := map[int32]int{}
+	getMetricsConn := func(_ context.Context, clusterID int32) (metricsstore.MetricsConn, error) {
+		mu.Lock()
+		conns[clusterID]++
+		mu.Unlock()
+		if clusterID == 102 {
+			return nil, errors.New("connection refused")
+		}
+		return &fakeMetricsConn{vectors: map[string]prom_model.Vector{
+			"up == 0": {
+				{Metric: prom_model.Metric{"__name__": "up", "instance": "a"}, Value: 0},
+				{Metric: prom_model.Metric{"__name__": "up", "instance": "b", "team": "meta"}, Value: 0},
+			},
+		}}, nil
+	}
+
+	var upserts []querier.UpsertAlertStateParams
+	mockModel.EXPECT().UpsertAlertState(ctx, gomock.Any()).Times(2).DoAndReturn(func(_ context.Context, arg querier.UpsertAlertStateParams) error {
+		upserts = append(upserts, arg)
+		return nil
+	})
+	mockModel.EXPECT().DeleteAlertState(ctx, querier.DeleteAlertStateParams{RuleID: 1, ClusterID: 101, Series: `up{instance="c"}`}).Return(nil)
+	mockModel.EXPECT().DeleteAlertState(ctx, querier.DeleteAlertStateParams{RuleID: 3, ClusterID: 101, Series: `{}`}).Return(nil)
+
+	var history []querier.CreateAlertHistoryParams
+	mockModel.EXPECT().CreateAlertHistory(ctx, gomock.Any()).Times(3).DoAndReturn(func(_ context.Context, arg querier.CreateAlertHistoryParams) error {
+		history = append(history, arg)
+		return nil
+	})
+	var events []querier.CreateClusterEventParams
+	mockModel.EXPECT().CreateClusterEvent(ctx, gomock.Any()).Times(3).DoAndReturn(func(_ context.Context, arg querier.CreateClusterEventParams) error {
+		events = append(events, arg)
+		return nil
+	})
+
+	executor := &TaskExecutor{
+		model:          mockModel,
+		getMetricsConn: getMetricsConn,
+		now:            func() time.Time { return now },
+	}
+	err := executor.ExecuteEvaluateAlertRules(ctx, &taskgen.EvaluateAlertRulesParameters{})
+	require.NoError(t, err)
+
+	// the connection of a cluster is reused by its rules
+	assert.Equal(t, map[int32]int{101: 1, 102: 1}, conns)
+
+	require.Len(t, upserts, 2)
+	assert.Equal(t, `up{instance="a"}`, upserts[0].Series)
+	assert.Equal(t, "firing", upserts[0].Status)
+	assert.Equal(t, `up{instance="b", team="meta"}`, upserts[1].Series)
+	assert.Equal(t, "pending", upserts[1].Status)
+	assert.Equal(t, apigen.AlertLabels{"instance": "b", "team": "db"}, upserts[1].Labels, "the labels of the rule take precedence")
+	assert.Equal(t, now, upserts[1].ActiveAt)
+	assert.Nil(t, upserts[1].FiredAt)
+
+	require.Len(t, history, 3)
+	assert.Equal(t, "firing", history[0].Status)
+	assert.Equal(t, "resolved", history[1].Status)
+	assert.Equal(t, "node down", history[1].RuleName)
+	assert.Equal(t, apigen.AlertLabels{"instance": "c", "team": "db"}, history[1].Labels)
+	assert.Equal(t, fired, history[1].ActiveAt)
+	assert.Equal(t, "resolved", history[2].Status)
+	assert.Equal(t, int32(3), *history[2].RuleID)
+
+	require.Len(t, events, 3)
+	assert.Equal(t, string(apigen.AlertFiring), events[0].Type)
+	assert.Equal(t, string(apigen.AlertResolved), events[1].Type)
+	assert.Equal(t, `critical alert node down is resolved: up{instance="c"}`, events[1].Message)
+	assert.Equal(t, int32(101), events[1].ClusterID)
+	assert.Equal(t, string(apigen.AlertResolved), events[2].Type)
+}
+
+func TestEvaluateAlertRuleFires(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	var (
+		ctx      = context.Background()
+		now      = time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+		activeAt = now.Add(-10 * time.Minute)
+		rule     = &querier.AlertRule{ID: 1, OrgID: 201, Name: "node down", Severity: "critical", Labels: apigen.AlertLabels{"team": "db"}}
+		vector   = prom_model.Vector{
+			{Metric: prom_model.Metric{"__name__": "up", "instance": "a"}, Value: 0},
+			{Metric: prom_model.Metric{"__name__": "up", "instance": "b"}, Value: 0},
+			{Metric: prom_model.Metric{"__name__": "up", "instance": "c"}, Value: 0},
+		}
+		states = []*querier.ListAlertStatesRow{
+			{RuleID: 1, ClusterID: 101, Series: `up{instance="a"}`, Status: "pending", ActiveAt: activeAt},
+			{RuleID: 1, ClusterID: 101, Series: `up{instance="b"}`, Status: "pending", ActiveAt: now.Add(-time.Minute)},
+			{RuleID: 1, ClusterID: 101, Series: `up{instance="c"}`, Status: "firing", ActiveAt: activeAt, FiredAt: &activeAt},
+		}
+	)
+
+	mockModel := model.NewMockModelInterfaceWithTransaction(ctrl)
+	upserts := map[string]querier.UpsertAlertStateParams{}
+	mockModel.EXPECT().UpsertAlertState(ctx, gomock.Any()).Times(3).DoAndReturn(func(_ context.Context, arg querier.UpsertAlertStateParams) error {
+		upserts[arg.Series] = arg
+		return nil
+	})
+	mockModel.EXPECT().CreateAlertHistory(ctx, querier.CreateAlertHistoryParams{
+		OrgID:     201,
+		RuleID:    &rule.ID,
+		RuleName:  "node down",
+		ClusterID: 101,
+		Status:    "firing",
+		Severity:  "critical",
+		Labels:    apigen.AlertLabels{"instance": "a", "team": "db"},
+		Value:     0,
+		ActiveAt:  activeAt,
+	}).Return(nil)
+	mockModel.EXPECT().CreateClusterEvent(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, arg querier.CreateClusterEventParams) error {
+		assert.Equal(t, string(apigen.AlertFiring), arg.Type)
+		assert.Equal(t, `critical alert node down is firing: up{instance="a"}`, arg.Message)
+		return nil
+	})
+
+	executor := &TaskExecutor{model: mockModel}
+	err := executor.evaluateAlertRule(ctx, rule, 101, 5*time.Minute, vector, states, now)
+	require.NoError(t, err)
+
+	assert.Equal(t, "firing", upserts[`up{instance="a"}`].Status)
+	assert.Equal(t, now, *upserts[`up{instance="a"}`].FiredAt)
+	assert.Equal(t, activeAt, upserts[`up{instance="a"}`].ActiveAt)
+	assert.Equal(t, "pending", upserts[`up{instance="b"}`].Status, "pending for less than the for duration")
+	assert.Equal(t, "firing", upserts[`up{instance="c"}`].Status)
+	assert.Equal(t, activeAt, *upserts[`up{instance="c"}`].FiredAt, "a firing alert fires once")
+	for _, upsert := range upserts {
+		assert.Equal(t, now, upsert.EvaluatedAt)
+	}
+}
+
+func TestExecuteEvaluateAlertRulesRecordsErrors(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	var (
+		ctx   = context.Background()
+		orgID = int32(201)
+		now   = time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+		fired = now.Add(-time.Hour)
+	)
+
+	tooMany := make(prom_model.Vector, maxAlertSeriesPerRule+1)
+	for i := range tooMany {
+		tooMany[i] = &prom_model.Sample{Metric: prom_model.Metric{"instance": prom_model.LabelValue(fmt.Sprintf("%d", i))}}
+	}
+
+	mockModel := model.NewMockModelInterfaceWithTransaction(ctrl)
+	mockModel.EXPECT().ListEnabledAlertRules(ctx).Return([]*querier.AlertRule{
+		{ID: 1, OrgID: orgID, Name: "everything", Expr: "up", ForDuration: "0s", Severity: "info"},
+		// evaluated without an error, the previous error is cleared
+		{ID: 2, OrgID: orgID, Name: "fixed", Expr: "up == 0", ForDuration: "0s", Severity: "info", LastError: utils.Ptr("bad expression")},
+		// the error is not written again
+		{ID: 3, OrgID: orgID, Name: "invalid", Expr: "up == 0", ForDuration: "soon", Severity: "info", LastError: utils.Ptr(`invalid for duration soon: time: invalid duration "soon"`)},
+	}, nil)
+	mockModel.EXPECT().ListAllClusters(ctx).Return([]*querier.Cluster{{ID: 101, OrgID: orgID}}, nil)
+	mockModel.EXPECT().ListAlertStates(ctx).Return([]*querier.ListAlertStatesRow{
+		// the rule returned too many series, kept
+		{RuleID: 1, ClusterID: 101, Series: `up{instance="a"}`, Status: "firing", ActiveAt: fired, FiredAt: &fired, OrgID: orgID, RuleName: "everything", Severity: "info"},
+	}, nil)
+
+	var lastErrors []querier.UpdateAlertRuleLastErrorParams
+	mockModel.EXPECT().UpdateAlertRuleLastError(ctx, gomock.Any()).Times(2).DoAndReturn(func(_ context.Context, arg querier.UpdateAlertRuleLastErrorParams) error {
+		lastErrors = append(lastErrors, arg)
+		return nil
+	})
+
+	executor := &TaskExecutor{
+		model: mockModel,
+		getMetricsConn: func(_ context.Context, _ int32) (metricsstore.MetricsConn, error) {
+			return &fakeMetricsConn{vectors: map[string]prom_model.Vector{"up": tooMany}}, nil
+		},
+		now: func() time.Time { return now },
+	}
+	err := executor.ExecuteEvaluateAlertRules(ctx, &taskgen.EvaluateAlertRulesParameters{})
+	require.NoError(t, err)
+
+	require.Len(t, lastErrors, 2)
+	assert.Equal(t, int32(1), lastErrors[0].ID)
+	require.NotNil(t, lastErrors[0].LastError)
+	assert.Equal(t, "cluster 101: the expression returned 501 series, more than the limit 500", *lastErrors[0].LastError)
+	assert.Equal(t, querier.UpdateAlertRuleLastErrorParams{ID: 2}, lastErrors[1])
+}
+
+func TestExecutePruneAlertHistory(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	currTime := time.Date(2025, 4, 1, 12, 0, 0, 0, time.UTC)
+	mockModel := model.NewMockModelInterface(ctrl)
+	mockModel.EXPECT().DeleteOldAlertHistory(gomock.Any(), time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)).Return(int64(2), nil)
+
+	executor := &TaskExecutor{
+		model: mockModel,
+		now:   func() time.Time { return currTime },
+	}
+	assert.NoError(t, executor.ExecutePruneAlertHistory(context.Background(), &taskgen.PruneAlertHistoryParameters{}))
+}
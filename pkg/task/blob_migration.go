@@ -0,0 +1,110 @@
+package task
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/risingwavelabs/risingwave-console/pkg/blobstore"
+	"github.com/risingwavelabs/risingwave-console/pkg/zcore/model"
+	"github.com/risingwavelabs/risingwave-console/pkg/zgen/querier"
+	"github.com/risingwavelabs/risingwave-console/pkg/zgen/taskgen"
+	"go.uber.org/zap"
+)
+
+// blobMigrationBatchSize is the number of payloads loaded from postgres at a time, the diagnose
+// reports are several MB each.
+const blobMigrationBatchSize = 20
+
+// ExecuteMigrateBlobPayloads moves the diagnose reports and the diagnostic bundles stored in
+// postgres before the blob store was introduced to the blob store. It is a one-time backfill, the
+// new payloads are written to the blob store directly.
+func (e *TaskExecutor) ExecuteMigrateBlobPayloads(ctx context.Context, params *taskgen.MigrateBlobPayloadsParameters) error {
+	diagnostics, err := e.migrateDiagnosticPayloads(ctx)
+	if err != nil {
+		return err
+	}
+	bundles, err := e.migrateDiagnosticBundlePayloads(ctx)
+	if err != nil {
+		return err
+	}
+	if diagnostics > 0 || bundles > 0 {
+		log.Info("payloads moved to the blob store", zap.Int("diagnostics", diagnostics), zap.Int("bundles", bundles))
+	}
+	return nil
+}
+
+func (e *TaskExecutor) migrateDiagnosticPayloads(ctx context.Context) (int, error) {
+	moved := 0
+	for {
+		diags, err := e.model.ListInlineClusterDiagnostics(ctx, blobMigrationBatchSize)
+		if err != nil {
+			return moved, errors.Wrap(err, "failed to list cluster diagnostics")
+		}
+		if len(diags) == 0 {
+			return moved, nil
+		}
+		for _, diag := range diags {
+			obj, err := blobstore.PutCompressed(ctx, e.blobs, blobstore.DiagnosticKey(diag.ClusterID), []byte(*diag.Content))
+			if err != nil {
+				return moved, errors.Wrapf(err, "failed to store diagnose report of diagnostic %d", diag.ID)
+			}
+			if err := e.model.MoveClusterDiagnosticContent(ctx, querier.MoveClusterDiagnosticContentParams{
+				ID:                diag.ID,
+				ContentRef:        &obj.Key,
+				ContentSize:       &obj.Size,
+				ContentStoredSize: &obj.StoredSize,
+				ContentSha256:     &obj.SHA256,
+			}); err != nil {
+				return moved, errors.Wrapf(err, "failed to update diagnostic %d", diag.ID)
+			}
+			moved++
+		}
+	}
+}
+
+func (e *TaskExecutor) migrateDiagnosticBundlePayloads(ctx context.Context) (int, error) {
+	moved := 0
+	for {
+		bundles, err := e.model.ListInlineClusterDiagnosticBundles(ctx, blobMigrationBatchSize)
+		if err != nil {
+			return moved, errors.Wrap(err, "failed to list diagnostic bundles")
+		}
+		if len(bundles) == 0 {
+			return moved, nil
+		}
+		for _, b := range bundles {
+			content, err := e.model.GetClusterDiagnosticBundleContent(ctx, b.ID)
+			if err != nil {
+				return moved, errors.Wrapf(err, "failed to get content of diagnostic bundle %d", b.ID)
+			}
+			key := blobstore.DiagnosticBundleKey(b.ClusterID, b.ID)
+			if err := e.blobs.Put(ctx, key, content); err != nil {
+				return moved, errors.Wrapf(err, "failed to store diagnostic bundle %d", b.ID)
+			}
+			if err := e.model.RunTransaction(ctx, func(txm model.ModelInterface) error {
+				if err := txm.UpdateClusterDiagnosticBundleContentRef(ctx, querier.UpdateClusterDiagnosticBundleContentRefParams{
+					ID:         b.ID,
+					ContentRef: &key,
+				}); err != nil {
+					return err
+				}
+				return txm.DeleteClusterDiagnosticBundleContent(ctx, b.ID)
+			}); err != nil {
+				return moved, errors.Wrapf(err, "failed to update diagnostic bundle %d", b.ID)
+			}
+			moved++
+		}
+	}
+}
+
+// ExecuteDeleteClusterBlobs deletes the blobs of a deleted cluster. The rows referencing them are
+// deleted together with the cluster, the blobs left by failed writes are deleted as well.
+func (e *TaskExecutor) ExecuteDeleteClusterBlobs(ctx context.Context, params *taskgen.DeleteClusterBlobsParameters) error {
+	for _, dir := range blobstore.ClusterDirs(params.ClusterID) {
+		if err := e.blobs.DeleteDir(ctx, dir); err != nil {
+			return errors.Wrapf(err, "failed to delete blobs of cluster %d", params.ClusterID)
+		}
+	}
+	log.Info("cluster blobs deleted", zap.Int32("cluster_id", params.ClusterID))
+	return nil
+}
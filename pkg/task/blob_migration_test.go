@@ -0,0 +1,82 @@
+package task
+
+import (
+	"context"
+	"testing"
+
+	"github.com/risingwavelabs/risingwave-console/pkg/blobstore"
+	"github.com/risingwavelabs/risingwave-console/pkg/utils"
+	"github.com/risingwavelabs/risingwave-console/pkg/zcore/model"
+	"github.com/risingwavelabs/risingwave-console/pkg/zgen/querier"
+	"github.com/risingwavelabs/risingwave-console/pkg/zgen/taskgen"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestExecuteMigrateBlobPayloads(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := context.Background()
+	model := model.NewMockModelInterfaceWithTransaction(ctrl)
+	blobs, err := blobstore.NewLocalStore(t.TempDir())
+	require.NoError(t, err)
+
+	model.EXPECT().ListInlineClusterDiagnostics(gomock.Any(), int32(blobMigrationBatchSize)).Return([]*querier.ClusterDiagnostic{
+		{ID: 1, ClusterID: 101, Content: utils.Ptr("diagnose 1")},
+		{ID: 2, ClusterID: 102, Content: utils.Ptr("diagnose 2")},
+	}, nil)
+	model.EXPECT().ListInlineClusterDiagnostics(gomock.Any(), int32(blobMigrationBatchSize)).Return(nil, nil)
+	moved := map[int32]querier.MoveClusterDiagnosticContentParams{}
+	model.EXPECT().MoveClusterDiagnosticContent(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, arg querier.MoveClusterDiagnosticContentParams) error {
+		moved[arg.ID] = arg
+		return nil
+	}).Times(2)
+
+	model.EXPECT().ListInlineClusterDiagnosticBundles(gomock.Any(), int32(blobMigrationBatchSize)).Return([]*querier.ClusterDiagnosticBundle{
+		{ID: 301, ClusterID: 101},
+	}, nil)
+	model.EXPECT().ListInlineClusterDiagnosticBundles(gomock.Any(), int32(blobMigrationBatchSize)).Return(nil, nil)
+	model.EXPECT().GetClusterDiagnosticBundleContent(gomock.Any(), int32(301)).Return([]byte("bundle"), nil)
+	model.EXPECT().UpdateClusterDiagnosticBundleContentRef(gomock.Any(), querier.UpdateClusterDiagnosticBundleContentRefParams{
+		ID:         301,
+		ContentRef: utils.Ptr("diagnostic-bundles/101/301.tar.gz"),
+	}).Return(nil)
+	model.EXPECT().DeleteClusterDiagnosticBundleContent(gomock.Any(), int32(301)).Return(nil)
+
+	executor := &TaskExecutor{model: model, blobs: blobs}
+	err = executor.ExecuteMigrateBlobPayloads(ctx, &taskgen.MigrateBlobPayloadsParameters{})
+	require.NoError(t, err)
+
+	require.Len(t, moved, 2)
+	for id, expected := range map[int32]string{1: "diagnose 1", 2: "diagnose 2"} {
+		arg := moved[id]
+		assert.Equal(t, int64(len(expected)), *arg.ContentSize)
+		content, err := blobstore.GetCompressed(ctx, blobs, *arg.ContentRef, *arg.ContentSha256)
+		require.NoError(t, err)
+		assert.Equal(t, expected, string(content))
+	}
+	bundle, err := blobs.Get(ctx, "diagnostic-bundles/101/301.tar.gz")
+	require.NoError(t, err)
+	assert.Equal(t, []byte("bundle"), bundle)
+}
+
+func TestExecuteDeleteClusterBlobs(t *testing.T) {
+	ctx := context.Background()
+	blobs, err := blobstore.NewLocalStore(t.TempDir())
+	require.NoError(t, err)
+	for _, key := range []string{"diagnostics/101/a.txt.gz", "diagnostic-bundles/101/301.tar.gz", "diagnostics/1010/b.txt.gz"} {
+		require.NoError(t, blobs.Put(ctx, key, []byte("x")))
+	}
+
+	executor := &TaskExecutor{blobs: blobs}
+	require.NoError(t, executor.ExecuteDeleteClusterBlobs(ctx, &taskgen.DeleteClusterBlobsParameters{ClusterID: 101}))
+
+	_, err = blobs.Get(ctx, "diagnostics/101/a.txt.gz")
+	assert.ErrorIs(t, err, blobstore.ErrNotFound)
+	_, err = blobs.Get(ctx, "diagnostic-bundles/101/301.tar.gz")
+	assert.ErrorIs(t, err, blobstore.ErrNotFound)
+	_, err = blobs.Get(ctx, "diagnostics/1010/b.txt.gz")
+	assert.NoError(t, err)
+}
@@ -0,0 +1,24 @@
+package task
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/risingwavelabs/risingwave-console/pkg/zgen/taskgen"
+	"go.uber.org/zap"
+)
+
+// clusterEventRetention is how long the cluster events are kept, e.g. the alerts and the
+// diagnostic findings reported
+const clusterEventRetention = 90 * 24 * time.Hour
+
+// ExecutePruneClusterEvents deletes the cluster events recorded before the retention.
+func (e *TaskExecutor) ExecutePruneClusterEvents(ctx context.Context, params *taskgen.PruneClusterEventsParameters) error {
+	deleted, err := e.model.DeleteOldClusterEvents(ctx, e.now().Add(-clusterEventRetention))
+	if err != nil {
+		return errors.Wrap(err, "failed to delete old cluster events")
+	}
+	log.Info("cluster events pruned", zap.Int64("deleted", deleted))
+	return nil
+}
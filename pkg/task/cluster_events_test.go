@@ -0,0 +1,27 @@
+package task
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/risingwavelabs/risingwave-console/pkg/zcore/model"
+	"github.com/risingwavelabs/risingwave-console/pkg/zgen/taskgen"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestExecutePruneClusterEvents(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	currTime := time.Date(2025, 4, 1, 12, 0, 0, 0, time.UTC)
+	model := model.NewMockModelInterface(ctrl)
+	model.EXPECT().DeleteOldClusterEvents(gomock.Any(), time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)).Return(int64(5), nil)
+
+	executor := &TaskExecutor{
+		model: model,
+		now:   func() time.Time { return currTime },
+	}
+	assert.NoError(t, executor.ExecutePruneClusterEvents(context.Background(), &taskgen.PruneClusterEventsParameters{}))
+}
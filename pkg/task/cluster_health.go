@@ -0,0 +1,230 @@
+package task
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/pkg/errors"
+	"github.com/risingwavelabs/risingwave-console/pkg/conn/meta"
+	"github.com/risingwavelabs/risingwave-console/pkg/zcore/model"
+	"github.com/risingwavelabs/risingwave-console/pkg/zgen/apigen"
+	"github.com/risingwavelabs/risingwave-console/pkg/zgen/querier"
+	"github.com/risingwavelabs/risingwave-console/pkg/zgen/taskgen"
+	"go.uber.org/zap"
+	"golang.org/x/sync/errgroup"
+)
+
+const (
+	// clusterHealthCheckTimeout bounds every check of a cluster
+	clusterHealthCheckTimeout = 5 * time.Second
+
+	// clusterHealthCheckConcurrency is the number of clusters checked at the same time
+	clusterHealthCheckConcurrency = 8
+
+	// clusterHealthRecordRetention is how long the health records are kept, the latest record
+	// of a cluster is kept regardless
+	clusterHealthRecordRetention = 30 * 24 * time.Hour
+)
+
+// the reasons of the health records are stable codes, so that a failure is recorded once
+// however its error changes
+const (
+	healthReasonMetaUnreachable       = "meta_unreachable"
+	healthReasonSQLUnreachable        = "sql_unreachable"
+	healthReasonHTTPUnreachable       = "http_unreachable"
+	healthReasonSQLQueryFailed        = "sql_query_failed"
+	healthReasonMetaHTTPNotResponding = "meta_http_not_responding"
+
+	// the notes are recorded along with the reasons but leave the status as it is
+	healthReasonSQLAuthNotChecked = "sql_auth_not_checked"
+	healthReasonVersionMismatch   = "version_mismatch"
+)
+
+func isHealthNote(reason string) bool {
+	return reason == healthReasonSQLAuthNotChecked || reason == healthReasonVersionMismatch
+}
+
+// clusterHealth is the result of the health checks of a cluster, the reasons are the checks
+// that failed and the notes, the details are their errors.
+type clusterHealth struct {
+	status  apigen.ClusterHealthStatus
+	reasons []string
+	details apigen.ClusterHealthDetails
+}
+
+func (h *clusterHealth) fail(reason string, detail string) {
+	h.note(reason, detail)
+}
+
+func (h *clusterHealth) note(reason string, detail string) {
+	h.reasons = append(h.reasons, reason)
+	if h.details == nil {
+		h.details = apigen.ClusterHealthDetails{}
+	}
+	h.details[reason] = detail
+}
+
+func (e *TaskExecutor) ExecuteCheckClusterHealth(ctx context.Context, params *taskgen.CheckClusterHealthParameters) error {
+	clusters, err := e.model.ListAllClusters(ctx)
+	if err != nil {
+		return errors.Wrap(err, "failed to list clusters")
+	}
+
+	var g errgroup.Group
+	g.SetLimit(clusterHealthCheckConcurrency)
+	for _, cluster := range clusters {
+		g.Go(func() error {
+			// one cluster failing to record its health should not block the others
+			health := e.checkClusterHealth(ctx, cluster)
+			if err := e.recordClusterHealth(ctx, cluster, health); err != nil {
+				log.Error(
+					"failed to record cluster health",
+					zap.Int32("cluster_id", cluster.ID),
+					zap.Error(err),
+				)
+			}
+			return nil
+		})
+	}
+	return g.Wait()
+}
+
+// checkClusterHealth checks the reachability of the endpoints of the cluster, then the SQL
+// authentication and the meta HTTP API through the reachable ones. The cluster is down if none
+// of the endpoints is reachable and degraded if any check fails. The SQL authentication left
+// unchecked for the lack of a database and a version reported by the cluster other than its
+// version are noted without degrading the cluster, updating the version is left to the version
+// detection.
+func (e *TaskExecutor) checkClusterHealth(ctx context.Context, cluster *querier.Cluster) clusterHealth {
+	var health clusterHealth
+	reachable := map[string]bool{}
+	for _, endpoint := range []struct {
+		name   string
+		port   int32
+		reason string
+	}{
+		{name: "meta", port: cluster.MetaPort, reason: healthReasonMetaUnreachable},
+		{name: "sql", port: cluster.SqlPort, reason: healthReasonSQLUnreachable},
+		{name: "http", port: cluster.HttpPort, reason: healthReasonHTTPUnreachable},
+	} {
+		if err := e.testTCPConnection(ctx, cluster.Host, endpoint.port, clusterHealthCheckTimeout); err != nil {
+			health.fail(endpoint.reason, fmt.Sprintf("%s port %d is unreachable: %s", endpoint.name, endpoint.port, err.Error()))
+			continue
+		}
+		reachable[endpoint.name] = true
+	}
+	if len(reachable) == 0 {
+		health.status = apigen.Down
+		return health
+	}
+
+	// the SQL check needs the credentials of a database of the cluster
+	if reachable["sql"] {
+		version, ok, err := e.probeClusterVersion(ctx, cluster)
+		switch {
+		case err != nil:
+			health.fail(healthReasonSQLQueryFailed, fmt.Sprintf("failed to query through SQL: %s", err.Error()))
+		case !ok:
+			health.note(healthReasonSQLAuthNotChecked, "SQL authentication is not checked, the cluster has no database to log in with")
+		case !meta.SameVersion(version, cluster.Version):
+			health.note(healthReasonVersionMismatch, fmt.Sprintf("version %s detected, but the version of the cluster is %s", version, cluster.Version))
+		}
+	}
+
+	if reachable["http"] {
+		pingCtx, cancel := context.WithTimeout(ctx, clusterHealthCheckTimeout)
+		err := e.metahttp.Ping(pingCtx, fmt.Sprintf("http://%s:%d", cluster.Host, cluster.HttpPort))
+		cancel()
+		if err != nil {
+			health.fail(healthReasonMetaHTTPNotResponding, fmt.Sprintf("meta HTTP API is not responding: %s", err.Error()))
+		}
+	}
+
+	if slices.ContainsFunc(health.reasons, func(reason string) bool { return !isHealthNote(reason) }) {
+		health.status = apigen.Degraded
+	} else {
+		health.status = apigen.Healthy
+	}
+	return health
+}
+
+// recordClusterHealth updates the status of the cluster and records it in the timeline once the
+// status or the reasons change. The change of the status is reported as a cluster event, the
+// first check is only reported if the cluster is not healthy.
+func (e *TaskExecutor) recordClusterHealth(ctx context.Context, cluster *querier.Cluster, health clusterHealth) error {
+	now := e.now()
+	var lastSeenAt *time.Time
+	if health.status != apigen.Down {
+		lastSeenAt = &now
+	}
+	if err := e.model.UpdateClusterHealth(ctx, querier.UpdateClusterHealthParams{
+		ID:              cluster.ID,
+		HealthStatus:    (*string)(&health.status),
+		HealthCheckedAt: &now,
+		LastSeenAt:      lastSeenAt,
+	}); err != nil {
+		return errors.Wrap(err, "failed to update cluster health")
+	}
+
+	latest, err := e.model.GetLatestClusterHealthRecord(ctx, cluster.ID)
+	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		return errors.Wrap(err, "failed to get latest cluster health record")
+	}
+	if err == nil && latest.Status == string(health.status) && slices.Equal(latest.Reasons, health.reasons) {
+		return nil
+	}
+
+	reasons := health.reasons
+	if reasons == nil {
+		reasons = []string{}
+	}
+	details := health.details
+	if details == nil {
+		details = apigen.ClusterHealthDetails{}
+	}
+	if err := e.model.CreateClusterHealthRecord(ctx, querier.CreateClusterHealthRecordParams{
+		ClusterID: cluster.ID,
+		Status:    string(health.status),
+		Reasons:   reasons,
+		Details:   details,
+	}); err != nil {
+		return errors.Wrap(err, "failed to create cluster health record")
+	}
+
+	previous := cluster.HealthStatus
+	if (previous == nil && health.status != apigen.Healthy) || (previous != nil && *previous != string(health.status)) {
+		message := fmt.Sprintf("cluster is %s", health.status)
+		if len(health.reasons) > 0 {
+			errs := make([]string, len(health.reasons))
+			for i, reason := range health.reasons {
+				errs[i] = details[reason]
+			}
+			message = fmt.Sprintf("%s: %s", message, strings.Join(errs, "; "))
+		}
+		eventDetails := apigen.ClusterEventDetails{
+			"status":  health.status,
+			"reasons": reasons,
+			"details": details,
+		}
+		if previous != nil {
+			eventDetails["previousStatus"] = *previous
+		}
+		model.RecordClusterEvent(ctx, e.model, cluster.ID, apigen.HealthChanged, message, eventDetails)
+	}
+	return nil
+}
+
+// ExecutePruneClusterHealthRecords deletes the health records older than the retention, the
+// latest record of every cluster is kept as the base of the next change.
+func (e *TaskExecutor) ExecutePruneClusterHealthRecords(ctx context.Context, params *taskgen.PruneClusterHealthRecordsParameters) error {
+	deleted, err := e.model.DeleteOldClusterHealthRecords(ctx, e.now().Add(-clusterHealthRecordRetention))
+	if err != nil {
+		return errors.Wrap(err, "failed to delete old cluster health records")
+	}
+	log.Info("cluster health records pruned", zap.Int64("deleted", deleted))
+	return nil
+}
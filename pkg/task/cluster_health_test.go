@@ -0,0 +1,262 @@
+package task
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	mock_http "github.com/risingwavelabs/risingwave-console/pkg/conn/http/mock"
+	"github.com/risingwavelabs/risingwave-console/pkg/utils"
+	"github.com/risingwavelabs/risingwave-console/pkg/zcore/model"
+	"github.com/risingwavelabs/risingwave-console/pkg/zgen/apigen"
+	"github.com/risingwavelabs/risingwave-console/pkg/zgen/querier"
+	"github.com/risingwavelabs/risingwave-console/pkg/zgen/taskgen"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestCheckClusterHealth(t *testing.T) {
+	var (
+		orgID     = int32(201)
+		clusterID = int32(101)
+	)
+
+	testCases := []struct {
+		name        string
+		unreachable []int32
+		noDatabase  bool
+		versionErr  error
+		version     string
+		pingErr     error
+		expected    clusterHealth
+	}{
+		{
+			name:     "healthy",
+			version:  "v2.3.0",
+			expected: clusterHealth{status: apigen.Healthy},
+		},
+		{
+			name:        "down",
+			unreachable: []int32{5690, 4566, 5691},
+			expected: clusterHealth{
+				status:  apigen.Down,
+				reasons: []string{"meta_unreachable", "sql_unreachable", "http_unreachable"},
+				details: apigen.ClusterHealthDetails{
+					"meta_unreachable": "meta port 5690 is unreachable: connection refused",
+					"sql_unreachable":  "sql port 4566 is unreachable: connection refused",
+					"http_unreachable": "http port 5691 is unreachable: connection refused",
+				},
+			},
+		},
+		{
+			name:        "sql unreachable",
+			unreachable: []int32{4566},
+			expected: clusterHealth{
+				status:  apigen.Degraded,
+				reasons: []string{"sql_unreachable"},
+				details: apigen.ClusterHealthDetails{"sql_unreachable": "sql port 4566 is unreachable: connection refused"},
+			},
+		},
+		{
+			name:       "authentication failed",
+			versionErr: errors.New("password authentication failed"),
+			expected: clusterHealth{
+				status:  apigen.Degraded,
+				reasons: []string{"sql_query_failed"},
+				details: apigen.ClusterHealthDetails{
+					"sql_query_failed": "failed to query through SQL: failed to get cluster version: failed to query through database 1: password authentication failed",
+				},
+			},
+		},
+		{
+			// there are no credentials to check the SQL authentication with
+			name:       "no database",
+			noDatabase: true,
+			expected: clusterHealth{
+				status:  apigen.Healthy,
+				reasons: []string{"sql_auth_not_checked"},
+				details: apigen.ClusterHealthDetails{"sql_auth_not_checked": "SQL authentication is not checked, the cluster has no database to log in with"},
+			},
+		},
+		{
+			name:    "version mismatch is not degraded",
+			version: "v2.4.0",
+			expected: clusterHealth{
+				status:  apigen.Healthy,
+				reasons: []string{"version_mismatch"},
+				details: apigen.ClusterHealthDetails{"version_mismatch": "version v2.4.0 detected, but the version of the cluster is v2.3.0"},
+			},
+		},
+		{
+			name:    "meta HTTP API not responding",
+			version: "v2.3.0",
+			pingErr: errors.New("unexpected status 502 Bad Gateway"),
+			expected: clusterHealth{
+				status:  apigen.Degraded,
+				reasons: []string{"meta_http_not_responding"},
+				details: apigen.ClusterHealthDetails{"meta_http_not_responding": "meta HTTP API is not responding: unexpected status 502 Bad Gateway"},
+			},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			model := model.NewMockModelInterface(ctrl)
+			metahttp := mock_http.NewMockMetaHttpManagerInterface(ctrl)
+
+			cluster := &querier.Cluster{
+				ID:       clusterID,
+				OrgID:    orgID,
+				Host:     "rw",
+				SqlPort:  4566,
+				MetaPort: 5690,
+				HttpPort: 5691,
+				Version:  "v2.3.0",
+			}
+
+			var dbs []*querier.DatabaseConnection
+			if !tc.noDatabase {
+				dbs = []*querier.DatabaseConnection{{ID: 1, Username: "root", Database: "dev"}}
+			}
+			model.EXPECT().GetAllOrgDatabseConnectionsByClusterID(gomock.Any(), gomock.Any()).Return(dbs, nil).AnyTimes()
+			metahttp.EXPECT().Ping(gomock.Any(), "http://rw:5691").Return(tc.pingErr).AnyTimes()
+
+			executor := &TaskExecutor{
+				model:    model,
+				metahttp: metahttp,
+				testTCPConnection: func(_ context.Context, host string, port int32, _ time.Duration) error {
+					for _, p := range tc.unreachable {
+						if p == port {
+							return errors.New("connection refused")
+						}
+					}
+					return nil
+				},
+				getClusterVersion: func(_ context.Context, _ string) (string, error) {
+					return tc.version, tc.versionErr
+				},
+			}
+
+			assert.Equal(t, tc.expected, executor.checkClusterHealth(context.Background(), cluster))
+		})
+	}
+}
+
+func TestRecordClusterHealth(t *testing.T) {
+	var (
+		clusterID = int32(101)
+		currTime  = time.Date(2025, 1, 10, 12, 0, 0, 0, time.UTC)
+	)
+
+	testCases := []struct {
+		name     string
+		previous *string
+		latest   *querier.ClusterHealthRecord
+		health   clusterHealth
+		record   bool
+		event    bool
+	}{
+		{
+			name:   "first check healthy",
+			health: clusterHealth{status: apigen.Healthy},
+			record: true,
+		},
+		{
+			name:   "first check down",
+			health: clusterHealth{status: apigen.Down, reasons: []string{"meta_unreachable"}, details: apigen.ClusterHealthDetails{"meta_unreachable": "connection refused"}},
+			record: true,
+			event:  true,
+		},
+		{
+			name:     "unchanged",
+			previous: utils.Ptr("degraded"),
+			latest:   &querier.ClusterHealthRecord{Status: "degraded", Reasons: []string{"meta_http_not_responding"}},
+			health: clusterHealth{
+				status:  apigen.Degraded,
+				reasons: []string{"meta_http_not_responding"},
+				details: apigen.ClusterHealthDetails{"meta_http_not_responding": "meta HTTP API is not responding: context deadline exceeded"},
+			},
+		},
+		{
+			name:     "reasons changed",
+			previous: utils.Ptr("degraded"),
+			latest:   &querier.ClusterHealthRecord{Status: "degraded", Reasons: []string{"meta_http_not_responding"}},
+			health:   clusterHealth{status: apigen.Degraded, reasons: []string{"sql_query_failed"}},
+			record:   true,
+		},
+		{
+			name:     "status changed",
+			previous: utils.Ptr("down"),
+			latest:   &querier.ClusterHealthRecord{Status: "down", Reasons: []string{"meta_unreachable"}},
+			health:   clusterHealth{status: apigen.Healthy},
+			record:   true,
+			event:    true,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			model := model.NewMockModelInterface(ctrl)
+
+			var lastSeenAt *time.Time
+			if tc.health.status != apigen.Down {
+				lastSeenAt = &currTime
+			}
+			model.EXPECT().UpdateClusterHealth(gomock.Any(), querier.UpdateClusterHealthParams{
+				ID:              clusterID,
+				HealthStatus:    utils.Ptr(string(tc.health.status)),
+				HealthCheckedAt: &currTime,
+				LastSeenAt:      lastSeenAt,
+			}).Return(nil)
+			if tc.latest != nil {
+				model.EXPECT().GetLatestClusterHealthRecord(gomock.Any(), clusterID).Return(tc.latest, nil)
+			} else {
+				model.EXPECT().GetLatestClusterHealthRecord(gomock.Any(), clusterID).Return(nil, pgx.ErrNoRows)
+			}
+			if tc.record {
+				model.EXPECT().CreateClusterHealthRecord(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, arg querier.CreateClusterHealthRecordParams) error {
+					assert.Equal(t, string(tc.health.status), arg.Status)
+					assert.NotNil(t, arg.Reasons)
+					assert.NotNil(t, arg.Details)
+					return nil
+				})
+			}
+			if tc.event {
+				model.EXPECT().CreateClusterEvent(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, arg querier.CreateClusterEventParams) error {
+					assert.Equal(t, "health_changed", arg.Type)
+					assert.Equal(t, tc.health.status, arg.Details["status"])
+					return nil
+				})
+			}
+
+			executor := &TaskExecutor{
+				model: model,
+				now:   func() time.Time { return currTime },
+			}
+
+			err := executor.recordClusterHealth(context.Background(), &querier.Cluster{ID: clusterID, HealthStatus: tc.previous}, tc.health)
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestExecutePruneClusterHealthRecords(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	currTime := time.Date(2025, 1, 31, 12, 0, 0, 0, time.UTC)
+	model := model.NewMockModelInterface(ctrl)
+	model.EXPECT().DeleteOldClusterHealthRecords(gomock.Any(), time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)).Return(int64(3), nil)
+
+	executor := &TaskExecutor{
+		model: model,
+		now:   func() time.Time { return currTime },
+	}
+	assert.NoError(t, executor.ExecutePruneClusterHealthRecords(context.Background(), &taskgen.PruneClusterHealthRecordsParameters{}))
+}
@@ -0,0 +1,229 @@
+package task
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/pkg/errors"
+	"github.com/risingwavelabs/risingwave-console/pkg/conn/meta"
+	"github.com/risingwavelabs/risingwave-console/pkg/utils"
+	"github.com/risingwavelabs/risingwave-console/pkg/zcore/model"
+	"github.com/risingwavelabs/risingwave-console/pkg/zgen/apigen"
+	"github.com/risingwavelabs/risingwave-console/pkg/zgen/querier"
+	"github.com/risingwavelabs/risingwave-console/pkg/zgen/taskgen"
+	"go.uber.org/zap"
+)
+
+// clusterUpgradePollInterval is how often the version of the cluster is checked while waiting
+// for the operator to upgrade it.
+const clusterUpgradePollInterval = 30 * time.Second
+
+// errClusterUpgradeFinished stops the task once the upgrade is cancelled.
+var errClusterUpgradeFinished = errors.New("cluster upgrade has already finished")
+
+// ExecuteClusterUpgrade runs the remaining steps of the upgrade: take the pre-upgrade snapshot,
+// wait for the cluster to report the target version, update the version of the cluster and
+// diagnose it. Every step is recorded as a cluster event, the upgrade is marked as failed once
+// a step fails.
+func (e *TaskExecutor) ExecuteClusterUpgrade(ctx context.Context, params *taskgen.ClusterUpgradeParameters) error {
+	upgrade, err := e.model.GetClusterUpgrade(ctx, params.UpgradeID)
+	if err != nil {
+		return errors.Wrap(err, "failed to get cluster upgrade")
+	}
+	if upgrade.FinishedAt != nil {
+		return nil
+	}
+
+	if err := e.runClusterUpgrade(ctx, upgrade); err != nil {
+		if errors.Is(err, errClusterUpgradeFinished) {
+			log.Info("cluster upgrade finished by others", zap.Int32("upgrade_id", upgrade.ID))
+			return nil
+		}
+		// the context may be done because of the task timeout
+		ctx := context.WithoutCancel(ctx)
+		if _, ferr := e.model.FinishClusterUpgrade(ctx, querier.FinishClusterUpgradeParams{
+			ID:     upgrade.ID,
+			Status: string(apigen.ClusterUpgradeStatusFailed),
+			Error:  utils.Ptr(err.Error()),
+		}); ferr != nil {
+			if errors.Is(ferr, pgx.ErrNoRows) {
+				return nil
+			}
+			return errors.Wrapf(ferr, "failed to mark cluster upgrade as failed: %s", err.Error())
+		}
+		model.RecordClusterEvent(ctx, e.model, upgrade.ClusterID, apigen.UpgradeFailed, fmt.Sprintf("upgrade to %s failed: %s", upgrade.TargetVersion, err.Error()), apigen.ClusterEventDetails{
+			"upgradeID": upgrade.ID,
+			"error":     err.Error(),
+		})
+		return err
+	}
+	return nil
+}
+
+func (e *TaskExecutor) runClusterUpgrade(ctx context.Context, upgrade *querier.ClusterUpgrade) error {
+	cluster, err := e.model.GetClusterByID(ctx, upgrade.ClusterID)
+	if err != nil {
+		return errors.Wrap(err, "failed to get cluster")
+	}
+
+	// the steps are resumed from the status if the task is run again
+	status := apigen.ClusterUpgradeStatus(upgrade.Status)
+	if status == apigen.ClusterUpgradeStatusPending {
+		if err := e.createPreUpgradeSnapshot(ctx, cluster, upgrade); err != nil {
+			return err
+		}
+		status = apigen.ClusterUpgradeStatusWaiting
+	}
+
+	if status == apigen.ClusterUpgradeStatusWaiting {
+		version, err := e.waitForClusterVersion(ctx, cluster, upgrade)
+		if err != nil {
+			return err
+		}
+		if err := e.updateClusterVersion(ctx, cluster, version, apigen.ClusterVersionSourceUpgrade); err != nil {
+			return err
+		}
+		if err := e.model.UpdateClusterUpgradeDetectedVersion(ctx, querier.UpdateClusterUpgradeDetectedVersionParams{
+			ID:              upgrade.ID,
+			DetectedVersion: &version,
+		}); err != nil {
+			return errors.Wrap(err, "failed to update cluster upgrade")
+		}
+		model.RecordClusterEvent(ctx, e.model, cluster.ID, apigen.UpgradeVersionDetected, fmt.Sprintf("version %s detected, the version of the cluster is updated", version), apigen.ClusterEventDetails{
+			"upgradeID":   upgrade.ID,
+			"fromVersion": cluster.Version,
+			"version":     version,
+		})
+	}
+
+	// the upgrade is done once the version is updated, a failed diagnostic is only reported
+	var diagnosticID *int32
+	var diagnosticErr *string
+	diag, err := e.createPostUpgradeDiagnostic(ctx, cluster)
+	if err != nil {
+		diagnosticErr = utils.Ptr(fmt.Sprintf("failed to create post-upgrade diagnostic: %s", err.Error()))
+	} else {
+		diagnosticID = &diag.ID
+		model.RecordClusterEvent(ctx, e.model, cluster.ID, apigen.UpgradeDiagnosticCreated, "post-upgrade diagnostic created", apigen.ClusterEventDetails{
+			"upgradeID":    upgrade.ID,
+			"diagnosticID": diag.ID,
+		})
+	}
+
+	finished, err := e.model.FinishClusterUpgrade(ctx, querier.FinishClusterUpgradeParams{
+		ID:           upgrade.ID,
+		Status:       string(apigen.ClusterUpgradeStatusCompleted),
+		DiagnosticID: diagnosticID,
+		Error:        diagnosticErr,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return errClusterUpgradeFinished
+		}
+		return errors.Wrap(err, "failed to finish cluster upgrade")
+	}
+
+	details := apigen.ClusterEventDetails{
+		"upgradeID": upgrade.ID,
+		"version":   utils.UnwrapOrDefault(finished.DetectedVersion, ""),
+	}
+	if diagnosticErr != nil {
+		details["error"] = *diagnosticErr
+	}
+	model.RecordClusterEvent(ctx, e.model, cluster.ID, apigen.UpgradeCompleted, fmt.Sprintf("upgrade to %s completed", upgrade.TargetVersion), details)
+	return nil
+}
+
+// createPreUpgradeSnapshot takes the snapshot to restore if the upgrade goes wrong, it is not
+// pruned by the retention policy.
+func (e *TaskExecutor) createPreUpgradeSnapshot(ctx context.Context, cluster *querier.Cluster, upgrade *querier.ClusterUpgrade) error {
+	conn, err := e.risectlm.NewConn(ctx, cluster.Version, cluster.Host, cluster.MetaPort, meta.WithVersionOverride(cluster.RisectlVersion))
+	if err != nil {
+		return errors.Wrap(err, "failed to get risectl connection")
+	}
+	snapshotID, err := conn.MetaBackup(ctx)
+	if err != nil {
+		return errors.Wrap(err, "failed to create pre-upgrade snapshot")
+	}
+
+	name := fmt.Sprintf("pre-upgrade-%s-%s", upgrade.TargetVersion, e.now().Format("2006-01-02-15-04-05"))
+	if err := e.model.CreateClusterSnapshot(ctx, querier.CreateClusterSnapshotParams{
+		ClusterID:  cluster.ID,
+		SnapshotID: snapshotID,
+		Name:       name,
+		Source:     string(apigen.SnapshotSourceUpgrade),
+	}); err != nil {
+		return errors.Wrap(err, "failed to record pre-upgrade snapshot")
+	}
+	if err := e.model.UpdateClusterUpgradeSnapshot(ctx, querier.UpdateClusterUpgradeSnapshotParams{
+		ID:         upgrade.ID,
+		SnapshotID: &snapshotID,
+	}); err != nil {
+		return errors.Wrap(err, "failed to update cluster upgrade")
+	}
+
+	model.RecordClusterEvent(ctx, e.model, cluster.ID, apigen.UpgradeSnapshotCreated, fmt.Sprintf("pre-upgrade snapshot %d created, waiting for the cluster to be upgraded to %s", snapshotID, upgrade.TargetVersion), apigen.ClusterEventDetails{
+		"upgradeID":  upgrade.ID,
+		"snapshotID": snapshotID,
+		"name":       name,
+	})
+	return nil
+}
+
+// waitForClusterVersion polls the version of the cluster until the target version is reported
+// or the deadline is passed. The cluster is unreachable for a while during the upgrade, so the
+// errors are only reported if the deadline is passed.
+func (e *TaskExecutor) waitForClusterVersion(ctx context.Context, cluster *querier.Cluster, upgrade *querier.ClusterUpgrade) (string, error) {
+	var (
+		lastVersion string
+		lastErr     error
+	)
+	for {
+		current, err := e.model.GetClusterUpgrade(ctx, upgrade.ID)
+		if err != nil {
+			return "", errors.Wrap(err, "failed to get cluster upgrade")
+		}
+		if current.FinishedAt != nil {
+			return "", errClusterUpgradeFinished
+		}
+
+		var version string
+		ok, err := e.queryCluster(ctx, cluster, func(connStr string) (err error) {
+			version, err = e.getClusterVersion(ctx, connStr)
+			return err
+		})
+		if err != nil {
+			lastErr = err
+		} else if !ok {
+			return "", errors.New("the cluster has no database to detect its version")
+		} else if meta.SameVersion(version, upgrade.TargetVersion) {
+			return version, nil
+		} else {
+			lastVersion = version
+			lastErr = nil
+		}
+
+		if !e.now().Before(upgrade.DeadlineAt) {
+			if lastErr != nil {
+				return "", errors.Wrapf(lastErr, "the cluster is not upgraded to %s before the deadline", upgrade.TargetVersion)
+			}
+			return "", errors.Errorf("the cluster is not upgraded to %s before the deadline, the detected version is %s", upgrade.TargetVersion, lastVersion)
+		}
+
+		select {
+		case <-ctx.Done():
+			return "", ctx.Err()
+		case <-time.After(e.upgradePollInterval):
+		}
+	}
+}
+
+func (e *TaskExecutor) createPostUpgradeDiagnostic(ctx context.Context, cluster *querier.Cluster) (*querier.ClusterDiagnostic, error) {
+	content, err := e.metahttp.GetDiagnose(ctx, fmt.Sprintf("http://%s:%d", cluster.Host, cluster.HttpPort))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get diagnose")
+	}
+	return e.createClusterDiagnostic(ctx, cluster.ID, content)
+}
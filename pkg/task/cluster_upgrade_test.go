@@ -0,0 +1,231 @@
+package task
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/risingwavelabs/risingwave-console/pkg/blobstore"
+	mock_http "github.com/risingwavelabs/risingwave-console/pkg/conn/http/mock"
+	mock_meta "github.com/risingwavelabs/risingwave-console/pkg/conn/meta/mock"
+	"github.com/risingwavelabs/risingwave-console/pkg/utils"
+	"github.com/risingwavelabs/risingwave-console/pkg/zcore/model"
+	"github.com/risingwavelabs/risingwave-console/pkg/zgen/querier"
+	"github.com/risingwavelabs/risingwave-console/pkg/zgen/taskgen"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestExecuteClusterUpgrade(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	var (
+		orgID        = int32(201)
+		clusterID    = int32(101)
+		upgradeID    = int32(301)
+		snapshotID   = int64(7)
+		diagnosticID = int32(401)
+		currTime     = time.Date(2025, 1, 10, 12, 0, 0, 0, time.UTC)
+	)
+
+	model := model.NewMockModelInterfaceWithTransaction(ctrl)
+	risectlm := mock_meta.NewMockRisectlManagerInterface(ctrl)
+	risectlcm := mock_meta.NewMockRisectlConn(ctrl)
+	metahttp := mock_http.NewMockMetaHttpManagerInterface(ctrl)
+
+	upgrade := &querier.ClusterUpgrade{
+		ID:            upgradeID,
+		ClusterID:     clusterID,
+		FromVersion:   "v2.2.1",
+		TargetVersion: "v2.3.0",
+		Status:        "pending",
+		DeadlineAt:    currTime.Add(time.Hour),
+	}
+	cluster := &querier.Cluster{
+		ID:       clusterID,
+		OrgID:    orgID,
+		Host:     "rw",
+		SqlPort:  4566,
+		HttpPort: 5691,
+		Version:  "v2.2.1",
+	}
+
+	model.EXPECT().GetClusterUpgrade(gomock.Any(), upgradeID).Return(upgrade, nil).Times(3)
+	model.EXPECT().GetClusterByID(gomock.Any(), clusterID).Return(cluster, nil)
+
+	// pre-upgrade snapshot
+	risectlm.EXPECT().NewConn(gomock.Any(), "v2.2.1", "rw", gomock.Any(), gomock.Any()).Return(risectlcm, nil)
+	risectlcm.EXPECT().MetaBackup(gomock.Any()).Return(snapshotID, nil)
+	model.EXPECT().CreateClusterSnapshot(gomock.Any(), querier.CreateClusterSnapshotParams{
+		ClusterID:  clusterID,
+		SnapshotID: snapshotID,
+		Name:       "pre-upgrade-v2.3.0-2025-01-10-12-00-00",
+		Source:     "upgrade",
+	}).Return(nil)
+	model.EXPECT().UpdateClusterUpgradeSnapshot(gomock.Any(), querier.UpdateClusterUpgradeSnapshotParams{
+		ID:         upgradeID,
+		SnapshotID: &snapshotID,
+	}).Return(nil)
+
+	// the old version is reported by the first poll
+	model.EXPECT().GetAllOrgDatabseConnectionsByClusterID(gomock.Any(), querier.GetAllOrgDatabseConnectionsByClusterIDParams{
+		ClusterID: clusterID,
+		OrgID:     orgID,
+	}).Return([]*querier.DatabaseConnection{{ID: 1, Username: "root", Database: "dev"}}, nil).Times(2)
+	model.EXPECT().UpdateClusterVersion(gomock.Any(), querier.UpdateClusterVersionParams{
+		ID:      clusterID,
+		Version: "v2.3.0",
+	}).Return(nil)
+	model.EXPECT().CreateClusterVersionHistory(gomock.Any(), querier.CreateClusterVersionHistoryParams{
+		ClusterID:       clusterID,
+		Version:         "v2.3.0",
+		PreviousVersion: utils.Ptr("v2.2.1"),
+		Source:          "upgrade",
+	}).Return(nil)
+	model.EXPECT().UpdateClusterUpgradeDetectedVersion(gomock.Any(), querier.UpdateClusterUpgradeDetectedVersionParams{
+		ID:              upgradeID,
+		DetectedVersion: utils.Ptr("v2.3.0"),
+	}).Return(nil)
+
+	// post-upgrade diagnostic
+	metahttp.EXPECT().GetDiagnose(gomock.Any(), "http://rw:5691").Return("diagnose", nil)
+	var diagnostic querier.CreateClusterDiagnosticParams
+	model.EXPECT().CreateClusterDiagnostic(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, arg querier.CreateClusterDiagnosticParams) (*querier.ClusterDiagnostic, error) {
+		diagnostic = arg
+		return &querier.ClusterDiagnostic{ID: diagnosticID}, nil
+	})
+	model.EXPECT().CreateClusterDiagnosticSearch(gomock.Any(), querier.CreateClusterDiagnosticSearchParams{
+		DiagnosticID: diagnosticID,
+		SearchText:   "diagnose",
+	}).Return(nil)
+	model.EXPECT().FinishClusterUpgrade(gomock.Any(), querier.FinishClusterUpgradeParams{
+		ID:           upgradeID,
+		Status:       "completed",
+		DiagnosticID: &diagnosticID,
+	}).Return(&querier.ClusterUpgrade{ID: upgradeID, DetectedVersion: utils.Ptr("v2.3.0")}, nil)
+
+	var eventTypes []string
+	model.EXPECT().CreateClusterEvent(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, arg querier.CreateClusterEventParams) error {
+		assert.Equal(t, clusterID, arg.ClusterID)
+		assert.Equal(t, upgradeID, arg.Details["upgradeID"])
+		eventTypes = append(eventTypes, arg.Type)
+		return nil
+	}).Times(4)
+
+	blobs, err := blobstore.NewLocalStore(t.TempDir())
+	require.NoError(t, err)
+	versions := []string{"v2.2.1", "v2.3.0"}
+	executor := &TaskExecutor{
+		risectlm: risectlm,
+		metahttp: metahttp,
+		model:    model,
+		blobs:    blobs,
+		now:      func() time.Time { return currTime },
+		getClusterVersion: func(_ context.Context, connStr string) (string, error) {
+			assert.Equal(t, "postgres://root:@rw:4566/dev?sslmode=disable", connStr)
+			version := versions[0]
+			versions = versions[1:]
+			return version, nil
+		},
+		upgradePollInterval: time.Millisecond,
+	}
+
+	err = executor.ExecuteClusterUpgrade(context.Background(), &taskgen.ClusterUpgradeParameters{
+		UpgradeID: upgradeID,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, clusterID, diagnostic.ClusterID)
+	assert.Equal(t, int64(len("diagnose")), *diagnostic.ContentSize)
+	report, err := blobstore.GetCompressed(context.Background(), blobs, *diagnostic.ContentRef, *diagnostic.ContentSha256)
+	require.NoError(t, err)
+	assert.Equal(t, "diagnose", string(report))
+	assert.Equal(t, []string{
+		"upgrade_snapshot_created",
+		"upgrade_version_detected",
+		"upgrade_diagnostic_created",
+		"upgrade_completed",
+	}, eventTypes)
+}
+
+func TestExecuteClusterUpgradeDeadline(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	var (
+		clusterID = int32(101)
+		upgradeID = int32(301)
+		currTime  = time.Date(2025, 1, 10, 12, 0, 0, 0, time.UTC)
+	)
+
+	model := model.NewMockModelInterface(ctrl)
+
+	upgrade := &querier.ClusterUpgrade{
+		ID:            upgradeID,
+		ClusterID:     clusterID,
+		TargetVersion: "v2.3.0",
+		Status:        "waiting",
+		DeadlineAt:    currTime,
+	}
+	model.EXPECT().GetClusterUpgrade(gomock.Any(), upgradeID).Return(upgrade, nil).Times(2)
+	model.EXPECT().GetClusterByID(gomock.Any(), clusterID).Return(&querier.Cluster{ID: clusterID}, nil)
+	model.EXPECT().GetAllOrgDatabseConnectionsByClusterID(gomock.Any(), gomock.Any()).Return([]*querier.DatabaseConnection{{ID: 1}}, nil)
+	model.EXPECT().FinishClusterUpgrade(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, arg querier.FinishClusterUpgradeParams) (*querier.ClusterUpgrade, error) {
+		assert.Equal(t, "failed", arg.Status)
+		assert.Contains(t, *arg.Error, "connection refused")
+		return &querier.ClusterUpgrade{ID: upgradeID}, nil
+	})
+	model.EXPECT().CreateClusterEvent(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, arg querier.CreateClusterEventParams) error {
+		assert.Equal(t, "upgrade_failed", arg.Type)
+		return nil
+	})
+
+	executor := &TaskExecutor{
+		model: model,
+		now:   func() time.Time { return currTime },
+		getClusterVersion: func(_ context.Context, _ string) (string, error) {
+			return "", errors.New("connection refused")
+		},
+		upgradePollInterval: time.Millisecond,
+	}
+
+	err := executor.ExecuteClusterUpgrade(context.Background(), &taskgen.ClusterUpgradeParameters{
+		UpgradeID: upgradeID,
+	})
+	require.ErrorContains(t, err, "before the deadline")
+}
+
+func TestExecuteClusterUpgradeCancelled(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	var (
+		clusterID = int32(101)
+		upgradeID = int32(301)
+		now       = time.Now()
+	)
+
+	model := model.NewMockModelInterface(ctrl)
+
+	model.EXPECT().GetClusterUpgrade(gomock.Any(), upgradeID).Return(&querier.ClusterUpgrade{
+		ID:        upgradeID,
+		ClusterID: clusterID,
+		Status:    "waiting",
+	}, nil)
+	model.EXPECT().GetClusterByID(gomock.Any(), clusterID).Return(&querier.Cluster{ID: clusterID}, nil)
+	model.EXPECT().GetClusterUpgrade(gomock.Any(), upgradeID).Return(&querier.ClusterUpgrade{
+		ID:         upgradeID,
+		ClusterID:  clusterID,
+		Status:     "cancelled",
+		FinishedAt: &now,
+	}, nil)
+
+	executor := &TaskExecutor{model: model}
+
+	err := executor.ExecuteClusterUpgrade(context.Background(), &taskgen.ClusterUpgradeParameters{
+		UpgradeID: upgradeID,
+	})
+	require.NoError(t, err)
+}
@@ -0,0 +1,149 @@
+package task
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/pkg/errors"
+	"github.com/risingwavelabs/risingwave-console/pkg/conn/meta"
+	"github.com/risingwavelabs/risingwave-console/pkg/zcore/model"
+	"github.com/risingwavelabs/risingwave-console/pkg/zgen/apigen"
+	"github.com/risingwavelabs/risingwave-console/pkg/zgen/querier"
+	"github.com/risingwavelabs/risingwave-console/pkg/zgen/taskgen"
+	"go.uber.org/zap"
+	"golang.org/x/sync/errgroup"
+)
+
+const (
+	// clusterVersionDetectTimeout bounds the detection of one cluster so that an unreachable
+	// cluster does not hold the periodic detection of the others.
+	clusterVersionDetectTimeout = 10 * time.Second
+
+	// clusterVersionDetectConcurrency is the number of clusters detected at the same time
+	clusterVersionDetectConcurrency = 8
+)
+
+func (e *TaskExecutor) ExecuteDetectClusterVersion(ctx context.Context, params *taskgen.DetectClusterVersionParameters) error {
+	cluster, err := e.model.GetClusterByID(ctx, params.ClusterID)
+	if err != nil {
+		return errors.Wrap(err, "failed to get cluster")
+	}
+	return e.detectClusterVersion(ctx, cluster)
+}
+
+func (e *TaskExecutor) ExecuteDetectClusterVersions(ctx context.Context, params *taskgen.DetectClusterVersionsParameters) error {
+	clusters, err := e.model.ListAllClusters(ctx)
+	if err != nil {
+		return errors.Wrap(err, "failed to list clusters")
+	}
+
+	var g errgroup.Group
+	g.SetLimit(clusterVersionDetectConcurrency)
+	for _, cluster := range clusters {
+		g.Go(func() error {
+			if err := e.detectClusterVersion(ctx, cluster); err != nil {
+				log.Error(
+					"failed to detect cluster version",
+					zap.Int32("cluster_id", cluster.ID),
+					zap.Error(err),
+				)
+			}
+			return nil
+		})
+	}
+	return g.Wait()
+}
+
+// detectClusterVersion records the version reported by the cluster. If it differs from the
+// version of the cluster and no upgrade is in progress, the version is updated if the
+// organization allows it, otherwise the mismatch is reported once per detected version.
+func (e *TaskExecutor) detectClusterVersion(ctx context.Context, cluster *querier.Cluster) error {
+	version, ok, err := e.probeClusterVersion(ctx, cluster)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		log.Info("cluster has no database to detect its version, skipping", zap.Int32("cluster_id", cluster.ID))
+		return nil
+	}
+
+	if err := e.model.UpdateClusterDetectedVersion(ctx, querier.UpdateClusterDetectedVersionParams{
+		ID:              cluster.ID,
+		DetectedVersion: &version,
+	}); err != nil {
+		return errors.Wrap(err, "failed to update detected version")
+	}
+	if meta.SameVersion(version, cluster.Version) {
+		return nil
+	}
+
+	// the version changes during an upgrade, the upgrade updates it once it is done
+	if _, err := e.model.GetInProgressClusterUpgrade(ctx, cluster.ID); err == nil {
+		return nil
+	} else if !errors.Is(err, pgx.ErrNoRows) {
+		return errors.Wrap(err, "failed to get the upgrade in progress")
+	}
+
+	settings, err := e.model.GetOrgSettings(ctx, cluster.OrgID)
+	if err != nil {
+		return errors.Wrap(err, "failed to get org settings")
+	}
+	if settings.AutoUpdateClusterVersion {
+		if err := e.updateClusterVersion(ctx, cluster, version, apigen.ClusterVersionSourceDetected); err != nil {
+			return err
+		}
+		model.RecordClusterEvent(ctx, e.model, cluster.ID, apigen.VersionUpdated, fmt.Sprintf("version %s detected, the version of the cluster is updated from %s", version, cluster.Version), apigen.ClusterEventDetails{
+			"fromVersion": cluster.Version,
+			"version":     version,
+		})
+		return nil
+	}
+
+	if cluster.DetectedVersion == nil || !meta.SameVersion(*cluster.DetectedVersion, version) {
+		model.RecordClusterEvent(ctx, e.model, cluster.ID, apigen.VersionMismatch, fmt.Sprintf("version %s detected, but the version of the cluster is %s", version, cluster.Version), apigen.ClusterEventDetails{
+			"version":         cluster.Version,
+			"detectedVersion": version,
+		})
+	}
+	return nil
+}
+
+// probeClusterVersion queries the version through the databases of the cluster, false is
+// returned if the cluster has no database, there are no credentials to log in with then.
+func (e *TaskExecutor) probeClusterVersion(ctx context.Context, cluster *querier.Cluster) (string, bool, error) {
+	ctx, cancel := context.WithTimeout(ctx, clusterVersionDetectTimeout)
+	defer cancel()
+
+	var version string
+	ok, err := e.queryCluster(ctx, cluster, func(connStr string) (err error) {
+		version, err = e.getClusterVersion(ctx, connStr)
+		return err
+	})
+	if err != nil {
+		return "", false, errors.Wrap(err, "failed to get cluster version")
+	}
+	return version, ok, nil
+}
+
+// updateClusterVersion updates the version of the cluster and records the change.
+func (e *TaskExecutor) updateClusterVersion(ctx context.Context, cluster *querier.Cluster, version string, source apigen.ClusterVersionSource) error {
+	return e.model.RunTransaction(ctx, func(txm model.ModelInterface) error {
+		if err := txm.UpdateClusterVersion(ctx, querier.UpdateClusterVersionParams{
+			ID:      cluster.ID,
+			Version: version,
+		}); err != nil {
+			return errors.Wrap(err, "failed to update cluster version")
+		}
+		if err := txm.CreateClusterVersionHistory(ctx, querier.CreateClusterVersionHistoryParams{
+			ClusterID:       cluster.ID,
+			Version:         version,
+			PreviousVersion: &cluster.Version,
+			Source:          string(source),
+		}); err != nil {
+			return errors.Wrap(err, "failed to record cluster version history")
+		}
+		return nil
+	})
+}
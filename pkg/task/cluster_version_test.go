@@ -0,0 +1,120 @@
+package task
+
+import (
+	"context"
+	"testing"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/risingwavelabs/risingwave-console/pkg/utils"
+	"github.com/risingwavelabs/risingwave-console/pkg/zcore/model"
+	"github.com/risingwavelabs/risingwave-console/pkg/zgen/querier"
+	"github.com/risingwavelabs/risingwave-console/pkg/zgen/taskgen"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestExecuteDetectClusterVersion(t *testing.T) {
+	var (
+		orgID     = int32(201)
+		clusterID = int32(101)
+	)
+
+	testCases := []struct {
+		name            string
+		detectedVersion *string
+		version         string
+		noDatabase      bool
+		inProgress      bool
+		autoUpdate      bool
+		event           string
+	}{
+		{name: "same version", version: "2.3.0"},
+		{name: "no database", version: "v2.2.1", noDatabase: true},
+		{name: "upgrade in progress", version: "v2.2.1", inProgress: true, autoUpdate: true},
+		{name: "mismatch", version: "v2.2.1", event: "version_mismatch"},
+		{name: "mismatch reported", version: "v2.2.1", detectedVersion: utils.Ptr("v2.3.0")},
+		{name: "mismatch changed", version: "v2.2.1", detectedVersion: utils.Ptr("v2.2.2"), event: "version_mismatch"},
+		{name: "auto update", version: "v2.2.1", autoUpdate: true, event: "version_updated"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			model := model.NewMockModelInterfaceWithTransaction(ctrl)
+
+			model.EXPECT().GetClusterByID(gomock.Any(), clusterID).Return(&querier.Cluster{
+				ID:              clusterID,
+				OrgID:           orgID,
+				Host:            "rw",
+				SqlPort:         4566,
+				Version:         tc.version,
+				DetectedVersion: tc.detectedVersion,
+			}, nil)
+
+			// the cluster without database is skipped, there are no credentials to log in with
+			dbs := []*querier.DatabaseConnection{{ID: 1, Username: "admin", Database: "prod"}}
+			if tc.noDatabase {
+				dbs = nil
+			}
+			model.EXPECT().GetAllOrgDatabseConnectionsByClusterID(gomock.Any(), querier.GetAllOrgDatabseConnectionsByClusterIDParams{
+				ClusterID: clusterID,
+				OrgID:     orgID,
+			}).Return(dbs, nil)
+			if !tc.noDatabase {
+				model.EXPECT().UpdateClusterDetectedVersion(gomock.Any(), querier.UpdateClusterDetectedVersionParams{
+					ID:              clusterID,
+					DetectedVersion: utils.Ptr("v2.3.0"),
+				}).Return(nil)
+			}
+
+			mismatch := !tc.noDatabase && tc.version != "2.3.0" && tc.version != "v2.3.0"
+			if mismatch {
+				if tc.inProgress {
+					model.EXPECT().GetInProgressClusterUpgrade(gomock.Any(), clusterID).Return(&querier.ClusterUpgrade{ClusterID: clusterID}, nil)
+				} else {
+					model.EXPECT().GetInProgressClusterUpgrade(gomock.Any(), clusterID).Return(nil, pgx.ErrNoRows)
+				}
+			}
+			if mismatch && !tc.inProgress {
+				model.EXPECT().GetOrgSettings(gomock.Any(), orgID).Return(&querier.OrgSetting{
+					OrgID:                    orgID,
+					AutoUpdateClusterVersion: tc.autoUpdate,
+				}, nil)
+			}
+			if tc.autoUpdate && !tc.inProgress {
+				model.EXPECT().UpdateClusterVersion(gomock.Any(), querier.UpdateClusterVersionParams{
+					ID:      clusterID,
+					Version: "v2.3.0",
+				}).Return(nil)
+				model.EXPECT().CreateClusterVersionHistory(gomock.Any(), querier.CreateClusterVersionHistoryParams{
+					ClusterID:       clusterID,
+					Version:         "v2.3.0",
+					PreviousVersion: &tc.version,
+					Source:          "detected",
+				}).Return(nil)
+			}
+			if tc.event != "" {
+				model.EXPECT().CreateClusterEvent(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, arg querier.CreateClusterEventParams) error {
+					assert.Equal(t, clusterID, arg.ClusterID)
+					assert.Equal(t, tc.event, arg.Type)
+					return nil
+				})
+			}
+
+			executor := &TaskExecutor{
+				model: model,
+				getClusterVersion: func(_ context.Context, got string) (string, error) {
+					assert.Equal(t, "postgres://admin:@rw:4566/prod?sslmode=disable", got)
+					return "v2.3.0", nil
+				},
+			}
+
+			err := executor.ExecuteDetectClusterVersion(context.Background(), &taskgen.DetectClusterVersionParameters{
+				ClusterID: clusterID,
+			})
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,312 @@
+package task
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/pkg/errors"
+	"github.com/risingwavelabs/risingwave-console/pkg/blobstore"
+	"github.com/risingwavelabs/risingwave-console/pkg/bundle"
+	"github.com/risingwavelabs/risingwave-console/pkg/conn/meta"
+	"github.com/risingwavelabs/risingwave-console/pkg/conn/sql"
+	"github.com/risingwavelabs/risingwave-console/pkg/utils"
+	"github.com/risingwavelabs/risingwave-console/pkg/zgen/apigen"
+	"github.com/risingwavelabs/risingwave-console/pkg/zgen/querier"
+	"github.com/risingwavelabs/risingwave-console/pkg/zgen/taskgen"
+	"go.uber.org/zap"
+)
+
+const (
+	// bundleMetricsRange is how far back the key metrics are collected
+	bundleMetricsRange = time.Hour
+
+	// bundleMetricsStep is the resolution of the key metrics
+	bundleMetricsStep = time.Minute
+
+	// diagnosticBundleRetention is how long the finished bundles are kept
+	diagnosticBundleRetention = 14 * 24 * time.Hour
+
+	// pruneDiagnosticBundlesBatch is the number of bundles deleted per batch
+	pruneDiagnosticBundlesBatch = 100
+)
+
+// errDiagnosticBundleInterrupted is the error of a bundle left running by an attempt that stopped
+// without finishing it, e.g. the worker crashed.
+var errDiagnosticBundleInterrupted = errors.New("diagnostic bundle collection is interrupted, the worker running it stopped")
+
+// bundleRisectlOperations are the risectl outputs collected into the bundles.
+var bundleRisectlOperations = []meta.OperationName{
+	meta.OperationClusterInfo,
+	meta.OperationHummockVersion,
+}
+
+// ExecuteDiagnosticBundle collects the diagnostic bundle of a cluster. An item that cannot be
+// collected is recorded in the manifest, the bundle only fails if the archive cannot be built. A
+// bundle found running was interrupted, it is finished as failed.
+func (e *TaskExecutor) ExecuteDiagnosticBundle(ctx context.Context, params *taskgen.DiagnosticBundleParameters) error {
+	b, err := e.model.GetClusterDiagnosticBundle(ctx, params.BundleID)
+	if err != nil {
+		return errors.Wrap(err, "failed to get diagnostic bundle")
+	}
+	// the final state is recorded even if the task is timed out
+	finishCtx := context.WithoutCancel(ctx)
+	switch apigen.DiagnosticBundleStatus(b.Status) {
+	case apigen.DiagnosticBundleStatusPending:
+	case apigen.DiagnosticBundleStatusRunning:
+		// the previous attempt stopped without finishing it, the bundle is not collected again so
+		// that the retries do not hold the cluster for another round of collection
+		return e.failDiagnosticBundle(finishCtx, b.ID, errDiagnosticBundleInterrupted)
+	default:
+		log.Info("diagnostic bundle is already finished, skipping", zap.Int32("bundle_id", b.ID))
+		return nil
+	}
+
+	cluster, err := e.model.GetClusterByID(ctx, b.ClusterID)
+	if err != nil {
+		return e.failDiagnosticBundle(finishCtx, b.ID, errors.Wrap(err, "failed to get cluster"))
+	}
+	if err := e.model.UpdateClusterDiagnosticBundleStatus(ctx, querier.UpdateClusterDiagnosticBundleStatusParams{
+		ID:     b.ID,
+		Status: string(apigen.DiagnosticBundleStatusRunning),
+	}); err != nil {
+		return errors.Wrap(err, "failed to start diagnostic bundle")
+	}
+
+	now := e.now()
+	metricsFrom := now.Add(-bundleMetricsRange)
+	w := bundle.NewWriter(apigen.DiagnosticBundleManifest{
+		ClusterID:      cluster.ID,
+		ClusterName:    cluster.Name,
+		ClusterVersion: &cluster.Version,
+		CreatedAt:      now,
+		MetricsFrom:    &metricsFrom,
+		MetricsTo:      &now,
+	})
+
+	collect := func(path string, err error) {
+		if err != nil {
+			log.Warn("failed to collect diagnostic bundle item", zap.Int32("bundle_id", b.ID), zap.String("path", path), zap.Error(err))
+			w.AddError(path, err)
+		}
+	}
+	collect("diagnose.txt", e.collectDiagnose(ctx, w, cluster))
+	for _, name := range bundleRisectlOperations {
+		path := fmt.Sprintf("risectl/%s.txt", name)
+		collect(path, e.collectRisectlOutput(ctx, w, cluster, name, path))
+	}
+	collect("metrics/key-metrics.json", e.collectKeyMetrics(ctx, w, cluster, metricsFrom, now))
+	collect("catalog", e.collectCatalogSummary(ctx, w, cluster))
+	collect("cluster.json", e.collectClusterConfig(ctx, w, cluster))
+
+	content, manifest, err := w.Close()
+	if err != nil {
+		return e.failDiagnosticBundle(finishCtx, b.ID, err)
+	}
+
+	key := blobstore.DiagnosticBundleKey(cluster.ID, b.ID)
+	if err := e.blobs.Put(finishCtx, key, content); err != nil {
+		return e.failDiagnosticBundle(finishCtx, b.ID, errors.Wrap(err, "failed to store diagnostic bundle"))
+	}
+	if err := e.model.FinishClusterDiagnosticBundle(finishCtx, querier.FinishClusterDiagnosticBundleParams{
+		ID:         b.ID,
+		Status:     string(apigen.DiagnosticBundleStatusCompleted),
+		Manifest:   manifest,
+		SizeBytes:  utils.Ptr(int64(len(content))),
+		ContentRef: &key,
+	}); err != nil {
+		return errors.Wrap(err, "failed to finish diagnostic bundle")
+	}
+	log.Info("diagnostic bundle collected", zap.Int32("bundle_id", b.ID), zap.Int("size", len(content)), zap.Int("errors", len(manifest.Errors)))
+	return nil
+}
+
+// ExecutePruneDiagnosticBundles deletes the bundles finished before the retention. The archive is
+// deleted before the row, an archive left by a failed row deletion is deleted by the next run.
+func (e *TaskExecutor) ExecutePruneDiagnosticBundles(ctx context.Context, params *taskgen.PruneDiagnosticBundlesParameters) error {
+	finishedBefore := e.now().Add(-diagnosticBundleRetention)
+	deleted := 0
+	for {
+		bundles, err := e.model.ListExpiredClusterDiagnosticBundles(ctx, querier.ListExpiredClusterDiagnosticBundlesParams{
+			FinishedBefore: finishedBefore,
+			Limit:          pruneDiagnosticBundlesBatch,
+		})
+		if err != nil {
+			return errors.Wrap(err, "failed to list expired diagnostic bundles")
+		}
+		for _, b := range bundles {
+			if b.ContentRef != nil {
+				if err := e.blobs.Delete(ctx, *b.ContentRef); err != nil {
+					return errors.Wrapf(err, "failed to delete the archive of diagnostic bundle %d", b.ID)
+				}
+			}
+			if err := e.model.DeleteClusterDiagnosticBundle(ctx, b.ID); err != nil {
+				return errors.Wrapf(err, "failed to delete diagnostic bundle %d", b.ID)
+			}
+			deleted++
+		}
+		if len(bundles) < pruneDiagnosticBundlesBatch {
+			break
+		}
+	}
+	log.Info("diagnostic bundles pruned", zap.Int("deleted", deleted))
+	return nil
+}
+
+func (e *TaskExecutor) failDiagnosticBundle(ctx context.Context, bundleID int32, cause error) error {
+	if err := e.model.FinishClusterDiagnosticBundle(ctx, querier.FinishClusterDiagnosticBundleParams{
+		ID:     bundleID,
+		Status: string(apigen.DiagnosticBundleStatusFailed),
+		Error:  utils.Ptr(cause.Error()),
+	}); err != nil {
+		return errors.Wrap(err, "failed to finish diagnostic bundle")
+	}
+	log.Error("diagnostic bundle failed", zap.Int32("bundle_id", bundleID), zap.Error(cause))
+	return nil
+}
+
+func (e *TaskExecutor) collectDiagnose(ctx context.Context, w *bundle.Writer, cluster *querier.Cluster) error {
+	content, err := e.metahttp.GetDiagnose(ctx, fmt.Sprintf("http://%s:%d", cluster.Host, cluster.HttpPort))
+	if err != nil {
+		return errors.Wrap(err, "failed to get diagnose")
+	}
+	return w.Add("diagnose.txt", "Output of the diagnose API of the meta node", []byte(content))
+}
+
+func (e *TaskExecutor) collectRisectlOutput(ctx context.Context, w *bundle.Writer, cluster *querier.Cluster, name meta.OperationName, path string) error {
+	op, ok := meta.GetOperation(name)
+	if !ok {
+		return errors.Errorf("unknown risectl operation %s", name)
+	}
+	conn, err := e.risectlm.NewConn(ctx, cluster.Version, cluster.Host, cluster.MetaPort, meta.WithVersionOverride(cluster.RisectlVersion))
+	if err != nil {
+		return errors.Wrap(err, "failed to get risectl connection")
+	}
+	out, exitCode, err := conn.RunCombined(ctx, op.Command...)
+	if err != nil {
+		return errors.Wrapf(err, "risectl %s exited with code %d", name, exitCode)
+	}
+	return w.Add(path, op.Description, []byte(out))
+}
+
+func (e *TaskExecutor) collectKeyMetrics(ctx context.Context, w *bundle.Writer, cluster *querier.Cluster, from time.Time, to time.Time) error {
+	if cluster.MetricsStoreID == nil {
+		return errors.New("no metrics store is configured for the cluster")
+	}
+	conn, err := e.getMetricsConn(ctx, cluster.ID)
+	if err != nil {
+		return errors.Wrap(err, "failed to get metrics connection")
+	}
+	return w.AddJSON("metrics/key-metrics.json", "Range queries of the key metrics of the cluster", conn.GetKeyMetrics(ctx, from, to, bundleMetricsStep))
+}
+
+// catalogSummary is the latest catalog snapshot of a database.
+type catalogSummary struct {
+	Database       string                `json:"database"`
+	SnapshotAt     time.Time             `json:"snapshotAt"`
+	RelationCounts map[string]int        `json:"relationCounts"`
+	Relations      []sql.CatalogRelation `json:"relations"`
+}
+
+func (e *TaskExecutor) collectCatalogSummary(ctx context.Context, w *bundle.Writer, cluster *querier.Cluster) error {
+	dbs, err := e.model.GetAllOrgDatabseConnectionsByClusterID(ctx, querier.GetAllOrgDatabseConnectionsByClusterIDParams{
+		ClusterID: cluster.ID,
+		OrgID:     cluster.OrgID,
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to list databases")
+	}
+	for _, db := range dbs {
+		path := fmt.Sprintf("catalog/%d-%s.json", db.ID, db.Database)
+		snapshot, err := e.model.GetLatestCatalogSnapshot(ctx, db.ID)
+		if err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				w.AddError(path, errors.New("no catalog snapshot is taken yet"))
+				continue
+			}
+			return errors.Wrap(err, "failed to get latest catalog snapshot")
+		}
+		var catalog sql.Catalog
+		if err := json.Unmarshal(snapshot.Catalog, &catalog); err != nil {
+			w.AddError(path, errors.Wrap(err, "failed to unmarshal catalog snapshot"))
+			continue
+		}
+		summary := catalogSummary{
+			Database:       db.Database,
+			SnapshotAt:     snapshot.CreatedAt,
+			RelationCounts: map[string]int{},
+			Relations:      catalog.Relations,
+		}
+		for _, relation := range catalog.Relations {
+			summary.RelationCounts[relation.Type]++
+		}
+		if err := w.AddJSON(path, fmt.Sprintf("Latest catalog snapshot of the database %s", db.Name), summary); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// clusterConfig is the config of the cluster in the console, the passwords are left out.
+type clusterConfig struct {
+	Name            string                   `json:"name"`
+	Host            string                   `json:"host"`
+	SqlPort         int32                    `json:"sqlPort"`
+	MetaPort        int32                    `json:"metaPort"`
+	HttpPort        int32                    `json:"httpPort"`
+	Version         string                   `json:"version"`
+	DetectedVersion *string                  `json:"detectedVersion,omitempty"`
+	RisectlVersion  *string                  `json:"risectlVersion,omitempty"`
+	HealthStatus    *string                  `json:"healthStatus,omitempty"`
+	Databases       []databaseConfig         `json:"databases"`
+	MetricsStore    *apigen.MetricsStoreSpec `json:"metricsStore,omitempty"`
+}
+
+type databaseConfig struct {
+	Name            string                  `json:"name"`
+	Username        string                  `json:"username"`
+	Database        string                  `json:"database"`
+	SessionSettings *apigen.SessionSettings `json:"sessionSettings,omitempty"`
+}
+
+func (e *TaskExecutor) collectClusterConfig(ctx context.Context, w *bundle.Writer, cluster *querier.Cluster) error {
+	cfg := clusterConfig{
+		Name:            cluster.Name,
+		Host:            cluster.Host,
+		SqlPort:         cluster.SqlPort,
+		MetaPort:        cluster.MetaPort,
+		HttpPort:        cluster.HttpPort,
+		Version:         cluster.Version,
+		DetectedVersion: cluster.DetectedVersion,
+		RisectlVersion:  cluster.RisectlVersion,
+		HealthStatus:    cluster.HealthStatus,
+		Databases:       []databaseConfig{},
+	}
+
+	dbs, err := e.model.GetAllOrgDatabseConnectionsByClusterID(ctx, querier.GetAllOrgDatabseConnectionsByClusterIDParams{
+		ClusterID: cluster.ID,
+		OrgID:     cluster.OrgID,
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to list databases")
+	}
+	for _, db := range dbs {
+		cfg.Databases = append(cfg.Databases, databaseConfig{
+			Name:            db.Name,
+			Username:        db.Username,
+			Database:        db.Database,
+			SessionSettings: db.SessionSettings,
+		})
+	}
+
+	if cluster.MetricsStoreID != nil {
+		store, err := e.model.GetMetricsStore(ctx, cluster.ID)
+		if err != nil {
+			return errors.Wrap(err, "failed to get metrics store")
+		}
+		cfg.MetricsStore = store.Spec
+	}
+	return w.AddJSON("cluster.json", "Config of the cluster in the console", cfg)
+}
@@ -0,0 +1,64 @@
+package task
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/risingwavelabs/risingwave-console/pkg/blobstore"
+	"github.com/risingwavelabs/risingwave-console/pkg/conn/http"
+	"github.com/risingwavelabs/risingwave-console/pkg/zgen/querier"
+	"github.com/risingwavelabs/risingwave-console/pkg/zgen/taskgen"
+	"go.uber.org/zap"
+)
+
+// diagnosticIndexBatchSize is the number of diagnose reports loaded at a time, the reports are
+// several MB each.
+const diagnosticIndexBatchSize = 20
+
+// ExecuteIndexDiagnostics indexes the diagnose reports without a search vector, i.e. the ones
+// created before the full-text search was introduced and the ones failed to be indexed when they
+// were created. The indexed reports are not listed again, so the periodic runs are cheap.
+func (e *TaskExecutor) ExecuteIndexDiagnostics(ctx context.Context, params *taskgen.IndexDiagnosticsParameters) error {
+	indexed, err := e.indexDiagnostics(ctx)
+	if err != nil {
+		return err
+	}
+	if indexed > 0 {
+		log.Info("diagnose reports indexed", zap.Int("diagnostics", indexed))
+	}
+	return nil
+}
+
+func (e *TaskExecutor) indexDiagnostics(ctx context.Context) (int, error) {
+	indexed := 0
+	for {
+		diags, err := e.model.ListUnindexedClusterDiagnostics(ctx, diagnosticIndexBatchSize)
+		if err != nil {
+			return indexed, errors.Wrap(err, "failed to list cluster diagnostics")
+		}
+		if len(diags) == 0 {
+			return indexed, nil
+		}
+		for _, diag := range diags {
+			var content string
+			switch {
+			case diag.Content != nil:
+				content = *diag.Content
+			case diag.ContentRef != nil:
+				payload, err := blobstore.GetCompressed(ctx, e.blobs, *diag.ContentRef, "")
+				if err != nil && !errors.Is(err, blobstore.ErrNotFound) {
+					return indexed, errors.Wrapf(err, "failed to get diagnose report of diagnostic %d", diag.ID)
+				}
+				// a missing report is indexed as empty so that it is not retried forever
+				content = string(payload)
+			}
+			if err := e.model.CreateClusterDiagnosticSearch(ctx, querier.CreateClusterDiagnosticSearchParams{
+				DiagnosticID: diag.ID,
+				SearchText:   http.DiagnoseSearchText(content),
+			}); err != nil {
+				return indexed, errors.Wrapf(err, "failed to index diagnostic %d", diag.ID)
+			}
+			indexed++
+		}
+	}
+}
@@ -0,0 +1,40 @@
+package task
+
+import (
+	"context"
+	"testing"
+
+	"github.com/risingwavelabs/risingwave-console/pkg/blobstore"
+	"github.com/risingwavelabs/risingwave-console/pkg/utils"
+	"github.com/risingwavelabs/risingwave-console/pkg/zcore/model"
+	"github.com/risingwavelabs/risingwave-console/pkg/zgen/querier"
+	"github.com/risingwavelabs/risingwave-console/pkg/zgen/taskgen"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestExecuteIndexDiagnostics(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := context.Background()
+	model := model.NewMockModelInterface(ctrl)
+	blobs, err := blobstore.NewLocalStore(t.TempDir())
+	require.NoError(t, err)
+	stored, err := blobstore.PutCompressed(ctx, blobs, blobstore.DiagnosticKey(101), []byte("diagnose 1"))
+	require.NoError(t, err)
+
+	// the inline, the stored and a diagnostic whose report is missing are indexed
+	model.EXPECT().ListUnindexedClusterDiagnostics(gomock.Any(), int32(diagnosticIndexBatchSize)).Return([]*querier.ClusterDiagnostic{
+		{ID: 1, ClusterID: 101, ContentRef: &stored.Key},
+		{ID: 2, ClusterID: 101, Content: utils.Ptr("diagnose 2")},
+		{ID: 3, ClusterID: 101, ContentRef: utils.Ptr("diagnostics/101/missing.txt.gz")},
+	}, nil)
+	model.EXPECT().ListUnindexedClusterDiagnostics(gomock.Any(), int32(diagnosticIndexBatchSize)).Return(nil, nil)
+	model.EXPECT().CreateClusterDiagnosticSearch(gomock.Any(), querier.CreateClusterDiagnosticSearchParams{DiagnosticID: 1, SearchText: "diagnose 1"}).Return(nil)
+	model.EXPECT().CreateClusterDiagnosticSearch(gomock.Any(), querier.CreateClusterDiagnosticSearchParams{DiagnosticID: 2, SearchText: "diagnose 2"}).Return(nil)
+	model.EXPECT().CreateClusterDiagnosticSearch(gomock.Any(), querier.CreateClusterDiagnosticSearchParams{DiagnosticID: 3, SearchText: ""}).Return(nil)
+
+	executor := &TaskExecutor{model: model, blobs: blobs}
+	require.NoError(t, executor.ExecuteIndexDiagnostics(ctx, &taskgen.IndexDiagnosticsParameters{}))
+}
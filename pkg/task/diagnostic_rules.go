@@ -0,0 +1,101 @@
+package task
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/pkg/errors"
+	"github.com/risingwavelabs/risingwave-console/pkg/blobstore"
+	"github.com/risingwavelabs/risingwave-console/pkg/conn/http"
+	"github.com/risingwavelabs/risingwave-console/pkg/zcore/model"
+	"github.com/risingwavelabs/risingwave-console/pkg/zgen/apigen"
+	"github.com/risingwavelabs/risingwave-console/pkg/zgen/querier"
+	"go.uber.org/zap"
+)
+
+// runDiagnosticRules evaluates the diagnostic rules of the organization over the report, every
+// finding is recorded with the diagnostic and reported as a cluster event.
+func (e *TaskExecutor) runDiagnosticRules(ctx context.Context, cluster *querier.Cluster, diag *querier.ClusterDiagnostic, content string) error {
+	settings, err := e.model.ListOrgDiagnosticRules(ctx, cluster.OrgID)
+	if err != nil {
+		return errors.Wrap(err, "failed to list diagnostic rules")
+	}
+	configs := map[http.DiagnoseRule]http.DiagnoseRuleConfig{}
+	for _, setting := range settings {
+		configs[http.DiagnoseRule(setting.Rule)] = http.DiagnoseRuleConfig{
+			Enabled:   setting.Enabled,
+			Threshold: setting.Threshold,
+		}
+	}
+
+	previous, err := e.getPreviousDiagnoseReport(ctx, cluster.ID, diag.ID)
+	if err != nil {
+		return err
+	}
+
+	findings := http.EvaluateDiagnoseRules(http.ParseDiagnoseReport(content), previous, configs)
+	for _, f := range findings {
+		details := apigen.DiagnosticFindingDetails(f.Details)
+		if details == nil {
+			details = apigen.DiagnosticFindingDetails{}
+		}
+		finding, err := e.model.CreateClusterDiagnosticFinding(ctx, querier.CreateClusterDiagnosticFindingParams{
+			DiagnosticID: diag.ID,
+			ClusterID:    cluster.ID,
+			Rule:         string(f.Rule),
+			Severity:     string(f.Severity),
+			Message:      f.Message,
+			Value:        f.Value,
+			Threshold:    f.Threshold,
+			Details:      details,
+		})
+		if err != nil {
+			return errors.Wrapf(err, "failed to create finding of rule %s", f.Rule)
+		}
+		model.RecordClusterEvent(ctx, e.model, cluster.ID, apigen.DiagnosticFinding, f.Message, apigen.ClusterEventDetails{
+			"diagnosticID": diag.ID,
+			"findingID":    finding.ID,
+			"rule":         f.Rule,
+			"severity":     f.Severity,
+			"value":        f.Value,
+			"threshold":    f.Threshold,
+		})
+	}
+	if len(findings) > 0 {
+		log.Info("diagnostic rules fired", zap.Int32("cluster_id", cluster.ID), zap.Int32("diagnostic_id", diag.ID), zap.Int("findings", len(findings)))
+	}
+	return nil
+}
+
+// getPreviousDiagnoseReport returns the report of the diagnostic of the cluster before the given
+// one, nil if there is none or its report is gone from the blob store.
+func (e *TaskExecutor) getPreviousDiagnoseReport(ctx context.Context, clusterID int32, diagnosticID int32) (*http.DiagnoseReport, error) {
+	prev, err := e.model.GetPreviousClusterDiagnostic(ctx, querier.GetPreviousClusterDiagnosticParams{
+		ClusterID: clusterID,
+		ID:        diagnosticID,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, errors.Wrap(err, "failed to get previous cluster diagnostic")
+	}
+	if prev.Content != nil {
+		return http.ParseDiagnoseReport(*prev.Content), nil
+	}
+	if prev.ContentRef == nil {
+		return nil, nil
+	}
+	checksum := ""
+	if prev.ContentSha256 != nil {
+		checksum = *prev.ContentSha256
+	}
+	payload, err := blobstore.GetCompressed(ctx, e.blobs, *prev.ContentRef, checksum)
+	if err != nil {
+		if errors.Is(err, blobstore.ErrNotFound) {
+			return nil, nil
+		}
+		return nil, errors.Wrapf(err, "failed to get diagnose report of diagnostic %d", prev.ID)
+	}
+	return http.ParseDiagnoseReport(string(payload)), nil
+}
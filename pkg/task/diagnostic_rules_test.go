@@ -0,0 +1,92 @@
+package task
+
+import (
+	"context"
+	"testing"
+
+	"github.com/risingwavelabs/risingwave-console/pkg/blobstore"
+	"github.com/risingwavelabs/risingwave-console/pkg/utils"
+	"github.com/risingwavelabs/risingwave-console/pkg/zcore/model"
+	"github.com/risingwavelabs/risingwave-console/pkg/zgen/apigen"
+	"github.com/risingwavelabs/risingwave-console/pkg/zgen/querier"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+const testWorkerNodes = `+----+----------------+--------------------------+---------+
+| id | host           | type                     | state   |
++===========================================================+
+| 2  | compute-0:5688 | WORKER_TYPE_COMPUTE_NODE | RUNNING |
+`
+
+func TestRunDiagnosticRules(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	var (
+		ctx          = context.Background()
+		orgID        = int32(201)
+		clusterID    = int32(101)
+		diagnosticID = int32(302)
+		previous     = testWorkerNodes + "|----+----------------+--------------------------+---------|\n" +
+			"| 3  | compute-1:5688 | WORKER_TYPE_COMPUTE_NODE | RUNNING |\n" +
+			"+----+----------------+--------------------------+---------+\n"
+		current = testWorkerNodes + "+----+----------------+--------------------------+---------+\n\n" +
+			"--- Actor Traces ---\n>> Actor 1\nActor 1: `mv_a` [40.000s]\n  MaterializeExecutor 1 (actor 1) [!!! 40.000s]\n\n" +
+			"latest barrier completions\n{\"epoch\": 7781, \"duration_sec\": 90.5}\n"
+	)
+
+	mockModel := model.NewMockModelInterface(ctrl)
+	blobs, err := blobstore.NewLocalStore(t.TempDir())
+	require.NoError(t, err)
+	stored, err := blobstore.PutCompressed(ctx, blobs, blobstore.DiagnosticKey(clusterID), []byte(previous))
+	require.NoError(t, err)
+
+	// the organization disables the barrier latency rule and lowers the actor blocked threshold
+	mockModel.EXPECT().ListOrgDiagnosticRules(ctx, orgID).Return([]*querier.OrgDiagnosticRule{
+		{OrgID: orgID, Rule: "barrier_latency", Enabled: false},
+		{OrgID: orgID, Rule: "actor_blocked", Enabled: true, Threshold: utils.Ptr(30.0)},
+	}, nil)
+	mockModel.EXPECT().GetPreviousClusterDiagnostic(ctx, querier.GetPreviousClusterDiagnosticParams{
+		ClusterID: clusterID,
+		ID:        diagnosticID,
+	}).Return(&querier.ClusterDiagnostic{
+		ID:            diagnosticID - 1,
+		ClusterID:     clusterID,
+		ContentRef:    &stored.Key,
+		ContentSha256: &stored.SHA256,
+	}, nil)
+
+	var findings []querier.CreateClusterDiagnosticFindingParams
+	mockModel.EXPECT().CreateClusterDiagnosticFinding(ctx, gomock.Any()).Times(2).DoAndReturn(func(_ context.Context, arg querier.CreateClusterDiagnosticFindingParams) (*querier.ClusterDiagnosticFinding, error) {
+		findings = append(findings, arg)
+		return &querier.ClusterDiagnosticFinding{ID: int32(400 + len(findings))}, nil
+	})
+	var events []querier.CreateClusterEventParams
+	mockModel.EXPECT().CreateClusterEvent(ctx, gomock.Any()).Times(2).DoAndReturn(func(_ context.Context, arg querier.CreateClusterEventParams) error {
+		events = append(events, arg)
+		return nil
+	})
+
+	executor := &TaskExecutor{model: mockModel, blobs: blobs}
+	err = executor.runDiagnosticRules(ctx, &querier.Cluster{ID: clusterID, OrgID: orgID}, &querier.ClusterDiagnostic{ID: diagnosticID}, current)
+	require.NoError(t, err)
+
+	require.Len(t, findings, 2)
+	assert.Equal(t, diagnosticID, findings[0].DiagnosticID)
+	assert.Equal(t, clusterID, findings[0].ClusterID)
+	assert.Equal(t, "actor_blocked", findings[0].Rule)
+	assert.Equal(t, "warning", findings[0].Severity)
+	assert.Equal(t, 40.0, findings[0].Value)
+	assert.Equal(t, 30.0, findings[0].Threshold)
+	assert.Equal(t, "worker_count_dropped", findings[1].Rule)
+	assert.Equal(t, "warning", findings[1].Severity)
+	assert.Equal(t, "1 running worker node(s) dropped since the previous diagnostic: WORKER_TYPE_COMPUTE_NODE 2 -> 1", findings[1].Message)
+
+	require.Len(t, events, 2)
+	assert.Equal(t, string(apigen.DiagnosticFinding), events[1].Type)
+	assert.Equal(t, findings[1].Message, events[1].Message)
+	assert.Equal(t, int32(402), events[1].Details["findingID"])
+	assert.Equal(t, diagnosticID, events[1].Details["diagnosticID"])
+}
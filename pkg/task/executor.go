@@ -2,6 +2,7 @@ package task
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"time"
 
@@ -9,11 +10,16 @@ import (
 
 	"github.com/jackc/pgx/v5"
 	"github.com/pkg/errors"
+	"github.com/risingwavelabs/risingwave-console/pkg/blobstore"
 	"github.com/risingwavelabs/risingwave-console/pkg/conn/http"
 	"github.com/risingwavelabs/risingwave-console/pkg/conn/meta"
+	"github.com/risingwavelabs/risingwave-console/pkg/conn/metricsstore"
+	"github.com/risingwavelabs/risingwave-console/pkg/conn/sql"
 	"github.com/risingwavelabs/risingwave-console/pkg/logger"
+	"github.com/risingwavelabs/risingwave-console/pkg/retention"
 	"github.com/risingwavelabs/risingwave-console/pkg/utils"
 	"github.com/risingwavelabs/risingwave-console/pkg/zcore/model"
+	"github.com/risingwavelabs/risingwave-console/pkg/zgen/apigen"
 	"github.com/risingwavelabs/risingwave-console/pkg/zgen/querier"
 	"github.com/risingwavelabs/risingwave-console/pkg/zgen/taskgen"
 	"go.uber.org/zap"
@@ -28,18 +34,38 @@ type TaskExecutor struct {
 
 	model model.ModelInterface
 
+	blobs blobstore.BlobStore
+
 	taskRunner taskgen.TaskRunner
 
 	now func() time.Time
+
+	listMetaSnapshots func(ctx context.Context, connStr string) ([]meta.MetaSnapshot, error)
+
+	getClusterVersion func(ctx context.Context, connStr string) (string, error)
+
+	testTCPConnection func(ctx context.Context, host string, port int32, timeout time.Duration) error
+
+	getMetricsConn func(ctx context.Context, clusterID int32) (metricsstore.MetricsConn, error)
+
+	upgradePollInterval time.Duration
 }
 
-func NewTaskExecutor(taskRunner taskgen.TaskRunner, model model.ModelInterface, risectlm meta.RisectlManagerInterface, metahttp http.MetaHttpManagerInterface) taskgen.ExecutorInterface {
+func NewTaskExecutor(taskRunner taskgen.TaskRunner, model model.ModelInterface, risectlm meta.RisectlManagerInterface, metahttp http.MetaHttpManagerInterface, metricsm *metricsstore.MetricsManager, blobs blobstore.BlobStore) taskgen.ExecutorInterface {
 	return &TaskExecutor{
 		taskRunner: taskRunner,
 		model:      model,
+		blobs:      blobs,
 		risectlm:   risectlm,
 		now:        time.Now,
 		metahttp:   metahttp,
+
+		listMetaSnapshots: sql.ListMetaSnapshots,
+		getClusterVersion: sql.GetRisingWaveVersion,
+		testTCPConnection: utils.TestTCPConnection,
+		getMetricsConn:    metricsm.GetMetricsConn,
+
+		upgradePollInterval: clusterUpgradePollInterval,
 	}
 }
 
@@ -50,7 +76,7 @@ func (e *TaskExecutor) ExecuteAutoBackup(ctx context.Context, params *taskgen.Au
 	}
 
 	// run meta backup
-	conn, err := e.risectlm.NewConn(ctx, cluster.Version, cluster.Host, cluster.MetaPort)
+	conn, err := e.risectlm.NewConn(ctx, cluster.Version, cluster.Host, cluster.MetaPort, meta.WithVersionOverride(cluster.RisectlVersion))
 	if err != nil {
 		return errors.Wrap(err, "failed to get risectl connection")
 	}
@@ -69,10 +95,31 @@ func (e *TaskExecutor) ExecuteAutoBackup(ctx context.Context, params *taskgen.Au
 		ClusterID:  cluster.ID,
 		SnapshotID: snapshotID,
 		Name:       fmt.Sprintf("auto-backup-%s", e.now().Format("2006-01-02-15-04-05")),
+		Source:     string(apigen.SnapshotSourceAuto),
 	}); err != nil {
 		return errors.Wrap(err, "failed to create snapshot")
 	}
 
+	// the retention policy replaces the retention duration once it is set
+	hasPolicy, err := e.hasSnapshotRetentionPolicy(ctx, cluster.ID)
+	if err != nil {
+		return err
+	}
+	if hasPolicy {
+		taskID, err := e.taskRunner.RunApplySnapshotRetention(ctx, &taskgen.ApplySnapshotRetentionParameters{
+			ClusterID: cluster.ID,
+		})
+		if err != nil {
+			return errors.Wrap(err, "failed to create task")
+		}
+		log.Info(
+			"apply snapshot retention task created",
+			zap.Int32("task_id", taskID),
+			zap.String("cluster_id", fmt.Sprintf("%d", cluster.ID)),
+		)
+		return nil
+	}
+
 	// create a task to delete the snapshot after the retention duration
 	retentionDuration, err := utils.ParseDuration(params.RetentionDuration)
 	if err != nil {
@@ -111,12 +158,9 @@ func (e *TaskExecutor) ExecuteAutoDiagnostic(ctx context.Context, params *taskge
 	if err != nil {
 		return errors.Wrap(err, "failed to get diagnose")
 	}
-	diag, err := e.model.CreateClusterDiagnostic(ctx, querier.CreateClusterDiagnosticParams{
-		ClusterID: cluster.ID,
-		Content:   content,
-	})
+	diag, err := e.createClusterDiagnostic(ctx, cluster.ID, content)
 	if err != nil {
-		return errors.Wrap(err, "failed to create cluster diagnostic")
+		return err
 	}
 	log.Info(
 		"cluster diagnostic created",
@@ -124,6 +168,11 @@ func (e *TaskExecutor) ExecuteAutoDiagnostic(ctx context.Context, params *taskge
 		zap.String("diagnostic_id", fmt.Sprintf("%d", diag.ID)),
 	)
 
+	// the diagnostic is kept even if the rules fail, it is still worth reading
+	if err := e.runDiagnosticRules(ctx, cluster, diag, content); err != nil {
+		log.Error("failed to run diagnostic rules", zap.Int32("cluster_id", cluster.ID), zap.Int32("diagnostic_id", diag.ID), zap.Error(err))
+	}
+
 	// create a task to delete the cluster diagnostic after the retention duration
 	retentionDuration, err := utils.ParseDuration(params.RetentionDuration)
 	if err != nil {
@@ -151,24 +200,91 @@ func (e *TaskExecutor) ExecuteAutoDiagnostic(ctx context.Context, params *taskge
 	return nil
 }
 
+// createClusterDiagnostic stores the diagnose report in the blob store and records it.
+func (e *TaskExecutor) createClusterDiagnostic(ctx context.Context, clusterID int32, content string) (*querier.ClusterDiagnostic, error) {
+	obj, err := blobstore.PutCompressed(ctx, e.blobs, blobstore.DiagnosticKey(clusterID), []byte(content))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to store diagnose report")
+	}
+	diag, err := e.model.CreateClusterDiagnostic(ctx, querier.CreateClusterDiagnosticParams{
+		ClusterID:         clusterID,
+		ContentRef:        &obj.Key,
+		ContentSize:       &obj.Size,
+		ContentStoredSize: &obj.StoredSize,
+		ContentSha256:     &obj.SHA256,
+	})
+	if err != nil {
+		if err := e.blobs.Delete(context.WithoutCancel(ctx), obj.Key); err != nil {
+			log.Warn("failed to delete orphan diagnose report", zap.String("key", obj.Key), zap.Error(err))
+		}
+		return nil, errors.Wrap(err, "failed to create cluster diagnostic")
+	}
+	// a diagnostic failed to be indexed is indexed later by the diagnostic indexing task
+	if err := e.model.CreateClusterDiagnosticSearch(ctx, querier.CreateClusterDiagnosticSearchParams{
+		DiagnosticID: diag.ID,
+		SearchText:   http.DiagnoseSearchText(content),
+	}); err != nil {
+		log.Warn("failed to index cluster diagnostic", zap.Int32("diagnostic_id", diag.ID), zap.Error(err))
+	}
+	return diag, nil
+}
+
 func (e *TaskExecutor) ExecuteDeleteClusterDiagnostic(ctx context.Context, params *taskgen.DeleteClusterDiagnosticParameters) error {
-	if err := e.model.DeleteClusterDiagnostic(ctx, params.DiagnosticID); err != nil {
+	diag, err := e.model.GetClusterDiagnostic(ctx, params.DiagnosticID)
+	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
 			log.Info("cluster diagnostic not found, skipping delete", zap.Int32("diagnostic_id", params.DiagnosticID))
 			return nil
 		}
+		return errors.Wrap(err, "failed to get cluster diagnostic")
+	}
+	// the blob is deleted first so that a failed attempt never leaves an orphan blob behind
+	if diag.ContentRef != nil {
+		if err := e.blobs.Delete(ctx, *diag.ContentRef); err != nil {
+			return errors.Wrap(err, "failed to delete diagnose report")
+		}
+	}
+	if err := e.model.DeleteClusterDiagnostic(ctx, params.DiagnosticID); err != nil {
 		return errors.Wrap(err, "failed to delete cluster diagnostic")
 	}
 	return nil
 }
 
+// hasSnapshotRetentionPolicy reports whether the snapshots of the cluster are pruned by a
+// retention policy instead of deleted after the retention duration.
+func (e *TaskExecutor) hasSnapshotRetentionPolicy(ctx context.Context, clusterID int32) (bool, error) {
+	cfg, err := e.model.GetAutoBackupConfig(ctx, clusterID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return false, nil
+		}
+		return false, errors.Wrap(err, "failed to get auto backup config")
+	}
+	return !retention.PolicyFromConfig(cfg).IsEmpty(), nil
+}
+
 func (e *TaskExecutor) ExecuteDeleteSnapshot(ctx context.Context, params *taskgen.DeleteSnapshotParameters) error {
 	cluster, err := e.model.GetClusterByID(ctx, params.ClusterID)
 	if err != nil {
 		return errors.Wrap(err, "failed to get cluster")
 	}
 
-	conn, err := e.risectlm.NewConn(ctx, cluster.Version, cluster.Host, cluster.MetaPort)
+	// the deletions scheduled before the retention policy was set would drop the snapshots
+	// the policy keeps
+	hasPolicy, err := e.hasSnapshotRetentionPolicy(ctx, cluster.ID)
+	if err != nil {
+		return err
+	}
+	if hasPolicy {
+		log.Info(
+			"snapshot retention policy is set, skipping the scheduled deletion",
+			zap.Int32("cluster_id", cluster.ID),
+			zap.Int64("snapshot_id", params.SnapshotID),
+		)
+		return nil
+	}
+
+	conn, err := e.risectlm.NewConn(ctx, cluster.Version, cluster.Host, cluster.MetaPort, meta.WithVersionOverride(cluster.RisectlVersion))
 	if err != nil {
 		return errors.Wrap(err, "failed to get risectl connection")
 	}
@@ -186,3 +302,140 @@ func (e *TaskExecutor) ExecuteDeleteSnapshot(ctx context.Context, params *taskge
 
 	return nil
 }
+
+// catalogSnapshotTimeout bounds the snapshot of one database so that an unreachable database
+// does not hold the snapshots of the others.
+const catalogSnapshotTimeout = 30 * time.Second
+
+// ExecuteCatalogSnapshot snapshots the catalog of every database and records the relations
+// created, dropped and altered since the previous snapshot. The first snapshot of a database
+// is only a baseline, no change is recorded for it. Only the latest snapshot of a database is
+// kept, the recorded changes outlive it.
+func (e *TaskExecutor) ExecuteCatalogSnapshot(ctx context.Context, params *taskgen.CatalogSnapshotParameters) error {
+	dbs, err := e.model.ListAllDatabaseConnections(ctx)
+	if err != nil {
+		return errors.Wrap(err, "failed to list databases")
+	}
+	for _, db := range dbs {
+		if err := e.snapshotCatalogWithTimeout(ctx, db); err != nil {
+			log.Error(
+				"failed to snapshot catalog",
+				zap.Int32("database_id", db.ID),
+				zap.Error(err),
+			)
+		}
+	}
+	return nil
+}
+
+func (e *TaskExecutor) snapshotCatalogWithTimeout(ctx context.Context, db *querier.DatabaseConnection) error {
+	ctx, cancel := context.WithTimeout(ctx, catalogSnapshotTimeout)
+	defer cancel()
+	return e.snapshotCatalog(ctx, db)
+}
+
+func (e *TaskExecutor) snapshotCatalog(ctx context.Context, db *querier.DatabaseConnection) error {
+	cluster, err := e.model.GetClusterByID(ctx, db.ClusterID)
+	if err != nil {
+		return errors.Wrap(err, "failed to get cluster")
+	}
+	connStr := sql.ConnString(cluster.Host, cluster.SqlPort, db.Username, utils.UnwrapOrDefault(db.Password, ""), db.Database)
+
+	catalog, err := sql.FetchCatalog(ctx, connStr)
+	if err != nil {
+		return errors.Wrap(err, "failed to fetch catalog")
+	}
+	hash, err := catalog.Hash()
+	if err != nil {
+		return err
+	}
+
+	var prev *sql.Catalog
+	latest, err := e.model.GetLatestCatalogSnapshot(ctx, db.ID)
+	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		return errors.Wrap(err, "failed to get latest catalog snapshot")
+	}
+	if err == nil {
+		if latest.Hash == hash {
+			return nil
+		}
+		prev = &sql.Catalog{}
+		if err := json.Unmarshal(latest.Catalog, prev); err != nil {
+			return errors.Wrap(err, "failed to unmarshal latest catalog snapshot")
+		}
+	}
+
+	raw, err := json.Marshal(catalog)
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal catalog")
+	}
+	var changes []sql.CatalogChange
+	if prev != nil {
+		changes = sql.DiffCatalog(prev, catalog)
+	}
+
+	return e.model.RunTransaction(ctx, func(txm model.ModelInterface) error {
+		snapshot, err := txm.CreateCatalogSnapshot(ctx, querier.CreateCatalogSnapshotParams{
+			DatabaseID: db.ID,
+			Hash:       hash,
+			Catalog:    raw,
+		})
+		if err != nil {
+			return errors.Wrap(err, "failed to create catalog snapshot")
+		}
+		for _, change := range changes {
+			if err := txm.CreateCatalogChangeEvent(ctx, querier.CreateCatalogChangeEventParams{
+				DatabaseID:   db.ID,
+				SnapshotID:   &snapshot.ID,
+				RelationID:   change.Relation.ID,
+				SchemaName:   change.Relation.Schema,
+				RelationName: change.Relation.Name,
+				RelationType: change.Relation.Type,
+				ChangeType:   string(change.ChangeType),
+				Details:      change.Details,
+			}); err != nil {
+				return errors.Wrap(err, "failed to create catalog change event")
+			}
+		}
+		// only the latest snapshot is diffed against, the older ones are dropped
+		if _, err := txm.DeleteOldCatalogSnapshots(ctx, querier.DeleteOldCatalogSnapshotsParams{
+			DatabaseID: db.ID,
+			ID:         snapshot.ID,
+		}); err != nil {
+			return errors.Wrap(err, "failed to delete old catalog snapshots")
+		}
+		log.Info(
+			"catalog snapshot created",
+			zap.Int32("database_id", db.ID),
+			zap.Int32("snapshot_id", snapshot.ID),
+			zap.Int("changes", len(changes)),
+		)
+		return nil
+	})
+}
+
+// queryCluster runs the query through the first reachable database of the cluster, false is
+// returned if the cluster has no database.
+func (e *TaskExecutor) queryCluster(ctx context.Context, cluster *querier.Cluster, query func(connStr string) error) (bool, error) {
+	dbs, err := e.model.GetAllOrgDatabseConnectionsByClusterID(ctx, querier.GetAllOrgDatabseConnectionsByClusterIDParams{
+		ClusterID: cluster.ID,
+		OrgID:     cluster.OrgID,
+	})
+	if err != nil {
+		return false, errors.Wrap(err, "failed to list databases")
+	}
+	if len(dbs) == 0 {
+		return false, nil
+	}
+
+	var lastErr error
+	for _, db := range dbs {
+		connStr := sql.ConnString(cluster.Host, cluster.SqlPort, db.Username, utils.UnwrapOrDefault(db.Password, ""), db.Database)
+		err := query(connStr)
+		if err == nil {
+			return true, nil
+		}
+		lastErr = errors.Wrapf(err, "failed to query through database %d", db.ID)
+	}
+	return false, lastErr
+}
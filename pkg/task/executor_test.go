@@ -6,12 +6,15 @@ import (
 	"testing"
 	"time"
 
+	"github.com/jackc/pgx/v5"
+	"github.com/risingwavelabs/risingwave-console/pkg/blobstore"
 	mock_http "github.com/risingwavelabs/risingwave-console/pkg/conn/http/mock"
 	mock_meta "github.com/risingwavelabs/risingwave-console/pkg/conn/meta/mock"
 	"github.com/risingwavelabs/risingwave-console/pkg/utils"
 	"github.com/risingwavelabs/risingwave-console/pkg/zcore/model"
 	"github.com/risingwavelabs/risingwave-console/pkg/zgen/querier"
 	"github.com/risingwavelabs/risingwave-console/pkg/zgen/taskgen"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
 
@@ -47,7 +50,7 @@ func TestExecuteAutoBackup(t *testing.T) {
 		OrgID:    orgID,
 	}, nil)
 
-	risectlm.EXPECT().NewConn(gomock.Any(), clusterVersion, clusterHost, clusterPort).Return(risectlcm, nil)
+	risectlm.EXPECT().NewConn(gomock.Any(), clusterVersion, clusterHost, clusterPort, gomock.Any()).Return(risectlcm, nil)
 	risectlcm.EXPECT().MetaBackup(gomock.Any()).Return(snapshotID, nil)
 
 	taskRunner := taskgen.NewMockTaskRunner(ctrl)
@@ -63,7 +66,9 @@ func TestExecuteAutoBackup(t *testing.T) {
 		ClusterID:  clusterID,
 		SnapshotID: snapshotID,
 		Name:       fmt.Sprintf("auto-backup-%s", currTime.Format("2006-01-02-15-04-05")),
+		Source:     "auto",
 	}).Return(nil)
+	model.EXPECT().GetAutoBackupConfig(gomock.Any(), clusterID).Return(&querier.AutoBackupConfig{ClusterID: clusterID}, nil)
 
 	taskRunner.EXPECT().RunDeleteSnapshot(
 		gomock.Any(),
@@ -84,6 +89,49 @@ func TestExecuteAutoBackup(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestExecuteAutoBackupWithRetentionPolicy(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	var (
+		clusterID  = int32(101)
+		snapshotID = int64(1)
+		currTime   = time.Now()
+	)
+
+	model := model.NewMockModelInterface(ctrl)
+	risectlm := mock_meta.NewMockRisectlManagerInterface(ctrl)
+	risectlcm := mock_meta.NewMockRisectlConn(ctrl)
+	taskRunner := taskgen.NewMockTaskRunner(ctrl)
+
+	model.EXPECT().GetClusterByID(gomock.Any(), clusterID).Return(&querier.Cluster{ID: clusterID}, nil)
+	risectlm.EXPECT().NewConn(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(risectlcm, nil)
+	risectlcm.EXPECT().MetaBackup(gomock.Any()).Return(snapshotID, nil)
+	model.EXPECT().CreateClusterSnapshot(gomock.Any(), gomock.Any()).Return(nil)
+	model.EXPECT().GetAutoBackupConfig(gomock.Any(), clusterID).Return(&querier.AutoBackupConfig{
+		ClusterID: clusterID,
+		KeepLast:  utils.Ptr(int32(7)),
+	}, nil)
+
+	// the snapshot is pruned by the policy instead of deleted after the retention duration
+	taskRunner.EXPECT().RunApplySnapshotRetention(gomock.Any(), &taskgen.ApplySnapshotRetentionParameters{
+		ClusterID: clusterID,
+	}).Return(int32(1), nil)
+
+	executor := &TaskExecutor{
+		risectlm:   risectlm,
+		now:        func() time.Time { return currTime },
+		taskRunner: taskRunner,
+		model:      model,
+	}
+
+	err := executor.ExecuteAutoBackup(context.Background(), &taskgen.AutoBackupParameters{
+		ClusterID:         clusterID,
+		RetentionDuration: "3d",
+	})
+	require.NoError(t, err)
+}
+
 func TestExecuteAutoDiagnostic(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -116,12 +164,28 @@ func TestExecuteAutoDiagnostic(t *testing.T) {
 		GetDiagnose(gomock.Any(), fmt.Sprintf("http://%s:%d", clusterHost, clusterHttpPort)).
 		Return(diagnose, nil)
 
-	model.EXPECT().CreateClusterDiagnostic(gomock.Any(), querier.CreateClusterDiagnosticParams{
+	blobs, err := blobstore.NewLocalStore(t.TempDir())
+	require.NoError(t, err)
+
+	model.EXPECT().CreateClusterDiagnostic(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, arg querier.CreateClusterDiagnosticParams) (*querier.ClusterDiagnostic, error) {
+		assert.Equal(t, clusterID, arg.ClusterID)
+		assert.Regexp(t, `^diagnostics/101/[0-9a-f]{32}\.txt\.gz$`, *arg.ContentRef)
+		content, err := blobstore.GetCompressed(ctx, blobs, *arg.ContentRef, *arg.ContentSha256)
+		require.NoError(t, err)
+		assert.Equal(t, diagnose, string(content))
+		return &querier.ClusterDiagnostic{
+			ID: diagnosticID,
+		}, nil
+	})
+	model.EXPECT().CreateClusterDiagnosticSearch(gomock.Any(), querier.CreateClusterDiagnosticSearchParams{
+		DiagnosticID: diagnosticID,
+		SearchText:   diagnose,
+	}).Return(nil)
+	model.EXPECT().ListOrgDiagnosticRules(gomock.Any(), orgID).Return(nil, nil)
+	model.EXPECT().GetPreviousClusterDiagnostic(gomock.Any(), querier.GetPreviousClusterDiagnosticParams{
 		ClusterID: clusterID,
-		Content:   diagnose,
-	}).Return(&querier.ClusterDiagnostic{
-		ID: diagnosticID,
-	}, nil)
+		ID:        diagnosticID,
+	}).Return(nil, pgx.ErrNoRows)
 
 	taskRunner.EXPECT().RunDeleteClusterDiagnostic(
 		gomock.Any(),
@@ -140,9 +204,10 @@ func TestExecuteAutoDiagnostic(t *testing.T) {
 		metahttp:   metahttp,
 		now:        func() time.Time { return currTime },
 		model:      model,
+		blobs:      blobs,
 	}
 
-	err := executor.ExecuteAutoDiagnostic(context.Background(), &taskgen.AutoDiagnosticParameters{
+	err = executor.ExecuteAutoDiagnostic(context.Background(), &taskgen.AutoDiagnosticParameters{
 		ClusterID:         clusterID,
 		RetentionDuration: retentionDurationRaw,
 	})
@@ -155,20 +220,31 @@ func TestExecuteDeleteClusterDiagnostic(t *testing.T) {
 
 	var (
 		diagID = int32(301)
+		key    = "diagnostics/101/a.txt.gz"
 	)
 
 	model := model.NewMockModelInterface(ctrl)
+	blobs, err := blobstore.NewLocalStore(t.TempDir())
+	require.NoError(t, err)
+	require.NoError(t, blobs.Put(context.Background(), key, []byte("diagnose")))
 
+	model.EXPECT().GetClusterDiagnostic(gomock.Any(), diagID).Return(&querier.ClusterDiagnostic{
+		ID:         diagID,
+		ContentRef: &key,
+	}, nil)
 	model.EXPECT().DeleteClusterDiagnostic(gomock.Any(), diagID).Return(nil)
 
 	executor := &TaskExecutor{
 		model: model,
+		blobs: blobs,
 	}
 
-	err := executor.ExecuteDeleteClusterDiagnostic(context.Background(), &taskgen.DeleteClusterDiagnosticParameters{
+	err = executor.ExecuteDeleteClusterDiagnostic(context.Background(), &taskgen.DeleteClusterDiagnosticParameters{
 		DiagnosticID: diagID,
 	})
 	require.NoError(t, err)
+	_, err = blobs.Get(context.Background(), key)
+	assert.ErrorIs(t, err, blobstore.ErrNotFound)
 }
 
 func TestExecuteDeleteSnapshot(t *testing.T) {
@@ -194,7 +270,8 @@ func TestExecuteDeleteSnapshot(t *testing.T) {
 		Version:  clusterVersion,
 	}, nil)
 
-	risectlm.EXPECT().NewConn(gomock.Any(), clusterVersion, clusterHost, clusterPort).Return(risectlcm, nil)
+	model.EXPECT().GetAutoBackupConfig(gomock.Any(), clusterID).Return(nil, pgx.ErrNoRows)
+	risectlm.EXPECT().NewConn(gomock.Any(), clusterVersion, clusterHost, clusterPort, gomock.Any()).Return(risectlcm, nil)
 	risectlcm.EXPECT().DeleteSnapshot(gomock.Any(), snapshotID).Return(nil)
 
 	model.EXPECT().DeleteClusterSnapshot(gomock.Any(), querier.DeleteClusterSnapshotParams{
@@ -213,3 +290,32 @@ func TestExecuteDeleteSnapshot(t *testing.T) {
 	})
 	require.NoError(t, err)
 }
+
+func TestExecuteDeleteSnapshotWithRetentionPolicy(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	var (
+		clusterID  = int32(101)
+		snapshotID = int64(1)
+	)
+
+	model := model.NewMockModelInterface(ctrl)
+
+	// the snapshot is left to the policy, risectl is not called
+	model.EXPECT().GetClusterByID(gomock.Any(), clusterID).Return(&querier.Cluster{ID: clusterID}, nil)
+	model.EXPECT().GetAutoBackupConfig(gomock.Any(), clusterID).Return(&querier.AutoBackupConfig{
+		ClusterID: clusterID,
+		KeepDaily: utils.Ptr(int32(7)),
+	}, nil)
+
+	executor := &TaskExecutor{
+		model: model,
+	}
+
+	err := executor.ExecuteDeleteSnapshot(context.Background(), &taskgen.DeleteSnapshotParameters{
+		ClusterID:  clusterID,
+		SnapshotID: snapshotID,
+	})
+	require.NoError(t, err)
+}
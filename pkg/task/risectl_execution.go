@@ -0,0 +1,300 @@
+package task
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"strings"
+	"sync"
+	"time"
+	"unicode/utf8"
+
+	"github.com/pkg/errors"
+	"github.com/risingwavelabs/risingwave-console/pkg/conn/meta"
+	"github.com/risingwavelabs/risingwave-console/pkg/utils"
+	"github.com/risingwavelabs/risingwave-console/pkg/zcore/model"
+	"github.com/risingwavelabs/risingwave-console/pkg/zgen/apigen"
+	"github.com/risingwavelabs/risingwave-console/pkg/zgen/querier"
+	"github.com/risingwavelabs/risingwave-console/pkg/zgen/taskgen"
+	"go.uber.org/zap"
+)
+
+const (
+	// outputFlushInterval is how often the buffered output and the cancellation are checked
+	outputFlushInterval = time.Second
+
+	// outputChunkSize is the size at which the buffered output is flushed immediately
+	outputChunkSize = 64 << 10
+
+	// maxExecutionOutputSize is the maximum size of the output persisted for one execution
+	maxExecutionOutputSize = 32 << 20
+
+	// risectlExecutionTimeout is the timeout of the RisectlExecution task, the command runs
+	// until then instead of the default timeout of risectl commands
+	risectlExecutionTimeout = time.Hour
+
+	// risectlExecutionRetention is how long the finished executions and their output are kept
+	risectlExecutionRetention = 30 * 24 * time.Hour
+)
+
+// errRisectlExecutionInterrupted is the error of an execution left running by an attempt that
+// stopped without finishing it, e.g. the worker crashed.
+var errRisectlExecutionInterrupted = errors.New("risectl execution is interrupted, the worker running it stopped")
+
+// ExecuteRisectlExecution runs the risectl command of the execution and persists its output in
+// chunks while it is running. The process is killed once the cancellation is requested. An
+// execution found running was interrupted, it is finished as failed.
+func (e *TaskExecutor) ExecuteRisectlExecution(ctx context.Context, params *taskgen.RisectlExecutionParameters) error {
+	execution, err := e.model.GetRisectlExecution(ctx, params.ExecutionID)
+	if err != nil {
+		return errors.Wrap(err, "failed to get risectl execution")
+	}
+	// the final state is recorded even if the task is timed out
+	finishCtx := context.WithoutCancel(ctx)
+	switch apigen.RisectlExecutionStatus(execution.Status) {
+	case apigen.RisectlExecutionStatusPending:
+	case apigen.RisectlExecutionStatusRunning:
+		// the previous attempt stopped without finishing it, the command is not run again as it
+		// may not be idempotent
+		return e.finishRisectlExecution(finishCtx, execution.ID, apigen.RisectlExecutionStatusFailed, nil, errRisectlExecutionInterrupted)
+	default:
+		log.Info("risectl execution is already finished, skipping", zap.Int32("execution_id", execution.ID))
+		return nil
+	}
+	if execution.CancelRequested {
+		return e.finishRisectlExecution(finishCtx, execution.ID, apigen.RisectlExecutionStatusCancelled, nil, nil)
+	}
+
+	cluster, err := e.model.GetClusterByID(ctx, execution.ClusterID)
+	if err != nil {
+		return e.finishRisectlExecution(finishCtx, execution.ID, apigen.RisectlExecutionStatusFailed, nil, errors.Wrap(err, "failed to get cluster"))
+	}
+	conn, err := e.risectlm.NewConn(ctx, cluster.Version, cluster.Host, cluster.MetaPort, meta.WithVersionOverride(cluster.RisectlVersion))
+	if err != nil {
+		return e.finishRisectlExecution(finishCtx, execution.ID, apigen.RisectlExecutionStatusFailed, nil, errors.Wrap(err, "failed to get risectl connection"))
+	}
+
+	if err := e.model.StartRisectlExecution(ctx, querier.StartRisectlExecutionParams{
+		ID:             execution.ID,
+		Status:         string(apigen.RisectlExecutionStatusRunning),
+		RisectlVersion: utils.Ptr(conn.Resolution().Resolved),
+	}); err != nil {
+		return errors.Wrap(err, "failed to start risectl execution")
+	}
+
+	runCtx, cancel := context.WithTimeout(ctx, risectlExecutionTimeout)
+	defer cancel()
+
+	output := newExecutionOutput(e.model, execution.ID)
+	cancelled := make(chan struct{})
+	done := make(chan struct{})
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		e.watchRisectlExecution(runCtx, execution.ID, output, cancel, cancelled, done)
+	}()
+
+	exitCode, runErr := conn.Stream(runCtx, output.Writer(apigen.RisectlExecutionOutputStreamStdout), output.Writer(apigen.RisectlExecutionOutputStreamStderr), execution.Args...)
+	close(done)
+	wg.Wait()
+
+	output.logError(output.Flush(finishCtx, true))
+
+	status := apigen.RisectlExecutionStatusSucceeded
+	select {
+	case <-cancelled:
+		status, runErr = apigen.RisectlExecutionStatusCancelled, nil
+	default:
+		if ctx.Err() != nil {
+			runErr = errors.Wrap(ctx.Err(), "risectl execution is interrupted")
+		}
+		if runErr != nil {
+			status = apigen.RisectlExecutionStatusFailed
+		}
+	}
+	return e.finishRisectlExecution(finishCtx, execution.ID, status, utils.Ptr(int32(exitCode)), runErr)
+}
+
+// watchRisectlExecution flushes the output periodically and cancels the execution once the
+// cancellation is requested, it returns when done is closed.
+func (e *TaskExecutor) watchRisectlExecution(ctx context.Context, executionID int32, output *executionOutput, cancel func(), cancelled chan<- struct{}, done <-chan struct{}) {
+	ticker := time.NewTicker(outputFlushInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-done:
+			return
+		case <-ticker.C:
+			output.logError(output.Flush(ctx, false))
+			requested, err := e.model.IsRisectlExecutionCancelRequested(ctx, executionID)
+			if err != nil {
+				log.Error("failed to check the cancellation of risectl execution", zap.Int32("execution_id", executionID), zap.Error(err))
+				continue
+			}
+			if requested {
+				log.Info("cancelling risectl execution", zap.Int32("execution_id", executionID))
+				close(cancelled)
+				cancel()
+				return
+			}
+		}
+	}
+}
+
+func (e *TaskExecutor) finishRisectlExecution(ctx context.Context, executionID int32, status apigen.RisectlExecutionStatus, exitCode *int32, runErr error) error {
+	var errMsg *string
+	if runErr != nil {
+		errMsg = utils.Ptr(runErr.Error())
+	}
+	if err := e.model.FinishRisectlExecution(ctx, querier.FinishRisectlExecutionParams{
+		ID:       executionID,
+		Status:   string(status),
+		ExitCode: exitCode,
+		Error:    errMsg,
+	}); err != nil {
+		return errors.Wrap(err, "failed to finish risectl execution")
+	}
+	log.Info("risectl execution finished", zap.Int32("execution_id", executionID), zap.String("status", string(status)))
+	return nil
+}
+
+// ExecutePruneRisectlExecutions deletes the executions finished before the retention, their
+// output is deleted with them.
+func (e *TaskExecutor) ExecutePruneRisectlExecutions(ctx context.Context, params *taskgen.PruneRisectlExecutionsParameters) error {
+	deleted, err := e.model.DeleteOldRisectlExecutions(ctx, utils.Ptr(e.now().Add(-risectlExecutionRetention)))
+	if err != nil {
+		return errors.Wrap(err, "failed to delete old risectl executions")
+	}
+	log.Info("risectl executions pruned", zap.Int64("deleted", deleted))
+	return nil
+}
+
+// executionOutput buffers the output of a risectl execution and persists it in chunks. A chunk
+// only contains the output of one stream, so stdout and stderr keep their order. A chunk failed
+// to be persisted is kept and persisted again by the next flush.
+type executionOutput struct {
+	model       model.ModelInterface
+	executionID int32
+
+	mu        sync.Mutex
+	stream    apigen.RisectlExecutionOutputStream
+	buf       bytes.Buffer
+	pending   []outputChunk
+	seq       int32
+	size      int
+	truncated bool
+}
+
+// outputChunk is a chunk cut from the buffer and not persisted yet.
+type outputChunk struct {
+	stream  apigen.RisectlExecutionOutputStream
+	content string
+}
+
+func newExecutionOutput(model model.ModelInterface, executionID int32) *executionOutput {
+	return &executionOutput{
+		model:       model,
+		executionID: executionID,
+	}
+}
+
+// Writer returns a writer appending to the output of the stream. Writes never fail, so the
+// process is not blocked by an unavailable database.
+func (o *executionOutput) Writer(stream apigen.RisectlExecutionOutputStream) io.Writer {
+	return &streamWriter{output: o, stream: stream}
+}
+
+type streamWriter struct {
+	output *executionOutput
+	stream apigen.RisectlExecutionOutputStream
+}
+
+func (w *streamWriter) Write(p []byte) (int, error) {
+	w.output.write(w.stream, p)
+	return len(p), nil
+}
+
+func (o *executionOutput) write(stream apigen.RisectlExecutionOutputStream, p []byte) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	if o.truncated {
+		return
+	}
+	if o.buf.Len() > 0 && o.stream != stream {
+		o.logError(o.flushLocked(context.Background(), true))
+	}
+	o.stream = stream
+
+	if remaining := maxExecutionOutputSize - o.size - o.buf.Len(); len(p) > remaining {
+		o.buf.Write(p[:max(remaining, 0)])
+		o.buf.WriteString("\n[output truncated]\n")
+		o.truncated = true
+		o.logError(o.flushLocked(context.Background(), true))
+		return
+	}
+	o.buf.Write(p)
+	if o.buf.Len() >= outputChunkSize {
+		o.logError(o.flushLocked(context.Background(), false))
+	}
+}
+
+func (o *executionOutput) logError(err error) {
+	if err != nil {
+		log.Error("failed to persist risectl output", zap.Int32("execution_id", o.executionID), zap.Error(err))
+	}
+}
+
+// Flush persists the buffered output. Unless final, an incomplete UTF-8 sequence at the end is
+// kept in the buffer until the rest of it is written.
+func (o *executionOutput) Flush(ctx context.Context, final bool) error {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	return o.flushLocked(ctx, final)
+}
+
+func (o *executionOutput) flushLocked(ctx context.Context, final bool) error {
+	data := o.buf.Bytes()
+	n := len(data)
+	if !final {
+		n = completeUTF8Prefix(data)
+	}
+	if n > 0 {
+		// PostgreSQL text can not hold invalid UTF-8 or NUL characters
+		content := strings.ReplaceAll(strings.ToValidUTF8(string(data[:n]), "\uFFFD"), "\x00", "")
+		o.pending = append(o.pending, outputChunk{stream: o.stream, content: content})
+		o.buf.Next(n)
+		o.size += n
+	}
+
+	// the sequence number of a chunk is taken once it is persisted, so a failed chunk is
+	// persisted again with the same number
+	for len(o.pending) > 0 {
+		chunk := o.pending[0]
+		if err := o.model.CreateRisectlExecutionOutput(ctx, querier.CreateRisectlExecutionOutputParams{
+			ExecutionID: o.executionID,
+			Seq:         o.seq + 1,
+			Stream:      string(chunk.stream),
+			Content:     chunk.content,
+		}); err != nil {
+			return errors.Wrapf(err, "failed to persist output chunk %d", o.seq+1)
+		}
+		o.seq++
+		o.pending = o.pending[1:]
+	}
+	return nil
+}
+
+// completeUTF8Prefix returns the length of data without a trailing incomplete UTF-8 sequence.
+func completeUTF8Prefix(data []byte) int {
+	for i := len(data) - 1; i >= 0 && i >= len(data)-utf8.UTFMax; i-- {
+		if utf8.RuneStart(data[i]) {
+			if !utf8.FullRune(data[i:]) {
+				return i
+			}
+			break
+		}
+	}
+	return len(data)
+}
@@ -0,0 +1,144 @@
+package task
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/risingwavelabs/risingwave-console/pkg/utils"
+	"github.com/risingwavelabs/risingwave-console/pkg/zcore/model"
+	"github.com/risingwavelabs/risingwave-console/pkg/zgen/apigen"
+	"github.com/risingwavelabs/risingwave-console/pkg/zgen/querier"
+	"github.com/risingwavelabs/risingwave-console/pkg/zgen/taskgen"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestExecutionOutput(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	executionID := int32(1)
+	model := model.NewMockModelInterface(ctrl)
+
+	var chunks []querier.CreateRisectlExecutionOutputParams
+	model.EXPECT().CreateRisectlExecutionOutput(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, arg querier.CreateRisectlExecutionOutputParams) error {
+		chunks = append(chunks, arg)
+		return nil
+	}).AnyTimes()
+
+	output := newExecutionOutput(model, executionID)
+	stdout, stderr := output.Writer(apigen.RisectlExecutionOutputStreamStdout), output.Writer(apigen.RisectlExecutionOutputStreamStderr)
+
+	// the incomplete "é" is kept until the rest of it is written
+	_, _ = stdout.Write([]byte("caf\xc3"))
+	require.NoError(t, output.Flush(context.Background(), false))
+	_, _ = stdout.Write([]byte("\xa9\n"))
+	// switching the stream flushes the buffered stdout
+	_, _ = stderr.Write([]byte("warn\x00ing\n"))
+	require.NoError(t, output.Flush(context.Background(), true))
+	require.NoError(t, output.Flush(context.Background(), true))
+
+	require.Equal(t, []querier.CreateRisectlExecutionOutputParams{
+		{ExecutionID: executionID, Seq: 1, Stream: "stdout", Content: "caf"},
+		{ExecutionID: executionID, Seq: 2, Stream: "stdout", Content: "é\n"},
+		{ExecutionID: executionID, Seq: 3, Stream: "stderr", Content: "warning\n"},
+	}, chunks)
+}
+
+func TestExecutionOutputRetriesFailedChunks(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	model := model.NewMockModelInterface(ctrl)
+
+	var chunks []querier.CreateRisectlExecutionOutputParams
+	gomock.InOrder(
+		model.EXPECT().CreateRisectlExecutionOutput(gomock.Any(), gomock.Any()).Return(errors.New("connection reset")),
+		model.EXPECT().CreateRisectlExecutionOutput(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, arg querier.CreateRisectlExecutionOutputParams) error {
+			chunks = append(chunks, arg)
+			return nil
+		}).Times(2),
+	)
+
+	output := newExecutionOutput(model, 1)
+	_, _ = output.Writer(apigen.RisectlExecutionOutputStreamStdout).Write([]byte("out\n"))
+	// the stdout chunk fails to be persisted when the stream switches, it is kept
+	_, _ = output.Writer(apigen.RisectlExecutionOutputStreamStderr).Write([]byte("err\n"))
+	require.NoError(t, output.Flush(context.Background(), true))
+
+	require.Equal(t, []querier.CreateRisectlExecutionOutputParams{
+		{ExecutionID: 1, Seq: 1, Stream: "stdout", Content: "out\n"},
+		{ExecutionID: 1, Seq: 2, Stream: "stderr", Content: "err\n"},
+	}, chunks)
+}
+
+func TestExecutionOutputTruncated(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	model := model.NewMockModelInterface(ctrl)
+
+	size := 0
+	var last string
+	model.EXPECT().CreateRisectlExecutionOutput(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, arg querier.CreateRisectlExecutionOutputParams) error {
+		size += len(arg.Content)
+		last = arg.Content
+		return nil
+	}).AnyTimes()
+
+	output := newExecutionOutput(model, 1)
+	stdout := output.Writer(apigen.RisectlExecutionOutputStreamStdout)
+	line := []byte(strings.Repeat("x", 1<<20))
+	for range maxExecutionOutputSize/len(line) + 2 {
+		n, err := stdout.Write(line)
+		require.NoError(t, err)
+		require.Equal(t, len(line), n)
+	}
+	require.NoError(t, output.Flush(context.Background(), true))
+
+	assert.Equal(t, maxExecutionOutputSize+len("\n[output truncated]\n"), size)
+	assert.True(t, strings.HasSuffix(last, "[output truncated]\n"))
+}
+
+func TestExecuteRisectlExecutionInterrupted(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockModel := model.NewMockModelInterface(ctrl)
+	mockModel.EXPECT().GetRisectlExecution(gomock.Any(), int32(1)).Return(&querier.RisectlExecution{
+		ID:     1,
+		Status: string(apigen.RisectlExecutionStatusRunning),
+	}, nil)
+	mockModel.EXPECT().FinishRisectlExecution(gomock.Any(), querier.FinishRisectlExecutionParams{
+		ID:     1,
+		Status: string(apigen.RisectlExecutionStatusFailed),
+		Error:  utils.Ptr(errRisectlExecutionInterrupted.Error()),
+	}).Return(nil)
+	mockModel.EXPECT().GetRisectlExecution(gomock.Any(), int32(2)).Return(&querier.RisectlExecution{
+		ID:     2,
+		Status: string(apigen.RisectlExecutionStatusSucceeded),
+	}, nil)
+
+	executor := &TaskExecutor{model: mockModel}
+	require.NoError(t, executor.ExecuteRisectlExecution(context.Background(), &taskgen.RisectlExecutionParameters{ExecutionID: 1}))
+	require.NoError(t, executor.ExecuteRisectlExecution(context.Background(), &taskgen.RisectlExecutionParameters{ExecutionID: 2}))
+}
+
+func TestExecutePruneRisectlExecutions(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	currTime := time.Date(2025, 1, 31, 12, 0, 0, 0, time.UTC)
+	model := model.NewMockModelInterface(ctrl)
+	model.EXPECT().DeleteOldRisectlExecutions(gomock.Any(), utils.Ptr(time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC))).Return(int64(2), nil)
+
+	executor := &TaskExecutor{
+		model: model,
+		now:   func() time.Time { return currTime },
+	}
+	assert.NoError(t, executor.ExecutePruneRisectlExecutions(context.Background(), &taskgen.PruneRisectlExecutionsParameters{}))
+}
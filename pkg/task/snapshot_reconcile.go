@@ -0,0 +1,170 @@
+package task
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/risingwavelabs/risingwave-console/pkg/conn/meta"
+	"github.com/risingwavelabs/risingwave-console/pkg/zgen/apigen"
+	"github.com/risingwavelabs/risingwave-console/pkg/zgen/querier"
+	"github.com/risingwavelabs/risingwave-console/pkg/zgen/taskgen"
+	"go.uber.org/zap"
+	"golang.org/x/sync/errgroup"
+)
+
+const (
+	// snapshotReconcileTimeout bounds the reconciliation of one cluster
+	snapshotReconcileTimeout = 2 * time.Minute
+
+	// snapshotReconcileConcurrency is the number of clusters reconciled at the same time
+	snapshotReconcileConcurrency = 8
+)
+
+// snapshotDiff is the difference between the recorded snapshots of a cluster and its meta
+// snapshots.
+type snapshotDiff struct {
+	// Synced are the recorded snapshots found in the cluster
+	Synced []int64
+	// Missing are the recorded snapshots not found in the cluster
+	Missing []int64
+	// Unknown are the snapshots of the cluster that are not recorded, e.g. created by risectl
+	Unknown []meta.MetaSnapshot
+}
+
+func diffSnapshots(recorded []*querier.ClusterSnapshot, actual []meta.MetaSnapshot) snapshotDiff {
+	exists := make(map[int64]bool, len(actual))
+	for _, snapshot := range actual {
+		exists[snapshot.ID] = true
+	}
+
+	diff := snapshotDiff{}
+	isRecorded := make(map[int64]bool, len(recorded))
+	for _, snapshot := range recorded {
+		isRecorded[snapshot.SnapshotID] = true
+		if exists[snapshot.SnapshotID] {
+			diff.Synced = append(diff.Synced, snapshot.SnapshotID)
+		} else {
+			diff.Missing = append(diff.Missing, snapshot.SnapshotID)
+		}
+	}
+	for _, snapshot := range actual {
+		if !isRecorded[snapshot.ID] {
+			diff.Unknown = append(diff.Unknown, snapshot)
+		}
+	}
+	return diff
+}
+
+// ExecuteReconcileSnapshots syncs the recorded snapshots of every cluster with the meta
+// snapshots of the cluster. The recorded snapshots not found in the cluster are marked as
+// missing and the unknown meta snapshots are imported.
+func (e *TaskExecutor) ExecuteReconcileSnapshots(ctx context.Context, params *taskgen.ReconcileSnapshotsParameters) error {
+	clusters, err := e.model.ListAllClusters(ctx)
+	if err != nil {
+		return errors.Wrap(err, "failed to list clusters")
+	}
+
+	var g errgroup.Group
+	g.SetLimit(snapshotReconcileConcurrency)
+	for _, cluster := range clusters {
+		g.Go(func() error {
+			// the timeout keeps an unreachable cluster from holding a slot for long
+			ctx, cancel := context.WithTimeout(ctx, snapshotReconcileTimeout)
+			defer cancel()
+			if err := e.reconcileSnapshots(ctx, cluster); err != nil {
+				log.Error(
+					"failed to reconcile snapshots",
+					zap.Int32("cluster_id", cluster.ID),
+					zap.Error(err),
+				)
+			}
+			return nil
+		})
+	}
+	return g.Wait()
+}
+
+// listClusterMetaSnapshots lists the meta snapshots through risectl. The risectl versions that
+// can not list them fall back to the first reachable database of the cluster, false is returned
+// if the cluster has no database then.
+func (e *TaskExecutor) listClusterMetaSnapshots(ctx context.Context, cluster *querier.Cluster) ([]meta.MetaSnapshot, bool, error) {
+	conn, err := e.risectlm.NewConn(ctx, cluster.Version, cluster.Host, cluster.MetaPort, meta.WithVersionOverride(cluster.RisectlVersion))
+	if err != nil {
+		return nil, false, errors.Wrap(err, "failed to get risectl connection")
+	}
+	snapshots, err := conn.ListMetaSnapshots(ctx)
+	if err == nil {
+		return snapshots, true, nil
+	}
+	if !errors.Is(err, meta.ErrOperationNotSupported) {
+		return nil, false, errors.Wrap(err, "failed to list meta snapshots")
+	}
+
+	ok, err := e.queryCluster(ctx, cluster, func(connStr string) (err error) {
+		snapshots, err = e.listMetaSnapshots(ctx, connStr)
+		return errors.Wrap(err, "failed to list meta snapshots")
+	})
+	return snapshots, ok, err
+}
+
+func (e *TaskExecutor) reconcileSnapshots(ctx context.Context, cluster *querier.Cluster) error {
+	// the records are listed before the meta snapshots, so a snapshot created in between is
+	// imported instead of marked as missing, the console overwrites the imported record
+	recorded, err := e.model.ListClusterSnapshots(ctx, cluster.ID)
+	if err != nil {
+		return errors.Wrap(err, "failed to list recorded snapshots")
+	}
+
+	actual, ok, err := e.listClusterMetaSnapshots(ctx, cluster)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		log.Info("risectl can not list meta snapshots and the cluster has no database, skipping reconcile", zap.Int32("cluster_id", cluster.ID))
+		return nil
+	}
+
+	diff := diffSnapshots(recorded, actual)
+	for _, id := range diff.Synced {
+		if err := e.updateSnapshotSyncStatus(ctx, cluster.ID, id, apigen.Synced); err != nil {
+			return err
+		}
+	}
+	for _, id := range diff.Missing {
+		if err := e.updateSnapshotSyncStatus(ctx, cluster.ID, id, apigen.Missing); err != nil {
+			return err
+		}
+	}
+	for _, snapshot := range diff.Unknown {
+		if err := e.model.ImportClusterSnapshot(ctx, querier.ImportClusterSnapshotParams{
+			ClusterID:  cluster.ID,
+			SnapshotID: snapshot.ID,
+			Name:       fmt.Sprintf("imported-%d", snapshot.ID),
+			CreatedAt:  snapshot.CreatedAt,
+		}); err != nil {
+			return errors.Wrapf(err, "failed to import snapshot %d", snapshot.ID)
+		}
+	}
+
+	log.Info(
+		"snapshots reconciled",
+		zap.Int32("cluster_id", cluster.ID),
+		zap.Int("synced", len(diff.Synced)),
+		zap.Int("missing", len(diff.Missing)),
+		zap.Int("imported", len(diff.Unknown)),
+	)
+	return nil
+}
+
+func (e *TaskExecutor) updateSnapshotSyncStatus(ctx context.Context, clusterID int32, snapshotID int64, status apigen.SnapshotSyncStatus) error {
+	if err := e.model.UpdateClusterSnapshotSyncStatus(ctx, querier.UpdateClusterSnapshotSyncStatusParams{
+		ClusterID:  clusterID,
+		SnapshotID: snapshotID,
+		SyncStatus: string(status),
+	}); err != nil {
+		return errors.Wrapf(err, "failed to update sync status of snapshot %d", snapshotID)
+	}
+	return nil
+}
@@ -0,0 +1,155 @@
+package task
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/risingwavelabs/risingwave-console/pkg/conn/meta"
+	"github.com/risingwavelabs/risingwave-console/pkg/conn/meta/mock"
+	"github.com/risingwavelabs/risingwave-console/pkg/zcore/model"
+	"github.com/risingwavelabs/risingwave-console/pkg/zgen/querier"
+	"github.com/risingwavelabs/risingwave-console/pkg/zgen/taskgen"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestDiffSnapshots(t *testing.T) {
+	diff := diffSnapshots([]*querier.ClusterSnapshot{
+		{SnapshotID: 1},
+		{SnapshotID: 2},
+		{SnapshotID: 3},
+	}, []meta.MetaSnapshot{{ID: 2}, {ID: 3}, {ID: 4}})
+
+	assert.Equal(t, []int64{2, 3}, diff.Synced)
+	assert.Equal(t, []int64{1}, diff.Missing)
+	assert.Equal(t, []meta.MetaSnapshot{{ID: 4}}, diff.Unknown)
+}
+
+func TestExecuteReconcileSnapshots(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	var (
+		orgID     = int32(201)
+		clusterID = int32(101)
+		password  = "secret"
+	)
+
+	model := model.NewMockModelInterface(ctrl)
+	risectlm := mock.NewMockRisectlManagerInterface(ctrl)
+	conn := mock.NewMockRisectlConn(ctrl)
+
+	model.EXPECT().ListAllClusters(gomock.Any()).Return([]*querier.Cluster{
+		{ID: clusterID, OrgID: orgID, Host: "rw", SqlPort: 4566, MetaPort: 5690, Version: "v2.0.0"},
+		{ID: clusterID + 1, OrgID: orgID, Host: "rw2", SqlPort: 4566, MetaPort: 5690, Version: "v2.0.0"},
+	}, nil)
+
+	// risectl can not list the snapshots, they are listed through the databases
+	risectlm.EXPECT().NewConn(gomock.Any(), "v2.0.0", gomock.Any(), int32(5690), gomock.Any()).Return(conn, nil).Times(2)
+	conn.EXPECT().ListMetaSnapshots(gomock.Any()).Return(nil, meta.ErrOperationNotSupported).Times(2)
+
+	// the first database is unreachable, the second one is used
+	model.EXPECT().ListClusterSnapshots(gomock.Any(), clusterID).Return([]*querier.ClusterSnapshot{
+		{ClusterID: clusterID, SnapshotID: 1},
+		{ClusterID: clusterID, SnapshotID: 2},
+	}, nil)
+	model.EXPECT().GetAllOrgDatabseConnectionsByClusterID(gomock.Any(), querier.GetAllOrgDatabseConnectionsByClusterIDParams{
+		ClusterID: clusterID,
+		OrgID:     orgID,
+	}).Return([]*querier.DatabaseConnection{
+		{ID: 1, Username: "root", Database: "broken"},
+		{ID: 2, Username: "root", Password: &password, Database: "dev"},
+	}, nil)
+	model.EXPECT().UpdateClusterSnapshotSyncStatus(gomock.Any(), querier.UpdateClusterSnapshotSyncStatusParams{
+		ClusterID:  clusterID,
+		SnapshotID: 2,
+		SyncStatus: "synced",
+	}).Return(nil)
+	model.EXPECT().UpdateClusterSnapshotSyncStatus(gomock.Any(), querier.UpdateClusterSnapshotSyncStatusParams{
+		ClusterID:  clusterID,
+		SnapshotID: 1,
+		SyncStatus: "missing",
+	}).Return(nil)
+	model.EXPECT().ImportClusterSnapshot(gomock.Any(), querier.ImportClusterSnapshotParams{
+		ClusterID:  clusterID,
+		SnapshotID: 3,
+		Name:       "imported-3",
+	}).Return(nil)
+
+	// the cluster without database is skipped
+	model.EXPECT().ListClusterSnapshots(gomock.Any(), clusterID+1).Return(nil, nil)
+	model.EXPECT().GetAllOrgDatabseConnectionsByClusterID(gomock.Any(), querier.GetAllOrgDatabseConnectionsByClusterIDParams{
+		ClusterID: clusterID + 1,
+		OrgID:     orgID,
+	}).Return(nil, nil)
+
+	var connStrs []string
+	executor := &TaskExecutor{
+		model:    model,
+		risectlm: risectlm,
+		listMetaSnapshots: func(_ context.Context, connStr string) ([]meta.MetaSnapshot, error) {
+			connStrs = append(connStrs, connStr)
+			if strings.HasSuffix(connStr, "/broken?sslmode=disable") {
+				return nil, errors.New("database does not exist")
+			}
+			return []meta.MetaSnapshot{{ID: 2}, {ID: 3}}, nil
+		},
+	}
+
+	err := executor.ExecuteReconcileSnapshots(context.Background(), &taskgen.ReconcileSnapshotsParameters{})
+	require.NoError(t, err)
+	require.Equal(t, []string{
+		"postgres://root:@rw:4566/broken?sslmode=disable",
+		"postgres://root:secret@rw:4566/dev?sslmode=disable",
+	}, connStrs)
+}
+
+func TestExecuteReconcileSnapshotsThroughRisectl(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	var (
+		clusterID = int32(101)
+		createdAt = time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	)
+
+	model := model.NewMockModelInterface(ctrl)
+	risectlm := mock.NewMockRisectlManagerInterface(ctrl)
+	conn := mock.NewMockRisectlConn(ctrl)
+
+	model.EXPECT().ListAllClusters(gomock.Any()).Return([]*querier.Cluster{
+		{ID: clusterID, Host: "rw", MetaPort: 5690, Version: "v2.0.0"},
+	}, nil)
+	model.EXPECT().ListClusterSnapshots(gomock.Any(), clusterID).Return(nil, nil)
+	risectlm.EXPECT().NewConn(gomock.Any(), "v2.0.0", "rw", int32(5690), gomock.Any()).Return(conn, nil)
+	conn.EXPECT().ListMetaSnapshots(gomock.Any()).Return([]meta.MetaSnapshot{{ID: 1, CreatedAt: &createdAt}, {ID: 2}}, nil)
+
+	// the imported snapshots keep their own time, the database decides for the unknown ones
+	model.EXPECT().ImportClusterSnapshot(gomock.Any(), querier.ImportClusterSnapshotParams{
+		ClusterID:  clusterID,
+		SnapshotID: 1,
+		Name:       "imported-1",
+		CreatedAt:  &createdAt,
+	}).Return(nil)
+	model.EXPECT().ImportClusterSnapshot(gomock.Any(), querier.ImportClusterSnapshotParams{
+		ClusterID:  clusterID,
+		SnapshotID: 2,
+		Name:       "imported-2",
+	}).Return(nil)
+
+	executor := &TaskExecutor{
+		model:    model,
+		risectlm: risectlm,
+		listMetaSnapshots: func(context.Context, string) ([]meta.MetaSnapshot, error) {
+			t.Fatal("the snapshots should be listed through risectl")
+			return nil, nil
+		},
+	}
+
+	err := executor.ExecuteReconcileSnapshots(context.Background(), &taskgen.ReconcileSnapshotsParameters{})
+	require.NoError(t, err)
+}
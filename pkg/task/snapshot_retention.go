@@ -0,0 +1,94 @@
+package task
+
+import (
+	"context"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/pkg/errors"
+	"github.com/risingwavelabs/risingwave-console/pkg/conn/meta"
+	"github.com/risingwavelabs/risingwave-console/pkg/retention"
+	"github.com/risingwavelabs/risingwave-console/pkg/zgen/apigen"
+	"github.com/risingwavelabs/risingwave-console/pkg/zgen/querier"
+	"github.com/risingwavelabs/risingwave-console/pkg/zgen/taskgen"
+	"go.uber.org/zap"
+)
+
+// ExecuteApplySnapshotRetention deletes the automatic snapshots of the cluster pruned by the
+// retention policy of its auto backup config. The snapshots created manually or imported from
+// the cluster are never pruned. The snapshots missing in the cluster only lose their record.
+func (e *TaskExecutor) ExecuteApplySnapshotRetention(ctx context.Context, params *taskgen.ApplySnapshotRetentionParameters) error {
+	cluster, err := e.model.GetClusterByID(ctx, params.ClusterID)
+	if err != nil {
+		return errors.Wrap(err, "failed to get cluster")
+	}
+
+	cfg, err := e.model.GetAutoBackupConfig(ctx, cluster.ID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil
+		}
+		return errors.Wrap(err, "failed to get auto backup config")
+	}
+	policy := retention.PolicyFromConfig(cfg)
+	if policy.IsEmpty() {
+		return nil
+	}
+
+	orgSettings, err := e.model.GetOrgSettings(ctx, cluster.OrgID)
+	if err != nil {
+		return errors.Wrap(err, "failed to get organization settings")
+	}
+	loc, err := time.LoadLocation(orgSettings.Timezone)
+	if err != nil {
+		loc = time.UTC
+	}
+
+	snapshots, err := e.model.ListClusterSnapshots(ctx, cluster.ID)
+	if err != nil {
+		return errors.Wrap(err, "failed to list cluster snapshots")
+	}
+	syncStatus := map[int64]string{}
+	var candidates []retention.Snapshot
+	for _, snapshot := range snapshots {
+		if snapshot.Source != string(apigen.SnapshotSourceAuto) {
+			continue
+		}
+		syncStatus[snapshot.SnapshotID] = snapshot.SyncStatus
+		candidates = append(candidates, retention.Snapshot{ID: snapshot.SnapshotID, CreatedAt: snapshot.CreatedAt})
+	}
+
+	var conn meta.RisectlConn
+	pruned := 0
+	for _, decision := range retention.Evaluate(policy, candidates, loc) {
+		if decision.Keep {
+			continue
+		}
+		if syncStatus[decision.ID] != string(apigen.Missing) {
+			if conn == nil {
+				conn, err = e.risectlm.NewConn(ctx, cluster.Version, cluster.Host, cluster.MetaPort, meta.WithVersionOverride(cluster.RisectlVersion))
+				if err != nil {
+					return errors.Wrap(err, "failed to get risectl connection")
+				}
+			}
+			if err := conn.DeleteSnapshot(ctx, decision.ID); err != nil {
+				return errors.Wrapf(err, "failed to delete snapshot in risingwave, snapshot_id: %d", decision.ID)
+			}
+		}
+		if err := e.model.DeleteClusterSnapshot(ctx, querier.DeleteClusterSnapshotParams{
+			ClusterID:  cluster.ID,
+			SnapshotID: decision.ID,
+		}); err != nil {
+			return errors.Wrapf(err, "failed to delete snapshot in database, cluster_id: %d, snapshot_id: %d", cluster.ID, decision.ID)
+		}
+		pruned++
+	}
+
+	log.Info(
+		"snapshot retention applied",
+		zap.Int32("cluster_id", cluster.ID),
+		zap.Int("snapshots", len(candidates)),
+		zap.Int("pruned", pruned),
+	)
+	return nil
+}
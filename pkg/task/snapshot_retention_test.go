@@ -0,0 +1,63 @@
+package task
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	mock_meta "github.com/risingwavelabs/risingwave-console/pkg/conn/meta/mock"
+	"github.com/risingwavelabs/risingwave-console/pkg/utils"
+	"github.com/risingwavelabs/risingwave-console/pkg/zcore/model"
+	"github.com/risingwavelabs/risingwave-console/pkg/zgen/querier"
+	"github.com/risingwavelabs/risingwave-console/pkg/zgen/taskgen"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestExecuteApplySnapshotRetention(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	var (
+		orgID     = int32(201)
+		clusterID = int32(101)
+		now       = time.Date(2025, 1, 10, 12, 0, 0, 0, time.UTC)
+	)
+
+	model := model.NewMockModelInterface(ctrl)
+	risectlm := mock_meta.NewMockRisectlManagerInterface(ctrl)
+	risectlcm := mock_meta.NewMockRisectlConn(ctrl)
+
+	model.EXPECT().GetClusterByID(gomock.Any(), clusterID).Return(&querier.Cluster{
+		ID:      clusterID,
+		OrgID:   orgID,
+		Version: "v2.2.1",
+	}, nil)
+	model.EXPECT().GetAutoBackupConfig(gomock.Any(), clusterID).Return(&querier.AutoBackupConfig{
+		ClusterID: clusterID,
+		KeepLast:  utils.Ptr(int32(1)),
+	}, nil)
+	model.EXPECT().GetOrgSettings(gomock.Any(), orgID).Return(&querier.OrgSetting{OrgID: orgID, Timezone: "UTC"}, nil)
+	model.EXPECT().ListClusterSnapshots(gomock.Any(), clusterID).Return([]*querier.ClusterSnapshot{
+		{ClusterID: clusterID, SnapshotID: 4, Source: "auto", SyncStatus: "synced", CreatedAt: now},
+		{ClusterID: clusterID, SnapshotID: 3, Source: "console", SyncStatus: "synced", CreatedAt: now.Add(-time.Hour)},
+		{ClusterID: clusterID, SnapshotID: 2, Source: "auto", SyncStatus: "synced", CreatedAt: now.Add(-2 * time.Hour)},
+		{ClusterID: clusterID, SnapshotID: 1, Source: "auto", SyncStatus: "missing", CreatedAt: now.Add(-3 * time.Hour)},
+	}, nil)
+
+	// the manual snapshot is never pruned, the missing one only loses its record
+	risectlm.EXPECT().NewConn(gomock.Any(), "v2.2.1", gomock.Any(), gomock.Any(), gomock.Any()).Return(risectlcm, nil)
+	risectlcm.EXPECT().DeleteSnapshot(gomock.Any(), int64(2)).Return(nil)
+	model.EXPECT().DeleteClusterSnapshot(gomock.Any(), querier.DeleteClusterSnapshotParams{ClusterID: clusterID, SnapshotID: 2}).Return(nil)
+	model.EXPECT().DeleteClusterSnapshot(gomock.Any(), querier.DeleteClusterSnapshotParams{ClusterID: clusterID, SnapshotID: 1}).Return(nil)
+
+	executor := &TaskExecutor{
+		risectlm: risectlm,
+		model:    model,
+	}
+
+	err := executor.ExecuteApplySnapshotRetention(context.Background(), &taskgen.ApplySnapshotRetentionParameters{
+		ClusterID: clusterID,
+	})
+	require.NoError(t, err)
+}
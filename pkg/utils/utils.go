@@ -5,6 +5,7 @@ import (
 	"crypto/sha256"
 	"encoding/json"
 	"fmt"
+	"math"
 	"math/rand"
 	"net"
 	"strconv"
@@ -86,6 +87,26 @@ func UnwrapOrDefault[T any](v *T, def T) T {
 	return *v
 }
 
+// ClampLimit returns the limit or def if it is not set, the limit is kept between 1 and max.
+func ClampLimit(limit *int32, def int32, max int32) int32 {
+	v := UnwrapOrDefault(limit, def)
+	if v < 1 {
+		return 1
+	}
+	if v > max {
+		return max
+	}
+	return v
+}
+
+// FiniteOrNil returns nil for NaN and infinite values, they cannot be encoded in JSON.
+func FiniteOrNil(v float64) *float64 {
+	if math.IsNaN(v) || math.IsInf(v, 0) {
+		return nil
+	}
+	return &v
+}
+
 func TestTCPConnection(ctx context.Context, host string, port int32, timeout time.Duration) error {
 	var d net.Dialer
 	d.Timeout = timeout
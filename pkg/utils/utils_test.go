@@ -1,6 +1,7 @@
 package utils
 
 import (
+	"math"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -14,3 +15,18 @@ func TestRetrieveFromJSON(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, "test", *ret)
 }
+
+func TestClampLimit(t *testing.T) {
+	assert.Equal(t, int32(100), ClampLimit(nil, 100, 1000))
+	assert.Equal(t, int32(20), ClampLimit(Ptr(int32(20)), 100, 1000))
+	assert.Equal(t, int32(1), ClampLimit(Ptr(int32(0)), 100, 1000))
+	assert.Equal(t, int32(1), ClampLimit(Ptr(int32(-5)), 100, 1000))
+	assert.Equal(t, int32(1000), ClampLimit(Ptr(int32(5000)), 100, 1000))
+}
+
+func TestFiniteOrNil(t *testing.T) {
+	assert.Equal(t, Ptr(1.5), FiniteOrNil(1.5))
+	assert.Nil(t, FiniteOrNil(math.NaN()))
+	assert.Nil(t, FiniteOrNil(math.Inf(1)))
+	assert.Nil(t, FiniteOrNil(math.Inf(-1)))
+}
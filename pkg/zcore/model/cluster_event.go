@@ -0,0 +1,25 @@
+package model
+
+import (
+	"context"
+
+	"github.com/risingwavelabs/risingwave-console/pkg/zgen/apigen"
+	"github.com/risingwavelabs/risingwave-console/pkg/zgen/querier"
+	"go.uber.org/zap"
+)
+
+// RecordClusterEvent records an event of the cluster, failing to record it does not fail the
+// caller.
+func RecordClusterEvent(ctx context.Context, q querier.Querier, clusterID int32, typ apigen.ClusterEventType, message string, details apigen.ClusterEventDetails) {
+	if details == nil {
+		details = apigen.ClusterEventDetails{}
+	}
+	if err := q.CreateClusterEvent(ctx, querier.CreateClusterEventParams{
+		ClusterID: clusterID,
+		Type:      string(typ),
+		Message:   message,
+		Details:   details,
+	}); err != nil {
+		log.Error("failed to record cluster event", zap.Int32("cluster_id", clusterID), zap.String("type", string(typ)), zap.Error(err))
+	}
+}
@@ -12,6 +12,7 @@ package model
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	pgx "github.com/jackc/pgx/v5"
 	querier "github.com/risingwavelabs/risingwave-console/pkg/zgen/querier"
@@ -42,6 +43,49 @@ func (m *MockModelInterface) EXPECT() *MockModelInterfaceMockRecorder {
 	return m.recorder
 }
 
+// CloseRisectlShellSession mocks base method.
+func (m *MockModelInterface) CloseRisectlShellSession(ctx context.Context, id int32) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CloseRisectlShellSession", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CloseRisectlShellSession indicates an expected call of CloseRisectlShellSession.
+func (mr *MockModelInterfaceMockRecorder) CloseRisectlShellSession(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloseRisectlShellSession", reflect.TypeOf((*MockModelInterface)(nil).CloseRisectlShellSession), ctx, id)
+}
+
+// CreateAlertHistory mocks base method.
+func (m *MockModelInterface) CreateAlertHistory(ctx context.Context, arg querier.CreateAlertHistoryParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAlertHistory", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateAlertHistory indicates an expected call of CreateAlertHistory.
+func (mr *MockModelInterfaceMockRecorder) CreateAlertHistory(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAlertHistory", reflect.TypeOf((*MockModelInterface)(nil).CreateAlertHistory), ctx, arg)
+}
+
+// CreateAlertRule mocks base method.
+func (m *MockModelInterface) CreateAlertRule(ctx context.Context, arg querier.CreateAlertRuleParams) (*querier.AlertRule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAlertRule", ctx, arg)
+	ret0, _ := ret[0].(*querier.AlertRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateAlertRule indicates an expected call of CreateAlertRule.
+func (mr *MockModelInterfaceMockRecorder) CreateAlertRule(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAlertRule", reflect.TypeOf((*MockModelInterface)(nil).CreateAlertRule), ctx, arg)
+}
+
 // CreateAutoBackupConfig mocks base method.
 func (m *MockModelInterface) CreateAutoBackupConfig(ctx context.Context, arg querier.CreateAutoBackupConfigParams) error {
 	m.ctrl.T.Helper()
@@ -70,6 +114,35 @@ func (mr *MockModelInterfaceMockRecorder) CreateAutoDiagnosticsConfig(ctx, arg a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAutoDiagnosticsConfig", reflect.TypeOf((*MockModelInterface)(nil).CreateAutoDiagnosticsConfig), ctx, arg)
 }
 
+// CreateCatalogChangeEvent mocks base method.
+func (m *MockModelInterface) CreateCatalogChangeEvent(ctx context.Context, arg querier.CreateCatalogChangeEventParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateCatalogChangeEvent", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateCatalogChangeEvent indicates an expected call of CreateCatalogChangeEvent.
+func (mr *MockModelInterfaceMockRecorder) CreateCatalogChangeEvent(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCatalogChangeEvent", reflect.TypeOf((*MockModelInterface)(nil).CreateCatalogChangeEvent), ctx, arg)
+}
+
+// CreateCatalogSnapshot mocks base method.
+func (m *MockModelInterface) CreateCatalogSnapshot(ctx context.Context, arg querier.CreateCatalogSnapshotParams) (*querier.CatalogSnapshot, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateCatalogSnapshot", ctx, arg)
+	ret0, _ := ret[0].(*querier.CatalogSnapshot)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateCatalogSnapshot indicates an expected call of CreateCatalogSnapshot.
+func (mr *MockModelInterfaceMockRecorder) CreateCatalogSnapshot(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCatalogSnapshot", reflect.TypeOf((*MockModelInterface)(nil).CreateCatalogSnapshot), ctx, arg)
+}
+
 // CreateCluster mocks base method.
 func (m *MockModelInterface) CreateCluster(ctx context.Context, arg querier.CreateClusterParams) (*querier.Cluster, error) {
 	m.ctrl.T.Helper()
@@ -100,6 +173,78 @@ func (mr *MockModelInterfaceMockRecorder) CreateClusterDiagnostic(ctx, arg any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateClusterDiagnostic", reflect.TypeOf((*MockModelInterface)(nil).CreateClusterDiagnostic), ctx, arg)
 }
 
+// CreateClusterDiagnosticBundle mocks base method.
+func (m *MockModelInterface) CreateClusterDiagnosticBundle(ctx context.Context, arg querier.CreateClusterDiagnosticBundleParams) (*querier.ClusterDiagnosticBundle, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateClusterDiagnosticBundle", ctx, arg)
+	ret0, _ := ret[0].(*querier.ClusterDiagnosticBundle)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateClusterDiagnosticBundle indicates an expected call of CreateClusterDiagnosticBundle.
+func (mr *MockModelInterfaceMockRecorder) CreateClusterDiagnosticBundle(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateClusterDiagnosticBundle", reflect.TypeOf((*MockModelInterface)(nil).CreateClusterDiagnosticBundle), ctx, arg)
+}
+
+// CreateClusterDiagnosticFinding mocks base method.
+func (m *MockModelInterface) CreateClusterDiagnosticFinding(ctx context.Context, arg querier.CreateClusterDiagnosticFindingParams) (*querier.ClusterDiagnosticFinding, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateClusterDiagnosticFinding", ctx, arg)
+	ret0, _ := ret[0].(*querier.ClusterDiagnosticFinding)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateClusterDiagnosticFinding indicates an expected call of CreateClusterDiagnosticFinding.
+func (mr *MockModelInterfaceMockRecorder) CreateClusterDiagnosticFinding(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateClusterDiagnosticFinding", reflect.TypeOf((*MockModelInterface)(nil).CreateClusterDiagnosticFinding), ctx, arg)
+}
+
+// CreateClusterDiagnosticSearch mocks base method.
+func (m *MockModelInterface) CreateClusterDiagnosticSearch(ctx context.Context, arg querier.CreateClusterDiagnosticSearchParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateClusterDiagnosticSearch", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateClusterDiagnosticSearch indicates an expected call of CreateClusterDiagnosticSearch.
+func (mr *MockModelInterfaceMockRecorder) CreateClusterDiagnosticSearch(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateClusterDiagnosticSearch", reflect.TypeOf((*MockModelInterface)(nil).CreateClusterDiagnosticSearch), ctx, arg)
+}
+
+// CreateClusterEvent mocks base method.
+func (m *MockModelInterface) CreateClusterEvent(ctx context.Context, arg querier.CreateClusterEventParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateClusterEvent", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateClusterEvent indicates an expected call of CreateClusterEvent.
+func (mr *MockModelInterfaceMockRecorder) CreateClusterEvent(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateClusterEvent", reflect.TypeOf((*MockModelInterface)(nil).CreateClusterEvent), ctx, arg)
+}
+
+// CreateClusterHealthRecord mocks base method.
+func (m *MockModelInterface) CreateClusterHealthRecord(ctx context.Context, arg querier.CreateClusterHealthRecordParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateClusterHealthRecord", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateClusterHealthRecord indicates an expected call of CreateClusterHealthRecord.
+func (mr *MockModelInterfaceMockRecorder) CreateClusterHealthRecord(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateClusterHealthRecord", reflect.TypeOf((*MockModelInterface)(nil).CreateClusterHealthRecord), ctx, arg)
+}
+
 // CreateClusterSnapshot mocks base method.
 func (m *MockModelInterface) CreateClusterSnapshot(ctx context.Context, arg querier.CreateClusterSnapshotParams) error {
 	m.ctrl.T.Helper()
@@ -114,6 +259,35 @@ func (mr *MockModelInterfaceMockRecorder) CreateClusterSnapshot(ctx, arg any) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateClusterSnapshot", reflect.TypeOf((*MockModelInterface)(nil).CreateClusterSnapshot), ctx, arg)
 }
 
+// CreateClusterUpgrade mocks base method.
+func (m *MockModelInterface) CreateClusterUpgrade(ctx context.Context, arg querier.CreateClusterUpgradeParams) (*querier.ClusterUpgrade, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateClusterUpgrade", ctx, arg)
+	ret0, _ := ret[0].(*querier.ClusterUpgrade)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateClusterUpgrade indicates an expected call of CreateClusterUpgrade.
+func (mr *MockModelInterfaceMockRecorder) CreateClusterUpgrade(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateClusterUpgrade", reflect.TypeOf((*MockModelInterface)(nil).CreateClusterUpgrade), ctx, arg)
+}
+
+// CreateClusterVersionHistory mocks base method.
+func (m *MockModelInterface) CreateClusterVersionHistory(ctx context.Context, arg querier.CreateClusterVersionHistoryParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateClusterVersionHistory", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateClusterVersionHistory indicates an expected call of CreateClusterVersionHistory.
+func (mr *MockModelInterfaceMockRecorder) CreateClusterVersionHistory(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateClusterVersionHistory", reflect.TypeOf((*MockModelInterface)(nil).CreateClusterVersionHistory), ctx, arg)
+}
+
 // CreateDatabaseConnection mocks base method.
 func (m *MockModelInterface) CreateDatabaseConnection(ctx context.Context, arg querier.CreateDatabaseConnectionParams) (*querier.DatabaseConnection, error) {
 	m.ctrl.T.Helper()
@@ -158,6 +332,94 @@ func (mr *MockModelInterfaceMockRecorder) CreateOrgSettings(ctx, arg any) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOrgSettings", reflect.TypeOf((*MockModelInterface)(nil).CreateOrgSettings), ctx, arg)
 }
 
+// CreateRisectlExecution mocks base method.
+func (m *MockModelInterface) CreateRisectlExecution(ctx context.Context, arg querier.CreateRisectlExecutionParams) (*querier.RisectlExecution, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateRisectlExecution", ctx, arg)
+	ret0, _ := ret[0].(*querier.RisectlExecution)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateRisectlExecution indicates an expected call of CreateRisectlExecution.
+func (mr *MockModelInterfaceMockRecorder) CreateRisectlExecution(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRisectlExecution", reflect.TypeOf((*MockModelInterface)(nil).CreateRisectlExecution), ctx, arg)
+}
+
+// CreateRisectlExecutionOutput mocks base method.
+func (m *MockModelInterface) CreateRisectlExecutionOutput(ctx context.Context, arg querier.CreateRisectlExecutionOutputParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateRisectlExecutionOutput", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateRisectlExecutionOutput indicates an expected call of CreateRisectlExecutionOutput.
+func (mr *MockModelInterfaceMockRecorder) CreateRisectlExecutionOutput(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRisectlExecutionOutput", reflect.TypeOf((*MockModelInterface)(nil).CreateRisectlExecutionOutput), ctx, arg)
+}
+
+// CreateRisectlShellCommand mocks base method.
+func (m *MockModelInterface) CreateRisectlShellCommand(ctx context.Context, arg querier.CreateRisectlShellCommandParams) (*querier.RisectlShellCommand, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateRisectlShellCommand", ctx, arg)
+	ret0, _ := ret[0].(*querier.RisectlShellCommand)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateRisectlShellCommand indicates an expected call of CreateRisectlShellCommand.
+func (mr *MockModelInterfaceMockRecorder) CreateRisectlShellCommand(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRisectlShellCommand", reflect.TypeOf((*MockModelInterface)(nil).CreateRisectlShellCommand), ctx, arg)
+}
+
+// CreateRisectlShellSession mocks base method.
+func (m *MockModelInterface) CreateRisectlShellSession(ctx context.Context, arg querier.CreateRisectlShellSessionParams) (*querier.RisectlShellSession, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateRisectlShellSession", ctx, arg)
+	ret0, _ := ret[0].(*querier.RisectlShellSession)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateRisectlShellSession indicates an expected call of CreateRisectlShellSession.
+func (mr *MockModelInterfaceMockRecorder) CreateRisectlShellSession(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRisectlShellSession", reflect.TypeOf((*MockModelInterface)(nil).CreateRisectlShellSession), ctx, arg)
+}
+
+// DeleteAlertState mocks base method.
+func (m *MockModelInterface) DeleteAlertState(ctx context.Context, arg querier.DeleteAlertStateParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteAlertState", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteAlertState indicates an expected call of DeleteAlertState.
+func (mr *MockModelInterfaceMockRecorder) DeleteAlertState(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAlertState", reflect.TypeOf((*MockModelInterface)(nil).DeleteAlertState), ctx, arg)
+}
+
+// DeleteAlertStatesByRule mocks base method.
+func (m *MockModelInterface) DeleteAlertStatesByRule(ctx context.Context, ruleID int32) ([]*querier.AlertState, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteAlertStatesByRule", ctx, ruleID)
+	ret0, _ := ret[0].([]*querier.AlertState)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteAlertStatesByRule indicates an expected call of DeleteAlertStatesByRule.
+func (mr *MockModelInterfaceMockRecorder) DeleteAlertStatesByRule(ctx, ruleID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAlertStatesByRule", reflect.TypeOf((*MockModelInterface)(nil).DeleteAlertStatesByRule), ctx, ruleID)
+}
+
 // DeleteAllOrgDatabaseConnectionsByClusterID mocks base method.
 func (m *MockModelInterface) DeleteAllOrgDatabaseConnectionsByClusterID(ctx context.Context, arg querier.DeleteAllOrgDatabaseConnectionsByClusterIDParams) error {
 	m.ctrl.T.Helper()
@@ -186,6 +448,34 @@ func (mr *MockModelInterfaceMockRecorder) DeleteClusterDiagnostic(ctx, id any) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteClusterDiagnostic", reflect.TypeOf((*MockModelInterface)(nil).DeleteClusterDiagnostic), ctx, id)
 }
 
+// DeleteClusterDiagnosticBundle mocks base method.
+func (m *MockModelInterface) DeleteClusterDiagnosticBundle(ctx context.Context, id int32) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteClusterDiagnosticBundle", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteClusterDiagnosticBundle indicates an expected call of DeleteClusterDiagnosticBundle.
+func (mr *MockModelInterfaceMockRecorder) DeleteClusterDiagnosticBundle(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteClusterDiagnosticBundle", reflect.TypeOf((*MockModelInterface)(nil).DeleteClusterDiagnosticBundle), ctx, id)
+}
+
+// DeleteClusterDiagnosticBundleContent mocks base method.
+func (m *MockModelInterface) DeleteClusterDiagnosticBundleContent(ctx context.Context, bundleID int32) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteClusterDiagnosticBundleContent", ctx, bundleID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteClusterDiagnosticBundleContent indicates an expected call of DeleteClusterDiagnosticBundleContent.
+func (mr *MockModelInterfaceMockRecorder) DeleteClusterDiagnosticBundleContent(ctx, bundleID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteClusterDiagnosticBundleContent", reflect.TypeOf((*MockModelInterface)(nil).DeleteClusterDiagnosticBundleContent), ctx, bundleID)
+}
+
 // DeleteClusterSnapshot mocks base method.
 func (m *MockModelInterface) DeleteClusterSnapshot(ctx context.Context, arg querier.DeleteClusterSnapshotParams) error {
 	m.ctrl.T.Helper()
@@ -214,6 +504,96 @@ func (mr *MockModelInterfaceMockRecorder) DeleteMetricsStore(ctx, arg any) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteMetricsStore", reflect.TypeOf((*MockModelInterface)(nil).DeleteMetricsStore), ctx, arg)
 }
 
+// DeleteOldAlertHistory mocks base method.
+func (m *MockModelInterface) DeleteOldAlertHistory(ctx context.Context, createdAt time.Time) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteOldAlertHistory", ctx, createdAt)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteOldAlertHistory indicates an expected call of DeleteOldAlertHistory.
+func (mr *MockModelInterfaceMockRecorder) DeleteOldAlertHistory(ctx, createdAt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteOldAlertHistory", reflect.TypeOf((*MockModelInterface)(nil).DeleteOldAlertHistory), ctx, createdAt)
+}
+
+// DeleteOldCatalogSnapshots mocks base method.
+func (m *MockModelInterface) DeleteOldCatalogSnapshots(ctx context.Context, arg querier.DeleteOldCatalogSnapshotsParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteOldCatalogSnapshots", ctx, arg)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteOldCatalogSnapshots indicates an expected call of DeleteOldCatalogSnapshots.
+func (mr *MockModelInterfaceMockRecorder) DeleteOldCatalogSnapshots(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteOldCatalogSnapshots", reflect.TypeOf((*MockModelInterface)(nil).DeleteOldCatalogSnapshots), ctx, arg)
+}
+
+// DeleteOldClusterEvents mocks base method.
+func (m *MockModelInterface) DeleteOldClusterEvents(ctx context.Context, createdAt time.Time) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteOldClusterEvents", ctx, createdAt)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteOldClusterEvents indicates an expected call of DeleteOldClusterEvents.
+func (mr *MockModelInterfaceMockRecorder) DeleteOldClusterEvents(ctx, createdAt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteOldClusterEvents", reflect.TypeOf((*MockModelInterface)(nil).DeleteOldClusterEvents), ctx, createdAt)
+}
+
+// DeleteOldClusterHealthRecords mocks base method.
+func (m *MockModelInterface) DeleteOldClusterHealthRecords(ctx context.Context, createdAt time.Time) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteOldClusterHealthRecords", ctx, createdAt)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteOldClusterHealthRecords indicates an expected call of DeleteOldClusterHealthRecords.
+func (mr *MockModelInterfaceMockRecorder) DeleteOldClusterHealthRecords(ctx, createdAt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteOldClusterHealthRecords", reflect.TypeOf((*MockModelInterface)(nil).DeleteOldClusterHealthRecords), ctx, createdAt)
+}
+
+// DeleteOldRisectlExecutions mocks base method.
+func (m *MockModelInterface) DeleteOldRisectlExecutions(ctx context.Context, finishedAt *time.Time) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteOldRisectlExecutions", ctx, finishedAt)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteOldRisectlExecutions indicates an expected call of DeleteOldRisectlExecutions.
+func (mr *MockModelInterfaceMockRecorder) DeleteOldRisectlExecutions(ctx, finishedAt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteOldRisectlExecutions", reflect.TypeOf((*MockModelInterface)(nil).DeleteOldRisectlExecutions), ctx, finishedAt)
+}
+
+// DeleteOrgAlertRule mocks base method.
+func (m *MockModelInterface) DeleteOrgAlertRule(ctx context.Context, arg querier.DeleteOrgAlertRuleParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteOrgAlertRule", ctx, arg)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteOrgAlertRule indicates an expected call of DeleteOrgAlertRule.
+func (mr *MockModelInterfaceMockRecorder) DeleteOrgAlertRule(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteOrgAlertRule", reflect.TypeOf((*MockModelInterface)(nil).DeleteOrgAlertRule), ctx, arg)
+}
+
 // DeleteOrgCluster mocks base method.
 func (m *MockModelInterface) DeleteOrgCluster(ctx context.Context, arg querier.DeleteOrgClusterParams) error {
 	m.ctrl.T.Helper()
@@ -242,6 +622,63 @@ func (mr *MockModelInterfaceMockRecorder) DeleteOrgDatabaseConnection(ctx, arg a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteOrgDatabaseConnection", reflect.TypeOf((*MockModelInterface)(nil).DeleteOrgDatabaseConnection), ctx, arg)
 }
 
+// FinishClusterDiagnosticBundle mocks base method.
+func (m *MockModelInterface) FinishClusterDiagnosticBundle(ctx context.Context, arg querier.FinishClusterDiagnosticBundleParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FinishClusterDiagnosticBundle", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// FinishClusterDiagnosticBundle indicates an expected call of FinishClusterDiagnosticBundle.
+func (mr *MockModelInterfaceMockRecorder) FinishClusterDiagnosticBundle(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FinishClusterDiagnosticBundle", reflect.TypeOf((*MockModelInterface)(nil).FinishClusterDiagnosticBundle), ctx, arg)
+}
+
+// FinishClusterUpgrade mocks base method.
+func (m *MockModelInterface) FinishClusterUpgrade(ctx context.Context, arg querier.FinishClusterUpgradeParams) (*querier.ClusterUpgrade, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FinishClusterUpgrade", ctx, arg)
+	ret0, _ := ret[0].(*querier.ClusterUpgrade)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FinishClusterUpgrade indicates an expected call of FinishClusterUpgrade.
+func (mr *MockModelInterfaceMockRecorder) FinishClusterUpgrade(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FinishClusterUpgrade", reflect.TypeOf((*MockModelInterface)(nil).FinishClusterUpgrade), ctx, arg)
+}
+
+// FinishRisectlExecution mocks base method.
+func (m *MockModelInterface) FinishRisectlExecution(ctx context.Context, arg querier.FinishRisectlExecutionParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FinishRisectlExecution", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// FinishRisectlExecution indicates an expected call of FinishRisectlExecution.
+func (mr *MockModelInterfaceMockRecorder) FinishRisectlExecution(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FinishRisectlExecution", reflect.TypeOf((*MockModelInterface)(nil).FinishRisectlExecution), ctx, arg)
+}
+
+// FinishRisectlShellCommand mocks base method.
+func (m *MockModelInterface) FinishRisectlShellCommand(ctx context.Context, arg querier.FinishRisectlShellCommandParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FinishRisectlShellCommand", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// FinishRisectlShellCommand indicates an expected call of FinishRisectlShellCommand.
+func (mr *MockModelInterfaceMockRecorder) FinishRisectlShellCommand(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FinishRisectlShellCommand", reflect.TypeOf((*MockModelInterface)(nil).FinishRisectlShellCommand), ctx, arg)
+}
+
 // GetAllOrgDatabseConnectionsByClusterID mocks base method.
 func (m *MockModelInterface) GetAllOrgDatabseConnectionsByClusterID(ctx context.Context, arg querier.GetAllOrgDatabseConnectionsByClusterIDParams) ([]*querier.DatabaseConnection, error) {
 	m.ctrl.T.Helper()
@@ -317,38 +754,128 @@ func (mr *MockModelInterfaceMockRecorder) GetClusterDiagnostic(ctx, id any) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetClusterDiagnostic", reflect.TypeOf((*MockModelInterface)(nil).GetClusterDiagnostic), ctx, id)
 }
 
-// GetDatabaseConnectionByID mocks base method.
-func (m *MockModelInterface) GetDatabaseConnectionByID(ctx context.Context, id int32) (*querier.DatabaseConnection, error) {
+// GetClusterDiagnosticBundle mocks base method.
+func (m *MockModelInterface) GetClusterDiagnosticBundle(ctx context.Context, id int32) (*querier.ClusterDiagnosticBundle, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetDatabaseConnectionByID", ctx, id)
-	ret0, _ := ret[0].(*querier.DatabaseConnection)
+	ret := m.ctrl.Call(m, "GetClusterDiagnosticBundle", ctx, id)
+	ret0, _ := ret[0].(*querier.ClusterDiagnosticBundle)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetDatabaseConnectionByID indicates an expected call of GetDatabaseConnectionByID.
-func (mr *MockModelInterfaceMockRecorder) GetDatabaseConnectionByID(ctx, id any) *gomock.Call {
+// GetClusterDiagnosticBundle indicates an expected call of GetClusterDiagnosticBundle.
+func (mr *MockModelInterfaceMockRecorder) GetClusterDiagnosticBundle(ctx, id any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDatabaseConnectionByID", reflect.TypeOf((*MockModelInterface)(nil).GetDatabaseConnectionByID), ctx, id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetClusterDiagnosticBundle", reflect.TypeOf((*MockModelInterface)(nil).GetClusterDiagnosticBundle), ctx, id)
 }
 
-// GetMetricsStore mocks base method.
-func (m *MockModelInterface) GetMetricsStore(ctx context.Context, id int32) (*querier.MetricsStore, error) {
+// GetClusterDiagnosticBundleContent mocks base method.
+func (m *MockModelInterface) GetClusterDiagnosticBundleContent(ctx context.Context, bundleID int32) ([]byte, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetMetricsStore", ctx, id)
-	ret0, _ := ret[0].(*querier.MetricsStore)
+	ret := m.ctrl.Call(m, "GetClusterDiagnosticBundleContent", ctx, bundleID)
+	ret0, _ := ret[0].([]byte)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetMetricsStore indicates an expected call of GetMetricsStore.
-func (mr *MockModelInterfaceMockRecorder) GetMetricsStore(ctx, id any) *gomock.Call {
+// GetClusterDiagnosticBundleContent indicates an expected call of GetClusterDiagnosticBundleContent.
+func (mr *MockModelInterfaceMockRecorder) GetClusterDiagnosticBundleContent(ctx, bundleID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMetricsStore", reflect.TypeOf((*MockModelInterface)(nil).GetMetricsStore), ctx, id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetClusterDiagnosticBundleContent", reflect.TypeOf((*MockModelInterface)(nil).GetClusterDiagnosticBundleContent), ctx, bundleID)
 }
 
-// GetMetricsStoreByIDAndOrgID mocks base method.
-func (m *MockModelInterface) GetMetricsStoreByIDAndOrgID(ctx context.Context, arg querier.GetMetricsStoreByIDAndOrgIDParams) (*querier.MetricsStore, error) {
+// GetClusterUpgrade mocks base method.
+func (m *MockModelInterface) GetClusterUpgrade(ctx context.Context, id int32) (*querier.ClusterUpgrade, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetClusterUpgrade", ctx, id)
+	ret0, _ := ret[0].(*querier.ClusterUpgrade)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetClusterUpgrade indicates an expected call of GetClusterUpgrade.
+func (mr *MockModelInterfaceMockRecorder) GetClusterUpgrade(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetClusterUpgrade", reflect.TypeOf((*MockModelInterface)(nil).GetClusterUpgrade), ctx, id)
+}
+
+// GetDatabaseConnectionByID mocks base method.
+func (m *MockModelInterface) GetDatabaseConnectionByID(ctx context.Context, id int32) (*querier.DatabaseConnection, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDatabaseConnectionByID", ctx, id)
+	ret0, _ := ret[0].(*querier.DatabaseConnection)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDatabaseConnectionByID indicates an expected call of GetDatabaseConnectionByID.
+func (mr *MockModelInterfaceMockRecorder) GetDatabaseConnectionByID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDatabaseConnectionByID", reflect.TypeOf((*MockModelInterface)(nil).GetDatabaseConnectionByID), ctx, id)
+}
+
+// GetInProgressClusterUpgrade mocks base method.
+func (m *MockModelInterface) GetInProgressClusterUpgrade(ctx context.Context, clusterID int32) (*querier.ClusterUpgrade, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetInProgressClusterUpgrade", ctx, clusterID)
+	ret0, _ := ret[0].(*querier.ClusterUpgrade)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetInProgressClusterUpgrade indicates an expected call of GetInProgressClusterUpgrade.
+func (mr *MockModelInterfaceMockRecorder) GetInProgressClusterUpgrade(ctx, clusterID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetInProgressClusterUpgrade", reflect.TypeOf((*MockModelInterface)(nil).GetInProgressClusterUpgrade), ctx, clusterID)
+}
+
+// GetLatestCatalogSnapshot mocks base method.
+func (m *MockModelInterface) GetLatestCatalogSnapshot(ctx context.Context, databaseID int32) (*querier.CatalogSnapshot, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLatestCatalogSnapshot", ctx, databaseID)
+	ret0, _ := ret[0].(*querier.CatalogSnapshot)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLatestCatalogSnapshot indicates an expected call of GetLatestCatalogSnapshot.
+func (mr *MockModelInterfaceMockRecorder) GetLatestCatalogSnapshot(ctx, databaseID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLatestCatalogSnapshot", reflect.TypeOf((*MockModelInterface)(nil).GetLatestCatalogSnapshot), ctx, databaseID)
+}
+
+// GetLatestClusterHealthRecord mocks base method.
+func (m *MockModelInterface) GetLatestClusterHealthRecord(ctx context.Context, clusterID int32) (*querier.ClusterHealthRecord, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLatestClusterHealthRecord", ctx, clusterID)
+	ret0, _ := ret[0].(*querier.ClusterHealthRecord)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLatestClusterHealthRecord indicates an expected call of GetLatestClusterHealthRecord.
+func (mr *MockModelInterfaceMockRecorder) GetLatestClusterHealthRecord(ctx, clusterID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLatestClusterHealthRecord", reflect.TypeOf((*MockModelInterface)(nil).GetLatestClusterHealthRecord), ctx, clusterID)
+}
+
+// GetMetricsStore mocks base method.
+func (m *MockModelInterface) GetMetricsStore(ctx context.Context, id int32) (*querier.MetricsStore, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMetricsStore", ctx, id)
+	ret0, _ := ret[0].(*querier.MetricsStore)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMetricsStore indicates an expected call of GetMetricsStore.
+func (mr *MockModelInterfaceMockRecorder) GetMetricsStore(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMetricsStore", reflect.TypeOf((*MockModelInterface)(nil).GetMetricsStore), ctx, id)
+}
+
+// GetMetricsStoreByIDAndOrgID mocks base method.
+func (m *MockModelInterface) GetMetricsStoreByIDAndOrgID(ctx context.Context, arg querier.GetMetricsStoreByIDAndOrgIDParams) (*querier.MetricsStore, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetMetricsStoreByIDAndOrgID", ctx, arg)
 	ret0, _ := ret[0].(*querier.MetricsStore)
@@ -362,6 +889,21 @@ func (mr *MockModelInterfaceMockRecorder) GetMetricsStoreByIDAndOrgID(ctx, arg a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMetricsStoreByIDAndOrgID", reflect.TypeOf((*MockModelInterface)(nil).GetMetricsStoreByIDAndOrgID), ctx, arg)
 }
 
+// GetOrgAlertRule mocks base method.
+func (m *MockModelInterface) GetOrgAlertRule(ctx context.Context, arg querier.GetOrgAlertRuleParams) (*querier.AlertRule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOrgAlertRule", ctx, arg)
+	ret0, _ := ret[0].(*querier.AlertRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOrgAlertRule indicates an expected call of GetOrgAlertRule.
+func (mr *MockModelInterfaceMockRecorder) GetOrgAlertRule(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrgAlertRule", reflect.TypeOf((*MockModelInterface)(nil).GetOrgAlertRule), ctx, arg)
+}
+
 // GetOrgCluster mocks base method.
 func (m *MockModelInterface) GetOrgCluster(ctx context.Context, arg querier.GetOrgClusterParams) (*querier.Cluster, error) {
 	m.ctrl.T.Helper()
@@ -377,6 +919,36 @@ func (mr *MockModelInterfaceMockRecorder) GetOrgCluster(ctx, arg any) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrgCluster", reflect.TypeOf((*MockModelInterface)(nil).GetOrgCluster), ctx, arg)
 }
 
+// GetOrgClusterDiagnosticBundle mocks base method.
+func (m *MockModelInterface) GetOrgClusterDiagnosticBundle(ctx context.Context, arg querier.GetOrgClusterDiagnosticBundleParams) (*querier.ClusterDiagnosticBundle, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOrgClusterDiagnosticBundle", ctx, arg)
+	ret0, _ := ret[0].(*querier.ClusterDiagnosticBundle)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOrgClusterDiagnosticBundle indicates an expected call of GetOrgClusterDiagnosticBundle.
+func (mr *MockModelInterfaceMockRecorder) GetOrgClusterDiagnosticBundle(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrgClusterDiagnosticBundle", reflect.TypeOf((*MockModelInterface)(nil).GetOrgClusterDiagnosticBundle), ctx, arg)
+}
+
+// GetOrgClusterUpgrade mocks base method.
+func (m *MockModelInterface) GetOrgClusterUpgrade(ctx context.Context, arg querier.GetOrgClusterUpgradeParams) (*querier.ClusterUpgrade, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOrgClusterUpgrade", ctx, arg)
+	ret0, _ := ret[0].(*querier.ClusterUpgrade)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOrgClusterUpgrade indicates an expected call of GetOrgClusterUpgrade.
+func (mr *MockModelInterfaceMockRecorder) GetOrgClusterUpgrade(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrgClusterUpgrade", reflect.TypeOf((*MockModelInterface)(nil).GetOrgClusterUpgrade), ctx, arg)
+}
+
 // GetOrgDatabaseByID mocks base method.
 func (m *MockModelInterface) GetOrgDatabaseByID(ctx context.Context, arg querier.GetOrgDatabaseByIDParams) (*querier.DatabaseConnection, error) {
 	m.ctrl.T.Helper()
@@ -407,6 +979,36 @@ func (mr *MockModelInterfaceMockRecorder) GetOrgDatabaseConnection(ctx, arg any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrgDatabaseConnection", reflect.TypeOf((*MockModelInterface)(nil).GetOrgDatabaseConnection), ctx, arg)
 }
 
+// GetOrgRisectlExecution mocks base method.
+func (m *MockModelInterface) GetOrgRisectlExecution(ctx context.Context, arg querier.GetOrgRisectlExecutionParams) (*querier.RisectlExecution, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOrgRisectlExecution", ctx, arg)
+	ret0, _ := ret[0].(*querier.RisectlExecution)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOrgRisectlExecution indicates an expected call of GetOrgRisectlExecution.
+func (mr *MockModelInterfaceMockRecorder) GetOrgRisectlExecution(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrgRisectlExecution", reflect.TypeOf((*MockModelInterface)(nil).GetOrgRisectlExecution), ctx, arg)
+}
+
+// GetOrgRisectlShellSession mocks base method.
+func (m *MockModelInterface) GetOrgRisectlShellSession(ctx context.Context, arg querier.GetOrgRisectlShellSessionParams) (*querier.RisectlShellSession, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOrgRisectlShellSession", ctx, arg)
+	ret0, _ := ret[0].(*querier.RisectlShellSession)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOrgRisectlShellSession indicates an expected call of GetOrgRisectlShellSession.
+func (mr *MockModelInterfaceMockRecorder) GetOrgRisectlShellSession(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrgRisectlShellSession", reflect.TypeOf((*MockModelInterface)(nil).GetOrgRisectlShellSession), ctx, arg)
+}
+
 // GetOrgSettings mocks base method.
 func (m *MockModelInterface) GetOrgSettings(ctx context.Context, orgID int32) (*querier.OrgSetting, error) {
 	m.ctrl.T.Helper()
@@ -422,6 +1024,50 @@ func (mr *MockModelInterfaceMockRecorder) GetOrgSettings(ctx, orgID any) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrgSettings", reflect.TypeOf((*MockModelInterface)(nil).GetOrgSettings), ctx, orgID)
 }
 
+// GetPreviousClusterDiagnostic mocks base method.
+func (m *MockModelInterface) GetPreviousClusterDiagnostic(ctx context.Context, arg querier.GetPreviousClusterDiagnosticParams) (*querier.ClusterDiagnostic, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPreviousClusterDiagnostic", ctx, arg)
+	ret0, _ := ret[0].(*querier.ClusterDiagnostic)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPreviousClusterDiagnostic indicates an expected call of GetPreviousClusterDiagnostic.
+func (mr *MockModelInterfaceMockRecorder) GetPreviousClusterDiagnostic(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPreviousClusterDiagnostic", reflect.TypeOf((*MockModelInterface)(nil).GetPreviousClusterDiagnostic), ctx, arg)
+}
+
+// GetRisectlExecution mocks base method.
+func (m *MockModelInterface) GetRisectlExecution(ctx context.Context, id int32) (*querier.RisectlExecution, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRisectlExecution", ctx, id)
+	ret0, _ := ret[0].(*querier.RisectlExecution)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRisectlExecution indicates an expected call of GetRisectlExecution.
+func (mr *MockModelInterfaceMockRecorder) GetRisectlExecution(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRisectlExecution", reflect.TypeOf((*MockModelInterface)(nil).GetRisectlExecution), ctx, id)
+}
+
+// ImportClusterSnapshot mocks base method.
+func (m *MockModelInterface) ImportClusterSnapshot(ctx context.Context, arg querier.ImportClusterSnapshotParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ImportClusterSnapshot", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ImportClusterSnapshot indicates an expected call of ImportClusterSnapshot.
+func (mr *MockModelInterfaceMockRecorder) ImportClusterSnapshot(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportClusterSnapshot", reflect.TypeOf((*MockModelInterface)(nil).ImportClusterSnapshot), ctx, arg)
+}
+
 // InTransaction mocks base method.
 func (m *MockModelInterface) InTransaction() bool {
 	m.ctrl.T.Helper()
@@ -481,6 +1127,111 @@ func (mr *MockModelInterfaceMockRecorder) InitMetricsStore(ctx, arg any) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InitMetricsStore", reflect.TypeOf((*MockModelInterface)(nil).InitMetricsStore), ctx, arg)
 }
 
+// IsOrgOwner mocks base method.
+func (m *MockModelInterface) IsOrgOwner(ctx context.Context, arg querier.IsOrgOwnerParams) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsOrgOwner", ctx, arg)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsOrgOwner indicates an expected call of IsOrgOwner.
+func (mr *MockModelInterfaceMockRecorder) IsOrgOwner(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsOrgOwner", reflect.TypeOf((*MockModelInterface)(nil).IsOrgOwner), ctx, arg)
+}
+
+// IsRisectlExecutionCancelRequested mocks base method.
+func (m *MockModelInterface) IsRisectlExecutionCancelRequested(ctx context.Context, id int32) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsRisectlExecutionCancelRequested", ctx, id)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsRisectlExecutionCancelRequested indicates an expected call of IsRisectlExecutionCancelRequested.
+func (mr *MockModelInterfaceMockRecorder) IsRisectlExecutionCancelRequested(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsRisectlExecutionCancelRequested", reflect.TypeOf((*MockModelInterface)(nil).IsRisectlExecutionCancelRequested), ctx, id)
+}
+
+// ListAlertStates mocks base method.
+func (m *MockModelInterface) ListAlertStates(ctx context.Context) ([]*querier.ListAlertStatesRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAlertStates", ctx)
+	ret0, _ := ret[0].([]*querier.ListAlertStatesRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAlertStates indicates an expected call of ListAlertStates.
+func (mr *MockModelInterfaceMockRecorder) ListAlertStates(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAlertStates", reflect.TypeOf((*MockModelInterface)(nil).ListAlertStates), ctx)
+}
+
+// ListAllClusters mocks base method.
+func (m *MockModelInterface) ListAllClusters(ctx context.Context) ([]*querier.Cluster, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAllClusters", ctx)
+	ret0, _ := ret[0].([]*querier.Cluster)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAllClusters indicates an expected call of ListAllClusters.
+func (mr *MockModelInterfaceMockRecorder) ListAllClusters(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAllClusters", reflect.TypeOf((*MockModelInterface)(nil).ListAllClusters), ctx)
+}
+
+// ListAllDatabaseConnections mocks base method.
+func (m *MockModelInterface) ListAllDatabaseConnections(ctx context.Context) ([]*querier.DatabaseConnection, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAllDatabaseConnections", ctx)
+	ret0, _ := ret[0].([]*querier.DatabaseConnection)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAllDatabaseConnections indicates an expected call of ListAllDatabaseConnections.
+func (mr *MockModelInterfaceMockRecorder) ListAllDatabaseConnections(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAllDatabaseConnections", reflect.TypeOf((*MockModelInterface)(nil).ListAllDatabaseConnections), ctx)
+}
+
+// ListCatalogChangeEvents mocks base method.
+func (m *MockModelInterface) ListCatalogChangeEvents(ctx context.Context, arg querier.ListCatalogChangeEventsParams) ([]*querier.CatalogChangeEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListCatalogChangeEvents", ctx, arg)
+	ret0, _ := ret[0].([]*querier.CatalogChangeEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListCatalogChangeEvents indicates an expected call of ListCatalogChangeEvents.
+func (mr *MockModelInterfaceMockRecorder) ListCatalogChangeEvents(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCatalogChangeEvents", reflect.TypeOf((*MockModelInterface)(nil).ListCatalogChangeEvents), ctx, arg)
+}
+
+// ListClusterDiagnosticFindings mocks base method.
+func (m *MockModelInterface) ListClusterDiagnosticFindings(ctx context.Context, diagnosticID int32) ([]*querier.ClusterDiagnosticFinding, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListClusterDiagnosticFindings", ctx, diagnosticID)
+	ret0, _ := ret[0].([]*querier.ClusterDiagnosticFinding)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListClusterDiagnosticFindings indicates an expected call of ListClusterDiagnosticFindings.
+func (mr *MockModelInterfaceMockRecorder) ListClusterDiagnosticFindings(ctx, diagnosticID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListClusterDiagnosticFindings", reflect.TypeOf((*MockModelInterface)(nil).ListClusterDiagnosticFindings), ctx, diagnosticID)
+}
+
 // ListClusterDiagnostics mocks base method.
 func (m *MockModelInterface) ListClusterDiagnostics(ctx context.Context, clusterID int32) ([]*querier.ListClusterDiagnosticsRow, error) {
 	m.ctrl.T.Helper()
@@ -496,6 +1247,36 @@ func (mr *MockModelInterfaceMockRecorder) ListClusterDiagnostics(ctx, clusterID
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListClusterDiagnostics", reflect.TypeOf((*MockModelInterface)(nil).ListClusterDiagnostics), ctx, clusterID)
 }
 
+// ListClusterEvents mocks base method.
+func (m *MockModelInterface) ListClusterEvents(ctx context.Context, arg querier.ListClusterEventsParams) ([]*querier.ClusterEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListClusterEvents", ctx, arg)
+	ret0, _ := ret[0].([]*querier.ClusterEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListClusterEvents indicates an expected call of ListClusterEvents.
+func (mr *MockModelInterfaceMockRecorder) ListClusterEvents(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListClusterEvents", reflect.TypeOf((*MockModelInterface)(nil).ListClusterEvents), ctx, arg)
+}
+
+// ListClusterHealthRecords mocks base method.
+func (m *MockModelInterface) ListClusterHealthRecords(ctx context.Context, arg querier.ListClusterHealthRecordsParams) ([]*querier.ClusterHealthRecord, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListClusterHealthRecords", ctx, arg)
+	ret0, _ := ret[0].([]*querier.ClusterHealthRecord)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListClusterHealthRecords indicates an expected call of ListClusterHealthRecords.
+func (mr *MockModelInterfaceMockRecorder) ListClusterHealthRecords(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListClusterHealthRecords", reflect.TypeOf((*MockModelInterface)(nil).ListClusterHealthRecords), ctx, arg)
+}
+
 // ListClusterSnapshots mocks base method.
 func (m *MockModelInterface) ListClusterSnapshots(ctx context.Context, clusterID int32) ([]*querier.ClusterSnapshot, error) {
 	m.ctrl.T.Helper()
@@ -511,6 +1292,36 @@ func (mr *MockModelInterfaceMockRecorder) ListClusterSnapshots(ctx, clusterID an
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListClusterSnapshots", reflect.TypeOf((*MockModelInterface)(nil).ListClusterSnapshots), ctx, clusterID)
 }
 
+// ListClusterUpgrades mocks base method.
+func (m *MockModelInterface) ListClusterUpgrades(ctx context.Context, clusterID int32) ([]*querier.ClusterUpgrade, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListClusterUpgrades", ctx, clusterID)
+	ret0, _ := ret[0].([]*querier.ClusterUpgrade)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListClusterUpgrades indicates an expected call of ListClusterUpgrades.
+func (mr *MockModelInterfaceMockRecorder) ListClusterUpgrades(ctx, clusterID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListClusterUpgrades", reflect.TypeOf((*MockModelInterface)(nil).ListClusterUpgrades), ctx, clusterID)
+}
+
+// ListClusterVersionHistory mocks base method.
+func (m *MockModelInterface) ListClusterVersionHistory(ctx context.Context, clusterID int32) ([]*querier.ClusterVersionHistory, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListClusterVersionHistory", ctx, clusterID)
+	ret0, _ := ret[0].([]*querier.ClusterVersionHistory)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListClusterVersionHistory indicates an expected call of ListClusterVersionHistory.
+func (mr *MockModelInterfaceMockRecorder) ListClusterVersionHistory(ctx, clusterID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListClusterVersionHistory", reflect.TypeOf((*MockModelInterface)(nil).ListClusterVersionHistory), ctx, clusterID)
+}
+
 // ListClustersByMetricsStoreID mocks base method.
 func (m *MockModelInterface) ListClustersByMetricsStoreID(ctx context.Context, metricsStoreID *int32) ([]*querier.Cluster, error) {
 	m.ctrl.T.Helper()
@@ -526,6 +1337,66 @@ func (mr *MockModelInterfaceMockRecorder) ListClustersByMetricsStoreID(ctx, metr
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListClustersByMetricsStoreID", reflect.TypeOf((*MockModelInterface)(nil).ListClustersByMetricsStoreID), ctx, metricsStoreID)
 }
 
+// ListEnabledAlertRules mocks base method.
+func (m *MockModelInterface) ListEnabledAlertRules(ctx context.Context) ([]*querier.AlertRule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListEnabledAlertRules", ctx)
+	ret0, _ := ret[0].([]*querier.AlertRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListEnabledAlertRules indicates an expected call of ListEnabledAlertRules.
+func (mr *MockModelInterfaceMockRecorder) ListEnabledAlertRules(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEnabledAlertRules", reflect.TypeOf((*MockModelInterface)(nil).ListEnabledAlertRules), ctx)
+}
+
+// ListExpiredClusterDiagnosticBundles mocks base method.
+func (m *MockModelInterface) ListExpiredClusterDiagnosticBundles(ctx context.Context, arg querier.ListExpiredClusterDiagnosticBundlesParams) ([]*querier.ClusterDiagnosticBundle, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListExpiredClusterDiagnosticBundles", ctx, arg)
+	ret0, _ := ret[0].([]*querier.ClusterDiagnosticBundle)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListExpiredClusterDiagnosticBundles indicates an expected call of ListExpiredClusterDiagnosticBundles.
+func (mr *MockModelInterfaceMockRecorder) ListExpiredClusterDiagnosticBundles(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListExpiredClusterDiagnosticBundles", reflect.TypeOf((*MockModelInterface)(nil).ListExpiredClusterDiagnosticBundles), ctx, arg)
+}
+
+// ListInlineClusterDiagnosticBundles mocks base method.
+func (m *MockModelInterface) ListInlineClusterDiagnosticBundles(ctx context.Context, limit int32) ([]*querier.ClusterDiagnosticBundle, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListInlineClusterDiagnosticBundles", ctx, limit)
+	ret0, _ := ret[0].([]*querier.ClusterDiagnosticBundle)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListInlineClusterDiagnosticBundles indicates an expected call of ListInlineClusterDiagnosticBundles.
+func (mr *MockModelInterfaceMockRecorder) ListInlineClusterDiagnosticBundles(ctx, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListInlineClusterDiagnosticBundles", reflect.TypeOf((*MockModelInterface)(nil).ListInlineClusterDiagnosticBundles), ctx, limit)
+}
+
+// ListInlineClusterDiagnostics mocks base method.
+func (m *MockModelInterface) ListInlineClusterDiagnostics(ctx context.Context, limit int32) ([]*querier.ClusterDiagnostic, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListInlineClusterDiagnostics", ctx, limit)
+	ret0, _ := ret[0].([]*querier.ClusterDiagnostic)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListInlineClusterDiagnostics indicates an expected call of ListInlineClusterDiagnostics.
+func (mr *MockModelInterfaceMockRecorder) ListInlineClusterDiagnostics(ctx, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListInlineClusterDiagnostics", reflect.TypeOf((*MockModelInterface)(nil).ListInlineClusterDiagnostics), ctx, limit)
+}
+
 // ListMetricsStoresByOrgID mocks base method.
 func (m *MockModelInterface) ListMetricsStoresByOrgID(ctx context.Context, orgID int32) ([]*querier.MetricsStore, error) {
 	m.ctrl.T.Helper()
@@ -541,6 +1412,66 @@ func (mr *MockModelInterfaceMockRecorder) ListMetricsStoresByOrgID(ctx, orgID an
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListMetricsStoresByOrgID", reflect.TypeOf((*MockModelInterface)(nil).ListMetricsStoresByOrgID), ctx, orgID)
 }
 
+// ListOrgAlertHistory mocks base method.
+func (m *MockModelInterface) ListOrgAlertHistory(ctx context.Context, arg querier.ListOrgAlertHistoryParams) ([]*querier.AlertHistory, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListOrgAlertHistory", ctx, arg)
+	ret0, _ := ret[0].([]*querier.AlertHistory)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListOrgAlertHistory indicates an expected call of ListOrgAlertHistory.
+func (mr *MockModelInterfaceMockRecorder) ListOrgAlertHistory(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListOrgAlertHistory", reflect.TypeOf((*MockModelInterface)(nil).ListOrgAlertHistory), ctx, arg)
+}
+
+// ListOrgAlertRules mocks base method.
+func (m *MockModelInterface) ListOrgAlertRules(ctx context.Context, arg querier.ListOrgAlertRulesParams) ([]*querier.AlertRule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListOrgAlertRules", ctx, arg)
+	ret0, _ := ret[0].([]*querier.AlertRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListOrgAlertRules indicates an expected call of ListOrgAlertRules.
+func (mr *MockModelInterfaceMockRecorder) ListOrgAlertRules(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListOrgAlertRules", reflect.TypeOf((*MockModelInterface)(nil).ListOrgAlertRules), ctx, arg)
+}
+
+// ListOrgAlertStates mocks base method.
+func (m *MockModelInterface) ListOrgAlertStates(ctx context.Context, arg querier.ListOrgAlertStatesParams) ([]*querier.ListOrgAlertStatesRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListOrgAlertStates", ctx, arg)
+	ret0, _ := ret[0].([]*querier.ListOrgAlertStatesRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListOrgAlertStates indicates an expected call of ListOrgAlertStates.
+func (mr *MockModelInterfaceMockRecorder) ListOrgAlertStates(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListOrgAlertStates", reflect.TypeOf((*MockModelInterface)(nil).ListOrgAlertStates), ctx, arg)
+}
+
+// ListOrgClusterDiagnosticBundles mocks base method.
+func (m *MockModelInterface) ListOrgClusterDiagnosticBundles(ctx context.Context, arg querier.ListOrgClusterDiagnosticBundlesParams) ([]*querier.ClusterDiagnosticBundle, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListOrgClusterDiagnosticBundles", ctx, arg)
+	ret0, _ := ret[0].([]*querier.ClusterDiagnosticBundle)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListOrgClusterDiagnosticBundles indicates an expected call of ListOrgClusterDiagnosticBundles.
+func (mr *MockModelInterfaceMockRecorder) ListOrgClusterDiagnosticBundles(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListOrgClusterDiagnosticBundles", reflect.TypeOf((*MockModelInterface)(nil).ListOrgClusterDiagnosticBundles), ctx, arg)
+}
+
 // ListOrgClusters mocks base method.
 func (m *MockModelInterface) ListOrgClusters(ctx context.Context, orgID int32) ([]*querier.Cluster, error) {
 	m.ctrl.T.Helper()
@@ -571,6 +1502,110 @@ func (mr *MockModelInterfaceMockRecorder) ListOrgDatabaseConnections(ctx, orgID
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListOrgDatabaseConnections", reflect.TypeOf((*MockModelInterface)(nil).ListOrgDatabaseConnections), ctx, orgID)
 }
 
+// ListOrgDiagnosticRules mocks base method.
+func (m *MockModelInterface) ListOrgDiagnosticRules(ctx context.Context, orgID int32) ([]*querier.OrgDiagnosticRule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListOrgDiagnosticRules", ctx, orgID)
+	ret0, _ := ret[0].([]*querier.OrgDiagnosticRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListOrgDiagnosticRules indicates an expected call of ListOrgDiagnosticRules.
+func (mr *MockModelInterfaceMockRecorder) ListOrgDiagnosticRules(ctx, orgID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListOrgDiagnosticRules", reflect.TypeOf((*MockModelInterface)(nil).ListOrgDiagnosticRules), ctx, orgID)
+}
+
+// ListOrgRisectlExecutions mocks base method.
+func (m *MockModelInterface) ListOrgRisectlExecutions(ctx context.Context, arg querier.ListOrgRisectlExecutionsParams) ([]*querier.RisectlExecution, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListOrgRisectlExecutions", ctx, arg)
+	ret0, _ := ret[0].([]*querier.RisectlExecution)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListOrgRisectlExecutions indicates an expected call of ListOrgRisectlExecutions.
+func (mr *MockModelInterfaceMockRecorder) ListOrgRisectlExecutions(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListOrgRisectlExecutions", reflect.TypeOf((*MockModelInterface)(nil).ListOrgRisectlExecutions), ctx, arg)
+}
+
+// ListOrgRisectlShellSessions mocks base method.
+func (m *MockModelInterface) ListOrgRisectlShellSessions(ctx context.Context, arg querier.ListOrgRisectlShellSessionsParams) ([]*querier.RisectlShellSession, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListOrgRisectlShellSessions", ctx, arg)
+	ret0, _ := ret[0].([]*querier.RisectlShellSession)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListOrgRisectlShellSessions indicates an expected call of ListOrgRisectlShellSessions.
+func (mr *MockModelInterfaceMockRecorder) ListOrgRisectlShellSessions(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListOrgRisectlShellSessions", reflect.TypeOf((*MockModelInterface)(nil).ListOrgRisectlShellSessions), ctx, arg)
+}
+
+// ListRisectlExecutionOutputs mocks base method.
+func (m *MockModelInterface) ListRisectlExecutionOutputs(ctx context.Context, arg querier.ListRisectlExecutionOutputsParams) ([]*querier.RisectlExecutionOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListRisectlExecutionOutputs", ctx, arg)
+	ret0, _ := ret[0].([]*querier.RisectlExecutionOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListRisectlExecutionOutputs indicates an expected call of ListRisectlExecutionOutputs.
+func (mr *MockModelInterfaceMockRecorder) ListRisectlExecutionOutputs(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRisectlExecutionOutputs", reflect.TypeOf((*MockModelInterface)(nil).ListRisectlExecutionOutputs), ctx, arg)
+}
+
+// ListRisectlShellCommands mocks base method.
+func (m *MockModelInterface) ListRisectlShellCommands(ctx context.Context, sessionID int32) ([]*querier.RisectlShellCommand, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListRisectlShellCommands", ctx, sessionID)
+	ret0, _ := ret[0].([]*querier.RisectlShellCommand)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListRisectlShellCommands indicates an expected call of ListRisectlShellCommands.
+func (mr *MockModelInterfaceMockRecorder) ListRisectlShellCommands(ctx, sessionID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRisectlShellCommands", reflect.TypeOf((*MockModelInterface)(nil).ListRisectlShellCommands), ctx, sessionID)
+}
+
+// ListUnindexedClusterDiagnostics mocks base method.
+func (m *MockModelInterface) ListUnindexedClusterDiagnostics(ctx context.Context, limit int32) ([]*querier.ClusterDiagnostic, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListUnindexedClusterDiagnostics", ctx, limit)
+	ret0, _ := ret[0].([]*querier.ClusterDiagnostic)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListUnindexedClusterDiagnostics indicates an expected call of ListUnindexedClusterDiagnostics.
+func (mr *MockModelInterfaceMockRecorder) ListUnindexedClusterDiagnostics(ctx, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListUnindexedClusterDiagnostics", reflect.TypeOf((*MockModelInterface)(nil).ListUnindexedClusterDiagnostics), ctx, limit)
+}
+
+// MoveClusterDiagnosticContent mocks base method.
+func (m *MockModelInterface) MoveClusterDiagnosticContent(ctx context.Context, arg querier.MoveClusterDiagnosticContentParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MoveClusterDiagnosticContent", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MoveClusterDiagnosticContent indicates an expected call of MoveClusterDiagnosticContent.
+func (mr *MockModelInterfaceMockRecorder) MoveClusterDiagnosticContent(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MoveClusterDiagnosticContent", reflect.TypeOf((*MockModelInterface)(nil).MoveClusterDiagnosticContent), ctx, arg)
+}
+
 // RemoveClusterMetricsStoreID mocks base method.
 func (m *MockModelInterface) RemoveClusterMetricsStoreID(ctx context.Context, arg querier.RemoveClusterMetricsStoreIDParams) error {
 	m.ctrl.T.Helper()
@@ -585,6 +1620,20 @@ func (mr *MockModelInterfaceMockRecorder) RemoveClusterMetricsStoreID(ctx, arg a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveClusterMetricsStoreID", reflect.TypeOf((*MockModelInterface)(nil).RemoveClusterMetricsStoreID), ctx, arg)
 }
 
+// RequestRisectlExecutionCancel mocks base method.
+func (m *MockModelInterface) RequestRisectlExecutionCancel(ctx context.Context, id int32) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RequestRisectlExecutionCancel", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RequestRisectlExecutionCancel indicates an expected call of RequestRisectlExecutionCancel.
+func (mr *MockModelInterfaceMockRecorder) RequestRisectlExecutionCancel(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RequestRisectlExecutionCancel", reflect.TypeOf((*MockModelInterface)(nil).RequestRisectlExecutionCancel), ctx, id)
+}
+
 // RunTransaction mocks base method.
 func (m *MockModelInterface) RunTransaction(ctx context.Context, f func(ModelInterface) error) error {
 	m.ctrl.T.Helper()
@@ -613,6 +1662,21 @@ func (mr *MockModelInterfaceMockRecorder) RunTransactionWithTx(ctx, f any) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RunTransactionWithTx", reflect.TypeOf((*MockModelInterface)(nil).RunTransactionWithTx), ctx, f)
 }
 
+// SearchOrgClusterDiagnostics mocks base method.
+func (m *MockModelInterface) SearchOrgClusterDiagnostics(ctx context.Context, arg querier.SearchOrgClusterDiagnosticsParams) ([]*querier.SearchOrgClusterDiagnosticsRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchOrgClusterDiagnostics", ctx, arg)
+	ret0, _ := ret[0].([]*querier.SearchOrgClusterDiagnosticsRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchOrgClusterDiagnostics indicates an expected call of SearchOrgClusterDiagnostics.
+func (mr *MockModelInterfaceMockRecorder) SearchOrgClusterDiagnostics(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchOrgClusterDiagnostics", reflect.TypeOf((*MockModelInterface)(nil).SearchOrgClusterDiagnostics), ctx, arg)
+}
+
 // SpawnWithTx mocks base method.
 func (m *MockModelInterface) SpawnWithTx(tx pgx.Tx) ModelInterface {
 	m.ctrl.T.Helper()
@@ -627,6 +1691,34 @@ func (mr *MockModelInterfaceMockRecorder) SpawnWithTx(tx any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SpawnWithTx", reflect.TypeOf((*MockModelInterface)(nil).SpawnWithTx), tx)
 }
 
+// StartRisectlExecution mocks base method.
+func (m *MockModelInterface) StartRisectlExecution(ctx context.Context, arg querier.StartRisectlExecutionParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartRisectlExecution", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// StartRisectlExecution indicates an expected call of StartRisectlExecution.
+func (mr *MockModelInterfaceMockRecorder) StartRisectlExecution(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartRisectlExecution", reflect.TypeOf((*MockModelInterface)(nil).StartRisectlExecution), ctx, arg)
+}
+
+// UpdateAlertRuleLastError mocks base method.
+func (m *MockModelInterface) UpdateAlertRuleLastError(ctx context.Context, arg querier.UpdateAlertRuleLastErrorParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateAlertRuleLastError", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateAlertRuleLastError indicates an expected call of UpdateAlertRuleLastError.
+func (mr *MockModelInterfaceMockRecorder) UpdateAlertRuleLastError(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAlertRuleLastError", reflect.TypeOf((*MockModelInterface)(nil).UpdateAlertRuleLastError), ctx, arg)
+}
+
 // UpdateAutoBackupConfig mocks base method.
 func (m *MockModelInterface) UpdateAutoBackupConfig(ctx context.Context, arg querier.UpdateAutoBackupConfigParams) error {
 	m.ctrl.T.Helper()
@@ -641,6 +1733,20 @@ func (mr *MockModelInterfaceMockRecorder) UpdateAutoBackupConfig(ctx, arg any) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAutoBackupConfig", reflect.TypeOf((*MockModelInterface)(nil).UpdateAutoBackupConfig), ctx, arg)
 }
 
+// UpdateAutoBackupRetentionPolicy mocks base method.
+func (m *MockModelInterface) UpdateAutoBackupRetentionPolicy(ctx context.Context, arg querier.UpdateAutoBackupRetentionPolicyParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateAutoBackupRetentionPolicy", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateAutoBackupRetentionPolicy indicates an expected call of UpdateAutoBackupRetentionPolicy.
+func (mr *MockModelInterfaceMockRecorder) UpdateAutoBackupRetentionPolicy(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAutoBackupRetentionPolicy", reflect.TypeOf((*MockModelInterface)(nil).UpdateAutoBackupRetentionPolicy), ctx, arg)
+}
+
 // UpdateAutoDiagnosticsConfig mocks base method.
 func (m *MockModelInterface) UpdateAutoDiagnosticsConfig(ctx context.Context, arg querier.UpdateAutoDiagnosticsConfigParams) error {
 	m.ctrl.T.Helper()
@@ -655,6 +1761,118 @@ func (mr *MockModelInterfaceMockRecorder) UpdateAutoDiagnosticsConfig(ctx, arg a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAutoDiagnosticsConfig", reflect.TypeOf((*MockModelInterface)(nil).UpdateAutoDiagnosticsConfig), ctx, arg)
 }
 
+// UpdateClusterDetectedVersion mocks base method.
+func (m *MockModelInterface) UpdateClusterDetectedVersion(ctx context.Context, arg querier.UpdateClusterDetectedVersionParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateClusterDetectedVersion", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateClusterDetectedVersion indicates an expected call of UpdateClusterDetectedVersion.
+func (mr *MockModelInterfaceMockRecorder) UpdateClusterDetectedVersion(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateClusterDetectedVersion", reflect.TypeOf((*MockModelInterface)(nil).UpdateClusterDetectedVersion), ctx, arg)
+}
+
+// UpdateClusterDiagnosticBundleContentRef mocks base method.
+func (m *MockModelInterface) UpdateClusterDiagnosticBundleContentRef(ctx context.Context, arg querier.UpdateClusterDiagnosticBundleContentRefParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateClusterDiagnosticBundleContentRef", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateClusterDiagnosticBundleContentRef indicates an expected call of UpdateClusterDiagnosticBundleContentRef.
+func (mr *MockModelInterfaceMockRecorder) UpdateClusterDiagnosticBundleContentRef(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateClusterDiagnosticBundleContentRef", reflect.TypeOf((*MockModelInterface)(nil).UpdateClusterDiagnosticBundleContentRef), ctx, arg)
+}
+
+// UpdateClusterDiagnosticBundleStatus mocks base method.
+func (m *MockModelInterface) UpdateClusterDiagnosticBundleStatus(ctx context.Context, arg querier.UpdateClusterDiagnosticBundleStatusParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateClusterDiagnosticBundleStatus", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateClusterDiagnosticBundleStatus indicates an expected call of UpdateClusterDiagnosticBundleStatus.
+func (mr *MockModelInterfaceMockRecorder) UpdateClusterDiagnosticBundleStatus(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateClusterDiagnosticBundleStatus", reflect.TypeOf((*MockModelInterface)(nil).UpdateClusterDiagnosticBundleStatus), ctx, arg)
+}
+
+// UpdateClusterHealth mocks base method.
+func (m *MockModelInterface) UpdateClusterHealth(ctx context.Context, arg querier.UpdateClusterHealthParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateClusterHealth", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateClusterHealth indicates an expected call of UpdateClusterHealth.
+func (mr *MockModelInterfaceMockRecorder) UpdateClusterHealth(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateClusterHealth", reflect.TypeOf((*MockModelInterface)(nil).UpdateClusterHealth), ctx, arg)
+}
+
+// UpdateClusterSnapshotSyncStatus mocks base method.
+func (m *MockModelInterface) UpdateClusterSnapshotSyncStatus(ctx context.Context, arg querier.UpdateClusterSnapshotSyncStatusParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateClusterSnapshotSyncStatus", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateClusterSnapshotSyncStatus indicates an expected call of UpdateClusterSnapshotSyncStatus.
+func (mr *MockModelInterfaceMockRecorder) UpdateClusterSnapshotSyncStatus(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateClusterSnapshotSyncStatus", reflect.TypeOf((*MockModelInterface)(nil).UpdateClusterSnapshotSyncStatus), ctx, arg)
+}
+
+// UpdateClusterUpgradeDetectedVersion mocks base method.
+func (m *MockModelInterface) UpdateClusterUpgradeDetectedVersion(ctx context.Context, arg querier.UpdateClusterUpgradeDetectedVersionParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateClusterUpgradeDetectedVersion", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateClusterUpgradeDetectedVersion indicates an expected call of UpdateClusterUpgradeDetectedVersion.
+func (mr *MockModelInterfaceMockRecorder) UpdateClusterUpgradeDetectedVersion(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateClusterUpgradeDetectedVersion", reflect.TypeOf((*MockModelInterface)(nil).UpdateClusterUpgradeDetectedVersion), ctx, arg)
+}
+
+// UpdateClusterUpgradeSnapshot mocks base method.
+func (m *MockModelInterface) UpdateClusterUpgradeSnapshot(ctx context.Context, arg querier.UpdateClusterUpgradeSnapshotParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateClusterUpgradeSnapshot", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateClusterUpgradeSnapshot indicates an expected call of UpdateClusterUpgradeSnapshot.
+func (mr *MockModelInterfaceMockRecorder) UpdateClusterUpgradeSnapshot(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateClusterUpgradeSnapshot", reflect.TypeOf((*MockModelInterface)(nil).UpdateClusterUpgradeSnapshot), ctx, arg)
+}
+
+// UpdateClusterVersion mocks base method.
+func (m *MockModelInterface) UpdateClusterVersion(ctx context.Context, arg querier.UpdateClusterVersionParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateClusterVersion", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateClusterVersion indicates an expected call of UpdateClusterVersion.
+func (mr *MockModelInterfaceMockRecorder) UpdateClusterVersion(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateClusterVersion", reflect.TypeOf((*MockModelInterface)(nil).UpdateClusterVersion), ctx, arg)
+}
+
 // UpdateMetricsStore mocks base method.
 func (m *MockModelInterface) UpdateMetricsStore(ctx context.Context, arg querier.UpdateMetricsStoreParams) (*querier.MetricsStore, error) {
 	m.ctrl.T.Helper()
@@ -670,6 +1888,21 @@ func (mr *MockModelInterfaceMockRecorder) UpdateMetricsStore(ctx, arg any) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateMetricsStore", reflect.TypeOf((*MockModelInterface)(nil).UpdateMetricsStore), ctx, arg)
 }
 
+// UpdateOrgAlertRule mocks base method.
+func (m *MockModelInterface) UpdateOrgAlertRule(ctx context.Context, arg querier.UpdateOrgAlertRuleParams) (*querier.AlertRule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateOrgAlertRule", ctx, arg)
+	ret0, _ := ret[0].(*querier.AlertRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateOrgAlertRule indicates an expected call of UpdateOrgAlertRule.
+func (mr *MockModelInterfaceMockRecorder) UpdateOrgAlertRule(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateOrgAlertRule", reflect.TypeOf((*MockModelInterface)(nil).UpdateOrgAlertRule), ctx, arg)
+}
+
 // UpdateOrgCluster mocks base method.
 func (m *MockModelInterface) UpdateOrgCluster(ctx context.Context, arg querier.UpdateOrgClusterParams) (*querier.Cluster, error) {
 	m.ctrl.T.Helper()
@@ -699,3 +1932,47 @@ func (mr *MockModelInterfaceMockRecorder) UpdateOrgDatabaseConnection(ctx, arg a
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateOrgDatabaseConnection", reflect.TypeOf((*MockModelInterface)(nil).UpdateOrgDatabaseConnection), ctx, arg)
 }
+
+// UpdateOrgSettings mocks base method.
+func (m *MockModelInterface) UpdateOrgSettings(ctx context.Context, arg querier.UpdateOrgSettingsParams) (*querier.OrgSetting, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateOrgSettings", ctx, arg)
+	ret0, _ := ret[0].(*querier.OrgSetting)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateOrgSettings indicates an expected call of UpdateOrgSettings.
+func (mr *MockModelInterfaceMockRecorder) UpdateOrgSettings(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateOrgSettings", reflect.TypeOf((*MockModelInterface)(nil).UpdateOrgSettings), ctx, arg)
+}
+
+// UpsertAlertState mocks base method.
+func (m *MockModelInterface) UpsertAlertState(ctx context.Context, arg querier.UpsertAlertStateParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertAlertState", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpsertAlertState indicates an expected call of UpsertAlertState.
+func (mr *MockModelInterfaceMockRecorder) UpsertAlertState(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertAlertState", reflect.TypeOf((*MockModelInterface)(nil).UpsertAlertState), ctx, arg)
+}
+
+// UpsertOrgDiagnosticRule mocks base method.
+func (m *MockModelInterface) UpsertOrgDiagnosticRule(ctx context.Context, arg querier.UpsertOrgDiagnosticRuleParams) (*querier.OrgDiagnosticRule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertOrgDiagnosticRule", ctx, arg)
+	ret0, _ := ret[0].(*querier.OrgDiagnosticRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpsertOrgDiagnosticRule indicates an expected call of UpsertOrgDiagnosticRule.
+func (mr *MockModelInterfaceMockRecorder) UpsertOrgDiagnosticRule(ctx, arg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertOrgDiagnosticRule", reflect.TypeOf((*MockModelInterface)(nil).UpsertOrgDiagnosticRule), ctx, arg)
+}
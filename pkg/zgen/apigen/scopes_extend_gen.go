@@ -29,6 +29,111 @@ func NewXMiddleware(handler ServerInterface, validator Validator) ServerInterfac
 	return &XMiddleware{ServerInterface: handler, Validator: validator}
 }
 
+// List alert rules
+// (GET /alert-rules)
+func (x *XMiddleware) ListAlertRules(c *fiber.Ctx, params ListAlertRulesParams) error {
+    if err := x.AuthFunc(c); err != nil {
+		return c.Status(fiber.StatusUnauthorized).SendString(err.Error())
+	} 
+	if err := x.PreValidate(c); err != nil {
+		return c.Status(fiber.StatusForbidden).SendString(err.Error())
+	}
+	   
+	if err := x.PostValidate(c); err != nil {
+		return c.Status(fiber.StatusForbidden).SendString(err.Error())
+	}
+    return x.ServerInterface.ListAlertRules(c, params)
+}
+// Create alert rule
+// (POST /alert-rules)
+func (x *XMiddleware) CreateAlertRule(c *fiber.Ctx) error {
+    if err := x.AuthFunc(c); err != nil {
+		return c.Status(fiber.StatusUnauthorized).SendString(err.Error())
+	} 
+	if err := x.PreValidate(c); err != nil {
+		return c.Status(fiber.StatusForbidden).SendString(err.Error())
+	}
+	   
+	if err := x.PostValidate(c); err != nil {
+		return c.Status(fiber.StatusForbidden).SendString(err.Error())
+	}
+    return x.ServerInterface.CreateAlertRule(c)
+}
+// Delete alert rule
+// (DELETE /alert-rules/{ID})
+func (x *XMiddleware) DeleteAlertRule(c *fiber.Ctx, id int32) error {
+    if err := x.AuthFunc(c); err != nil {
+		return c.Status(fiber.StatusUnauthorized).SendString(err.Error())
+	} 
+	if err := x.PreValidate(c); err != nil {
+		return c.Status(fiber.StatusForbidden).SendString(err.Error())
+	}
+	   
+	if err := x.PostValidate(c); err != nil {
+		return c.Status(fiber.StatusForbidden).SendString(err.Error())
+	}
+    return x.ServerInterface.DeleteAlertRule(c, id)
+}
+// Get alert rule
+// (GET /alert-rules/{ID})
+func (x *XMiddleware) GetAlertRule(c *fiber.Ctx, id int32) error {
+    if err := x.AuthFunc(c); err != nil {
+		return c.Status(fiber.StatusUnauthorized).SendString(err.Error())
+	} 
+	if err := x.PreValidate(c); err != nil {
+		return c.Status(fiber.StatusForbidden).SendString(err.Error())
+	}
+	   
+	if err := x.PostValidate(c); err != nil {
+		return c.Status(fiber.StatusForbidden).SendString(err.Error())
+	}
+    return x.ServerInterface.GetAlertRule(c, id)
+}
+// Update alert rule
+// (PUT /alert-rules/{ID})
+func (x *XMiddleware) UpdateAlertRule(c *fiber.Ctx, id int32) error {
+    if err := x.AuthFunc(c); err != nil {
+		return c.Status(fiber.StatusUnauthorized).SendString(err.Error())
+	} 
+	if err := x.PreValidate(c); err != nil {
+		return c.Status(fiber.StatusForbidden).SendString(err.Error())
+	}
+	   
+	if err := x.PostValidate(c); err != nil {
+		return c.Status(fiber.StatusForbidden).SendString(err.Error())
+	}
+    return x.ServerInterface.UpdateAlertRule(c, id)
+}
+// List alerts
+// (GET /alerts)
+func (x *XMiddleware) ListAlerts(c *fiber.Ctx, params ListAlertsParams) error {
+    if err := x.AuthFunc(c); err != nil {
+		return c.Status(fiber.StatusUnauthorized).SendString(err.Error())
+	} 
+	if err := x.PreValidate(c); err != nil {
+		return c.Status(fiber.StatusForbidden).SendString(err.Error())
+	}
+	   
+	if err := x.PostValidate(c); err != nil {
+		return c.Status(fiber.StatusForbidden).SendString(err.Error())
+	}
+    return x.ServerInterface.ListAlerts(c, params)
+}
+// List alert history
+// (GET /alerts/history)
+func (x *XMiddleware) ListAlertHistory(c *fiber.Ctx, params ListAlertHistoryParams) error {
+    if err := x.AuthFunc(c); err != nil {
+		return c.Status(fiber.StatusUnauthorized).SendString(err.Error())
+	} 
+	if err := x.PreValidate(c); err != nil {
+		return c.Status(fiber.StatusForbidden).SendString(err.Error())
+	}
+	   
+	if err := x.PostValidate(c); err != nil {
+		return c.Status(fiber.StatusForbidden).SendString(err.Error())
+	}
+    return x.ServerInterface.ListAlertHistory(c, params)
+}
 // List all clusters
 // (GET /clusters)
 func (x *XMiddleware) ListClusters(c *fiber.Ctx) error {
@@ -42,29 +147,374 @@ func (x *XMiddleware) ListClusters(c *fiber.Ctx) error {
 	if err := x.PostValidate(c); err != nil {
 		return c.Status(fiber.StatusForbidden).SendString(err.Error())
 	}
-    return x.ServerInterface.ListClusters(c)
+    return x.ServerInterface.ListClusters(c)
+}
+// Create a new cluster
+// (POST /clusters)
+func (x *XMiddleware) CreateCluster(c *fiber.Ctx) error {
+    if err := x.AuthFunc(c); err != nil {
+		return c.Status(fiber.StatusUnauthorized).SendString(err.Error())
+	} 
+	if err := x.PreValidate(c); err != nil {
+		return c.Status(fiber.StatusForbidden).SendString(err.Error())
+	}
+	  
+	if err := x.PremiumAccess(c); err != nil {
+	    return c.Status(fiber.StatusForbidden).SendString(err.Error())
+	}  
+	if err := x.PostValidate(c); err != nil {
+		return c.Status(fiber.StatusForbidden).SendString(err.Error())
+	}
+    return x.ServerInterface.CreateCluster(c)
+}
+// Import a cluster
+// (POST /clusters/import)
+func (x *XMiddleware) ImportCluster(c *fiber.Ctx) error {
+    if err := x.AuthFunc(c); err != nil {
+		return c.Status(fiber.StatusUnauthorized).SendString(err.Error())
+	} 
+	if err := x.PreValidate(c); err != nil {
+		return c.Status(fiber.StatusForbidden).SendString(err.Error())
+	}
+	   
+	if err := x.PostValidate(c); err != nil {
+		return c.Status(fiber.StatusForbidden).SendString(err.Error())
+	}
+    return x.ServerInterface.ImportCluster(c)
+}
+// Delete cluster
+// (DELETE /clusters/{ID})
+func (x *XMiddleware) DeleteCluster(c *fiber.Ctx, id int32, params DeleteClusterParams) error {
+    if err := x.AuthFunc(c); err != nil {
+		return c.Status(fiber.StatusUnauthorized).SendString(err.Error())
+	} 
+	if err := x.PreValidate(c); err != nil {
+		return c.Status(fiber.StatusForbidden).SendString(err.Error())
+	}
+	   
+	if err := x.PostValidate(c); err != nil {
+		return c.Status(fiber.StatusForbidden).SendString(err.Error())
+	}
+    return x.ServerInterface.DeleteCluster(c, id, params)
+}
+// Get cluster details
+// (GET /clusters/{ID})
+func (x *XMiddleware) GetCluster(c *fiber.Ctx, id int32) error {
+    if err := x.AuthFunc(c); err != nil {
+		return c.Status(fiber.StatusUnauthorized).SendString(err.Error())
+	} 
+	if err := x.PreValidate(c); err != nil {
+		return c.Status(fiber.StatusForbidden).SendString(err.Error())
+	}
+	   
+	if err := x.PostValidate(c); err != nil {
+		return c.Status(fiber.StatusForbidden).SendString(err.Error())
+	}
+    return x.ServerInterface.GetCluster(c, id)
+}
+// Update cluster
+// (PUT /clusters/{ID})
+func (x *XMiddleware) UpdateCluster(c *fiber.Ctx, id int32) error {
+    if err := x.AuthFunc(c); err != nil {
+		return c.Status(fiber.StatusUnauthorized).SendString(err.Error())
+	} 
+	if err := x.PreValidate(c); err != nil {
+		return c.Status(fiber.StatusForbidden).SendString(err.Error())
+	}
+	   
+	if err := x.PostValidate(c); err != nil {
+		return c.Status(fiber.StatusForbidden).SendString(err.Error())
+	}
+    return x.ServerInterface.UpdateCluster(c, id)
+}
+// Get snapshot configuration
+// (GET /clusters/{ID}/auto-backup-config)
+func (x *XMiddleware) GetClusterAutoBackupConfig(c *fiber.Ctx, id int32) error {
+    if err := x.AuthFunc(c); err != nil {
+		return c.Status(fiber.StatusUnauthorized).SendString(err.Error())
+	} 
+	if err := x.PreValidate(c); err != nil {
+		return c.Status(fiber.StatusForbidden).SendString(err.Error())
+	}
+	   
+	if err := x.PostValidate(c); err != nil {
+		return c.Status(fiber.StatusForbidden).SendString(err.Error())
+	}
+    return x.ServerInterface.GetClusterAutoBackupConfig(c, id)
+}
+// Update snapshot configuration
+// (PUT /clusters/{ID}/auto-backup-config)
+func (x *XMiddleware) UpdateClusterAutoBackupConfig(c *fiber.Ctx, id int32) error {
+    if err := x.AuthFunc(c); err != nil {
+		return c.Status(fiber.StatusUnauthorized).SendString(err.Error())
+	} 
+	if err := x.PreValidate(c); err != nil {
+		return c.Status(fiber.StatusForbidden).SendString(err.Error())
+	}
+	   
+	if err := x.PostValidate(c); err != nil {
+		return c.Status(fiber.StatusForbidden).SendString(err.Error())
+	}
+    return x.ServerInterface.UpdateClusterAutoBackupConfig(c, id)
+}
+// Preview snapshot retention
+// (POST /clusters/{ID}/auto-backup-config/retention-preview)
+func (x *XMiddleware) PreviewClusterSnapshotRetention(c *fiber.Ctx, id int32) error {
+    if err := x.AuthFunc(c); err != nil {
+		return c.Status(fiber.StatusUnauthorized).SendString(err.Error())
+	} 
+	if err := x.PreValidate(c); err != nil {
+		return c.Status(fiber.StatusForbidden).SendString(err.Error())
+	}
+	   
+	if err := x.PostValidate(c); err != nil {
+		return c.Status(fiber.StatusForbidden).SendString(err.Error())
+	}
+    return x.ServerInterface.PreviewClusterSnapshotRetention(c, id)
+}
+// List diagnostic bundles
+// (GET /clusters/{ID}/diagnostic-bundles)
+func (x *XMiddleware) ListClusterDiagnosticBundles(c *fiber.Ctx, id int32) error {
+    if err := x.AuthFunc(c); err != nil {
+		return c.Status(fiber.StatusUnauthorized).SendString(err.Error())
+	} 
+	if err := x.PreValidate(c); err != nil {
+		return c.Status(fiber.StatusForbidden).SendString(err.Error())
+	}
+	   
+	if err := x.PostValidate(c); err != nil {
+		return c.Status(fiber.StatusForbidden).SendString(err.Error())
+	}
+    return x.ServerInterface.ListClusterDiagnosticBundles(c, id)
+}
+// Create a diagnostic bundle
+// (POST /clusters/{ID}/diagnostic-bundles)
+func (x *XMiddleware) CreateClusterDiagnosticBundle(c *fiber.Ctx, id int32) error {
+    if err := x.AuthFunc(c); err != nil {
+		return c.Status(fiber.StatusUnauthorized).SendString(err.Error())
+	} 
+	if err := x.PreValidate(c); err != nil {
+		return c.Status(fiber.StatusForbidden).SendString(err.Error())
+	}
+	   
+	if err := x.PostValidate(c); err != nil {
+		return c.Status(fiber.StatusForbidden).SendString(err.Error())
+	}
+    return x.ServerInterface.CreateClusterDiagnosticBundle(c, id)
+}
+// Delete a diagnostic bundle
+// (DELETE /clusters/{ID}/diagnostic-bundles/{bundleId})
+func (x *XMiddleware) DeleteClusterDiagnosticBundle(c *fiber.Ctx, id int32, bundleId int32) error {
+    if err := x.AuthFunc(c); err != nil {
+		return c.Status(fiber.StatusUnauthorized).SendString(err.Error())
+	} 
+	if err := x.PreValidate(c); err != nil {
+		return c.Status(fiber.StatusForbidden).SendString(err.Error())
+	}
+	   
+	if err := x.PostValidate(c); err != nil {
+		return c.Status(fiber.StatusForbidden).SendString(err.Error())
+	}
+    return x.ServerInterface.DeleteClusterDiagnosticBundle(c, id, bundleId)
+}
+// Get a diagnostic bundle
+// (GET /clusters/{ID}/diagnostic-bundles/{bundleId})
+func (x *XMiddleware) GetClusterDiagnosticBundle(c *fiber.Ctx, id int32, bundleId int32) error {
+    if err := x.AuthFunc(c); err != nil {
+		return c.Status(fiber.StatusUnauthorized).SendString(err.Error())
+	} 
+	if err := x.PreValidate(c); err != nil {
+		return c.Status(fiber.StatusForbidden).SendString(err.Error())
+	}
+	   
+	if err := x.PostValidate(c); err != nil {
+		return c.Status(fiber.StatusForbidden).SendString(err.Error())
+	}
+    return x.ServerInterface.GetClusterDiagnosticBundle(c, id, bundleId)
+}
+// Download a diagnostic bundle
+// (GET /clusters/{ID}/diagnostic-bundles/{bundleId}/download)
+func (x *XMiddleware) DownloadClusterDiagnosticBundle(c *fiber.Ctx, id int32, bundleId int32) error {
+    if err := x.AuthFunc(c); err != nil {
+		return c.Status(fiber.StatusUnauthorized).SendString(err.Error())
+	} 
+	if err := x.PreValidate(c); err != nil {
+		return c.Status(fiber.StatusForbidden).SendString(err.Error())
+	}
+	   
+	if err := x.PostValidate(c); err != nil {
+		return c.Status(fiber.StatusForbidden).SendString(err.Error())
+	}
+    return x.ServerInterface.DownloadClusterDiagnosticBundle(c, id, bundleId)
+}
+// List diagnostic data
+// (GET /clusters/{ID}/diagnostics)
+func (x *XMiddleware) ListClusterDiagnostics(c *fiber.Ctx, id int32, params ListClusterDiagnosticsParams) error {
+    if err := x.AuthFunc(c); err != nil {
+		return c.Status(fiber.StatusUnauthorized).SendString(err.Error())
+	} 
+	if err := x.PreValidate(c); err != nil {
+		return c.Status(fiber.StatusForbidden).SendString(err.Error())
+	}
+	   
+	if err := x.PostValidate(c); err != nil {
+		return c.Status(fiber.StatusForbidden).SendString(err.Error())
+	}
+    return x.ServerInterface.ListClusterDiagnostics(c, id, params)
+}
+// Create diagnostic data
+// (POST /clusters/{ID}/diagnostics)
+func (x *XMiddleware) CreateClusterDiagnostic(c *fiber.Ctx, id int32) error {
+    if err := x.AuthFunc(c); err != nil {
+		return c.Status(fiber.StatusUnauthorized).SendString(err.Error())
+	} 
+	if err := x.PreValidate(c); err != nil {
+		return c.Status(fiber.StatusForbidden).SendString(err.Error())
+	}
+	   
+	if err := x.PostValidate(c); err != nil {
+		return c.Status(fiber.StatusForbidden).SendString(err.Error())
+	}
+    return x.ServerInterface.CreateClusterDiagnostic(c, id)
+}
+// Get diagnostic configuration
+// (GET /clusters/{ID}/diagnostics/config)
+func (x *XMiddleware) GetClusterAutoDiagnosticConfig(c *fiber.Ctx, id int32) error {
+    if err := x.AuthFunc(c); err != nil {
+		return c.Status(fiber.StatusUnauthorized).SendString(err.Error())
+	} 
+	if err := x.PreValidate(c); err != nil {
+		return c.Status(fiber.StatusForbidden).SendString(err.Error())
+	}
+	   
+	if err := x.PostValidate(c); err != nil {
+		return c.Status(fiber.StatusForbidden).SendString(err.Error())
+	}
+    return x.ServerInterface.GetClusterAutoDiagnosticConfig(c, id)
+}
+// Update diagnostic configuration
+// (PUT /clusters/{ID}/diagnostics/config)
+func (x *XMiddleware) UpdateClusterAutoDiagnosticConfig(c *fiber.Ctx, id int32) error {
+    if err := x.AuthFunc(c); err != nil {
+		return c.Status(fiber.StatusUnauthorized).SendString(err.Error())
+	} 
+	if err := x.PreValidate(c); err != nil {
+		return c.Status(fiber.StatusForbidden).SendString(err.Error())
+	}
+	   
+	if err := x.PostValidate(c); err != nil {
+		return c.Status(fiber.StatusForbidden).SendString(err.Error())
+	}
+    return x.ServerInterface.UpdateClusterAutoDiagnosticConfig(c, id)
+}
+// Diff diagnostic data
+// (GET /clusters/{ID}/diagnostics/diff)
+func (x *XMiddleware) DiffClusterDiagnostics(c *fiber.Ctx, id int32, params DiffClusterDiagnosticsParams) error {
+    if err := x.AuthFunc(c); err != nil {
+		return c.Status(fiber.StatusUnauthorized).SendString(err.Error())
+	} 
+	if err := x.PreValidate(c); err != nil {
+		return c.Status(fiber.StatusForbidden).SendString(err.Error())
+	}
+	   
+	if err := x.PostValidate(c); err != nil {
+		return c.Status(fiber.StatusForbidden).SendString(err.Error())
+	}
+    return x.ServerInterface.DiffClusterDiagnostics(c, id, params)
+}
+// Search diagnostic data
+// (GET /clusters/{ID}/diagnostics/search)
+func (x *XMiddleware) SearchClusterDiagnostics(c *fiber.Ctx, id int32, params SearchClusterDiagnosticsParams) error {
+    if err := x.AuthFunc(c); err != nil {
+		return c.Status(fiber.StatusUnauthorized).SendString(err.Error())
+	} 
+	if err := x.PreValidate(c); err != nil {
+		return c.Status(fiber.StatusForbidden).SendString(err.Error())
+	}
+	   
+	if err := x.PostValidate(c); err != nil {
+		return c.Status(fiber.StatusForbidden).SendString(err.Error())
+	}
+    return x.ServerInterface.SearchClusterDiagnostics(c, id, params)
+}
+// Get diagnostic data
+// (GET /clusters/{ID}/diagnostics/{diagnosticId})
+func (x *XMiddleware) GetClusterDiagnostic(c *fiber.Ctx, id int32, diagnosticId int32) error {
+    if err := x.AuthFunc(c); err != nil {
+		return c.Status(fiber.StatusUnauthorized).SendString(err.Error())
+	} 
+	if err := x.PreValidate(c); err != nil {
+		return c.Status(fiber.StatusForbidden).SendString(err.Error())
+	}
+	   
+	if err := x.PostValidate(c); err != nil {
+		return c.Status(fiber.StatusForbidden).SendString(err.Error())
+	}
+    return x.ServerInterface.GetClusterDiagnostic(c, id, diagnosticId)
+}
+// List diagnostic findings
+// (GET /clusters/{ID}/diagnostics/{diagnosticId}/findings)
+func (x *XMiddleware) ListClusterDiagnosticFindings(c *fiber.Ctx, id int32, diagnosticId int32) error {
+    if err := x.AuthFunc(c); err != nil {
+		return c.Status(fiber.StatusUnauthorized).SendString(err.Error())
+	} 
+	if err := x.PreValidate(c); err != nil {
+		return c.Status(fiber.StatusForbidden).SendString(err.Error())
+	}
+	   
+	if err := x.PostValidate(c); err != nil {
+		return c.Status(fiber.StatusForbidden).SendString(err.Error())
+	}
+    return x.ServerInterface.ListClusterDiagnosticFindings(c, id, diagnosticId)
+}
+// Get diagnostic report
+// (GET /clusters/{ID}/diagnostics/{diagnosticId}/report)
+func (x *XMiddleware) GetClusterDiagnosticReport(c *fiber.Ctx, id int32, diagnosticId int32) error {
+    if err := x.AuthFunc(c); err != nil {
+		return c.Status(fiber.StatusUnauthorized).SendString(err.Error())
+	} 
+	if err := x.PreValidate(c); err != nil {
+		return c.Status(fiber.StatusForbidden).SendString(err.Error())
+	}
+	   
+	if err := x.PostValidate(c); err != nil {
+		return c.Status(fiber.StatusForbidden).SendString(err.Error())
+	}
+    return x.ServerInterface.GetClusterDiagnosticReport(c, id, diagnosticId)
+}
+// List cluster events
+// (GET /clusters/{ID}/events)
+func (x *XMiddleware) ListClusterEvents(c *fiber.Ctx, id int32, params ListClusterEventsParams) error {
+    if err := x.AuthFunc(c); err != nil {
+		return c.Status(fiber.StatusUnauthorized).SendString(err.Error())
+	} 
+	if err := x.PreValidate(c); err != nil {
+		return c.Status(fiber.StatusForbidden).SendString(err.Error())
+	}
+	   
+	if err := x.PostValidate(c); err != nil {
+		return c.Status(fiber.StatusForbidden).SendString(err.Error())
+	}
+    return x.ServerInterface.ListClusterEvents(c, id, params)
 }
-// Create a new cluster
-// (POST /clusters)
-func (x *XMiddleware) CreateCluster(c *fiber.Ctx) error {
+// List cluster health history
+// (GET /clusters/{ID}/health-history)
+func (x *XMiddleware) ListClusterHealthHistory(c *fiber.Ctx, id int32, params ListClusterHealthHistoryParams) error {
     if err := x.AuthFunc(c); err != nil {
 		return c.Status(fiber.StatusUnauthorized).SendString(err.Error())
 	} 
 	if err := x.PreValidate(c); err != nil {
 		return c.Status(fiber.StatusForbidden).SendString(err.Error())
 	}
-	  
-	if err := x.PremiumAccess(c); err != nil {
-	    return c.Status(fiber.StatusForbidden).SendString(err.Error())
-	}  
+	   
 	if err := x.PostValidate(c); err != nil {
 		return c.Status(fiber.StatusForbidden).SendString(err.Error())
 	}
-    return x.ServerInterface.CreateCluster(c)
+    return x.ServerInterface.ListClusterHealthHistory(c, id, params)
 }
-// Import a cluster
-// (POST /clusters/import)
-func (x *XMiddleware) ImportCluster(c *fiber.Ctx) error {
+// Run risectl command
+// (POST /clusters/{ID}/risectl)
+func (x *XMiddleware) RunRisectlCommand(c *fiber.Ctx, id int32) error {
     if err := x.AuthFunc(c); err != nil {
 		return c.Status(fiber.StatusUnauthorized).SendString(err.Error())
 	} 
@@ -75,11 +525,11 @@ func (x *XMiddleware) ImportCluster(c *fiber.Ctx) error {
 	if err := x.PostValidate(c); err != nil {
 		return c.Status(fiber.StatusForbidden).SendString(err.Error())
 	}
-    return x.ServerInterface.ImportCluster(c)
+    return x.ServerInterface.RunRisectlCommand(c, id)
 }
-// Delete cluster
-// (DELETE /clusters/{ID})
-func (x *XMiddleware) DeleteCluster(c *fiber.Ctx, id int32, params DeleteClusterParams) error {
+// List risectl executions
+// (GET /clusters/{ID}/risectl/executions)
+func (x *XMiddleware) ListRisectlExecutions(c *fiber.Ctx, id int32, params ListRisectlExecutionsParams) error {
     if err := x.AuthFunc(c); err != nil {
 		return c.Status(fiber.StatusUnauthorized).SendString(err.Error())
 	} 
@@ -90,11 +540,11 @@ func (x *XMiddleware) DeleteCluster(c *fiber.Ctx, id int32, params DeleteCluster
 	if err := x.PostValidate(c); err != nil {
 		return c.Status(fiber.StatusForbidden).SendString(err.Error())
 	}
-    return x.ServerInterface.DeleteCluster(c, id, params)
+    return x.ServerInterface.ListRisectlExecutions(c, id, params)
 }
-// Get cluster details
-// (GET /clusters/{ID})
-func (x *XMiddleware) GetCluster(c *fiber.Ctx, id int32) error {
+// Start a risectl execution
+// (POST /clusters/{ID}/risectl/executions)
+func (x *XMiddleware) CreateRisectlExecution(c *fiber.Ctx, id int32) error {
     if err := x.AuthFunc(c); err != nil {
 		return c.Status(fiber.StatusUnauthorized).SendString(err.Error())
 	} 
@@ -105,11 +555,11 @@ func (x *XMiddleware) GetCluster(c *fiber.Ctx, id int32) error {
 	if err := x.PostValidate(c); err != nil {
 		return c.Status(fiber.StatusForbidden).SendString(err.Error())
 	}
-    return x.ServerInterface.GetCluster(c, id)
+    return x.ServerInterface.CreateRisectlExecution(c, id)
 }
-// Update cluster
-// (PUT /clusters/{ID})
-func (x *XMiddleware) UpdateCluster(c *fiber.Ctx, id int32) error {
+// Get a risectl execution
+// (GET /clusters/{ID}/risectl/executions/{executionID})
+func (x *XMiddleware) GetRisectlExecution(c *fiber.Ctx, id int32, executionID int32) error {
     if err := x.AuthFunc(c); err != nil {
 		return c.Status(fiber.StatusUnauthorized).SendString(err.Error())
 	} 
@@ -120,11 +570,11 @@ func (x *XMiddleware) UpdateCluster(c *fiber.Ctx, id int32) error {
 	if err := x.PostValidate(c); err != nil {
 		return c.Status(fiber.StatusForbidden).SendString(err.Error())
 	}
-    return x.ServerInterface.UpdateCluster(c, id)
+    return x.ServerInterface.GetRisectlExecution(c, id, executionID)
 }
-// Get snapshot configuration
-// (GET /clusters/{ID}/auto-backup-config)
-func (x *XMiddleware) GetClusterAutoBackupConfig(c *fiber.Ctx, id int32) error {
+// Cancel a risectl execution
+// (POST /clusters/{ID}/risectl/executions/{executionID}/cancel)
+func (x *XMiddleware) CancelRisectlExecution(c *fiber.Ctx, id int32, executionID int32) error {
     if err := x.AuthFunc(c); err != nil {
 		return c.Status(fiber.StatusUnauthorized).SendString(err.Error())
 	} 
@@ -135,11 +585,11 @@ func (x *XMiddleware) GetClusterAutoBackupConfig(c *fiber.Ctx, id int32) error {
 	if err := x.PostValidate(c); err != nil {
 		return c.Status(fiber.StatusForbidden).SendString(err.Error())
 	}
-    return x.ServerInterface.GetClusterAutoBackupConfig(c, id)
+    return x.ServerInterface.CancelRisectlExecution(c, id, executionID)
 }
-// Update snapshot configuration
-// (PUT /clusters/{ID}/auto-backup-config)
-func (x *XMiddleware) UpdateClusterAutoBackupConfig(c *fiber.Ctx, id int32) error {
+// Get the output of a risectl execution
+// (GET /clusters/{ID}/risectl/executions/{executionID}/output)
+func (x *XMiddleware) ListRisectlExecutionOutputs(c *fiber.Ctx, id int32, executionID int32, params ListRisectlExecutionOutputsParams) error {
     if err := x.AuthFunc(c); err != nil {
 		return c.Status(fiber.StatusUnauthorized).SendString(err.Error())
 	} 
@@ -150,11 +600,11 @@ func (x *XMiddleware) UpdateClusterAutoBackupConfig(c *fiber.Ctx, id int32) erro
 	if err := x.PostValidate(c); err != nil {
 		return c.Status(fiber.StatusForbidden).SendString(err.Error())
 	}
-    return x.ServerInterface.UpdateClusterAutoBackupConfig(c, id)
+    return x.ServerInterface.ListRisectlExecutionOutputs(c, id, executionID, params)
 }
-// List diagnostic data
-// (GET /clusters/{ID}/diagnostics)
-func (x *XMiddleware) ListClusterDiagnostics(c *fiber.Ctx, id int32, params ListClusterDiagnosticsParams) error {
+// Stream the output of a risectl execution
+// (GET /clusters/{ID}/risectl/executions/{executionID}/stream)
+func (x *XMiddleware) StreamRisectlExecution(c *fiber.Ctx, id int32, executionID int32, params StreamRisectlExecutionParams) error {
     if err := x.AuthFunc(c); err != nil {
 		return c.Status(fiber.StatusUnauthorized).SendString(err.Error())
 	} 
@@ -165,11 +615,11 @@ func (x *XMiddleware) ListClusterDiagnostics(c *fiber.Ctx, id int32, params List
 	if err := x.PostValidate(c); err != nil {
 		return c.Status(fiber.StatusForbidden).SendString(err.Error())
 	}
-    return x.ServerInterface.ListClusterDiagnostics(c, id, params)
+    return x.ServerInterface.StreamRisectlExecution(c, id, executionID, params)
 }
-// Create diagnostic data
-// (POST /clusters/{ID}/diagnostics)
-func (x *XMiddleware) CreateClusterDiagnostic(c *fiber.Ctx, id int32) error {
+// List risectl operations
+// (GET /clusters/{ID}/risectl/operations)
+func (x *XMiddleware) ListRisectlOperations(c *fiber.Ctx, id int32) error {
     if err := x.AuthFunc(c); err != nil {
 		return c.Status(fiber.StatusUnauthorized).SendString(err.Error())
 	} 
@@ -180,11 +630,11 @@ func (x *XMiddleware) CreateClusterDiagnostic(c *fiber.Ctx, id int32) error {
 	if err := x.PostValidate(c); err != nil {
 		return c.Status(fiber.StatusForbidden).SendString(err.Error())
 	}
-    return x.ServerInterface.CreateClusterDiagnostic(c, id)
+    return x.ServerInterface.ListRisectlOperations(c, id)
 }
-// Get diagnostic configuration
-// (GET /clusters/{ID}/diagnostics/config)
-func (x *XMiddleware) GetClusterAutoDiagnosticConfig(c *fiber.Ctx, id int32) error {
+// Run a risectl operation
+// (POST /clusters/{ID}/risectl/operations/{operation})
+func (x *XMiddleware) RunRisectlOperation(c *fiber.Ctx, id int32, operation RisectlOperationName) error {
     if err := x.AuthFunc(c); err != nil {
 		return c.Status(fiber.StatusUnauthorized).SendString(err.Error())
 	} 
@@ -195,11 +645,11 @@ func (x *XMiddleware) GetClusterAutoDiagnosticConfig(c *fiber.Ctx, id int32) err
 	if err := x.PostValidate(c); err != nil {
 		return c.Status(fiber.StatusForbidden).SendString(err.Error())
 	}
-    return x.ServerInterface.GetClusterAutoDiagnosticConfig(c, id)
+    return x.ServerInterface.RunRisectlOperation(c, id, operation)
 }
-// Update diagnostic configuration
-// (PUT /clusters/{ID}/diagnostics/config)
-func (x *XMiddleware) UpdateClusterAutoDiagnosticConfig(c *fiber.Ctx, id int32) error {
+// Open an interactive risectl shell
+// (GET /clusters/{ID}/risectl/shell)
+func (x *XMiddleware) OpenRisectlShell(c *fiber.Ctx, id int32) error {
     if err := x.AuthFunc(c); err != nil {
 		return c.Status(fiber.StatusUnauthorized).SendString(err.Error())
 	} 
@@ -210,11 +660,11 @@ func (x *XMiddleware) UpdateClusterAutoDiagnosticConfig(c *fiber.Ctx, id int32)
 	if err := x.PostValidate(c); err != nil {
 		return c.Status(fiber.StatusForbidden).SendString(err.Error())
 	}
-    return x.ServerInterface.UpdateClusterAutoDiagnosticConfig(c, id)
+    return x.ServerInterface.OpenRisectlShell(c, id)
 }
-// Get diagnostic data
-// (GET /clusters/{ID}/diagnostics/{diagnosticId})
-func (x *XMiddleware) GetClusterDiagnostic(c *fiber.Ctx, id int32, diagnosticId int32) error {
+// List risectl shell sessions
+// (GET /clusters/{ID}/risectl/shell/sessions)
+func (x *XMiddleware) ListRisectlShellSessions(c *fiber.Ctx, id int32) error {
     if err := x.AuthFunc(c); err != nil {
 		return c.Status(fiber.StatusUnauthorized).SendString(err.Error())
 	} 
@@ -225,11 +675,11 @@ func (x *XMiddleware) GetClusterDiagnostic(c *fiber.Ctx, id int32, diagnosticId
 	if err := x.PostValidate(c); err != nil {
 		return c.Status(fiber.StatusForbidden).SendString(err.Error())
 	}
-    return x.ServerInterface.GetClusterDiagnostic(c, id, diagnosticId)
+    return x.ServerInterface.ListRisectlShellSessions(c, id)
 }
-// Run risectl command
-// (POST /clusters/{ID}/risectl)
-func (x *XMiddleware) RunRisectlCommand(c *fiber.Ctx, id int32) error {
+// List the commands of a risectl shell session
+// (GET /clusters/{ID}/risectl/shell/sessions/{sessionID}/commands)
+func (x *XMiddleware) ListRisectlShellCommands(c *fiber.Ctx, id int32, sessionID int32) error {
     if err := x.AuthFunc(c); err != nil {
 		return c.Status(fiber.StatusUnauthorized).SendString(err.Error())
 	} 
@@ -240,7 +690,7 @@ func (x *XMiddleware) RunRisectlCommand(c *fiber.Ctx, id int32) error {
 	if err := x.PostValidate(c); err != nil {
 		return c.Status(fiber.StatusForbidden).SendString(err.Error())
 	}
-    return x.ServerInterface.RunRisectlCommand(c, id)
+    return x.ServerInterface.ListRisectlShellCommands(c, id, sessionID)
 }
 // List cluster snapshots
 // (GET /clusters/{ID}/snapshots)
@@ -302,6 +752,81 @@ func (x *XMiddleware) RestoreClusterSnapshot(c *fiber.Ctx, id int32, snapshotId
 	}
     return x.ServerInterface.RestoreClusterSnapshot(c, id, snapshotId)
 }
+// List cluster upgrades
+// (GET /clusters/{ID}/upgrades)
+func (x *XMiddleware) ListClusterUpgrades(c *fiber.Ctx, id int32) error {
+    if err := x.AuthFunc(c); err != nil {
+		return c.Status(fiber.StatusUnauthorized).SendString(err.Error())
+	} 
+	if err := x.PreValidate(c); err != nil {
+		return c.Status(fiber.StatusForbidden).SendString(err.Error())
+	}
+	   
+	if err := x.PostValidate(c); err != nil {
+		return c.Status(fiber.StatusForbidden).SendString(err.Error())
+	}
+    return x.ServerInterface.ListClusterUpgrades(c, id)
+}
+// Start a cluster upgrade
+// (POST /clusters/{ID}/upgrades)
+func (x *XMiddleware) CreateClusterUpgrade(c *fiber.Ctx, id int32) error {
+    if err := x.AuthFunc(c); err != nil {
+		return c.Status(fiber.StatusUnauthorized).SendString(err.Error())
+	} 
+	if err := x.PreValidate(c); err != nil {
+		return c.Status(fiber.StatusForbidden).SendString(err.Error())
+	}
+	   
+	if err := x.PostValidate(c); err != nil {
+		return c.Status(fiber.StatusForbidden).SendString(err.Error())
+	}
+    return x.ServerInterface.CreateClusterUpgrade(c, id)
+}
+// Get a cluster upgrade
+// (GET /clusters/{ID}/upgrades/{upgradeID})
+func (x *XMiddleware) GetClusterUpgrade(c *fiber.Ctx, id int32, upgradeID int32) error {
+    if err := x.AuthFunc(c); err != nil {
+		return c.Status(fiber.StatusUnauthorized).SendString(err.Error())
+	} 
+	if err := x.PreValidate(c); err != nil {
+		return c.Status(fiber.StatusForbidden).SendString(err.Error())
+	}
+	   
+	if err := x.PostValidate(c); err != nil {
+		return c.Status(fiber.StatusForbidden).SendString(err.Error())
+	}
+    return x.ServerInterface.GetClusterUpgrade(c, id, upgradeID)
+}
+// Cancel a cluster upgrade
+// (POST /clusters/{ID}/upgrades/{upgradeID}/cancel)
+func (x *XMiddleware) CancelClusterUpgrade(c *fiber.Ctx, id int32, upgradeID int32) error {
+    if err := x.AuthFunc(c); err != nil {
+		return c.Status(fiber.StatusUnauthorized).SendString(err.Error())
+	} 
+	if err := x.PreValidate(c); err != nil {
+		return c.Status(fiber.StatusForbidden).SendString(err.Error())
+	}
+	   
+	if err := x.PostValidate(c); err != nil {
+		return c.Status(fiber.StatusForbidden).SendString(err.Error())
+	}
+    return x.ServerInterface.CancelClusterUpgrade(c, id, upgradeID)
+}
+// List cluster version history
+// (GET /clusters/{ID}/version-history)
+func (x *XMiddleware) ListClusterVersionHistory(c *fiber.Ctx, id int32) error {
+    if err := x.AuthFunc(c); err != nil {
+		return c.Status(fiber.StatusUnauthorized).SendString(err.Error())
+	} 
+	if err := x.PreValidate(c); err != nil {
+		return c.Status(fiber.StatusForbidden).SendString(err.Error())
+	}
+	   
+	if err := x.PostValidate(c); err != nil {
+		return c.Status(fiber.StatusForbidden).SendString(err.Error())
+	}
+    return x.ServerInterface.ListClusterVersionHistory(c, id)
+}
 // List all databases
 // (GET /databases)
 func (x *XMiddleware) ListDatabases(c *fiber.Ctx) error {
@@ -364,7 +889,7 @@ func (x *XMiddleware) DeleteDatabase(c *fiber.Ctx, id int32) error {
 }
 // Get database details
 // (GET /databases/{ID})
-func (x *XMiddleware) GetDatabase(c *fiber.Ctx, id int32) error {
+func (x *XMiddleware) GetDatabase(c *fiber.Ctx, id int32, params GetDatabaseParams) error {
     if err := x.AuthFunc(c); err != nil {
 		return c.Status(fiber.StatusUnauthorized).SendString(err.Error())
 	} 
@@ -378,7 +903,7 @@ func (x *XMiddleware) GetDatabase(c *fiber.Ctx, id int32) error {
 	if err := x.PostValidate(c); err != nil {
 		return c.Status(fiber.StatusForbidden).SendString(err.Error())
 	}
-    return x.ServerInterface.GetDatabase(c, id)
+    return x.ServerInterface.GetDatabase(c, id, params)
 }
 // Update database
 // (PUT /databases/{ID})
@@ -395,6 +920,24 @@ func (x *XMiddleware) UpdateDatabase(c *fiber.Ctx, id int32) error {
 	}
     return x.ServerInterface.UpdateDatabase(c, id)
 }
+// List catalog changes
+// (GET /databases/{ID}/catalog-history)
+func (x *XMiddleware) ListDatabaseCatalogChanges(c *fiber.Ctx, id int32, params ListDatabaseCatalogChangesParams) error {
+    if err := x.AuthFunc(c); err != nil {
+		return c.Status(fiber.StatusUnauthorized).SendString(err.Error())
+	} 
+	if err := x.PreValidate(c); err != nil {
+		return c.Status(fiber.StatusForbidden).SendString(err.Error())
+	}
+	  
+	if err := x.OwnDatabase(c, x.GetOrgID(c), id); err != nil {
+	    return c.Status(fiber.StatusForbidden).SendString(err.Error())
+	}  
+	if err := x.PostValidate(c); err != nil {
+		return c.Status(fiber.StatusForbidden).SendString(err.Error())
+	}
+    return x.ServerInterface.ListDatabaseCatalogChanges(c, id, params)
+}
 // Get DDL progress
 // (GET /databases/{ID}/ddl-progress)
 func (x *XMiddleware) GetDDLProgress(c *fiber.Ctx, id int32) error {
@@ -425,6 +968,60 @@ func (x *XMiddleware) CancelDDLProgress(c *fiber.Ctx, id int32, ddlID int64) err
 	}
     return x.ServerInterface.CancelDDLProgress(c, id, ddlID)
 }
+// Analyze DDL impact
+// (POST /databases/{ID}/impact-analysis)
+func (x *XMiddleware) AnalyzeDatabaseImpact(c *fiber.Ctx, id int32) error {
+    if err := x.AuthFunc(c); err != nil {
+		return c.Status(fiber.StatusUnauthorized).SendString(err.Error())
+	} 
+	if err := x.PreValidate(c); err != nil {
+		return c.Status(fiber.StatusForbidden).SendString(err.Error())
+	}
+	  
+	if err := x.OwnDatabase(c, x.GetOrgID(c), id); err != nil {
+	    return c.Status(fiber.StatusForbidden).SendString(err.Error())
+	}  
+	if err := x.PostValidate(c); err != nil {
+		return c.Status(fiber.StatusForbidden).SendString(err.Error())
+	}
+    return x.ServerInterface.AnalyzeDatabaseImpact(c, id)
+}
+// Get database lineage
+// (GET /databases/{ID}/lineage)
+func (x *XMiddleware) GetDatabaseLineage(c *fiber.Ctx, id int32, params GetDatabaseLineageParams) error {
+    if err := x.AuthFunc(c); err != nil {
+		return c.Status(fiber.StatusUnauthorized).SendString(err.Error())
+	} 
+	if err := x.PreValidate(c); err != nil {
+		return c.Status(fiber.StatusForbidden).SendString(err.Error())
+	}
+	  
+	if err := x.OwnDatabase(c, x.GetOrgID(c), id); err != nil {
+	    return c.Status(fiber.StatusForbidden).SendString(err.Error())
+	}  
+	if err := x.PostValidate(c); err != nil {
+		return c.Status(fiber.StatusForbidden).SendString(err.Error())
+	}
+    return x.ServerInterface.GetDatabaseLineage(c, id, params)
+}
+// Export database lineage
+// (GET /databases/{ID}/lineage/export)
+func (x *XMiddleware) ExportDatabaseLineage(c *fiber.Ctx, id int32, params ExportDatabaseLineageParams) error {
+    if err := x.AuthFunc(c); err != nil {
+		return c.Status(fiber.StatusUnauthorized).SendString(err.Error())
+	} 
+	if err := x.PreValidate(c); err != nil {
+		return c.Status(fiber.StatusForbidden).SendString(err.Error())
+	}
+	  
+	if err := x.OwnDatabase(c, x.GetOrgID(c), id); err != nil {
+	    return c.Status(fiber.StatusForbidden).SendString(err.Error())
+	}  
+	if err := x.PostValidate(c); err != nil {
+		return c.Status(fiber.StatusForbidden).SendString(err.Error())
+	}
+    return x.ServerInterface.ExportDatabaseLineage(c, id, params)
+}
 // Query database
 // (POST /databases/{ID}/query)
 func (x *XMiddleware) QueryDatabase(c *fiber.Ctx, id int32) error {
@@ -440,6 +1037,69 @@ func (x *XMiddleware) QueryDatabase(c *fiber.Ctx, id int32) error {
 	}
     return x.ServerInterface.QueryDatabase(c, id)
 }
+// List database relations
+// (GET /databases/{ID}/relations)
+func (x *XMiddleware) ListDatabaseRelations(c *fiber.Ctx, id int32, params ListDatabaseRelationsParams) error {
+    if err := x.AuthFunc(c); err != nil {
+		return c.Status(fiber.StatusUnauthorized).SendString(err.Error())
+	} 
+	if err := x.PreValidate(c); err != nil {
+		return c.Status(fiber.StatusForbidden).SendString(err.Error())
+	}
+	  
+	if err := x.OwnDatabase(c, x.GetOrgID(c), id); err != nil {
+	    return c.Status(fiber.StatusForbidden).SendString(err.Error())
+	}  
+	if err := x.PostValidate(c); err != nil {
+		return c.Status(fiber.StatusForbidden).SendString(err.Error())
+	}
+    return x.ServerInterface.ListDatabaseRelations(c, id, params)
+}
+// List diagnostic rules
+// (GET /diagnostic-rules)
+func (x *XMiddleware) ListDiagnosticRules(c *fiber.Ctx) error {
+    if err := x.AuthFunc(c); err != nil {
+		return c.Status(fiber.StatusUnauthorized).SendString(err.Error())
+	} 
+	if err := x.PreValidate(c); err != nil {
+		return c.Status(fiber.StatusForbidden).SendString(err.Error())
+	}
+	   
+	if err := x.PostValidate(c); err != nil {
+		return c.Status(fiber.StatusForbidden).SendString(err.Error())
+	}
+    return x.ServerInterface.ListDiagnosticRules(c)
+}
+// Update diagnostic rule
+// (PUT /diagnostic-rules/{rule})
+func (x *XMiddleware) UpdateDiagnosticRule(c *fiber.Ctx, rule DiagnosticRuleName) error {
+    if err := x.AuthFunc(c); err != nil {
+		return c.Status(fiber.StatusUnauthorized).SendString(err.Error())
+	} 
+	if err := x.PreValidate(c); err != nil {
+		return c.Status(fiber.StatusForbidden).SendString(err.Error())
+	}
+	   
+	if err := x.PostValidate(c); err != nil {
+		return c.Status(fiber.StatusForbidden).SendString(err.Error())
+	}
+    return x.ServerInterface.UpdateDiagnosticRule(c, rule)
+}
+// Search diagnostic data of all clusters
+// (GET /diagnostics/search)
+func (x *XMiddleware) SearchDiagnostics(c *fiber.Ctx, params SearchDiagnosticsParams) error {
+    if err := x.AuthFunc(c); err != nil {
+		return c.Status(fiber.StatusUnauthorized).SendString(err.Error())
+	} 
+	if err := x.PreValidate(c); err != nil {
+		return c.Status(fiber.StatusForbidden).SendString(err.Error())
+	}
+	   
+	if err := x.PostValidate(c); err != nil {
+		return c.Status(fiber.StatusForbidden).SendString(err.Error())
+	}
+    return x.ServerInterface.SearchDiagnostics(c, params)
+}
 // Get all events
 // (GET /events)
 func (x *XMiddleware) ListEvents(c *fiber.Ctx) error {
@@ -545,6 +1205,51 @@ func (x *XMiddleware) GetMaterializedViewThroughput(c *fiber.Ctx, clusterID int3
 	}
     return x.ServerInterface.GetMaterializedViewThroughput(c, clusterID)
 }
+// Get organization settings
+// (GET /org-settings)
+func (x *XMiddleware) GetOrgSettings(c *fiber.Ctx) error {
+    if err := x.AuthFunc(c); err != nil {
+		return c.Status(fiber.StatusUnauthorized).SendString(err.Error())
+	} 
+	if err := x.PreValidate(c); err != nil {
+		return c.Status(fiber.StatusForbidden).SendString(err.Error())
+	}
+	   
+	if err := x.PostValidate(c); err != nil {
+		return c.Status(fiber.StatusForbidden).SendString(err.Error())
+	}
+    return x.ServerInterface.GetOrgSettings(c)
+}
+// Update organization settings
+// (PUT /org-settings)
+func (x *XMiddleware) UpdateOrgSettings(c *fiber.Ctx) error {
+    if err := x.AuthFunc(c); err != nil {
+		return c.Status(fiber.StatusUnauthorized).SendString(err.Error())
+	} 
+	if err := x.PreValidate(c); err != nil {
+		return c.Status(fiber.StatusForbidden).SendString(err.Error())
+	}
+	   
+	if err := x.PostValidate(c); err != nil {
+		return c.Status(fiber.StatusForbidden).SendString(err.Error())
+	}
+    return x.ServerInterface.UpdateOrgSettings(c)
+}
+// Upload risectl
+// (PUT /risectl/versions/{version})
+func (x *XMiddleware) UploadRisectl(c *fiber.Ctx, version string) error {
+    if err := x.AuthFunc(c); err != nil {
+		return c.Status(fiber.StatusUnauthorized).SendString(err.Error())
+	} 
+	if err := x.PreValidate(c); err != nil {
+		return c.Status(fiber.StatusForbidden).SendString(err.Error())
+	}
+	   
+	if err := x.PostValidate(c); err != nil {
+		return c.Status(fiber.StatusForbidden).SendString(err.Error())
+	}
+    return x.ServerInterface.UploadRisectl(c, version)
+}
 // Get all tasks
 // (GET /tasks)
 func (x *XMiddleware) ListTasks(c *fiber.Ctx) error {
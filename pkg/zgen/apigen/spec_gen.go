@@ -16,18 +16,156 @@ import (
 
 	"github.com/gofiber/fiber/v2"
 	"github.com/oapi-codegen/runtime"
+	openapi_types "github.com/oapi-codegen/runtime/types"
 )
 
 const (
 	BearerAuthScopes = "BearerAuth.Scopes"
 )
 
+// Defines values for AlertSeverity.
+const (
+	AlertSeverityCritical AlertSeverity = "critical"
+	AlertSeverityInfo     AlertSeverity = "info"
+	AlertSeverityWarning  AlertSeverity = "warning"
+)
+
+// Defines values for AlertStatus.
+const (
+	AlertStatusFiring   AlertStatus = "firing"
+	AlertStatusPending  AlertStatus = "pending"
+	AlertStatusResolved AlertStatus = "resolved"
+)
+
+// Defines values for CatalogChangeType.
+const (
+	Altered CatalogChangeType = "altered"
+	Created CatalogChangeType = "created"
+	Dropped CatalogChangeType = "dropped"
+)
+
+// Defines values for ClusterEventType.
+const (
+	AlertFiring              ClusterEventType = "alert_firing"
+	AlertResolved            ClusterEventType = "alert_resolved"
+	DiagnosticFinding        ClusterEventType = "diagnostic_finding"
+	HealthChanged            ClusterEventType = "health_changed"
+	UpgradeCancelled         ClusterEventType = "upgrade_cancelled"
+	UpgradeCompleted         ClusterEventType = "upgrade_completed"
+	UpgradeDiagnosticCreated ClusterEventType = "upgrade_diagnostic_created"
+	UpgradeFailed            ClusterEventType = "upgrade_failed"
+	UpgradeSnapshotCreated   ClusterEventType = "upgrade_snapshot_created"
+	UpgradeStarted           ClusterEventType = "upgrade_started"
+	UpgradeVersionDetected   ClusterEventType = "upgrade_version_detected"
+	VersionMismatch          ClusterEventType = "version_mismatch"
+	VersionUpdated           ClusterEventType = "version_updated"
+)
+
+// Defines values for ClusterHealthStatus.
+const (
+	Degraded ClusterHealthStatus = "degraded"
+	Down     ClusterHealthStatus = "down"
+	Healthy  ClusterHealthStatus = "healthy"
+)
+
+// Defines values for ClusterUpgradeStatus.
+const (
+	ClusterUpgradeStatusCancelled  ClusterUpgradeStatus = "cancelled"
+	ClusterUpgradeStatusCompleted  ClusterUpgradeStatus = "completed"
+	ClusterUpgradeStatusDiagnosing ClusterUpgradeStatus = "diagnosing"
+	ClusterUpgradeStatusFailed     ClusterUpgradeStatus = "failed"
+	ClusterUpgradeStatusPending    ClusterUpgradeStatus = "pending"
+	ClusterUpgradeStatusWaiting    ClusterUpgradeStatus = "waiting"
+)
+
+// Defines values for ClusterVersionSource.
+const (
+	ClusterVersionSourceDetected ClusterVersionSource = "detected"
+	ClusterVersionSourceUpgrade  ClusterVersionSource = "upgrade"
+	ClusterVersionSourceUser     ClusterVersionSource = "user"
+)
+
+// Defines values for ConnectionErrorCategory.
+const (
+	ConnectionErrorCategoryAuthentication     ConnectionErrorCategory = "authentication"
+	ConnectionErrorCategoryConnectionRefused  ConnectionErrorCategory = "connection_refused"
+	ConnectionErrorCategoryDatabaseNotFound   ConnectionErrorCategory = "database_not_found"
+	ConnectionErrorCategoryDns                ConnectionErrorCategory = "dns"
+	ConnectionErrorCategoryHttpStatus         ConnectionErrorCategory = "http_status"
+	ConnectionErrorCategoryNetworkUnreachable ConnectionErrorCategory = "network_unreachable"
+	ConnectionErrorCategoryRisectl            ConnectionErrorCategory = "risectl"
+	ConnectionErrorCategoryTimeout            ConnectionErrorCategory = "timeout"
+	ConnectionErrorCategoryTls                ConnectionErrorCategory = "tls"
+	ConnectionErrorCategoryUnknown            ConnectionErrorCategory = "unknown"
+)
+
+// Defines values for ConnectionTestStageName.
+const (
+	ConnectionTestStageNameDatabase   ConnectionTestStageName = "database"
+	ConnectionTestStageNameDns        ConnectionTestStageName = "dns"
+	ConnectionTestStageNameMetaHttp   ConnectionTestStageName = "meta_http"
+	ConnectionTestStageNamePgwireAuth ConnectionTestStageName = "pgwire_auth"
+	ConnectionTestStageNameRisectl    ConnectionTestStageName = "risectl"
+	ConnectionTestStageNameTcpHttp    ConnectionTestStageName = "tcp_http"
+	ConnectionTestStageNameTcpMeta    ConnectionTestStageName = "tcp_meta"
+	ConnectionTestStageNameTcpSql     ConnectionTestStageName = "tcp_sql"
+	ConnectionTestStageNameTls        ConnectionTestStageName = "tls"
+)
+
+// Defines values for ConnectionTestStageStatus.
+const (
+	ConnectionTestStageStatusFailed  ConnectionTestStageStatus = "failed"
+	ConnectionTestStageStatusPassed  ConnectionTestStageStatus = "passed"
+	ConnectionTestStageStatusSkipped ConnectionTestStageStatus = "skipped"
+)
+
+// Defines values for DiagnosticBundleStatus.
+const (
+	DiagnosticBundleStatusCompleted DiagnosticBundleStatus = "completed"
+	DiagnosticBundleStatusFailed    DiagnosticBundleStatus = "failed"
+	DiagnosticBundleStatusPending   DiagnosticBundleStatus = "pending"
+	DiagnosticBundleStatusRunning   DiagnosticBundleStatus = "running"
+)
+
+// Defines values for DiagnosticFindingSeverity.
+const (
+	DiagnosticFindingSeverityCritical DiagnosticFindingSeverity = "critical"
+	DiagnosticFindingSeverityWarning  DiagnosticFindingSeverity = "warning"
+)
+
+// Defines values for DiagnosticRuleName.
+const (
+	ActorBlocked       DiagnosticRuleName = "actor_blocked"
+	BarrierLatency     DiagnosticRuleName = "barrier_latency"
+	WorkerCountDropped DiagnosticRuleName = "worker_count_dropped"
+)
+
+// Defines values for DiagnosticSearchOrder.
+const (
+	Newest DiagnosticSearchOrder = "newest"
+	Oldest DiagnosticSearchOrder = "oldest"
+)
+
+// Defines values for DiagnosticSlowActorStatus.
+const (
+	Changed  DiagnosticSlowActorStatus = "changed"
+	New      DiagnosticSlowActorStatus = "new"
+	Resolved DiagnosticSlowActorStatus = "resolved"
+)
+
 // Defines values for EventSpecType.
 const (
 	TaskCompleted EventSpecType = "TaskCompleted"
 	TaskError     EventSpecType = "TaskError"
 )
 
+// Defines values for LineageDirection.
+const (
+	Both       LineageDirection = "both"
+	Downstream LineageDirection = "downstream"
+	Upstream   LineageDirection = "upstream"
+)
+
 // Defines values for MetricsStoreLabelMatcherOp.
 const (
 	EQ  MetricsStoreLabelMatcherOp = "EQ"
@@ -45,12 +183,68 @@ const (
 	Table            RelationType = "table"
 )
 
+// Defines values for RisectlExecutionOutputStream.
+const (
+	RisectlExecutionOutputStreamStderr RisectlExecutionOutputStream = "stderr"
+	RisectlExecutionOutputStreamStdout RisectlExecutionOutputStream = "stdout"
+)
+
+// Defines values for RisectlExecutionStatus.
+const (
+	RisectlExecutionStatusCancelled RisectlExecutionStatus = "cancelled"
+	RisectlExecutionStatusFailed    RisectlExecutionStatus = "failed"
+	RisectlExecutionStatusPending   RisectlExecutionStatus = "pending"
+	RisectlExecutionStatusRunning   RisectlExecutionStatus = "running"
+	RisectlExecutionStatusSucceeded RisectlExecutionStatus = "succeeded"
+)
+
+// Defines values for RisectlOperationName.
+const (
+	ClusterInfo      RisectlOperationName = "cluster-info"
+	CompactionStatus RisectlOperationName = "compaction-status"
+	HummockVersion   RisectlOperationName = "hummock-version"
+	ListActors       RisectlOperationName = "list-actors"
+	ListFragments    RisectlOperationName = "list-fragments"
+)
+
+// Defines values for RisectlShellMessageType.
+const (
+	RisectlShellMessageTypeError   RisectlShellMessageType = "error"
+	RisectlShellMessageTypeExit    RisectlShellMessageType = "exit"
+	RisectlShellMessageTypeHistory RisectlShellMessageType = "history"
+	RisectlShellMessageTypeReady   RisectlShellMessageType = "ready"
+	RisectlShellMessageTypeStart   RisectlShellMessageType = "start"
+	RisectlShellMessageTypeStderr  RisectlShellMessageType = "stderr"
+	RisectlShellMessageTypeStdout  RisectlShellMessageType = "stdout"
+)
+
+// Defines values for SnapshotSource.
+const (
+	SnapshotSourceAuto     SnapshotSource = "auto"
+	SnapshotSourceConsole  SnapshotSource = "console"
+	SnapshotSourceImported SnapshotSource = "imported"
+	SnapshotSourceUpgrade  SnapshotSource = "upgrade"
+)
+
+// Defines values for SnapshotSyncStatus.
+const (
+	Missing SnapshotSyncStatus = "missing"
+	Synced  SnapshotSyncStatus = "synced"
+	Unknown SnapshotSyncStatus = "unknown"
+)
+
+// Defines values for StatementImpactAction.
+const (
+	Alter StatementImpactAction = "alter"
+	Drop  StatementImpactAction = "drop"
+)
+
 // Defines values for TaskStatus.
 const (
-	Completed TaskStatus = "completed"
-	Failed    TaskStatus = "failed"
-	Paused    TaskStatus = "paused"
-	Pending   TaskStatus = "pending"
+	TaskStatusCompleted TaskStatus = "completed"
+	TaskStatusFailed    TaskStatus = "failed"
+	TaskStatusPaused    TaskStatus = "paused"
+	TaskStatusPending   TaskStatus = "pending"
 )
 
 // Defines values for TaskSpecType.
@@ -62,6 +256,103 @@ const (
 	DeleteSnapshot          TaskSpecType = "delete-snapshot"
 )
 
+// Defines values for ExportDatabaseLineageParamsFormat.
+const (
+	Dot         ExportDatabaseLineageParamsFormat = "dot"
+	Openlineage ExportDatabaseLineageParamsFormat = "openlineage"
+)
+
+// Alert defines model for Alert.
+type Alert struct {
+	// ActiveAt When the expression started returning the series
+	ActiveAt    time.Time  `json:"activeAt"`
+	ClusterID   int32      `json:"clusterID"`
+	EvaluatedAt time.Time  `json:"evaluatedAt"`
+	FiredAt     *time.Time `json:"firedAt,omitempty"`
+
+	// Labels Labels of an alert, the labels of the rule take precedence over the ones of the series
+	Labels   AlertLabels   `json:"labels"`
+	RuleID   int32         `json:"ruleID"`
+	RuleName string        `json:"ruleName"`
+	Severity AlertSeverity `json:"severity"`
+
+	// Status pending once the expression returns the series, firing once it keeps returning it for the for duration of the rule, resolved once it stops returning it.
+	Status AlertStatus `json:"status"`
+
+	// Value Latest value of the series, it is not set if the value is NaN or infinite
+	Value *float64 `json:"value,omitempty"`
+}
+
+// AlertHistoryEntry defines model for AlertHistoryEntry.
+type AlertHistoryEntry struct {
+	ID        int32     `json:"ID"`
+	ActiveAt  time.Time `json:"activeAt"`
+	ClusterID int32     `json:"clusterID"`
+	CreatedAt time.Time `json:"createdAt"`
+
+	// Labels Labels of an alert, the labels of the rule take precedence over the ones of the series
+	Labels AlertLabels `json:"labels"`
+
+	// RuleID It is not set once the rule is deleted
+	RuleID   *int32        `json:"ruleID,omitempty"`
+	RuleName string        `json:"ruleName"`
+	Severity AlertSeverity `json:"severity"`
+
+	// Status pending once the expression returns the series, firing once it keeps returning it for the for duration of the rule, resolved once it stops returning it.
+	Status AlertStatus `json:"status"`
+
+	// Value Value of the series when the alert fired or was last evaluated before it resolved, it is not set if the value is NaN or infinite
+	Value *float64 `json:"value,omitempty"`
+}
+
+// AlertLabels Labels of an alert, the labels of the rule take precedence over the ones of the series
+type AlertLabels map[string]string
+
+// AlertRule defines model for AlertRule.
+type AlertRule struct {
+	ID        int32     `json:"ID"`
+	ClusterID *int32    `json:"clusterID,omitempty"`
+	CreatedAt time.Time `json:"createdAt"`
+	Enabled   bool      `json:"enabled"`
+	Expr      string    `json:"expr"`
+	For       string    `json:"for"`
+
+	// Labels Labels of an alert, the labels of the rule take precedence over the ones of the series
+	Labels AlertLabels `json:"labels"`
+
+	// LastError The error of the last evaluation, e.g. the expression failed or returned more series than the limit. The alerts of the rule are kept as they are until it is evaluated without an error
+	LastError *string       `json:"lastError,omitempty"`
+	Name      string        `json:"name"`
+	Severity  AlertSeverity `json:"severity"`
+	UpdatedAt time.Time     `json:"updatedAt"`
+}
+
+// AlertRuleCreate defines model for AlertRuleCreate.
+type AlertRuleCreate struct {
+	// ClusterID Cluster the rule is evaluated against, every cluster of the organization if it is not set
+	ClusterID *int32 `json:"clusterID,omitempty"`
+
+	// Enabled Enabled by default
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// Expr PromQL expression, every series it returns is an alert. The default labels of the metrics store of the cluster are added to the selectors, e.g. `rate(stream_mview_input_row_count[1m]) == 0`.
+	Expr string `json:"expr"`
+
+	// For How long the expression returns a series before it fires (e.g., '5m'), it fires immediately by default
+	For *string `json:"for,omitempty"`
+
+	// Labels Labels of an alert, the labels of the rule take precedence over the ones of the series
+	Labels   *AlertLabels  `json:"labels,omitempty"`
+	Name     string        `json:"name"`
+	Severity AlertSeverity `json:"severity"`
+}
+
+// AlertSeverity defines model for AlertSeverity.
+type AlertSeverity string
+
+// AlertStatus pending once the expression returns the series, firing once it keeps returning it for the for duration of the rule, resolved once it stops returning it.
+type AlertStatus string
+
 // AutoBackupConfig defines model for AutoBackupConfig.
 type AutoBackupConfig struct {
 	// CronExpression Cron expression for automatic snapshots (e.g., '0 0 * * *')
@@ -72,6 +363,9 @@ type AutoBackupConfig struct {
 
 	// RetentionDuration How long to retain automatic snapshots (e.g., '1d', '7d', '14d', '30d', '90d')
 	RetentionDuration string `json:"retentionDuration"`
+
+	// RetentionPolicy Count-based retention of the automatic snapshots, applied after each automatic backup instead of the retention duration if any rule is set. A snapshot is kept if any rule keeps it. The daily, weekly and monthly rules keep the newest snapshot of each of the N most recent days, ISO weeks and months that have snapshots, in the timezone of the organization.
+	RetentionPolicy *SnapshotRetentionPolicy `json:"retentionPolicy,omitempty"`
 }
 
 // AutoDiagnosticConfig defines model for AutoDiagnosticConfig.
@@ -86,21 +380,77 @@ type AutoDiagnosticConfig struct {
 	RetentionDuration string `json:"retentionDuration"`
 }
 
+// CatalogChangeDetails defines model for CatalogChangeDetails.
+type CatalogChangeDetails struct {
+	AddedColumns *[]string `json:"addedColumns,omitempty"`
+
+	// ChangedColumns Columns whose data type changed
+	ChangedColumns       *[]string `json:"changedColumns,omitempty"`
+	Definition           *string   `json:"definition,omitempty"`
+	Dependencies         *[]int32  `json:"dependencies,omitempty"`
+	DroppedColumns       *[]string `json:"droppedColumns,omitempty"`
+	PreviousDefinition   *string   `json:"previousDefinition,omitempty"`
+	PreviousDependencies *[]int32  `json:"previousDependencies,omitempty"`
+
+	// PreviousName Name of the relation before it was renamed
+	PreviousName *string `json:"previousName,omitempty"`
+
+	// PreviousSchema Schema of the relation before it was altered
+	PreviousSchema *string `json:"previousSchema,omitempty"`
+}
+
+// CatalogChangeEvent defines model for CatalogChangeEvent.
+type CatalogChangeEvent struct {
+	ID         int32             `json:"ID"`
+	ChangeType CatalogChangeType `json:"changeType"`
+
+	// CreatedAt When the change was detected
+	CreatedAt    time.Time            `json:"createdAt"`
+	DatabaseID   int32                `json:"databaseID"`
+	Details      CatalogChangeDetails `json:"details"`
+	Name         string               `json:"name"`
+	RelationID   int32                `json:"relationID"`
+	RelationType string               `json:"relationType"`
+	Schema       string               `json:"schema"`
+}
+
+// CatalogChangeType defines model for CatalogChangeType.
+type CatalogChangeType string
+
 // Cluster defines model for Cluster.
 type Cluster struct {
 	ID        int32     `json:"ID"`
 	OrgID     int32     `json:"OrgID"`
 	CreatedAt time.Time `json:"createdAt"`
-	Host      string    `json:"host"`
-	HttpPort  int32     `json:"httpPort"`
-	MetaPort  int32     `json:"metaPort"`
+
+	// DetectedVersion Version reported by the cluster through SELECT version(), detected after import, update and periodically
+	DetectedVersion *string `json:"detectedVersion,omitempty"`
+
+	// HealthCheckedAt Time of the last health check
+	HealthCheckedAt *time.Time `json:"healthCheckedAt,omitempty"`
+
+	// HealthStatus healthy if every check passes, down if none of the endpoints of the cluster is reachable, degraded otherwise. The SQL authentication left unchecked and a version mismatch do not degrade a cluster
+	HealthStatus *ClusterHealthStatus `json:"healthStatus,omitempty"`
+	Host         string               `json:"host"`
+	HttpPort     int32                `json:"httpPort"`
+
+	// LastSeenAt Last time any endpoint of the cluster was reachable
+	LastSeenAt *time.Time `json:"lastSeenAt,omitempty"`
+	MetaPort   int32      `json:"metaPort"`
 
 	// MetricsStoreID ID of the metrics store this cluster belongs to
-	MetricsStoreID *int32    `json:"metricsStoreID,omitempty"`
-	Name           string    `json:"name"`
-	SqlPort        int32     `json:"sqlPort"`
-	UpdatedAt      time.Time `json:"updatedAt"`
-	Version        string    `json:"version"`
+	MetricsStoreID *int32 `json:"metricsStoreID,omitempty"`
+	Name           string `json:"name"`
+
+	// RisectlVersion Version of risectl used for this cluster, the nearest compatible version is used if it is not set
+	RisectlVersion    *string    `json:"risectlVersion,omitempty"`
+	SqlPort           int32      `json:"sqlPort"`
+	UpdatedAt         time.Time  `json:"updatedAt"`
+	Version           string     `json:"version"`
+	VersionDetectedAt *time.Time `json:"versionDetectedAt,omitempty"`
+
+	// VersionMismatch Whether the detected version differs from the version of the cluster
+	VersionMismatch bool `json:"versionMismatch"`
 }
 
 // ClusterCreate defines model for ClusterCreate.
@@ -112,6 +462,69 @@ type ClusterCreate struct {
 	Version string `json:"version"`
 }
 
+// ClusterDiagnosticFinding defines model for ClusterDiagnosticFinding.
+type ClusterDiagnosticFinding struct {
+	ID        int32     `json:"ID"`
+	ClusterID int32     `json:"clusterID"`
+	CreatedAt time.Time `json:"createdAt"`
+
+	// Details Details of a finding, the keys depend on the rule, e.g. the blocked actors
+	Details      DiagnosticFindingDetails `json:"details"`
+	DiagnosticID int32                    `json:"diagnosticID"`
+	Message      string                   `json:"message"`
+
+	// Rule barrier_latency fires once the barrier latency is over the threshold in seconds, actor_blocked once an actor is blocked for over the threshold in seconds, worker_count_dropped once the number of running worker nodes dropped by at least the threshold since the previous diagnostic.
+	Rule DiagnosticRuleName `json:"rule"`
+
+	// Severity warning once the value is over the threshold, critical once it is twice the threshold
+	Severity  DiagnosticFindingSeverity `json:"severity"`
+	Threshold float64                   `json:"threshold"`
+
+	// Value Value compared against the threshold, e.g. the barrier latency in seconds
+	Value float64 `json:"value"`
+}
+
+// ClusterEvent defines model for ClusterEvent.
+type ClusterEvent struct {
+	ID        int32     `json:"ID"`
+	ClusterID int32     `json:"clusterID"`
+	CreatedAt time.Time `json:"createdAt"`
+
+	// Details Details of a cluster event, the keys depend on the type of the event
+	Details ClusterEventDetails `json:"details"`
+	Message string              `json:"message"`
+
+	// Type Type of a cluster event
+	Type ClusterEventType `json:"type"`
+}
+
+// ClusterEventDetails Details of a cluster event, the keys depend on the type of the event
+type ClusterEventDetails map[string]interface{}
+
+// ClusterEventType Type of a cluster event
+type ClusterEventType string
+
+// ClusterHealthDetails The error of each failed check or the note keyed by its reason code
+type ClusterHealthDetails map[string]string
+
+// ClusterHealthRecord The health of a cluster since the time the record is created until the next record
+type ClusterHealthRecord struct {
+	ID        int32     `json:"ID"`
+	CreatedAt time.Time `json:"createdAt"`
+
+	// Details The error of each failed check or the note keyed by its reason code
+	Details ClusterHealthDetails `json:"details"`
+
+	// Reasons The codes of the checks that failed, e.g. meta_unreachable, sql_unreachable, http_unreachable, sql_query_failed and meta_http_not_responding. sql_auth_not_checked is noted if the cluster has no database to check the SQL authentication with, version_mismatch if the version reported by the cluster differs from its version
+	Reasons []string `json:"reasons"`
+
+	// Status healthy if every check passes, down if none of the endpoints of the cluster is reachable, degraded otherwise. The SQL authentication left unchecked and a version mismatch do not degrade a cluster
+	Status ClusterHealthStatus `json:"status"`
+}
+
+// ClusterHealthStatus healthy if every check passes, down if none of the endpoints of the cluster is reachable, degraded otherwise. The SQL authentication left unchecked and a version mismatch do not degrade a cluster
+type ClusterHealthStatus string
+
 // ClusterImport defines model for ClusterImport.
 type ClusterImport struct {
 	// Host Cluster host address
@@ -129,6 +542,9 @@ type ClusterImport struct {
 	// Name Name of the cluster
 	Name string `json:"name"`
 
+	// RisectlVersion Version of risectl used for this cluster, the nearest compatible version is used if it is not set
+	RisectlVersion *string `json:"risectlVersion,omitempty"`
+
 	// SqlPort SQL connection port
 	SqlPort int32 `json:"sqlPort"`
 
@@ -136,6 +552,66 @@ type ClusterImport struct {
 	Version string `json:"version"`
 }
 
+// ClusterUpgrade defines model for ClusterUpgrade.
+type ClusterUpgrade struct {
+	ID        int32     `json:"ID"`
+	ClusterID int32     `json:"clusterID"`
+	CreatedAt time.Time `json:"createdAt"`
+
+	// DeadlineAt The upgrade fails if the target version is not reported by then
+	DeadlineAt time.Time `json:"deadlineAt"`
+
+	// DetectedVersion Version reported by the cluster after the upgrade
+	DetectedVersion *string `json:"detectedVersion,omitempty"`
+
+	// DiagnosticID ID of the post-upgrade diagnostic
+	DiagnosticID *int32     `json:"diagnosticID,omitempty"`
+	Error        *string    `json:"error,omitempty"`
+	FinishedAt   *time.Time `json:"finishedAt,omitempty"`
+
+	// FromVersion Version of the cluster when the upgrade is started
+	FromVersion string `json:"fromVersion"`
+
+	// SnapshotID ID of the pre-upgrade snapshot
+	SnapshotID *int64 `json:"snapshotID,omitempty"`
+
+	// Status pending until the pre-upgrade snapshot is taken, waiting until the target version is reported by the cluster, diagnosing until the post-upgrade diagnostic is created, then completed. failed if a step fails or the cluster is not upgraded before the deadline.
+	Status        ClusterUpgradeStatus `json:"status"`
+	TargetVersion string               `json:"targetVersion"`
+	UpdatedAt     time.Time            `json:"updatedAt"`
+
+	// UserID ID of the user who started the upgrade
+	UserID int32 `json:"userID"`
+}
+
+// ClusterUpgradeCreate defines model for ClusterUpgradeCreate.
+type ClusterUpgradeCreate struct {
+	// TargetVersion Version the cluster is upgraded to (e.g., 'v2.3.0')
+	TargetVersion string `json:"targetVersion"`
+
+	// Timeout How long to wait for the cluster to be upgraded (e.g., '30m', '2h'), 2h by default and 24h at most
+	Timeout *string `json:"timeout,omitempty"`
+}
+
+// ClusterUpgradeStatus pending until the pre-upgrade snapshot is taken, waiting until the target version is reported by the cluster, diagnosing until the post-upgrade diagnostic is created, then completed. failed if a step fails or the cluster is not upgraded before the deadline.
+type ClusterUpgradeStatus string
+
+// ClusterVersionChange defines model for ClusterVersionChange.
+type ClusterVersionChange struct {
+	ID        int32     `json:"ID"`
+	CreatedAt time.Time `json:"createdAt"`
+
+	// PreviousVersion Version before the change, empty for the first version of the cluster
+	PreviousVersion *string `json:"previousVersion,omitempty"`
+
+	// Source user if the version is entered by a user, detected if it is updated to the detected version automatically, upgrade if it is updated by the upgrade workflow
+	Source  ClusterVersionSource `json:"source"`
+	Version string               `json:"version"`
+}
+
+// ClusterVersionSource user if the version is entered by a user, detected if it is updated to the detected version automatically, upgrade if it is updated by the upgrade workflow
+type ClusterVersionSource string
+
 // Column defines model for Column.
 type Column struct {
 	// IsHidden Whether the column is hidden
@@ -151,6 +627,31 @@ type Column struct {
 	Type string `json:"type"`
 }
 
+// ConnectionErrorCategory defines model for ConnectionErrorCategory.
+type ConnectionErrorCategory string
+
+// ConnectionTestStage defines model for ConnectionTestStage.
+type ConnectionTestStage struct {
+	// Detail The outcome of the stage, e.g. the resolved addresses or the reason of skipping
+	Detail        *string                  `json:"detail,omitempty"`
+	DurationMs    int64                    `json:"durationMs"`
+	Error         *string                  `json:"error,omitempty"`
+	ErrorCategory *ConnectionErrorCategory `json:"errorCategory,omitempty"`
+
+	// Hint What to check to fix the error
+	Hint *string                 `json:"hint,omitempty"`
+	Name ConnectionTestStageName `json:"name"`
+
+	// Status A stage is skipped once a stage it depends on fails or its input is not given
+	Status ConnectionTestStageStatus `json:"status"`
+}
+
+// ConnectionTestStageName defines model for ConnectionTestStageName.
+type ConnectionTestStageName string
+
+// ConnectionTestStageStatus A stage is skipped once a stage it depends on fails or its input is not given
+type ConnectionTestStageStatus string
+
 // DDLProgress defines model for DDLProgress.
 type DDLProgress struct {
 	ID int64 `json:"ID"`
@@ -171,6 +672,9 @@ type Database struct {
 	// OrgID ID of the organization this database belongs to
 	OrgID int32 `json:"OrgID"`
 
+	// CatalogFetchedAt When the schemas were fetched from the database, they may be served from the cache
+	CatalogFetchedAt *time.Time `json:"catalogFetchedAt,omitempty"`
+
 	// ClusterID ID of the cluster this database belongs to
 	ClusterID int32 `json:"clusterID"`
 
@@ -189,6 +693,11 @@ type Database struct {
 	// Schemas List of schemas in the database
 	Schemas *[]Schema `json:"schemas,omitempty"`
 
+	// SessionSettings Session variables applied with SET before the query runs, e.g. `streaming_parallelism`,
+	// `statement_timeout`, `query_mode`, `rw_streaming_enable_delta_join`, `search_path` and `background_ddl`.
+	// `statement_timeout` accepts a duration (e.g. 30s, 5m) or a number of seconds.
+	SessionSettings *SessionSettings `json:"sessionSettings,omitempty"`
+
 	// UpdatedAt Last update timestamp
 	UpdatedAt time.Time `json:"updatedAt"`
 
@@ -210,10 +719,101 @@ type DatabaseConnectInfo struct {
 	// Password Database password (optional)
 	Password *string `json:"password,omitempty"`
 
+	// SessionSettings Session variables applied with SET before the query runs, e.g. `streaming_parallelism`,
+	// `statement_timeout`, `query_mode`, `rw_streaming_enable_delta_join`, `search_path` and `background_ddl`.
+	// `statement_timeout` accepts a duration (e.g. 30s, 5m) or a number of seconds.
+	SessionSettings *SessionSettings `json:"sessionSettings,omitempty"`
+
 	// Username Database username
 	Username string `json:"username"`
 }
 
+// DiagnosticAttributeChange defines model for DiagnosticAttributeChange.
+type DiagnosticAttributeChange struct {
+	After  string `json:"after"`
+	Before string `json:"before"`
+	Name   string `json:"name"`
+}
+
+// DiagnosticAwaitTreeEntry defines model for DiagnosticAwaitTreeEntry.
+type DiagnosticAwaitTreeEntry struct {
+	// Key Identifies the entry in the section, e.g. "Actor 1"
+	Key string `json:"key"`
+
+	// SlowSpans Spans pending for too long, usually the executors blocked by backpressure
+	SlowSpans []DiagnosticAwaitTreeSpan `json:"slowSpans"`
+	Trace     string                    `json:"trace"`
+}
+
+// DiagnosticAwaitTreeSection defines model for DiagnosticAwaitTreeSection.
+type DiagnosticAwaitTreeSection struct {
+	Entries []DiagnosticAwaitTreeEntry `json:"entries"`
+
+	// Title Kind of the await trees, e.g. "Actor Traces"
+	Title string `json:"title"`
+}
+
+// DiagnosticAwaitTreeSpan defines model for DiagnosticAwaitTreeSpan.
+type DiagnosticAwaitTreeSpan struct {
+	Seconds float64 `json:"seconds"`
+	Span    string  `json:"span"`
+}
+
+// DiagnosticBundle defines model for DiagnosticBundle.
+type DiagnosticBundle struct {
+	ID         int32                     `json:"ID"`
+	ClusterID  int32                     `json:"clusterID"`
+	CreatedAt  time.Time                 `json:"createdAt"`
+	Error      *string                   `json:"error,omitempty"`
+	FinishedAt *time.Time                `json:"finishedAt,omitempty"`
+	Manifest   *DiagnosticBundleManifest `json:"manifest,omitempty"`
+	SizeBytes  *int64                    `json:"sizeBytes,omitempty"`
+	Status     DiagnosticBundleStatus    `json:"status"`
+	UserID     int32                     `json:"userID"`
+}
+
+// DiagnosticBundleError An item that could not be collected, the rest of the bundle is still collected
+type DiagnosticBundleError struct {
+	Error string `json:"error"`
+	Path  string `json:"path"`
+}
+
+// DiagnosticBundleFile defines model for DiagnosticBundleFile.
+type DiagnosticBundleFile struct {
+	Description string `json:"description"`
+
+	// Path Path of the file in the archive, e.g. risectl/cluster-info.txt
+	Path string `json:"path"`
+
+	// Redactions Number of secrets redacted from the file
+	Redactions int32  `json:"redactions"`
+	Sha256     string `json:"sha256"`
+	SizeBytes  int64  `json:"sizeBytes"`
+}
+
+// DiagnosticBundleManifest defines model for DiagnosticBundleManifest.
+type DiagnosticBundleManifest struct {
+	ClusterID      int32                   `json:"clusterID"`
+	ClusterName    string                  `json:"clusterName"`
+	ClusterVersion *string                 `json:"clusterVersion,omitempty"`
+	CreatedAt      time.Time               `json:"createdAt"`
+	Errors         []DiagnosticBundleError `json:"errors"`
+	Files          []DiagnosticBundleFile  `json:"files"`
+	FormatVersion  int32                   `json:"formatVersion"`
+	MetricsFrom    *time.Time              `json:"metricsFrom,omitempty"`
+	MetricsTo      *time.Time              `json:"metricsTo,omitempty"`
+}
+
+// DiagnosticBundleStatus defines model for DiagnosticBundleStatus.
+type DiagnosticBundleStatus string
+
+// DiagnosticCountChange defines model for DiagnosticCountChange.
+type DiagnosticCountChange struct {
+	After  int64  `json:"after"`
+	Before int64  `json:"before"`
+	Name   string `json:"name"`
+}
+
 // DiagnosticData defines model for DiagnosticData.
 type DiagnosticData struct {
 	// ID Unique identifier of the diagnostic entry
@@ -226,6 +826,186 @@ type DiagnosticData struct {
 	CreatedAt time.Time `json:"createdAt"`
 }
 
+// DiagnosticDiff defines model for DiagnosticDiff.
+type DiagnosticDiff struct {
+	Catalog          []DiagnosticCountChange     `json:"catalog"`
+	FromDiagnosticID int32                       `json:"fromDiagnosticID"`
+	FromVersion      *string                     `json:"fromVersion,omitempty"`
+	Metrics          []DiagnosticMetricChange    `json:"metrics"`
+	SlowActors       []DiagnosticSlowActorChange `json:"slowActors"`
+	ToDiagnosticID   int32                       `json:"toDiagnosticID"`
+	ToVersion        *string                     `json:"toVersion,omitempty"`
+
+	// TooLargeSections Sections left out of the line diff because they are too large to compare
+	TooLargeSections []string `json:"tooLargeSections"`
+
+	// UnifiedDiff Line diff of the raw diagnostic data
+	UnifiedDiff    string                    `json:"unifiedDiff"`
+	VersionChanged bool                      `json:"versionChanged"`
+	WorkerNodes    DiagnosticWorkerNodesDiff `json:"workerNodes"`
+}
+
+// DiagnosticFindingDetails Details of a finding, the keys depend on the rule, e.g. the blocked actors
+type DiagnosticFindingDetails map[string]interface{}
+
+// DiagnosticFindingSeverity warning once the value is over the threshold, critical once it is twice the threshold
+type DiagnosticFindingSeverity string
+
+// DiagnosticMetricChange A changed value of the streaming, batch or storage tables
+type DiagnosticMetricChange struct {
+	// After Absent if the row only exists in the older diagnostic data
+	After *string `json:"after,omitempty"`
+
+	// Before Absent if the row only exists in the newer diagnostic data
+	Before *string  `json:"before,omitempty"`
+	Column string   `json:"column"`
+	Delta  *float64 `json:"delta,omitempty"`
+	Row    string   `json:"row"`
+	Table  string   `json:"table"`
+}
+
+// DiagnosticReport The sections not recognized are returned in otherTables and textSections
+type DiagnosticReport struct {
+	AwaitTree []DiagnosticAwaitTreeSection `json:"awaitTree"`
+	Batch     []DiagnosticTable            `json:"batch"`
+
+	// Catalog Number of each kind of object, e.g. "materialized view"
+	Catalog      map[string]int64  `json:"catalog"`
+	CreatedAt    time.Time         `json:"createdAt"`
+	DiagnosticID int32             `json:"diagnosticID"`
+	EventLogs    []DiagnosticTable `json:"eventLogs"`
+	OtherTables  []DiagnosticTable `json:"otherTables"`
+
+	// ReportCreatedAt Creation time written in the report by the meta node
+	ReportCreatedAt *string                 `json:"reportCreatedAt,omitempty"`
+	Storage         DiagnosticStorageStats  `json:"storage"`
+	Streaming       []DiagnosticTable       `json:"streaming"`
+	TextSections    []DiagnosticTextSection `json:"textSections"`
+
+	// Version Version written in the report by the meta node
+	Version     *string                `json:"version,omitempty"`
+	WorkerNodes []DiagnosticWorkerNode `json:"workerNodes"`
+}
+
+// DiagnosticRule defines model for DiagnosticRule.
+type DiagnosticRule struct {
+	DefaultThreshold float64 `json:"defaultThreshold"`
+	Description      string  `json:"description"`
+	Enabled          bool    `json:"enabled"`
+
+	// Name barrier_latency fires once the barrier latency is over the threshold in seconds, actor_blocked once an actor is blocked for over the threshold in seconds, worker_count_dropped once the number of running worker nodes dropped by at least the threshold since the previous diagnostic.
+	Name DiagnosticRuleName `json:"name"`
+
+	// Threshold Threshold of the organization, the default one unless overridden
+	Threshold float64 `json:"threshold"`
+
+	// Unit Unit of the threshold, e.g. seconds
+	Unit string `json:"unit"`
+}
+
+// DiagnosticRuleName barrier_latency fires once the barrier latency is over the threshold in seconds, actor_blocked once an actor is blocked for over the threshold in seconds, worker_count_dropped once the number of running worker nodes dropped by at least the threshold since the previous diagnostic.
+type DiagnosticRuleName string
+
+// DiagnosticRuleUpdate defines model for DiagnosticRuleUpdate.
+type DiagnosticRuleUpdate struct {
+	Enabled bool `json:"enabled"`
+
+	// Threshold Threshold overriding the default one, the default one is used if it is not set
+	Threshold *float64 `json:"threshold,omitempty"`
+}
+
+// DiagnosticSearchOrder defines model for DiagnosticSearchOrder.
+type DiagnosticSearchOrder string
+
+// DiagnosticSearchResult defines model for DiagnosticSearchResult.
+type DiagnosticSearchResult struct {
+	ClusterID    int32     `json:"clusterID"`
+	ClusterName  string    `json:"clusterName"`
+	CreatedAt    time.Time `json:"createdAt"`
+	DiagnosticID int32     `json:"diagnosticID"`
+
+	// Snippets Lines of the report matching the most search words
+	Snippets []DiagnosticSnippet `json:"snippets"`
+}
+
+// DiagnosticSlowActorChange An await tree entry with slow spans in either diagnostic data
+type DiagnosticSlowActorChange struct {
+	// AfterSeconds Longest slow span in the newer diagnostic data
+	AfterSeconds *float64 `json:"afterSeconds,omitempty"`
+
+	// BeforeSeconds Longest slow span in the older diagnostic data
+	BeforeSeconds *float64                  `json:"beforeSeconds,omitempty"`
+	Key           string                    `json:"key"`
+	Section       string                    `json:"section"`
+	SlowSpans     []DiagnosticAwaitTreeSpan `json:"slowSpans"`
+	Status        DiagnosticSlowActorStatus `json:"status"`
+}
+
+// DiagnosticSlowActorStatus defines model for DiagnosticSlowActorStatus.
+type DiagnosticSlowActorStatus string
+
+// DiagnosticSnippet defines model for DiagnosticSnippet.
+type DiagnosticSnippet struct {
+	// Line Line number in the report, starting from 1
+	Line  int32                   `json:"line"`
+	Parts []DiagnosticSnippetPart `json:"parts"`
+}
+
+// DiagnosticSnippetPart defines model for DiagnosticSnippetPart.
+type DiagnosticSnippetPart struct {
+	// Match Whether the text matches a search word
+	Match bool   `json:"match"`
+	Text  string `json:"text"`
+}
+
+// DiagnosticStorageStats defines model for DiagnosticStorageStats.
+type DiagnosticStorageStats struct {
+	SstCount          *int64            `json:"sstCount,omitempty"`
+	SstTotalSizeBytes *int64            `json:"sstTotalSizeBytes,omitempty"`
+	Tables            []DiagnosticTable `json:"tables"`
+}
+
+// DiagnosticTable defines model for DiagnosticTable.
+type DiagnosticTable struct {
+	Headers []string   `json:"headers"`
+	Rows    [][]string `json:"rows"`
+	Title   string     `json:"title"`
+}
+
+// DiagnosticTextSection defines model for DiagnosticTextSection.
+type DiagnosticTextSection struct {
+	Content string `json:"content"`
+	Title   string `json:"title"`
+}
+
+// DiagnosticWorkerNode defines model for DiagnosticWorkerNode.
+type DiagnosticWorkerNode struct {
+	ActorCount int64 `json:"actorCount"`
+
+	// Attributes All the columns of the worker node in the report
+	Attributes  map[string]string `json:"attributes"`
+	Host        string            `json:"host"`
+	Id          int64             `json:"id"`
+	Parallelism int64             `json:"parallelism"`
+	State       string            `json:"state"`
+	Type        string            `json:"type"`
+}
+
+// DiagnosticWorkerNodeChange defines model for DiagnosticWorkerNodeChange.
+type DiagnosticWorkerNodeChange struct {
+	Changes []DiagnosticAttributeChange `json:"changes"`
+	Host    string                      `json:"host"`
+	Id      int64                       `json:"id"`
+	Type    string                      `json:"type"`
+}
+
+// DiagnosticWorkerNodesDiff defines model for DiagnosticWorkerNodesDiff.
+type DiagnosticWorkerNodesDiff struct {
+	Added   []DiagnosticWorkerNode       `json:"added"`
+	Changed []DiagnosticWorkerNodeChange `json:"changed"`
+	Removed []DiagnosticWorkerNode       `json:"removed"`
+}
+
 // Event defines model for Event.
 type Event struct {
 	ID        int32     `json:"ID"`
@@ -254,13 +1034,76 @@ type EventTaskError struct {
 	TaskID int32  `json:"taskID"`
 }
 
-// MetricMatrix defines model for MetricMatrix.
-type MetricMatrix = []MetricSeries
+// ImpactAnalysis defines model for ImpactAnalysis.
+type ImpactAnalysis struct {
+	// ConfirmationToken Token to pass in the query request to confirm the drop, set if requiresConfirmation is true
+	ConfirmationToken *string `json:"confirmationToken,omitempty"`
 
-// MetricSeries defines model for MetricSeries.
-type MetricSeries struct {
-	Metric map[string]interface{} `json:"metric"`
-	Values []MetricValue          `json:"values"`
+	// MaxCascadeObjects Maximum number of objects a CASCADE drop may affect without confirmation
+	MaxCascadeObjects int `json:"maxCascadeObjects"`
+
+	// RequiresConfirmation Whether a CASCADE drop affects more than maxCascadeObjects objects
+	RequiresConfirmation bool              `json:"requiresConfirmation"`
+	Statements           []StatementImpact `json:"statements"`
+}
+
+// ImpactAnalysisRequest defines model for ImpactAnalysisRequest.
+type ImpactAnalysisRequest struct {
+	// SessionSettings Session variables applied with SET before the query runs, e.g. `streaming_parallelism`,
+	// `statement_timeout`, `query_mode`, `rw_streaming_enable_delta_join`, `search_path` and `background_ddl`.
+	// `statement_timeout` accepts a duration (e.g. 30s, 5m) or a number of seconds.
+	SessionSettings *SessionSettings `json:"sessionSettings,omitempty"`
+
+	// Statement SQL statements to analyze, separated by semicolons
+	Statement string `json:"statement"`
+}
+
+// LineageDirection defines model for LineageDirection.
+type LineageDirection string
+
+// LineageEdge defines model for LineageEdge.
+type LineageEdge struct {
+	// From ID of the upstream relation
+	From int32 `json:"from"`
+
+	// To ID of the downstream relation
+	To int32 `json:"to"`
+}
+
+// LineageGraph defines model for LineageGraph.
+type LineageGraph struct {
+	Edges []LineageEdge `json:"edges"`
+	Nodes []LineageNode `json:"nodes"`
+}
+
+// LineageNode defines model for LineageNode.
+type LineageNode struct {
+	// ID ID of the relation
+	ID int32 `json:"ID"`
+
+	// Connector Connector type of the source or sink, e.g. kafka
+	Connector *string `json:"connector,omitempty"`
+
+	// Depth Number of hops from the relation the traversal started from
+	Depth *int `json:"depth,omitempty"`
+
+	// Name Name of the relation
+	Name string `json:"name"`
+
+	// Schema Name of the schema this relation belongs to
+	Schema string `json:"schema"`
+
+	// Type Type of the relation
+	Type RelationType `json:"type"`
+}
+
+// MetricMatrix defines model for MetricMatrix.
+type MetricMatrix = []MetricSeries
+
+// MetricSeries defines model for MetricSeries.
+type MetricSeries struct {
+	Metric map[string]interface{} `json:"metric"`
+	Values []MetricValue          `json:"values"`
 }
 
 // MetricValue defines model for MetricValue.
@@ -314,13 +1157,32 @@ type MetricsStoreVictoriaMetrics struct {
 	Endpoint string `json:"endpoint"`
 }
 
+// OrgSettings defines model for OrgSettings.
+type OrgSettings struct {
+	// AutoUpdateClusterVersion Update the version of the clusters to the version they report once they differ, except during an upgrade. The stored value is kept if it is omitted
+	AutoUpdateClusterVersion *bool `json:"autoUpdateClusterVersion,omitempty"`
+
+	// MaxStatementTimeout Upper bound of statement_timeout for queries run from the console, e.g. 30s, 5m, 1h.
+	// Queries without a statement_timeout get this value. An empty string removes the limit, the stored
+	// value is kept if it is omitted.
+	MaxStatementTimeout *string `json:"maxStatementTimeout,omitempty"`
+
+	// Timezone Timezone of the organization, it is read-only
+	Timezone string `json:"timezone"`
+}
+
 // QueryRequest defines model for QueryRequest.
 type QueryRequest struct {
-	// BackgroundDDL Whether to execute the query in background DDL mode
-	BackgroundDDL *bool `json:"backgroundDDL,omitempty"`
+	// ConfirmationToken Confirmation token from the impact analysis, required for CASCADE drops affecting many objects
+	ConfirmationToken *string `json:"confirmationToken,omitempty"`
 
 	// Query SQL query to execute
 	Query string `json:"query"`
+
+	// SessionSettings Session variables applied with SET before the query runs, e.g. `streaming_parallelism`,
+	// `statement_timeout`, `query_mode`, `rw_streaming_enable_delta_join`, `search_path` and `background_ddl`.
+	// `statement_timeout` accepts a duration (e.g. 30s, 5m) or a number of seconds.
+	SessionSettings *SessionSettings `json:"sessionSettings,omitempty"`
 }
 
 // QueryResponse defines model for QueryResponse.
@@ -354,9 +1216,39 @@ type Relation struct {
 	Type RelationType `json:"type"`
 }
 
+// RelationSummary defines model for RelationSummary.
+type RelationSummary struct {
+	// ID Unique identifier of the relation
+	ID           int32   `json:"ID"`
+	Dependencies []int32 `json:"dependencies"`
+
+	// Name Name of the relation
+	Name string `json:"name"`
+
+	// Schema Name of the schema this relation belongs to
+	Schema string `json:"schema"`
+
+	// Type Type of the relation
+	Type RelationType `json:"type"`
+}
+
 // RelationType Type of the relation
 type RelationType string
 
+// RisectlActor defines model for RisectlActor.
+type RisectlActor struct {
+	FragmentId int64  `json:"fragmentId"`
+	Id         int64  `json:"id"`
+	Worker     string `json:"worker"`
+}
+
+// RisectlClusterInfo defines model for RisectlClusterInfo.
+type RisectlClusterInfo struct {
+	Fragments []RisectlFragment `json:"fragments"`
+	Revision  *int64            `json:"revision,omitempty"`
+	Tables    []RisectlTable    `json:"tables"`
+}
+
 // RisectlCommand defines model for RisectlCommand.
 type RisectlCommand struct {
 	Args []string `json:"args"`
@@ -370,6 +1262,9 @@ type RisectlCommandResult struct {
 	// ExitCode Exit code of the risectl command
 	ExitCode int32 `json:"exitCode"`
 
+	// RisectlVersion Version of risectl that ran the command
+	RisectlVersion *string `json:"risectlVersion,omitempty"`
+
 	// Stderr Standard error of the risectl command
 	Stderr string `json:"stderr"`
 
@@ -377,6 +1272,163 @@ type RisectlCommandResult struct {
 	Stdout string `json:"stdout"`
 }
 
+// RisectlCompactionGroupStatus defines model for RisectlCompactionGroupStatus.
+type RisectlCompactionGroupStatus struct {
+	Fields map[string]string `json:"fields"`
+	Id     int64             `json:"id"`
+}
+
+// RisectlExecution defines model for RisectlExecution.
+type RisectlExecution struct {
+	ID int32 `json:"ID"`
+
+	// Args Arguments of the risectl command
+	Args            []string  `json:"args"`
+	CancelRequested bool      `json:"cancelRequested"`
+	ClusterID       int32     `json:"clusterID"`
+	CreatedAt       time.Time `json:"createdAt"`
+
+	// Error Error message if the execution failed
+	Error *string `json:"error,omitempty"`
+
+	// ExitCode Exit code of the risectl process, -1 if it was killed
+	ExitCode   *int32     `json:"exitCode,omitempty"`
+	FinishedAt *time.Time `json:"finishedAt,omitempty"`
+
+	// RisectlVersion Version of risectl that ran the command
+	RisectlVersion *string                `json:"risectlVersion,omitempty"`
+	StartedAt      *time.Time             `json:"startedAt,omitempty"`
+	Status         RisectlExecutionStatus `json:"status"`
+
+	// UserID ID of the user who started the execution
+	UserID int32 `json:"userID"`
+}
+
+// RisectlExecutionOutput defines model for RisectlExecutionOutput.
+type RisectlExecutionOutput struct {
+	Content   string    `json:"content"`
+	CreatedAt time.Time `json:"createdAt"`
+
+	// Seq Sequence number of the chunk, starting from 1
+	Seq    int32                        `json:"seq"`
+	Stream RisectlExecutionOutputStream `json:"stream"`
+}
+
+// RisectlExecutionOutputStream defines model for RisectlExecutionOutput.Stream.
+type RisectlExecutionOutputStream string
+
+// RisectlExecutionStatus defines model for RisectlExecutionStatus.
+type RisectlExecutionStatus string
+
+// RisectlFragment defines model for RisectlFragment.
+type RisectlFragment struct {
+	Id        int64   `json:"id"`
+	TableId   *int64  `json:"tableId,omitempty"`
+	TableName *string `json:"tableName,omitempty"`
+	Type      *string `json:"type,omitempty"`
+
+	// WorkerActors The actor IDs on each worker
+	WorkerActors map[string][]int64 `json:"workerActors"`
+}
+
+// RisectlHummockVersion defines model for RisectlHummockVersion.
+type RisectlHummockVersion struct {
+	Fields            map[string]string `json:"fields"`
+	Id                *int64            `json:"id,omitempty"`
+	MaxCommittedEpoch *int64            `json:"maxCommittedEpoch,omitempty"`
+	SafeEpoch         *int64            `json:"safeEpoch,omitempty"`
+}
+
+// RisectlOperation defines model for RisectlOperation.
+type RisectlOperation struct {
+	// Allowed Whether the current user may run the operation
+	Allowed bool `json:"allowed"`
+
+	// Command The risectl sub command run by the operation
+	Command     string               `json:"command"`
+	Description string               `json:"description"`
+	Name        RisectlOperationName `json:"name"`
+}
+
+// RisectlOperationName defines model for RisectlOperationName.
+type RisectlOperationName string
+
+// RisectlOperationResult defines model for RisectlOperationResult.
+type RisectlOperationResult struct {
+	Actors           *[]RisectlActor                 `json:"actors,omitempty"`
+	ClusterInfo      *RisectlClusterInfo             `json:"clusterInfo,omitempty"`
+	CompactionGroups *[]RisectlCompactionGroupStatus `json:"compactionGroups,omitempty"`
+	Fragments        *[]RisectlFragment              `json:"fragments,omitempty"`
+	HummockVersion   *RisectlHummockVersion          `json:"hummockVersion,omitempty"`
+	Operation        RisectlOperationName            `json:"operation"`
+
+	// RisectlVersion Version of risectl that ran the operation
+	RisectlVersion *string `json:"risectlVersion,omitempty"`
+}
+
+// RisectlShellCommand defines model for RisectlShellCommand.
+type RisectlShellCommand struct {
+	// Allowed Whether the allowlist of the user's role allowed the command
+	Allowed bool `json:"allowed"`
+
+	// Args Arguments passed to risectl
+	Args []string `json:"args"`
+
+	// Command The command line sent by the client
+	Command   string    `json:"command"`
+	CreatedAt time.Time `json:"createdAt"`
+
+	// Error Error message if the command was denied or failed to run
+	Error *string `json:"error,omitempty"`
+
+	// ExitCode Exit code of the risectl process, -1 if it was killed
+	ExitCode   *int32     `json:"exitCode,omitempty"`
+	FinishedAt *time.Time `json:"finishedAt,omitempty"`
+
+	// Seq Sequence number of the command in the session, starting from 1
+	Seq int32 `json:"seq"`
+}
+
+// RisectlShellMessage A message sent by the server of an interactive risectl shell. "ready" carries the session once it is opened, "start" and "exit" carry the command when it starts and finishes, "stdout" and "stderr" carry the output of the running command, "history" carries the commands of the session and "error" carries a message, e.g. a command line that can not be parsed.
+type RisectlShellMessage struct {
+	Command  *RisectlShellCommand   `json:"command,omitempty"`
+	Commands *[]RisectlShellCommand `json:"commands,omitempty"`
+
+	// Data Output of the command for stdout and stderr messages
+	Data *string `json:"data,omitempty"`
+
+	// Message Error message for error messages
+	Message *string `json:"message,omitempty"`
+
+	// Seq Sequence number of the command the message belongs to
+	Seq     *int32                  `json:"seq,omitempty"`
+	Session *RisectlShellSession    `json:"session,omitempty"`
+	Type    RisectlShellMessageType `json:"type"`
+}
+
+// RisectlShellMessageType defines model for RisectlShellMessageType.
+type RisectlShellMessageType string
+
+// RisectlShellSession defines model for RisectlShellSession.
+type RisectlShellSession struct {
+	ID        int32      `json:"ID"`
+	ClosedAt  *time.Time `json:"closedAt,omitempty"`
+	ClusterID int32      `json:"clusterID"`
+	CreatedAt time.Time  `json:"createdAt"`
+
+	// RisectlVersion Version of risectl used by the session
+	RisectlVersion *string `json:"risectlVersion,omitempty"`
+
+	// UserID ID of the user who opened the session
+	UserID int32 `json:"userID"`
+}
+
+// RisectlTable defines model for RisectlTable.
+type RisectlTable struct {
+	Headers []string   `json:"headers"`
+	Rows    [][]string `json:"rows"`
+}
+
 // Schema defines model for Schema.
 type Schema struct {
 	// Name Name of the schema
@@ -384,6 +1436,11 @@ type Schema struct {
 	Relations []Relation `json:"relations"`
 }
 
+// SessionSettings Session variables applied with SET before the query runs, e.g. `streaming_parallelism`,
+// `statement_timeout`, `query_mode`, `rw_streaming_enable_delta_join`, `search_path` and `background_ddl`.
+// `statement_timeout` accepts a duration (e.g. 30s, 5m) or a number of seconds.
+type SessionSettings map[string]string
+
 // Snapshot defines model for Snapshot.
 type Snapshot struct {
 	// ClusterID ID of the cluster this snapshot belongs to
@@ -397,6 +1454,15 @@ type Snapshot struct {
 
 	// Name Name of the snapshot
 	Name string `json:"name"`
+
+	// Source console if the snapshot is created manually in the console, auto if it is created by the automatic backup, upgrade if it is taken before a cluster upgrade, imported if it is found in the cluster. Only the automatic snapshots are pruned by the retention policy.
+	Source SnapshotSource `json:"source"`
+
+	// SyncStatus Whether the snapshot exists in the cluster. The snapshots are checked periodically through risectl, or through a database of the cluster if risectl can not list them. The status is unknown until the cluster is checked.
+	SyncStatus SnapshotSyncStatus `json:"syncStatus"`
+
+	// SyncedAt When the snapshot was last checked against the meta snapshots of the cluster
+	SyncedAt *time.Time `json:"syncedAt,omitempty"`
 }
 
 // SnapshotCreate defines model for SnapshotCreate.
@@ -405,6 +1471,63 @@ type SnapshotCreate struct {
 	Name string `json:"name"`
 }
 
+// SnapshotRetentionDecision defines model for SnapshotRetentionDecision.
+type SnapshotRetentionDecision struct {
+	// Keep Whether the snapshot is kept, the snapshot is deleted otherwise
+	Keep bool `json:"keep"`
+
+	// Reasons The rules keeping the snapshot (e.g., 'last 1', 'daily 2025-01-02', 'weekly 2025-W01')
+	Reasons  []string `json:"reasons"`
+	Snapshot Snapshot `json:"snapshot"`
+}
+
+// SnapshotRetentionPolicy Count-based retention of the automatic snapshots, applied after each automatic backup instead of the retention duration if any rule is set. A snapshot is kept if any rule keeps it. The daily, weekly and monthly rules keep the newest snapshot of each of the N most recent days, ISO weeks and months that have snapshots, in the timezone of the organization.
+type SnapshotRetentionPolicy struct {
+	// KeepDaily Keep the newest snapshot of each of the N most recent days
+	KeepDaily *int32 `json:"keepDaily,omitempty"`
+
+	// KeepLast Keep the N newest snapshots
+	KeepLast *int32 `json:"keepLast,omitempty"`
+
+	// KeepMonthly Keep the newest snapshot of each of the N most recent months
+	KeepMonthly *int32 `json:"keepMonthly,omitempty"`
+
+	// KeepWeekly Keep the newest snapshot of each of the N most recent weeks
+	KeepWeekly *int32 `json:"keepWeekly,omitempty"`
+}
+
+// SnapshotSource console if the snapshot is created manually in the console, auto if it is created by the automatic backup, upgrade if it is taken before a cluster upgrade, imported if it is found in the cluster. Only the automatic snapshots are pruned by the retention policy.
+type SnapshotSource string
+
+// SnapshotSyncStatus Whether the snapshot exists in the cluster. The snapshots are checked periodically through risectl, or through a database of the cluster if risectl can not list them. The status is unknown until the cluster is checked.
+type SnapshotSyncStatus string
+
+// StatementImpact defines model for StatementImpact.
+type StatementImpact struct {
+	Action StatementImpactAction `json:"action"`
+
+	// Affected Relations depending on the targets transitively
+	Affected []LineageNode `json:"affected"`
+
+	// Cascade Whether the statement is a DROP ... CASCADE
+	Cascade bool `json:"cascade"`
+
+	// ObjectType Type of the object in the statement, e.g. materialized view
+	ObjectType string `json:"objectType"`
+
+	// Statement The DROP or ALTER statement
+	Statement string `json:"statement"`
+
+	// Targets Relations referred to by the statement
+	Targets []LineageNode `json:"targets"`
+
+	// Unresolved Names in the statement that do not match any relation
+	Unresolved []string `json:"unresolved"`
+}
+
+// StatementImpactAction defines model for StatementImpact.Action.
+type StatementImpactAction string
+
 // Task defines model for Task.
 type Task struct {
 	ID         int32          `json:"ID"`
@@ -493,16 +1616,27 @@ type TaskSpecDeleteSnapshot struct {
 
 // TestClusterConnectionPayload defines model for TestClusterConnectionPayload.
 type TestClusterConnectionPayload struct {
-	Host     string `json:"host"`
-	HttpPort int32  `json:"httpPort"`
-	MetaPort int32  `json:"metaPort"`
-	SqlPort  int32  `json:"sqlPort"`
+	// Database Database to connect to, dev by default
+	Database       *string `json:"database,omitempty"`
+	Host           string  `json:"host"`
+	HttpPort       int32   `json:"httpPort"`
+	MetaPort       int32   `json:"metaPort"`
+	Password       *string `json:"password,omitempty"`
+	RisectlVersion *string `json:"risectlVersion,omitempty"`
+	SqlPort        int32   `json:"sqlPort"`
+
+	// Username User to test the SQL authentication with, the SQL authentication is skipped if it is not set
+	Username *string `json:"username,omitempty"`
+
+	// Version Version of the cluster to pick risectl with, the risectl stage is skipped if it is not set
+	Version *string `json:"version,omitempty"`
 }
 
 // TestClusterConnectionResult defines model for TestClusterConnectionResult.
 type TestClusterConnectionResult struct {
 	// Result Test result
-	Result string `json:"result"`
+	Result string                `json:"result"`
+	Stages []ConnectionTestStage `json:"stages"`
 
 	// Success Whether the cluster connection was successful
 	Success bool `json:"success"`
@@ -521,6 +1655,9 @@ type TestDatabaseConnectionResult struct {
 	// Result Test result
 	Result string `json:"result"`
 
+	// Stages The stages depending on the SQL port, dns, tcp_sql, tls, pgwire_auth and database
+	Stages []ConnectionTestStage `json:"stages"`
+
 	// Success Whether the database connection was successful
 	Success bool `json:"success"`
 }
@@ -534,8 +1671,33 @@ type UpdateClusterRequest struct {
 	// MetricsStoreID ID of the metrics store this cluster belongs to
 	MetricsStoreID *int32 `json:"metricsStoreID,omitempty"`
 	Name           string `json:"name"`
-	SqlPort        int32  `json:"sqlPort"`
-	Version        string `json:"version"`
+
+	// RisectlVersion Version of risectl used for this cluster, the nearest compatible version is used if it is not set
+	RisectlVersion *string `json:"risectlVersion,omitempty"`
+	SqlPort        int32   `json:"sqlPort"`
+	Version        string  `json:"version"`
+}
+
+// ListAlertRulesParams defines parameters for ListAlertRules.
+type ListAlertRulesParams struct {
+	// ClusterID Only return the rules of this cluster, the rules of all the clusters are not included
+	ClusterID *int32 `form:"clusterID,omitempty" json:"clusterID,omitempty"`
+}
+
+// ListAlertsParams defines parameters for ListAlerts.
+type ListAlertsParams struct {
+	ClusterID *int32 `form:"clusterID,omitempty" json:"clusterID,omitempty"`
+}
+
+// ListAlertHistoryParams defines parameters for ListAlertHistory.
+type ListAlertHistoryParams struct {
+	ClusterID *int32     `form:"clusterID,omitempty" json:"clusterID,omitempty"`
+	RuleID    *int32     `form:"ruleID,omitempty" json:"ruleID,omitempty"`
+	From      *time.Time `form:"from,omitempty" json:"from,omitempty"`
+	To        *time.Time `form:"to,omitempty" json:"to,omitempty"`
+
+	// Limit Maximum number of records to return, 100 by default
+	Limit *int32 `form:"limit,omitempty" json:"limit,omitempty"`
 }
 
 // DeleteClusterParams defines parameters for DeleteCluster.
@@ -558,12 +1720,163 @@ type ListClusterDiagnosticsParams struct {
 	PerPage *int `form:"perPage,omitempty" json:"perPage,omitempty"`
 }
 
+// DiffClusterDiagnosticsParams defines parameters for DiffClusterDiagnostics.
+type DiffClusterDiagnosticsParams struct {
+	// From ID of the older diagnostic data
+	From int32 `form:"from" json:"from"`
+
+	// To ID of the newer diagnostic data
+	To int32 `form:"to" json:"to"`
+}
+
+// SearchClusterDiagnosticsParams defines parameters for SearchClusterDiagnostics.
+type SearchClusterDiagnosticsParams struct {
+	// Q Words to search for, a diagnostic matches if its report contains all of them
+	Q    string     `form:"q" json:"q"`
+	From *time.Time `form:"from,omitempty" json:"from,omitempty"`
+	To   *time.Time `form:"to,omitempty" json:"to,omitempty"`
+
+	// Order Order of the results by creation time, newest by default
+	Order *DiagnosticSearchOrder `form:"order,omitempty" json:"order,omitempty"`
+
+	// Limit Maximum number of diagnostics to return, 20 by default
+	Limit *int32 `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// ListClusterEventsParams defines parameters for ListClusterEvents.
+type ListClusterEventsParams struct {
+	// Type Only return the events of this type
+	Type *ClusterEventType `form:"type,omitempty" json:"type,omitempty"`
+
+	// Limit Maximum number of events to return, 100 by default
+	Limit *int32 `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// ListClusterHealthHistoryParams defines parameters for ListClusterHealthHistory.
+type ListClusterHealthHistoryParams struct {
+	From *time.Time `form:"from,omitempty" json:"from,omitempty"`
+	To   *time.Time `form:"to,omitempty" json:"to,omitempty"`
+
+	// Limit Maximum number of records to return, 100 by default
+	Limit *int32 `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// ListRisectlExecutionsParams defines parameters for ListRisectlExecutions.
+type ListRisectlExecutionsParams struct {
+	// Before Only return the executions older than the execution with this ID, used to page through the executions
+	Before *int32 `form:"before,omitempty" json:"before,omitempty"`
+
+	// Limit Maximum number of executions to return, 50 by default
+	Limit *int32 `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// ListRisectlExecutionOutputsParams defines parameters for ListRisectlExecutionOutputs.
+type ListRisectlExecutionOutputsParams struct {
+	// After Only return the chunks after this sequence number
+	After *int32 `form:"after,omitempty" json:"after,omitempty"`
+}
+
+// StreamRisectlExecutionParams defines parameters for StreamRisectlExecution.
+type StreamRisectlExecutionParams struct {
+	// After Only stream the chunks after this sequence number
+	After *int32 `form:"after,omitempty" json:"after,omitempty"`
+}
+
+// GetDatabaseParams defines parameters for GetDatabase.
+type GetDatabaseParams struct {
+	// Refresh Fetch the catalog from the database instead of the cache
+	Refresh *bool `form:"refresh,omitempty" json:"refresh,omitempty"`
+}
+
+// ListDatabaseCatalogChangesParams defines parameters for ListDatabaseCatalogChanges.
+type ListDatabaseCatalogChangesParams struct {
+	// RelationID Only return the changes of this relation
+	RelationID *int32 `form:"relationID,omitempty" json:"relationID,omitempty"`
+
+	// RelationName Only return the changes of relations with this name, either name or schema.name
+	RelationName *string `form:"relationName,omitempty" json:"relationName,omitempty"`
+
+	// Before Only return the changes older than the change with this ID, used to page through the history
+	Before *int32 `form:"before,omitempty" json:"before,omitempty"`
+
+	// Limit Maximum number of changes to return, 100 by default
+	Limit *int32 `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// GetDatabaseLineageParams defines parameters for GetDatabaseLineage.
+type GetDatabaseLineageParams struct {
+	// RelationID Relation to start the traversal from, the whole database is returned if not set
+	RelationID *int32 `form:"relationID,omitempty" json:"relationID,omitempty"`
+
+	// Direction Direction of the traversal from the relation, default is both
+	Direction *LineageDirection `form:"direction,omitempty" json:"direction,omitempty"`
+
+	// Depth Maximum number of hops from the relation, 0 means unlimited
+	Depth *int `form:"depth,omitempty" json:"depth,omitempty"`
+}
+
+// ExportDatabaseLineageParams defines parameters for ExportDatabaseLineage.
+type ExportDatabaseLineageParams struct {
+	// Format Export format, Graphviz DOT or OpenLineage JSON
+	Format ExportDatabaseLineageParamsFormat `form:"format" json:"format"`
+
+	// RelationID Relation to start the traversal from, the whole database is exported if not set
+	RelationID *int32 `form:"relationID,omitempty" json:"relationID,omitempty"`
+
+	// Direction Direction of the traversal from the relation, default is both
+	Direction *LineageDirection `form:"direction,omitempty" json:"direction,omitempty"`
+
+	// Depth Maximum number of hops from the relation, 0 means unlimited
+	Depth *int `form:"depth,omitempty" json:"depth,omitempty"`
+}
+
+// ExportDatabaseLineageParamsFormat defines parameters for ExportDatabaseLineage.
+type ExportDatabaseLineageParamsFormat string
+
+// ListDatabaseRelationsParams defines parameters for ListDatabaseRelations.
+type ListDatabaseRelationsParams struct {
+	// Refresh Fetch the relations from the database instead of the cache
+	Refresh *bool `form:"refresh,omitempty" json:"refresh,omitempty"`
+}
+
+// SearchDiagnosticsParams defines parameters for SearchDiagnostics.
+type SearchDiagnosticsParams struct {
+	// ClusterID Only search the diagnostics of this cluster
+	ClusterID *int32 `form:"clusterID,omitempty" json:"clusterID,omitempty"`
+
+	// Q Words to search for, a diagnostic matches if its report contains all of them
+	Q    string     `form:"q" json:"q"`
+	From *time.Time `form:"from,omitempty" json:"from,omitempty"`
+	To   *time.Time `form:"to,omitempty" json:"to,omitempty"`
+
+	// Order Order of the results by creation time, newest by default
+	Order *DiagnosticSearchOrder `form:"order,omitempty" json:"order,omitempty"`
+
+	// Limit Maximum number of diagnostics to return, 20 by default
+	Limit *int32 `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
 // DeleteMetricsStoreParams defines parameters for DeleteMetricsStore.
 type DeleteMetricsStoreParams struct {
 	// Force force delete the metrics store even if it is in use
 	Force bool `form:"force" json:"force"`
 }
 
+// UploadRisectlMultipartBody defines parameters for UploadRisectl.
+type UploadRisectlMultipartBody struct {
+	// File The tar.gz archive containing the risectl binary
+	File openapi_types.File `json:"file"`
+
+	// Sha256 The hex encoded sha256 of the archive, the archive is rejected if it does not match
+	Sha256 string `json:"sha256"`
+}
+
+// CreateAlertRuleJSONRequestBody defines body for CreateAlertRule for application/json ContentType.
+type CreateAlertRuleJSONRequestBody = AlertRuleCreate
+
+// UpdateAlertRuleJSONRequestBody defines body for UpdateAlertRule for application/json ContentType.
+type UpdateAlertRuleJSONRequestBody = AlertRuleCreate
+
 // CreateClusterJSONRequestBody defines body for CreateCluster for application/json ContentType.
 type CreateClusterJSONRequestBody = ClusterCreate
 
@@ -576,6 +1889,9 @@ type UpdateClusterJSONRequestBody = UpdateClusterRequest
 // UpdateClusterAutoBackupConfigJSONRequestBody defines body for UpdateClusterAutoBackupConfig for application/json ContentType.
 type UpdateClusterAutoBackupConfigJSONRequestBody = AutoBackupConfig
 
+// PreviewClusterSnapshotRetentionJSONRequestBody defines body for PreviewClusterSnapshotRetention for application/json ContentType.
+type PreviewClusterSnapshotRetentionJSONRequestBody = SnapshotRetentionPolicy
+
 // CreateClusterDiagnosticJSONRequestBody defines body for CreateClusterDiagnostic for application/json ContentType.
 type CreateClusterDiagnosticJSONRequestBody = DiagnosticData
 
@@ -585,9 +1901,15 @@ type UpdateClusterAutoDiagnosticConfigJSONRequestBody = AutoDiagnosticConfig
 // RunRisectlCommandJSONRequestBody defines body for RunRisectlCommand for application/json ContentType.
 type RunRisectlCommandJSONRequestBody = RisectlCommand
 
+// CreateRisectlExecutionJSONRequestBody defines body for CreateRisectlExecution for application/json ContentType.
+type CreateRisectlExecutionJSONRequestBody = RisectlCommand
+
 // CreateClusterSnapshotJSONRequestBody defines body for CreateClusterSnapshot for application/json ContentType.
 type CreateClusterSnapshotJSONRequestBody = SnapshotCreate
 
+// CreateClusterUpgradeJSONRequestBody defines body for CreateClusterUpgrade for application/json ContentType.
+type CreateClusterUpgradeJSONRequestBody = ClusterUpgradeCreate
+
 // ImportDatabaseJSONRequestBody defines body for ImportDatabase for application/json ContentType.
 type ImportDatabaseJSONRequestBody = DatabaseConnectInfo
 
@@ -597,15 +1919,27 @@ type TestDatabaseConnectionJSONRequestBody = TestDatabaseConnectionPayload
 // UpdateDatabaseJSONRequestBody defines body for UpdateDatabase for application/json ContentType.
 type UpdateDatabaseJSONRequestBody = DatabaseConnectInfo
 
+// AnalyzeDatabaseImpactJSONRequestBody defines body for AnalyzeDatabaseImpact for application/json ContentType.
+type AnalyzeDatabaseImpactJSONRequestBody = ImpactAnalysisRequest
+
 // QueryDatabaseJSONRequestBody defines body for QueryDatabase for application/json ContentType.
 type QueryDatabaseJSONRequestBody = QueryRequest
 
+// UpdateDiagnosticRuleJSONRequestBody defines body for UpdateDiagnosticRule for application/json ContentType.
+type UpdateDiagnosticRuleJSONRequestBody = DiagnosticRuleUpdate
+
 // ImportMetricsStoreJSONRequestBody defines body for ImportMetricsStore for application/json ContentType.
 type ImportMetricsStoreJSONRequestBody = MetricsStoreImport
 
 // UpdateMetricsStoreJSONRequestBody defines body for UpdateMetricsStore for application/json ContentType.
 type UpdateMetricsStoreJSONRequestBody = MetricsStore
 
+// UpdateOrgSettingsJSONRequestBody defines body for UpdateOrgSettings for application/json ContentType.
+type UpdateOrgSettingsJSONRequestBody = OrgSettings
+
+// UploadRisectlMultipartRequestBody defines body for UploadRisectl for multipart/form-data ContentType.
+type UploadRisectlMultipartRequestBody UploadRisectlMultipartBody
+
 // TestClusterConnectionJSONRequestBody defines body for TestClusterConnection for application/json ContentType.
 type TestClusterConnectionJSONRequestBody = TestClusterConnectionPayload
 
@@ -682,6 +2016,31 @@ func WithRequestEditorFn(fn RequestEditorFn) ClientOption {
 
 // The interface specification for the client above.
 type ClientInterface interface {
+	// ListAlertRules request
+	ListAlertRules(ctx context.Context, params *ListAlertRulesParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateAlertRuleWithBody request with any body
+	CreateAlertRuleWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateAlertRule(ctx context.Context, body CreateAlertRuleJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteAlertRule request
+	DeleteAlertRule(ctx context.Context, id int32, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetAlertRule request
+	GetAlertRule(ctx context.Context, id int32, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpdateAlertRuleWithBody request with any body
+	UpdateAlertRuleWithBody(ctx context.Context, id int32, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdateAlertRule(ctx context.Context, id int32, body UpdateAlertRuleJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListAlerts request
+	ListAlerts(ctx context.Context, params *ListAlertsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListAlertHistory request
+	ListAlertHistory(ctx context.Context, params *ListAlertHistoryParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// ListClusterVersions request
 	ListClusterVersions(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -717,6 +2076,26 @@ type ClientInterface interface {
 
 	UpdateClusterAutoBackupConfig(ctx context.Context, id int32, body UpdateClusterAutoBackupConfigJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// PreviewClusterSnapshotRetentionWithBody request with any body
+	PreviewClusterSnapshotRetentionWithBody(ctx context.Context, id int32, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PreviewClusterSnapshotRetention(ctx context.Context, id int32, body PreviewClusterSnapshotRetentionJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListClusterDiagnosticBundles request
+	ListClusterDiagnosticBundles(ctx context.Context, id int32, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateClusterDiagnosticBundle request
+	CreateClusterDiagnosticBundle(ctx context.Context, id int32, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteClusterDiagnosticBundle request
+	DeleteClusterDiagnosticBundle(ctx context.Context, id int32, bundleId int32, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetClusterDiagnosticBundle request
+	GetClusterDiagnosticBundle(ctx context.Context, id int32, bundleId int32, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DownloadClusterDiagnosticBundle request
+	DownloadClusterDiagnosticBundle(ctx context.Context, id int32, bundleId int32, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// ListClusterDiagnostics request
 	ListClusterDiagnostics(ctx context.Context, id int32, params *ListClusterDiagnosticsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -733,14 +2112,67 @@ type ClientInterface interface {
 
 	UpdateClusterAutoDiagnosticConfig(ctx context.Context, id int32, body UpdateClusterAutoDiagnosticConfigJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// DiffClusterDiagnostics request
+	DiffClusterDiagnostics(ctx context.Context, id int32, params *DiffClusterDiagnosticsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// SearchClusterDiagnostics request
+	SearchClusterDiagnostics(ctx context.Context, id int32, params *SearchClusterDiagnosticsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// GetClusterDiagnostic request
 	GetClusterDiagnostic(ctx context.Context, id int32, diagnosticId int32, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// ListClusterDiagnosticFindings request
+	ListClusterDiagnosticFindings(ctx context.Context, id int32, diagnosticId int32, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetClusterDiagnosticReport request
+	GetClusterDiagnosticReport(ctx context.Context, id int32, diagnosticId int32, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListClusterEvents request
+	ListClusterEvents(ctx context.Context, id int32, params *ListClusterEventsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListClusterHealthHistory request
+	ListClusterHealthHistory(ctx context.Context, id int32, params *ListClusterHealthHistoryParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// RunRisectlCommandWithBody request with any body
 	RunRisectlCommandWithBody(ctx context.Context, id int32, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	RunRisectlCommand(ctx context.Context, id int32, body RunRisectlCommandJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// ListRisectlExecutions request
+	ListRisectlExecutions(ctx context.Context, id int32, params *ListRisectlExecutionsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateRisectlExecutionWithBody request with any body
+	CreateRisectlExecutionWithBody(ctx context.Context, id int32, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateRisectlExecution(ctx context.Context, id int32, body CreateRisectlExecutionJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetRisectlExecution request
+	GetRisectlExecution(ctx context.Context, id int32, executionID int32, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CancelRisectlExecution request
+	CancelRisectlExecution(ctx context.Context, id int32, executionID int32, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListRisectlExecutionOutputs request
+	ListRisectlExecutionOutputs(ctx context.Context, id int32, executionID int32, params *ListRisectlExecutionOutputsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// StreamRisectlExecution request
+	StreamRisectlExecution(ctx context.Context, id int32, executionID int32, params *StreamRisectlExecutionParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListRisectlOperations request
+	ListRisectlOperations(ctx context.Context, id int32, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// RunRisectlOperation request
+	RunRisectlOperation(ctx context.Context, id int32, operation RisectlOperationName, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// OpenRisectlShell request
+	OpenRisectlShell(ctx context.Context, id int32, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListRisectlShellSessions request
+	ListRisectlShellSessions(ctx context.Context, id int32, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListRisectlShellCommands request
+	ListRisectlShellCommands(ctx context.Context, id int32, sessionID int32, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// ListClusterSnapshots request
 	ListClusterSnapshots(ctx context.Context, id int32, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -755,6 +2187,23 @@ type ClientInterface interface {
 	// RestoreClusterSnapshot request
 	RestoreClusterSnapshot(ctx context.Context, id int32, snapshotId int64, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// ListClusterUpgrades request
+	ListClusterUpgrades(ctx context.Context, id int32, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateClusterUpgradeWithBody request with any body
+	CreateClusterUpgradeWithBody(ctx context.Context, id int32, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateClusterUpgrade(ctx context.Context, id int32, body CreateClusterUpgradeJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetClusterUpgrade request
+	GetClusterUpgrade(ctx context.Context, id int32, upgradeID int32, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CancelClusterUpgrade request
+	CancelClusterUpgrade(ctx context.Context, id int32, upgradeID int32, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListClusterVersionHistory request
+	ListClusterVersionHistory(ctx context.Context, id int32, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// ListDatabases request
 	ListDatabases(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -772,24 +2221,52 @@ type ClientInterface interface {
 	DeleteDatabase(ctx context.Context, id int32, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// GetDatabase request
-	GetDatabase(ctx context.Context, id int32, reqEditors ...RequestEditorFn) (*http.Response, error)
+	GetDatabase(ctx context.Context, id int32, params *GetDatabaseParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// UpdateDatabaseWithBody request with any body
 	UpdateDatabaseWithBody(ctx context.Context, id int32, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	UpdateDatabase(ctx context.Context, id int32, body UpdateDatabaseJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// ListDatabaseCatalogChanges request
+	ListDatabaseCatalogChanges(ctx context.Context, id int32, params *ListDatabaseCatalogChangesParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// GetDDLProgress request
 	GetDDLProgress(ctx context.Context, id int32, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// CancelDDLProgress request
 	CancelDDLProgress(ctx context.Context, id int32, ddlID int64, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// AnalyzeDatabaseImpactWithBody request with any body
+	AnalyzeDatabaseImpactWithBody(ctx context.Context, id int32, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	AnalyzeDatabaseImpact(ctx context.Context, id int32, body AnalyzeDatabaseImpactJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetDatabaseLineage request
+	GetDatabaseLineage(ctx context.Context, id int32, params *GetDatabaseLineageParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ExportDatabaseLineage request
+	ExportDatabaseLineage(ctx context.Context, id int32, params *ExportDatabaseLineageParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// QueryDatabaseWithBody request with any body
 	QueryDatabaseWithBody(ctx context.Context, id int32, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	QueryDatabase(ctx context.Context, id int32, body QueryDatabaseJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// ListDatabaseRelations request
+	ListDatabaseRelations(ctx context.Context, id int32, params *ListDatabaseRelationsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListDiagnosticRules request
+	ListDiagnosticRules(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpdateDiagnosticRuleWithBody request with any body
+	UpdateDiagnosticRuleWithBody(ctx context.Context, rule DiagnosticRuleName, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdateDiagnosticRule(ctx context.Context, rule DiagnosticRuleName, body UpdateDiagnosticRuleJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// SearchDiagnostics request
+	SearchDiagnostics(ctx context.Context, params *SearchDiagnosticsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// ListEvents request
 	ListEvents(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -815,6 +2292,17 @@ type ClientInterface interface {
 	// GetMaterializedViewThroughput request
 	GetMaterializedViewThroughput(ctx context.Context, clusterID int32, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// GetOrgSettings request
+	GetOrgSettings(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpdateOrgSettingsWithBody request with any body
+	UpdateOrgSettingsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdateOrgSettings(ctx context.Context, body UpdateOrgSettingsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UploadRisectlWithBody request with any body
+	UploadRisectlWithBody(ctx context.Context, version string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// ListTasks request
 	ListTasks(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -824,8 +2312,8 @@ type ClientInterface interface {
 	TestClusterConnection(ctx context.Context, body TestClusterConnectionJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 }
 
-func (c *Client) ListClusterVersions(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewListClusterVersionsRequest(c.Server)
+func (c *Client) ListAlertRules(ctx context.Context, params *ListAlertRulesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListAlertRulesRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
@@ -836,8 +2324,8 @@ func (c *Client) ListClusterVersions(ctx context.Context, reqEditors ...RequestE
 	return c.Client.Do(req)
 }
 
-func (c *Client) ListClusters(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewListClustersRequest(c.Server)
+func (c *Client) CreateAlertRuleWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateAlertRuleRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -848,8 +2336,8 @@ func (c *Client) ListClusters(ctx context.Context, reqEditors ...RequestEditorFn
 	return c.Client.Do(req)
 }
 
-func (c *Client) CreateClusterWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCreateClusterRequestWithBody(c.Server, contentType, body)
+func (c *Client) CreateAlertRule(ctx context.Context, body CreateAlertRuleJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateAlertRuleRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
@@ -860,8 +2348,8 @@ func (c *Client) CreateClusterWithBody(ctx context.Context, contentType string,
 	return c.Client.Do(req)
 }
 
-func (c *Client) CreateCluster(ctx context.Context, body CreateClusterJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCreateClusterRequest(c.Server, body)
+func (c *Client) DeleteAlertRule(ctx context.Context, id int32, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteAlertRuleRequest(c.Server, id)
 	if err != nil {
 		return nil, err
 	}
@@ -872,8 +2360,8 @@ func (c *Client) CreateCluster(ctx context.Context, body CreateClusterJSONReques
 	return c.Client.Do(req)
 }
 
-func (c *Client) ImportClusterWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewImportClusterRequestWithBody(c.Server, contentType, body)
+func (c *Client) GetAlertRule(ctx context.Context, id int32, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetAlertRuleRequest(c.Server, id)
 	if err != nil {
 		return nil, err
 	}
@@ -884,8 +2372,8 @@ func (c *Client) ImportClusterWithBody(ctx context.Context, contentType string,
 	return c.Client.Do(req)
 }
 
-func (c *Client) ImportCluster(ctx context.Context, body ImportClusterJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewImportClusterRequest(c.Server, body)
+func (c *Client) UpdateAlertRuleWithBody(ctx context.Context, id int32, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateAlertRuleRequestWithBody(c.Server, id, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -896,8 +2384,8 @@ func (c *Client) ImportCluster(ctx context.Context, body ImportClusterJSONReques
 	return c.Client.Do(req)
 }
 
-func (c *Client) DeleteCluster(ctx context.Context, id int32, params *DeleteClusterParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewDeleteClusterRequest(c.Server, id, params)
+func (c *Client) UpdateAlertRule(ctx context.Context, id int32, body UpdateAlertRuleJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateAlertRuleRequest(c.Server, id, body)
 	if err != nil {
 		return nil, err
 	}
@@ -908,8 +2396,8 @@ func (c *Client) DeleteCluster(ctx context.Context, id int32, params *DeleteClus
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetCluster(ctx context.Context, id int32, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetClusterRequest(c.Server, id)
+func (c *Client) ListAlerts(ctx context.Context, params *ListAlertsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListAlertsRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
@@ -920,8 +2408,8 @@ func (c *Client) GetCluster(ctx context.Context, id int32, reqEditors ...Request
 	return c.Client.Do(req)
 }
 
-func (c *Client) UpdateClusterWithBody(ctx context.Context, id int32, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUpdateClusterRequestWithBody(c.Server, id, contentType, body)
+func (c *Client) ListAlertHistory(ctx context.Context, params *ListAlertHistoryParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListAlertHistoryRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
@@ -932,8 +2420,8 @@ func (c *Client) UpdateClusterWithBody(ctx context.Context, id int32, contentTyp
 	return c.Client.Do(req)
 }
 
-func (c *Client) UpdateCluster(ctx context.Context, id int32, body UpdateClusterJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUpdateClusterRequest(c.Server, id, body)
+func (c *Client) ListClusterVersions(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListClusterVersionsRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
@@ -944,8 +2432,8 @@ func (c *Client) UpdateCluster(ctx context.Context, id int32, body UpdateCluster
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetClusterAutoBackupConfig(ctx context.Context, id int32, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetClusterAutoBackupConfigRequest(c.Server, id)
+func (c *Client) ListClusters(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListClustersRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
@@ -956,8 +2444,8 @@ func (c *Client) GetClusterAutoBackupConfig(ctx context.Context, id int32, reqEd
 	return c.Client.Do(req)
 }
 
-func (c *Client) UpdateClusterAutoBackupConfigWithBody(ctx context.Context, id int32, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUpdateClusterAutoBackupConfigRequestWithBody(c.Server, id, contentType, body)
+func (c *Client) CreateClusterWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateClusterRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -968,8 +2456,8 @@ func (c *Client) UpdateClusterAutoBackupConfigWithBody(ctx context.Context, id i
 	return c.Client.Do(req)
 }
 
-func (c *Client) UpdateClusterAutoBackupConfig(ctx context.Context, id int32, body UpdateClusterAutoBackupConfigJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUpdateClusterAutoBackupConfigRequest(c.Server, id, body)
+func (c *Client) CreateCluster(ctx context.Context, body CreateClusterJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateClusterRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
@@ -980,8 +2468,8 @@ func (c *Client) UpdateClusterAutoBackupConfig(ctx context.Context, id int32, bo
 	return c.Client.Do(req)
 }
 
-func (c *Client) ListClusterDiagnostics(ctx context.Context, id int32, params *ListClusterDiagnosticsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewListClusterDiagnosticsRequest(c.Server, id, params)
+func (c *Client) ImportClusterWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewImportClusterRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -992,8 +2480,8 @@ func (c *Client) ListClusterDiagnostics(ctx context.Context, id int32, params *L
 	return c.Client.Do(req)
 }
 
-func (c *Client) CreateClusterDiagnosticWithBody(ctx context.Context, id int32, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCreateClusterDiagnosticRequestWithBody(c.Server, id, contentType, body)
+func (c *Client) ImportCluster(ctx context.Context, body ImportClusterJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewImportClusterRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
@@ -1004,8 +2492,8 @@ func (c *Client) CreateClusterDiagnosticWithBody(ctx context.Context, id int32,
 	return c.Client.Do(req)
 }
 
-func (c *Client) CreateClusterDiagnostic(ctx context.Context, id int32, body CreateClusterDiagnosticJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCreateClusterDiagnosticRequest(c.Server, id, body)
+func (c *Client) DeleteCluster(ctx context.Context, id int32, params *DeleteClusterParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteClusterRequest(c.Server, id, params)
 	if err != nil {
 		return nil, err
 	}
@@ -1016,8 +2504,8 @@ func (c *Client) CreateClusterDiagnostic(ctx context.Context, id int32, body Cre
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetClusterAutoDiagnosticConfig(ctx context.Context, id int32, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetClusterAutoDiagnosticConfigRequest(c.Server, id)
+func (c *Client) GetCluster(ctx context.Context, id int32, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetClusterRequest(c.Server, id)
 	if err != nil {
 		return nil, err
 	}
@@ -1028,8 +2516,8 @@ func (c *Client) GetClusterAutoDiagnosticConfig(ctx context.Context, id int32, r
 	return c.Client.Do(req)
 }
 
-func (c *Client) UpdateClusterAutoDiagnosticConfigWithBody(ctx context.Context, id int32, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUpdateClusterAutoDiagnosticConfigRequestWithBody(c.Server, id, contentType, body)
+func (c *Client) UpdateClusterWithBody(ctx context.Context, id int32, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateClusterRequestWithBody(c.Server, id, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -1040,8 +2528,8 @@ func (c *Client) UpdateClusterAutoDiagnosticConfigWithBody(ctx context.Context,
 	return c.Client.Do(req)
 }
 
-func (c *Client) UpdateClusterAutoDiagnosticConfig(ctx context.Context, id int32, body UpdateClusterAutoDiagnosticConfigJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUpdateClusterAutoDiagnosticConfigRequest(c.Server, id, body)
+func (c *Client) UpdateCluster(ctx context.Context, id int32, body UpdateClusterJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateClusterRequest(c.Server, id, body)
 	if err != nil {
 		return nil, err
 	}
@@ -1052,8 +2540,8 @@ func (c *Client) UpdateClusterAutoDiagnosticConfig(ctx context.Context, id int32
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetClusterDiagnostic(ctx context.Context, id int32, diagnosticId int32, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetClusterDiagnosticRequest(c.Server, id, diagnosticId)
+func (c *Client) GetClusterAutoBackupConfig(ctx context.Context, id int32, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetClusterAutoBackupConfigRequest(c.Server, id)
 	if err != nil {
 		return nil, err
 	}
@@ -1064,8 +2552,8 @@ func (c *Client) GetClusterDiagnostic(ctx context.Context, id int32, diagnosticI
 	return c.Client.Do(req)
 }
 
-func (c *Client) RunRisectlCommandWithBody(ctx context.Context, id int32, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewRunRisectlCommandRequestWithBody(c.Server, id, contentType, body)
+func (c *Client) UpdateClusterAutoBackupConfigWithBody(ctx context.Context, id int32, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateClusterAutoBackupConfigRequestWithBody(c.Server, id, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -1076,8 +2564,8 @@ func (c *Client) RunRisectlCommandWithBody(ctx context.Context, id int32, conten
 	return c.Client.Do(req)
 }
 
-func (c *Client) RunRisectlCommand(ctx context.Context, id int32, body RunRisectlCommandJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewRunRisectlCommandRequest(c.Server, id, body)
+func (c *Client) UpdateClusterAutoBackupConfig(ctx context.Context, id int32, body UpdateClusterAutoBackupConfigJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateClusterAutoBackupConfigRequest(c.Server, id, body)
 	if err != nil {
 		return nil, err
 	}
@@ -1088,8 +2576,8 @@ func (c *Client) RunRisectlCommand(ctx context.Context, id int32, body RunRisect
 	return c.Client.Do(req)
 }
 
-func (c *Client) ListClusterSnapshots(ctx context.Context, id int32, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewListClusterSnapshotsRequest(c.Server, id)
+func (c *Client) PreviewClusterSnapshotRetentionWithBody(ctx context.Context, id int32, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPreviewClusterSnapshotRetentionRequestWithBody(c.Server, id, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -1100,8 +2588,8 @@ func (c *Client) ListClusterSnapshots(ctx context.Context, id int32, reqEditors
 	return c.Client.Do(req)
 }
 
-func (c *Client) CreateClusterSnapshotWithBody(ctx context.Context, id int32, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCreateClusterSnapshotRequestWithBody(c.Server, id, contentType, body)
+func (c *Client) PreviewClusterSnapshotRetention(ctx context.Context, id int32, body PreviewClusterSnapshotRetentionJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPreviewClusterSnapshotRetentionRequest(c.Server, id, body)
 	if err != nil {
 		return nil, err
 	}
@@ -1112,8 +2600,8 @@ func (c *Client) CreateClusterSnapshotWithBody(ctx context.Context, id int32, co
 	return c.Client.Do(req)
 }
 
-func (c *Client) CreateClusterSnapshot(ctx context.Context, id int32, body CreateClusterSnapshotJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCreateClusterSnapshotRequest(c.Server, id, body)
+func (c *Client) ListClusterDiagnosticBundles(ctx context.Context, id int32, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListClusterDiagnosticBundlesRequest(c.Server, id)
 	if err != nil {
 		return nil, err
 	}
@@ -1124,8 +2612,8 @@ func (c *Client) CreateClusterSnapshot(ctx context.Context, id int32, body Creat
 	return c.Client.Do(req)
 }
 
-func (c *Client) DeleteClusterSnapshot(ctx context.Context, id int32, snapshotId int64, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewDeleteClusterSnapshotRequest(c.Server, id, snapshotId)
+func (c *Client) CreateClusterDiagnosticBundle(ctx context.Context, id int32, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateClusterDiagnosticBundleRequest(c.Server, id)
 	if err != nil {
 		return nil, err
 	}
@@ -1136,8 +2624,8 @@ func (c *Client) DeleteClusterSnapshot(ctx context.Context, id int32, snapshotId
 	return c.Client.Do(req)
 }
 
-func (c *Client) RestoreClusterSnapshot(ctx context.Context, id int32, snapshotId int64, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewRestoreClusterSnapshotRequest(c.Server, id, snapshotId)
+func (c *Client) DeleteClusterDiagnosticBundle(ctx context.Context, id int32, bundleId int32, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteClusterDiagnosticBundleRequest(c.Server, id, bundleId)
 	if err != nil {
 		return nil, err
 	}
@@ -1148,8 +2636,8 @@ func (c *Client) RestoreClusterSnapshot(ctx context.Context, id int32, snapshotI
 	return c.Client.Do(req)
 }
 
-func (c *Client) ListDatabases(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewListDatabasesRequest(c.Server)
+func (c *Client) GetClusterDiagnosticBundle(ctx context.Context, id int32, bundleId int32, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetClusterDiagnosticBundleRequest(c.Server, id, bundleId)
 	if err != nil {
 		return nil, err
 	}
@@ -1160,8 +2648,8 @@ func (c *Client) ListDatabases(ctx context.Context, reqEditors ...RequestEditorF
 	return c.Client.Do(req)
 }
 
-func (c *Client) ImportDatabaseWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewImportDatabaseRequestWithBody(c.Server, contentType, body)
+func (c *Client) DownloadClusterDiagnosticBundle(ctx context.Context, id int32, bundleId int32, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDownloadClusterDiagnosticBundleRequest(c.Server, id, bundleId)
 	if err != nil {
 		return nil, err
 	}
@@ -1172,8 +2660,8 @@ func (c *Client) ImportDatabaseWithBody(ctx context.Context, contentType string,
 	return c.Client.Do(req)
 }
 
-func (c *Client) ImportDatabase(ctx context.Context, body ImportDatabaseJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewImportDatabaseRequest(c.Server, body)
+func (c *Client) ListClusterDiagnostics(ctx context.Context, id int32, params *ListClusterDiagnosticsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListClusterDiagnosticsRequest(c.Server, id, params)
 	if err != nil {
 		return nil, err
 	}
@@ -1184,8 +2672,8 @@ func (c *Client) ImportDatabase(ctx context.Context, body ImportDatabaseJSONRequ
 	return c.Client.Do(req)
 }
 
-func (c *Client) TestDatabaseConnectionWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewTestDatabaseConnectionRequestWithBody(c.Server, contentType, body)
+func (c *Client) CreateClusterDiagnosticWithBody(ctx context.Context, id int32, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateClusterDiagnosticRequestWithBody(c.Server, id, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -1196,8 +2684,8 @@ func (c *Client) TestDatabaseConnectionWithBody(ctx context.Context, contentType
 	return c.Client.Do(req)
 }
 
-func (c *Client) TestDatabaseConnection(ctx context.Context, body TestDatabaseConnectionJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewTestDatabaseConnectionRequest(c.Server, body)
+func (c *Client) CreateClusterDiagnostic(ctx context.Context, id int32, body CreateClusterDiagnosticJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateClusterDiagnosticRequest(c.Server, id, body)
 	if err != nil {
 		return nil, err
 	}
@@ -1208,8 +2696,8 @@ func (c *Client) TestDatabaseConnection(ctx context.Context, body TestDatabaseCo
 	return c.Client.Do(req)
 }
 
-func (c *Client) DeleteDatabase(ctx context.Context, id int32, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewDeleteDatabaseRequest(c.Server, id)
+func (c *Client) GetClusterAutoDiagnosticConfig(ctx context.Context, id int32, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetClusterAutoDiagnosticConfigRequest(c.Server, id)
 	if err != nil {
 		return nil, err
 	}
@@ -1220,8 +2708,8 @@ func (c *Client) DeleteDatabase(ctx context.Context, id int32, reqEditors ...Req
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetDatabase(ctx context.Context, id int32, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetDatabaseRequest(c.Server, id)
+func (c *Client) UpdateClusterAutoDiagnosticConfigWithBody(ctx context.Context, id int32, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateClusterAutoDiagnosticConfigRequestWithBody(c.Server, id, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -1232,8 +2720,8 @@ func (c *Client) GetDatabase(ctx context.Context, id int32, reqEditors ...Reques
 	return c.Client.Do(req)
 }
 
-func (c *Client) UpdateDatabaseWithBody(ctx context.Context, id int32, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUpdateDatabaseRequestWithBody(c.Server, id, contentType, body)
+func (c *Client) UpdateClusterAutoDiagnosticConfig(ctx context.Context, id int32, body UpdateClusterAutoDiagnosticConfigJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateClusterAutoDiagnosticConfigRequest(c.Server, id, body)
 	if err != nil {
 		return nil, err
 	}
@@ -1244,8 +2732,8 @@ func (c *Client) UpdateDatabaseWithBody(ctx context.Context, id int32, contentTy
 	return c.Client.Do(req)
 }
 
-func (c *Client) UpdateDatabase(ctx context.Context, id int32, body UpdateDatabaseJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUpdateDatabaseRequest(c.Server, id, body)
+func (c *Client) DiffClusterDiagnostics(ctx context.Context, id int32, params *DiffClusterDiagnosticsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDiffClusterDiagnosticsRequest(c.Server, id, params)
 	if err != nil {
 		return nil, err
 	}
@@ -1256,8 +2744,8 @@ func (c *Client) UpdateDatabase(ctx context.Context, id int32, body UpdateDataba
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetDDLProgress(ctx context.Context, id int32, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetDDLProgressRequest(c.Server, id)
+func (c *Client) SearchClusterDiagnostics(ctx context.Context, id int32, params *SearchClusterDiagnosticsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSearchClusterDiagnosticsRequest(c.Server, id, params)
 	if err != nil {
 		return nil, err
 	}
@@ -1268,8 +2756,8 @@ func (c *Client) GetDDLProgress(ctx context.Context, id int32, reqEditors ...Req
 	return c.Client.Do(req)
 }
 
-func (c *Client) CancelDDLProgress(ctx context.Context, id int32, ddlID int64, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewCancelDDLProgressRequest(c.Server, id, ddlID)
+func (c *Client) GetClusterDiagnostic(ctx context.Context, id int32, diagnosticId int32, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetClusterDiagnosticRequest(c.Server, id, diagnosticId)
 	if err != nil {
 		return nil, err
 	}
@@ -1280,8 +2768,8 @@ func (c *Client) CancelDDLProgress(ctx context.Context, id int32, ddlID int64, r
 	return c.Client.Do(req)
 }
 
-func (c *Client) QueryDatabaseWithBody(ctx context.Context, id int32, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewQueryDatabaseRequestWithBody(c.Server, id, contentType, body)
+func (c *Client) ListClusterDiagnosticFindings(ctx context.Context, id int32, diagnosticId int32, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListClusterDiagnosticFindingsRequest(c.Server, id, diagnosticId)
 	if err != nil {
 		return nil, err
 	}
@@ -1292,8 +2780,8 @@ func (c *Client) QueryDatabaseWithBody(ctx context.Context, id int32, contentTyp
 	return c.Client.Do(req)
 }
 
-func (c *Client) QueryDatabase(ctx context.Context, id int32, body QueryDatabaseJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewQueryDatabaseRequest(c.Server, id, body)
+func (c *Client) GetClusterDiagnosticReport(ctx context.Context, id int32, diagnosticId int32, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetClusterDiagnosticReportRequest(c.Server, id, diagnosticId)
 	if err != nil {
 		return nil, err
 	}
@@ -1304,8 +2792,8 @@ func (c *Client) QueryDatabase(ctx context.Context, id int32, body QueryDatabase
 	return c.Client.Do(req)
 }
 
-func (c *Client) ListEvents(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewListEventsRequest(c.Server)
+func (c *Client) ListClusterEvents(ctx context.Context, id int32, params *ListClusterEventsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListClusterEventsRequest(c.Server, id, params)
 	if err != nil {
 		return nil, err
 	}
@@ -1316,8 +2804,8 @@ func (c *Client) ListEvents(ctx context.Context, reqEditors ...RequestEditorFn)
 	return c.Client.Do(req)
 }
 
-func (c *Client) ListMetricsStores(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewListMetricsStoresRequest(c.Server)
+func (c *Client) ListClusterHealthHistory(ctx context.Context, id int32, params *ListClusterHealthHistoryParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListClusterHealthHistoryRequest(c.Server, id, params)
 	if err != nil {
 		return nil, err
 	}
@@ -1328,8 +2816,8 @@ func (c *Client) ListMetricsStores(ctx context.Context, reqEditors ...RequestEdi
 	return c.Client.Do(req)
 }
 
-func (c *Client) ImportMetricsStoreWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewImportMetricsStoreRequestWithBody(c.Server, contentType, body)
+func (c *Client) RunRisectlCommandWithBody(ctx context.Context, id int32, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRunRisectlCommandRequestWithBody(c.Server, id, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -1340,8 +2828,8 @@ func (c *Client) ImportMetricsStoreWithBody(ctx context.Context, contentType str
 	return c.Client.Do(req)
 }
 
-func (c *Client) ImportMetricsStore(ctx context.Context, body ImportMetricsStoreJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewImportMetricsStoreRequest(c.Server, body)
+func (c *Client) RunRisectlCommand(ctx context.Context, id int32, body RunRisectlCommandJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRunRisectlCommandRequest(c.Server, id, body)
 	if err != nil {
 		return nil, err
 	}
@@ -1352,8 +2840,8 @@ func (c *Client) ImportMetricsStore(ctx context.Context, body ImportMetricsStore
 	return c.Client.Do(req)
 }
 
-func (c *Client) DeleteMetricsStore(ctx context.Context, id int32, params *DeleteMetricsStoreParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewDeleteMetricsStoreRequest(c.Server, id, params)
+func (c *Client) ListRisectlExecutions(ctx context.Context, id int32, params *ListRisectlExecutionsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListRisectlExecutionsRequest(c.Server, id, params)
 	if err != nil {
 		return nil, err
 	}
@@ -1364,8 +2852,8 @@ func (c *Client) DeleteMetricsStore(ctx context.Context, id int32, params *Delet
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetMetricsStore(ctx context.Context, id int32, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetMetricsStoreRequest(c.Server, id)
+func (c *Client) CreateRisectlExecutionWithBody(ctx context.Context, id int32, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateRisectlExecutionRequestWithBody(c.Server, id, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -1376,8 +2864,8 @@ func (c *Client) GetMetricsStore(ctx context.Context, id int32, reqEditors ...Re
 	return c.Client.Do(req)
 }
 
-func (c *Client) UpdateMetricsStoreWithBody(ctx context.Context, id int32, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUpdateMetricsStoreRequestWithBody(c.Server, id, contentType, body)
+func (c *Client) CreateRisectlExecution(ctx context.Context, id int32, body CreateRisectlExecutionJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateRisectlExecutionRequest(c.Server, id, body)
 	if err != nil {
 		return nil, err
 	}
@@ -1388,8 +2876,8 @@ func (c *Client) UpdateMetricsStoreWithBody(ctx context.Context, id int32, conte
 	return c.Client.Do(req)
 }
 
-func (c *Client) UpdateMetricsStore(ctx context.Context, id int32, body UpdateMetricsStoreJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewUpdateMetricsStoreRequest(c.Server, id, body)
+func (c *Client) GetRisectlExecution(ctx context.Context, id int32, executionID int32, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetRisectlExecutionRequest(c.Server, id, executionID)
 	if err != nil {
 		return nil, err
 	}
@@ -1400,8 +2888,8 @@ func (c *Client) UpdateMetricsStore(ctx context.Context, id int32, body UpdateMe
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetMaterializedViewThroughput(ctx context.Context, clusterID int32, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetMaterializedViewThroughputRequest(c.Server, clusterID)
+func (c *Client) CancelRisectlExecution(ctx context.Context, id int32, executionID int32, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCancelRisectlExecutionRequest(c.Server, id, executionID)
 	if err != nil {
 		return nil, err
 	}
@@ -1412,8 +2900,8 @@ func (c *Client) GetMaterializedViewThroughput(ctx context.Context, clusterID in
 	return c.Client.Do(req)
 }
 
-func (c *Client) ListTasks(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewListTasksRequest(c.Server)
+func (c *Client) ListRisectlExecutionOutputs(ctx context.Context, id int32, executionID int32, params *ListRisectlExecutionOutputsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListRisectlExecutionOutputsRequest(c.Server, id, executionID, params)
 	if err != nil {
 		return nil, err
 	}
@@ -1424,8 +2912,8 @@ func (c *Client) ListTasks(ctx context.Context, reqEditors ...RequestEditorFn) (
 	return c.Client.Do(req)
 }
 
-func (c *Client) TestClusterConnectionWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewTestClusterConnectionRequestWithBody(c.Server, contentType, body)
+func (c *Client) StreamRisectlExecution(ctx context.Context, id int32, executionID int32, params *StreamRisectlExecutionParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewStreamRisectlExecutionRequest(c.Server, id, executionID, params)
 	if err != nil {
 		return nil, err
 	}
@@ -1436,8 +2924,8 @@ func (c *Client) TestClusterConnectionWithBody(ctx context.Context, contentType
 	return c.Client.Do(req)
 }
 
-func (c *Client) TestClusterConnection(ctx context.Context, body TestClusterConnectionJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewTestClusterConnectionRequest(c.Server, body)
+func (c *Client) ListRisectlOperations(ctx context.Context, id int32, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListRisectlOperationsRequest(c.Server, id)
 	if err != nil {
 		return nil, err
 	}
@@ -1448,695 +2936,664 @@ func (c *Client) TestClusterConnection(ctx context.Context, body TestClusterConn
 	return c.Client.Do(req)
 }
 
-// NewListClusterVersionsRequest generates requests for ListClusterVersions
-func NewListClusterVersionsRequest(server string) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) RunRisectlOperation(ctx context.Context, id int32, operation RisectlOperationName, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRunRisectlOperationRequest(c.Server, id, operation)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/cluster-versions")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) OpenRisectlShell(ctx context.Context, id int32, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewOpenRisectlShellRequest(c.Server, id)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewListClustersRequest generates requests for ListClusters
-func NewListClustersRequest(server string) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) ListRisectlShellSessions(ctx context.Context, id int32, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListRisectlShellSessionsRequest(c.Server, id)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/clusters")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) ListRisectlShellCommands(ctx context.Context, id int32, sessionID int32, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListRisectlShellCommandsRequest(c.Server, id, sessionID)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewCreateClusterRequest calls the generic CreateCluster builder with application/json body
-func NewCreateClusterRequest(server string, body CreateClusterJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) ListClusterSnapshots(ctx context.Context, id int32, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListClusterSnapshotsRequest(c.Server, id)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewCreateClusterRequestWithBody(server, "application/json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewCreateClusterRequestWithBody generates requests for CreateCluster with any type of body
-func NewCreateClusterRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) CreateClusterSnapshotWithBody(ctx context.Context, id int32, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateClusterSnapshotRequestWithBody(c.Server, id, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/clusters")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) CreateClusterSnapshot(ctx context.Context, id int32, body CreateClusterSnapshotJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateClusterSnapshotRequest(c.Server, id, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
+func (c *Client) DeleteClusterSnapshot(ctx context.Context, id int32, snapshotId int64, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteClusterSnapshotRequest(c.Server, id, snapshotId)
 	if err != nil {
 		return nil, err
 	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewImportClusterRequest calls the generic ImportCluster builder with application/json body
-func NewImportClusterRequest(server string, body ImportClusterJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) RestoreClusterSnapshot(ctx context.Context, id int32, snapshotId int64, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRestoreClusterSnapshotRequest(c.Server, id, snapshotId)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewImportClusterRequestWithBody(server, "application/json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewImportClusterRequestWithBody generates requests for ImportCluster with any type of body
-func NewImportClusterRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) ListClusterUpgrades(ctx context.Context, id int32, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListClusterUpgradesRequest(c.Server, id)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/clusters/import")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) CreateClusterUpgradeWithBody(ctx context.Context, id int32, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateClusterUpgradeRequestWithBody(c.Server, id, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("POST", queryURL.String(), body)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewDeleteClusterRequest generates requests for DeleteCluster
-func NewDeleteClusterRequest(server string, id int32, params *DeleteClusterParams) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "ID", runtime.ParamLocationPath, id)
+func (c *Client) CreateClusterUpgrade(ctx context.Context, id int32, body CreateClusterUpgradeJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateClusterUpgradeRequest(c.Server, id, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) GetClusterUpgrade(ctx context.Context, id int32, upgradeID int32, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetClusterUpgradeRequest(c.Server, id, upgradeID)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/clusters/%s", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) CancelClusterUpgrade(ctx context.Context, id int32, upgradeID int32, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCancelClusterUpgradeRequest(c.Server, id, upgradeID)
 	if err != nil {
 		return nil, err
 	}
-
-	if params != nil {
-		queryValues := queryURL.Query()
-
-		if params.Cascade != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cascade", runtime.ParamLocationQuery, *params.Cascade); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		queryURL.RawQuery = queryValues.Encode()
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+func (c *Client) ListClusterVersionHistory(ctx context.Context, id int32, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListClusterVersionHistoryRequest(c.Server, id)
 	if err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewGetClusterRequest generates requests for GetCluster
-func NewGetClusterRequest(server string, id int32) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "ID", runtime.ParamLocationPath, id)
+func (c *Client) ListDatabases(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListDatabasesRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) ImportDatabaseWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewImportDatabaseRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/clusters/%s", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) ImportDatabase(ctx context.Context, body ImportDatabaseJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewImportDatabaseRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewUpdateClusterRequest calls the generic UpdateCluster builder with application/json body
-func NewUpdateClusterRequest(server string, id int32, body UpdateClusterJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) TestDatabaseConnectionWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewTestDatabaseConnectionRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewUpdateClusterRequestWithBody(server, id, "application/json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewUpdateClusterRequestWithBody generates requests for UpdateCluster with any type of body
-func NewUpdateClusterRequestWithBody(server string, id int32, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "ID", runtime.ParamLocationPath, id)
+func (c *Client) TestDatabaseConnection(ctx context.Context, body TestDatabaseConnectionJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewTestDatabaseConnectionRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) DeleteDatabase(ctx context.Context, id int32, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteDatabaseRequest(c.Server, id)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/clusters/%s", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) GetDatabase(ctx context.Context, id int32, params *GetDatabaseParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetDatabaseRequest(c.Server, id, params)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("PUT", queryURL.String(), body)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewGetClusterAutoBackupConfigRequest generates requests for GetClusterAutoBackupConfig
-func NewGetClusterAutoBackupConfigRequest(server string, id int32) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "ID", runtime.ParamLocationPath, id)
+func (c *Client) UpdateDatabaseWithBody(ctx context.Context, id int32, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateDatabaseRequestWithBody(c.Server, id, contentType, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) UpdateDatabase(ctx context.Context, id int32, body UpdateDatabaseJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateDatabaseRequest(c.Server, id, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/clusters/%s/auto-backup-config", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) ListDatabaseCatalogChanges(ctx context.Context, id int32, params *ListDatabaseCatalogChangesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListDatabaseCatalogChangesRequest(c.Server, id, params)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+func (c *Client) GetDDLProgress(ctx context.Context, id int32, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetDDLProgressRequest(c.Server, id)
 	if err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewUpdateClusterAutoBackupConfigRequest calls the generic UpdateClusterAutoBackupConfig builder with application/json body
-func NewUpdateClusterAutoBackupConfigRequest(server string, id int32, body UpdateClusterAutoBackupConfigJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) CancelDDLProgress(ctx context.Context, id int32, ddlID int64, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCancelDDLProgressRequest(c.Server, id, ddlID)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewUpdateClusterAutoBackupConfigRequestWithBody(server, id, "application/json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewUpdateClusterAutoBackupConfigRequestWithBody generates requests for UpdateClusterAutoBackupConfig with any type of body
-func NewUpdateClusterAutoBackupConfigRequestWithBody(server string, id int32, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "ID", runtime.ParamLocationPath, id)
+func (c *Client) AnalyzeDatabaseImpactWithBody(ctx context.Context, id int32, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAnalyzeDatabaseImpactRequestWithBody(c.Server, id, contentType, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) AnalyzeDatabaseImpact(ctx context.Context, id int32, body AnalyzeDatabaseImpactJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewAnalyzeDatabaseImpactRequest(c.Server, id, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/clusters/%s/auto-backup-config", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) GetDatabaseLineage(ctx context.Context, id int32, params *GetDatabaseLineageParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetDatabaseLineageRequest(c.Server, id, params)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	req, err := http.NewRequest("PUT", queryURL.String(), body)
+func (c *Client) ExportDatabaseLineage(ctx context.Context, id int32, params *ExportDatabaseLineageParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewExportDatabaseLineageRequest(c.Server, id, params)
 	if err != nil {
 		return nil, err
 	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewListClusterDiagnosticsRequest generates requests for ListClusterDiagnostics
-func NewListClusterDiagnosticsRequest(server string, id int32, params *ListClusterDiagnosticsParams) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "ID", runtime.ParamLocationPath, id)
+func (c *Client) QueryDatabaseWithBody(ctx context.Context, id int32, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewQueryDatabaseRequestWithBody(c.Server, id, contentType, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) QueryDatabase(ctx context.Context, id int32, body QueryDatabaseJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewQueryDatabaseRequest(c.Server, id, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/clusters/%s/diagnostics", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) ListDatabaseRelations(ctx context.Context, id int32, params *ListDatabaseRelationsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListDatabaseRelationsRequest(c.Server, id, params)
 	if err != nil {
 		return nil, err
 	}
-
-	if params != nil {
-		queryValues := queryURL.Query()
-
-		if params.From != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "from", runtime.ParamLocationQuery, *params.From); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		if params.To != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "to", runtime.ParamLocationQuery, *params.To); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		if params.Page != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "page", runtime.ParamLocationQuery, *params.Page); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		if params.PerPage != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "perPage", runtime.ParamLocationQuery, *params.PerPage); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		queryURL.RawQuery = queryValues.Encode()
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+func (c *Client) ListDiagnosticRules(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListDiagnosticRulesRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewCreateClusterDiagnosticRequest calls the generic CreateClusterDiagnostic builder with application/json body
-func NewCreateClusterDiagnosticRequest(server string, id int32, body CreateClusterDiagnosticJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) UpdateDiagnosticRuleWithBody(ctx context.Context, rule DiagnosticRuleName, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateDiagnosticRuleRequestWithBody(c.Server, rule, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewCreateClusterDiagnosticRequestWithBody(server, id, "application/json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewCreateClusterDiagnosticRequestWithBody generates requests for CreateClusterDiagnostic with any type of body
-func NewCreateClusterDiagnosticRequestWithBody(server string, id int32, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "ID", runtime.ParamLocationPath, id)
+func (c *Client) UpdateDiagnosticRule(ctx context.Context, rule DiagnosticRuleName, body UpdateDiagnosticRuleJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateDiagnosticRuleRequest(c.Server, rule, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) SearchDiagnostics(ctx context.Context, params *SearchDiagnosticsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSearchDiagnosticsRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/clusters/%s/diagnostics", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) ListEvents(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListEventsRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("POST", queryURL.String(), body)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewGetClusterAutoDiagnosticConfigRequest generates requests for GetClusterAutoDiagnosticConfig
-func NewGetClusterAutoDiagnosticConfigRequest(server string, id int32) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "ID", runtime.ParamLocationPath, id)
+func (c *Client) ListMetricsStores(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListMetricsStoresRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) ImportMetricsStoreWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewImportMetricsStoreRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/clusters/%s/diagnostics/config", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) ImportMetricsStore(ctx context.Context, body ImportMetricsStoreJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewImportMetricsStoreRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewUpdateClusterAutoDiagnosticConfigRequest calls the generic UpdateClusterAutoDiagnosticConfig builder with application/json body
-func NewUpdateClusterAutoDiagnosticConfigRequest(server string, id int32, body UpdateClusterAutoDiagnosticConfigJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) DeleteMetricsStore(ctx context.Context, id int32, params *DeleteMetricsStoreParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteMetricsStoreRequest(c.Server, id, params)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewUpdateClusterAutoDiagnosticConfigRequestWithBody(server, id, "application/json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewUpdateClusterAutoDiagnosticConfigRequestWithBody generates requests for UpdateClusterAutoDiagnosticConfig with any type of body
-func NewUpdateClusterAutoDiagnosticConfigRequestWithBody(server string, id int32, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "ID", runtime.ParamLocationPath, id)
+func (c *Client) GetMetricsStore(ctx context.Context, id int32, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetMetricsStoreRequest(c.Server, id)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) UpdateMetricsStoreWithBody(ctx context.Context, id int32, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateMetricsStoreRequestWithBody(c.Server, id, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/clusters/%s/diagnostics/config", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) UpdateMetricsStore(ctx context.Context, id int32, body UpdateMetricsStoreJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateMetricsStoreRequest(c.Server, id, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("PUT", queryURL.String(), body)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewGetClusterDiagnosticRequest generates requests for GetClusterDiagnostic
-func NewGetClusterDiagnosticRequest(server string, id int32, diagnosticId int32) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "ID", runtime.ParamLocationPath, id)
+func (c *Client) GetMaterializedViewThroughput(ctx context.Context, clusterID int32, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetMaterializedViewThroughputRequest(c.Server, clusterID)
 	if err != nil {
 		return nil, err
 	}
-
-	var pathParam1 string
-
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "diagnosticId", runtime.ParamLocationPath, diagnosticId)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) GetOrgSettings(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetOrgSettingsRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/clusters/%s/diagnostics/%s", pathParam0, pathParam1)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) UpdateOrgSettingsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateOrgSettingsRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewRunRisectlCommandRequest calls the generic RunRisectlCommand builder with application/json body
-func NewRunRisectlCommandRequest(server string, id int32, body RunRisectlCommandJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+func (c *Client) UpdateOrgSettings(ctx context.Context, body UpdateOrgSettingsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateOrgSettingsRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewRunRisectlCommandRequestWithBody(server, id, "application/json", bodyReader)
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewRunRisectlCommandRequestWithBody generates requests for RunRisectlCommand with any type of body
-func NewRunRisectlCommandRequestWithBody(server string, id int32, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "ID", runtime.ParamLocationPath, id)
+func (c *Client) UploadRisectlWithBody(ctx context.Context, version string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUploadRisectlRequestWithBody(c.Server, version, contentType, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) ListTasks(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListTasksRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/clusters/%s/risectl", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) TestClusterConnectionWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewTestClusterConnectionRequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
-
-	req, err := http.NewRequest("POST", queryURL.String(), body)
-	if err != nil {
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
 		return nil, err
 	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
+	return c.Client.Do(req)
 }
 
-// NewListClusterSnapshotsRequest generates requests for ListClusterSnapshots
-func NewListClusterSnapshotsRequest(server string, id int32) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "ID", runtime.ParamLocationPath, id)
+func (c *Client) TestClusterConnection(ctx context.Context, body TestClusterConnectionJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewTestClusterConnectionRequest(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// NewListAlertRulesRequest generates requests for ListAlertRules
+func NewListAlertRulesRequest(server string, params *ListAlertRulesParams) (*http.Request, error) {
+	var err error
 
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/clusters/%s/snapshots", pathParam0)
+	operationPath := fmt.Sprintf("/alert-rules")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2146,6 +3603,28 @@ func NewListClusterSnapshotsRequest(server string, id int32) (*http.Request, err
 		return nil, err
 	}
 
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.ClusterID != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "clusterID", runtime.ParamLocationQuery, *params.ClusterID); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
 	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
@@ -2154,34 +3633,27 @@ func NewListClusterSnapshotsRequest(server string, id int32) (*http.Request, err
 	return req, nil
 }
 
-// NewCreateClusterSnapshotRequest calls the generic CreateClusterSnapshot builder with application/json body
-func NewCreateClusterSnapshotRequest(server string, id int32, body CreateClusterSnapshotJSONRequestBody) (*http.Request, error) {
+// NewCreateAlertRuleRequest calls the generic CreateAlertRule builder with application/json body
+func NewCreateAlertRuleRequest(server string, body CreateAlertRuleJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewCreateClusterSnapshotRequestWithBody(server, id, "application/json", bodyReader)
+	return NewCreateAlertRuleRequestWithBody(server, "application/json", bodyReader)
 }
 
-// NewCreateClusterSnapshotRequestWithBody generates requests for CreateClusterSnapshot with any type of body
-func NewCreateClusterSnapshotRequestWithBody(server string, id int32, contentType string, body io.Reader) (*http.Request, error) {
+// NewCreateAlertRuleRequestWithBody generates requests for CreateAlertRule with any type of body
+func NewCreateAlertRuleRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "ID", runtime.ParamLocationPath, id)
-	if err != nil {
-		return nil, err
-	}
-
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/clusters/%s/snapshots", pathParam0)
+	operationPath := fmt.Sprintf("/alert-rules")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2201,8 +3673,8 @@ func NewCreateClusterSnapshotRequestWithBody(server string, id int32, contentTyp
 	return req, nil
 }
 
-// NewDeleteClusterSnapshotRequest generates requests for DeleteClusterSnapshot
-func NewDeleteClusterSnapshotRequest(server string, id int32, snapshotId int64) (*http.Request, error) {
+// NewDeleteAlertRuleRequest generates requests for DeleteAlertRule
+func NewDeleteAlertRuleRequest(server string, id int32) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -2212,19 +3684,12 @@ func NewDeleteClusterSnapshotRequest(server string, id int32, snapshotId int64)
 		return nil, err
 	}
 
-	var pathParam1 string
-
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "snapshotId", runtime.ParamLocationPath, snapshotId)
-	if err != nil {
-		return nil, err
-	}
-
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/clusters/%s/snapshots/%s", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/alert-rules/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2242,8 +3707,8 @@ func NewDeleteClusterSnapshotRequest(server string, id int32, snapshotId int64)
 	return req, nil
 }
 
-// NewRestoreClusterSnapshotRequest generates requests for RestoreClusterSnapshot
-func NewRestoreClusterSnapshotRequest(server string, id int32, snapshotId int64) (*http.Request, error) {
+// NewGetAlertRuleRequest generates requests for GetAlertRule
+func NewGetAlertRuleRequest(server string, id int32) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -2253,46 +3718,12 @@ func NewRestoreClusterSnapshotRequest(server string, id int32, snapshotId int64)
 		return nil, err
 	}
 
-	var pathParam1 string
-
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "snapshotId", runtime.ParamLocationPath, snapshotId)
-	if err != nil {
-		return nil, err
-	}
-
-	serverURL, err := url.Parse(server)
-	if err != nil {
-		return nil, err
-	}
-
-	operationPath := fmt.Sprintf("/clusters/%s/snapshots/%s", pathParam0, pathParam1)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
-
-	queryURL, err := serverURL.Parse(operationPath)
-	if err != nil {
-		return nil, err
-	}
-
-	req, err := http.NewRequest("POST", queryURL.String(), nil)
-	if err != nil {
-		return nil, err
-	}
-
-	return req, nil
-}
-
-// NewListDatabasesRequest generates requests for ListDatabases
-func NewListDatabasesRequest(server string) (*http.Request, error) {
-	var err error
-
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/databases")
+	operationPath := fmt.Sprintf("/alert-rules/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2310,27 +3741,34 @@ func NewListDatabasesRequest(server string) (*http.Request, error) {
 	return req, nil
 }
 
-// NewImportDatabaseRequest calls the generic ImportDatabase builder with application/json body
-func NewImportDatabaseRequest(server string, body ImportDatabaseJSONRequestBody) (*http.Request, error) {
+// NewUpdateAlertRuleRequest calls the generic UpdateAlertRule builder with application/json body
+func NewUpdateAlertRuleRequest(server string, id int32, body UpdateAlertRuleJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewImportDatabaseRequestWithBody(server, "application/json", bodyReader)
+	return NewUpdateAlertRuleRequestWithBody(server, id, "application/json", bodyReader)
 }
 
-// NewImportDatabaseRequestWithBody generates requests for ImportDatabase with any type of body
-func NewImportDatabaseRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+// NewUpdateAlertRuleRequestWithBody generates requests for UpdateAlertRule with any type of body
+func NewUpdateAlertRuleRequestWithBody(server string, id int32, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "ID", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/databases/import")
+	operationPath := fmt.Sprintf("/alert-rules/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2340,7 +3778,7 @@ func NewImportDatabaseRequestWithBody(server string, contentType string, body io
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
@@ -2350,19 +3788,8 @@ func NewImportDatabaseRequestWithBody(server string, contentType string, body io
 	return req, nil
 }
 
-// NewTestDatabaseConnectionRequest calls the generic TestDatabaseConnection builder with application/json body
-func NewTestDatabaseConnectionRequest(server string, body TestDatabaseConnectionJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
-	if err != nil {
-		return nil, err
-	}
-	bodyReader = bytes.NewReader(buf)
-	return NewTestDatabaseConnectionRequestWithBody(server, "application/json", bodyReader)
-}
-
-// NewTestDatabaseConnectionRequestWithBody generates requests for TestDatabaseConnection with any type of body
-func NewTestDatabaseConnectionRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+// NewListAlertsRequest generates requests for ListAlerts
+func NewListAlertsRequest(server string, params *ListAlertsParams) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -2370,7 +3797,7 @@ func NewTestDatabaseConnectionRequestWithBody(server string, contentType string,
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/databases/test-connection")
+	operationPath := fmt.Sprintf("/alerts")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2380,33 +3807,46 @@ func NewTestDatabaseConnectionRequestWithBody(server string, contentType string,
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
-	if err != nil {
-		return nil, err
-	}
+	if params != nil {
+		queryValues := queryURL.Query()
 
-	req.Header.Add("Content-Type", contentType)
+		if params.ClusterID != nil {
 
-	return req, nil
-}
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "clusterID", runtime.ParamLocationQuery, *params.ClusterID); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-// NewDeleteDatabaseRequest generates requests for DeleteDatabase
-func NewDeleteDatabaseRequest(server string, id int32) (*http.Request, error) {
-	var err error
+		}
 
-	var pathParam0 string
+		queryURL.RawQuery = queryValues.Encode()
+	}
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "ID", runtime.ParamLocationPath, id)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
 
+	return req, nil
+}
+
+// NewListAlertHistoryRequest generates requests for ListAlertHistory
+func NewListAlertHistoryRequest(server string, params *ListAlertHistoryParams) (*http.Request, error) {
+	var err error
+
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/databases/%s", pathParam0)
+	operationPath := fmt.Sprintf("/alerts/history")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2416,38 +3856,90 @@ func NewDeleteDatabaseRequest(server string, id int32) (*http.Request, error) {
 		return nil, err
 	}
 
-	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
-	if err != nil {
-		return nil, err
-	}
+	if params != nil {
+		queryValues := queryURL.Query()
 
-	return req, nil
-}
+		if params.ClusterID != nil {
 
-// NewGetDatabaseRequest generates requests for GetDatabase
-func NewGetDatabaseRequest(server string, id int32) (*http.Request, error) {
-	var err error
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "clusterID", runtime.ParamLocationQuery, *params.ClusterID); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-	var pathParam0 string
+		}
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "ID", runtime.ParamLocationPath, id)
-	if err != nil {
-		return nil, err
-	}
+		if params.RuleID != nil {
 
-	serverURL, err := url.Parse(server)
-	if err != nil {
-		return nil, err
-	}
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "ruleID", runtime.ParamLocationQuery, *params.RuleID); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-	operationPath := fmt.Sprintf("/databases/%s", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
+		}
 
-	queryURL, err := serverURL.Parse(operationPath)
-	if err != nil {
-		return nil, err
+		if params.From != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "from", runtime.ParamLocationQuery, *params.From); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.To != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "to", runtime.ParamLocationQuery, *params.To); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
 	}
 
 	req, err := http.NewRequest("GET", queryURL.String(), nil)
@@ -2458,34 +3950,16 @@ func NewGetDatabaseRequest(server string, id int32) (*http.Request, error) {
 	return req, nil
 }
 
-// NewUpdateDatabaseRequest calls the generic UpdateDatabase builder with application/json body
-func NewUpdateDatabaseRequest(server string, id int32, body UpdateDatabaseJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
-	if err != nil {
-		return nil, err
-	}
-	bodyReader = bytes.NewReader(buf)
-	return NewUpdateDatabaseRequestWithBody(server, id, "application/json", bodyReader)
-}
-
-// NewUpdateDatabaseRequestWithBody generates requests for UpdateDatabase with any type of body
-func NewUpdateDatabaseRequestWithBody(server string, id int32, contentType string, body io.Reader) (*http.Request, error) {
+// NewListClusterVersionsRequest generates requests for ListClusterVersions
+func NewListClusterVersionsRequest(server string) (*http.Request, error) {
 	var err error
 
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "ID", runtime.ParamLocationPath, id)
-	if err != nil {
-		return nil, err
-	}
-
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/databases/%s", pathParam0)
+	operationPath := fmt.Sprintf("/cluster-versions")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2495,33 +3969,24 @@ func NewUpdateDatabaseRequestWithBody(server string, id int32, contentType strin
 		return nil, err
 	}
 
-	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
 
-	req.Header.Add("Content-Type", contentType)
-
 	return req, nil
 }
 
-// NewGetDDLProgressRequest generates requests for GetDDLProgress
-func NewGetDDLProgressRequest(server string, id int32) (*http.Request, error) {
+// NewListClustersRequest generates requests for ListClusters
+func NewListClustersRequest(server string) (*http.Request, error) {
 	var err error
 
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "ID", runtime.ParamLocationPath, id)
-	if err != nil {
-		return nil, err
-	}
-
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/databases/%s/ddl-progress", pathParam0)
+	operationPath := fmt.Sprintf("/clusters")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2539,30 +4004,27 @@ func NewGetDDLProgressRequest(server string, id int32) (*http.Request, error) {
 	return req, nil
 }
 
-// NewCancelDDLProgressRequest generates requests for CancelDDLProgress
-func NewCancelDDLProgressRequest(server string, id int32, ddlID int64) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "ID", runtime.ParamLocationPath, id)
+// NewCreateClusterRequest calls the generic CreateCluster builder with application/json body
+func NewCreateClusterRequest(server string, body CreateClusterJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateClusterRequestWithBody(server, "application/json", bodyReader)
+}
 
-	var pathParam1 string
-
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "ddlID", runtime.ParamLocationPath, ddlID)
-	if err != nil {
-		return nil, err
-	}
+// NewCreateClusterRequestWithBody generates requests for CreateCluster with any type of body
+func NewCreateClusterRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/databases/%s/ddl-progress/%s/cancel", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/clusters")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2572,42 +4034,37 @@ func NewCancelDDLProgressRequest(server string, id int32, ddlID int64) (*http.Re
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	req, err := http.NewRequest("POST", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
 
+	req.Header.Add("Content-Type", contentType)
+
 	return req, nil
 }
 
-// NewQueryDatabaseRequest calls the generic QueryDatabase builder with application/json body
-func NewQueryDatabaseRequest(server string, id int32, body QueryDatabaseJSONRequestBody) (*http.Request, error) {
+// NewImportClusterRequest calls the generic ImportCluster builder with application/json body
+func NewImportClusterRequest(server string, body ImportClusterJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewQueryDatabaseRequestWithBody(server, id, "application/json", bodyReader)
+	return NewImportClusterRequestWithBody(server, "application/json", bodyReader)
 }
 
-// NewQueryDatabaseRequestWithBody generates requests for QueryDatabase with any type of body
-func NewQueryDatabaseRequestWithBody(server string, id int32, contentType string, body io.Reader) (*http.Request, error) {
+// NewImportClusterRequestWithBody generates requests for ImportCluster with any type of body
+func NewImportClusterRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "ID", runtime.ParamLocationPath, id)
-	if err != nil {
-		return nil, err
-	}
-
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/databases/%s/query", pathParam0)
+	operationPath := fmt.Sprintf("/clusters/import")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2627,16 +4084,23 @@ func NewQueryDatabaseRequestWithBody(server string, id int32, contentType string
 	return req, nil
 }
 
-// NewListEventsRequest generates requests for ListEvents
-func NewListEventsRequest(server string) (*http.Request, error) {
+// NewDeleteClusterRequest generates requests for DeleteCluster
+func NewDeleteClusterRequest(server string, id int32, params *DeleteClusterParams) (*http.Request, error) {
 	var err error
 
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "ID", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/events")
+	operationPath := fmt.Sprintf("/clusters/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2646,7 +4110,29 @@ func NewListEventsRequest(server string) (*http.Request, error) {
 		return nil, err
 	}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Cascade != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cascade", runtime.ParamLocationQuery, *params.Cascade); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -2654,16 +4140,23 @@ func NewListEventsRequest(server string) (*http.Request, error) {
 	return req, nil
 }
 
-// NewListMetricsStoresRequest generates requests for ListMetricsStores
-func NewListMetricsStoresRequest(server string) (*http.Request, error) {
+// NewGetClusterRequest generates requests for GetCluster
+func NewGetClusterRequest(server string, id int32) (*http.Request, error) {
 	var err error
 
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "ID", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/metrics-stores")
+	operationPath := fmt.Sprintf("/clusters/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2681,27 +4174,34 @@ func NewListMetricsStoresRequest(server string) (*http.Request, error) {
 	return req, nil
 }
 
-// NewImportMetricsStoreRequest calls the generic ImportMetricsStore builder with application/json body
-func NewImportMetricsStoreRequest(server string, body ImportMetricsStoreJSONRequestBody) (*http.Request, error) {
+// NewUpdateClusterRequest calls the generic UpdateCluster builder with application/json body
+func NewUpdateClusterRequest(server string, id int32, body UpdateClusterJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewImportMetricsStoreRequestWithBody(server, "application/json", bodyReader)
+	return NewUpdateClusterRequestWithBody(server, id, "application/json", bodyReader)
 }
 
-// NewImportMetricsStoreRequestWithBody generates requests for ImportMetricsStore with any type of body
-func NewImportMetricsStoreRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+// NewUpdateClusterRequestWithBody generates requests for UpdateCluster with any type of body
+func NewUpdateClusterRequestWithBody(server string, id int32, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "ID", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/metrics-stores/import")
+	operationPath := fmt.Sprintf("/clusters/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2711,7 +4211,7 @@ func NewImportMetricsStoreRequestWithBody(server string, contentType string, bod
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
@@ -2721,8 +4221,8 @@ func NewImportMetricsStoreRequestWithBody(server string, contentType string, bod
 	return req, nil
 }
 
-// NewDeleteMetricsStoreRequest generates requests for DeleteMetricsStore
-func NewDeleteMetricsStoreRequest(server string, id int32, params *DeleteMetricsStoreParams) (*http.Request, error) {
+// NewGetClusterAutoBackupConfigRequest generates requests for GetClusterAutoBackupConfig
+func NewGetClusterAutoBackupConfigRequest(server string, id int32) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -2737,7 +4237,7 @@ func NewDeleteMetricsStoreRequest(server string, id int32, params *DeleteMetrics
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/metrics-stores/%s", pathParam0)
+	operationPath := fmt.Sprintf("/clusters/%s/auto-backup-config", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2747,25 +4247,7 @@ func NewDeleteMetricsStoreRequest(server string, id int32, params *DeleteMetrics
 		return nil, err
 	}
 
-	if params != nil {
-		queryValues := queryURL.Query()
-
-		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "force", runtime.ParamLocationQuery, params.Force); err != nil {
-			return nil, err
-		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-			return nil, err
-		} else {
-			for k, v := range parsed {
-				for _, v2 := range v {
-					queryValues.Add(k, v2)
-				}
-			}
-		}
-
-		queryURL.RawQuery = queryValues.Encode()
-	}
-
-	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -2773,8 +4255,19 @@ func NewDeleteMetricsStoreRequest(server string, id int32, params *DeleteMetrics
 	return req, nil
 }
 
-// NewGetMetricsStoreRequest generates requests for GetMetricsStore
-func NewGetMetricsStoreRequest(server string, id int32) (*http.Request, error) {
+// NewUpdateClusterAutoBackupConfigRequest calls the generic UpdateClusterAutoBackupConfig builder with application/json body
+func NewUpdateClusterAutoBackupConfigRequest(server string, id int32, body UpdateClusterAutoBackupConfigJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateClusterAutoBackupConfigRequestWithBody(server, id, "application/json", bodyReader)
+}
+
+// NewUpdateClusterAutoBackupConfigRequestWithBody generates requests for UpdateClusterAutoBackupConfig with any type of body
+func NewUpdateClusterAutoBackupConfigRequestWithBody(server string, id int32, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -2789,7 +4282,7 @@ func NewGetMetricsStoreRequest(server string, id int32) (*http.Request, error) {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/metrics-stores/%s", pathParam0)
+	operationPath := fmt.Sprintf("/clusters/%s/auto-backup-config", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2799,27 +4292,29 @@ func NewGetMetricsStoreRequest(server string, id int32) (*http.Request, error) {
 		return nil, err
 	}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
 
+	req.Header.Add("Content-Type", contentType)
+
 	return req, nil
 }
 
-// NewUpdateMetricsStoreRequest calls the generic UpdateMetricsStore builder with application/json body
-func NewUpdateMetricsStoreRequest(server string, id int32, body UpdateMetricsStoreJSONRequestBody) (*http.Request, error) {
+// NewPreviewClusterSnapshotRetentionRequest calls the generic PreviewClusterSnapshotRetention builder with application/json body
+func NewPreviewClusterSnapshotRetentionRequest(server string, id int32, body PreviewClusterSnapshotRetentionJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewUpdateMetricsStoreRequestWithBody(server, id, "application/json", bodyReader)
+	return NewPreviewClusterSnapshotRetentionRequestWithBody(server, id, "application/json", bodyReader)
 }
 
-// NewUpdateMetricsStoreRequestWithBody generates requests for UpdateMetricsStore with any type of body
-func NewUpdateMetricsStoreRequestWithBody(server string, id int32, contentType string, body io.Reader) (*http.Request, error) {
+// NewPreviewClusterSnapshotRetentionRequestWithBody generates requests for PreviewClusterSnapshotRetention with any type of body
+func NewPreviewClusterSnapshotRetentionRequestWithBody(server string, id int32, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -2834,7 +4329,7 @@ func NewUpdateMetricsStoreRequestWithBody(server string, id int32, contentType s
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/metrics-stores/%s", pathParam0)
+	operationPath := fmt.Sprintf("/clusters/%s/auto-backup-config/retention-preview", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2844,7 +4339,7 @@ func NewUpdateMetricsStoreRequestWithBody(server string, id int32, contentType s
 		return nil, err
 	}
 
-	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	req, err := http.NewRequest("POST", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
@@ -2854,13 +4349,13 @@ func NewUpdateMetricsStoreRequestWithBody(server string, id int32, contentType s
 	return req, nil
 }
 
-// NewGetMaterializedViewThroughputRequest generates requests for GetMaterializedViewThroughput
-func NewGetMaterializedViewThroughputRequest(server string, clusterID int32) (*http.Request, error) {
+// NewListClusterDiagnosticBundlesRequest generates requests for ListClusterDiagnosticBundles
+func NewListClusterDiagnosticBundlesRequest(server string, id int32) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "clusterID", runtime.ParamLocationPath, clusterID)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "ID", runtime.ParamLocationPath, id)
 	if err != nil {
 		return nil, err
 	}
@@ -2870,7 +4365,7 @@ func NewGetMaterializedViewThroughputRequest(server string, clusterID int32) (*h
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/metrics/%s/materialized-view-throughput", pathParam0)
+	operationPath := fmt.Sprintf("/clusters/%s/diagnostic-bundles", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2888,16 +4383,23 @@ func NewGetMaterializedViewThroughputRequest(server string, clusterID int32) (*h
 	return req, nil
 }
 
-// NewListTasksRequest generates requests for ListTasks
-func NewListTasksRequest(server string) (*http.Request, error) {
+// NewCreateClusterDiagnosticBundleRequest generates requests for CreateClusterDiagnosticBundle
+func NewCreateClusterDiagnosticBundleRequest(server string, id int32) (*http.Request, error) {
 	var err error
 
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "ID", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/tasks")
+	operationPath := fmt.Sprintf("/clusters/%s/diagnostic-bundles", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2907,7 +4409,7 @@ func NewListTasksRequest(server string) (*http.Request, error) {
 		return nil, err
 	}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -2915,27 +4417,30 @@ func NewListTasksRequest(server string) (*http.Request, error) {
 	return req, nil
 }
 
-// NewTestClusterConnectionRequest calls the generic TestClusterConnection builder with application/json body
-func NewTestClusterConnectionRequest(server string, body TestClusterConnectionJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+// NewDeleteClusterDiagnosticBundleRequest generates requests for DeleteClusterDiagnosticBundle
+func NewDeleteClusterDiagnosticBundleRequest(server string, id int32, bundleId int32) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "ID", runtime.ParamLocationPath, id)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewTestClusterConnectionRequestWithBody(server, "application/json", bodyReader)
-}
 
-// NewTestClusterConnectionRequestWithBody generates requests for TestClusterConnection with any type of body
-func NewTestClusterConnectionRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "bundleId", runtime.ParamLocationPath, bundleId)
+	if err != nil {
+		return nil, err
+	}
 
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/test-cluster-connection")
+	operationPath := fmt.Sprintf("/clusters/%s/diagnostic-bundles/%s", pathParam0, pathParam1)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -2945,2474 +4450,9630 @@ func NewTestClusterConnectionRequestWithBody(server string, contentType string,
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
 
-	req.Header.Add("Content-Type", contentType)
-
 	return req, nil
 }
 
-func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
-	for _, r := range c.RequestEditors {
-		if err := r(ctx, req); err != nil {
-			return err
-		}
-	}
-	for _, r := range additionalEditors {
-		if err := r(ctx, req); err != nil {
-			return err
-		}
-	}
-	return nil
-}
+// NewGetClusterDiagnosticBundleRequest generates requests for GetClusterDiagnosticBundle
+func NewGetClusterDiagnosticBundleRequest(server string, id int32, bundleId int32) (*http.Request, error) {
+	var err error
 
-// ClientWithResponses builds on ClientInterface to offer response payloads
-type ClientWithResponses struct {
-	ClientInterface
-}
+	var pathParam0 string
 
-// NewClientWithResponses creates a new ClientWithResponses, which wraps
-// Client with return type handling
-func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
-	client, err := NewClient(server, opts...)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "ID", runtime.ParamLocationPath, id)
 	if err != nil {
 		return nil, err
 	}
-	return &ClientWithResponses{client}, nil
-}
 
-// WithBaseURL overrides the baseURL.
-func WithBaseURL(baseURL string) ClientOption {
-	return func(c *Client) error {
-		newBaseURL, err := url.Parse(baseURL)
-		if err != nil {
-			return err
-		}
-		c.Server = newBaseURL.String()
-		return nil
-	}
-}
+	var pathParam1 string
 
-// ClientWithResponsesInterface is the interface specification for the client with responses above.
-type ClientWithResponsesInterface interface {
-	// ListClusterVersionsWithResponse request
-	ListClusterVersionsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListClusterVersionsResponse, error)
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "bundleId", runtime.ParamLocationPath, bundleId)
+	if err != nil {
+		return nil, err
+	}
 
-	// ListClustersWithResponse request
-	ListClustersWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListClustersResponse, error)
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	// CreateClusterWithBodyWithResponse request with any body
-	CreateClusterWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateClusterResponse, error)
+	operationPath := fmt.Sprintf("/clusters/%s/diagnostic-bundles/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	CreateClusterWithResponse(ctx context.Context, body CreateClusterJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateClusterResponse, error)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	// ImportClusterWithBodyWithResponse request with any body
-	ImportClusterWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ImportClusterResponse, error)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
 
-	ImportClusterWithResponse(ctx context.Context, body ImportClusterJSONRequestBody, reqEditors ...RequestEditorFn) (*ImportClusterResponse, error)
+	return req, nil
+}
 
-	// DeleteClusterWithResponse request
-	DeleteClusterWithResponse(ctx context.Context, id int32, params *DeleteClusterParams, reqEditors ...RequestEditorFn) (*DeleteClusterResponse, error)
+// NewDownloadClusterDiagnosticBundleRequest generates requests for DownloadClusterDiagnosticBundle
+func NewDownloadClusterDiagnosticBundleRequest(server string, id int32, bundleId int32) (*http.Request, error) {
+	var err error
 
-	// GetClusterWithResponse request
-	GetClusterWithResponse(ctx context.Context, id int32, reqEditors ...RequestEditorFn) (*GetClusterResponse, error)
+	var pathParam0 string
 
-	// UpdateClusterWithBodyWithResponse request with any body
-	UpdateClusterWithBodyWithResponse(ctx context.Context, id int32, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateClusterResponse, error)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "ID", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
 
-	UpdateClusterWithResponse(ctx context.Context, id int32, body UpdateClusterJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateClusterResponse, error)
+	var pathParam1 string
 
-	// GetClusterAutoBackupConfigWithResponse request
-	GetClusterAutoBackupConfigWithResponse(ctx context.Context, id int32, reqEditors ...RequestEditorFn) (*GetClusterAutoBackupConfigResponse, error)
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "bundleId", runtime.ParamLocationPath, bundleId)
+	if err != nil {
+		return nil, err
+	}
 
-	// UpdateClusterAutoBackupConfigWithBodyWithResponse request with any body
-	UpdateClusterAutoBackupConfigWithBodyWithResponse(ctx context.Context, id int32, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateClusterAutoBackupConfigResponse, error)
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	UpdateClusterAutoBackupConfigWithResponse(ctx context.Context, id int32, body UpdateClusterAutoBackupConfigJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateClusterAutoBackupConfigResponse, error)
+	operationPath := fmt.Sprintf("/clusters/%s/diagnostic-bundles/%s/download", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	// ListClusterDiagnosticsWithResponse request
-	ListClusterDiagnosticsWithResponse(ctx context.Context, id int32, params *ListClusterDiagnosticsParams, reqEditors ...RequestEditorFn) (*ListClusterDiagnosticsResponse, error)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	// CreateClusterDiagnosticWithBodyWithResponse request with any body
-	CreateClusterDiagnosticWithBodyWithResponse(ctx context.Context, id int32, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateClusterDiagnosticResponse, error)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
 
-	CreateClusterDiagnosticWithResponse(ctx context.Context, id int32, body CreateClusterDiagnosticJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateClusterDiagnosticResponse, error)
+	return req, nil
+}
 
-	// GetClusterAutoDiagnosticConfigWithResponse request
-	GetClusterAutoDiagnosticConfigWithResponse(ctx context.Context, id int32, reqEditors ...RequestEditorFn) (*GetClusterAutoDiagnosticConfigResponse, error)
+// NewListClusterDiagnosticsRequest generates requests for ListClusterDiagnostics
+func NewListClusterDiagnosticsRequest(server string, id int32, params *ListClusterDiagnosticsParams) (*http.Request, error) {
+	var err error
 
-	// UpdateClusterAutoDiagnosticConfigWithBodyWithResponse request with any body
-	UpdateClusterAutoDiagnosticConfigWithBodyWithResponse(ctx context.Context, id int32, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateClusterAutoDiagnosticConfigResponse, error)
+	var pathParam0 string
 
-	UpdateClusterAutoDiagnosticConfigWithResponse(ctx context.Context, id int32, body UpdateClusterAutoDiagnosticConfigJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateClusterAutoDiagnosticConfigResponse, error)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "ID", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
 
-	// GetClusterDiagnosticWithResponse request
-	GetClusterDiagnosticWithResponse(ctx context.Context, id int32, diagnosticId int32, reqEditors ...RequestEditorFn) (*GetClusterDiagnosticResponse, error)
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	// RunRisectlCommandWithBodyWithResponse request with any body
-	RunRisectlCommandWithBodyWithResponse(ctx context.Context, id int32, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RunRisectlCommandResponse, error)
+	operationPath := fmt.Sprintf("/clusters/%s/diagnostics", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	RunRisectlCommandWithResponse(ctx context.Context, id int32, body RunRisectlCommandJSONRequestBody, reqEditors ...RequestEditorFn) (*RunRisectlCommandResponse, error)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	// ListClusterSnapshotsWithResponse request
-	ListClusterSnapshotsWithResponse(ctx context.Context, id int32, reqEditors ...RequestEditorFn) (*ListClusterSnapshotsResponse, error)
+	if params != nil {
+		queryValues := queryURL.Query()
 
-	// CreateClusterSnapshotWithBodyWithResponse request with any body
-	CreateClusterSnapshotWithBodyWithResponse(ctx context.Context, id int32, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateClusterSnapshotResponse, error)
+		if params.From != nil {
 
-	CreateClusterSnapshotWithResponse(ctx context.Context, id int32, body CreateClusterSnapshotJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateClusterSnapshotResponse, error)
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "from", runtime.ParamLocationQuery, *params.From); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-	// DeleteClusterSnapshotWithResponse request
-	DeleteClusterSnapshotWithResponse(ctx context.Context, id int32, snapshotId int64, reqEditors ...RequestEditorFn) (*DeleteClusterSnapshotResponse, error)
+		}
 
-	// RestoreClusterSnapshotWithResponse request
-	RestoreClusterSnapshotWithResponse(ctx context.Context, id int32, snapshotId int64, reqEditors ...RequestEditorFn) (*RestoreClusterSnapshotResponse, error)
+		if params.To != nil {
 
-	// ListDatabasesWithResponse request
-	ListDatabasesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListDatabasesResponse, error)
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "to", runtime.ParamLocationQuery, *params.To); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-	// ImportDatabaseWithBodyWithResponse request with any body
-	ImportDatabaseWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ImportDatabaseResponse, error)
+		}
 
-	ImportDatabaseWithResponse(ctx context.Context, body ImportDatabaseJSONRequestBody, reqEditors ...RequestEditorFn) (*ImportDatabaseResponse, error)
+		if params.Page != nil {
 
-	// TestDatabaseConnectionWithBodyWithResponse request with any body
-	TestDatabaseConnectionWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*TestDatabaseConnectionResponse, error)
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "page", runtime.ParamLocationQuery, *params.Page); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-	TestDatabaseConnectionWithResponse(ctx context.Context, body TestDatabaseConnectionJSONRequestBody, reqEditors ...RequestEditorFn) (*TestDatabaseConnectionResponse, error)
+		}
 
-	// DeleteDatabaseWithResponse request
-	DeleteDatabaseWithResponse(ctx context.Context, id int32, reqEditors ...RequestEditorFn) (*DeleteDatabaseResponse, error)
+		if params.PerPage != nil {
 
-	// GetDatabaseWithResponse request
-	GetDatabaseWithResponse(ctx context.Context, id int32, reqEditors ...RequestEditorFn) (*GetDatabaseResponse, error)
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "perPage", runtime.ParamLocationQuery, *params.PerPage); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
 
-	// UpdateDatabaseWithBodyWithResponse request with any body
-	UpdateDatabaseWithBodyWithResponse(ctx context.Context, id int32, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateDatabaseResponse, error)
+		}
 
-	UpdateDatabaseWithResponse(ctx context.Context, id int32, body UpdateDatabaseJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateDatabaseResponse, error)
+		queryURL.RawQuery = queryValues.Encode()
+	}
 
-	// GetDDLProgressWithResponse request
-	GetDDLProgressWithResponse(ctx context.Context, id int32, reqEditors ...RequestEditorFn) (*GetDDLProgressResponse, error)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
 
-	// CancelDDLProgressWithResponse request
-	CancelDDLProgressWithResponse(ctx context.Context, id int32, ddlID int64, reqEditors ...RequestEditorFn) (*CancelDDLProgressResponse, error)
+	return req, nil
+}
 
-	// QueryDatabaseWithBodyWithResponse request with any body
-	QueryDatabaseWithBodyWithResponse(ctx context.Context, id int32, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*QueryDatabaseResponse, error)
+// NewCreateClusterDiagnosticRequest calls the generic CreateClusterDiagnostic builder with application/json body
+func NewCreateClusterDiagnosticRequest(server string, id int32, body CreateClusterDiagnosticJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateClusterDiagnosticRequestWithBody(server, id, "application/json", bodyReader)
+}
 
-	QueryDatabaseWithResponse(ctx context.Context, id int32, body QueryDatabaseJSONRequestBody, reqEditors ...RequestEditorFn) (*QueryDatabaseResponse, error)
+// NewCreateClusterDiagnosticRequestWithBody generates requests for CreateClusterDiagnostic with any type of body
+func NewCreateClusterDiagnosticRequestWithBody(server string, id int32, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
-	// ListEventsWithResponse request
-	ListEventsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListEventsResponse, error)
+	var pathParam0 string
 
-	// ListMetricsStoresWithResponse request
-	ListMetricsStoresWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListMetricsStoresResponse, error)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "ID", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
 
-	// ImportMetricsStoreWithBodyWithResponse request with any body
-	ImportMetricsStoreWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ImportMetricsStoreResponse, error)
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	ImportMetricsStoreWithResponse(ctx context.Context, body ImportMetricsStoreJSONRequestBody, reqEditors ...RequestEditorFn) (*ImportMetricsStoreResponse, error)
+	operationPath := fmt.Sprintf("/clusters/%s/diagnostics", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	// DeleteMetricsStoreWithResponse request
-	DeleteMetricsStoreWithResponse(ctx context.Context, id int32, params *DeleteMetricsStoreParams, reqEditors ...RequestEditorFn) (*DeleteMetricsStoreResponse, error)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	// GetMetricsStoreWithResponse request
-	GetMetricsStoreWithResponse(ctx context.Context, id int32, reqEditors ...RequestEditorFn) (*GetMetricsStoreResponse, error)
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
 
-	// UpdateMetricsStoreWithBodyWithResponse request with any body
-	UpdateMetricsStoreWithBodyWithResponse(ctx context.Context, id int32, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateMetricsStoreResponse, error)
+	req.Header.Add("Content-Type", contentType)
 
-	UpdateMetricsStoreWithResponse(ctx context.Context, id int32, body UpdateMetricsStoreJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateMetricsStoreResponse, error)
+	return req, nil
+}
 
-	// GetMaterializedViewThroughputWithResponse request
-	GetMaterializedViewThroughputWithResponse(ctx context.Context, clusterID int32, reqEditors ...RequestEditorFn) (*GetMaterializedViewThroughputResponse, error)
+// NewGetClusterAutoDiagnosticConfigRequest generates requests for GetClusterAutoDiagnosticConfig
+func NewGetClusterAutoDiagnosticConfigRequest(server string, id int32) (*http.Request, error) {
+	var err error
 
-	// ListTasksWithResponse request
-	ListTasksWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListTasksResponse, error)
+	var pathParam0 string
 
-	// TestClusterConnectionWithBodyWithResponse request with any body
-	TestClusterConnectionWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*TestClusterConnectionResponse, error)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "ID", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
 
-	TestClusterConnectionWithResponse(ctx context.Context, body TestClusterConnectionJSONRequestBody, reqEditors ...RequestEditorFn) (*TestClusterConnectionResponse, error)
-}
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-type ListClusterVersionsResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *[]string
-}
+	operationPath := fmt.Sprintf("/clusters/%s/diagnostics/config", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-// Status returns HTTPResponse.Status
-func (r ListClusterVersionsResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r ListClusterVersionsResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type ListClustersResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *[]Cluster
+	return req, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r ListClustersResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// NewUpdateClusterAutoDiagnosticConfigRequest calls the generic UpdateClusterAutoDiagnosticConfig builder with application/json body
+func NewUpdateClusterAutoDiagnosticConfigRequest(server string, id int32, body UpdateClusterAutoDiagnosticConfigJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateClusterAutoDiagnosticConfigRequestWithBody(server, id, "application/json", bodyReader)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r ListClustersResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
-}
+// NewUpdateClusterAutoDiagnosticConfigRequestWithBody generates requests for UpdateClusterAutoDiagnosticConfig with any type of body
+func NewUpdateClusterAutoDiagnosticConfigRequestWithBody(server string, id int32, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
-type CreateClusterResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON201      *Cluster
-}
+	var pathParam0 string
 
-// Status returns HTTPResponse.Status
-func (r CreateClusterResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "ID", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r CreateClusterResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type ImportClusterResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON201      *Cluster
-}
+	operationPath := fmt.Sprintf("/clusters/%s/diagnostics/config", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-// Status returns HTTPResponse.Status
-func (r ImportClusterResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r ImportClusterResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type DeleteClusterResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-}
+	req.Header.Add("Content-Type", contentType)
 
-// Status returns HTTPResponse.Status
-func (r DeleteClusterResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
+	return req, nil
+}
+
+// NewDiffClusterDiagnosticsRequest generates requests for DiffClusterDiagnostics
+func NewDiffClusterDiagnosticsRequest(server string, id int32, params *DiffClusterDiagnosticsParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "ID", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/clusters/%s/diagnostics/diff", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "from", runtime.ParamLocationQuery, params.From); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "to", runtime.ParamLocationQuery, params.To); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewSearchClusterDiagnosticsRequest generates requests for SearchClusterDiagnostics
+func NewSearchClusterDiagnosticsRequest(server string, id int32, params *SearchClusterDiagnosticsParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "ID", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/clusters/%s/diagnostics/search", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "q", runtime.ParamLocationQuery, params.Q); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		if params.From != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "from", runtime.ParamLocationQuery, *params.From); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.To != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "to", runtime.ParamLocationQuery, *params.To); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Order != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "order", runtime.ParamLocationQuery, *params.Order); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetClusterDiagnosticRequest generates requests for GetClusterDiagnostic
+func NewGetClusterDiagnosticRequest(server string, id int32, diagnosticId int32) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "ID", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "diagnosticId", runtime.ParamLocationPath, diagnosticId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/clusters/%s/diagnostics/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewListClusterDiagnosticFindingsRequest generates requests for ListClusterDiagnosticFindings
+func NewListClusterDiagnosticFindingsRequest(server string, id int32, diagnosticId int32) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "ID", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "diagnosticId", runtime.ParamLocationPath, diagnosticId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/clusters/%s/diagnostics/%s/findings", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetClusterDiagnosticReportRequest generates requests for GetClusterDiagnosticReport
+func NewGetClusterDiagnosticReportRequest(server string, id int32, diagnosticId int32) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "ID", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "diagnosticId", runtime.ParamLocationPath, diagnosticId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/clusters/%s/diagnostics/%s/report", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewListClusterEventsRequest generates requests for ListClusterEvents
+func NewListClusterEventsRequest(server string, id int32, params *ListClusterEventsParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "ID", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/clusters/%s/events", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Type != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "type", runtime.ParamLocationQuery, *params.Type); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewListClusterHealthHistoryRequest generates requests for ListClusterHealthHistory
+func NewListClusterHealthHistoryRequest(server string, id int32, params *ListClusterHealthHistoryParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "ID", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/clusters/%s/health-history", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.From != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "from", runtime.ParamLocationQuery, *params.From); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.To != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "to", runtime.ParamLocationQuery, *params.To); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewRunRisectlCommandRequest calls the generic RunRisectlCommand builder with application/json body
+func NewRunRisectlCommandRequest(server string, id int32, body RunRisectlCommandJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewRunRisectlCommandRequestWithBody(server, id, "application/json", bodyReader)
+}
+
+// NewRunRisectlCommandRequestWithBody generates requests for RunRisectlCommand with any type of body
+func NewRunRisectlCommandRequestWithBody(server string, id int32, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "ID", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/clusters/%s/risectl", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewListRisectlExecutionsRequest generates requests for ListRisectlExecutions
+func NewListRisectlExecutionsRequest(server string, id int32, params *ListRisectlExecutionsParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "ID", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/clusters/%s/risectl/executions", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Before != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "before", runtime.ParamLocationQuery, *params.Before); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreateRisectlExecutionRequest calls the generic CreateRisectlExecution builder with application/json body
+func NewCreateRisectlExecutionRequest(server string, id int32, body CreateRisectlExecutionJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateRisectlExecutionRequestWithBody(server, id, "application/json", bodyReader)
+}
+
+// NewCreateRisectlExecutionRequestWithBody generates requests for CreateRisectlExecution with any type of body
+func NewCreateRisectlExecutionRequestWithBody(server string, id int32, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "ID", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/clusters/%s/risectl/executions", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetRisectlExecutionRequest generates requests for GetRisectlExecution
+func NewGetRisectlExecutionRequest(server string, id int32, executionID int32) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "ID", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "executionID", runtime.ParamLocationPath, executionID)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/clusters/%s/risectl/executions/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCancelRisectlExecutionRequest generates requests for CancelRisectlExecution
+func NewCancelRisectlExecutionRequest(server string, id int32, executionID int32) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "ID", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "executionID", runtime.ParamLocationPath, executionID)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/clusters/%s/risectl/executions/%s/cancel", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewListRisectlExecutionOutputsRequest generates requests for ListRisectlExecutionOutputs
+func NewListRisectlExecutionOutputsRequest(server string, id int32, executionID int32, params *ListRisectlExecutionOutputsParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "ID", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "executionID", runtime.ParamLocationPath, executionID)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/clusters/%s/risectl/executions/%s/output", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.After != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "after", runtime.ParamLocationQuery, *params.After); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewStreamRisectlExecutionRequest generates requests for StreamRisectlExecution
+func NewStreamRisectlExecutionRequest(server string, id int32, executionID int32, params *StreamRisectlExecutionParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "ID", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "executionID", runtime.ParamLocationPath, executionID)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/clusters/%s/risectl/executions/%s/stream", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.After != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "after", runtime.ParamLocationQuery, *params.After); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewListRisectlOperationsRequest generates requests for ListRisectlOperations
+func NewListRisectlOperationsRequest(server string, id int32) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "ID", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/clusters/%s/risectl/operations", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewRunRisectlOperationRequest generates requests for RunRisectlOperation
+func NewRunRisectlOperationRequest(server string, id int32, operation RisectlOperationName) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "ID", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "operation", runtime.ParamLocationPath, operation)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/clusters/%s/risectl/operations/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewOpenRisectlShellRequest generates requests for OpenRisectlShell
+func NewOpenRisectlShellRequest(server string, id int32) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "ID", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/clusters/%s/risectl/shell", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewListRisectlShellSessionsRequest generates requests for ListRisectlShellSessions
+func NewListRisectlShellSessionsRequest(server string, id int32) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "ID", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/clusters/%s/risectl/shell/sessions", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewListRisectlShellCommandsRequest generates requests for ListRisectlShellCommands
+func NewListRisectlShellCommandsRequest(server string, id int32, sessionID int32) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "ID", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "sessionID", runtime.ParamLocationPath, sessionID)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/clusters/%s/risectl/shell/sessions/%s/commands", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewListClusterSnapshotsRequest generates requests for ListClusterSnapshots
+func NewListClusterSnapshotsRequest(server string, id int32) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "ID", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/clusters/%s/snapshots", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreateClusterSnapshotRequest calls the generic CreateClusterSnapshot builder with application/json body
+func NewCreateClusterSnapshotRequest(server string, id int32, body CreateClusterSnapshotJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateClusterSnapshotRequestWithBody(server, id, "application/json", bodyReader)
+}
+
+// NewCreateClusterSnapshotRequestWithBody generates requests for CreateClusterSnapshot with any type of body
+func NewCreateClusterSnapshotRequestWithBody(server string, id int32, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "ID", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/clusters/%s/snapshots", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteClusterSnapshotRequest generates requests for DeleteClusterSnapshot
+func NewDeleteClusterSnapshotRequest(server string, id int32, snapshotId int64) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "ID", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "snapshotId", runtime.ParamLocationPath, snapshotId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/clusters/%s/snapshots/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewRestoreClusterSnapshotRequest generates requests for RestoreClusterSnapshot
+func NewRestoreClusterSnapshotRequest(server string, id int32, snapshotId int64) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "ID", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "snapshotId", runtime.ParamLocationPath, snapshotId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/clusters/%s/snapshots/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewListClusterUpgradesRequest generates requests for ListClusterUpgrades
+func NewListClusterUpgradesRequest(server string, id int32) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "ID", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/clusters/%s/upgrades", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreateClusterUpgradeRequest calls the generic CreateClusterUpgrade builder with application/json body
+func NewCreateClusterUpgradeRequest(server string, id int32, body CreateClusterUpgradeJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateClusterUpgradeRequestWithBody(server, id, "application/json", bodyReader)
+}
+
+// NewCreateClusterUpgradeRequestWithBody generates requests for CreateClusterUpgrade with any type of body
+func NewCreateClusterUpgradeRequestWithBody(server string, id int32, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "ID", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/clusters/%s/upgrades", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetClusterUpgradeRequest generates requests for GetClusterUpgrade
+func NewGetClusterUpgradeRequest(server string, id int32, upgradeID int32) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "ID", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "upgradeID", runtime.ParamLocationPath, upgradeID)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/clusters/%s/upgrades/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCancelClusterUpgradeRequest generates requests for CancelClusterUpgrade
+func NewCancelClusterUpgradeRequest(server string, id int32, upgradeID int32) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "ID", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "upgradeID", runtime.ParamLocationPath, upgradeID)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/clusters/%s/upgrades/%s/cancel", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewListClusterVersionHistoryRequest generates requests for ListClusterVersionHistory
+func NewListClusterVersionHistoryRequest(server string, id int32) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "ID", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/clusters/%s/version-history", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewListDatabasesRequest generates requests for ListDatabases
+func NewListDatabasesRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/databases")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewImportDatabaseRequest calls the generic ImportDatabase builder with application/json body
+func NewImportDatabaseRequest(server string, body ImportDatabaseJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewImportDatabaseRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewImportDatabaseRequestWithBody generates requests for ImportDatabase with any type of body
+func NewImportDatabaseRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/databases/import")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewTestDatabaseConnectionRequest calls the generic TestDatabaseConnection builder with application/json body
+func NewTestDatabaseConnectionRequest(server string, body TestDatabaseConnectionJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewTestDatabaseConnectionRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewTestDatabaseConnectionRequestWithBody generates requests for TestDatabaseConnection with any type of body
+func NewTestDatabaseConnectionRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/databases/test-connection")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteDatabaseRequest generates requests for DeleteDatabase
+func NewDeleteDatabaseRequest(server string, id int32) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "ID", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/databases/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetDatabaseRequest generates requests for GetDatabase
+func NewGetDatabaseRequest(server string, id int32, params *GetDatabaseParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "ID", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/databases/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Refresh != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "refresh", runtime.ParamLocationQuery, *params.Refresh); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUpdateDatabaseRequest calls the generic UpdateDatabase builder with application/json body
+func NewUpdateDatabaseRequest(server string, id int32, body UpdateDatabaseJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateDatabaseRequestWithBody(server, id, "application/json", bodyReader)
+}
+
+// NewUpdateDatabaseRequestWithBody generates requests for UpdateDatabase with any type of body
+func NewUpdateDatabaseRequestWithBody(server string, id int32, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "ID", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/databases/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewListDatabaseCatalogChangesRequest generates requests for ListDatabaseCatalogChanges
+func NewListDatabaseCatalogChangesRequest(server string, id int32, params *ListDatabaseCatalogChangesParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "ID", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/databases/%s/catalog-history", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.RelationID != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "relationID", runtime.ParamLocationQuery, *params.RelationID); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.RelationName != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "relationName", runtime.ParamLocationQuery, *params.RelationName); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Before != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "before", runtime.ParamLocationQuery, *params.Before); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetDDLProgressRequest generates requests for GetDDLProgress
+func NewGetDDLProgressRequest(server string, id int32) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "ID", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/databases/%s/ddl-progress", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCancelDDLProgressRequest generates requests for CancelDDLProgress
+func NewCancelDDLProgressRequest(server string, id int32, ddlID int64) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "ID", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "ddlID", runtime.ParamLocationPath, ddlID)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/databases/%s/ddl-progress/%s/cancel", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewAnalyzeDatabaseImpactRequest calls the generic AnalyzeDatabaseImpact builder with application/json body
+func NewAnalyzeDatabaseImpactRequest(server string, id int32, body AnalyzeDatabaseImpactJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewAnalyzeDatabaseImpactRequestWithBody(server, id, "application/json", bodyReader)
+}
+
+// NewAnalyzeDatabaseImpactRequestWithBody generates requests for AnalyzeDatabaseImpact with any type of body
+func NewAnalyzeDatabaseImpactRequestWithBody(server string, id int32, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "ID", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/databases/%s/impact-analysis", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetDatabaseLineageRequest generates requests for GetDatabaseLineage
+func NewGetDatabaseLineageRequest(server string, id int32, params *GetDatabaseLineageParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "ID", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/databases/%s/lineage", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.RelationID != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "relationID", runtime.ParamLocationQuery, *params.RelationID); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Direction != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "direction", runtime.ParamLocationQuery, *params.Direction); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Depth != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "depth", runtime.ParamLocationQuery, *params.Depth); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewExportDatabaseLineageRequest generates requests for ExportDatabaseLineage
+func NewExportDatabaseLineageRequest(server string, id int32, params *ExportDatabaseLineageParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "ID", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/databases/%s/lineage/export", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "format", runtime.ParamLocationQuery, params.Format); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		if params.RelationID != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "relationID", runtime.ParamLocationQuery, *params.RelationID); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Direction != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "direction", runtime.ParamLocationQuery, *params.Direction); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Depth != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "depth", runtime.ParamLocationQuery, *params.Depth); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewQueryDatabaseRequest calls the generic QueryDatabase builder with application/json body
+func NewQueryDatabaseRequest(server string, id int32, body QueryDatabaseJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewQueryDatabaseRequestWithBody(server, id, "application/json", bodyReader)
+}
+
+// NewQueryDatabaseRequestWithBody generates requests for QueryDatabase with any type of body
+func NewQueryDatabaseRequestWithBody(server string, id int32, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "ID", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/databases/%s/query", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewListDatabaseRelationsRequest generates requests for ListDatabaseRelations
+func NewListDatabaseRelationsRequest(server string, id int32, params *ListDatabaseRelationsParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "ID", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/databases/%s/relations", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Refresh != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "refresh", runtime.ParamLocationQuery, *params.Refresh); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewListDiagnosticRulesRequest generates requests for ListDiagnosticRules
+func NewListDiagnosticRulesRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/diagnostic-rules")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUpdateDiagnosticRuleRequest calls the generic UpdateDiagnosticRule builder with application/json body
+func NewUpdateDiagnosticRuleRequest(server string, rule DiagnosticRuleName, body UpdateDiagnosticRuleJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateDiagnosticRuleRequestWithBody(server, rule, "application/json", bodyReader)
+}
+
+// NewUpdateDiagnosticRuleRequestWithBody generates requests for UpdateDiagnosticRule with any type of body
+func NewUpdateDiagnosticRuleRequestWithBody(server string, rule DiagnosticRuleName, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "rule", runtime.ParamLocationPath, rule)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/diagnostic-rules/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewSearchDiagnosticsRequest generates requests for SearchDiagnostics
+func NewSearchDiagnosticsRequest(server string, params *SearchDiagnosticsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/diagnostics/search")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.ClusterID != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "clusterID", runtime.ParamLocationQuery, *params.ClusterID); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "q", runtime.ParamLocationQuery, params.Q); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		if params.From != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "from", runtime.ParamLocationQuery, *params.From); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.To != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "to", runtime.ParamLocationQuery, *params.To); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Order != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "order", runtime.ParamLocationQuery, *params.Order); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewListEventsRequest generates requests for ListEvents
+func NewListEventsRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/events")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewListMetricsStoresRequest generates requests for ListMetricsStores
+func NewListMetricsStoresRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/metrics-stores")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewImportMetricsStoreRequest calls the generic ImportMetricsStore builder with application/json body
+func NewImportMetricsStoreRequest(server string, body ImportMetricsStoreJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewImportMetricsStoreRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewImportMetricsStoreRequestWithBody generates requests for ImportMetricsStore with any type of body
+func NewImportMetricsStoreRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/metrics-stores/import")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteMetricsStoreRequest generates requests for DeleteMetricsStore
+func NewDeleteMetricsStoreRequest(server string, id int32, params *DeleteMetricsStoreParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "ID", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/metrics-stores/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "force", runtime.ParamLocationQuery, params.Force); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetMetricsStoreRequest generates requests for GetMetricsStore
+func NewGetMetricsStoreRequest(server string, id int32) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "ID", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/metrics-stores/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUpdateMetricsStoreRequest calls the generic UpdateMetricsStore builder with application/json body
+func NewUpdateMetricsStoreRequest(server string, id int32, body UpdateMetricsStoreJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateMetricsStoreRequestWithBody(server, id, "application/json", bodyReader)
+}
+
+// NewUpdateMetricsStoreRequestWithBody generates requests for UpdateMetricsStore with any type of body
+func NewUpdateMetricsStoreRequestWithBody(server string, id int32, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "ID", runtime.ParamLocationPath, id)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/metrics-stores/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetMaterializedViewThroughputRequest generates requests for GetMaterializedViewThroughput
+func NewGetMaterializedViewThroughputRequest(server string, clusterID int32) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "clusterID", runtime.ParamLocationPath, clusterID)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/metrics/%s/materialized-view-throughput", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetOrgSettingsRequest generates requests for GetOrgSettings
+func NewGetOrgSettingsRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/org-settings")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewUpdateOrgSettingsRequest calls the generic UpdateOrgSettings builder with application/json body
+func NewUpdateOrgSettingsRequest(server string, body UpdateOrgSettingsJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateOrgSettingsRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewUpdateOrgSettingsRequestWithBody generates requests for UpdateOrgSettings with any type of body
+func NewUpdateOrgSettingsRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/org-settings")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewUploadRisectlRequestWithBody generates requests for UploadRisectl with any type of body
+func NewUploadRisectlRequestWithBody(server string, version string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "version", runtime.ParamLocationPath, version)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/risectl/versions/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewListTasksRequest generates requests for ListTasks
+func NewListTasksRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/tasks")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewTestClusterConnectionRequest calls the generic TestClusterConnection builder with application/json body
+func NewTestClusterConnectionRequest(server string, body TestClusterConnectionJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewTestClusterConnectionRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewTestClusterConnectionRequestWithBody generates requests for TestClusterConnection with any type of body
+func NewTestClusterConnectionRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/test-cluster-connection")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
+	for _, r := range c.RequestEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	for _, r := range additionalEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ClientWithResponses builds on ClientInterface to offer response payloads
+type ClientWithResponses struct {
+	ClientInterface
+}
+
+// NewClientWithResponses creates a new ClientWithResponses, which wraps
+// Client with return type handling
+func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
+	client, err := NewClient(server, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &ClientWithResponses{client}, nil
+}
+
+// WithBaseURL overrides the baseURL.
+func WithBaseURL(baseURL string) ClientOption {
+	return func(c *Client) error {
+		newBaseURL, err := url.Parse(baseURL)
+		if err != nil {
+			return err
+		}
+		c.Server = newBaseURL.String()
+		return nil
+	}
+}
+
+// ClientWithResponsesInterface is the interface specification for the client with responses above.
+type ClientWithResponsesInterface interface {
+	// ListAlertRulesWithResponse request
+	ListAlertRulesWithResponse(ctx context.Context, params *ListAlertRulesParams, reqEditors ...RequestEditorFn) (*ListAlertRulesResponse, error)
+
+	// CreateAlertRuleWithBodyWithResponse request with any body
+	CreateAlertRuleWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateAlertRuleResponse, error)
+
+	CreateAlertRuleWithResponse(ctx context.Context, body CreateAlertRuleJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateAlertRuleResponse, error)
+
+	// DeleteAlertRuleWithResponse request
+	DeleteAlertRuleWithResponse(ctx context.Context, id int32, reqEditors ...RequestEditorFn) (*DeleteAlertRuleResponse, error)
+
+	// GetAlertRuleWithResponse request
+	GetAlertRuleWithResponse(ctx context.Context, id int32, reqEditors ...RequestEditorFn) (*GetAlertRuleResponse, error)
+
+	// UpdateAlertRuleWithBodyWithResponse request with any body
+	UpdateAlertRuleWithBodyWithResponse(ctx context.Context, id int32, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateAlertRuleResponse, error)
+
+	UpdateAlertRuleWithResponse(ctx context.Context, id int32, body UpdateAlertRuleJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateAlertRuleResponse, error)
+
+	// ListAlertsWithResponse request
+	ListAlertsWithResponse(ctx context.Context, params *ListAlertsParams, reqEditors ...RequestEditorFn) (*ListAlertsResponse, error)
+
+	// ListAlertHistoryWithResponse request
+	ListAlertHistoryWithResponse(ctx context.Context, params *ListAlertHistoryParams, reqEditors ...RequestEditorFn) (*ListAlertHistoryResponse, error)
+
+	// ListClusterVersionsWithResponse request
+	ListClusterVersionsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListClusterVersionsResponse, error)
+
+	// ListClustersWithResponse request
+	ListClustersWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListClustersResponse, error)
+
+	// CreateClusterWithBodyWithResponse request with any body
+	CreateClusterWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateClusterResponse, error)
+
+	CreateClusterWithResponse(ctx context.Context, body CreateClusterJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateClusterResponse, error)
+
+	// ImportClusterWithBodyWithResponse request with any body
+	ImportClusterWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ImportClusterResponse, error)
+
+	ImportClusterWithResponse(ctx context.Context, body ImportClusterJSONRequestBody, reqEditors ...RequestEditorFn) (*ImportClusterResponse, error)
+
+	// DeleteClusterWithResponse request
+	DeleteClusterWithResponse(ctx context.Context, id int32, params *DeleteClusterParams, reqEditors ...RequestEditorFn) (*DeleteClusterResponse, error)
+
+	// GetClusterWithResponse request
+	GetClusterWithResponse(ctx context.Context, id int32, reqEditors ...RequestEditorFn) (*GetClusterResponse, error)
+
+	// UpdateClusterWithBodyWithResponse request with any body
+	UpdateClusterWithBodyWithResponse(ctx context.Context, id int32, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateClusterResponse, error)
+
+	UpdateClusterWithResponse(ctx context.Context, id int32, body UpdateClusterJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateClusterResponse, error)
+
+	// GetClusterAutoBackupConfigWithResponse request
+	GetClusterAutoBackupConfigWithResponse(ctx context.Context, id int32, reqEditors ...RequestEditorFn) (*GetClusterAutoBackupConfigResponse, error)
+
+	// UpdateClusterAutoBackupConfigWithBodyWithResponse request with any body
+	UpdateClusterAutoBackupConfigWithBodyWithResponse(ctx context.Context, id int32, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateClusterAutoBackupConfigResponse, error)
+
+	UpdateClusterAutoBackupConfigWithResponse(ctx context.Context, id int32, body UpdateClusterAutoBackupConfigJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateClusterAutoBackupConfigResponse, error)
+
+	// PreviewClusterSnapshotRetentionWithBodyWithResponse request with any body
+	PreviewClusterSnapshotRetentionWithBodyWithResponse(ctx context.Context, id int32, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PreviewClusterSnapshotRetentionResponse, error)
+
+	PreviewClusterSnapshotRetentionWithResponse(ctx context.Context, id int32, body PreviewClusterSnapshotRetentionJSONRequestBody, reqEditors ...RequestEditorFn) (*PreviewClusterSnapshotRetentionResponse, error)
+
+	// ListClusterDiagnosticBundlesWithResponse request
+	ListClusterDiagnosticBundlesWithResponse(ctx context.Context, id int32, reqEditors ...RequestEditorFn) (*ListClusterDiagnosticBundlesResponse, error)
+
+	// CreateClusterDiagnosticBundleWithResponse request
+	CreateClusterDiagnosticBundleWithResponse(ctx context.Context, id int32, reqEditors ...RequestEditorFn) (*CreateClusterDiagnosticBundleResponse, error)
+
+	// DeleteClusterDiagnosticBundleWithResponse request
+	DeleteClusterDiagnosticBundleWithResponse(ctx context.Context, id int32, bundleId int32, reqEditors ...RequestEditorFn) (*DeleteClusterDiagnosticBundleResponse, error)
+
+	// GetClusterDiagnosticBundleWithResponse request
+	GetClusterDiagnosticBundleWithResponse(ctx context.Context, id int32, bundleId int32, reqEditors ...RequestEditorFn) (*GetClusterDiagnosticBundleResponse, error)
+
+	// DownloadClusterDiagnosticBundleWithResponse request
+	DownloadClusterDiagnosticBundleWithResponse(ctx context.Context, id int32, bundleId int32, reqEditors ...RequestEditorFn) (*DownloadClusterDiagnosticBundleResponse, error)
+
+	// ListClusterDiagnosticsWithResponse request
+	ListClusterDiagnosticsWithResponse(ctx context.Context, id int32, params *ListClusterDiagnosticsParams, reqEditors ...RequestEditorFn) (*ListClusterDiagnosticsResponse, error)
+
+	// CreateClusterDiagnosticWithBodyWithResponse request with any body
+	CreateClusterDiagnosticWithBodyWithResponse(ctx context.Context, id int32, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateClusterDiagnosticResponse, error)
+
+	CreateClusterDiagnosticWithResponse(ctx context.Context, id int32, body CreateClusterDiagnosticJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateClusterDiagnosticResponse, error)
+
+	// GetClusterAutoDiagnosticConfigWithResponse request
+	GetClusterAutoDiagnosticConfigWithResponse(ctx context.Context, id int32, reqEditors ...RequestEditorFn) (*GetClusterAutoDiagnosticConfigResponse, error)
+
+	// UpdateClusterAutoDiagnosticConfigWithBodyWithResponse request with any body
+	UpdateClusterAutoDiagnosticConfigWithBodyWithResponse(ctx context.Context, id int32, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateClusterAutoDiagnosticConfigResponse, error)
+
+	UpdateClusterAutoDiagnosticConfigWithResponse(ctx context.Context, id int32, body UpdateClusterAutoDiagnosticConfigJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateClusterAutoDiagnosticConfigResponse, error)
+
+	// DiffClusterDiagnosticsWithResponse request
+	DiffClusterDiagnosticsWithResponse(ctx context.Context, id int32, params *DiffClusterDiagnosticsParams, reqEditors ...RequestEditorFn) (*DiffClusterDiagnosticsResponse, error)
+
+	// SearchClusterDiagnosticsWithResponse request
+	SearchClusterDiagnosticsWithResponse(ctx context.Context, id int32, params *SearchClusterDiagnosticsParams, reqEditors ...RequestEditorFn) (*SearchClusterDiagnosticsResponse, error)
+
+	// GetClusterDiagnosticWithResponse request
+	GetClusterDiagnosticWithResponse(ctx context.Context, id int32, diagnosticId int32, reqEditors ...RequestEditorFn) (*GetClusterDiagnosticResponse, error)
+
+	// ListClusterDiagnosticFindingsWithResponse request
+	ListClusterDiagnosticFindingsWithResponse(ctx context.Context, id int32, diagnosticId int32, reqEditors ...RequestEditorFn) (*ListClusterDiagnosticFindingsResponse, error)
+
+	// GetClusterDiagnosticReportWithResponse request
+	GetClusterDiagnosticReportWithResponse(ctx context.Context, id int32, diagnosticId int32, reqEditors ...RequestEditorFn) (*GetClusterDiagnosticReportResponse, error)
+
+	// ListClusterEventsWithResponse request
+	ListClusterEventsWithResponse(ctx context.Context, id int32, params *ListClusterEventsParams, reqEditors ...RequestEditorFn) (*ListClusterEventsResponse, error)
+
+	// ListClusterHealthHistoryWithResponse request
+	ListClusterHealthHistoryWithResponse(ctx context.Context, id int32, params *ListClusterHealthHistoryParams, reqEditors ...RequestEditorFn) (*ListClusterHealthHistoryResponse, error)
+
+	// RunRisectlCommandWithBodyWithResponse request with any body
+	RunRisectlCommandWithBodyWithResponse(ctx context.Context, id int32, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RunRisectlCommandResponse, error)
+
+	RunRisectlCommandWithResponse(ctx context.Context, id int32, body RunRisectlCommandJSONRequestBody, reqEditors ...RequestEditorFn) (*RunRisectlCommandResponse, error)
+
+	// ListRisectlExecutionsWithResponse request
+	ListRisectlExecutionsWithResponse(ctx context.Context, id int32, params *ListRisectlExecutionsParams, reqEditors ...RequestEditorFn) (*ListRisectlExecutionsResponse, error)
+
+	// CreateRisectlExecutionWithBodyWithResponse request with any body
+	CreateRisectlExecutionWithBodyWithResponse(ctx context.Context, id int32, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateRisectlExecutionResponse, error)
+
+	CreateRisectlExecutionWithResponse(ctx context.Context, id int32, body CreateRisectlExecutionJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateRisectlExecutionResponse, error)
+
+	// GetRisectlExecutionWithResponse request
+	GetRisectlExecutionWithResponse(ctx context.Context, id int32, executionID int32, reqEditors ...RequestEditorFn) (*GetRisectlExecutionResponse, error)
+
+	// CancelRisectlExecutionWithResponse request
+	CancelRisectlExecutionWithResponse(ctx context.Context, id int32, executionID int32, reqEditors ...RequestEditorFn) (*CancelRisectlExecutionResponse, error)
+
+	// ListRisectlExecutionOutputsWithResponse request
+	ListRisectlExecutionOutputsWithResponse(ctx context.Context, id int32, executionID int32, params *ListRisectlExecutionOutputsParams, reqEditors ...RequestEditorFn) (*ListRisectlExecutionOutputsResponse, error)
+
+	// StreamRisectlExecutionWithResponse request
+	StreamRisectlExecutionWithResponse(ctx context.Context, id int32, executionID int32, params *StreamRisectlExecutionParams, reqEditors ...RequestEditorFn) (*StreamRisectlExecutionResponse, error)
+
+	// ListRisectlOperationsWithResponse request
+	ListRisectlOperationsWithResponse(ctx context.Context, id int32, reqEditors ...RequestEditorFn) (*ListRisectlOperationsResponse, error)
+
+	// RunRisectlOperationWithResponse request
+	RunRisectlOperationWithResponse(ctx context.Context, id int32, operation RisectlOperationName, reqEditors ...RequestEditorFn) (*RunRisectlOperationResponse, error)
+
+	// OpenRisectlShellWithResponse request
+	OpenRisectlShellWithResponse(ctx context.Context, id int32, reqEditors ...RequestEditorFn) (*OpenRisectlShellResponse, error)
+
+	// ListRisectlShellSessionsWithResponse request
+	ListRisectlShellSessionsWithResponse(ctx context.Context, id int32, reqEditors ...RequestEditorFn) (*ListRisectlShellSessionsResponse, error)
+
+	// ListRisectlShellCommandsWithResponse request
+	ListRisectlShellCommandsWithResponse(ctx context.Context, id int32, sessionID int32, reqEditors ...RequestEditorFn) (*ListRisectlShellCommandsResponse, error)
+
+	// ListClusterSnapshotsWithResponse request
+	ListClusterSnapshotsWithResponse(ctx context.Context, id int32, reqEditors ...RequestEditorFn) (*ListClusterSnapshotsResponse, error)
+
+	// CreateClusterSnapshotWithBodyWithResponse request with any body
+	CreateClusterSnapshotWithBodyWithResponse(ctx context.Context, id int32, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateClusterSnapshotResponse, error)
+
+	CreateClusterSnapshotWithResponse(ctx context.Context, id int32, body CreateClusterSnapshotJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateClusterSnapshotResponse, error)
+
+	// DeleteClusterSnapshotWithResponse request
+	DeleteClusterSnapshotWithResponse(ctx context.Context, id int32, snapshotId int64, reqEditors ...RequestEditorFn) (*DeleteClusterSnapshotResponse, error)
+
+	// RestoreClusterSnapshotWithResponse request
+	RestoreClusterSnapshotWithResponse(ctx context.Context, id int32, snapshotId int64, reqEditors ...RequestEditorFn) (*RestoreClusterSnapshotResponse, error)
+
+	// ListClusterUpgradesWithResponse request
+	ListClusterUpgradesWithResponse(ctx context.Context, id int32, reqEditors ...RequestEditorFn) (*ListClusterUpgradesResponse, error)
+
+	// CreateClusterUpgradeWithBodyWithResponse request with any body
+	CreateClusterUpgradeWithBodyWithResponse(ctx context.Context, id int32, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateClusterUpgradeResponse, error)
+
+	CreateClusterUpgradeWithResponse(ctx context.Context, id int32, body CreateClusterUpgradeJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateClusterUpgradeResponse, error)
+
+	// GetClusterUpgradeWithResponse request
+	GetClusterUpgradeWithResponse(ctx context.Context, id int32, upgradeID int32, reqEditors ...RequestEditorFn) (*GetClusterUpgradeResponse, error)
+
+	// CancelClusterUpgradeWithResponse request
+	CancelClusterUpgradeWithResponse(ctx context.Context, id int32, upgradeID int32, reqEditors ...RequestEditorFn) (*CancelClusterUpgradeResponse, error)
+
+	// ListClusterVersionHistoryWithResponse request
+	ListClusterVersionHistoryWithResponse(ctx context.Context, id int32, reqEditors ...RequestEditorFn) (*ListClusterVersionHistoryResponse, error)
+
+	// ListDatabasesWithResponse request
+	ListDatabasesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListDatabasesResponse, error)
+
+	// ImportDatabaseWithBodyWithResponse request with any body
+	ImportDatabaseWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ImportDatabaseResponse, error)
+
+	ImportDatabaseWithResponse(ctx context.Context, body ImportDatabaseJSONRequestBody, reqEditors ...RequestEditorFn) (*ImportDatabaseResponse, error)
+
+	// TestDatabaseConnectionWithBodyWithResponse request with any body
+	TestDatabaseConnectionWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*TestDatabaseConnectionResponse, error)
+
+	TestDatabaseConnectionWithResponse(ctx context.Context, body TestDatabaseConnectionJSONRequestBody, reqEditors ...RequestEditorFn) (*TestDatabaseConnectionResponse, error)
+
+	// DeleteDatabaseWithResponse request
+	DeleteDatabaseWithResponse(ctx context.Context, id int32, reqEditors ...RequestEditorFn) (*DeleteDatabaseResponse, error)
+
+	// GetDatabaseWithResponse request
+	GetDatabaseWithResponse(ctx context.Context, id int32, params *GetDatabaseParams, reqEditors ...RequestEditorFn) (*GetDatabaseResponse, error)
+
+	// UpdateDatabaseWithBodyWithResponse request with any body
+	UpdateDatabaseWithBodyWithResponse(ctx context.Context, id int32, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateDatabaseResponse, error)
+
+	UpdateDatabaseWithResponse(ctx context.Context, id int32, body UpdateDatabaseJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateDatabaseResponse, error)
+
+	// ListDatabaseCatalogChangesWithResponse request
+	ListDatabaseCatalogChangesWithResponse(ctx context.Context, id int32, params *ListDatabaseCatalogChangesParams, reqEditors ...RequestEditorFn) (*ListDatabaseCatalogChangesResponse, error)
+
+	// GetDDLProgressWithResponse request
+	GetDDLProgressWithResponse(ctx context.Context, id int32, reqEditors ...RequestEditorFn) (*GetDDLProgressResponse, error)
+
+	// CancelDDLProgressWithResponse request
+	CancelDDLProgressWithResponse(ctx context.Context, id int32, ddlID int64, reqEditors ...RequestEditorFn) (*CancelDDLProgressResponse, error)
+
+	// AnalyzeDatabaseImpactWithBodyWithResponse request with any body
+	AnalyzeDatabaseImpactWithBodyWithResponse(ctx context.Context, id int32, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AnalyzeDatabaseImpactResponse, error)
+
+	AnalyzeDatabaseImpactWithResponse(ctx context.Context, id int32, body AnalyzeDatabaseImpactJSONRequestBody, reqEditors ...RequestEditorFn) (*AnalyzeDatabaseImpactResponse, error)
+
+	// GetDatabaseLineageWithResponse request
+	GetDatabaseLineageWithResponse(ctx context.Context, id int32, params *GetDatabaseLineageParams, reqEditors ...RequestEditorFn) (*GetDatabaseLineageResponse, error)
+
+	// ExportDatabaseLineageWithResponse request
+	ExportDatabaseLineageWithResponse(ctx context.Context, id int32, params *ExportDatabaseLineageParams, reqEditors ...RequestEditorFn) (*ExportDatabaseLineageResponse, error)
+
+	// QueryDatabaseWithBodyWithResponse request with any body
+	QueryDatabaseWithBodyWithResponse(ctx context.Context, id int32, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*QueryDatabaseResponse, error)
+
+	QueryDatabaseWithResponse(ctx context.Context, id int32, body QueryDatabaseJSONRequestBody, reqEditors ...RequestEditorFn) (*QueryDatabaseResponse, error)
+
+	// ListDatabaseRelationsWithResponse request
+	ListDatabaseRelationsWithResponse(ctx context.Context, id int32, params *ListDatabaseRelationsParams, reqEditors ...RequestEditorFn) (*ListDatabaseRelationsResponse, error)
+
+	// ListDiagnosticRulesWithResponse request
+	ListDiagnosticRulesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListDiagnosticRulesResponse, error)
+
+	// UpdateDiagnosticRuleWithBodyWithResponse request with any body
+	UpdateDiagnosticRuleWithBodyWithResponse(ctx context.Context, rule DiagnosticRuleName, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateDiagnosticRuleResponse, error)
+
+	UpdateDiagnosticRuleWithResponse(ctx context.Context, rule DiagnosticRuleName, body UpdateDiagnosticRuleJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateDiagnosticRuleResponse, error)
+
+	// SearchDiagnosticsWithResponse request
+	SearchDiagnosticsWithResponse(ctx context.Context, params *SearchDiagnosticsParams, reqEditors ...RequestEditorFn) (*SearchDiagnosticsResponse, error)
+
+	// ListEventsWithResponse request
+	ListEventsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListEventsResponse, error)
+
+	// ListMetricsStoresWithResponse request
+	ListMetricsStoresWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListMetricsStoresResponse, error)
+
+	// ImportMetricsStoreWithBodyWithResponse request with any body
+	ImportMetricsStoreWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ImportMetricsStoreResponse, error)
+
+	ImportMetricsStoreWithResponse(ctx context.Context, body ImportMetricsStoreJSONRequestBody, reqEditors ...RequestEditorFn) (*ImportMetricsStoreResponse, error)
+
+	// DeleteMetricsStoreWithResponse request
+	DeleteMetricsStoreWithResponse(ctx context.Context, id int32, params *DeleteMetricsStoreParams, reqEditors ...RequestEditorFn) (*DeleteMetricsStoreResponse, error)
+
+	// GetMetricsStoreWithResponse request
+	GetMetricsStoreWithResponse(ctx context.Context, id int32, reqEditors ...RequestEditorFn) (*GetMetricsStoreResponse, error)
+
+	// UpdateMetricsStoreWithBodyWithResponse request with any body
+	UpdateMetricsStoreWithBodyWithResponse(ctx context.Context, id int32, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateMetricsStoreResponse, error)
+
+	UpdateMetricsStoreWithResponse(ctx context.Context, id int32, body UpdateMetricsStoreJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateMetricsStoreResponse, error)
+
+	// GetMaterializedViewThroughputWithResponse request
+	GetMaterializedViewThroughputWithResponse(ctx context.Context, clusterID int32, reqEditors ...RequestEditorFn) (*GetMaterializedViewThroughputResponse, error)
+
+	// GetOrgSettingsWithResponse request
+	GetOrgSettingsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetOrgSettingsResponse, error)
+
+	// UpdateOrgSettingsWithBodyWithResponse request with any body
+	UpdateOrgSettingsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateOrgSettingsResponse, error)
+
+	UpdateOrgSettingsWithResponse(ctx context.Context, body UpdateOrgSettingsJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateOrgSettingsResponse, error)
+
+	// UploadRisectlWithBodyWithResponse request with any body
+	UploadRisectlWithBodyWithResponse(ctx context.Context, version string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UploadRisectlResponse, error)
+
+	// ListTasksWithResponse request
+	ListTasksWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListTasksResponse, error)
+
+	// TestClusterConnectionWithBodyWithResponse request with any body
+	TestClusterConnectionWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*TestClusterConnectionResponse, error)
+
+	TestClusterConnectionWithResponse(ctx context.Context, body TestClusterConnectionJSONRequestBody, reqEditors ...RequestEditorFn) (*TestClusterConnectionResponse, error)
+}
+
+type ListAlertRulesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]AlertRule
+}
+
+// Status returns HTTPResponse.Status
+func (r ListAlertRulesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListAlertRulesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateAlertRuleResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *AlertRule
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateAlertRuleResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateAlertRuleResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteAlertRuleResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteAlertRuleResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteAlertRuleResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetAlertRuleResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *AlertRule
+}
+
+// Status returns HTTPResponse.Status
+func (r GetAlertRuleResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetAlertRuleResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateAlertRuleResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *AlertRule
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateAlertRuleResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateAlertRuleResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListAlertsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]Alert
+}
+
+// Status returns HTTPResponse.Status
+func (r ListAlertsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListAlertsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListAlertHistoryResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]AlertHistoryEntry
+}
+
+// Status returns HTTPResponse.Status
+func (r ListAlertHistoryResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListAlertHistoryResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListClusterVersionsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]string
+}
+
+// Status returns HTTPResponse.Status
+func (r ListClusterVersionsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListClusterVersionsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListClustersResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]Cluster
+}
+
+// Status returns HTTPResponse.Status
+func (r ListClustersResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListClustersResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateClusterResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *Cluster
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateClusterResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateClusterResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ImportClusterResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *Cluster
+}
+
+// Status returns HTTPResponse.Status
+func (r ImportClusterResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ImportClusterResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteClusterResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteClusterResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteClusterResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetClusterResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Cluster
+}
+
+// Status returns HTTPResponse.Status
+func (r GetClusterResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetClusterResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateClusterResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Cluster
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateClusterResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateClusterResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetClusterAutoBackupConfigResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *AutoBackupConfig
+}
+
+// Status returns HTTPResponse.Status
+func (r GetClusterAutoBackupConfigResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetClusterAutoBackupConfigResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateClusterAutoBackupConfigResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateClusterAutoBackupConfigResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateClusterAutoBackupConfigResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PreviewClusterSnapshotRetentionResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]SnapshotRetentionDecision
+}
+
+// Status returns HTTPResponse.Status
+func (r PreviewClusterSnapshotRetentionResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PreviewClusterSnapshotRetentionResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListClusterDiagnosticBundlesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]DiagnosticBundle
+}
+
+// Status returns HTTPResponse.Status
+func (r ListClusterDiagnosticBundlesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListClusterDiagnosticBundlesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateClusterDiagnosticBundleResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON202      *DiagnosticBundle
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateClusterDiagnosticBundleResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateClusterDiagnosticBundleResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteClusterDiagnosticBundleResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteClusterDiagnosticBundleResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteClusterDiagnosticBundleResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetClusterDiagnosticBundleResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *DiagnosticBundle
+}
+
+// Status returns HTTPResponse.Status
+func (r GetClusterDiagnosticBundleResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetClusterDiagnosticBundleResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DownloadClusterDiagnosticBundleResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r DownloadClusterDiagnosticBundleResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DownloadClusterDiagnosticBundleResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListClusterDiagnosticsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]DiagnosticData
+}
+
+// Status returns HTTPResponse.Status
+func (r ListClusterDiagnosticsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListClusterDiagnosticsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateClusterDiagnosticResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateClusterDiagnosticResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateClusterDiagnosticResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetClusterAutoDiagnosticConfigResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *AutoDiagnosticConfig
+}
+
+// Status returns HTTPResponse.Status
+func (r GetClusterAutoDiagnosticConfigResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetClusterAutoDiagnosticConfigResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateClusterAutoDiagnosticConfigResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateClusterAutoDiagnosticConfigResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateClusterAutoDiagnosticConfigResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DiffClusterDiagnosticsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *DiagnosticDiff
+}
+
+// Status returns HTTPResponse.Status
+func (r DiffClusterDiagnosticsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DiffClusterDiagnosticsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type SearchClusterDiagnosticsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]DiagnosticSearchResult
+}
+
+// Status returns HTTPResponse.Status
+func (r SearchClusterDiagnosticsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SearchClusterDiagnosticsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetClusterDiagnosticResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *DiagnosticData
+}
+
+// Status returns HTTPResponse.Status
+func (r GetClusterDiagnosticResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetClusterDiagnosticResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListClusterDiagnosticFindingsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]ClusterDiagnosticFinding
+}
+
+// Status returns HTTPResponse.Status
+func (r ListClusterDiagnosticFindingsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListClusterDiagnosticFindingsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetClusterDiagnosticReportResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *DiagnosticReport
+}
+
+// Status returns HTTPResponse.Status
+func (r GetClusterDiagnosticReportResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetClusterDiagnosticReportResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListClusterEventsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]ClusterEvent
+}
+
+// Status returns HTTPResponse.Status
+func (r ListClusterEventsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListClusterEventsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListClusterHealthHistoryResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]ClusterHealthRecord
+}
+
+// Status returns HTTPResponse.Status
+func (r ListClusterHealthHistoryResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListClusterHealthHistoryResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type RunRisectlCommandResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *RisectlCommandResult
+}
+
+// Status returns HTTPResponse.Status
+func (r RunRisectlCommandResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RunRisectlCommandResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListRisectlExecutionsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]RisectlExecution
+}
+
+// Status returns HTTPResponse.Status
+func (r ListRisectlExecutionsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListRisectlExecutionsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateRisectlExecutionResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON202      *RisectlExecution
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateRisectlExecutionResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateRisectlExecutionResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetRisectlExecutionResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *RisectlExecution
+}
+
+// Status returns HTTPResponse.Status
+func (r GetRisectlExecutionResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetRisectlExecutionResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CancelRisectlExecutionResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON202      *RisectlExecution
+}
+
+// Status returns HTTPResponse.Status
+func (r CancelRisectlExecutionResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CancelRisectlExecutionResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListRisectlExecutionOutputsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]RisectlExecutionOutput
+}
+
+// Status returns HTTPResponse.Status
+func (r ListRisectlExecutionOutputsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListRisectlExecutionOutputsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type StreamRisectlExecutionResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r StreamRisectlExecutionResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r StreamRisectlExecutionResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListRisectlOperationsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]RisectlOperation
+}
+
+// Status returns HTTPResponse.Status
+func (r ListRisectlOperationsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListRisectlOperationsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type RunRisectlOperationResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *RisectlOperationResult
+}
+
+// Status returns HTTPResponse.Status
+func (r RunRisectlOperationResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RunRisectlOperationResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type OpenRisectlShellResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON101      *RisectlShellMessage
+}
+
+// Status returns HTTPResponse.Status
+func (r OpenRisectlShellResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r OpenRisectlShellResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListRisectlShellSessionsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]RisectlShellSession
+}
+
+// Status returns HTTPResponse.Status
+func (r ListRisectlShellSessionsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListRisectlShellSessionsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListRisectlShellCommandsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]RisectlShellCommand
+}
+
+// Status returns HTTPResponse.Status
+func (r ListRisectlShellCommandsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListRisectlShellCommandsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListClusterSnapshotsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]Snapshot
+}
+
+// Status returns HTTPResponse.Status
+func (r ListClusterSnapshotsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListClusterSnapshotsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateClusterSnapshotResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *Snapshot
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateClusterSnapshotResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateClusterSnapshotResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteClusterSnapshotResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteClusterSnapshotResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteClusterSnapshotResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type RestoreClusterSnapshotResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r RestoreClusterSnapshotResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RestoreClusterSnapshotResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListClusterUpgradesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]ClusterUpgrade
+}
+
+// Status returns HTTPResponse.Status
+func (r ListClusterUpgradesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListClusterUpgradesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateClusterUpgradeResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON202      *ClusterUpgrade
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateClusterUpgradeResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateClusterUpgradeResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetClusterUpgradeResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ClusterUpgrade
+}
+
+// Status returns HTTPResponse.Status
+func (r GetClusterUpgradeResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetClusterUpgradeResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CancelClusterUpgradeResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ClusterUpgrade
+}
+
+// Status returns HTTPResponse.Status
+func (r CancelClusterUpgradeResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CancelClusterUpgradeResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListClusterVersionHistoryResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]ClusterVersionChange
+}
+
+// Status returns HTTPResponse.Status
+func (r ListClusterVersionHistoryResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListClusterVersionHistoryResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListDatabasesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]Database
+}
+
+// Status returns HTTPResponse.Status
+func (r ListDatabasesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListDatabasesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ImportDatabaseResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *Database
+}
+
+// Status returns HTTPResponse.Status
+func (r ImportDatabaseResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ImportDatabaseResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type TestDatabaseConnectionResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TestDatabaseConnectionResult
+}
+
+// Status returns HTTPResponse.Status
+func (r TestDatabaseConnectionResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r TestDatabaseConnectionResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteDatabaseResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteDatabaseResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteDatabaseResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetDatabaseResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Database
+}
+
+// Status returns HTTPResponse.Status
+func (r GetDatabaseResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetDatabaseResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateDatabaseResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Database
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateDatabaseResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateDatabaseResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListDatabaseCatalogChangesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]CatalogChangeEvent
+}
+
+// Status returns HTTPResponse.Status
+func (r ListDatabaseCatalogChangesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListDatabaseCatalogChangesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetDDLProgressResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]DDLProgress
+}
+
+// Status returns HTTPResponse.Status
+func (r GetDDLProgressResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetDDLProgressResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CancelDDLProgressResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r CancelDDLProgressResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CancelDDLProgressResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type AnalyzeDatabaseImpactResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ImpactAnalysis
+}
+
+// Status returns HTTPResponse.Status
+func (r AnalyzeDatabaseImpactResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r AnalyzeDatabaseImpactResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetDatabaseLineageResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *LineageGraph
+}
+
+// Status returns HTTPResponse.Status
+func (r GetDatabaseLineageResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetDatabaseLineageResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ExportDatabaseLineageResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]map[string]interface{}
+}
+
+// Status returns HTTPResponse.Status
+func (r ExportDatabaseLineageResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ExportDatabaseLineageResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type QueryDatabaseResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *QueryResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r QueryDatabaseResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r QueryDatabaseResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListDatabaseRelationsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]RelationSummary
+}
+
+// Status returns HTTPResponse.Status
+func (r ListDatabaseRelationsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListDatabaseRelationsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListDiagnosticRulesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]DiagnosticRule
+}
+
+// Status returns HTTPResponse.Status
+func (r ListDiagnosticRulesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListDiagnosticRulesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateDiagnosticRuleResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *DiagnosticRule
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateDiagnosticRuleResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateDiagnosticRuleResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type SearchDiagnosticsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]DiagnosticSearchResult
+}
+
+// Status returns HTTPResponse.Status
+func (r SearchDiagnosticsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SearchDiagnosticsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListEventsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]Event
+}
+
+// Status returns HTTPResponse.Status
+func (r ListEventsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListEventsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListMetricsStoresResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]MetricsStore
+}
+
+// Status returns HTTPResponse.Status
+func (r ListMetricsStoresResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListMetricsStoresResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ImportMetricsStoreResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *MetricsStore
+}
+
+// Status returns HTTPResponse.Status
+func (r ImportMetricsStoreResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ImportMetricsStoreResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteMetricsStoreResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteMetricsStoreResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteMetricsStoreResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetMetricsStoreResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *MetricsStore
+}
+
+// Status returns HTTPResponse.Status
+func (r GetMetricsStoreResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetMetricsStoreResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateMetricsStoreResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateMetricsStoreResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateMetricsStoreResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetMaterializedViewThroughputResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *MetricMatrix
+}
+
+// Status returns HTTPResponse.Status
+func (r GetMaterializedViewThroughputResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetMaterializedViewThroughputResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetOrgSettingsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *OrgSettings
+}
+
+// Status returns HTTPResponse.Status
+func (r GetOrgSettingsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r DeleteClusterResponse) StatusCode() int {
+func (r GetOrgSettingsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateOrgSettingsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *OrgSettings
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateOrgSettingsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateOrgSettingsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UploadRisectlResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r UploadRisectlResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UploadRisectlResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListTasksResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]Task
+}
+
+// Status returns HTTPResponse.Status
+func (r ListTasksResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListTasksResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type TestClusterConnectionResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TestClusterConnectionResult
+}
+
+// Status returns HTTPResponse.Status
+func (r TestClusterConnectionResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r TestClusterConnectionResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
-	return 0
+	return 0
+}
+
+// ListAlertRulesWithResponse request returning *ListAlertRulesResponse
+func (c *ClientWithResponses) ListAlertRulesWithResponse(ctx context.Context, params *ListAlertRulesParams, reqEditors ...RequestEditorFn) (*ListAlertRulesResponse, error) {
+	rsp, err := c.ListAlertRules(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListAlertRulesResponse(rsp)
+}
+
+// CreateAlertRuleWithBodyWithResponse request with arbitrary body returning *CreateAlertRuleResponse
+func (c *ClientWithResponses) CreateAlertRuleWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateAlertRuleResponse, error) {
+	rsp, err := c.CreateAlertRuleWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateAlertRuleResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateAlertRuleWithResponse(ctx context.Context, body CreateAlertRuleJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateAlertRuleResponse, error) {
+	rsp, err := c.CreateAlertRule(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateAlertRuleResponse(rsp)
+}
+
+// DeleteAlertRuleWithResponse request returning *DeleteAlertRuleResponse
+func (c *ClientWithResponses) DeleteAlertRuleWithResponse(ctx context.Context, id int32, reqEditors ...RequestEditorFn) (*DeleteAlertRuleResponse, error) {
+	rsp, err := c.DeleteAlertRule(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteAlertRuleResponse(rsp)
+}
+
+// GetAlertRuleWithResponse request returning *GetAlertRuleResponse
+func (c *ClientWithResponses) GetAlertRuleWithResponse(ctx context.Context, id int32, reqEditors ...RequestEditorFn) (*GetAlertRuleResponse, error) {
+	rsp, err := c.GetAlertRule(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetAlertRuleResponse(rsp)
+}
+
+// UpdateAlertRuleWithBodyWithResponse request with arbitrary body returning *UpdateAlertRuleResponse
+func (c *ClientWithResponses) UpdateAlertRuleWithBodyWithResponse(ctx context.Context, id int32, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateAlertRuleResponse, error) {
+	rsp, err := c.UpdateAlertRuleWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateAlertRuleResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateAlertRuleWithResponse(ctx context.Context, id int32, body UpdateAlertRuleJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateAlertRuleResponse, error) {
+	rsp, err := c.UpdateAlertRule(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateAlertRuleResponse(rsp)
+}
+
+// ListAlertsWithResponse request returning *ListAlertsResponse
+func (c *ClientWithResponses) ListAlertsWithResponse(ctx context.Context, params *ListAlertsParams, reqEditors ...RequestEditorFn) (*ListAlertsResponse, error) {
+	rsp, err := c.ListAlerts(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListAlertsResponse(rsp)
+}
+
+// ListAlertHistoryWithResponse request returning *ListAlertHistoryResponse
+func (c *ClientWithResponses) ListAlertHistoryWithResponse(ctx context.Context, params *ListAlertHistoryParams, reqEditors ...RequestEditorFn) (*ListAlertHistoryResponse, error) {
+	rsp, err := c.ListAlertHistory(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListAlertHistoryResponse(rsp)
+}
+
+// ListClusterVersionsWithResponse request returning *ListClusterVersionsResponse
+func (c *ClientWithResponses) ListClusterVersionsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListClusterVersionsResponse, error) {
+	rsp, err := c.ListClusterVersions(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListClusterVersionsResponse(rsp)
+}
+
+// ListClustersWithResponse request returning *ListClustersResponse
+func (c *ClientWithResponses) ListClustersWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListClustersResponse, error) {
+	rsp, err := c.ListClusters(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListClustersResponse(rsp)
+}
+
+// CreateClusterWithBodyWithResponse request with arbitrary body returning *CreateClusterResponse
+func (c *ClientWithResponses) CreateClusterWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateClusterResponse, error) {
+	rsp, err := c.CreateClusterWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateClusterResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateClusterWithResponse(ctx context.Context, body CreateClusterJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateClusterResponse, error) {
+	rsp, err := c.CreateCluster(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateClusterResponse(rsp)
+}
+
+// ImportClusterWithBodyWithResponse request with arbitrary body returning *ImportClusterResponse
+func (c *ClientWithResponses) ImportClusterWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ImportClusterResponse, error) {
+	rsp, err := c.ImportClusterWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseImportClusterResponse(rsp)
+}
+
+func (c *ClientWithResponses) ImportClusterWithResponse(ctx context.Context, body ImportClusterJSONRequestBody, reqEditors ...RequestEditorFn) (*ImportClusterResponse, error) {
+	rsp, err := c.ImportCluster(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseImportClusterResponse(rsp)
+}
+
+// DeleteClusterWithResponse request returning *DeleteClusterResponse
+func (c *ClientWithResponses) DeleteClusterWithResponse(ctx context.Context, id int32, params *DeleteClusterParams, reqEditors ...RequestEditorFn) (*DeleteClusterResponse, error) {
+	rsp, err := c.DeleteCluster(ctx, id, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteClusterResponse(rsp)
+}
+
+// GetClusterWithResponse request returning *GetClusterResponse
+func (c *ClientWithResponses) GetClusterWithResponse(ctx context.Context, id int32, reqEditors ...RequestEditorFn) (*GetClusterResponse, error) {
+	rsp, err := c.GetCluster(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetClusterResponse(rsp)
+}
+
+// UpdateClusterWithBodyWithResponse request with arbitrary body returning *UpdateClusterResponse
+func (c *ClientWithResponses) UpdateClusterWithBodyWithResponse(ctx context.Context, id int32, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateClusterResponse, error) {
+	rsp, err := c.UpdateClusterWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateClusterResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateClusterWithResponse(ctx context.Context, id int32, body UpdateClusterJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateClusterResponse, error) {
+	rsp, err := c.UpdateCluster(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateClusterResponse(rsp)
+}
+
+// GetClusterAutoBackupConfigWithResponse request returning *GetClusterAutoBackupConfigResponse
+func (c *ClientWithResponses) GetClusterAutoBackupConfigWithResponse(ctx context.Context, id int32, reqEditors ...RequestEditorFn) (*GetClusterAutoBackupConfigResponse, error) {
+	rsp, err := c.GetClusterAutoBackupConfig(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetClusterAutoBackupConfigResponse(rsp)
+}
+
+// UpdateClusterAutoBackupConfigWithBodyWithResponse request with arbitrary body returning *UpdateClusterAutoBackupConfigResponse
+func (c *ClientWithResponses) UpdateClusterAutoBackupConfigWithBodyWithResponse(ctx context.Context, id int32, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateClusterAutoBackupConfigResponse, error) {
+	rsp, err := c.UpdateClusterAutoBackupConfigWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateClusterAutoBackupConfigResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateClusterAutoBackupConfigWithResponse(ctx context.Context, id int32, body UpdateClusterAutoBackupConfigJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateClusterAutoBackupConfigResponse, error) {
+	rsp, err := c.UpdateClusterAutoBackupConfig(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateClusterAutoBackupConfigResponse(rsp)
+}
+
+// PreviewClusterSnapshotRetentionWithBodyWithResponse request with arbitrary body returning *PreviewClusterSnapshotRetentionResponse
+func (c *ClientWithResponses) PreviewClusterSnapshotRetentionWithBodyWithResponse(ctx context.Context, id int32, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PreviewClusterSnapshotRetentionResponse, error) {
+	rsp, err := c.PreviewClusterSnapshotRetentionWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePreviewClusterSnapshotRetentionResponse(rsp)
+}
+
+func (c *ClientWithResponses) PreviewClusterSnapshotRetentionWithResponse(ctx context.Context, id int32, body PreviewClusterSnapshotRetentionJSONRequestBody, reqEditors ...RequestEditorFn) (*PreviewClusterSnapshotRetentionResponse, error) {
+	rsp, err := c.PreviewClusterSnapshotRetention(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePreviewClusterSnapshotRetentionResponse(rsp)
+}
+
+// ListClusterDiagnosticBundlesWithResponse request returning *ListClusterDiagnosticBundlesResponse
+func (c *ClientWithResponses) ListClusterDiagnosticBundlesWithResponse(ctx context.Context, id int32, reqEditors ...RequestEditorFn) (*ListClusterDiagnosticBundlesResponse, error) {
+	rsp, err := c.ListClusterDiagnosticBundles(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListClusterDiagnosticBundlesResponse(rsp)
+}
+
+// CreateClusterDiagnosticBundleWithResponse request returning *CreateClusterDiagnosticBundleResponse
+func (c *ClientWithResponses) CreateClusterDiagnosticBundleWithResponse(ctx context.Context, id int32, reqEditors ...RequestEditorFn) (*CreateClusterDiagnosticBundleResponse, error) {
+	rsp, err := c.CreateClusterDiagnosticBundle(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateClusterDiagnosticBundleResponse(rsp)
+}
+
+// DeleteClusterDiagnosticBundleWithResponse request returning *DeleteClusterDiagnosticBundleResponse
+func (c *ClientWithResponses) DeleteClusterDiagnosticBundleWithResponse(ctx context.Context, id int32, bundleId int32, reqEditors ...RequestEditorFn) (*DeleteClusterDiagnosticBundleResponse, error) {
+	rsp, err := c.DeleteClusterDiagnosticBundle(ctx, id, bundleId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteClusterDiagnosticBundleResponse(rsp)
+}
+
+// GetClusterDiagnosticBundleWithResponse request returning *GetClusterDiagnosticBundleResponse
+func (c *ClientWithResponses) GetClusterDiagnosticBundleWithResponse(ctx context.Context, id int32, bundleId int32, reqEditors ...RequestEditorFn) (*GetClusterDiagnosticBundleResponse, error) {
+	rsp, err := c.GetClusterDiagnosticBundle(ctx, id, bundleId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetClusterDiagnosticBundleResponse(rsp)
+}
+
+// DownloadClusterDiagnosticBundleWithResponse request returning *DownloadClusterDiagnosticBundleResponse
+func (c *ClientWithResponses) DownloadClusterDiagnosticBundleWithResponse(ctx context.Context, id int32, bundleId int32, reqEditors ...RequestEditorFn) (*DownloadClusterDiagnosticBundleResponse, error) {
+	rsp, err := c.DownloadClusterDiagnosticBundle(ctx, id, bundleId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDownloadClusterDiagnosticBundleResponse(rsp)
+}
+
+// ListClusterDiagnosticsWithResponse request returning *ListClusterDiagnosticsResponse
+func (c *ClientWithResponses) ListClusterDiagnosticsWithResponse(ctx context.Context, id int32, params *ListClusterDiagnosticsParams, reqEditors ...RequestEditorFn) (*ListClusterDiagnosticsResponse, error) {
+	rsp, err := c.ListClusterDiagnostics(ctx, id, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListClusterDiagnosticsResponse(rsp)
+}
+
+// CreateClusterDiagnosticWithBodyWithResponse request with arbitrary body returning *CreateClusterDiagnosticResponse
+func (c *ClientWithResponses) CreateClusterDiagnosticWithBodyWithResponse(ctx context.Context, id int32, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateClusterDiagnosticResponse, error) {
+	rsp, err := c.CreateClusterDiagnosticWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateClusterDiagnosticResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateClusterDiagnosticWithResponse(ctx context.Context, id int32, body CreateClusterDiagnosticJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateClusterDiagnosticResponse, error) {
+	rsp, err := c.CreateClusterDiagnostic(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateClusterDiagnosticResponse(rsp)
+}
+
+// GetClusterAutoDiagnosticConfigWithResponse request returning *GetClusterAutoDiagnosticConfigResponse
+func (c *ClientWithResponses) GetClusterAutoDiagnosticConfigWithResponse(ctx context.Context, id int32, reqEditors ...RequestEditorFn) (*GetClusterAutoDiagnosticConfigResponse, error) {
+	rsp, err := c.GetClusterAutoDiagnosticConfig(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetClusterAutoDiagnosticConfigResponse(rsp)
+}
+
+// UpdateClusterAutoDiagnosticConfigWithBodyWithResponse request with arbitrary body returning *UpdateClusterAutoDiagnosticConfigResponse
+func (c *ClientWithResponses) UpdateClusterAutoDiagnosticConfigWithBodyWithResponse(ctx context.Context, id int32, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateClusterAutoDiagnosticConfigResponse, error) {
+	rsp, err := c.UpdateClusterAutoDiagnosticConfigWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateClusterAutoDiagnosticConfigResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateClusterAutoDiagnosticConfigWithResponse(ctx context.Context, id int32, body UpdateClusterAutoDiagnosticConfigJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateClusterAutoDiagnosticConfigResponse, error) {
+	rsp, err := c.UpdateClusterAutoDiagnosticConfig(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateClusterAutoDiagnosticConfigResponse(rsp)
+}
+
+// DiffClusterDiagnosticsWithResponse request returning *DiffClusterDiagnosticsResponse
+func (c *ClientWithResponses) DiffClusterDiagnosticsWithResponse(ctx context.Context, id int32, params *DiffClusterDiagnosticsParams, reqEditors ...RequestEditorFn) (*DiffClusterDiagnosticsResponse, error) {
+	rsp, err := c.DiffClusterDiagnostics(ctx, id, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDiffClusterDiagnosticsResponse(rsp)
+}
+
+// SearchClusterDiagnosticsWithResponse request returning *SearchClusterDiagnosticsResponse
+func (c *ClientWithResponses) SearchClusterDiagnosticsWithResponse(ctx context.Context, id int32, params *SearchClusterDiagnosticsParams, reqEditors ...RequestEditorFn) (*SearchClusterDiagnosticsResponse, error) {
+	rsp, err := c.SearchClusterDiagnostics(ctx, id, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSearchClusterDiagnosticsResponse(rsp)
+}
+
+// GetClusterDiagnosticWithResponse request returning *GetClusterDiagnosticResponse
+func (c *ClientWithResponses) GetClusterDiagnosticWithResponse(ctx context.Context, id int32, diagnosticId int32, reqEditors ...RequestEditorFn) (*GetClusterDiagnosticResponse, error) {
+	rsp, err := c.GetClusterDiagnostic(ctx, id, diagnosticId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetClusterDiagnosticResponse(rsp)
+}
+
+// ListClusterDiagnosticFindingsWithResponse request returning *ListClusterDiagnosticFindingsResponse
+func (c *ClientWithResponses) ListClusterDiagnosticFindingsWithResponse(ctx context.Context, id int32, diagnosticId int32, reqEditors ...RequestEditorFn) (*ListClusterDiagnosticFindingsResponse, error) {
+	rsp, err := c.ListClusterDiagnosticFindings(ctx, id, diagnosticId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListClusterDiagnosticFindingsResponse(rsp)
+}
+
+// GetClusterDiagnosticReportWithResponse request returning *GetClusterDiagnosticReportResponse
+func (c *ClientWithResponses) GetClusterDiagnosticReportWithResponse(ctx context.Context, id int32, diagnosticId int32, reqEditors ...RequestEditorFn) (*GetClusterDiagnosticReportResponse, error) {
+	rsp, err := c.GetClusterDiagnosticReport(ctx, id, diagnosticId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetClusterDiagnosticReportResponse(rsp)
+}
+
+// ListClusterEventsWithResponse request returning *ListClusterEventsResponse
+func (c *ClientWithResponses) ListClusterEventsWithResponse(ctx context.Context, id int32, params *ListClusterEventsParams, reqEditors ...RequestEditorFn) (*ListClusterEventsResponse, error) {
+	rsp, err := c.ListClusterEvents(ctx, id, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListClusterEventsResponse(rsp)
+}
+
+// ListClusterHealthHistoryWithResponse request returning *ListClusterHealthHistoryResponse
+func (c *ClientWithResponses) ListClusterHealthHistoryWithResponse(ctx context.Context, id int32, params *ListClusterHealthHistoryParams, reqEditors ...RequestEditorFn) (*ListClusterHealthHistoryResponse, error) {
+	rsp, err := c.ListClusterHealthHistory(ctx, id, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListClusterHealthHistoryResponse(rsp)
+}
+
+// RunRisectlCommandWithBodyWithResponse request with arbitrary body returning *RunRisectlCommandResponse
+func (c *ClientWithResponses) RunRisectlCommandWithBodyWithResponse(ctx context.Context, id int32, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RunRisectlCommandResponse, error) {
+	rsp, err := c.RunRisectlCommandWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRunRisectlCommandResponse(rsp)
+}
+
+func (c *ClientWithResponses) RunRisectlCommandWithResponse(ctx context.Context, id int32, body RunRisectlCommandJSONRequestBody, reqEditors ...RequestEditorFn) (*RunRisectlCommandResponse, error) {
+	rsp, err := c.RunRisectlCommand(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRunRisectlCommandResponse(rsp)
+}
+
+// ListRisectlExecutionsWithResponse request returning *ListRisectlExecutionsResponse
+func (c *ClientWithResponses) ListRisectlExecutionsWithResponse(ctx context.Context, id int32, params *ListRisectlExecutionsParams, reqEditors ...RequestEditorFn) (*ListRisectlExecutionsResponse, error) {
+	rsp, err := c.ListRisectlExecutions(ctx, id, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListRisectlExecutionsResponse(rsp)
+}
+
+// CreateRisectlExecutionWithBodyWithResponse request with arbitrary body returning *CreateRisectlExecutionResponse
+func (c *ClientWithResponses) CreateRisectlExecutionWithBodyWithResponse(ctx context.Context, id int32, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateRisectlExecutionResponse, error) {
+	rsp, err := c.CreateRisectlExecutionWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateRisectlExecutionResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateRisectlExecutionWithResponse(ctx context.Context, id int32, body CreateRisectlExecutionJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateRisectlExecutionResponse, error) {
+	rsp, err := c.CreateRisectlExecution(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateRisectlExecutionResponse(rsp)
+}
+
+// GetRisectlExecutionWithResponse request returning *GetRisectlExecutionResponse
+func (c *ClientWithResponses) GetRisectlExecutionWithResponse(ctx context.Context, id int32, executionID int32, reqEditors ...RequestEditorFn) (*GetRisectlExecutionResponse, error) {
+	rsp, err := c.GetRisectlExecution(ctx, id, executionID, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetRisectlExecutionResponse(rsp)
+}
+
+// CancelRisectlExecutionWithResponse request returning *CancelRisectlExecutionResponse
+func (c *ClientWithResponses) CancelRisectlExecutionWithResponse(ctx context.Context, id int32, executionID int32, reqEditors ...RequestEditorFn) (*CancelRisectlExecutionResponse, error) {
+	rsp, err := c.CancelRisectlExecution(ctx, id, executionID, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCancelRisectlExecutionResponse(rsp)
+}
+
+// ListRisectlExecutionOutputsWithResponse request returning *ListRisectlExecutionOutputsResponse
+func (c *ClientWithResponses) ListRisectlExecutionOutputsWithResponse(ctx context.Context, id int32, executionID int32, params *ListRisectlExecutionOutputsParams, reqEditors ...RequestEditorFn) (*ListRisectlExecutionOutputsResponse, error) {
+	rsp, err := c.ListRisectlExecutionOutputs(ctx, id, executionID, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListRisectlExecutionOutputsResponse(rsp)
+}
+
+// StreamRisectlExecutionWithResponse request returning *StreamRisectlExecutionResponse
+func (c *ClientWithResponses) StreamRisectlExecutionWithResponse(ctx context.Context, id int32, executionID int32, params *StreamRisectlExecutionParams, reqEditors ...RequestEditorFn) (*StreamRisectlExecutionResponse, error) {
+	rsp, err := c.StreamRisectlExecution(ctx, id, executionID, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseStreamRisectlExecutionResponse(rsp)
+}
+
+// ListRisectlOperationsWithResponse request returning *ListRisectlOperationsResponse
+func (c *ClientWithResponses) ListRisectlOperationsWithResponse(ctx context.Context, id int32, reqEditors ...RequestEditorFn) (*ListRisectlOperationsResponse, error) {
+	rsp, err := c.ListRisectlOperations(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListRisectlOperationsResponse(rsp)
+}
+
+// RunRisectlOperationWithResponse request returning *RunRisectlOperationResponse
+func (c *ClientWithResponses) RunRisectlOperationWithResponse(ctx context.Context, id int32, operation RisectlOperationName, reqEditors ...RequestEditorFn) (*RunRisectlOperationResponse, error) {
+	rsp, err := c.RunRisectlOperation(ctx, id, operation, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRunRisectlOperationResponse(rsp)
+}
+
+// OpenRisectlShellWithResponse request returning *OpenRisectlShellResponse
+func (c *ClientWithResponses) OpenRisectlShellWithResponse(ctx context.Context, id int32, reqEditors ...RequestEditorFn) (*OpenRisectlShellResponse, error) {
+	rsp, err := c.OpenRisectlShell(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseOpenRisectlShellResponse(rsp)
+}
+
+// ListRisectlShellSessionsWithResponse request returning *ListRisectlShellSessionsResponse
+func (c *ClientWithResponses) ListRisectlShellSessionsWithResponse(ctx context.Context, id int32, reqEditors ...RequestEditorFn) (*ListRisectlShellSessionsResponse, error) {
+	rsp, err := c.ListRisectlShellSessions(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListRisectlShellSessionsResponse(rsp)
+}
+
+// ListRisectlShellCommandsWithResponse request returning *ListRisectlShellCommandsResponse
+func (c *ClientWithResponses) ListRisectlShellCommandsWithResponse(ctx context.Context, id int32, sessionID int32, reqEditors ...RequestEditorFn) (*ListRisectlShellCommandsResponse, error) {
+	rsp, err := c.ListRisectlShellCommands(ctx, id, sessionID, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListRisectlShellCommandsResponse(rsp)
+}
+
+// ListClusterSnapshotsWithResponse request returning *ListClusterSnapshotsResponse
+func (c *ClientWithResponses) ListClusterSnapshotsWithResponse(ctx context.Context, id int32, reqEditors ...RequestEditorFn) (*ListClusterSnapshotsResponse, error) {
+	rsp, err := c.ListClusterSnapshots(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListClusterSnapshotsResponse(rsp)
+}
+
+// CreateClusterSnapshotWithBodyWithResponse request with arbitrary body returning *CreateClusterSnapshotResponse
+func (c *ClientWithResponses) CreateClusterSnapshotWithBodyWithResponse(ctx context.Context, id int32, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateClusterSnapshotResponse, error) {
+	rsp, err := c.CreateClusterSnapshotWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateClusterSnapshotResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateClusterSnapshotWithResponse(ctx context.Context, id int32, body CreateClusterSnapshotJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateClusterSnapshotResponse, error) {
+	rsp, err := c.CreateClusterSnapshot(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateClusterSnapshotResponse(rsp)
+}
+
+// DeleteClusterSnapshotWithResponse request returning *DeleteClusterSnapshotResponse
+func (c *ClientWithResponses) DeleteClusterSnapshotWithResponse(ctx context.Context, id int32, snapshotId int64, reqEditors ...RequestEditorFn) (*DeleteClusterSnapshotResponse, error) {
+	rsp, err := c.DeleteClusterSnapshot(ctx, id, snapshotId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteClusterSnapshotResponse(rsp)
+}
+
+// RestoreClusterSnapshotWithResponse request returning *RestoreClusterSnapshotResponse
+func (c *ClientWithResponses) RestoreClusterSnapshotWithResponse(ctx context.Context, id int32, snapshotId int64, reqEditors ...RequestEditorFn) (*RestoreClusterSnapshotResponse, error) {
+	rsp, err := c.RestoreClusterSnapshot(ctx, id, snapshotId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRestoreClusterSnapshotResponse(rsp)
+}
+
+// ListClusterUpgradesWithResponse request returning *ListClusterUpgradesResponse
+func (c *ClientWithResponses) ListClusterUpgradesWithResponse(ctx context.Context, id int32, reqEditors ...RequestEditorFn) (*ListClusterUpgradesResponse, error) {
+	rsp, err := c.ListClusterUpgrades(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListClusterUpgradesResponse(rsp)
+}
+
+// CreateClusterUpgradeWithBodyWithResponse request with arbitrary body returning *CreateClusterUpgradeResponse
+func (c *ClientWithResponses) CreateClusterUpgradeWithBodyWithResponse(ctx context.Context, id int32, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateClusterUpgradeResponse, error) {
+	rsp, err := c.CreateClusterUpgradeWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateClusterUpgradeResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateClusterUpgradeWithResponse(ctx context.Context, id int32, body CreateClusterUpgradeJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateClusterUpgradeResponse, error) {
+	rsp, err := c.CreateClusterUpgrade(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateClusterUpgradeResponse(rsp)
+}
+
+// GetClusterUpgradeWithResponse request returning *GetClusterUpgradeResponse
+func (c *ClientWithResponses) GetClusterUpgradeWithResponse(ctx context.Context, id int32, upgradeID int32, reqEditors ...RequestEditorFn) (*GetClusterUpgradeResponse, error) {
+	rsp, err := c.GetClusterUpgrade(ctx, id, upgradeID, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetClusterUpgradeResponse(rsp)
+}
+
+// CancelClusterUpgradeWithResponse request returning *CancelClusterUpgradeResponse
+func (c *ClientWithResponses) CancelClusterUpgradeWithResponse(ctx context.Context, id int32, upgradeID int32, reqEditors ...RequestEditorFn) (*CancelClusterUpgradeResponse, error) {
+	rsp, err := c.CancelClusterUpgrade(ctx, id, upgradeID, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCancelClusterUpgradeResponse(rsp)
+}
+
+// ListClusterVersionHistoryWithResponse request returning *ListClusterVersionHistoryResponse
+func (c *ClientWithResponses) ListClusterVersionHistoryWithResponse(ctx context.Context, id int32, reqEditors ...RequestEditorFn) (*ListClusterVersionHistoryResponse, error) {
+	rsp, err := c.ListClusterVersionHistory(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListClusterVersionHistoryResponse(rsp)
+}
+
+// ListDatabasesWithResponse request returning *ListDatabasesResponse
+func (c *ClientWithResponses) ListDatabasesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListDatabasesResponse, error) {
+	rsp, err := c.ListDatabases(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListDatabasesResponse(rsp)
+}
+
+// ImportDatabaseWithBodyWithResponse request with arbitrary body returning *ImportDatabaseResponse
+func (c *ClientWithResponses) ImportDatabaseWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ImportDatabaseResponse, error) {
+	rsp, err := c.ImportDatabaseWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseImportDatabaseResponse(rsp)
+}
+
+func (c *ClientWithResponses) ImportDatabaseWithResponse(ctx context.Context, body ImportDatabaseJSONRequestBody, reqEditors ...RequestEditorFn) (*ImportDatabaseResponse, error) {
+	rsp, err := c.ImportDatabase(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseImportDatabaseResponse(rsp)
+}
+
+// TestDatabaseConnectionWithBodyWithResponse request with arbitrary body returning *TestDatabaseConnectionResponse
+func (c *ClientWithResponses) TestDatabaseConnectionWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*TestDatabaseConnectionResponse, error) {
+	rsp, err := c.TestDatabaseConnectionWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseTestDatabaseConnectionResponse(rsp)
+}
+
+func (c *ClientWithResponses) TestDatabaseConnectionWithResponse(ctx context.Context, body TestDatabaseConnectionJSONRequestBody, reqEditors ...RequestEditorFn) (*TestDatabaseConnectionResponse, error) {
+	rsp, err := c.TestDatabaseConnection(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseTestDatabaseConnectionResponse(rsp)
+}
+
+// DeleteDatabaseWithResponse request returning *DeleteDatabaseResponse
+func (c *ClientWithResponses) DeleteDatabaseWithResponse(ctx context.Context, id int32, reqEditors ...RequestEditorFn) (*DeleteDatabaseResponse, error) {
+	rsp, err := c.DeleteDatabase(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteDatabaseResponse(rsp)
+}
+
+// GetDatabaseWithResponse request returning *GetDatabaseResponse
+func (c *ClientWithResponses) GetDatabaseWithResponse(ctx context.Context, id int32, params *GetDatabaseParams, reqEditors ...RequestEditorFn) (*GetDatabaseResponse, error) {
+	rsp, err := c.GetDatabase(ctx, id, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetDatabaseResponse(rsp)
+}
+
+// UpdateDatabaseWithBodyWithResponse request with arbitrary body returning *UpdateDatabaseResponse
+func (c *ClientWithResponses) UpdateDatabaseWithBodyWithResponse(ctx context.Context, id int32, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateDatabaseResponse, error) {
+	rsp, err := c.UpdateDatabaseWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateDatabaseResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateDatabaseWithResponse(ctx context.Context, id int32, body UpdateDatabaseJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateDatabaseResponse, error) {
+	rsp, err := c.UpdateDatabase(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateDatabaseResponse(rsp)
+}
+
+// ListDatabaseCatalogChangesWithResponse request returning *ListDatabaseCatalogChangesResponse
+func (c *ClientWithResponses) ListDatabaseCatalogChangesWithResponse(ctx context.Context, id int32, params *ListDatabaseCatalogChangesParams, reqEditors ...RequestEditorFn) (*ListDatabaseCatalogChangesResponse, error) {
+	rsp, err := c.ListDatabaseCatalogChanges(ctx, id, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListDatabaseCatalogChangesResponse(rsp)
+}
+
+// GetDDLProgressWithResponse request returning *GetDDLProgressResponse
+func (c *ClientWithResponses) GetDDLProgressWithResponse(ctx context.Context, id int32, reqEditors ...RequestEditorFn) (*GetDDLProgressResponse, error) {
+	rsp, err := c.GetDDLProgress(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetDDLProgressResponse(rsp)
+}
+
+// CancelDDLProgressWithResponse request returning *CancelDDLProgressResponse
+func (c *ClientWithResponses) CancelDDLProgressWithResponse(ctx context.Context, id int32, ddlID int64, reqEditors ...RequestEditorFn) (*CancelDDLProgressResponse, error) {
+	rsp, err := c.CancelDDLProgress(ctx, id, ddlID, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCancelDDLProgressResponse(rsp)
+}
+
+// AnalyzeDatabaseImpactWithBodyWithResponse request with arbitrary body returning *AnalyzeDatabaseImpactResponse
+func (c *ClientWithResponses) AnalyzeDatabaseImpactWithBodyWithResponse(ctx context.Context, id int32, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*AnalyzeDatabaseImpactResponse, error) {
+	rsp, err := c.AnalyzeDatabaseImpactWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAnalyzeDatabaseImpactResponse(rsp)
+}
+
+func (c *ClientWithResponses) AnalyzeDatabaseImpactWithResponse(ctx context.Context, id int32, body AnalyzeDatabaseImpactJSONRequestBody, reqEditors ...RequestEditorFn) (*AnalyzeDatabaseImpactResponse, error) {
+	rsp, err := c.AnalyzeDatabaseImpact(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAnalyzeDatabaseImpactResponse(rsp)
+}
+
+// GetDatabaseLineageWithResponse request returning *GetDatabaseLineageResponse
+func (c *ClientWithResponses) GetDatabaseLineageWithResponse(ctx context.Context, id int32, params *GetDatabaseLineageParams, reqEditors ...RequestEditorFn) (*GetDatabaseLineageResponse, error) {
+	rsp, err := c.GetDatabaseLineage(ctx, id, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetDatabaseLineageResponse(rsp)
+}
+
+// ExportDatabaseLineageWithResponse request returning *ExportDatabaseLineageResponse
+func (c *ClientWithResponses) ExportDatabaseLineageWithResponse(ctx context.Context, id int32, params *ExportDatabaseLineageParams, reqEditors ...RequestEditorFn) (*ExportDatabaseLineageResponse, error) {
+	rsp, err := c.ExportDatabaseLineage(ctx, id, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseExportDatabaseLineageResponse(rsp)
+}
+
+// QueryDatabaseWithBodyWithResponse request with arbitrary body returning *QueryDatabaseResponse
+func (c *ClientWithResponses) QueryDatabaseWithBodyWithResponse(ctx context.Context, id int32, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*QueryDatabaseResponse, error) {
+	rsp, err := c.QueryDatabaseWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseQueryDatabaseResponse(rsp)
+}
+
+func (c *ClientWithResponses) QueryDatabaseWithResponse(ctx context.Context, id int32, body QueryDatabaseJSONRequestBody, reqEditors ...RequestEditorFn) (*QueryDatabaseResponse, error) {
+	rsp, err := c.QueryDatabase(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseQueryDatabaseResponse(rsp)
+}
+
+// ListDatabaseRelationsWithResponse request returning *ListDatabaseRelationsResponse
+func (c *ClientWithResponses) ListDatabaseRelationsWithResponse(ctx context.Context, id int32, params *ListDatabaseRelationsParams, reqEditors ...RequestEditorFn) (*ListDatabaseRelationsResponse, error) {
+	rsp, err := c.ListDatabaseRelations(ctx, id, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListDatabaseRelationsResponse(rsp)
+}
+
+// ListDiagnosticRulesWithResponse request returning *ListDiagnosticRulesResponse
+func (c *ClientWithResponses) ListDiagnosticRulesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListDiagnosticRulesResponse, error) {
+	rsp, err := c.ListDiagnosticRules(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListDiagnosticRulesResponse(rsp)
+}
+
+// UpdateDiagnosticRuleWithBodyWithResponse request with arbitrary body returning *UpdateDiagnosticRuleResponse
+func (c *ClientWithResponses) UpdateDiagnosticRuleWithBodyWithResponse(ctx context.Context, rule DiagnosticRuleName, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateDiagnosticRuleResponse, error) {
+	rsp, err := c.UpdateDiagnosticRuleWithBody(ctx, rule, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateDiagnosticRuleResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateDiagnosticRuleWithResponse(ctx context.Context, rule DiagnosticRuleName, body UpdateDiagnosticRuleJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateDiagnosticRuleResponse, error) {
+	rsp, err := c.UpdateDiagnosticRule(ctx, rule, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateDiagnosticRuleResponse(rsp)
+}
+
+// SearchDiagnosticsWithResponse request returning *SearchDiagnosticsResponse
+func (c *ClientWithResponses) SearchDiagnosticsWithResponse(ctx context.Context, params *SearchDiagnosticsParams, reqEditors ...RequestEditorFn) (*SearchDiagnosticsResponse, error) {
+	rsp, err := c.SearchDiagnostics(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSearchDiagnosticsResponse(rsp)
+}
+
+// ListEventsWithResponse request returning *ListEventsResponse
+func (c *ClientWithResponses) ListEventsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListEventsResponse, error) {
+	rsp, err := c.ListEvents(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListEventsResponse(rsp)
+}
+
+// ListMetricsStoresWithResponse request returning *ListMetricsStoresResponse
+func (c *ClientWithResponses) ListMetricsStoresWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListMetricsStoresResponse, error) {
+	rsp, err := c.ListMetricsStores(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListMetricsStoresResponse(rsp)
+}
+
+// ImportMetricsStoreWithBodyWithResponse request with arbitrary body returning *ImportMetricsStoreResponse
+func (c *ClientWithResponses) ImportMetricsStoreWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ImportMetricsStoreResponse, error) {
+	rsp, err := c.ImportMetricsStoreWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseImportMetricsStoreResponse(rsp)
+}
+
+func (c *ClientWithResponses) ImportMetricsStoreWithResponse(ctx context.Context, body ImportMetricsStoreJSONRequestBody, reqEditors ...RequestEditorFn) (*ImportMetricsStoreResponse, error) {
+	rsp, err := c.ImportMetricsStore(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseImportMetricsStoreResponse(rsp)
+}
+
+// DeleteMetricsStoreWithResponse request returning *DeleteMetricsStoreResponse
+func (c *ClientWithResponses) DeleteMetricsStoreWithResponse(ctx context.Context, id int32, params *DeleteMetricsStoreParams, reqEditors ...RequestEditorFn) (*DeleteMetricsStoreResponse, error) {
+	rsp, err := c.DeleteMetricsStore(ctx, id, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteMetricsStoreResponse(rsp)
+}
+
+// GetMetricsStoreWithResponse request returning *GetMetricsStoreResponse
+func (c *ClientWithResponses) GetMetricsStoreWithResponse(ctx context.Context, id int32, reqEditors ...RequestEditorFn) (*GetMetricsStoreResponse, error) {
+	rsp, err := c.GetMetricsStore(ctx, id, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetMetricsStoreResponse(rsp)
+}
+
+// UpdateMetricsStoreWithBodyWithResponse request with arbitrary body returning *UpdateMetricsStoreResponse
+func (c *ClientWithResponses) UpdateMetricsStoreWithBodyWithResponse(ctx context.Context, id int32, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateMetricsStoreResponse, error) {
+	rsp, err := c.UpdateMetricsStoreWithBody(ctx, id, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateMetricsStoreResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateMetricsStoreWithResponse(ctx context.Context, id int32, body UpdateMetricsStoreJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateMetricsStoreResponse, error) {
+	rsp, err := c.UpdateMetricsStore(ctx, id, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateMetricsStoreResponse(rsp)
+}
+
+// GetMaterializedViewThroughputWithResponse request returning *GetMaterializedViewThroughputResponse
+func (c *ClientWithResponses) GetMaterializedViewThroughputWithResponse(ctx context.Context, clusterID int32, reqEditors ...RequestEditorFn) (*GetMaterializedViewThroughputResponse, error) {
+	rsp, err := c.GetMaterializedViewThroughput(ctx, clusterID, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetMaterializedViewThroughputResponse(rsp)
+}
+
+// GetOrgSettingsWithResponse request returning *GetOrgSettingsResponse
+func (c *ClientWithResponses) GetOrgSettingsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetOrgSettingsResponse, error) {
+	rsp, err := c.GetOrgSettings(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetOrgSettingsResponse(rsp)
+}
+
+// UpdateOrgSettingsWithBodyWithResponse request with arbitrary body returning *UpdateOrgSettingsResponse
+func (c *ClientWithResponses) UpdateOrgSettingsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateOrgSettingsResponse, error) {
+	rsp, err := c.UpdateOrgSettingsWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateOrgSettingsResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateOrgSettingsWithResponse(ctx context.Context, body UpdateOrgSettingsJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateOrgSettingsResponse, error) {
+	rsp, err := c.UpdateOrgSettings(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateOrgSettingsResponse(rsp)
+}
+
+// UploadRisectlWithBodyWithResponse request with arbitrary body returning *UploadRisectlResponse
+func (c *ClientWithResponses) UploadRisectlWithBodyWithResponse(ctx context.Context, version string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UploadRisectlResponse, error) {
+	rsp, err := c.UploadRisectlWithBody(ctx, version, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUploadRisectlResponse(rsp)
+}
+
+// ListTasksWithResponse request returning *ListTasksResponse
+func (c *ClientWithResponses) ListTasksWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListTasksResponse, error) {
+	rsp, err := c.ListTasks(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListTasksResponse(rsp)
+}
+
+// TestClusterConnectionWithBodyWithResponse request with arbitrary body returning *TestClusterConnectionResponse
+func (c *ClientWithResponses) TestClusterConnectionWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*TestClusterConnectionResponse, error) {
+	rsp, err := c.TestClusterConnectionWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseTestClusterConnectionResponse(rsp)
+}
+
+func (c *ClientWithResponses) TestClusterConnectionWithResponse(ctx context.Context, body TestClusterConnectionJSONRequestBody, reqEditors ...RequestEditorFn) (*TestClusterConnectionResponse, error) {
+	rsp, err := c.TestClusterConnection(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseTestClusterConnectionResponse(rsp)
+}
+
+// ParseListAlertRulesResponse parses an HTTP response from a ListAlertRulesWithResponse call
+func ParseListAlertRulesResponse(rsp *http.Response) (*ListAlertRulesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListAlertRulesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []AlertRule
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateAlertRuleResponse parses an HTTP response from a CreateAlertRuleWithResponse call
+func ParseCreateAlertRuleResponse(rsp *http.Response) (*CreateAlertRuleResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateAlertRuleResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest AlertRule
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteAlertRuleResponse parses an HTTP response from a DeleteAlertRuleWithResponse call
+func ParseDeleteAlertRuleResponse(rsp *http.Response) (*DeleteAlertRuleResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteAlertRuleResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseGetAlertRuleResponse parses an HTTP response from a GetAlertRuleWithResponse call
+func ParseGetAlertRuleResponse(rsp *http.Response) (*GetAlertRuleResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetAlertRuleResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest AlertRule
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUpdateAlertRuleResponse parses an HTTP response from a UpdateAlertRuleWithResponse call
+func ParseUpdateAlertRuleResponse(rsp *http.Response) (*UpdateAlertRuleResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UpdateAlertRuleResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest AlertRule
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListAlertsResponse parses an HTTP response from a ListAlertsWithResponse call
+func ParseListAlertsResponse(rsp *http.Response) (*ListAlertsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListAlertsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []Alert
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListAlertHistoryResponse parses an HTTP response from a ListAlertHistoryWithResponse call
+func ParseListAlertHistoryResponse(rsp *http.Response) (*ListAlertHistoryResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListAlertHistoryResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []AlertHistoryEntry
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListClusterVersionsResponse parses an HTTP response from a ListClusterVersionsWithResponse call
+func ParseListClusterVersionsResponse(rsp *http.Response) (*ListClusterVersionsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListClusterVersionsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []string
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListClustersResponse parses an HTTP response from a ListClustersWithResponse call
+func ParseListClustersResponse(rsp *http.Response) (*ListClustersResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListClustersResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []Cluster
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateClusterResponse parses an HTTP response from a CreateClusterWithResponse call
+func ParseCreateClusterResponse(rsp *http.Response) (*CreateClusterResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateClusterResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest Cluster
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseImportClusterResponse parses an HTTP response from a ImportClusterWithResponse call
+func ParseImportClusterResponse(rsp *http.Response) (*ImportClusterResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ImportClusterResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest Cluster
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteClusterResponse parses an HTTP response from a DeleteClusterWithResponse call
+func ParseDeleteClusterResponse(rsp *http.Response) (*DeleteClusterResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteClusterResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseGetClusterResponse parses an HTTP response from a GetClusterWithResponse call
+func ParseGetClusterResponse(rsp *http.Response) (*GetClusterResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetClusterResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Cluster
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUpdateClusterResponse parses an HTTP response from a UpdateClusterWithResponse call
+func ParseUpdateClusterResponse(rsp *http.Response) (*UpdateClusterResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UpdateClusterResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Cluster
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetClusterAutoBackupConfigResponse parses an HTTP response from a GetClusterAutoBackupConfigWithResponse call
+func ParseGetClusterAutoBackupConfigResponse(rsp *http.Response) (*GetClusterAutoBackupConfigResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetClusterAutoBackupConfigResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest AutoBackupConfig
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUpdateClusterAutoBackupConfigResponse parses an HTTP response from a UpdateClusterAutoBackupConfigWithResponse call
+func ParseUpdateClusterAutoBackupConfigResponse(rsp *http.Response) (*UpdateClusterAutoBackupConfigResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UpdateClusterAutoBackupConfigResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParsePreviewClusterSnapshotRetentionResponse parses an HTTP response from a PreviewClusterSnapshotRetentionWithResponse call
+func ParsePreviewClusterSnapshotRetentionResponse(rsp *http.Response) (*PreviewClusterSnapshotRetentionResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PreviewClusterSnapshotRetentionResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []SnapshotRetentionDecision
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListClusterDiagnosticBundlesResponse parses an HTTP response from a ListClusterDiagnosticBundlesWithResponse call
+func ParseListClusterDiagnosticBundlesResponse(rsp *http.Response) (*ListClusterDiagnosticBundlesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListClusterDiagnosticBundlesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []DiagnosticBundle
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateClusterDiagnosticBundleResponse parses an HTTP response from a CreateClusterDiagnosticBundleWithResponse call
+func ParseCreateClusterDiagnosticBundleResponse(rsp *http.Response) (*CreateClusterDiagnosticBundleResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateClusterDiagnosticBundleResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 202:
+		var dest DiagnosticBundle
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON202 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteClusterDiagnosticBundleResponse parses an HTTP response from a DeleteClusterDiagnosticBundleWithResponse call
+func ParseDeleteClusterDiagnosticBundleResponse(rsp *http.Response) (*DeleteClusterDiagnosticBundleResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteClusterDiagnosticBundleResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseGetClusterDiagnosticBundleResponse parses an HTTP response from a GetClusterDiagnosticBundleWithResponse call
+func ParseGetClusterDiagnosticBundleResponse(rsp *http.Response) (*GetClusterDiagnosticBundleResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetClusterDiagnosticBundleResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest DiagnosticBundle
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDownloadClusterDiagnosticBundleResponse parses an HTTP response from a DownloadClusterDiagnosticBundleWithResponse call
+func ParseDownloadClusterDiagnosticBundleResponse(rsp *http.Response) (*DownloadClusterDiagnosticBundleResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DownloadClusterDiagnosticBundleResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseListClusterDiagnosticsResponse parses an HTTP response from a ListClusterDiagnosticsWithResponse call
+func ParseListClusterDiagnosticsResponse(rsp *http.Response) (*ListClusterDiagnosticsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListClusterDiagnosticsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []DiagnosticData
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateClusterDiagnosticResponse parses an HTTP response from a CreateClusterDiagnosticWithResponse call
+func ParseCreateClusterDiagnosticResponse(rsp *http.Response) (*CreateClusterDiagnosticResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateClusterDiagnosticResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseGetClusterAutoDiagnosticConfigResponse parses an HTTP response from a GetClusterAutoDiagnosticConfigWithResponse call
+func ParseGetClusterAutoDiagnosticConfigResponse(rsp *http.Response) (*GetClusterAutoDiagnosticConfigResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetClusterAutoDiagnosticConfigResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest AutoDiagnosticConfig
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUpdateClusterAutoDiagnosticConfigResponse parses an HTTP response from a UpdateClusterAutoDiagnosticConfigWithResponse call
+func ParseUpdateClusterAutoDiagnosticConfigResponse(rsp *http.Response) (*UpdateClusterAutoDiagnosticConfigResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UpdateClusterAutoDiagnosticConfigResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseDiffClusterDiagnosticsResponse parses an HTTP response from a DiffClusterDiagnosticsWithResponse call
+func ParseDiffClusterDiagnosticsResponse(rsp *http.Response) (*DiffClusterDiagnosticsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DiffClusterDiagnosticsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest DiagnosticDiff
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseSearchClusterDiagnosticsResponse parses an HTTP response from a SearchClusterDiagnosticsWithResponse call
+func ParseSearchClusterDiagnosticsResponse(rsp *http.Response) (*SearchClusterDiagnosticsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &SearchClusterDiagnosticsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []DiagnosticSearchResult
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetClusterDiagnosticResponse parses an HTTP response from a GetClusterDiagnosticWithResponse call
+func ParseGetClusterDiagnosticResponse(rsp *http.Response) (*GetClusterDiagnosticResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetClusterDiagnosticResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest DiagnosticData
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListClusterDiagnosticFindingsResponse parses an HTTP response from a ListClusterDiagnosticFindingsWithResponse call
+func ParseListClusterDiagnosticFindingsResponse(rsp *http.Response) (*ListClusterDiagnosticFindingsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListClusterDiagnosticFindingsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []ClusterDiagnosticFinding
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetClusterDiagnosticReportResponse parses an HTTP response from a GetClusterDiagnosticReportWithResponse call
+func ParseGetClusterDiagnosticReportResponse(rsp *http.Response) (*GetClusterDiagnosticReportResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetClusterDiagnosticReportResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest DiagnosticReport
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListClusterEventsResponse parses an HTTP response from a ListClusterEventsWithResponse call
+func ParseListClusterEventsResponse(rsp *http.Response) (*ListClusterEventsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListClusterEventsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []ClusterEvent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListClusterHealthHistoryResponse parses an HTTP response from a ListClusterHealthHistoryWithResponse call
+func ParseListClusterHealthHistoryResponse(rsp *http.Response) (*ListClusterHealthHistoryResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListClusterHealthHistoryResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []ClusterHealthRecord
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseRunRisectlCommandResponse parses an HTTP response from a RunRisectlCommandWithResponse call
+func ParseRunRisectlCommandResponse(rsp *http.Response) (*RunRisectlCommandResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &RunRisectlCommandResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest RisectlCommandResult
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListRisectlExecutionsResponse parses an HTTP response from a ListRisectlExecutionsWithResponse call
+func ParseListRisectlExecutionsResponse(rsp *http.Response) (*ListRisectlExecutionsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListRisectlExecutionsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []RisectlExecution
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateRisectlExecutionResponse parses an HTTP response from a CreateRisectlExecutionWithResponse call
+func ParseCreateRisectlExecutionResponse(rsp *http.Response) (*CreateRisectlExecutionResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateRisectlExecutionResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 202:
+		var dest RisectlExecution
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON202 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetRisectlExecutionResponse parses an HTTP response from a GetRisectlExecutionWithResponse call
+func ParseGetRisectlExecutionResponse(rsp *http.Response) (*GetRisectlExecutionResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetRisectlExecutionResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest RisectlExecution
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCancelRisectlExecutionResponse parses an HTTP response from a CancelRisectlExecutionWithResponse call
+func ParseCancelRisectlExecutionResponse(rsp *http.Response) (*CancelRisectlExecutionResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CancelRisectlExecutionResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 202:
+		var dest RisectlExecution
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON202 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListRisectlExecutionOutputsResponse parses an HTTP response from a ListRisectlExecutionOutputsWithResponse call
+func ParseListRisectlExecutionOutputsResponse(rsp *http.Response) (*ListRisectlExecutionOutputsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListRisectlExecutionOutputsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []RisectlExecutionOutput
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseStreamRisectlExecutionResponse parses an HTTP response from a StreamRisectlExecutionWithResponse call
+func ParseStreamRisectlExecutionResponse(rsp *http.Response) (*StreamRisectlExecutionResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &StreamRisectlExecutionResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseListRisectlOperationsResponse parses an HTTP response from a ListRisectlOperationsWithResponse call
+func ParseListRisectlOperationsResponse(rsp *http.Response) (*ListRisectlOperationsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListRisectlOperationsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []RisectlOperation
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseRunRisectlOperationResponse parses an HTTP response from a RunRisectlOperationWithResponse call
+func ParseRunRisectlOperationResponse(rsp *http.Response) (*RunRisectlOperationResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &RunRisectlOperationResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest RisectlOperationResult
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseOpenRisectlShellResponse parses an HTTP response from a OpenRisectlShellWithResponse call
+func ParseOpenRisectlShellResponse(rsp *http.Response) (*OpenRisectlShellResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &OpenRisectlShellResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 101:
+		var dest RisectlShellMessage
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON101 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListRisectlShellSessionsResponse parses an HTTP response from a ListRisectlShellSessionsWithResponse call
+func ParseListRisectlShellSessionsResponse(rsp *http.Response) (*ListRisectlShellSessionsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListRisectlShellSessionsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []RisectlShellSession
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListRisectlShellCommandsResponse parses an HTTP response from a ListRisectlShellCommandsWithResponse call
+func ParseListRisectlShellCommandsResponse(rsp *http.Response) (*ListRisectlShellCommandsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListRisectlShellCommandsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []RisectlShellCommand
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListClusterSnapshotsResponse parses an HTTP response from a ListClusterSnapshotsWithResponse call
+func ParseListClusterSnapshotsResponse(rsp *http.Response) (*ListClusterSnapshotsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListClusterSnapshotsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []Snapshot
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
 }
 
-type GetClusterResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *Cluster
+// ParseCreateClusterSnapshotResponse parses an HTTP response from a CreateClusterSnapshotWithResponse call
+func ParseCreateClusterSnapshotResponse(rsp *http.Response) (*CreateClusterSnapshotResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateClusterSnapshotResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest Snapshot
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	}
+
+	return response, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r GetClusterResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// ParseDeleteClusterSnapshotResponse parses an HTTP response from a DeleteClusterSnapshotWithResponse call
+func ParseDeleteClusterSnapshotResponse(rsp *http.Response) (*DeleteClusterSnapshotResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+
+	response := &DeleteClusterSnapshotResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetClusterResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// ParseRestoreClusterSnapshotResponse parses an HTTP response from a RestoreClusterSnapshotWithResponse call
+func ParseRestoreClusterSnapshotResponse(rsp *http.Response) (*RestoreClusterSnapshotResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return 0
+
+	response := &RestoreClusterSnapshotResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
 }
 
-type UpdateClusterResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *Cluster
+// ParseListClusterUpgradesResponse parses an HTTP response from a ListClusterUpgradesWithResponse call
+func ParseListClusterUpgradesResponse(rsp *http.Response) (*ListClusterUpgradesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListClusterUpgradesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []ClusterUpgrade
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r UpdateClusterResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// ParseCreateClusterUpgradeResponse parses an HTTP response from a CreateClusterUpgradeWithResponse call
+func ParseCreateClusterUpgradeResponse(rsp *http.Response) (*CreateClusterUpgradeResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+
+	response := &CreateClusterUpgradeResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 202:
+		var dest ClusterUpgrade
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON202 = &dest
+
+	}
+
+	return response, nil
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r UpdateClusterResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// ParseGetClusterUpgradeResponse parses an HTTP response from a GetClusterUpgradeWithResponse call
+func ParseGetClusterUpgradeResponse(rsp *http.Response) (*GetClusterUpgradeResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return 0
+
+	response := &GetClusterUpgradeResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ClusterUpgrade
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
 }
 
-type GetClusterAutoBackupConfigResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *AutoBackupConfig
+// ParseCancelClusterUpgradeResponse parses an HTTP response from a CancelClusterUpgradeWithResponse call
+func ParseCancelClusterUpgradeResponse(rsp *http.Response) (*CancelClusterUpgradeResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CancelClusterUpgradeResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ClusterUpgrade
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r GetClusterAutoBackupConfigResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// ParseListClusterVersionHistoryResponse parses an HTTP response from a ListClusterVersionHistoryWithResponse call
+func ParseListClusterVersionHistoryResponse(rsp *http.Response) (*ListClusterVersionHistoryResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+
+	response := &ListClusterVersionHistoryResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []ClusterVersionChange
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListDatabasesResponse parses an HTTP response from a ListDatabasesWithResponse call
+func ParseListDatabasesResponse(rsp *http.Response) (*ListDatabasesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListDatabasesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []Database
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseImportDatabaseResponse parses an HTTP response from a ImportDatabaseWithResponse call
+func ParseImportDatabaseResponse(rsp *http.Response) (*ImportDatabaseResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ImportDatabaseResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest Database
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	}
+
+	return response, nil
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetClusterAutoBackupConfigResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// ParseTestDatabaseConnectionResponse parses an HTTP response from a TestDatabaseConnectionWithResponse call
+func ParseTestDatabaseConnectionResponse(rsp *http.Response) (*TestDatabaseConnectionResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &TestDatabaseConnectionResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TestDatabaseConnectionResult
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	}
-	return 0
-}
 
-type UpdateClusterAutoBackupConfigResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
+	return response, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r UpdateClusterAutoBackupConfigResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// ParseDeleteDatabaseResponse parses an HTTP response from a DeleteDatabaseWithResponse call
+func ParseDeleteDatabaseResponse(rsp *http.Response) (*DeleteDatabaseResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r UpdateClusterAutoBackupConfigResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	response := &DeleteDatabaseResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return 0
-}
 
-type ListClusterDiagnosticsResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *[]DiagnosticData
+	return response, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r ListClusterDiagnosticsResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// ParseGetDatabaseResponse parses an HTTP response from a GetDatabaseWithResponse call
+func ParseGetDatabaseResponse(rsp *http.Response) (*GetDatabaseResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r ListClusterDiagnosticsResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	response := &GetDatabaseResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return 0
-}
 
-type CreateClusterDiagnosticResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-}
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Database
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
 
-// Status returns HTTPResponse.Status
-func (r CreateClusterDiagnosticResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r CreateClusterDiagnosticResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
+	return response, nil
 }
 
-type GetClusterAutoDiagnosticConfigResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *AutoDiagnosticConfig
-}
+// ParseUpdateDatabaseResponse parses an HTTP response from a UpdateDatabaseWithResponse call
+func ParseUpdateDatabaseResponse(rsp *http.Response) (*UpdateDatabaseResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
 
-// Status returns HTTPResponse.Status
-func (r GetClusterAutoDiagnosticConfigResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	response := &UpdateDatabaseResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetClusterAutoDiagnosticConfigResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Database
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	}
-	return 0
-}
 
-type UpdateClusterAutoDiagnosticConfigResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
+	return response, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r UpdateClusterAutoDiagnosticConfigResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// ParseListDatabaseCatalogChangesResponse parses an HTTP response from a ListDatabaseCatalogChangesWithResponse call
+func ParseListDatabaseCatalogChangesResponse(rsp *http.Response) (*ListDatabaseCatalogChangesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r UpdateClusterAutoDiagnosticConfigResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	response := &ListDatabaseCatalogChangesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return 0
-}
 
-type GetClusterDiagnosticResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *DiagnosticData
-}
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []CatalogChangeEvent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
 
-// Status returns HTTPResponse.Status
-func (r GetClusterDiagnosticResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetClusterDiagnosticResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
+	return response, nil
 }
 
-type RunRisectlCommandResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *RisectlCommandResult
-}
+// ParseGetDDLProgressResponse parses an HTTP response from a GetDDLProgressWithResponse call
+func ParseGetDDLProgressResponse(rsp *http.Response) (*GetDDLProgressResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
 
-// Status returns HTTPResponse.Status
-func (r RunRisectlCommandResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	response := &GetDDLProgressResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r RunRisectlCommandResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []DDLProgress
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	}
-	return 0
-}
 
-type ListClusterSnapshotsResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *[]Snapshot
+	return response, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r ListClusterSnapshotsResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// ParseCancelDDLProgressResponse parses an HTTP response from a CancelDDLProgressWithResponse call
+func ParseCancelDDLProgressResponse(rsp *http.Response) (*CancelDDLProgressResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r ListClusterSnapshotsResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	response := &CancelDDLProgressResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return 0
-}
 
-type CreateClusterSnapshotResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON201      *Snapshot
+	return response, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r CreateClusterSnapshotResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// ParseAnalyzeDatabaseImpactResponse parses an HTTP response from a AnalyzeDatabaseImpactWithResponse call
+func ParseAnalyzeDatabaseImpactResponse(rsp *http.Response) (*AnalyzeDatabaseImpactResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r CreateClusterSnapshotResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	response := &AnalyzeDatabaseImpactResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ImpactAnalysis
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	}
-	return 0
-}
 
-type DeleteClusterSnapshotResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
+	return response, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r DeleteClusterSnapshotResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// ParseGetDatabaseLineageResponse parses an HTTP response from a GetDatabaseLineageWithResponse call
+func ParseGetDatabaseLineageResponse(rsp *http.Response) (*GetDatabaseLineageResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r DeleteClusterSnapshotResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	response := &GetDatabaseLineageResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return 0
-}
 
-type RestoreClusterSnapshotResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-}
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest LineageGraph
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
 
-// Status returns HTTPResponse.Status
-func (r RestoreClusterSnapshotResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r RestoreClusterSnapshotResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
+	return response, nil
 }
 
-type ListDatabasesResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *[]Database
-}
+// ParseExportDatabaseLineageResponse parses an HTTP response from a ExportDatabaseLineageWithResponse call
+func ParseExportDatabaseLineageResponse(rsp *http.Response) (*ExportDatabaseLineageResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
 
-// Status returns HTTPResponse.Status
-func (r ListDatabasesResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	response := &ExportDatabaseLineageResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r ListDatabasesResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []map[string]interface{}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case rsp.StatusCode == 200:
+		// Content-type (text/vnd.graphviz) unsupported
+
 	}
-	return 0
-}
 
-type ImportDatabaseResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON201      *Database
+	return response, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r ImportDatabaseResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// ParseQueryDatabaseResponse parses an HTTP response from a QueryDatabaseWithResponse call
+func ParseQueryDatabaseResponse(rsp *http.Response) (*QueryDatabaseResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r ImportDatabaseResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	response := &QueryDatabaseResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return 0
-}
 
-type TestDatabaseConnectionResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *TestDatabaseConnectionResult
-}
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest QueryResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
 
-// Status returns HTTPResponse.Status
-func (r TestDatabaseConnectionResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r TestDatabaseConnectionResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
+	return response, nil
 }
 
-type DeleteDatabaseResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-}
+// ParseListDatabaseRelationsResponse parses an HTTP response from a ListDatabaseRelationsWithResponse call
+func ParseListDatabaseRelationsResponse(rsp *http.Response) (*ListDatabaseRelationsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
 
-// Status returns HTTPResponse.Status
-func (r DeleteDatabaseResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	response := &ListDatabaseRelationsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r DeleteDatabaseResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []RelationSummary
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	}
-	return 0
-}
 
-type GetDatabaseResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *Database
+	return response, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r GetDatabaseResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// ParseListDiagnosticRulesResponse parses an HTTP response from a ListDiagnosticRulesWithResponse call
+func ParseListDiagnosticRulesResponse(rsp *http.Response) (*ListDiagnosticRulesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetDatabaseResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	response := &ListDiagnosticRulesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return 0
-}
 
-type UpdateDatabaseResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *Database
-}
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []DiagnosticRule
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
 
-// Status returns HTTPResponse.Status
-func (r UpdateDatabaseResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r UpdateDatabaseResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
+	return response, nil
 }
 
-type GetDDLProgressResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *[]DDLProgress
-}
+// ParseUpdateDiagnosticRuleResponse parses an HTTP response from a UpdateDiagnosticRuleWithResponse call
+func ParseUpdateDiagnosticRuleResponse(rsp *http.Response) (*UpdateDiagnosticRuleResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
 
-// Status returns HTTPResponse.Status
-func (r GetDDLProgressResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	response := &UpdateDiagnosticRuleResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetDDLProgressResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest DiagnosticRule
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	}
-	return 0
-}
 
-type CancelDDLProgressResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
+	return response, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r CancelDDLProgressResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// ParseSearchDiagnosticsResponse parses an HTTP response from a SearchDiagnosticsWithResponse call
+func ParseSearchDiagnosticsResponse(rsp *http.Response) (*SearchDiagnosticsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &SearchDiagnosticsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r CancelDDLProgressResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []DiagnosticSearchResult
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	}
-	return 0
-}
 
-type QueryDatabaseResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *QueryResponse
+	return response, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r QueryDatabaseResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// ParseListEventsResponse parses an HTTP response from a ListEventsWithResponse call
+func ParseListEventsResponse(rsp *http.Response) (*ListEventsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r QueryDatabaseResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	response := &ListEventsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return 0
-}
 
-type ListEventsResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *[]Event
-}
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []Event
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
 
-// Status returns HTTPResponse.Status
-func (r ListEventsResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r ListEventsResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
+	return response, nil
 }
 
-type ListMetricsStoresResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *[]MetricsStore
-}
+// ParseListMetricsStoresResponse parses an HTTP response from a ListMetricsStoresWithResponse call
+func ParseListMetricsStoresResponse(rsp *http.Response) (*ListMetricsStoresResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
 
-// Status returns HTTPResponse.Status
-func (r ListMetricsStoresResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	response := &ListMetricsStoresResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r ListMetricsStoresResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []MetricsStore
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	}
-	return 0
-}
 
-type ImportMetricsStoreResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON201      *MetricsStore
+	return response, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r ImportMetricsStoreResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// ParseImportMetricsStoreResponse parses an HTTP response from a ImportMetricsStoreWithResponse call
+func ParseImportMetricsStoreResponse(rsp *http.Response) (*ImportMetricsStoreResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r ImportMetricsStoreResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	response := &ImportMetricsStoreResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return 0
-}
 
-type DeleteMetricsStoreResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-}
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest MetricsStore
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
 
-// Status returns HTTPResponse.Status
-func (r DeleteMetricsStoreResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
 	}
-	return http.StatusText(0)
+
+	return response, nil
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r DeleteMetricsStoreResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// ParseDeleteMetricsStoreResponse parses an HTTP response from a DeleteMetricsStoreWithResponse call
+func ParseDeleteMetricsStoreResponse(rsp *http.Response) (*DeleteMetricsStoreResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type GetMetricsStoreResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *MetricsStore
+	response := &DeleteMetricsStoreResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r GetMetricsStoreResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// ParseGetMetricsStoreResponse parses an HTTP response from a GetMetricsStoreWithResponse call
+func ParseGetMetricsStoreResponse(rsp *http.Response) (*GetMetricsStoreResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetMetricsStoreResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	response := &GetMetricsStoreResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return 0
-}
 
-type UpdateMetricsStoreResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-}
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest MetricsStore
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
 
-// Status returns HTTPResponse.Status
-func (r UpdateMetricsStoreResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
 	}
-	return http.StatusText(0)
+
+	return response, nil
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r UpdateMetricsStoreResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// ParseUpdateMetricsStoreResponse parses an HTTP response from a UpdateMetricsStoreWithResponse call
+func ParseUpdateMetricsStoreResponse(rsp *http.Response) (*UpdateMetricsStoreResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type GetMaterializedViewThroughputResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *MetricMatrix
+	response := &UpdateMetricsStoreResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r GetMaterializedViewThroughputResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// ParseGetMaterializedViewThroughputResponse parses an HTTP response from a GetMaterializedViewThroughputWithResponse call
+func ParseGetMaterializedViewThroughputResponse(rsp *http.Response) (*GetMaterializedViewThroughputResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetMaterializedViewThroughputResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	response := &GetMaterializedViewThroughputResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest MetricMatrix
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	}
-	return 0
-}
 
-type ListTasksResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *[]Task
+	return response, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r ListTasksResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// ParseGetOrgSettingsResponse parses an HTTP response from a GetOrgSettingsWithResponse call
+func ParseGetOrgSettingsResponse(rsp *http.Response) (*GetOrgSettingsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r ListTasksResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	response := &GetOrgSettingsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return 0
-}
 
-type TestClusterConnectionResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *TestClusterConnectionResult
-}
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest OrgSettings
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
 
-// Status returns HTTPResponse.Status
-func (r TestClusterConnectionResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r TestClusterConnectionResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
+	return response, nil
 }
 
-// ListClusterVersionsWithResponse request returning *ListClusterVersionsResponse
-func (c *ClientWithResponses) ListClusterVersionsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListClusterVersionsResponse, error) {
-	rsp, err := c.ListClusterVersions(ctx, reqEditors...)
+// ParseUpdateOrgSettingsResponse parses an HTTP response from a UpdateOrgSettingsWithResponse call
+func ParseUpdateOrgSettingsResponse(rsp *http.Response) (*UpdateOrgSettingsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseListClusterVersionsResponse(rsp)
-}
 
-// ListClustersWithResponse request returning *ListClustersResponse
-func (c *ClientWithResponses) ListClustersWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListClustersResponse, error) {
-	rsp, err := c.ListClusters(ctx, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &UpdateOrgSettingsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseListClustersResponse(rsp)
-}
 
-// CreateClusterWithBodyWithResponse request with arbitrary body returning *CreateClusterResponse
-func (c *ClientWithResponses) CreateClusterWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateClusterResponse, error) {
-	rsp, err := c.CreateClusterWithBody(ctx, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest OrgSettings
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	}
-	return ParseCreateClusterResponse(rsp)
+
+	return response, nil
 }
 
-func (c *ClientWithResponses) CreateClusterWithResponse(ctx context.Context, body CreateClusterJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateClusterResponse, error) {
-	rsp, err := c.CreateCluster(ctx, body, reqEditors...)
+// ParseUploadRisectlResponse parses an HTTP response from a UploadRisectlWithResponse call
+func ParseUploadRisectlResponse(rsp *http.Response) (*UploadRisectlResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseCreateClusterResponse(rsp)
-}
 
-// ImportClusterWithBodyWithResponse request with arbitrary body returning *ImportClusterResponse
-func (c *ClientWithResponses) ImportClusterWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ImportClusterResponse, error) {
-	rsp, err := c.ImportClusterWithBody(ctx, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &UploadRisectlResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseImportClusterResponse(rsp)
+
+	return response, nil
 }
 
-func (c *ClientWithResponses) ImportClusterWithResponse(ctx context.Context, body ImportClusterJSONRequestBody, reqEditors ...RequestEditorFn) (*ImportClusterResponse, error) {
-	rsp, err := c.ImportCluster(ctx, body, reqEditors...)
+// ParseListTasksResponse parses an HTTP response from a ListTasksWithResponse call
+func ParseListTasksResponse(rsp *http.Response) (*ListTasksResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseImportClusterResponse(rsp)
+
+	response := &ListTasksResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []Task
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
 }
 
-// DeleteClusterWithResponse request returning *DeleteClusterResponse
-func (c *ClientWithResponses) DeleteClusterWithResponse(ctx context.Context, id int32, params *DeleteClusterParams, reqEditors ...RequestEditorFn) (*DeleteClusterResponse, error) {
-	rsp, err := c.DeleteCluster(ctx, id, params, reqEditors...)
+// ParseTestClusterConnectionResponse parses an HTTP response from a TestClusterConnectionWithResponse call
+func ParseTestClusterConnectionResponse(rsp *http.Response) (*TestClusterConnectionResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseDeleteClusterResponse(rsp)
+
+	response := &TestClusterConnectionResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TestClusterConnectionResult
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ServerInterface represents all server handlers.
+type ServerInterface interface {
+	// List alert rules
+	// (GET /alert-rules)
+	ListAlertRules(c *fiber.Ctx, params ListAlertRulesParams) error
+	// Create alert rule
+	// (POST /alert-rules)
+	CreateAlertRule(c *fiber.Ctx) error
+	// Delete alert rule
+	// (DELETE /alert-rules/{ID})
+	DeleteAlertRule(c *fiber.Ctx, id int32) error
+	// Get alert rule
+	// (GET /alert-rules/{ID})
+	GetAlertRule(c *fiber.Ctx, id int32) error
+	// Update alert rule
+	// (PUT /alert-rules/{ID})
+	UpdateAlertRule(c *fiber.Ctx, id int32) error
+	// List alerts
+	// (GET /alerts)
+	ListAlerts(c *fiber.Ctx, params ListAlertsParams) error
+	// List alert history
+	// (GET /alerts/history)
+	ListAlertHistory(c *fiber.Ctx, params ListAlertHistoryParams) error
+	// List all cluster versions
+	// (GET /cluster-versions)
+	ListClusterVersions(c *fiber.Ctx) error
+	// List all clusters
+	// (GET /clusters)
+	ListClusters(c *fiber.Ctx) error
+	// Create a new cluster
+	// (POST /clusters)
+	CreateCluster(c *fiber.Ctx) error
+	// Import a cluster
+	// (POST /clusters/import)
+	ImportCluster(c *fiber.Ctx) error
+	// Delete cluster
+	// (DELETE /clusters/{ID})
+	DeleteCluster(c *fiber.Ctx, id int32, params DeleteClusterParams) error
+	// Get cluster details
+	// (GET /clusters/{ID})
+	GetCluster(c *fiber.Ctx, id int32) error
+	// Update cluster
+	// (PUT /clusters/{ID})
+	UpdateCluster(c *fiber.Ctx, id int32) error
+	// Get snapshot configuration
+	// (GET /clusters/{ID}/auto-backup-config)
+	GetClusterAutoBackupConfig(c *fiber.Ctx, id int32) error
+	// Update snapshot configuration
+	// (PUT /clusters/{ID}/auto-backup-config)
+	UpdateClusterAutoBackupConfig(c *fiber.Ctx, id int32) error
+	// Preview snapshot retention
+	// (POST /clusters/{ID}/auto-backup-config/retention-preview)
+	PreviewClusterSnapshotRetention(c *fiber.Ctx, id int32) error
+	// List diagnostic bundles
+	// (GET /clusters/{ID}/diagnostic-bundles)
+	ListClusterDiagnosticBundles(c *fiber.Ctx, id int32) error
+	// Create a diagnostic bundle
+	// (POST /clusters/{ID}/diagnostic-bundles)
+	CreateClusterDiagnosticBundle(c *fiber.Ctx, id int32) error
+	// Delete a diagnostic bundle
+	// (DELETE /clusters/{ID}/diagnostic-bundles/{bundleId})
+	DeleteClusterDiagnosticBundle(c *fiber.Ctx, id int32, bundleId int32) error
+	// Get a diagnostic bundle
+	// (GET /clusters/{ID}/diagnostic-bundles/{bundleId})
+	GetClusterDiagnosticBundle(c *fiber.Ctx, id int32, bundleId int32) error
+	// Download a diagnostic bundle
+	// (GET /clusters/{ID}/diagnostic-bundles/{bundleId}/download)
+	DownloadClusterDiagnosticBundle(c *fiber.Ctx, id int32, bundleId int32) error
+	// List diagnostic data
+	// (GET /clusters/{ID}/diagnostics)
+	ListClusterDiagnostics(c *fiber.Ctx, id int32, params ListClusterDiagnosticsParams) error
+	// Create diagnostic data
+	// (POST /clusters/{ID}/diagnostics)
+	CreateClusterDiagnostic(c *fiber.Ctx, id int32) error
+	// Get diagnostic configuration
+	// (GET /clusters/{ID}/diagnostics/config)
+	GetClusterAutoDiagnosticConfig(c *fiber.Ctx, id int32) error
+	// Update diagnostic configuration
+	// (PUT /clusters/{ID}/diagnostics/config)
+	UpdateClusterAutoDiagnosticConfig(c *fiber.Ctx, id int32) error
+	// Diff diagnostic data
+	// (GET /clusters/{ID}/diagnostics/diff)
+	DiffClusterDiagnostics(c *fiber.Ctx, id int32, params DiffClusterDiagnosticsParams) error
+	// Search diagnostic data
+	// (GET /clusters/{ID}/diagnostics/search)
+	SearchClusterDiagnostics(c *fiber.Ctx, id int32, params SearchClusterDiagnosticsParams) error
+	// Get diagnostic data
+	// (GET /clusters/{ID}/diagnostics/{diagnosticId})
+	GetClusterDiagnostic(c *fiber.Ctx, id int32, diagnosticId int32) error
+	// List diagnostic findings
+	// (GET /clusters/{ID}/diagnostics/{diagnosticId}/findings)
+	ListClusterDiagnosticFindings(c *fiber.Ctx, id int32, diagnosticId int32) error
+	// Get diagnostic report
+	// (GET /clusters/{ID}/diagnostics/{diagnosticId}/report)
+	GetClusterDiagnosticReport(c *fiber.Ctx, id int32, diagnosticId int32) error
+	// List cluster events
+	// (GET /clusters/{ID}/events)
+	ListClusterEvents(c *fiber.Ctx, id int32, params ListClusterEventsParams) error
+	// List cluster health history
+	// (GET /clusters/{ID}/health-history)
+	ListClusterHealthHistory(c *fiber.Ctx, id int32, params ListClusterHealthHistoryParams) error
+	// Run risectl command
+	// (POST /clusters/{ID}/risectl)
+	RunRisectlCommand(c *fiber.Ctx, id int32) error
+	// List risectl executions
+	// (GET /clusters/{ID}/risectl/executions)
+	ListRisectlExecutions(c *fiber.Ctx, id int32, params ListRisectlExecutionsParams) error
+	// Start a risectl execution
+	// (POST /clusters/{ID}/risectl/executions)
+	CreateRisectlExecution(c *fiber.Ctx, id int32) error
+	// Get a risectl execution
+	// (GET /clusters/{ID}/risectl/executions/{executionID})
+	GetRisectlExecution(c *fiber.Ctx, id int32, executionID int32) error
+	// Cancel a risectl execution
+	// (POST /clusters/{ID}/risectl/executions/{executionID}/cancel)
+	CancelRisectlExecution(c *fiber.Ctx, id int32, executionID int32) error
+	// Get the output of a risectl execution
+	// (GET /clusters/{ID}/risectl/executions/{executionID}/output)
+	ListRisectlExecutionOutputs(c *fiber.Ctx, id int32, executionID int32, params ListRisectlExecutionOutputsParams) error
+	// Stream the output of a risectl execution
+	// (GET /clusters/{ID}/risectl/executions/{executionID}/stream)
+	StreamRisectlExecution(c *fiber.Ctx, id int32, executionID int32, params StreamRisectlExecutionParams) error
+	// List risectl operations
+	// (GET /clusters/{ID}/risectl/operations)
+	ListRisectlOperations(c *fiber.Ctx, id int32) error
+	// Run a risectl operation
+	// (POST /clusters/{ID}/risectl/operations/{operation})
+	RunRisectlOperation(c *fiber.Ctx, id int32, operation RisectlOperationName) error
+	// Open an interactive risectl shell
+	// (GET /clusters/{ID}/risectl/shell)
+	OpenRisectlShell(c *fiber.Ctx, id int32) error
+	// List risectl shell sessions
+	// (GET /clusters/{ID}/risectl/shell/sessions)
+	ListRisectlShellSessions(c *fiber.Ctx, id int32) error
+	// List the commands of a risectl shell session
+	// (GET /clusters/{ID}/risectl/shell/sessions/{sessionID}/commands)
+	ListRisectlShellCommands(c *fiber.Ctx, id int32, sessionID int32) error
+	// List cluster snapshots
+	// (GET /clusters/{ID}/snapshots)
+	ListClusterSnapshots(c *fiber.Ctx, id int32) error
+	// Create a new snapshot
+	// (POST /clusters/{ID}/snapshots)
+	CreateClusterSnapshot(c *fiber.Ctx, id int32) error
+	// Delete snapshot
+	// (DELETE /clusters/{ID}/snapshots/{snapshotId})
+	DeleteClusterSnapshot(c *fiber.Ctx, id int32, snapshotId int64) error
+	// Restore snapshot
+	// (POST /clusters/{ID}/snapshots/{snapshotId})
+	RestoreClusterSnapshot(c *fiber.Ctx, id int32, snapshotId int64) error
+	// List cluster upgrades
+	// (GET /clusters/{ID}/upgrades)
+	ListClusterUpgrades(c *fiber.Ctx, id int32) error
+	// Start a cluster upgrade
+	// (POST /clusters/{ID}/upgrades)
+	CreateClusterUpgrade(c *fiber.Ctx, id int32) error
+	// Get a cluster upgrade
+	// (GET /clusters/{ID}/upgrades/{upgradeID})
+	GetClusterUpgrade(c *fiber.Ctx, id int32, upgradeID int32) error
+	// Cancel a cluster upgrade
+	// (POST /clusters/{ID}/upgrades/{upgradeID}/cancel)
+	CancelClusterUpgrade(c *fiber.Ctx, id int32, upgradeID int32) error
+	// List cluster version history
+	// (GET /clusters/{ID}/version-history)
+	ListClusterVersionHistory(c *fiber.Ctx, id int32) error
+	// List all databases
+	// (GET /databases)
+	ListDatabases(c *fiber.Ctx) error
+	// Import a database
+	// (POST /databases/import)
+	ImportDatabase(c *fiber.Ctx) error
+	// Test database connection
+	// (POST /databases/test-connection)
+	TestDatabaseConnection(c *fiber.Ctx) error
+	// Delete database
+	// (DELETE /databases/{ID})
+	DeleteDatabase(c *fiber.Ctx, id int32) error
+	// Get database details
+	// (GET /databases/{ID})
+	GetDatabase(c *fiber.Ctx, id int32, params GetDatabaseParams) error
+	// Update database
+	// (PUT /databases/{ID})
+	UpdateDatabase(c *fiber.Ctx, id int32) error
+	// List catalog changes
+	// (GET /databases/{ID}/catalog-history)
+	ListDatabaseCatalogChanges(c *fiber.Ctx, id int32, params ListDatabaseCatalogChangesParams) error
+	// Get DDL progress
+	// (GET /databases/{ID}/ddl-progress)
+	GetDDLProgress(c *fiber.Ctx, id int32) error
+	// Cancel DDL progress
+	// (POST /databases/{ID}/ddl-progress/{ddlID}/cancel)
+	CancelDDLProgress(c *fiber.Ctx, id int32, ddlID int64) error
+	// Analyze DDL impact
+	// (POST /databases/{ID}/impact-analysis)
+	AnalyzeDatabaseImpact(c *fiber.Ctx, id int32) error
+	// Get database lineage
+	// (GET /databases/{ID}/lineage)
+	GetDatabaseLineage(c *fiber.Ctx, id int32, params GetDatabaseLineageParams) error
+	// Export database lineage
+	// (GET /databases/{ID}/lineage/export)
+	ExportDatabaseLineage(c *fiber.Ctx, id int32, params ExportDatabaseLineageParams) error
+	// Query database
+	// (POST /databases/{ID}/query)
+	QueryDatabase(c *fiber.Ctx, id int32) error
+	// List database relations
+	// (GET /databases/{ID}/relations)
+	ListDatabaseRelations(c *fiber.Ctx, id int32, params ListDatabaseRelationsParams) error
+	// List diagnostic rules
+	// (GET /diagnostic-rules)
+	ListDiagnosticRules(c *fiber.Ctx) error
+	// Update diagnostic rule
+	// (PUT /diagnostic-rules/{rule})
+	UpdateDiagnosticRule(c *fiber.Ctx, rule DiagnosticRuleName) error
+	// Search diagnostic data of all clusters
+	// (GET /diagnostics/search)
+	SearchDiagnostics(c *fiber.Ctx, params SearchDiagnosticsParams) error
+	// Get all events
+	// (GET /events)
+	ListEvents(c *fiber.Ctx) error
+	// Get all metrics stores
+	// (GET /metrics-stores)
+	ListMetricsStores(c *fiber.Ctx) error
+	// Import a metrics store
+	// (POST /metrics-stores/import)
+	ImportMetricsStore(c *fiber.Ctx) error
+	// Delete a metrics store
+	// (DELETE /metrics-stores/{ID})
+	DeleteMetricsStore(c *fiber.Ctx, id int32, params DeleteMetricsStoreParams) error
+	// Get a metrics store
+	// (GET /metrics-stores/{ID})
+	GetMetricsStore(c *fiber.Ctx, id int32) error
+	// Update a metrics store
+	// (PUT /metrics-stores/{ID})
+	UpdateMetricsStore(c *fiber.Ctx, id int32) error
+	// Get materialized view throughput
+	// (GET /metrics/{clusterID}/materialized-view-throughput)
+	GetMaterializedViewThroughput(c *fiber.Ctx, clusterID int32) error
+	// Get organization settings
+	// (GET /org-settings)
+	GetOrgSettings(c *fiber.Ctx) error
+	// Update organization settings
+	// (PUT /org-settings)
+	UpdateOrgSettings(c *fiber.Ctx) error
+	// Upload risectl
+	// (PUT /risectl/versions/{version})
+	UploadRisectl(c *fiber.Ctx, version string) error
+	// Get all tasks
+	// (GET /tasks)
+	ListTasks(c *fiber.Ctx) error
+	// Test cluster connection
+	// (POST /test-cluster-connection)
+	TestClusterConnection(c *fiber.Ctx) error
 }
 
-// GetClusterWithResponse request returning *GetClusterResponse
-func (c *ClientWithResponses) GetClusterWithResponse(ctx context.Context, id int32, reqEditors ...RequestEditorFn) (*GetClusterResponse, error) {
-	rsp, err := c.GetCluster(ctx, id, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseGetClusterResponse(rsp)
+// ServerInterfaceWrapper converts contexts to parameters.
+type ServerInterfaceWrapper struct {
+	Handler ServerInterface
 }
 
-// UpdateClusterWithBodyWithResponse request with arbitrary body returning *UpdateClusterResponse
-func (c *ClientWithResponses) UpdateClusterWithBodyWithResponse(ctx context.Context, id int32, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateClusterResponse, error) {
-	rsp, err := c.UpdateClusterWithBody(ctx, id, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseUpdateClusterResponse(rsp)
-}
+type MiddlewareFunc fiber.Handler
 
-func (c *ClientWithResponses) UpdateClusterWithResponse(ctx context.Context, id int32, body UpdateClusterJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateClusterResponse, error) {
-	rsp, err := c.UpdateCluster(ctx, id, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseUpdateClusterResponse(rsp)
-}
+// ListAlertRules operation middleware
+func (siw *ServerInterfaceWrapper) ListAlertRules(c *fiber.Ctx) error {
 
-// GetClusterAutoBackupConfigWithResponse request returning *GetClusterAutoBackupConfigResponse
-func (c *ClientWithResponses) GetClusterAutoBackupConfigWithResponse(ctx context.Context, id int32, reqEditors ...RequestEditorFn) (*GetClusterAutoBackupConfigResponse, error) {
-	rsp, err := c.GetClusterAutoBackupConfig(ctx, id, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseGetClusterAutoBackupConfigResponse(rsp)
-}
+	var err error
 
-// UpdateClusterAutoBackupConfigWithBodyWithResponse request with arbitrary body returning *UpdateClusterAutoBackupConfigResponse
-func (c *ClientWithResponses) UpdateClusterAutoBackupConfigWithBodyWithResponse(ctx context.Context, id int32, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateClusterAutoBackupConfigResponse, error) {
-	rsp, err := c.UpdateClusterAutoBackupConfigWithBody(ctx, id, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseUpdateClusterAutoBackupConfigResponse(rsp)
-}
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
 
-func (c *ClientWithResponses) UpdateClusterAutoBackupConfigWithResponse(ctx context.Context, id int32, body UpdateClusterAutoBackupConfigJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateClusterAutoBackupConfigResponse, error) {
-	rsp, err := c.UpdateClusterAutoBackupConfig(ctx, id, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseUpdateClusterAutoBackupConfigResponse(rsp)
-}
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListAlertRulesParams
 
-// ListClusterDiagnosticsWithResponse request returning *ListClusterDiagnosticsResponse
-func (c *ClientWithResponses) ListClusterDiagnosticsWithResponse(ctx context.Context, id int32, params *ListClusterDiagnosticsParams, reqEditors ...RequestEditorFn) (*ListClusterDiagnosticsResponse, error) {
-	rsp, err := c.ListClusterDiagnostics(ctx, id, params, reqEditors...)
+	var query url.Values
+	query, err = url.ParseQuery(string(c.Request().URI().QueryString()))
 	if err != nil {
-		return nil, err
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for query string: %w", err).Error())
 	}
-	return ParseListClusterDiagnosticsResponse(rsp)
-}
 
-// CreateClusterDiagnosticWithBodyWithResponse request with arbitrary body returning *CreateClusterDiagnosticResponse
-func (c *ClientWithResponses) CreateClusterDiagnosticWithBodyWithResponse(ctx context.Context, id int32, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateClusterDiagnosticResponse, error) {
-	rsp, err := c.CreateClusterDiagnosticWithBody(ctx, id, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseCreateClusterDiagnosticResponse(rsp)
-}
+	// ------------- Optional query parameter "clusterID" -------------
 
-func (c *ClientWithResponses) CreateClusterDiagnosticWithResponse(ctx context.Context, id int32, body CreateClusterDiagnosticJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateClusterDiagnosticResponse, error) {
-	rsp, err := c.CreateClusterDiagnostic(ctx, id, body, reqEditors...)
+	err = runtime.BindQueryParameter("form", true, false, "clusterID", query, &params.ClusterID)
 	if err != nil {
-		return nil, err
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter clusterID: %w", err).Error())
 	}
-	return ParseCreateClusterDiagnosticResponse(rsp)
-}
 
-// GetClusterAutoDiagnosticConfigWithResponse request returning *GetClusterAutoDiagnosticConfigResponse
-func (c *ClientWithResponses) GetClusterAutoDiagnosticConfigWithResponse(ctx context.Context, id int32, reqEditors ...RequestEditorFn) (*GetClusterAutoDiagnosticConfigResponse, error) {
-	rsp, err := c.GetClusterAutoDiagnosticConfig(ctx, id, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseGetClusterAutoDiagnosticConfigResponse(rsp)
+	return siw.Handler.ListAlertRules(c, params)
 }
 
-// UpdateClusterAutoDiagnosticConfigWithBodyWithResponse request with arbitrary body returning *UpdateClusterAutoDiagnosticConfigResponse
-func (c *ClientWithResponses) UpdateClusterAutoDiagnosticConfigWithBodyWithResponse(ctx context.Context, id int32, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateClusterAutoDiagnosticConfigResponse, error) {
-	rsp, err := c.UpdateClusterAutoDiagnosticConfigWithBody(ctx, id, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseUpdateClusterAutoDiagnosticConfigResponse(rsp)
-}
+// CreateAlertRule operation middleware
+func (siw *ServerInterfaceWrapper) CreateAlertRule(c *fiber.Ctx) error {
 
-func (c *ClientWithResponses) UpdateClusterAutoDiagnosticConfigWithResponse(ctx context.Context, id int32, body UpdateClusterAutoDiagnosticConfigJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateClusterAutoDiagnosticConfigResponse, error) {
-	rsp, err := c.UpdateClusterAutoDiagnosticConfig(ctx, id, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseUpdateClusterAutoDiagnosticConfigResponse(rsp)
-}
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
 
-// GetClusterDiagnosticWithResponse request returning *GetClusterDiagnosticResponse
-func (c *ClientWithResponses) GetClusterDiagnosticWithResponse(ctx context.Context, id int32, diagnosticId int32, reqEditors ...RequestEditorFn) (*GetClusterDiagnosticResponse, error) {
-	rsp, err := c.GetClusterDiagnostic(ctx, id, diagnosticId, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseGetClusterDiagnosticResponse(rsp)
+	return siw.Handler.CreateAlertRule(c)
 }
 
-// RunRisectlCommandWithBodyWithResponse request with arbitrary body returning *RunRisectlCommandResponse
-func (c *ClientWithResponses) RunRisectlCommandWithBodyWithResponse(ctx context.Context, id int32, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*RunRisectlCommandResponse, error) {
-	rsp, err := c.RunRisectlCommandWithBody(ctx, id, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseRunRisectlCommandResponse(rsp)
-}
+// DeleteAlertRule operation middleware
+func (siw *ServerInterfaceWrapper) DeleteAlertRule(c *fiber.Ctx) error {
 
-func (c *ClientWithResponses) RunRisectlCommandWithResponse(ctx context.Context, id int32, body RunRisectlCommandJSONRequestBody, reqEditors ...RequestEditorFn) (*RunRisectlCommandResponse, error) {
-	rsp, err := c.RunRisectlCommand(ctx, id, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseRunRisectlCommandResponse(rsp)
-}
+	var err error
 
-// ListClusterSnapshotsWithResponse request returning *ListClusterSnapshotsResponse
-func (c *ClientWithResponses) ListClusterSnapshotsWithResponse(ctx context.Context, id int32, reqEditors ...RequestEditorFn) (*ListClusterSnapshotsResponse, error) {
-	rsp, err := c.ListClusterSnapshots(ctx, id, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseListClusterSnapshotsResponse(rsp)
-}
+	// ------------- Path parameter "ID" -------------
+	var id int32
 
-// CreateClusterSnapshotWithBodyWithResponse request with arbitrary body returning *CreateClusterSnapshotResponse
-func (c *ClientWithResponses) CreateClusterSnapshotWithBodyWithResponse(ctx context.Context, id int32, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateClusterSnapshotResponse, error) {
-	rsp, err := c.CreateClusterSnapshotWithBody(ctx, id, contentType, body, reqEditors...)
+	err = runtime.BindStyledParameterWithOptions("simple", "ID", c.Params("ID"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
-		return nil, err
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter ID: %w", err).Error())
 	}
-	return ParseCreateClusterSnapshotResponse(rsp)
-}
 
-func (c *ClientWithResponses) CreateClusterSnapshotWithResponse(ctx context.Context, id int32, body CreateClusterSnapshotJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateClusterSnapshotResponse, error) {
-	rsp, err := c.CreateClusterSnapshot(ctx, id, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseCreateClusterSnapshotResponse(rsp)
-}
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
 
-// DeleteClusterSnapshotWithResponse request returning *DeleteClusterSnapshotResponse
-func (c *ClientWithResponses) DeleteClusterSnapshotWithResponse(ctx context.Context, id int32, snapshotId int64, reqEditors ...RequestEditorFn) (*DeleteClusterSnapshotResponse, error) {
-	rsp, err := c.DeleteClusterSnapshot(ctx, id, snapshotId, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseDeleteClusterSnapshotResponse(rsp)
+	return siw.Handler.DeleteAlertRule(c, id)
 }
 
-// RestoreClusterSnapshotWithResponse request returning *RestoreClusterSnapshotResponse
-func (c *ClientWithResponses) RestoreClusterSnapshotWithResponse(ctx context.Context, id int32, snapshotId int64, reqEditors ...RequestEditorFn) (*RestoreClusterSnapshotResponse, error) {
-	rsp, err := c.RestoreClusterSnapshot(ctx, id, snapshotId, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseRestoreClusterSnapshotResponse(rsp)
-}
+// GetAlertRule operation middleware
+func (siw *ServerInterfaceWrapper) GetAlertRule(c *fiber.Ctx) error {
 
-// ListDatabasesWithResponse request returning *ListDatabasesResponse
-func (c *ClientWithResponses) ListDatabasesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListDatabasesResponse, error) {
-	rsp, err := c.ListDatabases(ctx, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseListDatabasesResponse(rsp)
-}
+	var err error
 
-// ImportDatabaseWithBodyWithResponse request with arbitrary body returning *ImportDatabaseResponse
-func (c *ClientWithResponses) ImportDatabaseWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ImportDatabaseResponse, error) {
-	rsp, err := c.ImportDatabaseWithBody(ctx, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseImportDatabaseResponse(rsp)
-}
+	// ------------- Path parameter "ID" -------------
+	var id int32
 
-func (c *ClientWithResponses) ImportDatabaseWithResponse(ctx context.Context, body ImportDatabaseJSONRequestBody, reqEditors ...RequestEditorFn) (*ImportDatabaseResponse, error) {
-	rsp, err := c.ImportDatabase(ctx, body, reqEditors...)
+	err = runtime.BindStyledParameterWithOptions("simple", "ID", c.Params("ID"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
-		return nil, err
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter ID: %w", err).Error())
 	}
-	return ParseImportDatabaseResponse(rsp)
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.GetAlertRule(c, id)
 }
 
-// TestDatabaseConnectionWithBodyWithResponse request with arbitrary body returning *TestDatabaseConnectionResponse
-func (c *ClientWithResponses) TestDatabaseConnectionWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*TestDatabaseConnectionResponse, error) {
-	rsp, err := c.TestDatabaseConnectionWithBody(ctx, contentType, body, reqEditors...)
+// UpdateAlertRule operation middleware
+func (siw *ServerInterfaceWrapper) UpdateAlertRule(c *fiber.Ctx) error {
+
+	var err error
+
+	// ------------- Path parameter "ID" -------------
+	var id int32
+
+	err = runtime.BindStyledParameterWithOptions("simple", "ID", c.Params("ID"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
-		return nil, err
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter ID: %w", err).Error())
 	}
-	return ParseTestDatabaseConnectionResponse(rsp)
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.UpdateAlertRule(c, id)
 }
 
-func (c *ClientWithResponses) TestDatabaseConnectionWithResponse(ctx context.Context, body TestDatabaseConnectionJSONRequestBody, reqEditors ...RequestEditorFn) (*TestDatabaseConnectionResponse, error) {
-	rsp, err := c.TestDatabaseConnection(ctx, body, reqEditors...)
+// ListAlerts operation middleware
+func (siw *ServerInterfaceWrapper) ListAlerts(c *fiber.Ctx) error {
+
+	var err error
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListAlertsParams
+
+	var query url.Values
+	query, err = url.ParseQuery(string(c.Request().URI().QueryString()))
 	if err != nil {
-		return nil, err
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for query string: %w", err).Error())
 	}
-	return ParseTestDatabaseConnectionResponse(rsp)
-}
 
-// DeleteDatabaseWithResponse request returning *DeleteDatabaseResponse
-func (c *ClientWithResponses) DeleteDatabaseWithResponse(ctx context.Context, id int32, reqEditors ...RequestEditorFn) (*DeleteDatabaseResponse, error) {
-	rsp, err := c.DeleteDatabase(ctx, id, reqEditors...)
+	// ------------- Optional query parameter "clusterID" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "clusterID", query, &params.ClusterID)
 	if err != nil {
-		return nil, err
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter clusterID: %w", err).Error())
 	}
-	return ParseDeleteDatabaseResponse(rsp)
+
+	return siw.Handler.ListAlerts(c, params)
 }
 
-// GetDatabaseWithResponse request returning *GetDatabaseResponse
-func (c *ClientWithResponses) GetDatabaseWithResponse(ctx context.Context, id int32, reqEditors ...RequestEditorFn) (*GetDatabaseResponse, error) {
-	rsp, err := c.GetDatabase(ctx, id, reqEditors...)
+// ListAlertHistory operation middleware
+func (siw *ServerInterfaceWrapper) ListAlertHistory(c *fiber.Ctx) error {
+
+	var err error
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListAlertHistoryParams
+
+	var query url.Values
+	query, err = url.ParseQuery(string(c.Request().URI().QueryString()))
 	if err != nil {
-		return nil, err
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for query string: %w", err).Error())
 	}
-	return ParseGetDatabaseResponse(rsp)
-}
 
-// UpdateDatabaseWithBodyWithResponse request with arbitrary body returning *UpdateDatabaseResponse
-func (c *ClientWithResponses) UpdateDatabaseWithBodyWithResponse(ctx context.Context, id int32, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateDatabaseResponse, error) {
-	rsp, err := c.UpdateDatabaseWithBody(ctx, id, contentType, body, reqEditors...)
+	// ------------- Optional query parameter "clusterID" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "clusterID", query, &params.ClusterID)
 	if err != nil {
-		return nil, err
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter clusterID: %w", err).Error())
 	}
-	return ParseUpdateDatabaseResponse(rsp)
-}
 
-func (c *ClientWithResponses) UpdateDatabaseWithResponse(ctx context.Context, id int32, body UpdateDatabaseJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateDatabaseResponse, error) {
-	rsp, err := c.UpdateDatabase(ctx, id, body, reqEditors...)
+	// ------------- Optional query parameter "ruleID" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "ruleID", query, &params.RuleID)
 	if err != nil {
-		return nil, err
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter ruleID: %w", err).Error())
 	}
-	return ParseUpdateDatabaseResponse(rsp)
-}
 
-// GetDDLProgressWithResponse request returning *GetDDLProgressResponse
-func (c *ClientWithResponses) GetDDLProgressWithResponse(ctx context.Context, id int32, reqEditors ...RequestEditorFn) (*GetDDLProgressResponse, error) {
-	rsp, err := c.GetDDLProgress(ctx, id, reqEditors...)
+	// ------------- Optional query parameter "from" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "from", query, &params.From)
 	if err != nil {
-		return nil, err
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter from: %w", err).Error())
 	}
-	return ParseGetDDLProgressResponse(rsp)
-}
 
-// CancelDDLProgressWithResponse request returning *CancelDDLProgressResponse
-func (c *ClientWithResponses) CancelDDLProgressWithResponse(ctx context.Context, id int32, ddlID int64, reqEditors ...RequestEditorFn) (*CancelDDLProgressResponse, error) {
-	rsp, err := c.CancelDDLProgress(ctx, id, ddlID, reqEditors...)
+	// ------------- Optional query parameter "to" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "to", query, &params.To)
 	if err != nil {
-		return nil, err
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter to: %w", err).Error())
 	}
-	return ParseCancelDDLProgressResponse(rsp)
-}
 
-// QueryDatabaseWithBodyWithResponse request with arbitrary body returning *QueryDatabaseResponse
-func (c *ClientWithResponses) QueryDatabaseWithBodyWithResponse(ctx context.Context, id int32, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*QueryDatabaseResponse, error) {
-	rsp, err := c.QueryDatabaseWithBody(ctx, id, contentType, body, reqEditors...)
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", query, &params.Limit)
 	if err != nil {
-		return nil, err
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter limit: %w", err).Error())
 	}
-	return ParseQueryDatabaseResponse(rsp)
+
+	return siw.Handler.ListAlertHistory(c, params)
 }
 
-func (c *ClientWithResponses) QueryDatabaseWithResponse(ctx context.Context, id int32, body QueryDatabaseJSONRequestBody, reqEditors ...RequestEditorFn) (*QueryDatabaseResponse, error) {
-	rsp, err := c.QueryDatabase(ctx, id, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseQueryDatabaseResponse(rsp)
+// ListClusterVersions operation middleware
+func (siw *ServerInterfaceWrapper) ListClusterVersions(c *fiber.Ctx) error {
+
+	return siw.Handler.ListClusterVersions(c)
 }
 
-// ListEventsWithResponse request returning *ListEventsResponse
-func (c *ClientWithResponses) ListEventsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListEventsResponse, error) {
-	rsp, err := c.ListEvents(ctx, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseListEventsResponse(rsp)
+// ListClusters operation middleware
+func (siw *ServerInterfaceWrapper) ListClusters(c *fiber.Ctx) error {
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.ListClusters(c)
 }
 
-// ListMetricsStoresWithResponse request returning *ListMetricsStoresResponse
-func (c *ClientWithResponses) ListMetricsStoresWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListMetricsStoresResponse, error) {
-	rsp, err := c.ListMetricsStores(ctx, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseListMetricsStoresResponse(rsp)
+// CreateCluster operation middleware
+func (siw *ServerInterfaceWrapper) CreateCluster(c *fiber.Ctx) error {
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{"x.PremiumAccess(c)"})
+
+	return siw.Handler.CreateCluster(c)
 }
 
-// ImportMetricsStoreWithBodyWithResponse request with arbitrary body returning *ImportMetricsStoreResponse
-func (c *ClientWithResponses) ImportMetricsStoreWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*ImportMetricsStoreResponse, error) {
-	rsp, err := c.ImportMetricsStoreWithBody(ctx, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseImportMetricsStoreResponse(rsp)
+// ImportCluster operation middleware
+func (siw *ServerInterfaceWrapper) ImportCluster(c *fiber.Ctx) error {
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.ImportCluster(c)
 }
 
-func (c *ClientWithResponses) ImportMetricsStoreWithResponse(ctx context.Context, body ImportMetricsStoreJSONRequestBody, reqEditors ...RequestEditorFn) (*ImportMetricsStoreResponse, error) {
-	rsp, err := c.ImportMetricsStore(ctx, body, reqEditors...)
+// DeleteCluster operation middleware
+func (siw *ServerInterfaceWrapper) DeleteCluster(c *fiber.Ctx) error {
+
+	var err error
+
+	// ------------- Path parameter "ID" -------------
+	var id int32
+
+	err = runtime.BindStyledParameterWithOptions("simple", "ID", c.Params("ID"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
-		return nil, err
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter ID: %w", err).Error())
 	}
-	return ParseImportMetricsStoreResponse(rsp)
-}
 
-// DeleteMetricsStoreWithResponse request returning *DeleteMetricsStoreResponse
-func (c *ClientWithResponses) DeleteMetricsStoreWithResponse(ctx context.Context, id int32, params *DeleteMetricsStoreParams, reqEditors ...RequestEditorFn) (*DeleteMetricsStoreResponse, error) {
-	rsp, err := c.DeleteMetricsStore(ctx, id, params, reqEditors...)
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DeleteClusterParams
+
+	var query url.Values
+	query, err = url.ParseQuery(string(c.Request().URI().QueryString()))
 	if err != nil {
-		return nil, err
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for query string: %w", err).Error())
 	}
-	return ParseDeleteMetricsStoreResponse(rsp)
-}
 
-// GetMetricsStoreWithResponse request returning *GetMetricsStoreResponse
-func (c *ClientWithResponses) GetMetricsStoreWithResponse(ctx context.Context, id int32, reqEditors ...RequestEditorFn) (*GetMetricsStoreResponse, error) {
-	rsp, err := c.GetMetricsStore(ctx, id, reqEditors...)
+	// ------------- Optional query parameter "cascade" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cascade", query, &params.Cascade)
 	if err != nil {
-		return nil, err
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter cascade: %w", err).Error())
 	}
-	return ParseGetMetricsStoreResponse(rsp)
+
+	return siw.Handler.DeleteCluster(c, id, params)
 }
 
-// UpdateMetricsStoreWithBodyWithResponse request with arbitrary body returning *UpdateMetricsStoreResponse
-func (c *ClientWithResponses) UpdateMetricsStoreWithBodyWithResponse(ctx context.Context, id int32, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateMetricsStoreResponse, error) {
-	rsp, err := c.UpdateMetricsStoreWithBody(ctx, id, contentType, body, reqEditors...)
+// GetCluster operation middleware
+func (siw *ServerInterfaceWrapper) GetCluster(c *fiber.Ctx) error {
+
+	var err error
+
+	// ------------- Path parameter "ID" -------------
+	var id int32
+
+	err = runtime.BindStyledParameterWithOptions("simple", "ID", c.Params("ID"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
-		return nil, err
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter ID: %w", err).Error())
 	}
-	return ParseUpdateMetricsStoreResponse(rsp)
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.GetCluster(c, id)
 }
 
-func (c *ClientWithResponses) UpdateMetricsStoreWithResponse(ctx context.Context, id int32, body UpdateMetricsStoreJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateMetricsStoreResponse, error) {
-	rsp, err := c.UpdateMetricsStore(ctx, id, body, reqEditors...)
+// UpdateCluster operation middleware
+func (siw *ServerInterfaceWrapper) UpdateCluster(c *fiber.Ctx) error {
+
+	var err error
+
+	// ------------- Path parameter "ID" -------------
+	var id int32
+
+	err = runtime.BindStyledParameterWithOptions("simple", "ID", c.Params("ID"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
-		return nil, err
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter ID: %w", err).Error())
 	}
-	return ParseUpdateMetricsStoreResponse(rsp)
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.UpdateCluster(c, id)
 }
 
-// GetMaterializedViewThroughputWithResponse request returning *GetMaterializedViewThroughputResponse
-func (c *ClientWithResponses) GetMaterializedViewThroughputWithResponse(ctx context.Context, clusterID int32, reqEditors ...RequestEditorFn) (*GetMaterializedViewThroughputResponse, error) {
-	rsp, err := c.GetMaterializedViewThroughput(ctx, clusterID, reqEditors...)
+// GetClusterAutoBackupConfig operation middleware
+func (siw *ServerInterfaceWrapper) GetClusterAutoBackupConfig(c *fiber.Ctx) error {
+
+	var err error
+
+	// ------------- Path parameter "ID" -------------
+	var id int32
+
+	err = runtime.BindStyledParameterWithOptions("simple", "ID", c.Params("ID"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
-		return nil, err
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter ID: %w", err).Error())
 	}
-	return ParseGetMaterializedViewThroughputResponse(rsp)
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.GetClusterAutoBackupConfig(c, id)
 }
 
-// ListTasksWithResponse request returning *ListTasksResponse
-func (c *ClientWithResponses) ListTasksWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListTasksResponse, error) {
-	rsp, err := c.ListTasks(ctx, reqEditors...)
+// UpdateClusterAutoBackupConfig operation middleware
+func (siw *ServerInterfaceWrapper) UpdateClusterAutoBackupConfig(c *fiber.Ctx) error {
+
+	var err error
+
+	// ------------- Path parameter "ID" -------------
+	var id int32
+
+	err = runtime.BindStyledParameterWithOptions("simple", "ID", c.Params("ID"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
-		return nil, err
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter ID: %w", err).Error())
 	}
-	return ParseListTasksResponse(rsp)
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.UpdateClusterAutoBackupConfig(c, id)
 }
 
-// TestClusterConnectionWithBodyWithResponse request with arbitrary body returning *TestClusterConnectionResponse
-func (c *ClientWithResponses) TestClusterConnectionWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*TestClusterConnectionResponse, error) {
-	rsp, err := c.TestClusterConnectionWithBody(ctx, contentType, body, reqEditors...)
+// PreviewClusterSnapshotRetention operation middleware
+func (siw *ServerInterfaceWrapper) PreviewClusterSnapshotRetention(c *fiber.Ctx) error {
+
+	var err error
+
+	// ------------- Path parameter "ID" -------------
+	var id int32
+
+	err = runtime.BindStyledParameterWithOptions("simple", "ID", c.Params("ID"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
-		return nil, err
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter ID: %w", err).Error())
 	}
-	return ParseTestClusterConnectionResponse(rsp)
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.PreviewClusterSnapshotRetention(c, id)
 }
 
-func (c *ClientWithResponses) TestClusterConnectionWithResponse(ctx context.Context, body TestClusterConnectionJSONRequestBody, reqEditors ...RequestEditorFn) (*TestClusterConnectionResponse, error) {
-	rsp, err := c.TestClusterConnection(ctx, body, reqEditors...)
+// ListClusterDiagnosticBundles operation middleware
+func (siw *ServerInterfaceWrapper) ListClusterDiagnosticBundles(c *fiber.Ctx) error {
+
+	var err error
+
+	// ------------- Path parameter "ID" -------------
+	var id int32
+
+	err = runtime.BindStyledParameterWithOptions("simple", "ID", c.Params("ID"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
-		return nil, err
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter ID: %w", err).Error())
 	}
-	return ParseTestClusterConnectionResponse(rsp)
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.ListClusterDiagnosticBundles(c, id)
 }
 
-// ParseListClusterVersionsResponse parses an HTTP response from a ListClusterVersionsWithResponse call
-func ParseListClusterVersionsResponse(rsp *http.Response) (*ListClusterVersionsResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+// CreateClusterDiagnosticBundle operation middleware
+func (siw *ServerInterfaceWrapper) CreateClusterDiagnosticBundle(c *fiber.Ctx) error {
+
+	var err error
+
+	// ------------- Path parameter "ID" -------------
+	var id int32
+
+	err = runtime.BindStyledParameterWithOptions("simple", "ID", c.Params("ID"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
-		return nil, err
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter ID: %w", err).Error())
 	}
 
-	response := &ListClusterVersionsResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest []string
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+	return siw.Handler.CreateClusterDiagnosticBundle(c, id)
+}
+
+// DeleteClusterDiagnosticBundle operation middleware
+func (siw *ServerInterfaceWrapper) DeleteClusterDiagnosticBundle(c *fiber.Ctx) error {
+
+	var err error
 
+	// ------------- Path parameter "ID" -------------
+	var id int32
+
+	err = runtime.BindStyledParameterWithOptions("simple", "ID", c.Params("ID"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter ID: %w", err).Error())
 	}
 
-	return response, nil
-}
+	// ------------- Path parameter "bundleId" -------------
+	var bundleId int32
 
-// ParseListClustersResponse parses an HTTP response from a ListClustersWithResponse call
-func ParseListClustersResponse(rsp *http.Response) (*ListClustersResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+	err = runtime.BindStyledParameterWithOptions("simple", "bundleId", c.Params("bundleId"), &bundleId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
-		return nil, err
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter bundleId: %w", err).Error())
 	}
 
-	response := &ListClustersResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest []Cluster
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+	return siw.Handler.DeleteClusterDiagnosticBundle(c, id, bundleId)
+}
 
-	}
+// GetClusterDiagnosticBundle operation middleware
+func (siw *ServerInterfaceWrapper) GetClusterDiagnosticBundle(c *fiber.Ctx) error {
 
-	return response, nil
-}
+	var err error
 
-// ParseCreateClusterResponse parses an HTTP response from a CreateClusterWithResponse call
-func ParseCreateClusterResponse(rsp *http.Response) (*CreateClusterResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
+	// ------------- Path parameter "ID" -------------
+	var id int32
 
-	response := &CreateClusterResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+	err = runtime.BindStyledParameterWithOptions("simple", "ID", c.Params("ID"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter ID: %w", err).Error())
 	}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest Cluster
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON201 = &dest
+	// ------------- Path parameter "bundleId" -------------
+	var bundleId int32
 
+	err = runtime.BindStyledParameterWithOptions("simple", "bundleId", c.Params("bundleId"), &bundleId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter bundleId: %w", err).Error())
 	}
 
-	return response, nil
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.GetClusterDiagnosticBundle(c, id, bundleId)
 }
 
-// ParseImportClusterResponse parses an HTTP response from a ImportClusterWithResponse call
-func ParseImportClusterResponse(rsp *http.Response) (*ImportClusterResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
+// DownloadClusterDiagnosticBundle operation middleware
+func (siw *ServerInterfaceWrapper) DownloadClusterDiagnosticBundle(c *fiber.Ctx) error {
 
-	response := &ImportClusterResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+	var err error
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest Cluster
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON201 = &dest
+	// ------------- Path parameter "ID" -------------
+	var id int32
 
+	err = runtime.BindStyledParameterWithOptions("simple", "ID", c.Params("ID"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter ID: %w", err).Error())
 	}
 
-	return response, nil
-}
+	// ------------- Path parameter "bundleId" -------------
+	var bundleId int32
 
-// ParseDeleteClusterResponse parses an HTTP response from a DeleteClusterWithResponse call
-func ParseDeleteClusterResponse(rsp *http.Response) (*DeleteClusterResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+	err = runtime.BindStyledParameterWithOptions("simple", "bundleId", c.Params("bundleId"), &bundleId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
-		return nil, err
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter bundleId: %w", err).Error())
 	}
 
-	response := &DeleteClusterResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
 
-	return response, nil
+	return siw.Handler.DownloadClusterDiagnosticBundle(c, id, bundleId)
 }
 
-// ParseGetClusterResponse parses an HTTP response from a GetClusterWithResponse call
-func ParseGetClusterResponse(rsp *http.Response) (*GetClusterResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+// ListClusterDiagnostics operation middleware
+func (siw *ServerInterfaceWrapper) ListClusterDiagnostics(c *fiber.Ctx) error {
+
+	var err error
+
+	// ------------- Path parameter "ID" -------------
+	var id int32
+
+	err = runtime.BindStyledParameterWithOptions("simple", "ID", c.Params("ID"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
-		return nil, err
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter ID: %w", err).Error())
 	}
 
-	response := &GetClusterResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Cluster
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListClusterDiagnosticsParams
 
+	var query url.Values
+	query, err = url.ParseQuery(string(c.Request().URI().QueryString()))
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for query string: %w", err).Error())
 	}
 
-	return response, nil
-}
+	// ------------- Optional query parameter "from" -------------
 
-// ParseUpdateClusterResponse parses an HTTP response from a UpdateClusterWithResponse call
-func ParseUpdateClusterResponse(rsp *http.Response) (*UpdateClusterResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+	err = runtime.BindQueryParameter("form", true, false, "from", query, &params.From)
 	if err != nil {
-		return nil, err
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter from: %w", err).Error())
 	}
 
-	response := &UpdateClusterResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+	// ------------- Optional query parameter "to" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "to", query, &params.To)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter to: %w", err).Error())
 	}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Cluster
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+	// ------------- Optional query parameter "page" -------------
 
+	err = runtime.BindQueryParameter("form", true, false, "page", query, &params.Page)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter page: %w", err).Error())
 	}
 
-	return response, nil
-}
+	// ------------- Optional query parameter "perPage" -------------
 
-// ParseGetClusterAutoBackupConfigResponse parses an HTTP response from a GetClusterAutoBackupConfigWithResponse call
-func ParseGetClusterAutoBackupConfigResponse(rsp *http.Response) (*GetClusterAutoBackupConfigResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+	err = runtime.BindQueryParameter("form", true, false, "perPage", query, &params.PerPage)
 	if err != nil {
-		return nil, err
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter perPage: %w", err).Error())
 	}
 
-	response := &GetClusterAutoBackupConfigResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+	return siw.Handler.ListClusterDiagnostics(c, id, params)
+}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest AutoBackupConfig
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+// CreateClusterDiagnostic operation middleware
+func (siw *ServerInterfaceWrapper) CreateClusterDiagnostic(c *fiber.Ctx) error {
 
-	}
+	var err error
 
-	return response, nil
-}
+	// ------------- Path parameter "ID" -------------
+	var id int32
 
-// ParseUpdateClusterAutoBackupConfigResponse parses an HTTP response from a UpdateClusterAutoBackupConfigWithResponse call
-func ParseUpdateClusterAutoBackupConfigResponse(rsp *http.Response) (*UpdateClusterAutoBackupConfigResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+	err = runtime.BindStyledParameterWithOptions("simple", "ID", c.Params("ID"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
-		return nil, err
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter ID: %w", err).Error())
 	}
 
-	response := &UpdateClusterAutoBackupConfigResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
 
-	return response, nil
+	return siw.Handler.CreateClusterDiagnostic(c, id)
 }
 
-// ParseListClusterDiagnosticsResponse parses an HTTP response from a ListClusterDiagnosticsWithResponse call
-func ParseListClusterDiagnosticsResponse(rsp *http.Response) (*ListClusterDiagnosticsResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+// GetClusterAutoDiagnosticConfig operation middleware
+func (siw *ServerInterfaceWrapper) GetClusterAutoDiagnosticConfig(c *fiber.Ctx) error {
+
+	var err error
+
+	// ------------- Path parameter "ID" -------------
+	var id int32
+
+	err = runtime.BindStyledParameterWithOptions("simple", "ID", c.Params("ID"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
-		return nil, err
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter ID: %w", err).Error())
 	}
 
-	response := &ListClusterDiagnosticsResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest []DiagnosticData
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+	return siw.Handler.GetClusterAutoDiagnosticConfig(c, id)
+}
+
+// UpdateClusterAutoDiagnosticConfig operation middleware
+func (siw *ServerInterfaceWrapper) UpdateClusterAutoDiagnosticConfig(c *fiber.Ctx) error {
 
+	var err error
+
+	// ------------- Path parameter "ID" -------------
+	var id int32
+
+	err = runtime.BindStyledParameterWithOptions("simple", "ID", c.Params("ID"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter ID: %w", err).Error())
 	}
 
-	return response, nil
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.UpdateClusterAutoDiagnosticConfig(c, id)
 }
 
-// ParseCreateClusterDiagnosticResponse parses an HTTP response from a CreateClusterDiagnosticWithResponse call
-func ParseCreateClusterDiagnosticResponse(rsp *http.Response) (*CreateClusterDiagnosticResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+// DiffClusterDiagnostics operation middleware
+func (siw *ServerInterfaceWrapper) DiffClusterDiagnostics(c *fiber.Ctx) error {
+
+	var err error
+
+	// ------------- Path parameter "ID" -------------
+	var id int32
+
+	err = runtime.BindStyledParameterWithOptions("simple", "ID", c.Params("ID"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
-		return nil, err
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter ID: %w", err).Error())
 	}
 
-	response := &CreateClusterDiagnosticResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
 
-	return response, nil
-}
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DiffClusterDiagnosticsParams
 
-// ParseGetClusterAutoDiagnosticConfigResponse parses an HTTP response from a GetClusterAutoDiagnosticConfigWithResponse call
-func ParseGetClusterAutoDiagnosticConfigResponse(rsp *http.Response) (*GetClusterAutoDiagnosticConfigResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+	var query url.Values
+	query, err = url.ParseQuery(string(c.Request().URI().QueryString()))
 	if err != nil {
-		return nil, err
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for query string: %w", err).Error())
 	}
 
-	response := &GetClusterAutoDiagnosticConfigResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+	// ------------- Required query parameter "from" -------------
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest AutoDiagnosticConfig
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+	if paramValue := c.Query("from"); paramValue != "" {
 
+	} else {
+		err = fmt.Errorf("Query argument from is required, but not found")
+		c.Status(fiber.StatusBadRequest).JSON(err)
+		return err
 	}
 
-	return response, nil
-}
-
-// ParseUpdateClusterAutoDiagnosticConfigResponse parses an HTTP response from a UpdateClusterAutoDiagnosticConfigWithResponse call
-func ParseUpdateClusterAutoDiagnosticConfigResponse(rsp *http.Response) (*UpdateClusterAutoDiagnosticConfigResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+	err = runtime.BindQueryParameter("form", true, true, "from", query, &params.From)
 	if err != nil {
-		return nil, err
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter from: %w", err).Error())
 	}
 
-	response := &UpdateClusterAutoDiagnosticConfigResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+	// ------------- Required query parameter "to" -------------
 
-	return response, nil
-}
+	if paramValue := c.Query("to"); paramValue != "" {
 
-// ParseGetClusterDiagnosticResponse parses an HTTP response from a GetClusterDiagnosticWithResponse call
-func ParseGetClusterDiagnosticResponse(rsp *http.Response) (*GetClusterDiagnosticResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
+	} else {
+		err = fmt.Errorf("Query argument to is required, but not found")
+		c.Status(fiber.StatusBadRequest).JSON(err)
+		return err
 	}
 
-	response := &GetClusterDiagnosticResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+	err = runtime.BindQueryParameter("form", true, true, "to", query, &params.To)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter to: %w", err).Error())
 	}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest DiagnosticData
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+	return siw.Handler.DiffClusterDiagnostics(c, id, params)
+}
 
-	}
+// SearchClusterDiagnostics operation middleware
+func (siw *ServerInterfaceWrapper) SearchClusterDiagnostics(c *fiber.Ctx) error {
 
-	return response, nil
-}
+	var err error
 
-// ParseRunRisectlCommandResponse parses an HTTP response from a RunRisectlCommandWithResponse call
-func ParseRunRisectlCommandResponse(rsp *http.Response) (*RunRisectlCommandResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+	// ------------- Path parameter "ID" -------------
+	var id int32
+
+	err = runtime.BindStyledParameterWithOptions("simple", "ID", c.Params("ID"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
-		return nil, err
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter ID: %w", err).Error())
 	}
 
-	response := &RunRisectlCommandResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest RisectlCommandResult
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+	// Parameter object where we will unmarshal all parameters from the context
+	var params SearchClusterDiagnosticsParams
 
+	var query url.Values
+	query, err = url.ParseQuery(string(c.Request().URI().QueryString()))
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for query string: %w", err).Error())
 	}
 
-	return response, nil
-}
+	// ------------- Required query parameter "q" -------------
 
-// ParseListClusterSnapshotsResponse parses an HTTP response from a ListClusterSnapshotsWithResponse call
-func ParseListClusterSnapshotsResponse(rsp *http.Response) (*ListClusterSnapshotsResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
+	if paramValue := c.Query("q"); paramValue != "" {
+
+	} else {
+		err = fmt.Errorf("Query argument q is required, but not found")
+		c.Status(fiber.StatusBadRequest).JSON(err)
+		return err
 	}
 
-	response := &ListClusterSnapshotsResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+	err = runtime.BindQueryParameter("form", true, true, "q", query, &params.Q)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter q: %w", err).Error())
 	}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest []Snapshot
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+	// ------------- Optional query parameter "from" -------------
 
+	err = runtime.BindQueryParameter("form", true, false, "from", query, &params.From)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter from: %w", err).Error())
 	}
 
-	return response, nil
-}
+	// ------------- Optional query parameter "to" -------------
 
-// ParseCreateClusterSnapshotResponse parses an HTTP response from a CreateClusterSnapshotWithResponse call
-func ParseCreateClusterSnapshotResponse(rsp *http.Response) (*CreateClusterSnapshotResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+	err = runtime.BindQueryParameter("form", true, false, "to", query, &params.To)
 	if err != nil {
-		return nil, err
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter to: %w", err).Error())
 	}
 
-	response := &CreateClusterSnapshotResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+	// ------------- Optional query parameter "order" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "order", query, &params.Order)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter order: %w", err).Error())
 	}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest Snapshot
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON201 = &dest
+	// ------------- Optional query parameter "limit" -------------
 
+	err = runtime.BindQueryParameter("form", true, false, "limit", query, &params.Limit)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter limit: %w", err).Error())
 	}
 
-	return response, nil
+	return siw.Handler.SearchClusterDiagnostics(c, id, params)
 }
 
-// ParseDeleteClusterSnapshotResponse parses an HTTP response from a DeleteClusterSnapshotWithResponse call
-func ParseDeleteClusterSnapshotResponse(rsp *http.Response) (*DeleteClusterSnapshotResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+// GetClusterDiagnostic operation middleware
+func (siw *ServerInterfaceWrapper) GetClusterDiagnostic(c *fiber.Ctx) error {
+
+	var err error
+
+	// ------------- Path parameter "ID" -------------
+	var id int32
+
+	err = runtime.BindStyledParameterWithOptions("simple", "ID", c.Params("ID"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
-		return nil, err
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter ID: %w", err).Error())
 	}
 
-	response := &DeleteClusterSnapshotResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+	// ------------- Path parameter "diagnosticId" -------------
+	var diagnosticId int32
+
+	err = runtime.BindStyledParameterWithOptions("simple", "diagnosticId", c.Params("diagnosticId"), &diagnosticId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter diagnosticId: %w", err).Error())
 	}
 
-	return response, nil
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.GetClusterDiagnostic(c, id, diagnosticId)
 }
 
-// ParseRestoreClusterSnapshotResponse parses an HTTP response from a RestoreClusterSnapshotWithResponse call
-func ParseRestoreClusterSnapshotResponse(rsp *http.Response) (*RestoreClusterSnapshotResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+// ListClusterDiagnosticFindings operation middleware
+func (siw *ServerInterfaceWrapper) ListClusterDiagnosticFindings(c *fiber.Ctx) error {
+
+	var err error
+
+	// ------------- Path parameter "ID" -------------
+	var id int32
+
+	err = runtime.BindStyledParameterWithOptions("simple", "ID", c.Params("ID"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
-		return nil, err
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter ID: %w", err).Error())
 	}
 
-	response := &RestoreClusterSnapshotResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+	// ------------- Path parameter "diagnosticId" -------------
+	var diagnosticId int32
+
+	err = runtime.BindStyledParameterWithOptions("simple", "diagnosticId", c.Params("diagnosticId"), &diagnosticId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter diagnosticId: %w", err).Error())
 	}
 
-	return response, nil
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.ListClusterDiagnosticFindings(c, id, diagnosticId)
 }
 
-// ParseListDatabasesResponse parses an HTTP response from a ListDatabasesWithResponse call
-func ParseListDatabasesResponse(rsp *http.Response) (*ListDatabasesResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
+// GetClusterDiagnosticReport operation middleware
+func (siw *ServerInterfaceWrapper) GetClusterDiagnosticReport(c *fiber.Ctx) error {
 
-	response := &ListDatabasesResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+	var err error
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest []Database
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+	// ------------- Path parameter "ID" -------------
+	var id int32
 
+	err = runtime.BindStyledParameterWithOptions("simple", "ID", c.Params("ID"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter ID: %w", err).Error())
 	}
 
-	return response, nil
-}
+	// ------------- Path parameter "diagnosticId" -------------
+	var diagnosticId int32
 
-// ParseImportDatabaseResponse parses an HTTP response from a ImportDatabaseWithResponse call
-func ParseImportDatabaseResponse(rsp *http.Response) (*ImportDatabaseResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+	err = runtime.BindStyledParameterWithOptions("simple", "diagnosticId", c.Params("diagnosticId"), &diagnosticId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
-		return nil, err
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter diagnosticId: %w", err).Error())
 	}
 
-	response := &ImportDatabaseResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest Database
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON201 = &dest
+	return siw.Handler.GetClusterDiagnosticReport(c, id, diagnosticId)
+}
 
-	}
+// ListClusterEvents operation middleware
+func (siw *ServerInterfaceWrapper) ListClusterEvents(c *fiber.Ctx) error {
 
-	return response, nil
-}
+	var err error
+
+	// ------------- Path parameter "ID" -------------
+	var id int32
 
-// ParseTestDatabaseConnectionResponse parses an HTTP response from a TestDatabaseConnectionWithResponse call
-func ParseTestDatabaseConnectionResponse(rsp *http.Response) (*TestDatabaseConnectionResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+	err = runtime.BindStyledParameterWithOptions("simple", "ID", c.Params("ID"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
-		return nil, err
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter ID: %w", err).Error())
 	}
 
-	response := &TestDatabaseConnectionResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest TestDatabaseConnectionResult
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListClusterEventsParams
 
+	var query url.Values
+	query, err = url.ParseQuery(string(c.Request().URI().QueryString()))
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for query string: %w", err).Error())
 	}
 
-	return response, nil
-}
+	// ------------- Optional query parameter "type" -------------
 
-// ParseDeleteDatabaseResponse parses an HTTP response from a DeleteDatabaseWithResponse call
-func ParseDeleteDatabaseResponse(rsp *http.Response) (*DeleteDatabaseResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+	err = runtime.BindQueryParameter("form", true, false, "type", query, &params.Type)
 	if err != nil {
-		return nil, err
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter type: %w", err).Error())
 	}
 
-	response := &DeleteDatabaseResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", query, &params.Limit)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter limit: %w", err).Error())
 	}
 
-	return response, nil
+	return siw.Handler.ListClusterEvents(c, id, params)
 }
 
-// ParseGetDatabaseResponse parses an HTTP response from a GetDatabaseWithResponse call
-func ParseGetDatabaseResponse(rsp *http.Response) (*GetDatabaseResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+// ListClusterHealthHistory operation middleware
+func (siw *ServerInterfaceWrapper) ListClusterHealthHistory(c *fiber.Ctx) error {
+
+	var err error
+
+	// ------------- Path parameter "ID" -------------
+	var id int32
+
+	err = runtime.BindStyledParameterWithOptions("simple", "ID", c.Params("ID"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
-		return nil, err
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter ID: %w", err).Error())
 	}
 
-	response := &GetDatabaseResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Database
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListClusterHealthHistoryParams
 
+	var query url.Values
+	query, err = url.ParseQuery(string(c.Request().URI().QueryString()))
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for query string: %w", err).Error())
 	}
 
-	return response, nil
-}
+	// ------------- Optional query parameter "from" -------------
 
-// ParseUpdateDatabaseResponse parses an HTTP response from a UpdateDatabaseWithResponse call
-func ParseUpdateDatabaseResponse(rsp *http.Response) (*UpdateDatabaseResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+	err = runtime.BindQueryParameter("form", true, false, "from", query, &params.From)
 	if err != nil {
-		return nil, err
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter from: %w", err).Error())
 	}
 
-	response := &UpdateDatabaseResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+	// ------------- Optional query parameter "to" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "to", query, &params.To)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter to: %w", err).Error())
 	}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest Database
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+	// ------------- Optional query parameter "limit" -------------
 
+	err = runtime.BindQueryParameter("form", true, false, "limit", query, &params.Limit)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter limit: %w", err).Error())
 	}
 
-	return response, nil
+	return siw.Handler.ListClusterHealthHistory(c, id, params)
 }
 
-// ParseGetDDLProgressResponse parses an HTTP response from a GetDDLProgressWithResponse call
-func ParseGetDDLProgressResponse(rsp *http.Response) (*GetDDLProgressResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
+// RunRisectlCommand operation middleware
+func (siw *ServerInterfaceWrapper) RunRisectlCommand(c *fiber.Ctx) error {
 
-	response := &GetDDLProgressResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+	var err error
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest []DDLProgress
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+	// ------------- Path parameter "ID" -------------
+	var id int32
 
+	err = runtime.BindStyledParameterWithOptions("simple", "ID", c.Params("ID"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter ID: %w", err).Error())
 	}
 
-	return response, nil
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.RunRisectlCommand(c, id)
 }
 
-// ParseCancelDDLProgressResponse parses an HTTP response from a CancelDDLProgressWithResponse call
-func ParseCancelDDLProgressResponse(rsp *http.Response) (*CancelDDLProgressResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+// ListRisectlExecutions operation middleware
+func (siw *ServerInterfaceWrapper) ListRisectlExecutions(c *fiber.Ctx) error {
+
+	var err error
+
+	// ------------- Path parameter "ID" -------------
+	var id int32
+
+	err = runtime.BindStyledParameterWithOptions("simple", "ID", c.Params("ID"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
-		return nil, err
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter ID: %w", err).Error())
 	}
 
-	response := &CancelDDLProgressResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
 
-	return response, nil
-}
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListRisectlExecutionsParams
 
-// ParseQueryDatabaseResponse parses an HTTP response from a QueryDatabaseWithResponse call
-func ParseQueryDatabaseResponse(rsp *http.Response) (*QueryDatabaseResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+	var query url.Values
+	query, err = url.ParseQuery(string(c.Request().URI().QueryString()))
 	if err != nil {
-		return nil, err
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for query string: %w", err).Error())
 	}
 
-	response := &QueryDatabaseResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+	// ------------- Optional query parameter "before" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "before", query, &params.Before)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter before: %w", err).Error())
 	}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest QueryResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+	// ------------- Optional query parameter "limit" -------------
 
+	err = runtime.BindQueryParameter("form", true, false, "limit", query, &params.Limit)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter limit: %w", err).Error())
 	}
 
-	return response, nil
+	return siw.Handler.ListRisectlExecutions(c, id, params)
 }
 
-// ParseListEventsResponse parses an HTTP response from a ListEventsWithResponse call
-func ParseListEventsResponse(rsp *http.Response) (*ListEventsResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
+// CreateRisectlExecution operation middleware
+func (siw *ServerInterfaceWrapper) CreateRisectlExecution(c *fiber.Ctx) error {
 
-	response := &ListEventsResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+	var err error
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest []Event
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+	// ------------- Path parameter "ID" -------------
+	var id int32
 
+	err = runtime.BindStyledParameterWithOptions("simple", "ID", c.Params("ID"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter ID: %w", err).Error())
 	}
 
-	return response, nil
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.CreateRisectlExecution(c, id)
 }
 
-// ParseListMetricsStoresResponse parses an HTTP response from a ListMetricsStoresWithResponse call
-func ParseListMetricsStoresResponse(rsp *http.Response) (*ListMetricsStoresResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+// GetRisectlExecution operation middleware
+func (siw *ServerInterfaceWrapper) GetRisectlExecution(c *fiber.Ctx) error {
+
+	var err error
+
+	// ------------- Path parameter "ID" -------------
+	var id int32
+
+	err = runtime.BindStyledParameterWithOptions("simple", "ID", c.Params("ID"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
-		return nil, err
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter ID: %w", err).Error())
 	}
 
-	response := &ListMetricsStoresResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+	// ------------- Path parameter "executionID" -------------
+	var executionID int32
+
+	err = runtime.BindStyledParameterWithOptions("simple", "executionID", c.Params("executionID"), &executionID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter executionID: %w", err).Error())
 	}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest []MetricsStore
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.GetRisectlExecution(c, id, executionID)
+}
 
+// CancelRisectlExecution operation middleware
+func (siw *ServerInterfaceWrapper) CancelRisectlExecution(c *fiber.Ctx) error {
+
+	var err error
+
+	// ------------- Path parameter "ID" -------------
+	var id int32
+
+	err = runtime.BindStyledParameterWithOptions("simple", "ID", c.Params("ID"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter ID: %w", err).Error())
 	}
 
-	return response, nil
-}
+	// ------------- Path parameter "executionID" -------------
+	var executionID int32
 
-// ParseImportMetricsStoreResponse parses an HTTP response from a ImportMetricsStoreWithResponse call
-func ParseImportMetricsStoreResponse(rsp *http.Response) (*ImportMetricsStoreResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+	err = runtime.BindStyledParameterWithOptions("simple", "executionID", c.Params("executionID"), &executionID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
-		return nil, err
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter executionID: %w", err).Error())
 	}
 
-	response := &ImportMetricsStoreResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.CancelRisectlExecution(c, id, executionID)
+}
+
+// ListRisectlExecutionOutputs operation middleware
+func (siw *ServerInterfaceWrapper) ListRisectlExecutionOutputs(c *fiber.Ctx) error {
+
+	var err error
+
+	// ------------- Path parameter "ID" -------------
+	var id int32
+
+	err = runtime.BindStyledParameterWithOptions("simple", "ID", c.Params("ID"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter ID: %w", err).Error())
 	}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest MetricsStore
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON201 = &dest
+	// ------------- Path parameter "executionID" -------------
+	var executionID int32
 
+	err = runtime.BindStyledParameterWithOptions("simple", "executionID", c.Params("executionID"), &executionID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter executionID: %w", err).Error())
 	}
 
-	return response, nil
-}
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
 
-// ParseDeleteMetricsStoreResponse parses an HTTP response from a DeleteMetricsStoreWithResponse call
-func ParseDeleteMetricsStoreResponse(rsp *http.Response) (*DeleteMetricsStoreResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListRisectlExecutionOutputsParams
+
+	var query url.Values
+	query, err = url.ParseQuery(string(c.Request().URI().QueryString()))
 	if err != nil {
-		return nil, err
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for query string: %w", err).Error())
 	}
 
-	response := &DeleteMetricsStoreResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+	// ------------- Optional query parameter "after" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "after", query, &params.After)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter after: %w", err).Error())
 	}
 
-	return response, nil
+	return siw.Handler.ListRisectlExecutionOutputs(c, id, executionID, params)
 }
 
-// ParseGetMetricsStoreResponse parses an HTTP response from a GetMetricsStoreWithResponse call
-func ParseGetMetricsStoreResponse(rsp *http.Response) (*GetMetricsStoreResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
+// StreamRisectlExecution operation middleware
+func (siw *ServerInterfaceWrapper) StreamRisectlExecution(c *fiber.Ctx) error {
 
-	response := &GetMetricsStoreResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+	var err error
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest MetricsStore
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+	// ------------- Path parameter "ID" -------------
+	var id int32
 
+	err = runtime.BindStyledParameterWithOptions("simple", "ID", c.Params("ID"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter ID: %w", err).Error())
 	}
 
-	return response, nil
-}
+	// ------------- Path parameter "executionID" -------------
+	var executionID int32
 
-// ParseUpdateMetricsStoreResponse parses an HTTP response from a UpdateMetricsStoreWithResponse call
-func ParseUpdateMetricsStoreResponse(rsp *http.Response) (*UpdateMetricsStoreResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+	err = runtime.BindStyledParameterWithOptions("simple", "executionID", c.Params("executionID"), &executionID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
-		return nil, err
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter executionID: %w", err).Error())
 	}
 
-	response := &UpdateMetricsStoreResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
 
-	return response, nil
-}
+	// Parameter object where we will unmarshal all parameters from the context
+	var params StreamRisectlExecutionParams
 
-// ParseGetMaterializedViewThroughputResponse parses an HTTP response from a GetMaterializedViewThroughputWithResponse call
-func ParseGetMaterializedViewThroughputResponse(rsp *http.Response) (*GetMaterializedViewThroughputResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
+	var query url.Values
+	query, err = url.ParseQuery(string(c.Request().URI().QueryString()))
 	if err != nil {
-		return nil, err
-	}
-
-	response := &GetMaterializedViewThroughputResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for query string: %w", err).Error())
 	}
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest MetricMatrix
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+	// ------------- Optional query parameter "after" -------------
 
+	err = runtime.BindQueryParameter("form", true, false, "after", query, &params.After)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter after: %w", err).Error())
 	}
 
-	return response, nil
+	return siw.Handler.StreamRisectlExecution(c, id, executionID, params)
 }
 
-// ParseListTasksResponse parses an HTTP response from a ListTasksWithResponse call
-func ParseListTasksResponse(rsp *http.Response) (*ListTasksResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
+// ListRisectlOperations operation middleware
+func (siw *ServerInterfaceWrapper) ListRisectlOperations(c *fiber.Ctx) error {
 
-	response := &ListTasksResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+	var err error
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest []Task
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+	// ------------- Path parameter "ID" -------------
+	var id int32
 
+	err = runtime.BindStyledParameterWithOptions("simple", "ID", c.Params("ID"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter ID: %w", err).Error())
 	}
 
-	return response, nil
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.ListRisectlOperations(c, id)
 }
 
-// ParseTestClusterConnectionResponse parses an HTTP response from a TestClusterConnectionWithResponse call
-func ParseTestClusterConnectionResponse(rsp *http.Response) (*TestClusterConnectionResponse, error) {
-	bodyBytes, err := io.ReadAll(rsp.Body)
-	defer func() { _ = rsp.Body.Close() }()
-	if err != nil {
-		return nil, err
-	}
+// RunRisectlOperation operation middleware
+func (siw *ServerInterfaceWrapper) RunRisectlOperation(c *fiber.Ctx) error {
 
-	response := &TestClusterConnectionResponse{
-		Body:         bodyBytes,
-		HTTPResponse: rsp,
-	}
+	var err error
 
-	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest TestClusterConnectionResult
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
+	// ------------- Path parameter "ID" -------------
+	var id int32
 
+	err = runtime.BindStyledParameterWithOptions("simple", "ID", c.Params("ID"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter ID: %w", err).Error())
 	}
 
-	return response, nil
-}
+	// ------------- Path parameter "operation" -------------
+	var operation RisectlOperationName
 
-// ServerInterface represents all server handlers.
-type ServerInterface interface {
-	// List all cluster versions
-	// (GET /cluster-versions)
-	ListClusterVersions(c *fiber.Ctx) error
-	// List all clusters
-	// (GET /clusters)
-	ListClusters(c *fiber.Ctx) error
-	// Create a new cluster
-	// (POST /clusters)
-	CreateCluster(c *fiber.Ctx) error
-	// Import a cluster
-	// (POST /clusters/import)
-	ImportCluster(c *fiber.Ctx) error
-	// Delete cluster
-	// (DELETE /clusters/{ID})
-	DeleteCluster(c *fiber.Ctx, id int32, params DeleteClusterParams) error
-	// Get cluster details
-	// (GET /clusters/{ID})
-	GetCluster(c *fiber.Ctx, id int32) error
-	// Update cluster
-	// (PUT /clusters/{ID})
-	UpdateCluster(c *fiber.Ctx, id int32) error
-	// Get snapshot configuration
-	// (GET /clusters/{ID}/auto-backup-config)
-	GetClusterAutoBackupConfig(c *fiber.Ctx, id int32) error
-	// Update snapshot configuration
-	// (PUT /clusters/{ID}/auto-backup-config)
-	UpdateClusterAutoBackupConfig(c *fiber.Ctx, id int32) error
-	// List diagnostic data
-	// (GET /clusters/{ID}/diagnostics)
-	ListClusterDiagnostics(c *fiber.Ctx, id int32, params ListClusterDiagnosticsParams) error
-	// Create diagnostic data
-	// (POST /clusters/{ID}/diagnostics)
-	CreateClusterDiagnostic(c *fiber.Ctx, id int32) error
-	// Get diagnostic configuration
-	// (GET /clusters/{ID}/diagnostics/config)
-	GetClusterAutoDiagnosticConfig(c *fiber.Ctx, id int32) error
-	// Update diagnostic configuration
-	// (PUT /clusters/{ID}/diagnostics/config)
-	UpdateClusterAutoDiagnosticConfig(c *fiber.Ctx, id int32) error
-	// Get diagnostic data
-	// (GET /clusters/{ID}/diagnostics/{diagnosticId})
-	GetClusterDiagnostic(c *fiber.Ctx, id int32, diagnosticId int32) error
-	// Run risectl command
-	// (POST /clusters/{ID}/risectl)
-	RunRisectlCommand(c *fiber.Ctx, id int32) error
-	// List cluster snapshots
-	// (GET /clusters/{ID}/snapshots)
-	ListClusterSnapshots(c *fiber.Ctx, id int32) error
-	// Create a new snapshot
-	// (POST /clusters/{ID}/snapshots)
-	CreateClusterSnapshot(c *fiber.Ctx, id int32) error
-	// Delete snapshot
-	// (DELETE /clusters/{ID}/snapshots/{snapshotId})
-	DeleteClusterSnapshot(c *fiber.Ctx, id int32, snapshotId int64) error
-	// Restore snapshot
-	// (POST /clusters/{ID}/snapshots/{snapshotId})
-	RestoreClusterSnapshot(c *fiber.Ctx, id int32, snapshotId int64) error
-	// List all databases
-	// (GET /databases)
-	ListDatabases(c *fiber.Ctx) error
-	// Import a database
-	// (POST /databases/import)
-	ImportDatabase(c *fiber.Ctx) error
-	// Test database connection
-	// (POST /databases/test-connection)
-	TestDatabaseConnection(c *fiber.Ctx) error
-	// Delete database
-	// (DELETE /databases/{ID})
-	DeleteDatabase(c *fiber.Ctx, id int32) error
-	// Get database details
-	// (GET /databases/{ID})
-	GetDatabase(c *fiber.Ctx, id int32) error
-	// Update database
-	// (PUT /databases/{ID})
-	UpdateDatabase(c *fiber.Ctx, id int32) error
-	// Get DDL progress
-	// (GET /databases/{ID}/ddl-progress)
-	GetDDLProgress(c *fiber.Ctx, id int32) error
-	// Cancel DDL progress
-	// (POST /databases/{ID}/ddl-progress/{ddlID}/cancel)
-	CancelDDLProgress(c *fiber.Ctx, id int32, ddlID int64) error
-	// Query database
-	// (POST /databases/{ID}/query)
-	QueryDatabase(c *fiber.Ctx, id int32) error
-	// Get all events
-	// (GET /events)
-	ListEvents(c *fiber.Ctx) error
-	// Get all metrics stores
-	// (GET /metrics-stores)
-	ListMetricsStores(c *fiber.Ctx) error
-	// Import a metrics store
-	// (POST /metrics-stores/import)
-	ImportMetricsStore(c *fiber.Ctx) error
-	// Delete a metrics store
-	// (DELETE /metrics-stores/{ID})
-	DeleteMetricsStore(c *fiber.Ctx, id int32, params DeleteMetricsStoreParams) error
-	// Get a metrics store
-	// (GET /metrics-stores/{ID})
-	GetMetricsStore(c *fiber.Ctx, id int32) error
-	// Update a metrics store
-	// (PUT /metrics-stores/{ID})
-	UpdateMetricsStore(c *fiber.Ctx, id int32) error
-	// Get materialized view throughput
-	// (GET /metrics/{clusterID}/materialized-view-throughput)
-	GetMaterializedViewThroughput(c *fiber.Ctx, clusterID int32) error
-	// Get all tasks
-	// (GET /tasks)
-	ListTasks(c *fiber.Ctx) error
-	// Test cluster connection
-	// (POST /test-cluster-connection)
-	TestClusterConnection(c *fiber.Ctx) error
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "operation", c.Params("operation"), &operation, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter operation: %w", err).Error())
+	}
 
-// ServerInterfaceWrapper converts contexts to parameters.
-type ServerInterfaceWrapper struct {
-	Handler ServerInterface
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.RunRisectlOperation(c, id, operation)
 }
 
-type MiddlewareFunc fiber.Handler
+// OpenRisectlShell operation middleware
+func (siw *ServerInterfaceWrapper) OpenRisectlShell(c *fiber.Ctx) error {
 
-// ListClusterVersions operation middleware
-func (siw *ServerInterfaceWrapper) ListClusterVersions(c *fiber.Ctx) error {
+	var err error
 
-	return siw.Handler.ListClusterVersions(c)
+	// ------------- Path parameter "ID" -------------
+	var id int32
+
+	err = runtime.BindStyledParameterWithOptions("simple", "ID", c.Params("ID"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter ID: %w", err).Error())
+	}
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.OpenRisectlShell(c, id)
 }
 
-// ListClusters operation middleware
-func (siw *ServerInterfaceWrapper) ListClusters(c *fiber.Ctx) error {
+// ListRisectlShellSessions operation middleware
+func (siw *ServerInterfaceWrapper) ListRisectlShellSessions(c *fiber.Ctx) error {
+
+	var err error
+
+	// ------------- Path parameter "ID" -------------
+	var id int32
+
+	err = runtime.BindStyledParameterWithOptions("simple", "ID", c.Params("ID"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter ID: %w", err).Error())
+	}
 
 	c.Context().SetUserValue(BearerAuthScopes, []string{})
 
-	return siw.Handler.ListClusters(c)
+	return siw.Handler.ListRisectlShellSessions(c, id)
 }
 
-// CreateCluster operation middleware
-func (siw *ServerInterfaceWrapper) CreateCluster(c *fiber.Ctx) error {
+// ListRisectlShellCommands operation middleware
+func (siw *ServerInterfaceWrapper) ListRisectlShellCommands(c *fiber.Ctx) error {
 
-	c.Context().SetUserValue(BearerAuthScopes, []string{"x.PremiumAccess(c)"})
+	var err error
 
-	return siw.Handler.CreateCluster(c)
+	// ------------- Path parameter "ID" -------------
+	var id int32
+
+	err = runtime.BindStyledParameterWithOptions("simple", "ID", c.Params("ID"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter ID: %w", err).Error())
+	}
+
+	// ------------- Path parameter "sessionID" -------------
+	var sessionID int32
+
+	err = runtime.BindStyledParameterWithOptions("simple", "sessionID", c.Params("sessionID"), &sessionID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter sessionID: %w", err).Error())
+	}
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.ListRisectlShellCommands(c, id, sessionID)
 }
 
-// ImportCluster operation middleware
-func (siw *ServerInterfaceWrapper) ImportCluster(c *fiber.Ctx) error {
+// ListClusterSnapshots operation middleware
+func (siw *ServerInterfaceWrapper) ListClusterSnapshots(c *fiber.Ctx) error {
+
+	var err error
+
+	// ------------- Path parameter "ID" -------------
+	var id int32
+
+	err = runtime.BindStyledParameterWithOptions("simple", "ID", c.Params("ID"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter ID: %w", err).Error())
+	}
 
 	c.Context().SetUserValue(BearerAuthScopes, []string{})
 
-	return siw.Handler.ImportCluster(c)
+	return siw.Handler.ListClusterSnapshots(c, id)
 }
 
-// DeleteCluster operation middleware
-func (siw *ServerInterfaceWrapper) DeleteCluster(c *fiber.Ctx) error {
+// CreateClusterSnapshot operation middleware
+func (siw *ServerInterfaceWrapper) CreateClusterSnapshot(c *fiber.Ctx) error {
 
 	var err error
 
@@ -5426,27 +14087,37 @@ func (siw *ServerInterfaceWrapper) DeleteCluster(c *fiber.Ctx) error {
 
 	c.Context().SetUserValue(BearerAuthScopes, []string{})
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params DeleteClusterParams
+	return siw.Handler.CreateClusterSnapshot(c, id)
+}
 
-	var query url.Values
-	query, err = url.ParseQuery(string(c.Request().URI().QueryString()))
+// DeleteClusterSnapshot operation middleware
+func (siw *ServerInterfaceWrapper) DeleteClusterSnapshot(c *fiber.Ctx) error {
+
+	var err error
+
+	// ------------- Path parameter "ID" -------------
+	var id int32
+
+	err = runtime.BindStyledParameterWithOptions("simple", "ID", c.Params("ID"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
-		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for query string: %w", err).Error())
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter ID: %w", err).Error())
 	}
 
-	// ------------- Optional query parameter "cascade" -------------
+	// ------------- Path parameter "snapshotId" -------------
+	var snapshotId int64
 
-	err = runtime.BindQueryParameter("form", true, false, "cascade", query, &params.Cascade)
+	err = runtime.BindStyledParameterWithOptions("simple", "snapshotId", c.Params("snapshotId"), &snapshotId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
-		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter cascade: %w", err).Error())
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter snapshotId: %w", err).Error())
 	}
 
-	return siw.Handler.DeleteCluster(c, id, params)
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.DeleteClusterSnapshot(c, id, snapshotId)
 }
 
-// GetCluster operation middleware
-func (siw *ServerInterfaceWrapper) GetCluster(c *fiber.Ctx) error {
+// RestoreClusterSnapshot operation middleware
+func (siw *ServerInterfaceWrapper) RestoreClusterSnapshot(c *fiber.Ctx) error {
 
 	var err error
 
@@ -5458,13 +14129,21 @@ func (siw *ServerInterfaceWrapper) GetCluster(c *fiber.Ctx) error {
 		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter ID: %w", err).Error())
 	}
 
+	// ------------- Path parameter "snapshotId" -------------
+	var snapshotId int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "snapshotId", c.Params("snapshotId"), &snapshotId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter snapshotId: %w", err).Error())
+	}
+
 	c.Context().SetUserValue(BearerAuthScopes, []string{})
 
-	return siw.Handler.GetCluster(c, id)
+	return siw.Handler.RestoreClusterSnapshot(c, id, snapshotId)
 }
 
-// UpdateCluster operation middleware
-func (siw *ServerInterfaceWrapper) UpdateCluster(c *fiber.Ctx) error {
+// ListClusterUpgrades operation middleware
+func (siw *ServerInterfaceWrapper) ListClusterUpgrades(c *fiber.Ctx) error {
 
 	var err error
 
@@ -5478,11 +14157,11 @@ func (siw *ServerInterfaceWrapper) UpdateCluster(c *fiber.Ctx) error {
 
 	c.Context().SetUserValue(BearerAuthScopes, []string{})
 
-	return siw.Handler.UpdateCluster(c, id)
+	return siw.Handler.ListClusterUpgrades(c, id)
 }
 
-// GetClusterAutoBackupConfig operation middleware
-func (siw *ServerInterfaceWrapper) GetClusterAutoBackupConfig(c *fiber.Ctx) error {
+// CreateClusterUpgrade operation middleware
+func (siw *ServerInterfaceWrapper) CreateClusterUpgrade(c *fiber.Ctx) error {
 
 	var err error
 
@@ -5496,11 +14175,11 @@ func (siw *ServerInterfaceWrapper) GetClusterAutoBackupConfig(c *fiber.Ctx) erro
 
 	c.Context().SetUserValue(BearerAuthScopes, []string{})
 
-	return siw.Handler.GetClusterAutoBackupConfig(c, id)
+	return siw.Handler.CreateClusterUpgrade(c, id)
 }
 
-// UpdateClusterAutoBackupConfig operation middleware
-func (siw *ServerInterfaceWrapper) UpdateClusterAutoBackupConfig(c *fiber.Ctx) error {
+// GetClusterUpgrade operation middleware
+func (siw *ServerInterfaceWrapper) GetClusterUpgrade(c *fiber.Ctx) error {
 
 	var err error
 
@@ -5512,13 +14191,21 @@ func (siw *ServerInterfaceWrapper) UpdateClusterAutoBackupConfig(c *fiber.Ctx) e
 		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter ID: %w", err).Error())
 	}
 
+	// ------------- Path parameter "upgradeID" -------------
+	var upgradeID int32
+
+	err = runtime.BindStyledParameterWithOptions("simple", "upgradeID", c.Params("upgradeID"), &upgradeID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter upgradeID: %w", err).Error())
+	}
+
 	c.Context().SetUserValue(BearerAuthScopes, []string{})
 
-	return siw.Handler.UpdateClusterAutoBackupConfig(c, id)
+	return siw.Handler.GetClusterUpgrade(c, id, upgradeID)
 }
 
-// ListClusterDiagnostics operation middleware
-func (siw *ServerInterfaceWrapper) ListClusterDiagnostics(c *fiber.Ctx) error {
+// CancelClusterUpgrade operation middleware
+func (siw *ServerInterfaceWrapper) CancelClusterUpgrade(c *fiber.Ctx) error {
 
 	var err error
 
@@ -5530,50 +14217,115 @@ func (siw *ServerInterfaceWrapper) ListClusterDiagnostics(c *fiber.Ctx) error {
 		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter ID: %w", err).Error())
 	}
 
+	// ------------- Path parameter "upgradeID" -------------
+	var upgradeID int32
+
+	err = runtime.BindStyledParameterWithOptions("simple", "upgradeID", c.Params("upgradeID"), &upgradeID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter upgradeID: %w", err).Error())
+	}
+
 	c.Context().SetUserValue(BearerAuthScopes, []string{})
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params ListClusterDiagnosticsParams
+	return siw.Handler.CancelClusterUpgrade(c, id, upgradeID)
+}
 
-	var query url.Values
-	query, err = url.ParseQuery(string(c.Request().URI().QueryString()))
+// ListClusterVersionHistory operation middleware
+func (siw *ServerInterfaceWrapper) ListClusterVersionHistory(c *fiber.Ctx) error {
+
+	var err error
+
+	// ------------- Path parameter "ID" -------------
+	var id int32
+
+	err = runtime.BindStyledParameterWithOptions("simple", "ID", c.Params("ID"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
-		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for query string: %w", err).Error())
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter ID: %w", err).Error())
 	}
 
-	// ------------- Optional query parameter "from" -------------
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
 
-	err = runtime.BindQueryParameter("form", true, false, "from", query, &params.From)
+	return siw.Handler.ListClusterVersionHistory(c, id)
+}
+
+// ListDatabases operation middleware
+func (siw *ServerInterfaceWrapper) ListDatabases(c *fiber.Ctx) error {
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.ListDatabases(c)
+}
+
+// ImportDatabase operation middleware
+func (siw *ServerInterfaceWrapper) ImportDatabase(c *fiber.Ctx) error {
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.ImportDatabase(c)
+}
+
+// TestDatabaseConnection operation middleware
+func (siw *ServerInterfaceWrapper) TestDatabaseConnection(c *fiber.Ctx) error {
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.TestDatabaseConnection(c)
+}
+
+// DeleteDatabase operation middleware
+func (siw *ServerInterfaceWrapper) DeleteDatabase(c *fiber.Ctx) error {
+
+	var err error
+
+	// ------------- Path parameter "ID" -------------
+	var id int32
+
+	err = runtime.BindStyledParameterWithOptions("simple", "ID", c.Params("ID"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
-		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter from: %w", err).Error())
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter ID: %w", err).Error())
 	}
 
-	// ------------- Optional query parameter "to" -------------
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
 
-	err = runtime.BindQueryParameter("form", true, false, "to", query, &params.To)
+	return siw.Handler.DeleteDatabase(c, id)
+}
+
+// GetDatabase operation middleware
+func (siw *ServerInterfaceWrapper) GetDatabase(c *fiber.Ctx) error {
+
+	var err error
+
+	// ------------- Path parameter "ID" -------------
+	var id int32
+
+	err = runtime.BindStyledParameterWithOptions("simple", "ID", c.Params("ID"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
-		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter to: %w", err).Error())
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter ID: %w", err).Error())
 	}
 
-	// ------------- Optional query parameter "page" -------------
+	c.Context().SetUserValue(BearerAuthScopes, []string{"x.OwnDatabase(c, x.GetOrgID(c), id)"})
 
-	err = runtime.BindQueryParameter("form", true, false, "page", query, &params.Page)
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetDatabaseParams
+
+	var query url.Values
+	query, err = url.ParseQuery(string(c.Request().URI().QueryString()))
 	if err != nil {
-		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter page: %w", err).Error())
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for query string: %w", err).Error())
 	}
 
-	// ------------- Optional query parameter "perPage" -------------
+	// ------------- Optional query parameter "refresh" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "perPage", query, &params.PerPage)
+	err = runtime.BindQueryParameter("form", true, false, "refresh", query, &params.Refresh)
 	if err != nil {
-		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter perPage: %w", err).Error())
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter refresh: %w", err).Error())
 	}
 
-	return siw.Handler.ListClusterDiagnostics(c, id, params)
+	return siw.Handler.GetDatabase(c, id, params)
 }
 
-// CreateClusterDiagnostic operation middleware
-func (siw *ServerInterfaceWrapper) CreateClusterDiagnostic(c *fiber.Ctx) error {
+// UpdateDatabase operation middleware
+func (siw *ServerInterfaceWrapper) UpdateDatabase(c *fiber.Ctx) error {
 
 	var err error
 
@@ -5587,11 +14339,11 @@ func (siw *ServerInterfaceWrapper) CreateClusterDiagnostic(c *fiber.Ctx) error {
 
 	c.Context().SetUserValue(BearerAuthScopes, []string{})
 
-	return siw.Handler.CreateClusterDiagnostic(c, id)
+	return siw.Handler.UpdateDatabase(c, id)
 }
 
-// GetClusterAutoDiagnosticConfig operation middleware
-func (siw *ServerInterfaceWrapper) GetClusterAutoDiagnosticConfig(c *fiber.Ctx) error {
+// ListDatabaseCatalogChanges operation middleware
+func (siw *ServerInterfaceWrapper) ListDatabaseCatalogChanges(c *fiber.Ctx) error {
 
 	var err error
 
@@ -5603,13 +14355,50 @@ func (siw *ServerInterfaceWrapper) GetClusterAutoDiagnosticConfig(c *fiber.Ctx)
 		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter ID: %w", err).Error())
 	}
 
-	c.Context().SetUserValue(BearerAuthScopes, []string{})
+	c.Context().SetUserValue(BearerAuthScopes, []string{"x.OwnDatabase(c, x.GetOrgID(c), id)"})
 
-	return siw.Handler.GetClusterAutoDiagnosticConfig(c, id)
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListDatabaseCatalogChangesParams
+
+	var query url.Values
+	query, err = url.ParseQuery(string(c.Request().URI().QueryString()))
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for query string: %w", err).Error())
+	}
+
+	// ------------- Optional query parameter "relationID" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "relationID", query, &params.RelationID)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter relationID: %w", err).Error())
+	}
+
+	// ------------- Optional query parameter "relationName" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "relationName", query, &params.RelationName)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter relationName: %w", err).Error())
+	}
+
+	// ------------- Optional query parameter "before" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "before", query, &params.Before)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter before: %w", err).Error())
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", query, &params.Limit)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter limit: %w", err).Error())
+	}
+
+	return siw.Handler.ListDatabaseCatalogChanges(c, id, params)
 }
 
-// UpdateClusterAutoDiagnosticConfig operation middleware
-func (siw *ServerInterfaceWrapper) UpdateClusterAutoDiagnosticConfig(c *fiber.Ctx) error {
+// GetDDLProgress operation middleware
+func (siw *ServerInterfaceWrapper) GetDDLProgress(c *fiber.Ctx) error {
 
 	var err error
 
@@ -5623,11 +14412,11 @@ func (siw *ServerInterfaceWrapper) UpdateClusterAutoDiagnosticConfig(c *fiber.Ct
 
 	c.Context().SetUserValue(BearerAuthScopes, []string{})
 
-	return siw.Handler.UpdateClusterAutoDiagnosticConfig(c, id)
+	return siw.Handler.GetDDLProgress(c, id)
 }
 
-// GetClusterDiagnostic operation middleware
-func (siw *ServerInterfaceWrapper) GetClusterDiagnostic(c *fiber.Ctx) error {
+// CancelDDLProgress operation middleware
+func (siw *ServerInterfaceWrapper) CancelDDLProgress(c *fiber.Ctx) error {
 
 	var err error
 
@@ -5639,21 +14428,21 @@ func (siw *ServerInterfaceWrapper) GetClusterDiagnostic(c *fiber.Ctx) error {
 		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter ID: %w", err).Error())
 	}
 
-	// ------------- Path parameter "diagnosticId" -------------
-	var diagnosticId int32
+	// ------------- Path parameter "ddlID" -------------
+	var ddlID int64
 
-	err = runtime.BindStyledParameterWithOptions("simple", "diagnosticId", c.Params("diagnosticId"), &diagnosticId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "ddlID", c.Params("ddlID"), &ddlID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
-		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter diagnosticId: %w", err).Error())
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter ddlID: %w", err).Error())
 	}
 
 	c.Context().SetUserValue(BearerAuthScopes, []string{})
 
-	return siw.Handler.GetClusterDiagnostic(c, id, diagnosticId)
+	return siw.Handler.CancelDDLProgress(c, id, ddlID)
 }
 
-// RunRisectlCommand operation middleware
-func (siw *ServerInterfaceWrapper) RunRisectlCommand(c *fiber.Ctx) error {
+// AnalyzeDatabaseImpact operation middleware
+func (siw *ServerInterfaceWrapper) AnalyzeDatabaseImpact(c *fiber.Ctx) error {
 
 	var err error
 
@@ -5665,13 +14454,13 @@ func (siw *ServerInterfaceWrapper) RunRisectlCommand(c *fiber.Ctx) error {
 		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter ID: %w", err).Error())
 	}
 
-	c.Context().SetUserValue(BearerAuthScopes, []string{})
+	c.Context().SetUserValue(BearerAuthScopes, []string{"x.OwnDatabase(c, x.GetOrgID(c), id)"})
 
-	return siw.Handler.RunRisectlCommand(c, id)
+	return siw.Handler.AnalyzeDatabaseImpact(c, id)
 }
 
-// ListClusterSnapshots operation middleware
-func (siw *ServerInterfaceWrapper) ListClusterSnapshots(c *fiber.Ctx) error {
+// GetDatabaseLineage operation middleware
+func (siw *ServerInterfaceWrapper) GetDatabaseLineage(c *fiber.Ctx) error {
 
 	var err error
 
@@ -5683,57 +14472,43 @@ func (siw *ServerInterfaceWrapper) ListClusterSnapshots(c *fiber.Ctx) error {
 		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter ID: %w", err).Error())
 	}
 
-	c.Context().SetUserValue(BearerAuthScopes, []string{})
-
-	return siw.Handler.ListClusterSnapshots(c, id)
-}
-
-// CreateClusterSnapshot operation middleware
-func (siw *ServerInterfaceWrapper) CreateClusterSnapshot(c *fiber.Ctx) error {
-
-	var err error
+	c.Context().SetUserValue(BearerAuthScopes, []string{"x.OwnDatabase(c, x.GetOrgID(c), id)"})
 
-	// ------------- Path parameter "ID" -------------
-	var id int32
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetDatabaseLineageParams
 
-	err = runtime.BindStyledParameterWithOptions("simple", "ID", c.Params("ID"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	var query url.Values
+	query, err = url.ParseQuery(string(c.Request().URI().QueryString()))
 	if err != nil {
-		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter ID: %w", err).Error())
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for query string: %w", err).Error())
 	}
 
-	c.Context().SetUserValue(BearerAuthScopes, []string{})
-
-	return siw.Handler.CreateClusterSnapshot(c, id)
-}
-
-// DeleteClusterSnapshot operation middleware
-func (siw *ServerInterfaceWrapper) DeleteClusterSnapshot(c *fiber.Ctx) error {
+	// ------------- Optional query parameter "relationID" -------------
 
-	var err error
+	err = runtime.BindQueryParameter("form", true, false, "relationID", query, &params.RelationID)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter relationID: %w", err).Error())
+	}
 
-	// ------------- Path parameter "ID" -------------
-	var id int32
+	// ------------- Optional query parameter "direction" -------------
 
-	err = runtime.BindStyledParameterWithOptions("simple", "ID", c.Params("ID"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	err = runtime.BindQueryParameter("form", true, false, "direction", query, &params.Direction)
 	if err != nil {
-		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter ID: %w", err).Error())
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter direction: %w", err).Error())
 	}
 
-	// ------------- Path parameter "snapshotId" -------------
-	var snapshotId int64
+	// ------------- Optional query parameter "depth" -------------
 
-	err = runtime.BindStyledParameterWithOptions("simple", "snapshotId", c.Params("snapshotId"), &snapshotId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	err = runtime.BindQueryParameter("form", true, false, "depth", query, &params.Depth)
 	if err != nil {
-		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter snapshotId: %w", err).Error())
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter depth: %w", err).Error())
 	}
 
-	c.Context().SetUserValue(BearerAuthScopes, []string{})
-
-	return siw.Handler.DeleteClusterSnapshot(c, id, snapshotId)
+	return siw.Handler.GetDatabaseLineage(c, id, params)
 }
 
-// RestoreClusterSnapshot operation middleware
-func (siw *ServerInterfaceWrapper) RestoreClusterSnapshot(c *fiber.Ctx) error {
+// ExportDatabaseLineage operation middleware
+func (siw *ServerInterfaceWrapper) ExportDatabaseLineage(c *fiber.Ctx) error {
 
 	var err error
 
@@ -5745,45 +14520,58 @@ func (siw *ServerInterfaceWrapper) RestoreClusterSnapshot(c *fiber.Ctx) error {
 		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter ID: %w", err).Error())
 	}
 
-	// ------------- Path parameter "snapshotId" -------------
-	var snapshotId int64
+	c.Context().SetUserValue(BearerAuthScopes, []string{"x.OwnDatabase(c, x.GetOrgID(c), id)"})
 
-	err = runtime.BindStyledParameterWithOptions("simple", "snapshotId", c.Params("snapshotId"), &snapshotId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ExportDatabaseLineageParams
+
+	var query url.Values
+	query, err = url.ParseQuery(string(c.Request().URI().QueryString()))
 	if err != nil {
-		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter snapshotId: %w", err).Error())
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for query string: %w", err).Error())
 	}
 
-	c.Context().SetUserValue(BearerAuthScopes, []string{})
+	// ------------- Required query parameter "format" -------------
 
-	return siw.Handler.RestoreClusterSnapshot(c, id, snapshotId)
-}
+	if paramValue := c.Query("format"); paramValue != "" {
 
-// ListDatabases operation middleware
-func (siw *ServerInterfaceWrapper) ListDatabases(c *fiber.Ctx) error {
+	} else {
+		err = fmt.Errorf("Query argument format is required, but not found")
+		c.Status(fiber.StatusBadRequest).JSON(err)
+		return err
+	}
 
-	c.Context().SetUserValue(BearerAuthScopes, []string{})
+	err = runtime.BindQueryParameter("form", true, true, "format", query, &params.Format)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter format: %w", err).Error())
+	}
 
-	return siw.Handler.ListDatabases(c)
-}
+	// ------------- Optional query parameter "relationID" -------------
 
-// ImportDatabase operation middleware
-func (siw *ServerInterfaceWrapper) ImportDatabase(c *fiber.Ctx) error {
+	err = runtime.BindQueryParameter("form", true, false, "relationID", query, &params.RelationID)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter relationID: %w", err).Error())
+	}
 
-	c.Context().SetUserValue(BearerAuthScopes, []string{})
+	// ------------- Optional query parameter "direction" -------------
 
-	return siw.Handler.ImportDatabase(c)
-}
+	err = runtime.BindQueryParameter("form", true, false, "direction", query, &params.Direction)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter direction: %w", err).Error())
+	}
 
-// TestDatabaseConnection operation middleware
-func (siw *ServerInterfaceWrapper) TestDatabaseConnection(c *fiber.Ctx) error {
+	// ------------- Optional query parameter "depth" -------------
 
-	c.Context().SetUserValue(BearerAuthScopes, []string{})
+	err = runtime.BindQueryParameter("form", true, false, "depth", query, &params.Depth)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter depth: %w", err).Error())
+	}
 
-	return siw.Handler.TestDatabaseConnection(c)
+	return siw.Handler.ExportDatabaseLineage(c, id, params)
 }
 
-// DeleteDatabase operation middleware
-func (siw *ServerInterfaceWrapper) DeleteDatabase(c *fiber.Ctx) error {
+// QueryDatabase operation middleware
+func (siw *ServerInterfaceWrapper) QueryDatabase(c *fiber.Ctx) error {
 
 	var err error
 
@@ -5797,11 +14585,11 @@ func (siw *ServerInterfaceWrapper) DeleteDatabase(c *fiber.Ctx) error {
 
 	c.Context().SetUserValue(BearerAuthScopes, []string{})
 
-	return siw.Handler.DeleteDatabase(c, id)
+	return siw.Handler.QueryDatabase(c, id)
 }
 
-// GetDatabase operation middleware
-func (siw *ServerInterfaceWrapper) GetDatabase(c *fiber.Ctx) error {
+// ListDatabaseRelations operation middleware
+func (siw *ServerInterfaceWrapper) ListDatabaseRelations(c *fiber.Ctx) error {
 
 	var err error
 
@@ -5815,87 +14603,118 @@ func (siw *ServerInterfaceWrapper) GetDatabase(c *fiber.Ctx) error {
 
 	c.Context().SetUserValue(BearerAuthScopes, []string{"x.OwnDatabase(c, x.GetOrgID(c), id)"})
 
-	return siw.Handler.GetDatabase(c, id)
-}
-
-// UpdateDatabase operation middleware
-func (siw *ServerInterfaceWrapper) UpdateDatabase(c *fiber.Ctx) error {
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListDatabaseRelationsParams
 
-	var err error
+	var query url.Values
+	query, err = url.ParseQuery(string(c.Request().URI().QueryString()))
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for query string: %w", err).Error())
+	}
 
-	// ------------- Path parameter "ID" -------------
-	var id int32
+	// ------------- Optional query parameter "refresh" -------------
 
-	err = runtime.BindStyledParameterWithOptions("simple", "ID", c.Params("ID"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	err = runtime.BindQueryParameter("form", true, false, "refresh", query, &params.Refresh)
 	if err != nil {
-		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter ID: %w", err).Error())
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter refresh: %w", err).Error())
 	}
 
+	return siw.Handler.ListDatabaseRelations(c, id, params)
+}
+
+// ListDiagnosticRules operation middleware
+func (siw *ServerInterfaceWrapper) ListDiagnosticRules(c *fiber.Ctx) error {
+
 	c.Context().SetUserValue(BearerAuthScopes, []string{})
 
-	return siw.Handler.UpdateDatabase(c, id)
+	return siw.Handler.ListDiagnosticRules(c)
 }
 
-// GetDDLProgress operation middleware
-func (siw *ServerInterfaceWrapper) GetDDLProgress(c *fiber.Ctx) error {
+// UpdateDiagnosticRule operation middleware
+func (siw *ServerInterfaceWrapper) UpdateDiagnosticRule(c *fiber.Ctx) error {
 
 	var err error
 
-	// ------------- Path parameter "ID" -------------
-	var id int32
+	// ------------- Path parameter "rule" -------------
+	var rule DiagnosticRuleName
 
-	err = runtime.BindStyledParameterWithOptions("simple", "ID", c.Params("ID"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "rule", c.Params("rule"), &rule, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
-		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter ID: %w", err).Error())
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter rule: %w", err).Error())
 	}
 
 	c.Context().SetUserValue(BearerAuthScopes, []string{})
 
-	return siw.Handler.GetDDLProgress(c, id)
+	return siw.Handler.UpdateDiagnosticRule(c, rule)
 }
 
-// CancelDDLProgress operation middleware
-func (siw *ServerInterfaceWrapper) CancelDDLProgress(c *fiber.Ctx) error {
+// SearchDiagnostics operation middleware
+func (siw *ServerInterfaceWrapper) SearchDiagnostics(c *fiber.Ctx) error {
 
 	var err error
 
-	// ------------- Path parameter "ID" -------------
-	var id int32
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
 
-	err = runtime.BindStyledParameterWithOptions("simple", "ID", c.Params("ID"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	// Parameter object where we will unmarshal all parameters from the context
+	var params SearchDiagnosticsParams
+
+	var query url.Values
+	query, err = url.ParseQuery(string(c.Request().URI().QueryString()))
 	if err != nil {
-		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter ID: %w", err).Error())
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for query string: %w", err).Error())
 	}
 
-	// ------------- Path parameter "ddlID" -------------
-	var ddlID int64
+	// ------------- Optional query parameter "clusterID" -------------
 
-	err = runtime.BindStyledParameterWithOptions("simple", "ddlID", c.Params("ddlID"), &ddlID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	err = runtime.BindQueryParameter("form", true, false, "clusterID", query, &params.ClusterID)
 	if err != nil {
-		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter ddlID: %w", err).Error())
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter clusterID: %w", err).Error())
 	}
 
-	c.Context().SetUserValue(BearerAuthScopes, []string{})
+	// ------------- Required query parameter "q" -------------
 
-	return siw.Handler.CancelDDLProgress(c, id, ddlID)
-}
+	if paramValue := c.Query("q"); paramValue != "" {
 
-// QueryDatabase operation middleware
-func (siw *ServerInterfaceWrapper) QueryDatabase(c *fiber.Ctx) error {
+	} else {
+		err = fmt.Errorf("Query argument q is required, but not found")
+		c.Status(fiber.StatusBadRequest).JSON(err)
+		return err
+	}
 
-	var err error
+	err = runtime.BindQueryParameter("form", true, true, "q", query, &params.Q)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter q: %w", err).Error())
+	}
 
-	// ------------- Path parameter "ID" -------------
-	var id int32
+	// ------------- Optional query parameter "from" -------------
 
-	err = runtime.BindStyledParameterWithOptions("simple", "ID", c.Params("ID"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	err = runtime.BindQueryParameter("form", true, false, "from", query, &params.From)
 	if err != nil {
-		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter ID: %w", err).Error())
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter from: %w", err).Error())
 	}
 
-	c.Context().SetUserValue(BearerAuthScopes, []string{})
+	// ------------- Optional query parameter "to" -------------
 
-	return siw.Handler.QueryDatabase(c, id)
+	err = runtime.BindQueryParameter("form", true, false, "to", query, &params.To)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter to: %w", err).Error())
+	}
+
+	// ------------- Optional query parameter "order" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "order", query, &params.Order)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter order: %w", err).Error())
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", query, &params.Limit)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter limit: %w", err).Error())
+	}
+
+	return siw.Handler.SearchDiagnostics(c, params)
 }
 
 // ListEvents operation middleware
@@ -6018,6 +14837,40 @@ func (siw *ServerInterfaceWrapper) GetMaterializedViewThroughput(c *fiber.Ctx) e
 	return siw.Handler.GetMaterializedViewThroughput(c, clusterID)
 }
 
+// GetOrgSettings operation middleware
+func (siw *ServerInterfaceWrapper) GetOrgSettings(c *fiber.Ctx) error {
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.GetOrgSettings(c)
+}
+
+// UpdateOrgSettings operation middleware
+func (siw *ServerInterfaceWrapper) UpdateOrgSettings(c *fiber.Ctx) error {
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.UpdateOrgSettings(c)
+}
+
+// UploadRisectl operation middleware
+func (siw *ServerInterfaceWrapper) UploadRisectl(c *fiber.Ctx) error {
+
+	var err error
+
+	// ------------- Path parameter "version" -------------
+	var version string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "version", c.Params("version"), &version, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter version: %w", err).Error())
+	}
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.UploadRisectl(c, version)
+}
+
 // ListTasks operation middleware
 func (siw *ServerInterfaceWrapper) ListTasks(c *fiber.Ctx) error {
 
@@ -6055,6 +14908,20 @@ func RegisterHandlersWithOptions(router fiber.Router, si ServerInterface, option
 		router.Use(fiber.Handler(m))
 	}
 
+	router.Get(options.BaseURL+"/alert-rules", wrapper.ListAlertRules)
+
+	router.Post(options.BaseURL+"/alert-rules", wrapper.CreateAlertRule)
+
+	router.Delete(options.BaseURL+"/alert-rules/:ID", wrapper.DeleteAlertRule)
+
+	router.Get(options.BaseURL+"/alert-rules/:ID", wrapper.GetAlertRule)
+
+	router.Put(options.BaseURL+"/alert-rules/:ID", wrapper.UpdateAlertRule)
+
+	router.Get(options.BaseURL+"/alerts", wrapper.ListAlerts)
+
+	router.Get(options.BaseURL+"/alerts/history", wrapper.ListAlertHistory)
+
 	router.Get(options.BaseURL+"/cluster-versions", wrapper.ListClusterVersions)
 
 	router.Get(options.BaseURL+"/clusters", wrapper.ListClusters)
@@ -6073,6 +14940,18 @@ func RegisterHandlersWithOptions(router fiber.Router, si ServerInterface, option
 
 	router.Put(options.BaseURL+"/clusters/:ID/auto-backup-config", wrapper.UpdateClusterAutoBackupConfig)
 
+	router.Post(options.BaseURL+"/clusters/:ID/auto-backup-config/retention-preview", wrapper.PreviewClusterSnapshotRetention)
+
+	router.Get(options.BaseURL+"/clusters/:ID/diagnostic-bundles", wrapper.ListClusterDiagnosticBundles)
+
+	router.Post(options.BaseURL+"/clusters/:ID/diagnostic-bundles", wrapper.CreateClusterDiagnosticBundle)
+
+	router.Delete(options.BaseURL+"/clusters/:ID/diagnostic-bundles/:bundleId", wrapper.DeleteClusterDiagnosticBundle)
+
+	router.Get(options.BaseURL+"/clusters/:ID/diagnostic-bundles/:bundleId", wrapper.GetClusterDiagnosticBundle)
+
+	router.Get(options.BaseURL+"/clusters/:ID/diagnostic-bundles/:bundleId/download", wrapper.DownloadClusterDiagnosticBundle)
+
 	router.Get(options.BaseURL+"/clusters/:ID/diagnostics", wrapper.ListClusterDiagnostics)
 
 	router.Post(options.BaseURL+"/clusters/:ID/diagnostics", wrapper.CreateClusterDiagnostic)
@@ -6081,10 +14960,44 @@ func RegisterHandlersWithOptions(router fiber.Router, si ServerInterface, option
 
 	router.Put(options.BaseURL+"/clusters/:ID/diagnostics/config", wrapper.UpdateClusterAutoDiagnosticConfig)
 
+	router.Get(options.BaseURL+"/clusters/:ID/diagnostics/diff", wrapper.DiffClusterDiagnostics)
+
+	router.Get(options.BaseURL+"/clusters/:ID/diagnostics/search", wrapper.SearchClusterDiagnostics)
+
 	router.Get(options.BaseURL+"/clusters/:ID/diagnostics/:diagnosticId", wrapper.GetClusterDiagnostic)
 
+	router.Get(options.BaseURL+"/clusters/:ID/diagnostics/:diagnosticId/findings", wrapper.ListClusterDiagnosticFindings)
+
+	router.Get(options.BaseURL+"/clusters/:ID/diagnostics/:diagnosticId/report", wrapper.GetClusterDiagnosticReport)
+
+	router.Get(options.BaseURL+"/clusters/:ID/events", wrapper.ListClusterEvents)
+
+	router.Get(options.BaseURL+"/clusters/:ID/health-history", wrapper.ListClusterHealthHistory)
+
 	router.Post(options.BaseURL+"/clusters/:ID/risectl", wrapper.RunRisectlCommand)
 
+	router.Get(options.BaseURL+"/clusters/:ID/risectl/executions", wrapper.ListRisectlExecutions)
+
+	router.Post(options.BaseURL+"/clusters/:ID/risectl/executions", wrapper.CreateRisectlExecution)
+
+	router.Get(options.BaseURL+"/clusters/:ID/risectl/executions/:executionID", wrapper.GetRisectlExecution)
+
+	router.Post(options.BaseURL+"/clusters/:ID/risectl/executions/:executionID/cancel", wrapper.CancelRisectlExecution)
+
+	router.Get(options.BaseURL+"/clusters/:ID/risectl/executions/:executionID/output", wrapper.ListRisectlExecutionOutputs)
+
+	router.Get(options.BaseURL+"/clusters/:ID/risectl/executions/:executionID/stream", wrapper.StreamRisectlExecution)
+
+	router.Get(options.BaseURL+"/clusters/:ID/risectl/operations", wrapper.ListRisectlOperations)
+
+	router.Post(options.BaseURL+"/clusters/:ID/risectl/operations/:operation", wrapper.RunRisectlOperation)
+
+	router.Get(options.BaseURL+"/clusters/:ID/risectl/shell", wrapper.OpenRisectlShell)
+
+	router.Get(options.BaseURL+"/clusters/:ID/risectl/shell/sessions", wrapper.ListRisectlShellSessions)
+
+	router.Get(options.BaseURL+"/clusters/:ID/risectl/shell/sessions/:sessionID/commands", wrapper.ListRisectlShellCommands)
+
 	router.Get(options.BaseURL+"/clusters/:ID/snapshots", wrapper.ListClusterSnapshots)
 
 	router.Post(options.BaseURL+"/clusters/:ID/snapshots", wrapper.CreateClusterSnapshot)
@@ -6093,6 +15006,16 @@ func RegisterHandlersWithOptions(router fiber.Router, si ServerInterface, option
 
 	router.Post(options.BaseURL+"/clusters/:ID/snapshots/:snapshotId", wrapper.RestoreClusterSnapshot)
 
+	router.Get(options.BaseURL+"/clusters/:ID/upgrades", wrapper.ListClusterUpgrades)
+
+	router.Post(options.BaseURL+"/clusters/:ID/upgrades", wrapper.CreateClusterUpgrade)
+
+	router.Get(options.BaseURL+"/clusters/:ID/upgrades/:upgradeID", wrapper.GetClusterUpgrade)
+
+	router.Post(options.BaseURL+"/clusters/:ID/upgrades/:upgradeID/cancel", wrapper.CancelClusterUpgrade)
+
+	router.Get(options.BaseURL+"/clusters/:ID/version-history", wrapper.ListClusterVersionHistory)
+
 	router.Get(options.BaseURL+"/databases", wrapper.ListDatabases)
 
 	router.Post(options.BaseURL+"/databases/import", wrapper.ImportDatabase)
@@ -6105,12 +15028,28 @@ func RegisterHandlersWithOptions(router fiber.Router, si ServerInterface, option
 
 	router.Put(options.BaseURL+"/databases/:ID", wrapper.UpdateDatabase)
 
+	router.Get(options.BaseURL+"/databases/:ID/catalog-history", wrapper.ListDatabaseCatalogChanges)
+
 	router.Get(options.BaseURL+"/databases/:ID/ddl-progress", wrapper.GetDDLProgress)
 
 	router.Post(options.BaseURL+"/databases/:ID/ddl-progress/:ddlID/cancel", wrapper.CancelDDLProgress)
 
+	router.Post(options.BaseURL+"/databases/:ID/impact-analysis", wrapper.AnalyzeDatabaseImpact)
+
+	router.Get(options.BaseURL+"/databases/:ID/lineage", wrapper.GetDatabaseLineage)
+
+	router.Get(options.BaseURL+"/databases/:ID/lineage/export", wrapper.ExportDatabaseLineage)
+
 	router.Post(options.BaseURL+"/databases/:ID/query", wrapper.QueryDatabase)
 
+	router.Get(options.BaseURL+"/databases/:ID/relations", wrapper.ListDatabaseRelations)
+
+	router.Get(options.BaseURL+"/diagnostic-rules", wrapper.ListDiagnosticRules)
+
+	router.Put(options.BaseURL+"/diagnostic-rules/:rule", wrapper.UpdateDiagnosticRule)
+
+	router.Get(options.BaseURL+"/diagnostics/search", wrapper.SearchDiagnostics)
+
 	router.Get(options.BaseURL+"/events", wrapper.ListEvents)
 
 	router.Get(options.BaseURL+"/metrics-stores", wrapper.ListMetricsStores)
@@ -6125,6 +15064,12 @@ func RegisterHandlersWithOptions(router fiber.Router, si ServerInterface, option
 
 	router.Get(options.BaseURL+"/metrics/:clusterID/materialized-view-throughput", wrapper.GetMaterializedViewThroughput)
 
+	router.Get(options.BaseURL+"/org-settings", wrapper.GetOrgSettings)
+
+	router.Put(options.BaseURL+"/org-settings", wrapper.UpdateOrgSettings)
+
+	router.Put(options.BaseURL+"/risectl/versions/:version", wrapper.UploadRisectl)
+
 	router.Get(options.BaseURL+"/tasks", wrapper.ListTasks)
 
 	router.Post(options.BaseURL+"/test-cluster-connection", wrapper.TestClusterConnection)
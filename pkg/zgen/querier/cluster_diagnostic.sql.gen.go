@@ -11,16 +11,26 @@ import (
 )
 
 const createClusterDiagnostic = `-- name: CreateClusterDiagnostic :one
-INSERT INTO cluster_diagnostics (cluster_id, content) VALUES ($1, $2) RETURNING id, cluster_id, content, created_at, updated_at
+INSERT INTO cluster_diagnostics (cluster_id, content_ref, content_size, content_stored_size, content_sha256)
+VALUES ($1, $2, $3, $4, $5) RETURNING id, cluster_id, content, created_at, updated_at, content_ref, content_size, content_stored_size, content_sha256
 `
 
 type CreateClusterDiagnosticParams struct {
-	ClusterID int32
-	Content   string
+	ClusterID         int32
+	ContentRef        *string
+	ContentSize       *int64
+	ContentStoredSize *int64
+	ContentSha256     *string
 }
 
 func (q *Queries) CreateClusterDiagnostic(ctx context.Context, arg CreateClusterDiagnosticParams) (*ClusterDiagnostic, error) {
-	row := q.db.QueryRow(ctx, createClusterDiagnostic, arg.ClusterID, arg.Content)
+	row := q.db.QueryRow(ctx, createClusterDiagnostic,
+		arg.ClusterID,
+		arg.ContentRef,
+		arg.ContentSize,
+		arg.ContentStoredSize,
+		arg.ContentSha256,
+	)
 	var i ClusterDiagnostic
 	err := row.Scan(
 		&i.ID,
@@ -28,10 +38,30 @@ func (q *Queries) CreateClusterDiagnostic(ctx context.Context, arg CreateCluster
 		&i.Content,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.ContentRef,
+		&i.ContentSize,
+		&i.ContentStoredSize,
+		&i.ContentSha256,
 	)
 	return &i, err
 }
 
+const createClusterDiagnosticSearch = `-- name: CreateClusterDiagnosticSearch :exec
+INSERT INTO cluster_diagnostic_search (diagnostic_id, search_vector)
+VALUES ($1, strip(to_tsvector('simple', $2::TEXT)))
+ON CONFLICT (diagnostic_id) DO UPDATE SET search_vector = EXCLUDED.search_vector
+`
+
+type CreateClusterDiagnosticSearchParams struct {
+	DiagnosticID int32
+	SearchText   string
+}
+
+func (q *Queries) CreateClusterDiagnosticSearch(ctx context.Context, arg CreateClusterDiagnosticSearchParams) error {
+	_, err := q.db.Exec(ctx, createClusterDiagnosticSearch, arg.DiagnosticID, arg.SearchText)
+	return err
+}
+
 const deleteClusterDiagnostic = `-- name: DeleteClusterDiagnostic :exec
 DELETE FROM cluster_diagnostics WHERE id = $1
 `
@@ -42,7 +72,7 @@ func (q *Queries) DeleteClusterDiagnostic(ctx context.Context, id int32) error {
 }
 
 const getClusterDiagnostic = `-- name: GetClusterDiagnostic :one
-SELECT id, cluster_id, content, created_at, updated_at FROM cluster_diagnostics WHERE id = $1
+SELECT id, cluster_id, content, created_at, updated_at, content_ref, content_size, content_stored_size, content_sha256 FROM cluster_diagnostics WHERE id = $1
 `
 
 func (q *Queries) GetClusterDiagnostic(ctx context.Context, id int32) (*ClusterDiagnostic, error) {
@@ -54,6 +84,39 @@ func (q *Queries) GetClusterDiagnostic(ctx context.Context, id int32) (*ClusterD
 		&i.Content,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.ContentRef,
+		&i.ContentSize,
+		&i.ContentStoredSize,
+		&i.ContentSha256,
+	)
+	return &i, err
+}
+
+const getPreviousClusterDiagnostic = `-- name: GetPreviousClusterDiagnostic :one
+SELECT id, cluster_id, content, created_at, updated_at, content_ref, content_size, content_stored_size, content_sha256 FROM cluster_diagnostics
+WHERE cluster_id = $1 AND id < $2
+ORDER BY id DESC
+LIMIT 1
+`
+
+type GetPreviousClusterDiagnosticParams struct {
+	ClusterID int32
+	ID        int32
+}
+
+func (q *Queries) GetPreviousClusterDiagnostic(ctx context.Context, arg GetPreviousClusterDiagnosticParams) (*ClusterDiagnostic, error) {
+	row := q.db.QueryRow(ctx, getPreviousClusterDiagnostic, arg.ClusterID, arg.ID)
+	var i ClusterDiagnostic
+	err := row.Scan(
+		&i.ID,
+		&i.ClusterID,
+		&i.Content,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.ContentRef,
+		&i.ContentSize,
+		&i.ContentStoredSize,
+		&i.ContentSha256,
 	)
 	return &i, err
 }
@@ -86,3 +149,175 @@ func (q *Queries) ListClusterDiagnostics(ctx context.Context, clusterID int32) (
 	}
 	return items, nil
 }
+
+const listInlineClusterDiagnostics = `-- name: ListInlineClusterDiagnostics :many
+SELECT id, cluster_id, content, created_at, updated_at, content_ref, content_size, content_stored_size, content_sha256 FROM cluster_diagnostics
+WHERE content IS NOT NULL
+ORDER BY id
+LIMIT $1
+`
+
+func (q *Queries) ListInlineClusterDiagnostics(ctx context.Context, limit int32) ([]*ClusterDiagnostic, error) {
+	rows, err := q.db.Query(ctx, listInlineClusterDiagnostics, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*ClusterDiagnostic
+	for rows.Next() {
+		var i ClusterDiagnostic
+		if err := rows.Scan(
+			&i.ID,
+			&i.ClusterID,
+			&i.Content,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.ContentRef,
+			&i.ContentSize,
+			&i.ContentStoredSize,
+			&i.ContentSha256,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listUnindexedClusterDiagnostics = `-- name: ListUnindexedClusterDiagnostics :many
+SELECT id, cluster_id, content, created_at, updated_at, content_ref, content_size, content_stored_size, content_sha256 FROM cluster_diagnostics
+WHERE id NOT IN (SELECT diagnostic_id FROM cluster_diagnostic_search)
+ORDER BY id
+LIMIT $1
+`
+
+func (q *Queries) ListUnindexedClusterDiagnostics(ctx context.Context, limit int32) ([]*ClusterDiagnostic, error) {
+	rows, err := q.db.Query(ctx, listUnindexedClusterDiagnostics, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*ClusterDiagnostic
+	for rows.Next() {
+		var i ClusterDiagnostic
+		if err := rows.Scan(
+			&i.ID,
+			&i.ClusterID,
+			&i.Content,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.ContentRef,
+			&i.ContentSize,
+			&i.ContentStoredSize,
+			&i.ContentSha256,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const moveClusterDiagnosticContent = `-- name: MoveClusterDiagnosticContent :exec
+UPDATE cluster_diagnostics
+SET content = NULL, content_ref = $2, content_size = $3, content_stored_size = $4, content_sha256 = $5
+WHERE id = $1
+`
+
+type MoveClusterDiagnosticContentParams struct {
+	ID                int32
+	ContentRef        *string
+	ContentSize       *int64
+	ContentStoredSize *int64
+	ContentSha256     *string
+}
+
+func (q *Queries) MoveClusterDiagnosticContent(ctx context.Context, arg MoveClusterDiagnosticContentParams) error {
+	_, err := q.db.Exec(ctx, moveClusterDiagnosticContent,
+		arg.ID,
+		arg.ContentRef,
+		arg.ContentSize,
+		arg.ContentStoredSize,
+		arg.ContentSha256,
+	)
+	return err
+}
+
+const searchOrgClusterDiagnostics = `-- name: SearchOrgClusterDiagnostics :many
+SELECT d.id, d.cluster_id, c.name AS cluster_name, d.created_at, d.content, d.content_ref, d.content_sha256
+FROM cluster_diagnostics d
+JOIN clusters c ON c.id = d.cluster_id
+JOIN cluster_diagnostic_search s ON s.diagnostic_id = d.id
+WHERE c.org_id = $1
+    AND s.search_vector @@ plainto_tsquery('simple', $3::TEXT)
+    AND ($4::INTEGER IS NULL OR d.cluster_id = $4::INTEGER)
+    AND ($5::TIMESTAMPTZ IS NULL OR d.created_at >= $5::TIMESTAMPTZ)
+    AND ($6::TIMESTAMPTZ IS NULL OR d.created_at <= $6::TIMESTAMPTZ)
+ORDER BY
+    CASE WHEN $7::BOOLEAN THEN d.created_at END ASC,
+    d.created_at DESC,
+    d.id
+LIMIT $2
+`
+
+type SearchOrgClusterDiagnosticsParams struct {
+	OrgID       int32
+	Limit       int32
+	Query       string
+	ClusterID   *int32
+	From        *time.Time
+	To          *time.Time
+	OldestFirst bool
+}
+
+type SearchOrgClusterDiagnosticsRow struct {
+	ID            int32
+	ClusterID     int32
+	ClusterName   string
+	CreatedAt     time.Time
+	Content       *string
+	ContentRef    *string
+	ContentSha256 *string
+}
+
+func (q *Queries) SearchOrgClusterDiagnostics(ctx context.Context, arg SearchOrgClusterDiagnosticsParams) ([]*SearchOrgClusterDiagnosticsRow, error) {
+	rows, err := q.db.Query(ctx, searchOrgClusterDiagnostics,
+		arg.OrgID,
+		arg.Limit,
+		arg.Query,
+		arg.ClusterID,
+		arg.From,
+		arg.To,
+		arg.OldestFirst,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*SearchOrgClusterDiagnosticsRow
+	for rows.Next() {
+		var i SearchOrgClusterDiagnosticsRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.ClusterID,
+			&i.ClusterName,
+			&i.CreatedAt,
+			&i.Content,
+			&i.ContentRef,
+			&i.ContentSha256,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
@@ -42,7 +42,7 @@ func (q *Queries) CreateAutoDiagnosticsConfig(ctx context.Context, arg CreateAut
 }
 
 const getAutoBackupConfig = `-- name: GetAutoBackupConfig :one
-SELECT cluster_id, enabled, created_at, updated_at, task_id FROM auto_backup_configs
+SELECT cluster_id, enabled, created_at, updated_at, task_id, keep_last, keep_daily, keep_weekly, keep_monthly FROM auto_backup_configs
 WHERE cluster_id = $1
 `
 
@@ -55,6 +55,10 @@ func (q *Queries) GetAutoBackupConfig(ctx context.Context, clusterID int32) (*Au
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.TaskID,
+		&i.KeepLast,
+		&i.KeepDaily,
+		&i.KeepWeekly,
+		&i.KeepMonthly,
 	)
 	return &i, err
 }
@@ -93,6 +97,31 @@ func (q *Queries) UpdateAutoBackupConfig(ctx context.Context, arg UpdateAutoBack
 	return err
 }
 
+const updateAutoBackupRetentionPolicy = `-- name: UpdateAutoBackupRetentionPolicy :exec
+UPDATE auto_backup_configs
+SET keep_last = $2, keep_daily = $3, keep_weekly = $4, keep_monthly = $5, updated_at = CURRENT_TIMESTAMP
+WHERE cluster_id = $1
+`
+
+type UpdateAutoBackupRetentionPolicyParams struct {
+	ClusterID   int32
+	KeepLast    *int32
+	KeepDaily   *int32
+	KeepWeekly  *int32
+	KeepMonthly *int32
+}
+
+func (q *Queries) UpdateAutoBackupRetentionPolicy(ctx context.Context, arg UpdateAutoBackupRetentionPolicyParams) error {
+	_, err := q.db.Exec(ctx, updateAutoBackupRetentionPolicy,
+		arg.ClusterID,
+		arg.KeepLast,
+		arg.KeepDaily,
+		arg.KeepWeekly,
+		arg.KeepMonthly,
+	)
+	return err
+}
+
 const updateAutoDiagnosticsConfig = `-- name: UpdateAutoDiagnosticsConfig :exec
 UPDATE auto_diagnostics_configs
 SET enabled = $2
@@ -7,21 +7,30 @@ package querier
 
 import (
 	"context"
+	"time"
 )
 
 const createClusterSnapshot = `-- name: CreateClusterSnapshot :exec
-INSERT INTO cluster_snapshots (cluster_id, snapshot_id, name)
-VALUES ($1, $2, $3)
+INSERT INTO cluster_snapshots (cluster_id, snapshot_id, name, source, sync_status, synced_at)
+VALUES ($1, $2, $3, $4, 'synced', CURRENT_TIMESTAMP)
+ON CONFLICT (cluster_id, snapshot_id) DO UPDATE
+SET name = EXCLUDED.name, source = EXCLUDED.source, sync_status = 'synced', synced_at = CURRENT_TIMESTAMP, updated_at = CURRENT_TIMESTAMP
 `
 
 type CreateClusterSnapshotParams struct {
 	ClusterID  int32
 	SnapshotID int64
 	Name       string
+	Source     string
 }
 
 func (q *Queries) CreateClusterSnapshot(ctx context.Context, arg CreateClusterSnapshotParams) error {
-	_, err := q.db.Exec(ctx, createClusterSnapshot, arg.ClusterID, arg.SnapshotID, arg.Name)
+	_, err := q.db.Exec(ctx, createClusterSnapshot,
+		arg.ClusterID,
+		arg.SnapshotID,
+		arg.Name,
+		arg.Source,
+	)
 	return err
 }
 
@@ -40,8 +49,31 @@ func (q *Queries) DeleteClusterSnapshot(ctx context.Context, arg DeleteClusterSn
 	return err
 }
 
+const importClusterSnapshot = `-- name: ImportClusterSnapshot :exec
+INSERT INTO cluster_snapshots (cluster_id, snapshot_id, name, source, sync_status, synced_at, created_at)
+VALUES ($1, $2, $3, 'imported', 'synced', CURRENT_TIMESTAMP, COALESCE($4, CURRENT_TIMESTAMP))
+ON CONFLICT (cluster_id, snapshot_id) DO NOTHING
+`
+
+type ImportClusterSnapshotParams struct {
+	ClusterID  int32
+	SnapshotID int64
+	Name       string
+	CreatedAt  *time.Time
+}
+
+func (q *Queries) ImportClusterSnapshot(ctx context.Context, arg ImportClusterSnapshotParams) error {
+	_, err := q.db.Exec(ctx, importClusterSnapshot,
+		arg.ClusterID,
+		arg.SnapshotID,
+		arg.Name,
+		arg.CreatedAt,
+	)
+	return err
+}
+
 const listClusterSnapshots = `-- name: ListClusterSnapshots :many
-SELECT cluster_id, snapshot_id, name, created_at, updated_at FROM cluster_snapshots
+SELECT cluster_id, snapshot_id, name, created_at, updated_at, source, sync_status, synced_at FROM cluster_snapshots
 WHERE cluster_id = $1 ORDER BY created_at DESC
 `
 
@@ -60,6 +92,9 @@ func (q *Queries) ListClusterSnapshots(ctx context.Context, clusterID int32) ([]
 			&i.Name,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.Source,
+			&i.SyncStatus,
+			&i.SyncedAt,
 		); err != nil {
 			return nil, err
 		}
@@ -70,3 +105,20 @@ func (q *Queries) ListClusterSnapshots(ctx context.Context, clusterID int32) ([]
 	}
 	return items, nil
 }
+
+const updateClusterSnapshotSyncStatus = `-- name: UpdateClusterSnapshotSyncStatus :exec
+UPDATE cluster_snapshots
+SET sync_status = $3, synced_at = CURRENT_TIMESTAMP, updated_at = CURRENT_TIMESTAMP
+WHERE cluster_id = $1 AND snapshot_id = $2
+`
+
+type UpdateClusterSnapshotSyncStatusParams struct {
+	ClusterID  int32
+	SnapshotID int64
+	SyncStatus string
+}
+
+func (q *Queries) UpdateClusterSnapshotSyncStatus(ctx context.Context, arg UpdateClusterSnapshotSyncStatusParams) error {
+	_, err := q.db.Exec(ctx, updateClusterSnapshotSyncStatus, arg.ClusterID, arg.SnapshotID, arg.SyncStatus)
+	return err
+}
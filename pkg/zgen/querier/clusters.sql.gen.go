@@ -7,6 +7,7 @@ package querier
 
 import (
 	"context"
+	"time"
 )
 
 const createCluster = `-- name: CreateCluster :one
@@ -18,10 +19,11 @@ INSERT INTO clusters (
     meta_port,
     http_port,
     version, 
-    metrics_store_id
+    metrics_store_id,
+    risectl_version
 ) VALUES (
-    $1, $2, $3, $4, $5, $6, $7, $8
-) RETURNING id, org_id, name, host, sql_port, meta_port, http_port, version, created_at, updated_at, metrics_store_id
+    $1, $2, $3, $4, $5, $6, $7, $8, $9
+) RETURNING id, org_id, name, host, sql_port, meta_port, http_port, version, created_at, updated_at, metrics_store_id, risectl_version, detected_version, version_detected_at, health_status, health_checked_at, last_seen_at
 `
 
 type CreateClusterParams struct {
@@ -33,6 +35,7 @@ type CreateClusterParams struct {
 	HttpPort       int32
 	Version        string
 	MetricsStoreID *int32
+	RisectlVersion *string
 }
 
 func (q *Queries) CreateCluster(ctx context.Context, arg CreateClusterParams) (*Cluster, error) {
@@ -45,6 +48,7 @@ func (q *Queries) CreateCluster(ctx context.Context, arg CreateClusterParams) (*
 		arg.HttpPort,
 		arg.Version,
 		arg.MetricsStoreID,
+		arg.RisectlVersion,
 	)
 	var i Cluster
 	err := row.Scan(
@@ -59,6 +63,12 @@ func (q *Queries) CreateCluster(ctx context.Context, arg CreateClusterParams) (*
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.MetricsStoreID,
+		&i.RisectlVersion,
+		&i.DetectedVersion,
+		&i.VersionDetectedAt,
+		&i.HealthStatus,
+		&i.HealthCheckedAt,
+		&i.LastSeenAt,
 	)
 	return &i, err
 }
@@ -79,7 +89,7 @@ func (q *Queries) DeleteOrgCluster(ctx context.Context, arg DeleteOrgClusterPara
 }
 
 const getClusterByID = `-- name: GetClusterByID :one
-SELECT id, org_id, name, host, sql_port, meta_port, http_port, version, created_at, updated_at, metrics_store_id FROM clusters
+SELECT id, org_id, name, host, sql_port, meta_port, http_port, version, created_at, updated_at, metrics_store_id, risectl_version, detected_version, version_detected_at, health_status, health_checked_at, last_seen_at FROM clusters
 WHERE id = $1
 `
 
@@ -98,12 +108,18 @@ func (q *Queries) GetClusterByID(ctx context.Context, id int32) (*Cluster, error
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.MetricsStoreID,
+		&i.RisectlVersion,
+		&i.DetectedVersion,
+		&i.VersionDetectedAt,
+		&i.HealthStatus,
+		&i.HealthCheckedAt,
+		&i.LastSeenAt,
 	)
 	return &i, err
 }
 
 const getOrgCluster = `-- name: GetOrgCluster :one
-SELECT id, org_id, name, host, sql_port, meta_port, http_port, version, created_at, updated_at, metrics_store_id FROM clusters
+SELECT id, org_id, name, host, sql_port, meta_port, http_port, version, created_at, updated_at, metrics_store_id, risectl_version, detected_version, version_detected_at, health_status, health_checked_at, last_seen_at FROM clusters
 WHERE id = $1 AND org_id = $2
 `
 
@@ -127,6 +143,12 @@ func (q *Queries) GetOrgCluster(ctx context.Context, arg GetOrgClusterParams) (*
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.MetricsStoreID,
+		&i.RisectlVersion,
+		&i.DetectedVersion,
+		&i.VersionDetectedAt,
+		&i.HealthStatus,
+		&i.HealthCheckedAt,
+		&i.LastSeenAt,
 	)
 	return &i, err
 }
@@ -140,9 +162,10 @@ INSERT INTO clusters (
     meta_port,
     http_port,
     version,
-    metrics_store_id
+    metrics_store_id,
+    risectl_version
 ) VALUES (
-    $1, $2, $3, $4, $5, $6, $7, $8
+    $1, $2, $3, $4, $5, $6, $7, $8, $9
 ) ON CONFLICT (org_id, name) DO UPDATE 
     SET 
         host = EXCLUDED.host,
@@ -151,8 +174,9 @@ INSERT INTO clusters (
         http_port = EXCLUDED.http_port,
         version = EXCLUDED.version,
         metrics_store_id = EXCLUDED.metrics_store_id,
+        risectl_version = EXCLUDED.risectl_version,
         updated_at = CURRENT_TIMESTAMP
-RETURNING id, org_id, name, host, sql_port, meta_port, http_port, version, created_at, updated_at, metrics_store_id
+RETURNING id, org_id, name, host, sql_port, meta_port, http_port, version, created_at, updated_at, metrics_store_id, risectl_version, detected_version, version_detected_at, health_status, health_checked_at, last_seen_at
 `
 
 type InitClusterParams struct {
@@ -164,6 +188,7 @@ type InitClusterParams struct {
 	HttpPort       int32
 	Version        string
 	MetricsStoreID *int32
+	RisectlVersion *string
 }
 
 func (q *Queries) InitCluster(ctx context.Context, arg InitClusterParams) (*Cluster, error) {
@@ -176,6 +201,7 @@ func (q *Queries) InitCluster(ctx context.Context, arg InitClusterParams) (*Clus
 		arg.HttpPort,
 		arg.Version,
 		arg.MetricsStoreID,
+		arg.RisectlVersion,
 	)
 	var i Cluster
 	err := row.Scan(
@@ -190,12 +216,61 @@ func (q *Queries) InitCluster(ctx context.Context, arg InitClusterParams) (*Clus
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.MetricsStoreID,
+		&i.RisectlVersion,
+		&i.DetectedVersion,
+		&i.VersionDetectedAt,
+		&i.HealthStatus,
+		&i.HealthCheckedAt,
+		&i.LastSeenAt,
 	)
 	return &i, err
 }
 
+const listAllClusters = `-- name: ListAllClusters :many
+SELECT id, org_id, name, host, sql_port, meta_port, http_port, version, created_at, updated_at, metrics_store_id, risectl_version, detected_version, version_detected_at, health_status, health_checked_at, last_seen_at FROM clusters
+ORDER BY id
+`
+
+func (q *Queries) ListAllClusters(ctx context.Context) ([]*Cluster, error) {
+	rows, err := q.db.Query(ctx, listAllClusters)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*Cluster
+	for rows.Next() {
+		var i Cluster
+		if err := rows.Scan(
+			&i.ID,
+			&i.OrgID,
+			&i.Name,
+			&i.Host,
+			&i.SqlPort,
+			&i.MetaPort,
+			&i.HttpPort,
+			&i.Version,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.MetricsStoreID,
+			&i.RisectlVersion,
+			&i.DetectedVersion,
+			&i.VersionDetectedAt,
+			&i.HealthStatus,
+			&i.HealthCheckedAt,
+			&i.LastSeenAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const listClustersByMetricsStoreID = `-- name: ListClustersByMetricsStoreID :many
-SELECT id, org_id, name, host, sql_port, meta_port, http_port, version, created_at, updated_at, metrics_store_id FROM clusters
+SELECT id, org_id, name, host, sql_port, meta_port, http_port, version, created_at, updated_at, metrics_store_id, risectl_version, detected_version, version_detected_at, health_status, health_checked_at, last_seen_at FROM clusters
 WHERE metrics_store_id = $1
 ORDER BY name
 `
@@ -221,6 +296,12 @@ func (q *Queries) ListClustersByMetricsStoreID(ctx context.Context, metricsStore
 			&i.CreatedAt,
 			&i.UpdatedAt,
 			&i.MetricsStoreID,
+			&i.RisectlVersion,
+			&i.DetectedVersion,
+			&i.VersionDetectedAt,
+			&i.HealthStatus,
+			&i.HealthCheckedAt,
+			&i.LastSeenAt,
 		); err != nil {
 			return nil, err
 		}
@@ -233,7 +314,7 @@ func (q *Queries) ListClustersByMetricsStoreID(ctx context.Context, metricsStore
 }
 
 const listOrgClusters = `-- name: ListOrgClusters :many
-SELECT id, org_id, name, host, sql_port, meta_port, http_port, version, created_at, updated_at, metrics_store_id FROM clusters
+SELECT id, org_id, name, host, sql_port, meta_port, http_port, version, created_at, updated_at, metrics_store_id, risectl_version, detected_version, version_detected_at, health_status, health_checked_at, last_seen_at FROM clusters
 WHERE org_id = $1
 ORDER BY name
 `
@@ -259,6 +340,12 @@ func (q *Queries) ListOrgClusters(ctx context.Context, orgID int32) ([]*Cluster,
 			&i.CreatedAt,
 			&i.UpdatedAt,
 			&i.MetricsStoreID,
+			&i.RisectlVersion,
+			&i.DetectedVersion,
+			&i.VersionDetectedAt,
+			&i.HealthStatus,
+			&i.HealthCheckedAt,
+			&i.LastSeenAt,
 		); err != nil {
 			return nil, err
 		}
@@ -286,6 +373,63 @@ func (q *Queries) RemoveClusterMetricsStoreID(ctx context.Context, arg RemoveClu
 	return err
 }
 
+const updateClusterDetectedVersion = `-- name: UpdateClusterDetectedVersion :exec
+UPDATE clusters
+SET detected_version = $2, version_detected_at = CURRENT_TIMESTAMP
+WHERE id = $1
+`
+
+type UpdateClusterDetectedVersionParams struct {
+	ID              int32
+	DetectedVersion *string
+}
+
+func (q *Queries) UpdateClusterDetectedVersion(ctx context.Context, arg UpdateClusterDetectedVersionParams) error {
+	_, err := q.db.Exec(ctx, updateClusterDetectedVersion, arg.ID, arg.DetectedVersion)
+	return err
+}
+
+const updateClusterHealth = `-- name: UpdateClusterHealth :exec
+UPDATE clusters
+SET health_status = $2,
+    health_checked_at = $3,
+    last_seen_at = COALESCE($4, last_seen_at)
+WHERE id = $1
+`
+
+type UpdateClusterHealthParams struct {
+	ID              int32
+	HealthStatus    *string
+	HealthCheckedAt *time.Time
+	LastSeenAt      *time.Time
+}
+
+func (q *Queries) UpdateClusterHealth(ctx context.Context, arg UpdateClusterHealthParams) error {
+	_, err := q.db.Exec(ctx, updateClusterHealth,
+		arg.ID,
+		arg.HealthStatus,
+		arg.HealthCheckedAt,
+		arg.LastSeenAt,
+	)
+	return err
+}
+
+const updateClusterVersion = `-- name: UpdateClusterVersion :exec
+UPDATE clusters
+SET version = $2, updated_at = CURRENT_TIMESTAMP
+WHERE id = $1
+`
+
+type UpdateClusterVersionParams struct {
+	ID      int32
+	Version string
+}
+
+func (q *Queries) UpdateClusterVersion(ctx context.Context, arg UpdateClusterVersionParams) error {
+	_, err := q.db.Exec(ctx, updateClusterVersion, arg.ID, arg.Version)
+	return err
+}
+
 const updateOrgCluster = `-- name: UpdateOrgCluster :one
 UPDATE clusters
 SET
@@ -296,9 +440,10 @@ SET
     http_port = $7,
     version = $8,
     metrics_store_id = $9,
+    risectl_version = $10,
     updated_at = CURRENT_TIMESTAMP
 WHERE id = $1 AND org_id = $2
-RETURNING id, org_id, name, host, sql_port, meta_port, http_port, version, created_at, updated_at, metrics_store_id
+RETURNING id, org_id, name, host, sql_port, meta_port, http_port, version, created_at, updated_at, metrics_store_id, risectl_version, detected_version, version_detected_at, health_status, health_checked_at, last_seen_at
 `
 
 type UpdateOrgClusterParams struct {
@@ -311,6 +456,7 @@ type UpdateOrgClusterParams struct {
 	HttpPort       int32
 	Version        string
 	MetricsStoreID *int32
+	RisectlVersion *string
 }
 
 func (q *Queries) UpdateOrgCluster(ctx context.Context, arg UpdateOrgClusterParams) (*Cluster, error) {
@@ -324,6 +470,7 @@ func (q *Queries) UpdateOrgCluster(ctx context.Context, arg UpdateOrgClusterPara
 		arg.HttpPort,
 		arg.Version,
 		arg.MetricsStoreID,
+		arg.RisectlVersion,
 	)
 	var i Cluster
 	err := row.Scan(
@@ -338,6 +485,12 @@ func (q *Queries) UpdateOrgCluster(ctx context.Context, arg UpdateOrgClusterPara
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.MetricsStoreID,
+		&i.RisectlVersion,
+		&i.DetectedVersion,
+		&i.VersionDetectedAt,
+		&i.HealthStatus,
+		&i.HealthCheckedAt,
+		&i.LastSeenAt,
 	)
 	return &i, err
 }
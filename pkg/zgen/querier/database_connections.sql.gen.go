@@ -7,6 +7,8 @@ package querier
 
 import (
 	"context"
+
+	"github.com/risingwavelabs/risingwave-console/pkg/zgen/apigen"
 )
 
 const createDatabaseConnection = `-- name: CreateDatabaseConnection :one
@@ -16,19 +18,21 @@ INSERT INTO database_connections (
     username,
     password,
     database,
-    org_id
+    org_id,
+    session_settings
 ) VALUES (
-    $1, $2, $3, $4, $5, $6
-) RETURNING id, org_id, name, cluster_id, username, password, database, created_at, updated_at
+    $1, $2, $3, $4, $5, $6, $7
+) RETURNING id, org_id, name, cluster_id, username, password, database, created_at, updated_at, session_settings
 `
 
 type CreateDatabaseConnectionParams struct {
-	Name      string
-	ClusterID int32
-	Username  string
-	Password  *string
-	Database  string
-	OrgID     int32
+	Name            string
+	ClusterID       int32
+	Username        string
+	Password        *string
+	Database        string
+	OrgID           int32
+	SessionSettings *apigen.SessionSettings
 }
 
 func (q *Queries) CreateDatabaseConnection(ctx context.Context, arg CreateDatabaseConnectionParams) (*DatabaseConnection, error) {
@@ -39,6 +43,7 @@ func (q *Queries) CreateDatabaseConnection(ctx context.Context, arg CreateDataba
 		arg.Password,
 		arg.Database,
 		arg.OrgID,
+		arg.SessionSettings,
 	)
 	var i DatabaseConnection
 	err := row.Scan(
@@ -51,6 +56,7 @@ func (q *Queries) CreateDatabaseConnection(ctx context.Context, arg CreateDataba
 		&i.Database,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.SessionSettings,
 	)
 	return &i, err
 }
@@ -86,7 +92,7 @@ func (q *Queries) DeleteOrgDatabaseConnection(ctx context.Context, arg DeleteOrg
 }
 
 const getAllOrgDatabseConnectionsByClusterID = `-- name: GetAllOrgDatabseConnectionsByClusterID :many
-SELECT id, org_id, name, cluster_id, username, password, database, created_at, updated_at FROM database_connections
+SELECT id, org_id, name, cluster_id, username, password, database, created_at, updated_at, session_settings FROM database_connections
 WHERE cluster_id = $1 AND org_id = $2
 `
 
@@ -114,6 +120,7 @@ func (q *Queries) GetAllOrgDatabseConnectionsByClusterID(ctx context.Context, ar
 			&i.Database,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.SessionSettings,
 		); err != nil {
 			return nil, err
 		}
@@ -126,7 +133,7 @@ func (q *Queries) GetAllOrgDatabseConnectionsByClusterID(ctx context.Context, ar
 }
 
 const getDatabaseConnectionByID = `-- name: GetDatabaseConnectionByID :one
-SELECT id, org_id, name, cluster_id, username, password, database, created_at, updated_at FROM database_connections
+SELECT id, org_id, name, cluster_id, username, password, database, created_at, updated_at, session_settings FROM database_connections
 WHERE id = $1
 `
 
@@ -143,12 +150,13 @@ func (q *Queries) GetDatabaseConnectionByID(ctx context.Context, id int32) (*Dat
 		&i.Database,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.SessionSettings,
 	)
 	return &i, err
 }
 
 const getOrgDatabaseByID = `-- name: GetOrgDatabaseByID :one
-SELECT id, org_id, name, cluster_id, username, password, database, created_at, updated_at FROM database_connections
+SELECT id, org_id, name, cluster_id, username, password, database, created_at, updated_at, session_settings FROM database_connections
 WHERE id = $1 AND org_id = $2
 `
 
@@ -170,12 +178,13 @@ func (q *Queries) GetOrgDatabaseByID(ctx context.Context, arg GetOrgDatabaseByID
 		&i.Database,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.SessionSettings,
 	)
 	return &i, err
 }
 
 const getOrgDatabaseConnection = `-- name: GetOrgDatabaseConnection :one
-SELECT id, org_id, name, cluster_id, username, password, database, created_at, updated_at FROM database_connections
+SELECT id, org_id, name, cluster_id, username, password, database, created_at, updated_at, session_settings FROM database_connections
 WHERE id = $1 AND org_id = $2
 `
 
@@ -197,6 +206,7 @@ func (q *Queries) GetOrgDatabaseConnection(ctx context.Context, arg GetOrgDataba
 		&i.Database,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.SessionSettings,
 	)
 	return &i, err
 }
@@ -208,26 +218,29 @@ INSERT INTO database_connections (
     username,
     password,
     database,
-    org_id
+    org_id,
+    session_settings
 ) VALUES (
-    $1, $2, $3, $4, $5, $6
+    $1, $2, $3, $4, $5, $6, $7
 ) ON CONFLICT (org_id, name) DO UPDATE 
     SET 
         cluster_id = EXCLUDED.cluster_id,
         username = EXCLUDED.username,
         password = EXCLUDED.password,
         database = EXCLUDED.database,
+        session_settings = EXCLUDED.session_settings,
         updated_at = CURRENT_TIMESTAMP
-RETURNING id, org_id, name, cluster_id, username, password, database, created_at, updated_at
+RETURNING id, org_id, name, cluster_id, username, password, database, created_at, updated_at, session_settings
 `
 
 type InitDatabaseConnectionParams struct {
-	Name      string
-	ClusterID int32
-	Username  string
-	Password  *string
-	Database  string
-	OrgID     int32
+	Name            string
+	ClusterID       int32
+	Username        string
+	Password        *string
+	Database        string
+	OrgID           int32
+	SessionSettings *apigen.SessionSettings
 }
 
 func (q *Queries) InitDatabaseConnection(ctx context.Context, arg InitDatabaseConnectionParams) (*DatabaseConnection, error) {
@@ -238,6 +251,7 @@ func (q *Queries) InitDatabaseConnection(ctx context.Context, arg InitDatabaseCo
 		arg.Password,
 		arg.Database,
 		arg.OrgID,
+		arg.SessionSettings,
 	)
 	var i DatabaseConnection
 	err := row.Scan(
@@ -250,12 +264,49 @@ func (q *Queries) InitDatabaseConnection(ctx context.Context, arg InitDatabaseCo
 		&i.Database,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.SessionSettings,
 	)
 	return &i, err
 }
 
+const listAllDatabaseConnections = `-- name: ListAllDatabaseConnections :many
+SELECT id, org_id, name, cluster_id, username, password, database, created_at, updated_at, session_settings FROM database_connections
+ORDER BY id
+`
+
+func (q *Queries) ListAllDatabaseConnections(ctx context.Context) ([]*DatabaseConnection, error) {
+	rows, err := q.db.Query(ctx, listAllDatabaseConnections)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*DatabaseConnection
+	for rows.Next() {
+		var i DatabaseConnection
+		if err := rows.Scan(
+			&i.ID,
+			&i.OrgID,
+			&i.Name,
+			&i.ClusterID,
+			&i.Username,
+			&i.Password,
+			&i.Database,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.SessionSettings,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const listOrgDatabaseConnections = `-- name: ListOrgDatabaseConnections :many
-SELECT id, org_id, name, cluster_id, username, password, database, created_at, updated_at FROM database_connections
+SELECT id, org_id, name, cluster_id, username, password, database, created_at, updated_at, session_settings FROM database_connections
 WHERE org_id = $1
 ORDER BY name
 `
@@ -279,6 +330,7 @@ func (q *Queries) ListOrgDatabaseConnections(ctx context.Context, orgID int32) (
 			&i.Database,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.SessionSettings,
 		); err != nil {
 			return nil, err
 		}
@@ -299,20 +351,22 @@ SET
     password = $6,
     database = $7,
     org_id = $8,
+    session_settings = $9,
     updated_at = CURRENT_TIMESTAMP
 WHERE id = $1 AND org_id = $2
-RETURNING id, org_id, name, cluster_id, username, password, database, created_at, updated_at
+RETURNING id, org_id, name, cluster_id, username, password, database, created_at, updated_at, session_settings
 `
 
 type UpdateOrgDatabaseConnectionParams struct {
-	ID        int32
-	OrgID     int32
-	Name      string
-	ClusterID int32
-	Username  string
-	Password  *string
-	Database  string
-	OrgID_2   int32
+	ID              int32
+	OrgID           int32
+	Name            string
+	ClusterID       int32
+	Username        string
+	Password        *string
+	Database        string
+	OrgID_2         int32
+	SessionSettings *apigen.SessionSettings
 }
 
 func (q *Queries) UpdateOrgDatabaseConnection(ctx context.Context, arg UpdateOrgDatabaseConnectionParams) (*DatabaseConnection, error) {
@@ -325,6 +379,7 @@ func (q *Queries) UpdateOrgDatabaseConnection(ctx context.Context, arg UpdateOrg
 		arg.Password,
 		arg.Database,
 		arg.OrgID_2,
+		arg.SessionSettings,
 	)
 	var i DatabaseConnection
 	err := row.Scan(
@@ -337,6 +392,7 @@ func (q *Queries) UpdateOrgDatabaseConnection(ctx context.Context, arg UpdateOrg
 		&i.Database,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.SessionSettings,
 	)
 	return &i, err
 }
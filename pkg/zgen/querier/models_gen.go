@@ -5,18 +5,70 @@
 package querier
 
 import (
+	"encoding/json"
 	"time"
 
 	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/risingwavelabs/risingwave-console/pkg/zgen/apigen"
 )
 
-type AutoBackupConfig struct {
+type AlertHistory struct {
+	ID        int32
+	OrgID     int32
+	RuleID    *int32
+	RuleName  string
 	ClusterID int32
-	Enabled   bool
+	Status    string
+	Severity  string
+	Labels    apigen.AlertLabels
+	Value     float64
+	ActiveAt  time.Time
 	CreatedAt time.Time
-	UpdatedAt time.Time
-	TaskID    int32
+}
+
+type AlertRule struct {
+	ID          int32
+	OrgID       int32
+	ClusterID   *int32
+	Name        string
+	Expr        string
+	ForDuration string
+	Severity    string
+	Labels      apigen.AlertLabels
+	Enabled     bool
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+	LastError   *string
+}
+
+type AlertState struct {
+	RuleID      int32
+	ClusterID   int32
+	Series      string
+	Labels      apigen.AlertLabels
+	Status      string
+	Value       float64
+	ActiveAt    time.Time
+	FiredAt     *time.Time
+	EvaluatedAt time.Time
+}
+
+type AnchorOrgOwner struct {
+	OrgID     int32
+	UserID    int32
+	CreatedAt time.Time
+}
+
+type AutoBackupConfig struct {
+	ClusterID   int32
+	Enabled     bool
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+	TaskID      int32
+	KeepLast    *int32
+	KeepDaily   *int32
+	KeepWeekly  *int32
+	KeepMonthly *int32
 }
 
 type AutoDiagnosticsConfig struct {
@@ -27,26 +79,107 @@ type AutoDiagnosticsConfig struct {
 	TaskID    int32
 }
 
+type CatalogChangeEvent struct {
+	ID           int32
+	DatabaseID   int32
+	SnapshotID   *int32
+	RelationID   int32
+	SchemaName   string
+	RelationName string
+	RelationType string
+	ChangeType   string
+	Details      apigen.CatalogChangeDetails
+	CreatedAt    time.Time
+}
+
+type CatalogSnapshot struct {
+	ID         int32
+	DatabaseID int32
+	Hash       string
+	Catalog    json.RawMessage
+	CreatedAt  time.Time
+}
+
 type Cluster struct {
-	ID             int32
-	OrgID          int32
-	Name           string
-	Host           string
-	SqlPort        int32
-	MetaPort       int32
-	HttpPort       int32
-	Version        string
-	CreatedAt      time.Time
-	UpdatedAt      time.Time
-	MetricsStoreID *int32
+	ID                int32
+	OrgID             int32
+	Name              string
+	Host              string
+	SqlPort           int32
+	MetaPort          int32
+	HttpPort          int32
+	Version           string
+	CreatedAt         time.Time
+	UpdatedAt         time.Time
+	MetricsStoreID    *int32
+	RisectlVersion    *string
+	DetectedVersion   *string
+	VersionDetectedAt *time.Time
+	HealthStatus      *string
+	HealthCheckedAt   *time.Time
+	LastSeenAt        *time.Time
 }
 
 type ClusterDiagnostic struct {
+	ID                int32
+	ClusterID         int32
+	Content           *string
+	CreatedAt         time.Time
+	UpdatedAt         time.Time
+	ContentRef        *string
+	ContentSize       *int64
+	ContentStoredSize *int64
+	ContentSha256     *string
+}
+
+type ClusterDiagnosticBundle struct {
+	ID         int32
+	ClusterID  int32
+	OrgID      int32
+	UserID     int32
+	Status     string
+	Error      *string
+	Manifest   *apigen.DiagnosticBundleManifest
+	SizeBytes  *int64
+	CreatedAt  time.Time
+	FinishedAt *time.Time
+	ContentRef *string
+}
+
+type ClusterDiagnosticBundleContent struct {
+	BundleID int32
+	Content  []byte
+}
+
+type ClusterDiagnosticFinding struct {
+	ID           int32
+	DiagnosticID int32
+	ClusterID    int32
+	Rule         string
+	Severity     string
+	Message      string
+	Value        float64
+	Threshold    float64
+	Details      apigen.DiagnosticFindingDetails
+	CreatedAt    time.Time
+}
+
+type ClusterEvent struct {
 	ID        int32
 	ClusterID int32
-	Content   string
+	Type      string
+	Message   string
+	Details   apigen.ClusterEventDetails
+	CreatedAt time.Time
+}
+
+type ClusterHealthRecord struct {
+	ID        int32
+	ClusterID int32
+	Status    string
+	Reasons   []string
+	Details   apigen.ClusterHealthDetails
 	CreatedAt time.Time
-	UpdatedAt time.Time
 }
 
 type ClusterSnapshot struct {
@@ -55,18 +188,49 @@ type ClusterSnapshot struct {
 	Name       string
 	CreatedAt  time.Time
 	UpdatedAt  time.Time
+	Source     string
+	SyncStatus string
+	SyncedAt   *time.Time
+}
+
+type ClusterUpgrade struct {
+	ID              int32
+	ClusterID       int32
+	OrgID           int32
+	UserID          int32
+	FromVersion     string
+	TargetVersion   string
+	Status          string
+	SnapshotID      *int64
+	DetectedVersion *string
+	DiagnosticID    *int32
+	Error           *string
+	DeadlineAt      time.Time
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
+	FinishedAt      *time.Time
+}
+
+type ClusterVersionHistory struct {
+	ID              int32
+	ClusterID       int32
+	Version         string
+	PreviousVersion *string
+	Source          string
+	CreatedAt       time.Time
 }
 
 type DatabaseConnection struct {
-	ID        int32
-	OrgID     int32
-	Name      string
-	ClusterID int32
-	Username  string
-	Password  *string
-	Database  string
-	CreatedAt time.Time
-	UpdatedAt time.Time
+	ID              int32
+	OrgID           int32
+	Name            string
+	ClusterID       int32
+	Username        string
+	Password        *string
+	Database        string
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
+	SessionSettings *apigen.SessionSettings
 }
 
 type MetricsStore struct {
@@ -87,13 +251,23 @@ type OpaqueKey struct {
 	UpdatedAt time.Time
 }
 
-type OrgSetting struct {
+type OrgDiagnosticRule struct {
 	OrgID     int32
-	Timezone  string
-	CreatedAt time.Time
+	Rule      string
+	Enabled   bool
+	Threshold *float64
 	UpdatedAt time.Time
 }
 
+type OrgSetting struct {
+	OrgID                    int32
+	Timezone                 string
+	CreatedAt                time.Time
+	UpdatedAt                time.Time
+	MaxStatementTimeout      *string
+	AutoUpdateClusterVersion bool
+}
+
 type Organization struct {
 	ID        int32
 	Name      string
@@ -117,6 +291,52 @@ type RefreshToken struct {
 	UpdatedAt time.Time
 }
 
+type RisectlExecution struct {
+	ID              int32
+	ClusterID       int32
+	OrgID           int32
+	UserID          int32
+	Args            []string
+	Status          string
+	ExitCode        *int32
+	Error           *string
+	RisectlVersion  *string
+	CancelRequested bool
+	CreatedAt       time.Time
+	StartedAt       *time.Time
+	FinishedAt      *time.Time
+}
+
+type RisectlExecutionOutput struct {
+	ExecutionID int32
+	Seq         int32
+	Stream      string
+	Content     string
+	CreatedAt   time.Time
+}
+
+type RisectlShellCommand struct {
+	SessionID  int32
+	Seq        int32
+	Command    string
+	Args       []string
+	Allowed    bool
+	ExitCode   *int32
+	Error      *string
+	CreatedAt  time.Time
+	FinishedAt *time.Time
+}
+
+type RisectlShellSession struct {
+	ID             int32
+	ClusterID      int32
+	OrgID          int32
+	UserID         int32
+	RisectlVersion *string
+	CreatedAt      time.Time
+	ClosedAt       *time.Time
+}
+
 type Snapshot struct {
 	ClusterID  int32
 	SnapshotID int64
@@ -25,7 +25,7 @@ func (q *Queries) CreateOrgSettings(ctx context.Context, arg CreateOrgSettingsPa
 }
 
 const getOrgSettings = `-- name: GetOrgSettings :one
-SELECT org_id, timezone, created_at, updated_at FROM org_settings
+SELECT org_id, timezone, created_at, updated_at, max_statement_timeout, auto_update_cluster_version FROM org_settings
 WHERE org_id = $1
 `
 
@@ -37,6 +37,37 @@ func (q *Queries) GetOrgSettings(ctx context.Context, orgID int32) (*OrgSetting,
 		&i.Timezone,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.MaxStatementTimeout,
+		&i.AutoUpdateClusterVersion,
+	)
+	return &i, err
+}
+
+const updateOrgSettings = `-- name: UpdateOrgSettings :one
+UPDATE org_settings
+SET max_statement_timeout = NULLIF(COALESCE($2, max_statement_timeout), ''),
+    auto_update_cluster_version = COALESCE($3, auto_update_cluster_version),
+    updated_at = CURRENT_TIMESTAMP
+WHERE org_id = $1
+RETURNING org_id, timezone, created_at, updated_at, max_statement_timeout, auto_update_cluster_version
+`
+
+type UpdateOrgSettingsParams struct {
+	OrgID                    int32
+	MaxStatementTimeout      *string
+	AutoUpdateClusterVersion *bool
+}
+
+func (q *Queries) UpdateOrgSettings(ctx context.Context, arg UpdateOrgSettingsParams) (*OrgSetting, error) {
+	row := q.db.QueryRow(ctx, updateOrgSettings, arg.OrgID, arg.MaxStatementTimeout, arg.AutoUpdateClusterVersion)
+	var i OrgSetting
+	err := row.Scan(
+		&i.OrgID,
+		&i.Timezone,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.MaxStatementTimeout,
+		&i.AutoUpdateClusterVersion,
 	)
 	return &i, err
 }
@@ -6,50 +6,138 @@ package querier
 
 import (
 	"context"
+	"time"
 )
 
 type Querier interface {
+	CloseRisectlShellSession(ctx context.Context, id int32) error
+	CreateAlertHistory(ctx context.Context, arg CreateAlertHistoryParams) error
+	CreateAlertRule(ctx context.Context, arg CreateAlertRuleParams) (*AlertRule, error)
 	CreateAutoBackupConfig(ctx context.Context, arg CreateAutoBackupConfigParams) error
 	CreateAutoDiagnosticsConfig(ctx context.Context, arg CreateAutoDiagnosticsConfigParams) error
+	CreateCatalogChangeEvent(ctx context.Context, arg CreateCatalogChangeEventParams) error
+	CreateCatalogSnapshot(ctx context.Context, arg CreateCatalogSnapshotParams) (*CatalogSnapshot, error)
 	CreateCluster(ctx context.Context, arg CreateClusterParams) (*Cluster, error)
 	CreateClusterDiagnostic(ctx context.Context, arg CreateClusterDiagnosticParams) (*ClusterDiagnostic, error)
+	CreateClusterDiagnosticBundle(ctx context.Context, arg CreateClusterDiagnosticBundleParams) (*ClusterDiagnosticBundle, error)
+	CreateClusterDiagnosticFinding(ctx context.Context, arg CreateClusterDiagnosticFindingParams) (*ClusterDiagnosticFinding, error)
+	CreateClusterDiagnosticSearch(ctx context.Context, arg CreateClusterDiagnosticSearchParams) error
+	CreateClusterEvent(ctx context.Context, arg CreateClusterEventParams) error
+	CreateClusterHealthRecord(ctx context.Context, arg CreateClusterHealthRecordParams) error
 	CreateClusterSnapshot(ctx context.Context, arg CreateClusterSnapshotParams) error
+	CreateClusterUpgrade(ctx context.Context, arg CreateClusterUpgradeParams) (*ClusterUpgrade, error)
+	CreateClusterVersionHistory(ctx context.Context, arg CreateClusterVersionHistoryParams) error
 	CreateDatabaseConnection(ctx context.Context, arg CreateDatabaseConnectionParams) (*DatabaseConnection, error)
 	CreateMetricsStore(ctx context.Context, arg CreateMetricsStoreParams) (*MetricsStore, error)
 	CreateOrgSettings(ctx context.Context, arg CreateOrgSettingsParams) error
+	CreateRisectlExecution(ctx context.Context, arg CreateRisectlExecutionParams) (*RisectlExecution, error)
+	CreateRisectlExecutionOutput(ctx context.Context, arg CreateRisectlExecutionOutputParams) error
+	CreateRisectlShellCommand(ctx context.Context, arg CreateRisectlShellCommandParams) (*RisectlShellCommand, error)
+	CreateRisectlShellSession(ctx context.Context, arg CreateRisectlShellSessionParams) (*RisectlShellSession, error)
+	DeleteAlertState(ctx context.Context, arg DeleteAlertStateParams) error
+	DeleteAlertStatesByRule(ctx context.Context, ruleID int32) ([]*AlertState, error)
 	DeleteAllOrgDatabaseConnectionsByClusterID(ctx context.Context, arg DeleteAllOrgDatabaseConnectionsByClusterIDParams) error
 	DeleteClusterDiagnostic(ctx context.Context, id int32) error
+	DeleteClusterDiagnosticBundle(ctx context.Context, id int32) error
+	DeleteClusterDiagnosticBundleContent(ctx context.Context, bundleID int32) error
 	DeleteClusterSnapshot(ctx context.Context, arg DeleteClusterSnapshotParams) error
 	DeleteMetricsStore(ctx context.Context, arg DeleteMetricsStoreParams) error
+	DeleteOldAlertHistory(ctx context.Context, createdAt time.Time) (int64, error)
+	DeleteOldCatalogSnapshots(ctx context.Context, arg DeleteOldCatalogSnapshotsParams) (int64, error)
+	DeleteOldClusterEvents(ctx context.Context, createdAt time.Time) (int64, error)
+	DeleteOldClusterHealthRecords(ctx context.Context, createdAt time.Time) (int64, error)
+	DeleteOldRisectlExecutions(ctx context.Context, finishedAt *time.Time) (int64, error)
+	DeleteOrgAlertRule(ctx context.Context, arg DeleteOrgAlertRuleParams) (int64, error)
 	DeleteOrgCluster(ctx context.Context, arg DeleteOrgClusterParams) error
 	DeleteOrgDatabaseConnection(ctx context.Context, arg DeleteOrgDatabaseConnectionParams) error
+	FinishClusterDiagnosticBundle(ctx context.Context, arg FinishClusterDiagnosticBundleParams) error
+	FinishClusterUpgrade(ctx context.Context, arg FinishClusterUpgradeParams) (*ClusterUpgrade, error)
+	FinishRisectlExecution(ctx context.Context, arg FinishRisectlExecutionParams) error
+	FinishRisectlShellCommand(ctx context.Context, arg FinishRisectlShellCommandParams) error
 	GetAllOrgDatabseConnectionsByClusterID(ctx context.Context, arg GetAllOrgDatabseConnectionsByClusterIDParams) ([]*DatabaseConnection, error)
 	GetAutoBackupConfig(ctx context.Context, clusterID int32) (*AutoBackupConfig, error)
 	GetAutoDiagnosticsConfig(ctx context.Context, clusterID int32) (*AutoDiagnosticsConfig, error)
 	GetClusterByID(ctx context.Context, id int32) (*Cluster, error)
 	GetClusterDiagnostic(ctx context.Context, id int32) (*ClusterDiagnostic, error)
+	GetClusterDiagnosticBundle(ctx context.Context, id int32) (*ClusterDiagnosticBundle, error)
+	GetClusterDiagnosticBundleContent(ctx context.Context, bundleID int32) ([]byte, error)
+	GetClusterUpgrade(ctx context.Context, id int32) (*ClusterUpgrade, error)
 	GetDatabaseConnectionByID(ctx context.Context, id int32) (*DatabaseConnection, error)
+	GetInProgressClusterUpgrade(ctx context.Context, clusterID int32) (*ClusterUpgrade, error)
+	GetLatestCatalogSnapshot(ctx context.Context, databaseID int32) (*CatalogSnapshot, error)
+	GetLatestClusterHealthRecord(ctx context.Context, clusterID int32) (*ClusterHealthRecord, error)
 	GetMetricsStore(ctx context.Context, id int32) (*MetricsStore, error)
 	GetMetricsStoreByIDAndOrgID(ctx context.Context, arg GetMetricsStoreByIDAndOrgIDParams) (*MetricsStore, error)
+	GetOrgAlertRule(ctx context.Context, arg GetOrgAlertRuleParams) (*AlertRule, error)
 	GetOrgCluster(ctx context.Context, arg GetOrgClusterParams) (*Cluster, error)
+	GetOrgClusterDiagnosticBundle(ctx context.Context, arg GetOrgClusterDiagnosticBundleParams) (*ClusterDiagnosticBundle, error)
+	GetOrgClusterUpgrade(ctx context.Context, arg GetOrgClusterUpgradeParams) (*ClusterUpgrade, error)
 	GetOrgDatabaseByID(ctx context.Context, arg GetOrgDatabaseByIDParams) (*DatabaseConnection, error)
 	GetOrgDatabaseConnection(ctx context.Context, arg GetOrgDatabaseConnectionParams) (*DatabaseConnection, error)
+	GetOrgRisectlExecution(ctx context.Context, arg GetOrgRisectlExecutionParams) (*RisectlExecution, error)
+	GetOrgRisectlShellSession(ctx context.Context, arg GetOrgRisectlShellSessionParams) (*RisectlShellSession, error)
 	GetOrgSettings(ctx context.Context, orgID int32) (*OrgSetting, error)
+	GetPreviousClusterDiagnostic(ctx context.Context, arg GetPreviousClusterDiagnosticParams) (*ClusterDiagnostic, error)
+	GetRisectlExecution(ctx context.Context, id int32) (*RisectlExecution, error)
+	ImportClusterSnapshot(ctx context.Context, arg ImportClusterSnapshotParams) error
 	InitCluster(ctx context.Context, arg InitClusterParams) (*Cluster, error)
 	InitDatabaseConnection(ctx context.Context, arg InitDatabaseConnectionParams) (*DatabaseConnection, error)
 	InitMetricsStore(ctx context.Context, arg InitMetricsStoreParams) (*MetricsStore, error)
+	IsOrgOwner(ctx context.Context, arg IsOrgOwnerParams) (bool, error)
+	IsRisectlExecutionCancelRequested(ctx context.Context, id int32) (bool, error)
+	ListAlertStates(ctx context.Context) ([]*ListAlertStatesRow, error)
+	ListAllClusters(ctx context.Context) ([]*Cluster, error)
+	ListAllDatabaseConnections(ctx context.Context) ([]*DatabaseConnection, error)
+	ListCatalogChangeEvents(ctx context.Context, arg ListCatalogChangeEventsParams) ([]*CatalogChangeEvent, error)
+	ListClusterDiagnosticFindings(ctx context.Context, diagnosticID int32) ([]*ClusterDiagnosticFinding, error)
 	ListClusterDiagnostics(ctx context.Context, clusterID int32) ([]*ListClusterDiagnosticsRow, error)
+	ListClusterEvents(ctx context.Context, arg ListClusterEventsParams) ([]*ClusterEvent, error)
+	ListClusterHealthRecords(ctx context.Context, arg ListClusterHealthRecordsParams) ([]*ClusterHealthRecord, error)
 	ListClusterSnapshots(ctx context.Context, clusterID int32) ([]*ClusterSnapshot, error)
+	ListClusterUpgrades(ctx context.Context, clusterID int32) ([]*ClusterUpgrade, error)
+	ListClusterVersionHistory(ctx context.Context, clusterID int32) ([]*ClusterVersionHistory, error)
 	ListClustersByMetricsStoreID(ctx context.Context, metricsStoreID *int32) ([]*Cluster, error)
+	ListEnabledAlertRules(ctx context.Context) ([]*AlertRule, error)
+	ListExpiredClusterDiagnosticBundles(ctx context.Context, arg ListExpiredClusterDiagnosticBundlesParams) ([]*ClusterDiagnosticBundle, error)
+	ListInlineClusterDiagnosticBundles(ctx context.Context, limit int32) ([]*ClusterDiagnosticBundle, error)
+	ListInlineClusterDiagnostics(ctx context.Context, limit int32) ([]*ClusterDiagnostic, error)
 	ListMetricsStoresByOrgID(ctx context.Context, orgID int32) ([]*MetricsStore, error)
+	ListOrgAlertHistory(ctx context.Context, arg ListOrgAlertHistoryParams) ([]*AlertHistory, error)
+	ListOrgAlertRules(ctx context.Context, arg ListOrgAlertRulesParams) ([]*AlertRule, error)
+	ListOrgAlertStates(ctx context.Context, arg ListOrgAlertStatesParams) ([]*ListOrgAlertStatesRow, error)
+	ListOrgClusterDiagnosticBundles(ctx context.Context, arg ListOrgClusterDiagnosticBundlesParams) ([]*ClusterDiagnosticBundle, error)
 	ListOrgClusters(ctx context.Context, orgID int32) ([]*Cluster, error)
 	ListOrgDatabaseConnections(ctx context.Context, orgID int32) ([]*DatabaseConnection, error)
+	ListOrgDiagnosticRules(ctx context.Context, orgID int32) ([]*OrgDiagnosticRule, error)
+	ListOrgRisectlExecutions(ctx context.Context, arg ListOrgRisectlExecutionsParams) ([]*RisectlExecution, error)
+	ListOrgRisectlShellSessions(ctx context.Context, arg ListOrgRisectlShellSessionsParams) ([]*RisectlShellSession, error)
+	ListRisectlExecutionOutputs(ctx context.Context, arg ListRisectlExecutionOutputsParams) ([]*RisectlExecutionOutput, error)
+	ListRisectlShellCommands(ctx context.Context, sessionID int32) ([]*RisectlShellCommand, error)
+	ListUnindexedClusterDiagnostics(ctx context.Context, limit int32) ([]*ClusterDiagnostic, error)
+	MoveClusterDiagnosticContent(ctx context.Context, arg MoveClusterDiagnosticContentParams) error
 	RemoveClusterMetricsStoreID(ctx context.Context, arg RemoveClusterMetricsStoreIDParams) error
+	RequestRisectlExecutionCancel(ctx context.Context, id int32) error
+	SearchOrgClusterDiagnostics(ctx context.Context, arg SearchOrgClusterDiagnosticsParams) ([]*SearchOrgClusterDiagnosticsRow, error)
+	StartRisectlExecution(ctx context.Context, arg StartRisectlExecutionParams) error
+	UpdateAlertRuleLastError(ctx context.Context, arg UpdateAlertRuleLastErrorParams) error
 	UpdateAutoBackupConfig(ctx context.Context, arg UpdateAutoBackupConfigParams) error
+	UpdateAutoBackupRetentionPolicy(ctx context.Context, arg UpdateAutoBackupRetentionPolicyParams) error
 	UpdateAutoDiagnosticsConfig(ctx context.Context, arg UpdateAutoDiagnosticsConfigParams) error
+	UpdateClusterDetectedVersion(ctx context.Context, arg UpdateClusterDetectedVersionParams) error
+	UpdateClusterDiagnosticBundleContentRef(ctx context.Context, arg UpdateClusterDiagnosticBundleContentRefParams) error
+	UpdateClusterDiagnosticBundleStatus(ctx context.Context, arg UpdateClusterDiagnosticBundleStatusParams) error
+	UpdateClusterHealth(ctx context.Context, arg UpdateClusterHealthParams) error
+	UpdateClusterSnapshotSyncStatus(ctx context.Context, arg UpdateClusterSnapshotSyncStatusParams) error
+	UpdateClusterUpgradeDetectedVersion(ctx context.Context, arg UpdateClusterUpgradeDetectedVersionParams) error
+	UpdateClusterUpgradeSnapshot(ctx context.Context, arg UpdateClusterUpgradeSnapshotParams) error
+	UpdateClusterVersion(ctx context.Context, arg UpdateClusterVersionParams) error
 	UpdateMetricsStore(ctx context.Context, arg UpdateMetricsStoreParams) (*MetricsStore, error)
+	UpdateOrgAlertRule(ctx context.Context, arg UpdateOrgAlertRuleParams) (*AlertRule, error)
 	UpdateOrgCluster(ctx context.Context, arg UpdateOrgClusterParams) (*Cluster, error)
 	UpdateOrgDatabaseConnection(ctx context.Context, arg UpdateOrgDatabaseConnectionParams) (*DatabaseConnection, error)
+	UpdateOrgSettings(ctx context.Context, arg UpdateOrgSettingsParams) (*OrgSetting, error)
+	UpsertAlertState(ctx context.Context, arg UpsertAlertStateParams) error
+	UpsertOrgDiagnosticRule(ctx context.Context, arg UpsertOrgDiagnosticRuleParams) (*OrgDiagnosticRule, error)
 }
 
 var _ Querier = (*Queries)(nil)
@@ -42,6 +42,46 @@ func (m *MockTaskRunner) EXPECT() *MockTaskRunnerMockRecorder {
 	return m.recorder
 }
 
+// RunApplySnapshotRetention mocks base method.
+func (m *MockTaskRunner) RunApplySnapshotRetention(ctx context.Context, params *ApplySnapshotRetentionParameters, overrides ...taskcore.TaskOverride) (int32, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, params}
+	for _, a := range overrides {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RunApplySnapshotRetention", varargs...)
+	ret0, _ := ret[0].(int32)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RunApplySnapshotRetention indicates an expected call of RunApplySnapshotRetention.
+func (mr *MockTaskRunnerMockRecorder) RunApplySnapshotRetention(ctx, params any, overrides ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, params}, overrides...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RunApplySnapshotRetention", reflect.TypeOf((*MockTaskRunner)(nil).RunApplySnapshotRetention), varargs...)
+}
+
+// RunApplySnapshotRetentionWithTx mocks base method.
+func (m *MockTaskRunner) RunApplySnapshotRetentionWithTx(ctx context.Context, tx pgx.Tx, params *ApplySnapshotRetentionParameters, overrides ...taskcore.TaskOverride) (int32, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, tx, params}
+	for _, a := range overrides {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RunApplySnapshotRetentionWithTx", varargs...)
+	ret0, _ := ret[0].(int32)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RunApplySnapshotRetentionWithTx indicates an expected call of RunApplySnapshotRetentionWithTx.
+func (mr *MockTaskRunnerMockRecorder) RunApplySnapshotRetentionWithTx(ctx, tx, params any, overrides ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, tx, params}, overrides...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RunApplySnapshotRetentionWithTx", reflect.TypeOf((*MockTaskRunner)(nil).RunApplySnapshotRetentionWithTx), varargs...)
+}
+
 // RunAutoBackup mocks base method.
 func (m *MockTaskRunner) RunAutoBackup(ctx context.Context, params *AutoBackupParameters, overrides ...taskcore.TaskOverride) (int32, error) {
 	m.ctrl.T.Helper()
@@ -49,157 +89,837 @@ func (m *MockTaskRunner) RunAutoBackup(ctx context.Context, params *AutoBackupPa
 	for _, a := range overrides {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "RunAutoBackup", varargs...)
+	ret := m.ctrl.Call(m, "RunAutoBackup", varargs...)
+	ret0, _ := ret[0].(int32)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RunAutoBackup indicates an expected call of RunAutoBackup.
+func (mr *MockTaskRunnerMockRecorder) RunAutoBackup(ctx, params any, overrides ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, params}, overrides...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RunAutoBackup", reflect.TypeOf((*MockTaskRunner)(nil).RunAutoBackup), varargs...)
+}
+
+// RunAutoBackupWithTx mocks base method.
+func (m *MockTaskRunner) RunAutoBackupWithTx(ctx context.Context, tx pgx.Tx, params *AutoBackupParameters, overrides ...taskcore.TaskOverride) (int32, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, tx, params}
+	for _, a := range overrides {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RunAutoBackupWithTx", varargs...)
+	ret0, _ := ret[0].(int32)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RunAutoBackupWithTx indicates an expected call of RunAutoBackupWithTx.
+func (mr *MockTaskRunnerMockRecorder) RunAutoBackupWithTx(ctx, tx, params any, overrides ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, tx, params}, overrides...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RunAutoBackupWithTx", reflect.TypeOf((*MockTaskRunner)(nil).RunAutoBackupWithTx), varargs...)
+}
+
+// RunAutoDiagnostic mocks base method.
+func (m *MockTaskRunner) RunAutoDiagnostic(ctx context.Context, params *AutoDiagnosticParameters, overrides ...taskcore.TaskOverride) (int32, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, params}
+	for _, a := range overrides {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RunAutoDiagnostic", varargs...)
+	ret0, _ := ret[0].(int32)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RunAutoDiagnostic indicates an expected call of RunAutoDiagnostic.
+func (mr *MockTaskRunnerMockRecorder) RunAutoDiagnostic(ctx, params any, overrides ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, params}, overrides...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RunAutoDiagnostic", reflect.TypeOf((*MockTaskRunner)(nil).RunAutoDiagnostic), varargs...)
+}
+
+// RunAutoDiagnosticWithTx mocks base method.
+func (m *MockTaskRunner) RunAutoDiagnosticWithTx(ctx context.Context, tx pgx.Tx, params *AutoDiagnosticParameters, overrides ...taskcore.TaskOverride) (int32, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, tx, params}
+	for _, a := range overrides {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RunAutoDiagnosticWithTx", varargs...)
+	ret0, _ := ret[0].(int32)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RunAutoDiagnosticWithTx indicates an expected call of RunAutoDiagnosticWithTx.
+func (mr *MockTaskRunnerMockRecorder) RunAutoDiagnosticWithTx(ctx, tx, params any, overrides ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, tx, params}, overrides...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RunAutoDiagnosticWithTx", reflect.TypeOf((*MockTaskRunner)(nil).RunAutoDiagnosticWithTx), varargs...)
+}
+
+// RunCatalogSnapshot mocks base method.
+func (m *MockTaskRunner) RunCatalogSnapshot(ctx context.Context, params *CatalogSnapshotParameters, overrides ...taskcore.TaskOverride) (int32, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, params}
+	for _, a := range overrides {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RunCatalogSnapshot", varargs...)
+	ret0, _ := ret[0].(int32)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RunCatalogSnapshot indicates an expected call of RunCatalogSnapshot.
+func (mr *MockTaskRunnerMockRecorder) RunCatalogSnapshot(ctx, params any, overrides ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, params}, overrides...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RunCatalogSnapshot", reflect.TypeOf((*MockTaskRunner)(nil).RunCatalogSnapshot), varargs...)
+}
+
+// RunCatalogSnapshotWithTx mocks base method.
+func (m *MockTaskRunner) RunCatalogSnapshotWithTx(ctx context.Context, tx pgx.Tx, params *CatalogSnapshotParameters, overrides ...taskcore.TaskOverride) (int32, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, tx, params}
+	for _, a := range overrides {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RunCatalogSnapshotWithTx", varargs...)
+	ret0, _ := ret[0].(int32)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RunCatalogSnapshotWithTx indicates an expected call of RunCatalogSnapshotWithTx.
+func (mr *MockTaskRunnerMockRecorder) RunCatalogSnapshotWithTx(ctx, tx, params any, overrides ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, tx, params}, overrides...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RunCatalogSnapshotWithTx", reflect.TypeOf((*MockTaskRunner)(nil).RunCatalogSnapshotWithTx), varargs...)
+}
+
+// RunCheckClusterHealth mocks base method.
+func (m *MockTaskRunner) RunCheckClusterHealth(ctx context.Context, params *CheckClusterHealthParameters, overrides ...taskcore.TaskOverride) (int32, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, params}
+	for _, a := range overrides {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RunCheckClusterHealth", varargs...)
+	ret0, _ := ret[0].(int32)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RunCheckClusterHealth indicates an expected call of RunCheckClusterHealth.
+func (mr *MockTaskRunnerMockRecorder) RunCheckClusterHealth(ctx, params any, overrides ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, params}, overrides...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RunCheckClusterHealth", reflect.TypeOf((*MockTaskRunner)(nil).RunCheckClusterHealth), varargs...)
+}
+
+// RunCheckClusterHealthWithTx mocks base method.
+func (m *MockTaskRunner) RunCheckClusterHealthWithTx(ctx context.Context, tx pgx.Tx, params *CheckClusterHealthParameters, overrides ...taskcore.TaskOverride) (int32, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, tx, params}
+	for _, a := range overrides {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RunCheckClusterHealthWithTx", varargs...)
+	ret0, _ := ret[0].(int32)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RunCheckClusterHealthWithTx indicates an expected call of RunCheckClusterHealthWithTx.
+func (mr *MockTaskRunnerMockRecorder) RunCheckClusterHealthWithTx(ctx, tx, params any, overrides ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, tx, params}, overrides...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RunCheckClusterHealthWithTx", reflect.TypeOf((*MockTaskRunner)(nil).RunCheckClusterHealthWithTx), varargs...)
+}
+
+// RunClusterUpgrade mocks base method.
+func (m *MockTaskRunner) RunClusterUpgrade(ctx context.Context, params *ClusterUpgradeParameters, overrides ...taskcore.TaskOverride) (int32, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, params}
+	for _, a := range overrides {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RunClusterUpgrade", varargs...)
+	ret0, _ := ret[0].(int32)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RunClusterUpgrade indicates an expected call of RunClusterUpgrade.
+func (mr *MockTaskRunnerMockRecorder) RunClusterUpgrade(ctx, params any, overrides ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, params}, overrides...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RunClusterUpgrade", reflect.TypeOf((*MockTaskRunner)(nil).RunClusterUpgrade), varargs...)
+}
+
+// RunClusterUpgradeWithTx mocks base method.
+func (m *MockTaskRunner) RunClusterUpgradeWithTx(ctx context.Context, tx pgx.Tx, params *ClusterUpgradeParameters, overrides ...taskcore.TaskOverride) (int32, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, tx, params}
+	for _, a := range overrides {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RunClusterUpgradeWithTx", varargs...)
+	ret0, _ := ret[0].(int32)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RunClusterUpgradeWithTx indicates an expected call of RunClusterUpgradeWithTx.
+func (mr *MockTaskRunnerMockRecorder) RunClusterUpgradeWithTx(ctx, tx, params any, overrides ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, tx, params}, overrides...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RunClusterUpgradeWithTx", reflect.TypeOf((*MockTaskRunner)(nil).RunClusterUpgradeWithTx), varargs...)
+}
+
+// RunDeleteClusterBlobs mocks base method.
+func (m *MockTaskRunner) RunDeleteClusterBlobs(ctx context.Context, params *DeleteClusterBlobsParameters, overrides ...taskcore.TaskOverride) (int32, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, params}
+	for _, a := range overrides {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RunDeleteClusterBlobs", varargs...)
+	ret0, _ := ret[0].(int32)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RunDeleteClusterBlobs indicates an expected call of RunDeleteClusterBlobs.
+func (mr *MockTaskRunnerMockRecorder) RunDeleteClusterBlobs(ctx, params any, overrides ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, params}, overrides...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RunDeleteClusterBlobs", reflect.TypeOf((*MockTaskRunner)(nil).RunDeleteClusterBlobs), varargs...)
+}
+
+// RunDeleteClusterBlobsWithTx mocks base method.
+func (m *MockTaskRunner) RunDeleteClusterBlobsWithTx(ctx context.Context, tx pgx.Tx, params *DeleteClusterBlobsParameters, overrides ...taskcore.TaskOverride) (int32, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, tx, params}
+	for _, a := range overrides {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RunDeleteClusterBlobsWithTx", varargs...)
+	ret0, _ := ret[0].(int32)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RunDeleteClusterBlobsWithTx indicates an expected call of RunDeleteClusterBlobsWithTx.
+func (mr *MockTaskRunnerMockRecorder) RunDeleteClusterBlobsWithTx(ctx, tx, params any, overrides ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, tx, params}, overrides...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RunDeleteClusterBlobsWithTx", reflect.TypeOf((*MockTaskRunner)(nil).RunDeleteClusterBlobsWithTx), varargs...)
+}
+
+// RunDeleteClusterDiagnostic mocks base method.
+func (m *MockTaskRunner) RunDeleteClusterDiagnostic(ctx context.Context, params *DeleteClusterDiagnosticParameters, overrides ...taskcore.TaskOverride) (int32, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, params}
+	for _, a := range overrides {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RunDeleteClusterDiagnostic", varargs...)
+	ret0, _ := ret[0].(int32)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RunDeleteClusterDiagnostic indicates an expected call of RunDeleteClusterDiagnostic.
+func (mr *MockTaskRunnerMockRecorder) RunDeleteClusterDiagnostic(ctx, params any, overrides ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, params}, overrides...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RunDeleteClusterDiagnostic", reflect.TypeOf((*MockTaskRunner)(nil).RunDeleteClusterDiagnostic), varargs...)
+}
+
+// RunDeleteClusterDiagnosticWithTx mocks base method.
+func (m *MockTaskRunner) RunDeleteClusterDiagnosticWithTx(ctx context.Context, tx pgx.Tx, params *DeleteClusterDiagnosticParameters, overrides ...taskcore.TaskOverride) (int32, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, tx, params}
+	for _, a := range overrides {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RunDeleteClusterDiagnosticWithTx", varargs...)
+	ret0, _ := ret[0].(int32)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RunDeleteClusterDiagnosticWithTx indicates an expected call of RunDeleteClusterDiagnosticWithTx.
+func (mr *MockTaskRunnerMockRecorder) RunDeleteClusterDiagnosticWithTx(ctx, tx, params any, overrides ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, tx, params}, overrides...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RunDeleteClusterDiagnosticWithTx", reflect.TypeOf((*MockTaskRunner)(nil).RunDeleteClusterDiagnosticWithTx), varargs...)
+}
+
+// RunDeleteSnapshot mocks base method.
+func (m *MockTaskRunner) RunDeleteSnapshot(ctx context.Context, params *DeleteSnapshotParameters, overrides ...taskcore.TaskOverride) (int32, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, params}
+	for _, a := range overrides {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RunDeleteSnapshot", varargs...)
+	ret0, _ := ret[0].(int32)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RunDeleteSnapshot indicates an expected call of RunDeleteSnapshot.
+func (mr *MockTaskRunnerMockRecorder) RunDeleteSnapshot(ctx, params any, overrides ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, params}, overrides...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RunDeleteSnapshot", reflect.TypeOf((*MockTaskRunner)(nil).RunDeleteSnapshot), varargs...)
+}
+
+// RunDeleteSnapshotWithTx mocks base method.
+func (m *MockTaskRunner) RunDeleteSnapshotWithTx(ctx context.Context, tx pgx.Tx, params *DeleteSnapshotParameters, overrides ...taskcore.TaskOverride) (int32, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, tx, params}
+	for _, a := range overrides {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RunDeleteSnapshotWithTx", varargs...)
+	ret0, _ := ret[0].(int32)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RunDeleteSnapshotWithTx indicates an expected call of RunDeleteSnapshotWithTx.
+func (mr *MockTaskRunnerMockRecorder) RunDeleteSnapshotWithTx(ctx, tx, params any, overrides ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, tx, params}, overrides...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RunDeleteSnapshotWithTx", reflect.TypeOf((*MockTaskRunner)(nil).RunDeleteSnapshotWithTx), varargs...)
+}
+
+// RunDetectClusterVersion mocks base method.
+func (m *MockTaskRunner) RunDetectClusterVersion(ctx context.Context, params *DetectClusterVersionParameters, overrides ...taskcore.TaskOverride) (int32, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, params}
+	for _, a := range overrides {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RunDetectClusterVersion", varargs...)
+	ret0, _ := ret[0].(int32)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RunDetectClusterVersion indicates an expected call of RunDetectClusterVersion.
+func (mr *MockTaskRunnerMockRecorder) RunDetectClusterVersion(ctx, params any, overrides ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, params}, overrides...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RunDetectClusterVersion", reflect.TypeOf((*MockTaskRunner)(nil).RunDetectClusterVersion), varargs...)
+}
+
+// RunDetectClusterVersionWithTx mocks base method.
+func (m *MockTaskRunner) RunDetectClusterVersionWithTx(ctx context.Context, tx pgx.Tx, params *DetectClusterVersionParameters, overrides ...taskcore.TaskOverride) (int32, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, tx, params}
+	for _, a := range overrides {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RunDetectClusterVersionWithTx", varargs...)
+	ret0, _ := ret[0].(int32)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RunDetectClusterVersionWithTx indicates an expected call of RunDetectClusterVersionWithTx.
+func (mr *MockTaskRunnerMockRecorder) RunDetectClusterVersionWithTx(ctx, tx, params any, overrides ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, tx, params}, overrides...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RunDetectClusterVersionWithTx", reflect.TypeOf((*MockTaskRunner)(nil).RunDetectClusterVersionWithTx), varargs...)
+}
+
+// RunDetectClusterVersions mocks base method.
+func (m *MockTaskRunner) RunDetectClusterVersions(ctx context.Context, params *DetectClusterVersionsParameters, overrides ...taskcore.TaskOverride) (int32, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, params}
+	for _, a := range overrides {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RunDetectClusterVersions", varargs...)
+	ret0, _ := ret[0].(int32)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RunDetectClusterVersions indicates an expected call of RunDetectClusterVersions.
+func (mr *MockTaskRunnerMockRecorder) RunDetectClusterVersions(ctx, params any, overrides ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, params}, overrides...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RunDetectClusterVersions", reflect.TypeOf((*MockTaskRunner)(nil).RunDetectClusterVersions), varargs...)
+}
+
+// RunDetectClusterVersionsWithTx mocks base method.
+func (m *MockTaskRunner) RunDetectClusterVersionsWithTx(ctx context.Context, tx pgx.Tx, params *DetectClusterVersionsParameters, overrides ...taskcore.TaskOverride) (int32, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, tx, params}
+	for _, a := range overrides {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RunDetectClusterVersionsWithTx", varargs...)
+	ret0, _ := ret[0].(int32)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RunDetectClusterVersionsWithTx indicates an expected call of RunDetectClusterVersionsWithTx.
+func (mr *MockTaskRunnerMockRecorder) RunDetectClusterVersionsWithTx(ctx, tx, params any, overrides ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, tx, params}, overrides...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RunDetectClusterVersionsWithTx", reflect.TypeOf((*MockTaskRunner)(nil).RunDetectClusterVersionsWithTx), varargs...)
+}
+
+// RunDiagnosticBundle mocks base method.
+func (m *MockTaskRunner) RunDiagnosticBundle(ctx context.Context, params *DiagnosticBundleParameters, overrides ...taskcore.TaskOverride) (int32, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, params}
+	for _, a := range overrides {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RunDiagnosticBundle", varargs...)
+	ret0, _ := ret[0].(int32)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RunDiagnosticBundle indicates an expected call of RunDiagnosticBundle.
+func (mr *MockTaskRunnerMockRecorder) RunDiagnosticBundle(ctx, params any, overrides ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, params}, overrides...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RunDiagnosticBundle", reflect.TypeOf((*MockTaskRunner)(nil).RunDiagnosticBundle), varargs...)
+}
+
+// RunDiagnosticBundleWithTx mocks base method.
+func (m *MockTaskRunner) RunDiagnosticBundleWithTx(ctx context.Context, tx pgx.Tx, params *DiagnosticBundleParameters, overrides ...taskcore.TaskOverride) (int32, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, tx, params}
+	for _, a := range overrides {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RunDiagnosticBundleWithTx", varargs...)
+	ret0, _ := ret[0].(int32)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RunDiagnosticBundleWithTx indicates an expected call of RunDiagnosticBundleWithTx.
+func (mr *MockTaskRunnerMockRecorder) RunDiagnosticBundleWithTx(ctx, tx, params any, overrides ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, tx, params}, overrides...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RunDiagnosticBundleWithTx", reflect.TypeOf((*MockTaskRunner)(nil).RunDiagnosticBundleWithTx), varargs...)
+}
+
+// RunEvaluateAlertRules mocks base method.
+func (m *MockTaskRunner) RunEvaluateAlertRules(ctx context.Context, params *EvaluateAlertRulesParameters, overrides ...taskcore.TaskOverride) (int32, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, params}
+	for _, a := range overrides {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RunEvaluateAlertRules", varargs...)
+	ret0, _ := ret[0].(int32)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RunEvaluateAlertRules indicates an expected call of RunEvaluateAlertRules.
+func (mr *MockTaskRunnerMockRecorder) RunEvaluateAlertRules(ctx, params any, overrides ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, params}, overrides...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RunEvaluateAlertRules", reflect.TypeOf((*MockTaskRunner)(nil).RunEvaluateAlertRules), varargs...)
+}
+
+// RunEvaluateAlertRulesWithTx mocks base method.
+func (m *MockTaskRunner) RunEvaluateAlertRulesWithTx(ctx context.Context, tx pgx.Tx, params *EvaluateAlertRulesParameters, overrides ...taskcore.TaskOverride) (int32, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, tx, params}
+	for _, a := range overrides {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RunEvaluateAlertRulesWithTx", varargs...)
+	ret0, _ := ret[0].(int32)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RunEvaluateAlertRulesWithTx indicates an expected call of RunEvaluateAlertRulesWithTx.
+func (mr *MockTaskRunnerMockRecorder) RunEvaluateAlertRulesWithTx(ctx, tx, params any, overrides ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, tx, params}, overrides...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RunEvaluateAlertRulesWithTx", reflect.TypeOf((*MockTaskRunner)(nil).RunEvaluateAlertRulesWithTx), varargs...)
+}
+
+// RunIndexDiagnostics mocks base method.
+func (m *MockTaskRunner) RunIndexDiagnostics(ctx context.Context, params *IndexDiagnosticsParameters, overrides ...taskcore.TaskOverride) (int32, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, params}
+	for _, a := range overrides {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RunIndexDiagnostics", varargs...)
+	ret0, _ := ret[0].(int32)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RunIndexDiagnostics indicates an expected call of RunIndexDiagnostics.
+func (mr *MockTaskRunnerMockRecorder) RunIndexDiagnostics(ctx, params any, overrides ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, params}, overrides...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RunIndexDiagnostics", reflect.TypeOf((*MockTaskRunner)(nil).RunIndexDiagnostics), varargs...)
+}
+
+// RunIndexDiagnosticsWithTx mocks base method.
+func (m *MockTaskRunner) RunIndexDiagnosticsWithTx(ctx context.Context, tx pgx.Tx, params *IndexDiagnosticsParameters, overrides ...taskcore.TaskOverride) (int32, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, tx, params}
+	for _, a := range overrides {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RunIndexDiagnosticsWithTx", varargs...)
+	ret0, _ := ret[0].(int32)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RunIndexDiagnosticsWithTx indicates an expected call of RunIndexDiagnosticsWithTx.
+func (mr *MockTaskRunnerMockRecorder) RunIndexDiagnosticsWithTx(ctx, tx, params any, overrides ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, tx, params}, overrides...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RunIndexDiagnosticsWithTx", reflect.TypeOf((*MockTaskRunner)(nil).RunIndexDiagnosticsWithTx), varargs...)
+}
+
+// RunMigrateBlobPayloads mocks base method.
+func (m *MockTaskRunner) RunMigrateBlobPayloads(ctx context.Context, params *MigrateBlobPayloadsParameters, overrides ...taskcore.TaskOverride) (int32, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, params}
+	for _, a := range overrides {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RunMigrateBlobPayloads", varargs...)
+	ret0, _ := ret[0].(int32)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RunMigrateBlobPayloads indicates an expected call of RunMigrateBlobPayloads.
+func (mr *MockTaskRunnerMockRecorder) RunMigrateBlobPayloads(ctx, params any, overrides ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, params}, overrides...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RunMigrateBlobPayloads", reflect.TypeOf((*MockTaskRunner)(nil).RunMigrateBlobPayloads), varargs...)
+}
+
+// RunMigrateBlobPayloadsWithTx mocks base method.
+func (m *MockTaskRunner) RunMigrateBlobPayloadsWithTx(ctx context.Context, tx pgx.Tx, params *MigrateBlobPayloadsParameters, overrides ...taskcore.TaskOverride) (int32, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, tx, params}
+	for _, a := range overrides {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RunMigrateBlobPayloadsWithTx", varargs...)
+	ret0, _ := ret[0].(int32)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RunMigrateBlobPayloadsWithTx indicates an expected call of RunMigrateBlobPayloadsWithTx.
+func (mr *MockTaskRunnerMockRecorder) RunMigrateBlobPayloadsWithTx(ctx, tx, params any, overrides ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, tx, params}, overrides...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RunMigrateBlobPayloadsWithTx", reflect.TypeOf((*MockTaskRunner)(nil).RunMigrateBlobPayloadsWithTx), varargs...)
+}
+
+// RunPruneAlertHistory mocks base method.
+func (m *MockTaskRunner) RunPruneAlertHistory(ctx context.Context, params *PruneAlertHistoryParameters, overrides ...taskcore.TaskOverride) (int32, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, params}
+	for _, a := range overrides {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RunPruneAlertHistory", varargs...)
+	ret0, _ := ret[0].(int32)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RunPruneAlertHistory indicates an expected call of RunPruneAlertHistory.
+func (mr *MockTaskRunnerMockRecorder) RunPruneAlertHistory(ctx, params any, overrides ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, params}, overrides...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RunPruneAlertHistory", reflect.TypeOf((*MockTaskRunner)(nil).RunPruneAlertHistory), varargs...)
+}
+
+// RunPruneAlertHistoryWithTx mocks base method.
+func (m *MockTaskRunner) RunPruneAlertHistoryWithTx(ctx context.Context, tx pgx.Tx, params *PruneAlertHistoryParameters, overrides ...taskcore.TaskOverride) (int32, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, tx, params}
+	for _, a := range overrides {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RunPruneAlertHistoryWithTx", varargs...)
+	ret0, _ := ret[0].(int32)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RunPruneAlertHistoryWithTx indicates an expected call of RunPruneAlertHistoryWithTx.
+func (mr *MockTaskRunnerMockRecorder) RunPruneAlertHistoryWithTx(ctx, tx, params any, overrides ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, tx, params}, overrides...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RunPruneAlertHistoryWithTx", reflect.TypeOf((*MockTaskRunner)(nil).RunPruneAlertHistoryWithTx), varargs...)
+}
+
+// RunPruneClusterEvents mocks base method.
+func (m *MockTaskRunner) RunPruneClusterEvents(ctx context.Context, params *PruneClusterEventsParameters, overrides ...taskcore.TaskOverride) (int32, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, params}
+	for _, a := range overrides {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RunPruneClusterEvents", varargs...)
 	ret0, _ := ret[0].(int32)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// RunAutoBackup indicates an expected call of RunAutoBackup.
-func (mr *MockTaskRunnerMockRecorder) RunAutoBackup(ctx, params any, overrides ...any) *gomock.Call {
+// RunPruneClusterEvents indicates an expected call of RunPruneClusterEvents.
+func (mr *MockTaskRunnerMockRecorder) RunPruneClusterEvents(ctx, params any, overrides ...any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	varargs := append([]any{ctx, params}, overrides...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RunAutoBackup", reflect.TypeOf((*MockTaskRunner)(nil).RunAutoBackup), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RunPruneClusterEvents", reflect.TypeOf((*MockTaskRunner)(nil).RunPruneClusterEvents), varargs...)
 }
 
-// RunAutoBackupWithTx mocks base method.
-func (m *MockTaskRunner) RunAutoBackupWithTx(ctx context.Context, tx pgx.Tx, params *AutoBackupParameters, overrides ...taskcore.TaskOverride) (int32, error) {
+// RunPruneClusterEventsWithTx mocks base method.
+func (m *MockTaskRunner) RunPruneClusterEventsWithTx(ctx context.Context, tx pgx.Tx, params *PruneClusterEventsParameters, overrides ...taskcore.TaskOverride) (int32, error) {
 	m.ctrl.T.Helper()
 	varargs := []any{ctx, tx, params}
 	for _, a := range overrides {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "RunAutoBackupWithTx", varargs...)
+	ret := m.ctrl.Call(m, "RunPruneClusterEventsWithTx", varargs...)
 	ret0, _ := ret[0].(int32)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// RunAutoBackupWithTx indicates an expected call of RunAutoBackupWithTx.
-func (mr *MockTaskRunnerMockRecorder) RunAutoBackupWithTx(ctx, tx, params any, overrides ...any) *gomock.Call {
+// RunPruneClusterEventsWithTx indicates an expected call of RunPruneClusterEventsWithTx.
+func (mr *MockTaskRunnerMockRecorder) RunPruneClusterEventsWithTx(ctx, tx, params any, overrides ...any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	varargs := append([]any{ctx, tx, params}, overrides...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RunAutoBackupWithTx", reflect.TypeOf((*MockTaskRunner)(nil).RunAutoBackupWithTx), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RunPruneClusterEventsWithTx", reflect.TypeOf((*MockTaskRunner)(nil).RunPruneClusterEventsWithTx), varargs...)
 }
 
-// RunAutoDiagnostic mocks base method.
-func (m *MockTaskRunner) RunAutoDiagnostic(ctx context.Context, params *AutoDiagnosticParameters, overrides ...taskcore.TaskOverride) (int32, error) {
+// RunPruneClusterHealthRecords mocks base method.
+func (m *MockTaskRunner) RunPruneClusterHealthRecords(ctx context.Context, params *PruneClusterHealthRecordsParameters, overrides ...taskcore.TaskOverride) (int32, error) {
 	m.ctrl.T.Helper()
 	varargs := []any{ctx, params}
 	for _, a := range overrides {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "RunAutoDiagnostic", varargs...)
+	ret := m.ctrl.Call(m, "RunPruneClusterHealthRecords", varargs...)
 	ret0, _ := ret[0].(int32)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// RunAutoDiagnostic indicates an expected call of RunAutoDiagnostic.
-func (mr *MockTaskRunnerMockRecorder) RunAutoDiagnostic(ctx, params any, overrides ...any) *gomock.Call {
+// RunPruneClusterHealthRecords indicates an expected call of RunPruneClusterHealthRecords.
+func (mr *MockTaskRunnerMockRecorder) RunPruneClusterHealthRecords(ctx, params any, overrides ...any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	varargs := append([]any{ctx, params}, overrides...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RunAutoDiagnostic", reflect.TypeOf((*MockTaskRunner)(nil).RunAutoDiagnostic), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RunPruneClusterHealthRecords", reflect.TypeOf((*MockTaskRunner)(nil).RunPruneClusterHealthRecords), varargs...)
 }
 
-// RunAutoDiagnosticWithTx mocks base method.
-func (m *MockTaskRunner) RunAutoDiagnosticWithTx(ctx context.Context, tx pgx.Tx, params *AutoDiagnosticParameters, overrides ...taskcore.TaskOverride) (int32, error) {
+// RunPruneClusterHealthRecordsWithTx mocks base method.
+func (m *MockTaskRunner) RunPruneClusterHealthRecordsWithTx(ctx context.Context, tx pgx.Tx, params *PruneClusterHealthRecordsParameters, overrides ...taskcore.TaskOverride) (int32, error) {
 	m.ctrl.T.Helper()
 	varargs := []any{ctx, tx, params}
 	for _, a := range overrides {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "RunAutoDiagnosticWithTx", varargs...)
+	ret := m.ctrl.Call(m, "RunPruneClusterHealthRecordsWithTx", varargs...)
 	ret0, _ := ret[0].(int32)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// RunAutoDiagnosticWithTx indicates an expected call of RunAutoDiagnosticWithTx.
-func (mr *MockTaskRunnerMockRecorder) RunAutoDiagnosticWithTx(ctx, tx, params any, overrides ...any) *gomock.Call {
+// RunPruneClusterHealthRecordsWithTx indicates an expected call of RunPruneClusterHealthRecordsWithTx.
+func (mr *MockTaskRunnerMockRecorder) RunPruneClusterHealthRecordsWithTx(ctx, tx, params any, overrides ...any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	varargs := append([]any{ctx, tx, params}, overrides...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RunAutoDiagnosticWithTx", reflect.TypeOf((*MockTaskRunner)(nil).RunAutoDiagnosticWithTx), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RunPruneClusterHealthRecordsWithTx", reflect.TypeOf((*MockTaskRunner)(nil).RunPruneClusterHealthRecordsWithTx), varargs...)
 }
 
-// RunDeleteClusterDiagnostic mocks base method.
-func (m *MockTaskRunner) RunDeleteClusterDiagnostic(ctx context.Context, params *DeleteClusterDiagnosticParameters, overrides ...taskcore.TaskOverride) (int32, error) {
+// RunPruneDiagnosticBundles mocks base method.
+func (m *MockTaskRunner) RunPruneDiagnosticBundles(ctx context.Context, params *PruneDiagnosticBundlesParameters, overrides ...taskcore.TaskOverride) (int32, error) {
 	m.ctrl.T.Helper()
 	varargs := []any{ctx, params}
 	for _, a := range overrides {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "RunDeleteClusterDiagnostic", varargs...)
+	ret := m.ctrl.Call(m, "RunPruneDiagnosticBundles", varargs...)
 	ret0, _ := ret[0].(int32)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// RunDeleteClusterDiagnostic indicates an expected call of RunDeleteClusterDiagnostic.
-func (mr *MockTaskRunnerMockRecorder) RunDeleteClusterDiagnostic(ctx, params any, overrides ...any) *gomock.Call {
+// RunPruneDiagnosticBundles indicates an expected call of RunPruneDiagnosticBundles.
+func (mr *MockTaskRunnerMockRecorder) RunPruneDiagnosticBundles(ctx, params any, overrides ...any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	varargs := append([]any{ctx, params}, overrides...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RunDeleteClusterDiagnostic", reflect.TypeOf((*MockTaskRunner)(nil).RunDeleteClusterDiagnostic), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RunPruneDiagnosticBundles", reflect.TypeOf((*MockTaskRunner)(nil).RunPruneDiagnosticBundles), varargs...)
 }
 
-// RunDeleteClusterDiagnosticWithTx mocks base method.
-func (m *MockTaskRunner) RunDeleteClusterDiagnosticWithTx(ctx context.Context, tx pgx.Tx, params *DeleteClusterDiagnosticParameters, overrides ...taskcore.TaskOverride) (int32, error) {
+// RunPruneDiagnosticBundlesWithTx mocks base method.
+func (m *MockTaskRunner) RunPruneDiagnosticBundlesWithTx(ctx context.Context, tx pgx.Tx, params *PruneDiagnosticBundlesParameters, overrides ...taskcore.TaskOverride) (int32, error) {
 	m.ctrl.T.Helper()
 	varargs := []any{ctx, tx, params}
 	for _, a := range overrides {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "RunDeleteClusterDiagnosticWithTx", varargs...)
+	ret := m.ctrl.Call(m, "RunPruneDiagnosticBundlesWithTx", varargs...)
 	ret0, _ := ret[0].(int32)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// RunDeleteClusterDiagnosticWithTx indicates an expected call of RunDeleteClusterDiagnosticWithTx.
-func (mr *MockTaskRunnerMockRecorder) RunDeleteClusterDiagnosticWithTx(ctx, tx, params any, overrides ...any) *gomock.Call {
+// RunPruneDiagnosticBundlesWithTx indicates an expected call of RunPruneDiagnosticBundlesWithTx.
+func (mr *MockTaskRunnerMockRecorder) RunPruneDiagnosticBundlesWithTx(ctx, tx, params any, overrides ...any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	varargs := append([]any{ctx, tx, params}, overrides...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RunDeleteClusterDiagnosticWithTx", reflect.TypeOf((*MockTaskRunner)(nil).RunDeleteClusterDiagnosticWithTx), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RunPruneDiagnosticBundlesWithTx", reflect.TypeOf((*MockTaskRunner)(nil).RunPruneDiagnosticBundlesWithTx), varargs...)
 }
 
-// RunDeleteSnapshot mocks base method.
-func (m *MockTaskRunner) RunDeleteSnapshot(ctx context.Context, params *DeleteSnapshotParameters, overrides ...taskcore.TaskOverride) (int32, error) {
+// RunPruneRisectlExecutions mocks base method.
+func (m *MockTaskRunner) RunPruneRisectlExecutions(ctx context.Context, params *PruneRisectlExecutionsParameters, overrides ...taskcore.TaskOverride) (int32, error) {
 	m.ctrl.T.Helper()
 	varargs := []any{ctx, params}
 	for _, a := range overrides {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "RunDeleteSnapshot", varargs...)
+	ret := m.ctrl.Call(m, "RunPruneRisectlExecutions", varargs...)
 	ret0, _ := ret[0].(int32)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// RunDeleteSnapshot indicates an expected call of RunDeleteSnapshot.
-func (mr *MockTaskRunnerMockRecorder) RunDeleteSnapshot(ctx, params any, overrides ...any) *gomock.Call {
+// RunPruneRisectlExecutions indicates an expected call of RunPruneRisectlExecutions.
+func (mr *MockTaskRunnerMockRecorder) RunPruneRisectlExecutions(ctx, params any, overrides ...any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	varargs := append([]any{ctx, params}, overrides...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RunDeleteSnapshot", reflect.TypeOf((*MockTaskRunner)(nil).RunDeleteSnapshot), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RunPruneRisectlExecutions", reflect.TypeOf((*MockTaskRunner)(nil).RunPruneRisectlExecutions), varargs...)
 }
 
-// RunDeleteSnapshotWithTx mocks base method.
-func (m *MockTaskRunner) RunDeleteSnapshotWithTx(ctx context.Context, tx pgx.Tx, params *DeleteSnapshotParameters, overrides ...taskcore.TaskOverride) (int32, error) {
+// RunPruneRisectlExecutionsWithTx mocks base method.
+func (m *MockTaskRunner) RunPruneRisectlExecutionsWithTx(ctx context.Context, tx pgx.Tx, params *PruneRisectlExecutionsParameters, overrides ...taskcore.TaskOverride) (int32, error) {
 	m.ctrl.T.Helper()
 	varargs := []any{ctx, tx, params}
 	for _, a := range overrides {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "RunDeleteSnapshotWithTx", varargs...)
+	ret := m.ctrl.Call(m, "RunPruneRisectlExecutionsWithTx", varargs...)
 	ret0, _ := ret[0].(int32)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// RunDeleteSnapshotWithTx indicates an expected call of RunDeleteSnapshotWithTx.
-func (mr *MockTaskRunnerMockRecorder) RunDeleteSnapshotWithTx(ctx, tx, params any, overrides ...any) *gomock.Call {
+// RunPruneRisectlExecutionsWithTx indicates an expected call of RunPruneRisectlExecutionsWithTx.
+func (mr *MockTaskRunnerMockRecorder) RunPruneRisectlExecutionsWithTx(ctx, tx, params any, overrides ...any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	varargs := append([]any{ctx, tx, params}, overrides...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RunDeleteSnapshotWithTx", reflect.TypeOf((*MockTaskRunner)(nil).RunDeleteSnapshotWithTx), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RunPruneRisectlExecutionsWithTx", reflect.TypeOf((*MockTaskRunner)(nil).RunPruneRisectlExecutionsWithTx), varargs...)
+}
+
+// RunReconcileSnapshots mocks base method.
+func (m *MockTaskRunner) RunReconcileSnapshots(ctx context.Context, params *ReconcileSnapshotsParameters, overrides ...taskcore.TaskOverride) (int32, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, params}
+	for _, a := range overrides {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RunReconcileSnapshots", varargs...)
+	ret0, _ := ret[0].(int32)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RunReconcileSnapshots indicates an expected call of RunReconcileSnapshots.
+func (mr *MockTaskRunnerMockRecorder) RunReconcileSnapshots(ctx, params any, overrides ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, params}, overrides...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RunReconcileSnapshots", reflect.TypeOf((*MockTaskRunner)(nil).RunReconcileSnapshots), varargs...)
+}
+
+// RunReconcileSnapshotsWithTx mocks base method.
+func (m *MockTaskRunner) RunReconcileSnapshotsWithTx(ctx context.Context, tx pgx.Tx, params *ReconcileSnapshotsParameters, overrides ...taskcore.TaskOverride) (int32, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, tx, params}
+	for _, a := range overrides {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RunReconcileSnapshotsWithTx", varargs...)
+	ret0, _ := ret[0].(int32)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RunReconcileSnapshotsWithTx indicates an expected call of RunReconcileSnapshotsWithTx.
+func (mr *MockTaskRunnerMockRecorder) RunReconcileSnapshotsWithTx(ctx, tx, params any, overrides ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, tx, params}, overrides...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RunReconcileSnapshotsWithTx", reflect.TypeOf((*MockTaskRunner)(nil).RunReconcileSnapshotsWithTx), varargs...)
+}
+
+// RunRisectlExecution mocks base method.
+func (m *MockTaskRunner) RunRisectlExecution(ctx context.Context, params *RisectlExecutionParameters, overrides ...taskcore.TaskOverride) (int32, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, params}
+	for _, a := range overrides {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RunRisectlExecution", varargs...)
+	ret0, _ := ret[0].(int32)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RunRisectlExecution indicates an expected call of RunRisectlExecution.
+func (mr *MockTaskRunnerMockRecorder) RunRisectlExecution(ctx, params any, overrides ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, params}, overrides...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RunRisectlExecution", reflect.TypeOf((*MockTaskRunner)(nil).RunRisectlExecution), varargs...)
+}
+
+// RunRisectlExecutionWithTx mocks base method.
+func (m *MockTaskRunner) RunRisectlExecutionWithTx(ctx context.Context, tx pgx.Tx, params *RisectlExecutionParameters, overrides ...taskcore.TaskOverride) (int32, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, tx, params}
+	for _, a := range overrides {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RunRisectlExecutionWithTx", varargs...)
+	ret0, _ := ret[0].(int32)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RunRisectlExecutionWithTx indicates an expected call of RunRisectlExecutionWithTx.
+func (mr *MockTaskRunnerMockRecorder) RunRisectlExecutionWithTx(ctx, tx, params any, overrides ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, tx, params}, overrides...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RunRisectlExecutionWithTx", reflect.TypeOf((*MockTaskRunner)(nil).RunRisectlExecutionWithTx), varargs...)
 }
 
 // MockExecutorInterface is a mock of ExecutorInterface interface.
@@ -226,6 +946,20 @@ func (m *MockExecutorInterface) EXPECT() *MockExecutorInterfaceMockRecorder {
 	return m.recorder
 }
 
+// ExecuteApplySnapshotRetention mocks base method.
+func (m *MockExecutorInterface) ExecuteApplySnapshotRetention(ctx context.Context, params *ApplySnapshotRetentionParameters) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExecuteApplySnapshotRetention", ctx, params)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ExecuteApplySnapshotRetention indicates an expected call of ExecuteApplySnapshotRetention.
+func (mr *MockExecutorInterfaceMockRecorder) ExecuteApplySnapshotRetention(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExecuteApplySnapshotRetention", reflect.TypeOf((*MockExecutorInterface)(nil).ExecuteApplySnapshotRetention), ctx, params)
+}
+
 // ExecuteAutoBackup mocks base method.
 func (m *MockExecutorInterface) ExecuteAutoBackup(ctx context.Context, params *AutoBackupParameters) error {
 	m.ctrl.T.Helper()
@@ -254,6 +988,62 @@ func (mr *MockExecutorInterfaceMockRecorder) ExecuteAutoDiagnostic(ctx, params a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExecuteAutoDiagnostic", reflect.TypeOf((*MockExecutorInterface)(nil).ExecuteAutoDiagnostic), ctx, params)
 }
 
+// ExecuteCatalogSnapshot mocks base method.
+func (m *MockExecutorInterface) ExecuteCatalogSnapshot(ctx context.Context, params *CatalogSnapshotParameters) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExecuteCatalogSnapshot", ctx, params)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ExecuteCatalogSnapshot indicates an expected call of ExecuteCatalogSnapshot.
+func (mr *MockExecutorInterfaceMockRecorder) ExecuteCatalogSnapshot(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExecuteCatalogSnapshot", reflect.TypeOf((*MockExecutorInterface)(nil).ExecuteCatalogSnapshot), ctx, params)
+}
+
+// ExecuteCheckClusterHealth mocks base method.
+func (m *MockExecutorInterface) ExecuteCheckClusterHealth(ctx context.Context, params *CheckClusterHealthParameters) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExecuteCheckClusterHealth", ctx, params)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ExecuteCheckClusterHealth indicates an expected call of ExecuteCheckClusterHealth.
+func (mr *MockExecutorInterfaceMockRecorder) ExecuteCheckClusterHealth(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExecuteCheckClusterHealth", reflect.TypeOf((*MockExecutorInterface)(nil).ExecuteCheckClusterHealth), ctx, params)
+}
+
+// ExecuteClusterUpgrade mocks base method.
+func (m *MockExecutorInterface) ExecuteClusterUpgrade(ctx context.Context, params *ClusterUpgradeParameters) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExecuteClusterUpgrade", ctx, params)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ExecuteClusterUpgrade indicates an expected call of ExecuteClusterUpgrade.
+func (mr *MockExecutorInterfaceMockRecorder) ExecuteClusterUpgrade(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExecuteClusterUpgrade", reflect.TypeOf((*MockExecutorInterface)(nil).ExecuteClusterUpgrade), ctx, params)
+}
+
+// ExecuteDeleteClusterBlobs mocks base method.
+func (m *MockExecutorInterface) ExecuteDeleteClusterBlobs(ctx context.Context, params *DeleteClusterBlobsParameters) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExecuteDeleteClusterBlobs", ctx, params)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ExecuteDeleteClusterBlobs indicates an expected call of ExecuteDeleteClusterBlobs.
+func (mr *MockExecutorInterfaceMockRecorder) ExecuteDeleteClusterBlobs(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExecuteDeleteClusterBlobs", reflect.TypeOf((*MockExecutorInterface)(nil).ExecuteDeleteClusterBlobs), ctx, params)
+}
+
 // ExecuteDeleteClusterDiagnostic mocks base method.
 func (m *MockExecutorInterface) ExecuteDeleteClusterDiagnostic(ctx context.Context, params *DeleteClusterDiagnosticParameters) error {
 	m.ctrl.T.Helper()
@@ -281,3 +1071,185 @@ func (mr *MockExecutorInterfaceMockRecorder) ExecuteDeleteSnapshot(ctx, params a
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExecuteDeleteSnapshot", reflect.TypeOf((*MockExecutorInterface)(nil).ExecuteDeleteSnapshot), ctx, params)
 }
+
+// ExecuteDetectClusterVersion mocks base method.
+func (m *MockExecutorInterface) ExecuteDetectClusterVersion(ctx context.Context, params *DetectClusterVersionParameters) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExecuteDetectClusterVersion", ctx, params)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ExecuteDetectClusterVersion indicates an expected call of ExecuteDetectClusterVersion.
+func (mr *MockExecutorInterfaceMockRecorder) ExecuteDetectClusterVersion(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExecuteDetectClusterVersion", reflect.TypeOf((*MockExecutorInterface)(nil).ExecuteDetectClusterVersion), ctx, params)
+}
+
+// ExecuteDetectClusterVersions mocks base method.
+func (m *MockExecutorInterface) ExecuteDetectClusterVersions(ctx context.Context, params *DetectClusterVersionsParameters) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExecuteDetectClusterVersions", ctx, params)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ExecuteDetectClusterVersions indicates an expected call of ExecuteDetectClusterVersions.
+func (mr *MockExecutorInterfaceMockRecorder) ExecuteDetectClusterVersions(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExecuteDetectClusterVersions", reflect.TypeOf((*MockExecutorInterface)(nil).ExecuteDetectClusterVersions), ctx, params)
+}
+
+// ExecuteDiagnosticBundle mocks base method.
+func (m *MockExecutorInterface) ExecuteDiagnosticBundle(ctx context.Context, params *DiagnosticBundleParameters) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExecuteDiagnosticBundle", ctx, params)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ExecuteDiagnosticBundle indicates an expected call of ExecuteDiagnosticBundle.
+func (mr *MockExecutorInterfaceMockRecorder) ExecuteDiagnosticBundle(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExecuteDiagnosticBundle", reflect.TypeOf((*MockExecutorInterface)(nil).ExecuteDiagnosticBundle), ctx, params)
+}
+
+// ExecuteEvaluateAlertRules mocks base method.
+func (m *MockExecutorInterface) ExecuteEvaluateAlertRules(ctx context.Context, params *EvaluateAlertRulesParameters) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExecuteEvaluateAlertRules", ctx, params)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ExecuteEvaluateAlertRules indicates an expected call of ExecuteEvaluateAlertRules.
+func (mr *MockExecutorInterfaceMockRecorder) ExecuteEvaluateAlertRules(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExecuteEvaluateAlertRules", reflect.TypeOf((*MockExecutorInterface)(nil).ExecuteEvaluateAlertRules), ctx, params)
+}
+
+// ExecuteIndexDiagnostics mocks base method.
+func (m *MockExecutorInterface) ExecuteIndexDiagnostics(ctx context.Context, params *IndexDiagnosticsParameters) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExecuteIndexDiagnostics", ctx, params)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ExecuteIndexDiagnostics indicates an expected call of ExecuteIndexDiagnostics.
+func (mr *MockExecutorInterfaceMockRecorder) ExecuteIndexDiagnostics(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExecuteIndexDiagnostics", reflect.TypeOf((*MockExecutorInterface)(nil).ExecuteIndexDiagnostics), ctx, params)
+}
+
+// ExecuteMigrateBlobPayloads mocks base method.
+func (m *MockExecutorInterface) ExecuteMigrateBlobPayloads(ctx context.Context, params *MigrateBlobPayloadsParameters) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExecuteMigrateBlobPayloads", ctx, params)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ExecuteMigrateBlobPayloads indicates an expected call of ExecuteMigrateBlobPayloads.
+func (mr *MockExecutorInterfaceMockRecorder) ExecuteMigrateBlobPayloads(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExecuteMigrateBlobPayloads", reflect.TypeOf((*MockExecutorInterface)(nil).ExecuteMigrateBlobPayloads), ctx, params)
+}
+
+// ExecutePruneAlertHistory mocks base method.
+func (m *MockExecutorInterface) ExecutePruneAlertHistory(ctx context.Context, params *PruneAlertHistoryParameters) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExecutePruneAlertHistory", ctx, params)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ExecutePruneAlertHistory indicates an expected call of ExecutePruneAlertHistory.
+func (mr *MockExecutorInterfaceMockRecorder) ExecutePruneAlertHistory(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExecutePruneAlertHistory", reflect.TypeOf((*MockExecutorInterface)(nil).ExecutePruneAlertHistory), ctx, params)
+}
+
+// ExecutePruneClusterEvents mocks base method.
+func (m *MockExecutorInterface) ExecutePruneClusterEvents(ctx context.Context, params *PruneClusterEventsParameters) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExecutePruneClusterEvents", ctx, params)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ExecutePruneClusterEvents indicates an expected call of ExecutePruneClusterEvents.
+func (mr *MockExecutorInterfaceMockRecorder) ExecutePruneClusterEvents(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExecutePruneClusterEvents", reflect.TypeOf((*MockExecutorInterface)(nil).ExecutePruneClusterEvents), ctx, params)
+}
+
+// ExecutePruneClusterHealthRecords mocks base method.
+func (m *MockExecutorInterface) ExecutePruneClusterHealthRecords(ctx context.Context, params *PruneClusterHealthRecordsParameters) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExecutePruneClusterHealthRecords", ctx, params)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ExecutePruneClusterHealthRecords indicates an expected call of ExecutePruneClusterHealthRecords.
+func (mr *MockExecutorInterfaceMockRecorder) ExecutePruneClusterHealthRecords(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExecutePruneClusterHealthRecords", reflect.TypeOf((*MockExecutorInterface)(nil).ExecutePruneClusterHealthRecords), ctx, params)
+}
+
+// ExecutePruneDiagnosticBundles mocks base method.
+func (m *MockExecutorInterface) ExecutePruneDiagnosticBundles(ctx context.Context, params *PruneDiagnosticBundlesParameters) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExecutePruneDiagnosticBundles", ctx, params)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ExecutePruneDiagnosticBundles indicates an expected call of ExecutePruneDiagnosticBundles.
+func (mr *MockExecutorInterfaceMockRecorder) ExecutePruneDiagnosticBundles(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExecutePruneDiagnosticBundles", reflect.TypeOf((*MockExecutorInterface)(nil).ExecutePruneDiagnosticBundles), ctx, params)
+}
+
+// ExecutePruneRisectlExecutions mocks base method.
+func (m *MockExecutorInterface) ExecutePruneRisectlExecutions(ctx context.Context, params *PruneRisectlExecutionsParameters) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExecutePruneRisectlExecutions", ctx, params)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ExecutePruneRisectlExecutions indicates an expected call of ExecutePruneRisectlExecutions.
+func (mr *MockExecutorInterfaceMockRecorder) ExecutePruneRisectlExecutions(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExecutePruneRisectlExecutions", reflect.TypeOf((*MockExecutorInterface)(nil).ExecutePruneRisectlExecutions), ctx, params)
+}
+
+// ExecuteReconcileSnapshots mocks base method.
+func (m *MockExecutorInterface) ExecuteReconcileSnapshots(ctx context.Context, params *ReconcileSnapshotsParameters) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExecuteReconcileSnapshots", ctx, params)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ExecuteReconcileSnapshots indicates an expected call of ExecuteReconcileSnapshots.
+func (mr *MockExecutorInterfaceMockRecorder) ExecuteReconcileSnapshots(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExecuteReconcileSnapshots", reflect.TypeOf((*MockExecutorInterface)(nil).ExecuteReconcileSnapshots), ctx, params)
+}
+
+// ExecuteRisectlExecution mocks base method.
+func (m *MockExecutorInterface) ExecuteRisectlExecution(ctx context.Context, params *RisectlExecutionParameters) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExecuteRisectlExecution", ctx, params)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ExecuteRisectlExecution indicates an expected call of ExecuteRisectlExecution.
+func (mr *MockExecutorInterfaceMockRecorder) ExecuteRisectlExecution(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExecuteRisectlExecution", reflect.TypeOf((*MockExecutorInterface)(nil).ExecuteRisectlExecution), ctx, params)
+}
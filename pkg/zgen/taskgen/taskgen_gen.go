@@ -27,6 +27,42 @@ const (
 	DeleteClusterDiagnostic = "DeleteClusterDiagnostic" 
 
 	DeleteSnapshot = "DeleteSnapshot" 
+
+	CatalogSnapshot = "CatalogSnapshot" 
+
+	RisectlExecution = "RisectlExecution" 
+
+	ReconcileSnapshots = "ReconcileSnapshots" 
+
+	ApplySnapshotRetention = "ApplySnapshotRetention" 
+
+	ClusterUpgrade = "ClusterUpgrade" 
+
+	DetectClusterVersion = "DetectClusterVersion" 
+
+	DetectClusterVersions = "DetectClusterVersions" 
+
+	CheckClusterHealth = "CheckClusterHealth" 
+
+	PruneClusterHealthRecords = "PruneClusterHealthRecords" 
+
+	PruneRisectlExecutions = "PruneRisectlExecutions" 
+
+	DiagnosticBundle = "DiagnosticBundle" 
+
+	PruneDiagnosticBundles = "PruneDiagnosticBundles" 
+
+	PruneAlertHistory = "PruneAlertHistory" 
+
+	PruneClusterEvents = "PruneClusterEvents" 
+
+	DeleteClusterBlobs = "DeleteClusterBlobs" 
+
+	MigrateBlobPayloads = "MigrateBlobPayloads" 
+
+	IndexDiagnostics = "IndexDiagnostics" 
+
+	EvaluateAlertRules = "EvaluateAlertRules" 
 )
 
 type TaskRunner interface { 
@@ -49,6 +85,96 @@ type TaskRunner interface {
 	RunDeleteSnapshot(ctx context.Context, params *DeleteSnapshotParameters, overrides ...taskcore.TaskOverride) (int32, error)
     // Delete snapshot
 	RunDeleteSnapshotWithTx(ctx context.Context, tx pgx.Tx, params *DeleteSnapshotParameters, overrides ...taskcore.TaskOverride) (int32, error)
+
+    // Snapshot the catalog of every database and record the changes since the previous snapshot
+	RunCatalogSnapshot(ctx context.Context, params *CatalogSnapshotParameters, overrides ...taskcore.TaskOverride) (int32, error)
+    // Snapshot the catalog of every database and record the changes since the previous snapshot
+	RunCatalogSnapshotWithTx(ctx context.Context, tx pgx.Tx, params *CatalogSnapshotParameters, overrides ...taskcore.TaskOverride) (int32, error)
+
+    // Run a risectl command and persist its output as it is produced
+	RunRisectlExecution(ctx context.Context, params *RisectlExecutionParameters, overrides ...taskcore.TaskOverride) (int32, error)
+    // Run a risectl command and persist its output as it is produced
+	RunRisectlExecutionWithTx(ctx context.Context, tx pgx.Tx, params *RisectlExecutionParameters, overrides ...taskcore.TaskOverride) (int32, error)
+
+    // Sync the recorded snapshots of every cluster with the meta snapshots of the cluster
+	RunReconcileSnapshots(ctx context.Context, params *ReconcileSnapshotsParameters, overrides ...taskcore.TaskOverride) (int32, error)
+    // Sync the recorded snapshots of every cluster with the meta snapshots of the cluster
+	RunReconcileSnapshotsWithTx(ctx context.Context, tx pgx.Tx, params *ReconcileSnapshotsParameters, overrides ...taskcore.TaskOverride) (int32, error)
+
+    // Delete the automatic snapshots of a cluster pruned by the retention policy of its auto backup config
+	RunApplySnapshotRetention(ctx context.Context, params *ApplySnapshotRetentionParameters, overrides ...taskcore.TaskOverride) (int32, error)
+    // Delete the automatic snapshots of a cluster pruned by the retention policy of its auto backup config
+	RunApplySnapshotRetentionWithTx(ctx context.Context, tx pgx.Tx, params *ApplySnapshotRetentionParameters, overrides ...taskcore.TaskOverride) (int32, error)
+
+    // Take a pre-upgrade snapshot, wait for the cluster to report the target version, then update its version and diagnose it
+	RunClusterUpgrade(ctx context.Context, params *ClusterUpgradeParameters, overrides ...taskcore.TaskOverride) (int32, error)
+    // Take a pre-upgrade snapshot, wait for the cluster to report the target version, then update its version and diagnose it
+	RunClusterUpgradeWithTx(ctx context.Context, tx pgx.Tx, params *ClusterUpgradeParameters, overrides ...taskcore.TaskOverride) (int32, error)
+
+    // Detect the version of a cluster through SELECT version() and flag or fix the drift from its recorded version
+	RunDetectClusterVersion(ctx context.Context, params *DetectClusterVersionParameters, overrides ...taskcore.TaskOverride) (int32, error)
+    // Detect the version of a cluster through SELECT version() and flag or fix the drift from its recorded version
+	RunDetectClusterVersionWithTx(ctx context.Context, tx pgx.Tx, params *DetectClusterVersionParameters, overrides ...taskcore.TaskOverride) (int32, error)
+
+    // Detect the versions of every cluster
+	RunDetectClusterVersions(ctx context.Context, params *DetectClusterVersionsParameters, overrides ...taskcore.TaskOverride) (int32, error)
+    // Detect the versions of every cluster
+	RunDetectClusterVersionsWithTx(ctx context.Context, tx pgx.Tx, params *DetectClusterVersionsParameters, overrides ...taskcore.TaskOverride) (int32, error)
+
+    // Check the health of every cluster and record the changes of their status
+	RunCheckClusterHealth(ctx context.Context, params *CheckClusterHealthParameters, overrides ...taskcore.TaskOverride) (int32, error)
+    // Check the health of every cluster and record the changes of their status
+	RunCheckClusterHealthWithTx(ctx context.Context, tx pgx.Tx, params *CheckClusterHealthParameters, overrides ...taskcore.TaskOverride) (int32, error)
+
+    // Delete the cluster health records older than the retention, the latest record of every cluster is kept
+	RunPruneClusterHealthRecords(ctx context.Context, params *PruneClusterHealthRecordsParameters, overrides ...taskcore.TaskOverride) (int32, error)
+    // Delete the cluster health records older than the retention, the latest record of every cluster is kept
+	RunPruneClusterHealthRecordsWithTx(ctx context.Context, tx pgx.Tx, params *PruneClusterHealthRecordsParameters, overrides ...taskcore.TaskOverride) (int32, error)
+
+    // Delete the risectl executions finished before the retention together with their output
+	RunPruneRisectlExecutions(ctx context.Context, params *PruneRisectlExecutionsParameters, overrides ...taskcore.TaskOverride) (int32, error)
+    // Delete the risectl executions finished before the retention together with their output
+	RunPruneRisectlExecutionsWithTx(ctx context.Context, tx pgx.Tx, params *PruneRisectlExecutionsParameters, overrides ...taskcore.TaskOverride) (int32, error)
+
+    // Collect the diagnose output, the risectl outputs, the key metrics, the catalog summary and the config of a cluster into a redacted tar.gz archive
+	RunDiagnosticBundle(ctx context.Context, params *DiagnosticBundleParameters, overrides ...taskcore.TaskOverride) (int32, error)
+    // Collect the diagnose output, the risectl outputs, the key metrics, the catalog summary and the config of a cluster into a redacted tar.gz archive
+	RunDiagnosticBundleWithTx(ctx context.Context, tx pgx.Tx, params *DiagnosticBundleParameters, overrides ...taskcore.TaskOverride) (int32, error)
+
+    // Delete the diagnostic bundles finished before the retention together with their archives
+	RunPruneDiagnosticBundles(ctx context.Context, params *PruneDiagnosticBundlesParameters, overrides ...taskcore.TaskOverride) (int32, error)
+    // Delete the diagnostic bundles finished before the retention together with their archives
+	RunPruneDiagnosticBundlesWithTx(ctx context.Context, tx pgx.Tx, params *PruneDiagnosticBundlesParameters, overrides ...taskcore.TaskOverride) (int32, error)
+
+    // Delete the fired and resolved alerts recorded before the retention
+	RunPruneAlertHistory(ctx context.Context, params *PruneAlertHistoryParameters, overrides ...taskcore.TaskOverride) (int32, error)
+    // Delete the fired and resolved alerts recorded before the retention
+	RunPruneAlertHistoryWithTx(ctx context.Context, tx pgx.Tx, params *PruneAlertHistoryParameters, overrides ...taskcore.TaskOverride) (int32, error)
+
+    // Delete the cluster events recorded before the retention, e.g. the alerts and the diagnostic findings reported
+	RunPruneClusterEvents(ctx context.Context, params *PruneClusterEventsParameters, overrides ...taskcore.TaskOverride) (int32, error)
+    // Delete the cluster events recorded before the retention, e.g. the alerts and the diagnostic findings reported
+	RunPruneClusterEventsWithTx(ctx context.Context, tx pgx.Tx, params *PruneClusterEventsParameters, overrides ...taskcore.TaskOverride) (int32, error)
+
+    // Delete the diagnose reports and the diagnostic bundles of a deleted cluster from the blob store
+	RunDeleteClusterBlobs(ctx context.Context, params *DeleteClusterBlobsParameters, overrides ...taskcore.TaskOverride) (int32, error)
+    // Delete the diagnose reports and the diagnostic bundles of a deleted cluster from the blob store
+	RunDeleteClusterBlobsWithTx(ctx context.Context, tx pgx.Tx, params *DeleteClusterBlobsParameters, overrides ...taskcore.TaskOverride) (int32, error)
+
+    // Move the diagnose reports and the diagnostic bundles stored in postgres before the blob store was introduced to the blob store. It runs once.
+	RunMigrateBlobPayloads(ctx context.Context, params *MigrateBlobPayloadsParameters, overrides ...taskcore.TaskOverride) (int32, error)
+    // Move the diagnose reports and the diagnostic bundles stored in postgres before the blob store was introduced to the blob store. It runs once.
+	RunMigrateBlobPayloadsWithTx(ctx context.Context, tx pgx.Tx, params *MigrateBlobPayloadsParameters, overrides ...taskcore.TaskOverride) (int32, error)
+
+    // Index the diagnose reports without a search vector, e.g. the ones created before the full-text search was introduced or failed to be indexed when they were created
+	RunIndexDiagnostics(ctx context.Context, params *IndexDiagnosticsParameters, overrides ...taskcore.TaskOverride) (int32, error)
+    // Index the diagnose reports without a search vector, e.g. the ones created before the full-text search was introduced or failed to be indexed when they were created
+	RunIndexDiagnosticsWithTx(ctx context.Context, tx pgx.Tx, params *IndexDiagnosticsParameters, overrides ...taskcore.TaskOverride) (int32, error)
+
+    // Evaluate the enabled alert rules against the metrics stores of the clusters and record the alerts fired and resolved
+	RunEvaluateAlertRules(ctx context.Context, params *EvaluateAlertRulesParameters, overrides ...taskcore.TaskOverride) (int32, error)
+    // Evaluate the enabled alert rules against the metrics stores of the clusters and record the alerts fired and resolved
+	RunEvaluateAlertRulesWithTx(ctx context.Context, tx pgx.Tx, params *EvaluateAlertRulesParameters, overrides ...taskcore.TaskOverride) (int32, error)
 }
 
 type Client struct {
@@ -62,32 +188,766 @@ func NewTaskRunner(taskStore taskcore.TaskStoreInterface) TaskRunner {
 		now:       time.Now,
 	}
 }
-
-
-func (c *Client) RunAutoBackup(ctx context.Context, params *AutoBackupParameters, overrides ...taskcore.TaskOverride) (int32, error) {
-	return c.runAutoBackup(ctx, c.taskStore, params, overrides...)
+
+
+func (c *Client) RunAutoBackup(ctx context.Context, params *AutoBackupParameters, overrides ...taskcore.TaskOverride) (int32, error) {
+	return c.runAutoBackup(ctx, c.taskStore, params, overrides...)
+}
+
+func (c *Client) RunAutoBackupWithTx(ctx context.Context, tx pgx.Tx, params *AutoBackupParameters, overrides ...taskcore.TaskOverride) (int32, error) {
+	return c.runAutoBackup(ctx, c.taskStore.WithTx(tx), params, overrides...)
+}
+
+func (c *Client) runAutoBackup(ctx context.Context, taskstore taskcore.TaskStoreInterface, params *AutoBackupParameters, overrides ...taskcore.TaskOverride) (int32, error) {
+	payload, err := params.Marshal()
+	if err != nil {
+		return 0, err
+	}
+
+	spec := apigen.TaskSpec{
+		Type:    AutoBackup,
+		Payload: payload,
+	}
+	attributes := apigen.TaskAttributes{}
+	attributes.Timeout = utils.Ptr("30m")
+	
+	attributes.Cronjob = &apigen.TaskCronjob{
+		CronExpression: "0 */6 * * *",
+	}
+	task := &apigen.Task{
+		Attributes: attributes,
+		Spec:       spec,
+		Status:     apigen.Pending,
+	}
+	
+	for _, override := range overrides {
+		if err := override(task); err != nil {
+			return 0, errors.Wrap(err, "failed to apply task override")
+		}
+	}
+	taskID, err := taskstore.PushTask(ctx, task)
+	if err != nil {
+		return 0, err
+	}
+	return taskID, nil
+}
+func (c *Client) RunAutoDiagnostic(ctx context.Context, params *AutoDiagnosticParameters, overrides ...taskcore.TaskOverride) (int32, error) {
+	return c.runAutoDiagnostic(ctx, c.taskStore, params, overrides...)
+}
+
+func (c *Client) RunAutoDiagnosticWithTx(ctx context.Context, tx pgx.Tx, params *AutoDiagnosticParameters, overrides ...taskcore.TaskOverride) (int32, error) {
+	return c.runAutoDiagnostic(ctx, c.taskStore.WithTx(tx), params, overrides...)
+}
+
+func (c *Client) runAutoDiagnostic(ctx context.Context, taskstore taskcore.TaskStoreInterface, params *AutoDiagnosticParameters, overrides ...taskcore.TaskOverride) (int32, error) {
+	payload, err := params.Marshal()
+	if err != nil {
+		return 0, err
+	}
+
+	spec := apigen.TaskSpec{
+		Type:    AutoDiagnostic,
+		Payload: payload,
+	}
+	attributes := apigen.TaskAttributes{}
+	attributes.Timeout = utils.Ptr("30m")
+	
+	attributes.Cronjob = &apigen.TaskCronjob{
+		CronExpression: "0 */6 * * *",
+	}
+	task := &apigen.Task{
+		Attributes: attributes,
+		Spec:       spec,
+		Status:     apigen.Pending,
+	}
+	
+	for _, override := range overrides {
+		if err := override(task); err != nil {
+			return 0, errors.Wrap(err, "failed to apply task override")
+		}
+	}
+	taskID, err := taskstore.PushTask(ctx, task)
+	if err != nil {
+		return 0, err
+	}
+	return taskID, nil
+}
+func (c *Client) RunDeleteClusterDiagnostic(ctx context.Context, params *DeleteClusterDiagnosticParameters, overrides ...taskcore.TaskOverride) (int32, error) {
+	return c.runDeleteClusterDiagnostic(ctx, c.taskStore, params, overrides...)
+}
+
+func (c *Client) RunDeleteClusterDiagnosticWithTx(ctx context.Context, tx pgx.Tx, params *DeleteClusterDiagnosticParameters, overrides ...taskcore.TaskOverride) (int32, error) {
+	return c.runDeleteClusterDiagnostic(ctx, c.taskStore.WithTx(tx), params, overrides...)
+}
+
+func (c *Client) runDeleteClusterDiagnostic(ctx context.Context, taskstore taskcore.TaskStoreInterface, params *DeleteClusterDiagnosticParameters, overrides ...taskcore.TaskOverride) (int32, error) {
+	payload, err := params.Marshal()
+	if err != nil {
+		return 0, err
+	}
+
+	spec := apigen.TaskSpec{
+		Type:    DeleteClusterDiagnostic,
+		Payload: payload,
+	}
+	attributes := apigen.TaskAttributes{}
+	attributes.Timeout = utils.Ptr("30m")
+	attributes.RetryPolicy = &apigen.TaskRetryPolicy{
+		Interval:             "30m",
+		AlwaysRetryOnFailure: true,
+	}
+	
+	task := &apigen.Task{
+		Attributes: attributes,
+		Spec:       spec,
+		Status:     apigen.Pending,
+	}
+	
+	for _, override := range overrides {
+		if err := override(task); err != nil {
+			return 0, errors.Wrap(err, "failed to apply task override")
+		}
+	}
+	taskID, err := taskstore.PushTask(ctx, task)
+	if err != nil {
+		return 0, err
+	}
+	return taskID, nil
+}
+func (c *Client) RunDeleteSnapshot(ctx context.Context, params *DeleteSnapshotParameters, overrides ...taskcore.TaskOverride) (int32, error) {
+	return c.runDeleteSnapshot(ctx, c.taskStore, params, overrides...)
+}
+
+func (c *Client) RunDeleteSnapshotWithTx(ctx context.Context, tx pgx.Tx, params *DeleteSnapshotParameters, overrides ...taskcore.TaskOverride) (int32, error) {
+	return c.runDeleteSnapshot(ctx, c.taskStore.WithTx(tx), params, overrides...)
+}
+
+func (c *Client) runDeleteSnapshot(ctx context.Context, taskstore taskcore.TaskStoreInterface, params *DeleteSnapshotParameters, overrides ...taskcore.TaskOverride) (int32, error) {
+	payload, err := params.Marshal()
+	if err != nil {
+		return 0, err
+	}
+
+	spec := apigen.TaskSpec{
+		Type:    DeleteSnapshot,
+		Payload: payload,
+	}
+	attributes := apigen.TaskAttributes{}
+	attributes.Timeout = utils.Ptr("30m")
+	attributes.RetryPolicy = &apigen.TaskRetryPolicy{
+		Interval:             "30m",
+		AlwaysRetryOnFailure: true,
+	}
+	
+	task := &apigen.Task{
+		Attributes: attributes,
+		Spec:       spec,
+		Status:     apigen.Pending,
+	}
+	
+	for _, override := range overrides {
+		if err := override(task); err != nil {
+			return 0, errors.Wrap(err, "failed to apply task override")
+		}
+	}
+	taskID, err := taskstore.PushTask(ctx, task)
+	if err != nil {
+		return 0, err
+	}
+	return taskID, nil
+}
+func (c *Client) RunCatalogSnapshot(ctx context.Context, params *CatalogSnapshotParameters, overrides ...taskcore.TaskOverride) (int32, error) {
+	return c.runCatalogSnapshot(ctx, c.taskStore, params, overrides...)
+}
+
+func (c *Client) RunCatalogSnapshotWithTx(ctx context.Context, tx pgx.Tx, params *CatalogSnapshotParameters, overrides ...taskcore.TaskOverride) (int32, error) {
+	return c.runCatalogSnapshot(ctx, c.taskStore.WithTx(tx), params, overrides...)
+}
+
+func (c *Client) runCatalogSnapshot(ctx context.Context, taskstore taskcore.TaskStoreInterface, params *CatalogSnapshotParameters, overrides ...taskcore.TaskOverride) (int32, error) {
+	payload, err := params.Marshal()
+	if err != nil {
+		return 0, err
+	}
+
+	spec := apigen.TaskSpec{
+		Type:    CatalogSnapshot,
+		Payload: payload,
+	}
+	attributes := apigen.TaskAttributes{}
+	attributes.Timeout = utils.Ptr("30m")
+	
+	attributes.Cronjob = &apigen.TaskCronjob{
+		CronExpression: "0 */30 * * * *",
+	}
+	task := &apigen.Task{
+		Attributes: attributes,
+		Spec:       spec,
+		Status:     apigen.Pending,
+	}
+	
+	for _, override := range overrides {
+		if err := override(task); err != nil {
+			return 0, errors.Wrap(err, "failed to apply task override")
+		}
+	}
+	taskID, err := taskstore.PushTask(ctx, task)
+	if err != nil {
+		return 0, err
+	}
+	return taskID, nil
+}
+func (c *Client) RunRisectlExecution(ctx context.Context, params *RisectlExecutionParameters, overrides ...taskcore.TaskOverride) (int32, error) {
+	return c.runRisectlExecution(ctx, c.taskStore, params, overrides...)
+}
+
+func (c *Client) RunRisectlExecutionWithTx(ctx context.Context, tx pgx.Tx, params *RisectlExecutionParameters, overrides ...taskcore.TaskOverride) (int32, error) {
+	return c.runRisectlExecution(ctx, c.taskStore.WithTx(tx), params, overrides...)
+}
+
+func (c *Client) runRisectlExecution(ctx context.Context, taskstore taskcore.TaskStoreInterface, params *RisectlExecutionParameters, overrides ...taskcore.TaskOverride) (int32, error) {
+	payload, err := params.Marshal()
+	if err != nil {
+		return 0, err
+	}
+
+	spec := apigen.TaskSpec{
+		Type:    RisectlExecution,
+		Payload: payload,
+	}
+	attributes := apigen.TaskAttributes{}
+	attributes.Timeout = utils.Ptr("1h")
+	
+	
+	task := &apigen.Task{
+		Attributes: attributes,
+		Spec:       spec,
+		Status:     apigen.Pending,
+	}
+	
+	for _, override := range overrides {
+		if err := override(task); err != nil {
+			return 0, errors.Wrap(err, "failed to apply task override")
+		}
+	}
+	taskID, err := taskstore.PushTask(ctx, task)
+	if err != nil {
+		return 0, err
+	}
+	return taskID, nil
+}
+func (c *Client) RunReconcileSnapshots(ctx context.Context, params *ReconcileSnapshotsParameters, overrides ...taskcore.TaskOverride) (int32, error) {
+	return c.runReconcileSnapshots(ctx, c.taskStore, params, overrides...)
+}
+
+func (c *Client) RunReconcileSnapshotsWithTx(ctx context.Context, tx pgx.Tx, params *ReconcileSnapshotsParameters, overrides ...taskcore.TaskOverride) (int32, error) {
+	return c.runReconcileSnapshots(ctx, c.taskStore.WithTx(tx), params, overrides...)
+}
+
+func (c *Client) runReconcileSnapshots(ctx context.Context, taskstore taskcore.TaskStoreInterface, params *ReconcileSnapshotsParameters, overrides ...taskcore.TaskOverride) (int32, error) {
+	payload, err := params.Marshal()
+	if err != nil {
+		return 0, err
+	}
+
+	spec := apigen.TaskSpec{
+		Type:    ReconcileSnapshots,
+		Payload: payload,
+	}
+	attributes := apigen.TaskAttributes{}
+	attributes.Timeout = utils.Ptr("30m")
+	
+	attributes.Cronjob = &apigen.TaskCronjob{
+		CronExpression: "0 */30 * * * *",
+	}
+	task := &apigen.Task{
+		Attributes: attributes,
+		Spec:       spec,
+		Status:     apigen.Pending,
+	}
+	
+	for _, override := range overrides {
+		if err := override(task); err != nil {
+			return 0, errors.Wrap(err, "failed to apply task override")
+		}
+	}
+	taskID, err := taskstore.PushTask(ctx, task)
+	if err != nil {
+		return 0, err
+	}
+	return taskID, nil
+}
+func (c *Client) RunApplySnapshotRetention(ctx context.Context, params *ApplySnapshotRetentionParameters, overrides ...taskcore.TaskOverride) (int32, error) {
+	return c.runApplySnapshotRetention(ctx, c.taskStore, params, overrides...)
+}
+
+func (c *Client) RunApplySnapshotRetentionWithTx(ctx context.Context, tx pgx.Tx, params *ApplySnapshotRetentionParameters, overrides ...taskcore.TaskOverride) (int32, error) {
+	return c.runApplySnapshotRetention(ctx, c.taskStore.WithTx(tx), params, overrides...)
+}
+
+func (c *Client) runApplySnapshotRetention(ctx context.Context, taskstore taskcore.TaskStoreInterface, params *ApplySnapshotRetentionParameters, overrides ...taskcore.TaskOverride) (int32, error) {
+	payload, err := params.Marshal()
+	if err != nil {
+		return 0, err
+	}
+
+	spec := apigen.TaskSpec{
+		Type:    ApplySnapshotRetention,
+		Payload: payload,
+	}
+	attributes := apigen.TaskAttributes{}
+	attributes.Timeout = utils.Ptr("30m")
+	attributes.RetryPolicy = &apigen.TaskRetryPolicy{
+		Interval:             "30m",
+		AlwaysRetryOnFailure: true,
+	}
+	
+	task := &apigen.Task{
+		Attributes: attributes,
+		Spec:       spec,
+		Status:     apigen.Pending,
+	}
+	
+	for _, override := range overrides {
+		if err := override(task); err != nil {
+			return 0, errors.Wrap(err, "failed to apply task override")
+		}
+	}
+	taskID, err := taskstore.PushTask(ctx, task)
+	if err != nil {
+		return 0, err
+	}
+	return taskID, nil
+}
+func (c *Client) RunClusterUpgrade(ctx context.Context, params *ClusterUpgradeParameters, overrides ...taskcore.TaskOverride) (int32, error) {
+	return c.runClusterUpgrade(ctx, c.taskStore, params, overrides...)
+}
+
+func (c *Client) RunClusterUpgradeWithTx(ctx context.Context, tx pgx.Tx, params *ClusterUpgradeParameters, overrides ...taskcore.TaskOverride) (int32, error) {
+	return c.runClusterUpgrade(ctx, c.taskStore.WithTx(tx), params, overrides...)
+}
+
+func (c *Client) runClusterUpgrade(ctx context.Context, taskstore taskcore.TaskStoreInterface, params *ClusterUpgradeParameters, overrides ...taskcore.TaskOverride) (int32, error) {
+	payload, err := params.Marshal()
+	if err != nil {
+		return 0, err
+	}
+
+	spec := apigen.TaskSpec{
+		Type:    ClusterUpgrade,
+		Payload: payload,
+	}
+	attributes := apigen.TaskAttributes{}
+	attributes.Timeout = utils.Ptr("25h")
+	
+	
+	task := &apigen.Task{
+		Attributes: attributes,
+		Spec:       spec,
+		Status:     apigen.Pending,
+	}
+	
+	for _, override := range overrides {
+		if err := override(task); err != nil {
+			return 0, errors.Wrap(err, "failed to apply task override")
+		}
+	}
+	taskID, err := taskstore.PushTask(ctx, task)
+	if err != nil {
+		return 0, err
+	}
+	return taskID, nil
+}
+func (c *Client) RunDetectClusterVersion(ctx context.Context, params *DetectClusterVersionParameters, overrides ...taskcore.TaskOverride) (int32, error) {
+	return c.runDetectClusterVersion(ctx, c.taskStore, params, overrides...)
+}
+
+func (c *Client) RunDetectClusterVersionWithTx(ctx context.Context, tx pgx.Tx, params *DetectClusterVersionParameters, overrides ...taskcore.TaskOverride) (int32, error) {
+	return c.runDetectClusterVersion(ctx, c.taskStore.WithTx(tx), params, overrides...)
+}
+
+func (c *Client) runDetectClusterVersion(ctx context.Context, taskstore taskcore.TaskStoreInterface, params *DetectClusterVersionParameters, overrides ...taskcore.TaskOverride) (int32, error) {
+	payload, err := params.Marshal()
+	if err != nil {
+		return 0, err
+	}
+
+	spec := apigen.TaskSpec{
+		Type:    DetectClusterVersion,
+		Payload: payload,
+	}
+	attributes := apigen.TaskAttributes{}
+	attributes.Timeout = utils.Ptr("5m")
+	
+	
+	task := &apigen.Task{
+		Attributes: attributes,
+		Spec:       spec,
+		Status:     apigen.Pending,
+	}
+	
+	for _, override := range overrides {
+		if err := override(task); err != nil {
+			return 0, errors.Wrap(err, "failed to apply task override")
+		}
+	}
+	taskID, err := taskstore.PushTask(ctx, task)
+	if err != nil {
+		return 0, err
+	}
+	return taskID, nil
+}
+func (c *Client) RunDetectClusterVersions(ctx context.Context, params *DetectClusterVersionsParameters, overrides ...taskcore.TaskOverride) (int32, error) {
+	return c.runDetectClusterVersions(ctx, c.taskStore, params, overrides...)
+}
+
+func (c *Client) RunDetectClusterVersionsWithTx(ctx context.Context, tx pgx.Tx, params *DetectClusterVersionsParameters, overrides ...taskcore.TaskOverride) (int32, error) {
+	return c.runDetectClusterVersions(ctx, c.taskStore.WithTx(tx), params, overrides...)
+}
+
+func (c *Client) runDetectClusterVersions(ctx context.Context, taskstore taskcore.TaskStoreInterface, params *DetectClusterVersionsParameters, overrides ...taskcore.TaskOverride) (int32, error) {
+	payload, err := params.Marshal()
+	if err != nil {
+		return 0, err
+	}
+
+	spec := apigen.TaskSpec{
+		Type:    DetectClusterVersions,
+		Payload: payload,
+	}
+	attributes := apigen.TaskAttributes{}
+	attributes.Timeout = utils.Ptr("30m")
+	
+	attributes.Cronjob = &apigen.TaskCronjob{
+		CronExpression: "0 */10 * * * *",
+	}
+	task := &apigen.Task{
+		Attributes: attributes,
+		Spec:       spec,
+		Status:     apigen.Pending,
+	}
+	
+	for _, override := range overrides {
+		if err := override(task); err != nil {
+			return 0, errors.Wrap(err, "failed to apply task override")
+		}
+	}
+	taskID, err := taskstore.PushTask(ctx, task)
+	if err != nil {
+		return 0, err
+	}
+	return taskID, nil
+}
+func (c *Client) RunCheckClusterHealth(ctx context.Context, params *CheckClusterHealthParameters, overrides ...taskcore.TaskOverride) (int32, error) {
+	return c.runCheckClusterHealth(ctx, c.taskStore, params, overrides...)
+}
+
+func (c *Client) RunCheckClusterHealthWithTx(ctx context.Context, tx pgx.Tx, params *CheckClusterHealthParameters, overrides ...taskcore.TaskOverride) (int32, error) {
+	return c.runCheckClusterHealth(ctx, c.taskStore.WithTx(tx), params, overrides...)
+}
+
+func (c *Client) runCheckClusterHealth(ctx context.Context, taskstore taskcore.TaskStoreInterface, params *CheckClusterHealthParameters, overrides ...taskcore.TaskOverride) (int32, error) {
+	payload, err := params.Marshal()
+	if err != nil {
+		return 0, err
+	}
+
+	spec := apigen.TaskSpec{
+		Type:    CheckClusterHealth,
+		Payload: payload,
+	}
+	attributes := apigen.TaskAttributes{}
+	attributes.Timeout = utils.Ptr("5m")
+	
+	attributes.Cronjob = &apigen.TaskCronjob{
+		CronExpression: "0 * * * * *",
+	}
+	task := &apigen.Task{
+		Attributes: attributes,
+		Spec:       spec,
+		Status:     apigen.Pending,
+	}
+	
+	for _, override := range overrides {
+		if err := override(task); err != nil {
+			return 0, errors.Wrap(err, "failed to apply task override")
+		}
+	}
+	taskID, err := taskstore.PushTask(ctx, task)
+	if err != nil {
+		return 0, err
+	}
+	return taskID, nil
+}
+func (c *Client) RunPruneClusterHealthRecords(ctx context.Context, params *PruneClusterHealthRecordsParameters, overrides ...taskcore.TaskOverride) (int32, error) {
+	return c.runPruneClusterHealthRecords(ctx, c.taskStore, params, overrides...)
+}
+
+func (c *Client) RunPruneClusterHealthRecordsWithTx(ctx context.Context, tx pgx.Tx, params *PruneClusterHealthRecordsParameters, overrides ...taskcore.TaskOverride) (int32, error) {
+	return c.runPruneClusterHealthRecords(ctx, c.taskStore.WithTx(tx), params, overrides...)
+}
+
+func (c *Client) runPruneClusterHealthRecords(ctx context.Context, taskstore taskcore.TaskStoreInterface, params *PruneClusterHealthRecordsParameters, overrides ...taskcore.TaskOverride) (int32, error) {
+	payload, err := params.Marshal()
+	if err != nil {
+		return 0, err
+	}
+
+	spec := apigen.TaskSpec{
+		Type:    PruneClusterHealthRecords,
+		Payload: payload,
+	}
+	attributes := apigen.TaskAttributes{}
+	attributes.Timeout = utils.Ptr("10m")
+	
+	attributes.Cronjob = &apigen.TaskCronjob{
+		CronExpression: "0 0 3 * * *",
+	}
+	task := &apigen.Task{
+		Attributes: attributes,
+		Spec:       spec,
+		Status:     apigen.Pending,
+	}
+	
+	for _, override := range overrides {
+		if err := override(task); err != nil {
+			return 0, errors.Wrap(err, "failed to apply task override")
+		}
+	}
+	taskID, err := taskstore.PushTask(ctx, task)
+	if err != nil {
+		return 0, err
+	}
+	return taskID, nil
+}
+func (c *Client) RunPruneRisectlExecutions(ctx context.Context, params *PruneRisectlExecutionsParameters, overrides ...taskcore.TaskOverride) (int32, error) {
+	return c.runPruneRisectlExecutions(ctx, c.taskStore, params, overrides...)
+}
+
+func (c *Client) RunPruneRisectlExecutionsWithTx(ctx context.Context, tx pgx.Tx, params *PruneRisectlExecutionsParameters, overrides ...taskcore.TaskOverride) (int32, error) {
+	return c.runPruneRisectlExecutions(ctx, c.taskStore.WithTx(tx), params, overrides...)
+}
+
+func (c *Client) runPruneRisectlExecutions(ctx context.Context, taskstore taskcore.TaskStoreInterface, params *PruneRisectlExecutionsParameters, overrides ...taskcore.TaskOverride) (int32, error) {
+	payload, err := params.Marshal()
+	if err != nil {
+		return 0, err
+	}
+
+	spec := apigen.TaskSpec{
+		Type:    PruneRisectlExecutions,
+		Payload: payload,
+	}
+	attributes := apigen.TaskAttributes{}
+	attributes.Timeout = utils.Ptr("30m")
+	
+	attributes.Cronjob = &apigen.TaskCronjob{
+		CronExpression: "0 15 3 * * *",
+	}
+	task := &apigen.Task{
+		Attributes: attributes,
+		Spec:       spec,
+		Status:     apigen.Pending,
+	}
+	
+	for _, override := range overrides {
+		if err := override(task); err != nil {
+			return 0, errors.Wrap(err, "failed to apply task override")
+		}
+	}
+	taskID, err := taskstore.PushTask(ctx, task)
+	if err != nil {
+		return 0, err
+	}
+	return taskID, nil
+}
+func (c *Client) RunDiagnosticBundle(ctx context.Context, params *DiagnosticBundleParameters, overrides ...taskcore.TaskOverride) (int32, error) {
+	return c.runDiagnosticBundle(ctx, c.taskStore, params, overrides...)
+}
+
+func (c *Client) RunDiagnosticBundleWithTx(ctx context.Context, tx pgx.Tx, params *DiagnosticBundleParameters, overrides ...taskcore.TaskOverride) (int32, error) {
+	return c.runDiagnosticBundle(ctx, c.taskStore.WithTx(tx), params, overrides...)
+}
+
+func (c *Client) runDiagnosticBundle(ctx context.Context, taskstore taskcore.TaskStoreInterface, params *DiagnosticBundleParameters, overrides ...taskcore.TaskOverride) (int32, error) {
+	payload, err := params.Marshal()
+	if err != nil {
+		return 0, err
+	}
+
+	spec := apigen.TaskSpec{
+		Type:    DiagnosticBundle,
+		Payload: payload,
+	}
+	attributes := apigen.TaskAttributes{}
+	attributes.Timeout = utils.Ptr("30m")
+	
+	
+	task := &apigen.Task{
+		Attributes: attributes,
+		Spec:       spec,
+		Status:     apigen.Pending,
+	}
+	
+	for _, override := range overrides {
+		if err := override(task); err != nil {
+			return 0, errors.Wrap(err, "failed to apply task override")
+		}
+	}
+	taskID, err := taskstore.PushTask(ctx, task)
+	if err != nil {
+		return 0, err
+	}
+	return taskID, nil
+}
+func (c *Client) RunPruneDiagnosticBundles(ctx context.Context, params *PruneDiagnosticBundlesParameters, overrides ...taskcore.TaskOverride) (int32, error) {
+	return c.runPruneDiagnosticBundles(ctx, c.taskStore, params, overrides...)
+}
+
+func (c *Client) RunPruneDiagnosticBundlesWithTx(ctx context.Context, tx pgx.Tx, params *PruneDiagnosticBundlesParameters, overrides ...taskcore.TaskOverride) (int32, error) {
+	return c.runPruneDiagnosticBundles(ctx, c.taskStore.WithTx(tx), params, overrides...)
+}
+
+func (c *Client) runPruneDiagnosticBundles(ctx context.Context, taskstore taskcore.TaskStoreInterface, params *PruneDiagnosticBundlesParameters, overrides ...taskcore.TaskOverride) (int32, error) {
+	payload, err := params.Marshal()
+	if err != nil {
+		return 0, err
+	}
+
+	spec := apigen.TaskSpec{
+		Type:    PruneDiagnosticBundles,
+		Payload: payload,
+	}
+	attributes := apigen.TaskAttributes{}
+	attributes.Timeout = utils.Ptr("30m")
+	
+	attributes.Cronjob = &apigen.TaskCronjob{
+		CronExpression: "0 30 3 * * *",
+	}
+	task := &apigen.Task{
+		Attributes: attributes,
+		Spec:       spec,
+		Status:     apigen.Pending,
+	}
+	
+	for _, override := range overrides {
+		if err := override(task); err != nil {
+			return 0, errors.Wrap(err, "failed to apply task override")
+		}
+	}
+	taskID, err := taskstore.PushTask(ctx, task)
+	if err != nil {
+		return 0, err
+	}
+	return taskID, nil
+}
+func (c *Client) RunPruneAlertHistory(ctx context.Context, params *PruneAlertHistoryParameters, overrides ...taskcore.TaskOverride) (int32, error) {
+	return c.runPruneAlertHistory(ctx, c.taskStore, params, overrides...)
+}
+
+func (c *Client) RunPruneAlertHistoryWithTx(ctx context.Context, tx pgx.Tx, params *PruneAlertHistoryParameters, overrides ...taskcore.TaskOverride) (int32, error) {
+	return c.runPruneAlertHistory(ctx, c.taskStore.WithTx(tx), params, overrides...)
+}
+
+func (c *Client) runPruneAlertHistory(ctx context.Context, taskstore taskcore.TaskStoreInterface, params *PruneAlertHistoryParameters, overrides ...taskcore.TaskOverride) (int32, error) {
+	payload, err := params.Marshal()
+	if err != nil {
+		return 0, err
+	}
+
+	spec := apigen.TaskSpec{
+		Type:    PruneAlertHistory,
+		Payload: payload,
+	}
+	attributes := apigen.TaskAttributes{}
+	attributes.Timeout = utils.Ptr("10m")
+	
+	attributes.Cronjob = &apigen.TaskCronjob{
+		CronExpression: "0 45 3 * * *",
+	}
+	task := &apigen.Task{
+		Attributes: attributes,
+		Spec:       spec,
+		Status:     apigen.Pending,
+	}
+	
+	for _, override := range overrides {
+		if err := override(task); err != nil {
+			return 0, errors.Wrap(err, "failed to apply task override")
+		}
+	}
+	taskID, err := taskstore.PushTask(ctx, task)
+	if err != nil {
+		return 0, err
+	}
+	return taskID, nil
+}
+func (c *Client) RunPruneClusterEvents(ctx context.Context, params *PruneClusterEventsParameters, overrides ...taskcore.TaskOverride) (int32, error) {
+	return c.runPruneClusterEvents(ctx, c.taskStore, params, overrides...)
+}
+
+func (c *Client) RunPruneClusterEventsWithTx(ctx context.Context, tx pgx.Tx, params *PruneClusterEventsParameters, overrides ...taskcore.TaskOverride) (int32, error) {
+	return c.runPruneClusterEvents(ctx, c.taskStore.WithTx(tx), params, overrides...)
+}
+
+func (c *Client) runPruneClusterEvents(ctx context.Context, taskstore taskcore.TaskStoreInterface, params *PruneClusterEventsParameters, overrides ...taskcore.TaskOverride) (int32, error) {
+	payload, err := params.Marshal()
+	if err != nil {
+		return 0, err
+	}
+
+	spec := apigen.TaskSpec{
+		Type:    PruneClusterEvents,
+		Payload: payload,
+	}
+	attributes := apigen.TaskAttributes{}
+	attributes.Timeout = utils.Ptr("10m")
+	
+	attributes.Cronjob = &apigen.TaskCronjob{
+		CronExpression: "0 0 4 * * *",
+	}
+	task := &apigen.Task{
+		Attributes: attributes,
+		Spec:       spec,
+		Status:     apigen.Pending,
+	}
+	
+	for _, override := range overrides {
+		if err := override(task); err != nil {
+			return 0, errors.Wrap(err, "failed to apply task override")
+		}
+	}
+	taskID, err := taskstore.PushTask(ctx, task)
+	if err != nil {
+		return 0, err
+	}
+	return taskID, nil
+}
+func (c *Client) RunDeleteClusterBlobs(ctx context.Context, params *DeleteClusterBlobsParameters, overrides ...taskcore.TaskOverride) (int32, error) {
+	return c.runDeleteClusterBlobs(ctx, c.taskStore, params, overrides...)
 }
 
-func (c *Client) RunAutoBackupWithTx(ctx context.Context, tx pgx.Tx, params *AutoBackupParameters, overrides ...taskcore.TaskOverride) (int32, error) {
-	return c.runAutoBackup(ctx, c.taskStore.WithTx(tx), params, overrides...)
+func (c *Client) RunDeleteClusterBlobsWithTx(ctx context.Context, tx pgx.Tx, params *DeleteClusterBlobsParameters, overrides ...taskcore.TaskOverride) (int32, error) {
+	return c.runDeleteClusterBlobs(ctx, c.taskStore.WithTx(tx), params, overrides...)
 }
 
-func (c *Client) runAutoBackup(ctx context.Context, taskstore taskcore.TaskStoreInterface, params *AutoBackupParameters, overrides ...taskcore.TaskOverride) (int32, error) {
+func (c *Client) runDeleteClusterBlobs(ctx context.Context, taskstore taskcore.TaskStoreInterface, params *DeleteClusterBlobsParameters, overrides ...taskcore.TaskOverride) (int32, error) {
 	payload, err := params.Marshal()
 	if err != nil {
 		return 0, err
 	}
 
 	spec := apigen.TaskSpec{
-		Type:    AutoBackup,
+		Type:    DeleteClusterBlobs,
 		Payload: payload,
 	}
 	attributes := apigen.TaskAttributes{}
 	attributes.Timeout = utils.Ptr("30m")
-	
-	attributes.Cronjob = &apigen.TaskCronjob{
-		CronExpression: "0 */6 * * *",
+	attributes.RetryPolicy = &apigen.TaskRetryPolicy{
+		Interval:             "30m",
+		AlwaysRetryOnFailure: true,
 	}
+	
 	task := &apigen.Task{
 		Attributes: attributes,
 		Spec:       spec,
@@ -105,30 +965,31 @@ func (c *Client) runAutoBackup(ctx context.Context, taskstore taskcore.TaskStore
 	}
 	return taskID, nil
 }
-func (c *Client) RunAutoDiagnostic(ctx context.Context, params *AutoDiagnosticParameters, overrides ...taskcore.TaskOverride) (int32, error) {
-	return c.runAutoDiagnostic(ctx, c.taskStore, params, overrides...)
+func (c *Client) RunMigrateBlobPayloads(ctx context.Context, params *MigrateBlobPayloadsParameters, overrides ...taskcore.TaskOverride) (int32, error) {
+	return c.runMigrateBlobPayloads(ctx, c.taskStore, params, overrides...)
 }
 
-func (c *Client) RunAutoDiagnosticWithTx(ctx context.Context, tx pgx.Tx, params *AutoDiagnosticParameters, overrides ...taskcore.TaskOverride) (int32, error) {
-	return c.runAutoDiagnostic(ctx, c.taskStore.WithTx(tx), params, overrides...)
+func (c *Client) RunMigrateBlobPayloadsWithTx(ctx context.Context, tx pgx.Tx, params *MigrateBlobPayloadsParameters, overrides ...taskcore.TaskOverride) (int32, error) {
+	return c.runMigrateBlobPayloads(ctx, c.taskStore.WithTx(tx), params, overrides...)
 }
 
-func (c *Client) runAutoDiagnostic(ctx context.Context, taskstore taskcore.TaskStoreInterface, params *AutoDiagnosticParameters, overrides ...taskcore.TaskOverride) (int32, error) {
+func (c *Client) runMigrateBlobPayloads(ctx context.Context, taskstore taskcore.TaskStoreInterface, params *MigrateBlobPayloadsParameters, overrides ...taskcore.TaskOverride) (int32, error) {
 	payload, err := params.Marshal()
 	if err != nil {
 		return 0, err
 	}
 
 	spec := apigen.TaskSpec{
-		Type:    AutoDiagnostic,
+		Type:    MigrateBlobPayloads,
 		Payload: payload,
 	}
 	attributes := apigen.TaskAttributes{}
-	attributes.Timeout = utils.Ptr("30m")
-	
-	attributes.Cronjob = &apigen.TaskCronjob{
-		CronExpression: "0 */6 * * *",
+	attributes.Timeout = utils.Ptr("1h")
+	attributes.RetryPolicy = &apigen.TaskRetryPolicy{
+		Interval:             "30m",
+		AlwaysRetryOnFailure: true,
 	}
+	
 	task := &apigen.Task{
 		Attributes: attributes,
 		Spec:       spec,
@@ -146,31 +1007,30 @@ func (c *Client) runAutoDiagnostic(ctx context.Context, taskstore taskcore.TaskS
 	}
 	return taskID, nil
 }
-func (c *Client) RunDeleteClusterDiagnostic(ctx context.Context, params *DeleteClusterDiagnosticParameters, overrides ...taskcore.TaskOverride) (int32, error) {
-	return c.runDeleteClusterDiagnostic(ctx, c.taskStore, params, overrides...)
+func (c *Client) RunIndexDiagnostics(ctx context.Context, params *IndexDiagnosticsParameters, overrides ...taskcore.TaskOverride) (int32, error) {
+	return c.runIndexDiagnostics(ctx, c.taskStore, params, overrides...)
 }
 
-func (c *Client) RunDeleteClusterDiagnosticWithTx(ctx context.Context, tx pgx.Tx, params *DeleteClusterDiagnosticParameters, overrides ...taskcore.TaskOverride) (int32, error) {
-	return c.runDeleteClusterDiagnostic(ctx, c.taskStore.WithTx(tx), params, overrides...)
+func (c *Client) RunIndexDiagnosticsWithTx(ctx context.Context, tx pgx.Tx, params *IndexDiagnosticsParameters, overrides ...taskcore.TaskOverride) (int32, error) {
+	return c.runIndexDiagnostics(ctx, c.taskStore.WithTx(tx), params, overrides...)
 }
 
-func (c *Client) runDeleteClusterDiagnostic(ctx context.Context, taskstore taskcore.TaskStoreInterface, params *DeleteClusterDiagnosticParameters, overrides ...taskcore.TaskOverride) (int32, error) {
+func (c *Client) runIndexDiagnostics(ctx context.Context, taskstore taskcore.TaskStoreInterface, params *IndexDiagnosticsParameters, overrides ...taskcore.TaskOverride) (int32, error) {
 	payload, err := params.Marshal()
 	if err != nil {
 		return 0, err
 	}
 
 	spec := apigen.TaskSpec{
-		Type:    DeleteClusterDiagnostic,
+		Type:    IndexDiagnostics,
 		Payload: payload,
 	}
 	attributes := apigen.TaskAttributes{}
-	attributes.Timeout = utils.Ptr("30m")
-	attributes.RetryPolicy = &apigen.TaskRetryPolicy{
-		Interval:             "30m",
-		AlwaysRetryOnFailure: true,
-	}
+	attributes.Timeout = utils.Ptr("1h")
 	
+	attributes.Cronjob = &apigen.TaskCronjob{
+		CronExpression: "0 10 * * * *",
+	}
 	task := &apigen.Task{
 		Attributes: attributes,
 		Spec:       spec,
@@ -188,31 +1048,30 @@ func (c *Client) runDeleteClusterDiagnostic(ctx context.Context, taskstore taskc
 	}
 	return taskID, nil
 }
-func (c *Client) RunDeleteSnapshot(ctx context.Context, params *DeleteSnapshotParameters, overrides ...taskcore.TaskOverride) (int32, error) {
-	return c.runDeleteSnapshot(ctx, c.taskStore, params, overrides...)
+func (c *Client) RunEvaluateAlertRules(ctx context.Context, params *EvaluateAlertRulesParameters, overrides ...taskcore.TaskOverride) (int32, error) {
+	return c.runEvaluateAlertRules(ctx, c.taskStore, params, overrides...)
 }
 
-func (c *Client) RunDeleteSnapshotWithTx(ctx context.Context, tx pgx.Tx, params *DeleteSnapshotParameters, overrides ...taskcore.TaskOverride) (int32, error) {
-	return c.runDeleteSnapshot(ctx, c.taskStore.WithTx(tx), params, overrides...)
+func (c *Client) RunEvaluateAlertRulesWithTx(ctx context.Context, tx pgx.Tx, params *EvaluateAlertRulesParameters, overrides ...taskcore.TaskOverride) (int32, error) {
+	return c.runEvaluateAlertRules(ctx, c.taskStore.WithTx(tx), params, overrides...)
 }
 
-func (c *Client) runDeleteSnapshot(ctx context.Context, taskstore taskcore.TaskStoreInterface, params *DeleteSnapshotParameters, overrides ...taskcore.TaskOverride) (int32, error) {
+func (c *Client) runEvaluateAlertRules(ctx context.Context, taskstore taskcore.TaskStoreInterface, params *EvaluateAlertRulesParameters, overrides ...taskcore.TaskOverride) (int32, error) {
 	payload, err := params.Marshal()
 	if err != nil {
 		return 0, err
 	}
 
 	spec := apigen.TaskSpec{
-		Type:    DeleteSnapshot,
+		Type:    EvaluateAlertRules,
 		Payload: payload,
 	}
 	attributes := apigen.TaskAttributes{}
-	attributes.Timeout = utils.Ptr("30m")
-	attributes.RetryPolicy = &apigen.TaskRetryPolicy{
-		Interval:             "30m",
-		AlwaysRetryOnFailure: true,
-	}
+	attributes.Timeout = utils.Ptr("5m")
 	
+	attributes.Cronjob = &apigen.TaskCronjob{
+		CronExpression: "0 * * * * *",
+	}
 	task := &apigen.Task{
 		Attributes: attributes,
 		Spec:       spec,
@@ -264,6 +1123,60 @@ type DeleteSnapshotParameters struct {
 	SnapshotID int64 `json:"snapshotID" yaml:"snapshotID"`
 }
 
+type CatalogSnapshotParameters struct { }
+
+type RisectlExecutionParameters struct { 
+    // 
+	ExecutionID int32 `json:"executionID" yaml:"executionID"`
+}
+
+type ReconcileSnapshotsParameters struct { }
+
+type ApplySnapshotRetentionParameters struct { 
+    // 
+	ClusterID int32 `json:"clusterID" yaml:"clusterID"`
+}
+
+type ClusterUpgradeParameters struct { 
+    // 
+	UpgradeID int32 `json:"upgradeID" yaml:"upgradeID"`
+}
+
+type DetectClusterVersionParameters struct { 
+    // 
+	ClusterID int32 `json:"clusterID" yaml:"clusterID"`
+}
+
+type DetectClusterVersionsParameters struct { }
+
+type CheckClusterHealthParameters struct { }
+
+type PruneClusterHealthRecordsParameters struct { }
+
+type PruneRisectlExecutionsParameters struct { }
+
+type DiagnosticBundleParameters struct { 
+    // 
+	BundleID int32 `json:"bundleID" yaml:"bundleID"`
+}
+
+type PruneDiagnosticBundlesParameters struct { }
+
+type PruneAlertHistoryParameters struct { }
+
+type PruneClusterEventsParameters struct { }
+
+type DeleteClusterBlobsParameters struct { 
+    // 
+	ClusterID int32 `json:"clusterID" yaml:"clusterID"`
+}
+
+type MigrateBlobPayloadsParameters struct { }
+
+type IndexDiagnosticsParameters struct { }
+
+type EvaluateAlertRulesParameters struct { }
+
 func (r *AutoBackupParameters) Parse(spec json.RawMessage) error {
 	return json.Unmarshal(spec, r)
 }
@@ -292,6 +1205,132 @@ func (r *DeleteSnapshotParameters) Parse(spec json.RawMessage) error {
 func (r *DeleteSnapshotParameters) Marshal() (json.RawMessage, error) {
 	return json.Marshal(r)
 }
+func (r *CatalogSnapshotParameters) Parse(spec json.RawMessage) error {
+	return json.Unmarshal(spec, r)
+}
+
+func (r *CatalogSnapshotParameters) Marshal() (json.RawMessage, error) {
+	return json.Marshal(r)
+}
+func (r *RisectlExecutionParameters) Parse(spec json.RawMessage) error {
+	return json.Unmarshal(spec, r)
+}
+
+func (r *RisectlExecutionParameters) Marshal() (json.RawMessage, error) {
+	return json.Marshal(r)
+}
+func (r *ReconcileSnapshotsParameters) Parse(spec json.RawMessage) error {
+	return json.Unmarshal(spec, r)
+}
+
+func (r *ReconcileSnapshotsParameters) Marshal() (json.RawMessage, error) {
+	return json.Marshal(r)
+}
+func (r *ApplySnapshotRetentionParameters) Parse(spec json.RawMessage) error {
+	return json.Unmarshal(spec, r)
+}
+
+func (r *ApplySnapshotRetentionParameters) Marshal() (json.RawMessage, error) {
+	return json.Marshal(r)
+}
+func (r *ClusterUpgradeParameters) Parse(spec json.RawMessage) error {
+	return json.Unmarshal(spec, r)
+}
+
+func (r *ClusterUpgradeParameters) Marshal() (json.RawMessage, error) {
+	return json.Marshal(r)
+}
+func (r *DetectClusterVersionParameters) Parse(spec json.RawMessage) error {
+	return json.Unmarshal(spec, r)
+}
+
+func (r *DetectClusterVersionParameters) Marshal() (json.RawMessage, error) {
+	return json.Marshal(r)
+}
+func (r *DetectClusterVersionsParameters) Parse(spec json.RawMessage) error {
+	return json.Unmarshal(spec, r)
+}
+
+func (r *DetectClusterVersionsParameters) Marshal() (json.RawMessage, error) {
+	return json.Marshal(r)
+}
+func (r *CheckClusterHealthParameters) Parse(spec json.RawMessage) error {
+	return json.Unmarshal(spec, r)
+}
+
+func (r *CheckClusterHealthParameters) Marshal() (json.RawMessage, error) {
+	return json.Marshal(r)
+}
+func (r *PruneClusterHealthRecordsParameters) Parse(spec json.RawMessage) error {
+	return json.Unmarshal(spec, r)
+}
+
+func (r *PruneClusterHealthRecordsParameters) Marshal() (json.RawMessage, error) {
+	return json.Marshal(r)
+}
+func (r *PruneRisectlExecutionsParameters) Parse(spec json.RawMessage) error {
+	return json.Unmarshal(spec, r)
+}
+
+func (r *PruneRisectlExecutionsParameters) Marshal() (json.RawMessage, error) {
+	return json.Marshal(r)
+}
+func (r *DiagnosticBundleParameters) Parse(spec json.RawMessage) error {
+	return json.Unmarshal(spec, r)
+}
+
+func (r *DiagnosticBundleParameters) Marshal() (json.RawMessage, error) {
+	return json.Marshal(r)
+}
+func (r *PruneDiagnosticBundlesParameters) Parse(spec json.RawMessage) error {
+	return json.Unmarshal(spec, r)
+}
+
+func (r *PruneDiagnosticBundlesParameters) Marshal() (json.RawMessage, error) {
+	return json.Marshal(r)
+}
+func (r *PruneAlertHistoryParameters) Parse(spec json.RawMessage) error {
+	return json.Unmarshal(spec, r)
+}
+
+func (r *PruneAlertHistoryParameters) Marshal() (json.RawMessage, error) {
+	return json.Marshal(r)
+}
+func (r *PruneClusterEventsParameters) Parse(spec json.RawMessage) error {
+	return json.Unmarshal(spec, r)
+}
+
+func (r *PruneClusterEventsParameters) Marshal() (json.RawMessage, error) {
+	return json.Marshal(r)
+}
+func (r *DeleteClusterBlobsParameters) Parse(spec json.RawMessage) error {
+	return json.Unmarshal(spec, r)
+}
+
+func (r *DeleteClusterBlobsParameters) Marshal() (json.RawMessage, error) {
+	return json.Marshal(r)
+}
+func (r *MigrateBlobPayloadsParameters) Parse(spec json.RawMessage) error {
+	return json.Unmarshal(spec, r)
+}
+
+func (r *MigrateBlobPayloadsParameters) Marshal() (json.RawMessage, error) {
+	return json.Marshal(r)
+}
+func (r *IndexDiagnosticsParameters) Parse(spec json.RawMessage) error {
+	return json.Unmarshal(spec, r)
+}
+
+func (r *IndexDiagnosticsParameters) Marshal() (json.RawMessage, error) {
+	return json.Marshal(r)
+}
+func (r *EvaluateAlertRulesParameters) Parse(spec json.RawMessage) error {
+	return json.Unmarshal(spec, r)
+}
+
+func (r *EvaluateAlertRulesParameters) Marshal() (json.RawMessage, error) {
+	return json.Marshal(r)
+}
 
 type ExecutorInterface interface { 
     // Auto backup
@@ -305,6 +1344,60 @@ type ExecutorInterface interface {
 
     // Delete snapshot
 	ExecuteDeleteSnapshot(ctx context.Context, params *DeleteSnapshotParameters) error
+
+    // Snapshot the catalog of every database and record the changes since the previous snapshot
+	ExecuteCatalogSnapshot(ctx context.Context, params *CatalogSnapshotParameters) error
+
+    // Run a risectl command and persist its output as it is produced
+	ExecuteRisectlExecution(ctx context.Context, params *RisectlExecutionParameters) error
+
+    // Sync the recorded snapshots of every cluster with the meta snapshots of the cluster
+	ExecuteReconcileSnapshots(ctx context.Context, params *ReconcileSnapshotsParameters) error
+
+    // Delete the automatic snapshots of a cluster pruned by the retention policy of its auto backup config
+	ExecuteApplySnapshotRetention(ctx context.Context, params *ApplySnapshotRetentionParameters) error
+
+    // Take a pre-upgrade snapshot, wait for the cluster to report the target version, then update its version and diagnose it
+	ExecuteClusterUpgrade(ctx context.Context, params *ClusterUpgradeParameters) error
+
+    // Detect the version of a cluster through SELECT version() and flag or fix the drift from its recorded version
+	ExecuteDetectClusterVersion(ctx context.Context, params *DetectClusterVersionParameters) error
+
+    // Detect the versions of every cluster
+	ExecuteDetectClusterVersions(ctx context.Context, params *DetectClusterVersionsParameters) error
+
+    // Check the health of every cluster and record the changes of their status
+	ExecuteCheckClusterHealth(ctx context.Context, params *CheckClusterHealthParameters) error
+
+    // Delete the cluster health records older than the retention, the latest record of every cluster is kept
+	ExecutePruneClusterHealthRecords(ctx context.Context, params *PruneClusterHealthRecordsParameters) error
+
+    // Delete the risectl executions finished before the retention together with their output
+	ExecutePruneRisectlExecutions(ctx context.Context, params *PruneRisectlExecutionsParameters) error
+
+    // Collect the diagnose output, the risectl outputs, the key metrics, the catalog summary and the config of a cluster into a redacted tar.gz archive
+	ExecuteDiagnosticBundle(ctx context.Context, params *DiagnosticBundleParameters) error
+
+    // Delete the diagnostic bundles finished before the retention together with their archives
+	ExecutePruneDiagnosticBundles(ctx context.Context, params *PruneDiagnosticBundlesParameters) error
+
+    // Delete the fired and resolved alerts recorded before the retention
+	ExecutePruneAlertHistory(ctx context.Context, params *PruneAlertHistoryParameters) error
+
+    // Delete the cluster events recorded before the retention, e.g. the alerts and the diagnostic findings reported
+	ExecutePruneClusterEvents(ctx context.Context, params *PruneClusterEventsParameters) error
+
+    // Delete the diagnose reports and the diagnostic bundles of a deleted cluster from the blob store
+	ExecuteDeleteClusterBlobs(ctx context.Context, params *DeleteClusterBlobsParameters) error
+
+    // Move the diagnose reports and the diagnostic bundles stored in postgres before the blob store was introduced to the blob store. It runs once.
+	ExecuteMigrateBlobPayloads(ctx context.Context, params *MigrateBlobPayloadsParameters) error
+
+    // Index the diagnose reports without a search vector, e.g. the ones created before the full-text search was introduced or failed to be indexed when they were created
+	ExecuteIndexDiagnostics(ctx context.Context, params *IndexDiagnosticsParameters) error
+
+    // Evaluate the enabled alert rules against the metrics stores of the clusters and record the alerts fired and resolved
+	ExecuteEvaluateAlertRules(ctx context.Context, params *EvaluateAlertRulesParameters) error
 }
 
 type TaskHandler struct {
@@ -363,6 +1456,132 @@ func (f *TaskHandler) HandleTask(ctx context.Context, spec worker.TaskSpec) erro
 		}
 		return f.executor.ExecuteDeleteSnapshot(ctx, &params)
 		
+	case CatalogSnapshot:
+		var params CatalogSnapshotParameters
+		if err := params.Parse(spec.GetPayload()); err != nil {
+			return fmt.Errorf("failed to parse CatalogSnapshot parameters: %w", err)
+		}
+		return f.executor.ExecuteCatalogSnapshot(ctx, &params)
+		
+	case RisectlExecution:
+		var params RisectlExecutionParameters
+		if err := params.Parse(spec.GetPayload()); err != nil {
+			return fmt.Errorf("failed to parse RisectlExecution parameters: %w", err)
+		}
+		return f.executor.ExecuteRisectlExecution(ctx, &params)
+		
+	case ReconcileSnapshots:
+		var params ReconcileSnapshotsParameters
+		if err := params.Parse(spec.GetPayload()); err != nil {
+			return fmt.Errorf("failed to parse ReconcileSnapshots parameters: %w", err)
+		}
+		return f.executor.ExecuteReconcileSnapshots(ctx, &params)
+		
+	case ApplySnapshotRetention:
+		var params ApplySnapshotRetentionParameters
+		if err := params.Parse(spec.GetPayload()); err != nil {
+			return fmt.Errorf("failed to parse ApplySnapshotRetention parameters: %w", err)
+		}
+		return f.executor.ExecuteApplySnapshotRetention(ctx, &params)
+		
+	case ClusterUpgrade:
+		var params ClusterUpgradeParameters
+		if err := params.Parse(spec.GetPayload()); err != nil {
+			return fmt.Errorf("failed to parse ClusterUpgrade parameters: %w", err)
+		}
+		return f.executor.ExecuteClusterUpgrade(ctx, &params)
+		
+	case DetectClusterVersion:
+		var params DetectClusterVersionParameters
+		if err := params.Parse(spec.GetPayload()); err != nil {
+			return fmt.Errorf("failed to parse DetectClusterVersion parameters: %w", err)
+		}
+		return f.executor.ExecuteDetectClusterVersion(ctx, &params)
+		
+	case DetectClusterVersions:
+		var params DetectClusterVersionsParameters
+		if err := params.Parse(spec.GetPayload()); err != nil {
+			return fmt.Errorf("failed to parse DetectClusterVersions parameters: %w", err)
+		}
+		return f.executor.ExecuteDetectClusterVersions(ctx, &params)
+		
+	case CheckClusterHealth:
+		var params CheckClusterHealthParameters
+		if err := params.Parse(spec.GetPayload()); err != nil {
+			return fmt.Errorf("failed to parse CheckClusterHealth parameters: %w", err)
+		}
+		return f.executor.ExecuteCheckClusterHealth(ctx, &params)
+		
+	case PruneClusterHealthRecords:
+		var params PruneClusterHealthRecordsParameters
+		if err := params.Parse(spec.GetPayload()); err != nil {
+			return fmt.Errorf("failed to parse PruneClusterHealthRecords parameters: %w", err)
+		}
+		return f.executor.ExecutePruneClusterHealthRecords(ctx, &params)
+		
+	case PruneRisectlExecutions:
+		var params PruneRisectlExecutionsParameters
+		if err := params.Parse(spec.GetPayload()); err != nil {
+			return fmt.Errorf("failed to parse PruneRisectlExecutions parameters: %w", err)
+		}
+		return f.executor.ExecutePruneRisectlExecutions(ctx, &params)
+		
+	case DiagnosticBundle:
+		var params DiagnosticBundleParameters
+		if err := params.Parse(spec.GetPayload()); err != nil {
+			return fmt.Errorf("failed to parse DiagnosticBundle parameters: %w", err)
+		}
+		return f.executor.ExecuteDiagnosticBundle(ctx, &params)
+		
+	case PruneDiagnosticBundles:
+		var params PruneDiagnosticBundlesParameters
+		if err := params.Parse(spec.GetPayload()); err != nil {
+			return fmt.Errorf("failed to parse PruneDiagnosticBundles parameters: %w", err)
+		}
+		return f.executor.ExecutePruneDiagnosticBundles(ctx, &params)
+		
+	case PruneAlertHistory:
+		var params PruneAlertHistoryParameters
+		if err := params.Parse(spec.GetPayload()); err != nil {
+			return fmt.Errorf("failed to parse PruneAlertHistory parameters: %w", err)
+		}
+		return f.executor.ExecutePruneAlertHistory(ctx, &params)
+		
+	case PruneClusterEvents:
+		var params PruneClusterEventsParameters
+		if err := params.Parse(spec.GetPayload()); err != nil {
+			return fmt.Errorf("failed to parse PruneClusterEvents parameters: %w", err)
+		}
+		return f.executor.ExecutePruneClusterEvents(ctx, &params)
+		
+	case DeleteClusterBlobs:
+		var params DeleteClusterBlobsParameters
+		if err := params.Parse(spec.GetPayload()); err != nil {
+			return fmt.Errorf("failed to parse DeleteClusterBlobs parameters: %w", err)
+		}
+		return f.executor.ExecuteDeleteClusterBlobs(ctx, &params)
+		
+	case MigrateBlobPayloads:
+		var params MigrateBlobPayloadsParameters
+		if err := params.Parse(spec.GetPayload()); err != nil {
+			return fmt.Errorf("failed to parse MigrateBlobPayloads parameters: %w", err)
+		}
+		return f.executor.ExecuteMigrateBlobPayloads(ctx, &params)
+		
+	case IndexDiagnostics:
+		var params IndexDiagnosticsParameters
+		if err := params.Parse(spec.GetPayload()); err != nil {
+			return fmt.Errorf("failed to parse IndexDiagnostics parameters: %w", err)
+		}
+		return f.executor.ExecuteIndexDiagnostics(ctx, &params)
+		
+	case EvaluateAlertRules:
+		var params EvaluateAlertRulesParameters
+		if err := params.Parse(spec.GetPayload()); err != nil {
+			return fmt.Errorf("failed to parse EvaluateAlertRules parameters: %w", err)
+		}
+		return f.executor.ExecuteEvaluateAlertRules(ctx, &params)
+		
 	default:
 		return errors.Wrapf(worker.ErrUnknownTaskType, "unknown task type: %s", spec.GetType())
 	}
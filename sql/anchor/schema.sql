@@ -0,0 +1,12 @@
+-- The tables owned by anchor that the queries read. They are created by the
+-- anchor migrations, this file only lets sqlc know about them.
+
+CREATE SCHEMA IF NOT EXISTS anchor;
+
+CREATE TABLE IF NOT EXISTS anchor.org_owners (
+    org_id     INTEGER NOT NULL,
+    user_id    INTEGER NOT NULL,
+    created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP NOT NULL,
+
+    PRIMARY KEY (org_id)
+);
@@ -0,0 +1,7 @@
+BEGIN;
+
+ALTER TABLE org_settings DROP COLUMN max_statement_timeout;
+
+ALTER TABLE database_connections DROP COLUMN session_settings;
+
+COMMIT;
@@ -0,0 +1,7 @@
+BEGIN;
+
+ALTER TABLE database_connections ADD COLUMN session_settings JSONB;
+
+ALTER TABLE org_settings ADD COLUMN max_statement_timeout TEXT;
+
+COMMIT;
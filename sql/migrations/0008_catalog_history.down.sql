@@ -0,0 +1,7 @@
+BEGIN;
+
+DROP TABLE IF EXISTS catalog_change_events;
+
+DROP TABLE IF EXISTS catalog_snapshots;
+
+COMMIT;
@@ -0,0 +1,34 @@
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS catalog_snapshots (
+    id              SERIAL,
+    database_id     INTEGER     NOT NULL REFERENCES database_connections(id) ON DELETE CASCADE,
+    hash            TEXT        NOT NULL,
+    catalog         JSONB       NOT NULL,
+    created_at      TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP NOT NULL,
+
+    PRIMARY KEY (id)
+);
+
+CREATE INDEX IF NOT EXISTS catalog_snapshots_database_id_created_at_idx ON catalog_snapshots (database_id, created_at DESC);
+
+-- only the latest catalog snapshot of a database is kept, the changes outlive their snapshots
+CREATE TABLE IF NOT EXISTS catalog_change_events (
+    id              SERIAL,
+    database_id     INTEGER     NOT NULL REFERENCES database_connections(id) ON DELETE CASCADE,
+    snapshot_id     INTEGER     REFERENCES catalog_snapshots(id) ON DELETE SET NULL,
+    relation_id     INTEGER     NOT NULL,
+    schema_name     TEXT        NOT NULL,
+    relation_name   TEXT        NOT NULL,
+    relation_type   TEXT        NOT NULL,
+    change_type     TEXT        NOT NULL,
+    details         JSONB       NOT NULL,
+    created_at      TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP NOT NULL,
+
+    PRIMARY KEY (id)
+);
+
+CREATE INDEX IF NOT EXISTS catalog_change_events_database_id_relation_id_idx ON catalog_change_events (database_id, relation_id);
+CREATE INDEX IF NOT EXISTS catalog_change_events_database_id_id_idx ON catalog_change_events (database_id, id DESC);
+
+COMMIT;
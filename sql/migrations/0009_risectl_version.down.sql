@@ -0,0 +1,5 @@
+BEGIN;
+
+ALTER TABLE clusters DROP COLUMN risectl_version;
+
+COMMIT;
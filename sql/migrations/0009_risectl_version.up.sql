@@ -0,0 +1,5 @@
+BEGIN;
+
+ALTER TABLE clusters ADD COLUMN risectl_version TEXT;
+
+COMMIT;
@@ -0,0 +1,6 @@
+BEGIN;
+
+DROP TABLE IF EXISTS risectl_execution_outputs;
+DROP TABLE IF EXISTS risectl_executions;
+
+COMMIT;
@@ -0,0 +1,33 @@
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS risectl_executions (
+    id               SERIAL,
+    cluster_id       INTEGER     NOT NULL REFERENCES clusters(id) ON DELETE CASCADE,
+    org_id           INTEGER     NOT NULL,
+    user_id          INTEGER     NOT NULL,
+    args             TEXT[]      NOT NULL,
+    status           TEXT        NOT NULL,
+    exit_code        INTEGER,
+    error            TEXT,
+    risectl_version  TEXT,
+    cancel_requested BOOLEAN     DEFAULT FALSE NOT NULL,
+    created_at       TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    started_at       TIMESTAMPTZ,
+    finished_at      TIMESTAMPTZ,
+
+    PRIMARY KEY (id)
+);
+
+CREATE INDEX IF NOT EXISTS risectl_executions_cluster_id_created_at_idx ON risectl_executions (cluster_id, created_at DESC);
+
+CREATE TABLE IF NOT EXISTS risectl_execution_outputs (
+    execution_id    INTEGER     NOT NULL REFERENCES risectl_executions(id) ON DELETE CASCADE,
+    seq             INTEGER     NOT NULL,
+    stream          TEXT        NOT NULL,
+    content         TEXT        NOT NULL,
+    created_at      TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP NOT NULL,
+
+    PRIMARY KEY (execution_id, seq)
+);
+
+COMMIT;
@@ -0,0 +1,6 @@
+BEGIN;
+
+DROP TABLE IF EXISTS risectl_shell_commands;
+DROP TABLE IF EXISTS risectl_shell_sessions;
+
+COMMIT;
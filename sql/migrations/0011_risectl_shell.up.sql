@@ -0,0 +1,31 @@
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS risectl_shell_sessions (
+    id              SERIAL,
+    cluster_id      INTEGER     NOT NULL REFERENCES clusters(id) ON DELETE CASCADE,
+    org_id          INTEGER     NOT NULL,
+    user_id         INTEGER     NOT NULL,
+    risectl_version TEXT,
+    created_at      TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    closed_at       TIMESTAMPTZ,
+
+    PRIMARY KEY (id)
+);
+
+CREATE INDEX IF NOT EXISTS risectl_shell_sessions_cluster_id_created_at_idx ON risectl_shell_sessions (cluster_id, created_at DESC);
+
+CREATE TABLE IF NOT EXISTS risectl_shell_commands (
+    session_id      INTEGER     NOT NULL REFERENCES risectl_shell_sessions(id) ON DELETE CASCADE,
+    seq             INTEGER     NOT NULL,
+    command         TEXT        NOT NULL,
+    args            TEXT[]      NOT NULL,
+    allowed         BOOLEAN     NOT NULL,
+    exit_code       INTEGER,
+    error           TEXT,
+    created_at      TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    finished_at     TIMESTAMPTZ,
+
+    PRIMARY KEY (session_id, seq)
+);
+
+COMMIT;
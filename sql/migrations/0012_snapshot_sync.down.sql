@@ -0,0 +1,7 @@
+BEGIN;
+
+ALTER TABLE cluster_snapshots DROP COLUMN IF EXISTS synced_at;
+ALTER TABLE cluster_snapshots DROP COLUMN IF EXISTS sync_status;
+ALTER TABLE cluster_snapshots DROP COLUMN IF EXISTS source;
+
+COMMIT;
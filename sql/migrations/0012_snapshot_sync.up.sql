@@ -0,0 +1,7 @@
+BEGIN;
+
+ALTER TABLE cluster_snapshots ADD COLUMN IF NOT EXISTS source TEXT DEFAULT 'console' NOT NULL;
+ALTER TABLE cluster_snapshots ADD COLUMN IF NOT EXISTS sync_status TEXT DEFAULT 'unknown' NOT NULL;
+ALTER TABLE cluster_snapshots ADD COLUMN IF NOT EXISTS synced_at TIMESTAMPTZ;
+
+COMMIT;
@@ -0,0 +1,10 @@
+BEGIN;
+
+UPDATE cluster_snapshots SET source = 'console' WHERE source = 'auto';
+
+ALTER TABLE auto_backup_configs DROP COLUMN IF EXISTS keep_monthly;
+ALTER TABLE auto_backup_configs DROP COLUMN IF EXISTS keep_weekly;
+ALTER TABLE auto_backup_configs DROP COLUMN IF EXISTS keep_daily;
+ALTER TABLE auto_backup_configs DROP COLUMN IF EXISTS keep_last;
+
+COMMIT;
@@ -0,0 +1,22 @@
+BEGIN;
+
+ALTER TABLE auto_backup_configs ADD COLUMN IF NOT EXISTS keep_last INTEGER;
+ALTER TABLE auto_backup_configs ADD COLUMN IF NOT EXISTS keep_daily INTEGER;
+ALTER TABLE auto_backup_configs ADD COLUMN IF NOT EXISTS keep_weekly INTEGER;
+ALTER TABLE auto_backup_configs ADD COLUMN IF NOT EXISTS keep_monthly INTEGER;
+
+-- the snapshots of the automatic backups were recorded as created by the console, the names can
+-- be chosen by anyone, so they are told apart by the task deleting them which only the automatic
+-- backups schedule. The payload of a task spec is base64 encoded JSON.
+UPDATE cluster_snapshots s SET source = 'auto'
+WHERE s.source = 'console' AND EXISTS (
+    SELECT 1 FROM (
+        SELECT convert_from(decode(t.spec->>'payload', 'base64'), 'UTF8')::JSONB AS payload
+        FROM anchor.tasks t
+        WHERE t.spec->>'type' = 'DeleteSnapshot'
+    ) d
+    WHERE (d.payload->>'clusterID')::INTEGER = s.cluster_id
+        AND (d.payload->>'snapshotID')::BIGINT = s.snapshot_id
+);
+
+COMMIT;
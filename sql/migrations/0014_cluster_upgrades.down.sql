@@ -0,0 +1,6 @@
+BEGIN;
+
+DROP TABLE IF EXISTS cluster_upgrades;
+DROP TABLE IF EXISTS cluster_events;
+
+COMMIT;
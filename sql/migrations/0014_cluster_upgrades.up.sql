@@ -0,0 +1,41 @@
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS cluster_events (
+    id              SERIAL,
+    cluster_id      INTEGER     NOT NULL REFERENCES clusters(id) ON DELETE CASCADE,
+    type            TEXT        NOT NULL,
+    message         TEXT        NOT NULL,
+    details         JSONB       DEFAULT '{}' NOT NULL,
+    created_at      TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP NOT NULL,
+
+    PRIMARY KEY (id)
+);
+
+CREATE INDEX IF NOT EXISTS cluster_events_cluster_id_created_at_idx ON cluster_events (cluster_id, created_at DESC);
+
+CREATE TABLE IF NOT EXISTS cluster_upgrades (
+    id               SERIAL,
+    cluster_id       INTEGER     NOT NULL REFERENCES clusters(id) ON DELETE CASCADE,
+    org_id           INTEGER     NOT NULL,
+    user_id          INTEGER     NOT NULL,
+    from_version     TEXT        NOT NULL,
+    target_version   TEXT        NOT NULL,
+    status           TEXT        NOT NULL,
+    snapshot_id      BIGINT,
+    detected_version TEXT,
+    diagnostic_id    INTEGER,
+    error            TEXT,
+    deadline_at      TIMESTAMPTZ NOT NULL,
+    created_at       TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    updated_at       TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    finished_at      TIMESTAMPTZ,
+
+    PRIMARY KEY (id)
+);
+
+CREATE INDEX IF NOT EXISTS cluster_upgrades_cluster_id_created_at_idx ON cluster_upgrades (cluster_id, created_at DESC);
+
+-- at most one upgrade of a cluster is in progress
+CREATE UNIQUE INDEX IF NOT EXISTS cluster_upgrades_cluster_id_in_progress_idx ON cluster_upgrades (cluster_id) WHERE finished_at IS NULL;
+
+COMMIT;
@@ -0,0 +1,10 @@
+BEGIN;
+
+DROP TABLE IF EXISTS cluster_version_history;
+
+ALTER TABLE org_settings DROP COLUMN IF EXISTS auto_update_cluster_version;
+
+ALTER TABLE clusters DROP COLUMN IF EXISTS version_detected_at;
+ALTER TABLE clusters DROP COLUMN IF EXISTS detected_version;
+
+COMMIT;
@@ -0,0 +1,25 @@
+BEGIN;
+
+ALTER TABLE clusters ADD COLUMN IF NOT EXISTS detected_version TEXT;
+ALTER TABLE clusters ADD COLUMN IF NOT EXISTS version_detected_at TIMESTAMPTZ;
+
+ALTER TABLE org_settings ADD COLUMN IF NOT EXISTS auto_update_cluster_version BOOLEAN DEFAULT FALSE NOT NULL;
+
+CREATE TABLE IF NOT EXISTS cluster_version_history (
+    id               SERIAL,
+    cluster_id       INTEGER     NOT NULL REFERENCES clusters(id) ON DELETE CASCADE,
+    version          TEXT        NOT NULL,
+    previous_version TEXT,
+    source           TEXT        NOT NULL,
+    created_at       TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP NOT NULL,
+
+    PRIMARY KEY (id)
+);
+
+CREATE INDEX IF NOT EXISTS cluster_version_history_cluster_id_created_at_idx ON cluster_version_history (cluster_id, created_at DESC);
+
+-- the current versions are the baseline of the history
+INSERT INTO cluster_version_history (cluster_id, version, source, created_at)
+SELECT id, version, 'user', created_at FROM clusters;
+
+COMMIT;
@@ -0,0 +1,9 @@
+BEGIN;
+
+DROP TABLE IF EXISTS cluster_health_records;
+
+ALTER TABLE clusters DROP COLUMN IF EXISTS last_seen_at;
+ALTER TABLE clusters DROP COLUMN IF EXISTS health_checked_at;
+ALTER TABLE clusters DROP COLUMN IF EXISTS health_status;
+
+COMMIT;
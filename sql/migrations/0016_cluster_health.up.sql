@@ -0,0 +1,23 @@
+BEGIN;
+
+ALTER TABLE clusters ADD COLUMN IF NOT EXISTS health_status TEXT;
+ALTER TABLE clusters ADD COLUMN IF NOT EXISTS health_checked_at TIMESTAMPTZ;
+ALTER TABLE clusters ADD COLUMN IF NOT EXISTS last_seen_at TIMESTAMPTZ;
+
+-- a record is created once the status or the reasons of a cluster change, the reasons are stable
+-- codes so that a failure is recorded once however its error changes, the errors of the failed
+-- checks are kept in the details
+CREATE TABLE IF NOT EXISTS cluster_health_records (
+    id          SERIAL,
+    cluster_id  INTEGER     NOT NULL REFERENCES clusters(id) ON DELETE CASCADE,
+    status      TEXT        NOT NULL,
+    reasons     TEXT[]      DEFAULT '{}' NOT NULL,
+    details     JSONB       DEFAULT '{}' NOT NULL,
+    created_at  TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP NOT NULL,
+
+    PRIMARY KEY (id)
+);
+
+CREATE INDEX IF NOT EXISTS cluster_health_records_cluster_id_created_at_idx ON cluster_health_records (cluster_id, created_at DESC);
+
+COMMIT;
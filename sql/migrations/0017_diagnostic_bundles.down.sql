@@ -0,0 +1,6 @@
+BEGIN;
+
+DROP TABLE IF EXISTS cluster_diagnostic_bundle_contents;
+DROP TABLE IF EXISTS cluster_diagnostic_bundles;
+
+COMMIT;
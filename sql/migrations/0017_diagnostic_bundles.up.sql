@@ -0,0 +1,28 @@
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS cluster_diagnostic_bundles (
+    id          SERIAL,
+    cluster_id  INTEGER     NOT NULL REFERENCES clusters(id) ON DELETE CASCADE,
+    org_id      INTEGER     NOT NULL,
+    user_id     INTEGER     NOT NULL,
+    status      TEXT        NOT NULL,
+    error       TEXT,
+    manifest    JSONB,
+    size_bytes  BIGINT,
+    created_at  TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    finished_at TIMESTAMPTZ,
+
+    PRIMARY KEY (id)
+);
+
+CREATE INDEX IF NOT EXISTS cluster_diagnostic_bundles_cluster_id_created_at_idx ON cluster_diagnostic_bundles (cluster_id, created_at DESC);
+
+-- the archive is kept apart so listing the bundles does not read it
+CREATE TABLE IF NOT EXISTS cluster_diagnostic_bundle_contents (
+    bundle_id   INTEGER     NOT NULL REFERENCES cluster_diagnostic_bundles(id) ON DELETE CASCADE,
+    content     BYTEA       NOT NULL,
+
+    PRIMARY KEY (bundle_id)
+);
+
+COMMIT;
@@ -0,0 +1,26 @@
+BEGIN;
+
+-- the payloads in the blob store cannot be moved back, the downgrade is refused instead of
+-- dropping the diagnose reports and the diagnostic bundles stored there
+DO $$
+BEGIN
+    IF EXISTS (SELECT 1 FROM cluster_diagnostics WHERE content IS NULL)
+        OR EXISTS (SELECT 1 FROM cluster_diagnostic_bundles WHERE content_ref IS NOT NULL) THEN
+        RAISE EXCEPTION 'cannot revert the blob store: diagnose reports or diagnostic bundles are stored in the blob store, delete them first';
+    END IF;
+END
+$$;
+
+ALTER TABLE cluster_diagnostic_bundles
+    DROP COLUMN IF EXISTS content_ref;
+
+DROP INDEX IF EXISTS cluster_diagnostics_inline_content_idx;
+
+ALTER TABLE cluster_diagnostics
+    DROP COLUMN IF EXISTS content_sha256,
+    DROP COLUMN IF EXISTS content_stored_size,
+    DROP COLUMN IF EXISTS content_size,
+    DROP COLUMN IF EXISTS content_ref,
+    ALTER COLUMN content SET NOT NULL;
+
+COMMIT;
@@ -0,0 +1,17 @@
+BEGIN;
+
+-- the payloads are moved to the blob store, the content column is only kept for the rows not
+-- migrated yet
+ALTER TABLE cluster_diagnostics
+    ALTER COLUMN content DROP NOT NULL,
+    ADD COLUMN IF NOT EXISTS content_ref         TEXT,
+    ADD COLUMN IF NOT EXISTS content_size        BIGINT,
+    ADD COLUMN IF NOT EXISTS content_stored_size BIGINT,
+    ADD COLUMN IF NOT EXISTS content_sha256      TEXT;
+
+CREATE INDEX IF NOT EXISTS cluster_diagnostics_inline_content_idx ON cluster_diagnostics (id) WHERE content IS NOT NULL;
+
+ALTER TABLE cluster_diagnostic_bundles
+    ADD COLUMN IF NOT EXISTS content_ref TEXT;
+
+COMMIT;
@@ -0,0 +1,6 @@
+BEGIN;
+
+DROP INDEX IF EXISTS cluster_diagnostics_cluster_id_created_at_idx;
+DROP TABLE IF EXISTS cluster_diagnostic_search;
+
+COMMIT;
@@ -0,0 +1,16 @@
+BEGIN;
+
+-- the search vectors of the diagnose reports, the reports themselves are in the blob store. The
+-- diagnostics without a row are indexed by the diagnostic indexing task.
+CREATE TABLE IF NOT EXISTS cluster_diagnostic_search (
+    diagnostic_id   INTEGER     NOT NULL REFERENCES cluster_diagnostics(id) ON DELETE CASCADE,
+    search_vector   TSVECTOR    NOT NULL,
+
+    PRIMARY KEY (diagnostic_id)
+);
+
+CREATE INDEX IF NOT EXISTS cluster_diagnostic_search_vector_idx ON cluster_diagnostic_search USING GIN (search_vector);
+
+CREATE INDEX IF NOT EXISTS cluster_diagnostics_cluster_id_created_at_idx ON cluster_diagnostics (cluster_id, created_at DESC);
+
+COMMIT;
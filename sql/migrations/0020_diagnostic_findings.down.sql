@@ -0,0 +1,6 @@
+BEGIN;
+
+DROP TABLE IF EXISTS cluster_diagnostic_findings;
+DROP TABLE IF EXISTS org_diagnostic_rules;
+
+COMMIT;
@@ -0,0 +1,32 @@
+BEGIN;
+
+-- the thresholds of the built-in diagnostic rules overridden by an organization, a NULL
+-- threshold means the default one
+CREATE TABLE IF NOT EXISTS org_diagnostic_rules (
+    org_id      INTEGER          NOT NULL REFERENCES anchor.orgs(id) ON DELETE CASCADE,
+    rule        TEXT             NOT NULL,
+    enabled     BOOLEAN          NOT NULL,
+    threshold   DOUBLE PRECISION,
+    updated_at  TIMESTAMPTZ      DEFAULT CURRENT_TIMESTAMP NOT NULL,
+
+    PRIMARY KEY (org_id, rule)
+);
+
+CREATE TABLE IF NOT EXISTS cluster_diagnostic_findings (
+    id              SERIAL,
+    diagnostic_id   INTEGER          NOT NULL REFERENCES cluster_diagnostics(id) ON DELETE CASCADE,
+    cluster_id      INTEGER          NOT NULL REFERENCES clusters(id) ON DELETE CASCADE,
+    rule            TEXT             NOT NULL,
+    severity        TEXT             NOT NULL,
+    message         TEXT             NOT NULL,
+    value           DOUBLE PRECISION NOT NULL,
+    threshold       DOUBLE PRECISION NOT NULL,
+    details         JSONB            DEFAULT '{}' NOT NULL,
+    created_at      TIMESTAMPTZ      DEFAULT CURRENT_TIMESTAMP NOT NULL,
+
+    PRIMARY KEY (id)
+);
+
+CREATE INDEX IF NOT EXISTS cluster_diagnostic_findings_diagnostic_id_idx ON cluster_diagnostic_findings (diagnostic_id);
+
+COMMIT;
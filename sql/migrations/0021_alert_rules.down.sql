@@ -0,0 +1,7 @@
+BEGIN;
+
+DROP TABLE IF EXISTS alert_history;
+DROP TABLE IF EXISTS alert_states;
+DROP TABLE IF EXISTS alert_rules;
+
+COMMIT;
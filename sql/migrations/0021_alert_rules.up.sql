@@ -0,0 +1,62 @@
+BEGIN;
+
+-- the alert rules of an organization, a rule without a cluster is evaluated against every cluster
+-- of the organization
+CREATE TABLE IF NOT EXISTS alert_rules (
+    id              SERIAL,
+    org_id          INTEGER     NOT NULL REFERENCES anchor.orgs(id) ON DELETE CASCADE,
+    cluster_id      INTEGER     REFERENCES clusters(id) ON DELETE CASCADE,
+    name            TEXT        NOT NULL,
+    expr            TEXT        NOT NULL,
+    for_duration    TEXT        NOT NULL,
+    severity        TEXT        NOT NULL,
+    labels          JSONB       DEFAULT '{}' NOT NULL,
+    enabled         BOOLEAN     DEFAULT TRUE NOT NULL,
+    created_at      TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    updated_at      TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP NOT NULL,
+
+    -- the error of the last evaluation, e.g. the expression failed or returned too many series
+    last_error      TEXT,
+
+    PRIMARY KEY (id)
+);
+
+CREATE INDEX IF NOT EXISTS alert_rules_org_id_idx ON alert_rules (org_id);
+
+-- the pending and firing alerts, a series of the result of the rule is an alert. The row is
+-- removed once the alert is resolved.
+CREATE TABLE IF NOT EXISTS alert_states (
+    rule_id         INTEGER          NOT NULL REFERENCES alert_rules(id) ON DELETE CASCADE,
+    cluster_id      INTEGER          NOT NULL REFERENCES clusters(id) ON DELETE CASCADE,
+    series          TEXT             NOT NULL,
+    labels          JSONB            DEFAULT '{}' NOT NULL,
+    status          TEXT             NOT NULL,
+    value           DOUBLE PRECISION NOT NULL,
+    active_at       TIMESTAMPTZ      NOT NULL,
+    fired_at        TIMESTAMPTZ,
+    evaluated_at    TIMESTAMPTZ      NOT NULL,
+
+    PRIMARY KEY (rule_id, cluster_id, series)
+);
+
+-- the alerts fired and resolved, the name and the severity of the rule are kept once the rule is
+-- deleted
+CREATE TABLE IF NOT EXISTS alert_history (
+    id              SERIAL,
+    org_id          INTEGER          NOT NULL REFERENCES anchor.orgs(id) ON DELETE CASCADE,
+    rule_id         INTEGER          REFERENCES alert_rules(id) ON DELETE SET NULL,
+    rule_name       TEXT             NOT NULL,
+    cluster_id      INTEGER          NOT NULL REFERENCES clusters(id) ON DELETE CASCADE,
+    status          TEXT             NOT NULL,
+    severity        TEXT             NOT NULL,
+    labels          JSONB            DEFAULT '{}' NOT NULL,
+    value           DOUBLE PRECISION NOT NULL,
+    active_at       TIMESTAMPTZ      NOT NULL,
+    created_at      TIMESTAMPTZ      DEFAULT CURRENT_TIMESTAMP NOT NULL,
+
+    PRIMARY KEY (id)
+);
+
+CREATE INDEX IF NOT EXISTS alert_history_org_id_created_at_idx ON alert_history (org_id, created_at DESC);
+
+COMMIT;
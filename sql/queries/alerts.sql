@@ -0,0 +1,94 @@
+-- name: CreateAlertRule :one
+INSERT INTO alert_rules (org_id, cluster_id, name, expr, for_duration, severity, labels, enabled)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8) RETURNING *;
+
+-- name: GetOrgAlertRule :one
+SELECT * FROM alert_rules
+WHERE id = $1 AND org_id = $2;
+
+-- name: ListOrgAlertRules :many
+SELECT * FROM alert_rules
+WHERE org_id = $1
+    AND (sqlc.narg('cluster_id')::INTEGER IS NULL OR cluster_id = sqlc.narg('cluster_id')::INTEGER)
+ORDER BY id;
+
+-- name: ListEnabledAlertRules :many
+SELECT * FROM alert_rules
+WHERE enabled
+ORDER BY id;
+
+-- name: UpdateOrgAlertRule :one
+UPDATE alert_rules
+SET cluster_id = $3,
+    name = $4,
+    expr = $5,
+    for_duration = $6,
+    severity = $7,
+    labels = $8,
+    enabled = $9,
+    updated_at = CURRENT_TIMESTAMP
+WHERE id = $1 AND org_id = $2
+RETURNING *;
+
+-- name: UpdateAlertRuleLastError :exec
+UPDATE alert_rules
+SET last_error = $2
+WHERE id = $1;
+
+-- name: DeleteOrgAlertRule :execrows
+DELETE FROM alert_rules
+WHERE id = $1 AND org_id = $2;
+
+-- name: ListAlertStates :many
+SELECT s.rule_id, s.cluster_id, s.series, s.labels, s.status, s.value, s.active_at, s.fired_at, s.evaluated_at,
+    r.org_id, r.name AS rule_name, r.severity
+FROM alert_states s
+JOIN alert_rules r ON r.id = s.rule_id
+ORDER BY s.rule_id, s.cluster_id, s.series;
+
+-- name: ListOrgAlertStates :many
+SELECT s.rule_id, s.cluster_id, s.series, s.labels, s.status, s.value, s.active_at, s.fired_at, s.evaluated_at,
+    r.org_id, r.name AS rule_name, r.severity
+FROM alert_states s
+JOIN alert_rules r ON r.id = s.rule_id
+WHERE r.org_id = $1
+    AND (sqlc.narg('cluster_id')::INTEGER IS NULL OR s.cluster_id = sqlc.narg('cluster_id')::INTEGER)
+ORDER BY s.active_at DESC, s.rule_id, s.series;
+
+-- name: UpsertAlertState :exec
+INSERT INTO alert_states (rule_id, cluster_id, series, labels, status, value, active_at, fired_at, evaluated_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+ON CONFLICT (rule_id, cluster_id, series) DO UPDATE
+SET labels = EXCLUDED.labels,
+    status = EXCLUDED.status,
+    value = EXCLUDED.value,
+    active_at = EXCLUDED.active_at,
+    fired_at = EXCLUDED.fired_at,
+    evaluated_at = EXCLUDED.evaluated_at;
+
+-- name: DeleteAlertState :exec
+DELETE FROM alert_states
+WHERE rule_id = $1 AND cluster_id = $2 AND series = $3;
+
+-- name: DeleteAlertStatesByRule :many
+DELETE FROM alert_states
+WHERE rule_id = $1
+RETURNING *;
+
+-- name: CreateAlertHistory :exec
+INSERT INTO alert_history (org_id, rule_id, rule_name, cluster_id, status, severity, labels, value, active_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9);
+
+-- name: DeleteOldAlertHistory :execrows
+DELETE FROM alert_history
+WHERE created_at < $1;
+
+-- name: ListOrgAlertHistory :many
+SELECT * FROM alert_history
+WHERE org_id = $1
+    AND (sqlc.narg('cluster_id')::INTEGER IS NULL OR cluster_id = sqlc.narg('cluster_id')::INTEGER)
+    AND (sqlc.narg('rule_id')::INTEGER IS NULL OR rule_id = sqlc.narg('rule_id')::INTEGER)
+    AND (sqlc.narg('from')::TIMESTAMPTZ IS NULL OR created_at >= sqlc.narg('from')::TIMESTAMPTZ)
+    AND (sqlc.narg('to')::TIMESTAMPTZ IS NULL OR created_at <= sqlc.narg('to')::TIMESTAMPTZ)
+ORDER BY created_at DESC, id DESC
+LIMIT $2;
@@ -0,0 +1,36 @@
+-- name: CreateCatalogSnapshot :one
+INSERT INTO catalog_snapshots (database_id, hash, catalog)
+VALUES ($1, $2, $3) RETURNING *;
+
+-- name: GetLatestCatalogSnapshot :one
+SELECT * FROM catalog_snapshots
+WHERE database_id = $1
+ORDER BY created_at DESC, id DESC
+LIMIT 1;
+
+-- name: DeleteOldCatalogSnapshots :execrows
+DELETE FROM catalog_snapshots
+WHERE database_id = $1 AND id < $2;
+
+-- name: CreateCatalogChangeEvent :exec
+INSERT INTO catalog_change_events (
+    database_id,
+    snapshot_id,
+    relation_id,
+    schema_name,
+    relation_name,
+    relation_type,
+    change_type,
+    details
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7, $8
+);
+
+-- name: ListCatalogChangeEvents :many
+SELECT * FROM catalog_change_events
+WHERE database_id = $1
+    AND (sqlc.narg('relation_id')::INTEGER IS NULL OR relation_id = sqlc.narg('relation_id')::INTEGER)
+    AND (sqlc.narg('relation_name')::TEXT IS NULL OR relation_name = sqlc.narg('relation_name')::TEXT OR schema_name || '.' || relation_name = sqlc.narg('relation_name')::TEXT)
+    AND (sqlc.narg('before')::INTEGER IS NULL OR id < sqlc.narg('before')::INTEGER)
+ORDER BY id DESC
+LIMIT $2;
@@ -1,5 +1,6 @@
 -- name: CreateClusterDiagnostic :one
-INSERT INTO cluster_diagnostics (cluster_id, content) VALUES ($1, $2) RETURNING *;
+INSERT INTO cluster_diagnostics (cluster_id, content_ref, content_size, content_stored_size, content_sha256)
+VALUES ($1, $2, $3, $4, $5) RETURNING *;
 
 -- name: GetClusterDiagnostic :one
 SELECT * FROM cluster_diagnostics WHERE id = $1;
@@ -9,3 +10,47 @@ SELECT id, created_at FROM cluster_diagnostics WHERE cluster_id = $1 ORDER BY cr
 
 -- name: DeleteClusterDiagnostic :exec
 DELETE FROM cluster_diagnostics WHERE id = $1;
+
+-- name: ListInlineClusterDiagnostics :many
+SELECT * FROM cluster_diagnostics
+WHERE content IS NOT NULL
+ORDER BY id
+LIMIT $1;
+
+-- name: MoveClusterDiagnosticContent :exec
+UPDATE cluster_diagnostics
+SET content = NULL, content_ref = $2, content_size = $3, content_stored_size = $4, content_sha256 = $5
+WHERE id = $1;
+
+-- name: CreateClusterDiagnosticSearch :exec
+INSERT INTO cluster_diagnostic_search (diagnostic_id, search_vector)
+VALUES ($1, strip(to_tsvector('simple', sqlc.arg('search_text')::TEXT)))
+ON CONFLICT (diagnostic_id) DO UPDATE SET search_vector = EXCLUDED.search_vector;
+
+-- name: ListUnindexedClusterDiagnostics :many
+SELECT * FROM cluster_diagnostics
+WHERE id NOT IN (SELECT diagnostic_id FROM cluster_diagnostic_search)
+ORDER BY id
+LIMIT $1;
+
+-- name: SearchOrgClusterDiagnostics :many
+SELECT d.id, d.cluster_id, c.name AS cluster_name, d.created_at, d.content, d.content_ref, d.content_sha256
+FROM cluster_diagnostics d
+JOIN clusters c ON c.id = d.cluster_id
+JOIN cluster_diagnostic_search s ON s.diagnostic_id = d.id
+WHERE c.org_id = $1
+    AND s.search_vector @@ plainto_tsquery('simple', sqlc.arg('query')::TEXT)
+    AND (sqlc.narg('cluster_id')::INTEGER IS NULL OR d.cluster_id = sqlc.narg('cluster_id')::INTEGER)
+    AND (sqlc.narg('from')::TIMESTAMPTZ IS NULL OR d.created_at >= sqlc.narg('from')::TIMESTAMPTZ)
+    AND (sqlc.narg('to')::TIMESTAMPTZ IS NULL OR d.created_at <= sqlc.narg('to')::TIMESTAMPTZ)
+ORDER BY
+    CASE WHEN sqlc.arg('oldest_first')::BOOLEAN THEN d.created_at END ASC,
+    d.created_at DESC,
+    d.id
+LIMIT $2;
+
+-- name: GetPreviousClusterDiagnostic :one
+SELECT * FROM cluster_diagnostics
+WHERE cluster_id = $1 AND id < $2
+ORDER BY id DESC
+LIMIT 1;
@@ -0,0 +1,55 @@
+-- name: CreateClusterDiagnosticBundle :one
+INSERT INTO cluster_diagnostic_bundles (cluster_id, org_id, user_id, status)
+VALUES ($1, $2, $3, $4) RETURNING *;
+
+-- name: GetClusterDiagnosticBundle :one
+SELECT * FROM cluster_diagnostic_bundles
+WHERE id = $1;
+
+-- name: GetOrgClusterDiagnosticBundle :one
+SELECT * FROM cluster_diagnostic_bundles
+WHERE id = $1 AND cluster_id = $2 AND org_id = $3;
+
+-- name: ListOrgClusterDiagnosticBundles :many
+SELECT * FROM cluster_diagnostic_bundles
+WHERE cluster_id = $1 AND org_id = $2
+ORDER BY created_at DESC, id DESC;
+
+-- name: UpdateClusterDiagnosticBundleStatus :exec
+UPDATE cluster_diagnostic_bundles
+SET status = $2
+WHERE id = $1;
+
+-- name: FinishClusterDiagnosticBundle :exec
+UPDATE cluster_diagnostic_bundles
+SET status = $2, error = $3, manifest = $4, size_bytes = $5, content_ref = $6, finished_at = CURRENT_TIMESTAMP
+WHERE id = $1;
+
+-- name: GetClusterDiagnosticBundleContent :one
+SELECT content FROM cluster_diagnostic_bundle_contents
+WHERE bundle_id = $1;
+
+-- name: ListInlineClusterDiagnosticBundles :many
+SELECT * FROM cluster_diagnostic_bundles
+WHERE id IN (SELECT bundle_id FROM cluster_diagnostic_bundle_contents)
+ORDER BY id
+LIMIT $1;
+
+-- name: UpdateClusterDiagnosticBundleContentRef :exec
+UPDATE cluster_diagnostic_bundles
+SET content_ref = $2
+WHERE id = $1;
+
+-- name: DeleteClusterDiagnosticBundleContent :exec
+DELETE FROM cluster_diagnostic_bundle_contents
+WHERE bundle_id = $1;
+
+-- name: DeleteClusterDiagnosticBundle :exec
+DELETE FROM cluster_diagnostic_bundles
+WHERE id = $1;
+
+-- name: ListExpiredClusterDiagnosticBundles :many
+SELECT * FROM cluster_diagnostic_bundles
+WHERE finished_at < sqlc.arg('finished_before')::TIMESTAMPTZ
+ORDER BY id
+LIMIT sqlc.arg('limit');
@@ -0,0 +1,14 @@
+-- name: CreateClusterEvent :exec
+INSERT INTO cluster_events (cluster_id, type, message, details)
+VALUES ($1, $2, $3, $4);
+
+-- name: ListClusterEvents :many
+SELECT * FROM cluster_events
+WHERE cluster_id = $1
+    AND (sqlc.narg('type')::TEXT IS NULL OR type = sqlc.narg('type')::TEXT)
+ORDER BY created_at DESC, id DESC
+LIMIT $2;
+
+-- name: DeleteOldClusterEvents :execrows
+DELETE FROM cluster_events
+WHERE created_at < $1;
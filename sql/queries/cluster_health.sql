@@ -0,0 +1,27 @@
+-- name: CreateClusterHealthRecord :exec
+INSERT INTO cluster_health_records (cluster_id, status, reasons, details)
+VALUES ($1, $2, $3, $4);
+
+-- name: GetLatestClusterHealthRecord :one
+SELECT * FROM cluster_health_records
+WHERE cluster_id = $1
+ORDER BY created_at DESC, id DESC
+LIMIT 1;
+
+-- name: ListClusterHealthRecords :many
+SELECT * FROM cluster_health_records
+WHERE cluster_id = $1
+    AND (sqlc.narg('from')::TIMESTAMPTZ IS NULL OR created_at >= sqlc.narg('from')::TIMESTAMPTZ)
+    AND (sqlc.narg('to')::TIMESTAMPTZ IS NULL OR created_at <= sqlc.narg('to')::TIMESTAMPTZ)
+ORDER BY created_at DESC, id DESC
+LIMIT $2;
+
+-- name: DeleteOldClusterHealthRecords :execrows
+DELETE FROM cluster_health_records r
+WHERE r.created_at < $1
+    AND r.id <> (
+        SELECT l.id FROM cluster_health_records l
+        WHERE l.cluster_id = r.cluster_id
+        ORDER BY l.created_at DESC, l.id DESC
+        LIMIT 1
+    );
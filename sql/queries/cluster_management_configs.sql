@@ -19,6 +19,11 @@ UPDATE auto_backup_configs
 SET enabled = $2
 WHERE cluster_id = $1;
 
+-- name: UpdateAutoBackupRetentionPolicy :exec
+UPDATE auto_backup_configs
+SET keep_last = $2, keep_daily = $3, keep_weekly = $4, keep_monthly = $5, updated_at = CURRENT_TIMESTAMP
+WHERE cluster_id = $1;
+
 -- name: UpdateAutoDiagnosticsConfig :exec
 UPDATE auto_diagnostics_configs
 SET enabled = $2
@@ -1,6 +1,8 @@
 -- name: CreateClusterSnapshot :exec
-INSERT INTO cluster_snapshots (cluster_id, snapshot_id, name)
-VALUES ($1, $2, $3);
+INSERT INTO cluster_snapshots (cluster_id, snapshot_id, name, source, sync_status, synced_at)
+VALUES ($1, $2, $3, $4, 'synced', CURRENT_TIMESTAMP)
+ON CONFLICT (cluster_id, snapshot_id) DO UPDATE
+SET name = EXCLUDED.name, source = EXCLUDED.source, sync_status = 'synced', synced_at = CURRENT_TIMESTAMP, updated_at = CURRENT_TIMESTAMP;
 
 -- name: DeleteClusterSnapshot :exec
 DELETE FROM cluster_snapshots
@@ -9,3 +11,13 @@ WHERE cluster_id = $1 AND snapshot_id = $2;
 -- name: ListClusterSnapshots :many
 SELECT * FROM cluster_snapshots
 WHERE cluster_id = $1 ORDER BY created_at DESC;
+
+-- name: ImportClusterSnapshot :exec
+INSERT INTO cluster_snapshots (cluster_id, snapshot_id, name, source, sync_status, synced_at, created_at)
+VALUES ($1, $2, $3, 'imported', 'synced', CURRENT_TIMESTAMP, COALESCE(sqlc.narg('created_at'), CURRENT_TIMESTAMP))
+ON CONFLICT (cluster_id, snapshot_id) DO NOTHING;
+
+-- name: UpdateClusterSnapshotSyncStatus :exec
+UPDATE cluster_snapshots
+SET sync_status = $3, synced_at = CURRENT_TIMESTAMP, updated_at = CURRENT_TIMESTAMP
+WHERE cluster_id = $1 AND snapshot_id = $2;
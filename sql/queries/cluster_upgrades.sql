@@ -0,0 +1,37 @@
+-- name: CreateClusterUpgrade :one
+INSERT INTO cluster_upgrades (cluster_id, org_id, user_id, from_version, target_version, status, deadline_at)
+VALUES ($1, $2, $3, $4, $5, 'pending', $6)
+RETURNING *;
+
+-- name: GetClusterUpgrade :one
+SELECT * FROM cluster_upgrades
+WHERE id = $1;
+
+-- name: GetOrgClusterUpgrade :one
+SELECT * FROM cluster_upgrades
+WHERE id = $1 AND cluster_id = $2 AND org_id = $3;
+
+-- name: GetInProgressClusterUpgrade :one
+SELECT * FROM cluster_upgrades
+WHERE cluster_id = $1 AND finished_at IS NULL;
+
+-- name: ListClusterUpgrades :many
+SELECT * FROM cluster_upgrades
+WHERE cluster_id = $1
+ORDER BY created_at DESC, id DESC;
+
+-- name: UpdateClusterUpgradeSnapshot :exec
+UPDATE cluster_upgrades
+SET snapshot_id = $2, status = 'waiting', updated_at = CURRENT_TIMESTAMP
+WHERE id = $1 AND finished_at IS NULL;
+
+-- name: UpdateClusterUpgradeDetectedVersion :exec
+UPDATE cluster_upgrades
+SET detected_version = $2, status = 'diagnosing', updated_at = CURRENT_TIMESTAMP
+WHERE id = $1 AND finished_at IS NULL;
+
+-- name: FinishClusterUpgrade :one
+UPDATE cluster_upgrades
+SET status = $2, diagnostic_id = $3, error = $4, updated_at = CURRENT_TIMESTAMP, finished_at = CURRENT_TIMESTAMP
+WHERE id = $1 AND finished_at IS NULL
+RETURNING *;
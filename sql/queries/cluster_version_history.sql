@@ -0,0 +1,8 @@
+-- name: CreateClusterVersionHistory :exec
+INSERT INTO cluster_version_history (cluster_id, version, previous_version, source)
+VALUES ($1, $2, $3, $4);
+
+-- name: ListClusterVersionHistory :many
+SELECT * FROM cluster_version_history
+WHERE cluster_id = $1
+ORDER BY created_at DESC, id DESC;
@@ -7,9 +7,10 @@ INSERT INTO clusters (
     meta_port,
     http_port,
     version, 
-    metrics_store_id
+    metrics_store_id,
+    risectl_version
 ) VALUES (
-    $1, $2, $3, $4, $5, $6, $7, $8
+    $1, $2, $3, $4, $5, $6, $7, $8, $9
 ) RETURNING *;
 
 -- name: InitCluster :one
@@ -21,9 +22,10 @@ INSERT INTO clusters (
     meta_port,
     http_port,
     version,
-    metrics_store_id
+    metrics_store_id,
+    risectl_version
 ) VALUES (
-    $1, $2, $3, $4, $5, $6, $7, $8
+    $1, $2, $3, $4, $5, $6, $7, $8, $9
 ) ON CONFLICT (org_id, name) DO UPDATE 
     SET 
         host = EXCLUDED.host,
@@ -32,6 +34,7 @@ INSERT INTO clusters (
         http_port = EXCLUDED.http_port,
         version = EXCLUDED.version,
         metrics_store_id = EXCLUDED.metrics_store_id,
+        risectl_version = EXCLUDED.risectl_version,
         updated_at = CURRENT_TIMESTAMP
 RETURNING *;
 
@@ -54,6 +57,7 @@ SET
     http_port = $7,
     version = $8,
     metrics_store_id = $9,
+    risectl_version = $10,
     updated_at = CURRENT_TIMESTAMP
 WHERE id = $1 AND org_id = $2
 RETURNING *;
@@ -66,6 +70,10 @@ WHERE id = $1 AND org_id = $2;
 SELECT * FROM clusters
 WHERE id = $1;
 
+-- name: ListAllClusters :many
+SELECT * FROM clusters
+ORDER BY id;
+
 -- name: ListClustersByMetricsStoreID :many
 SELECT * FROM clusters
 WHERE metrics_store_id = $1
@@ -76,3 +84,20 @@ ORDER BY name;
 UPDATE clusters
 SET metrics_store_id = NULL
 WHERE id = $1 AND org_id = $2;
+
+-- name: UpdateClusterVersion :exec
+UPDATE clusters
+SET version = $2, updated_at = CURRENT_TIMESTAMP
+WHERE id = $1;
+
+-- name: UpdateClusterDetectedVersion :exec
+UPDATE clusters
+SET detected_version = $2, version_detected_at = CURRENT_TIMESTAMP
+WHERE id = $1;
+
+-- name: UpdateClusterHealth :exec
+UPDATE clusters
+SET health_status = $2,
+    health_checked_at = $3,
+    last_seen_at = COALESCE(sqlc.narg('last_seen_at'), last_seen_at)
+WHERE id = $1;
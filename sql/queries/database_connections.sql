@@ -5,9 +5,10 @@ INSERT INTO database_connections (
     username,
     password,
     database,
-    org_id
+    org_id,
+    session_settings
 ) VALUES (
-    $1, $2, $3, $4, $5, $6
+    $1, $2, $3, $4, $5, $6, $7
 ) RETURNING *;
 
 -- name: InitDatabaseConnection :one
@@ -17,15 +18,17 @@ INSERT INTO database_connections (
     username,
     password,
     database,
-    org_id
+    org_id,
+    session_settings
 ) VALUES (
-    $1, $2, $3, $4, $5, $6
+    $1, $2, $3, $4, $5, $6, $7
 ) ON CONFLICT (org_id, name) DO UPDATE 
     SET 
         cluster_id = EXCLUDED.cluster_id,
         username = EXCLUDED.username,
         password = EXCLUDED.password,
         database = EXCLUDED.database,
+        session_settings = EXCLUDED.session_settings,
         updated_at = CURRENT_TIMESTAMP
 RETURNING *;
 
@@ -51,6 +54,7 @@ SET
     password = $6,
     database = $7,
     org_id = $8,
+    session_settings = $9,
     updated_at = CURRENT_TIMESTAMP
 WHERE id = $1 AND org_id = $2
 RETURNING *;
@@ -70,3 +74,7 @@ WHERE cluster_id = $1 AND org_id = $2;
 -- name: DeleteAllOrgDatabaseConnectionsByClusterID :exec
 DELETE FROM database_connections
 WHERE cluster_id = $1 AND org_id = $2;
+
+-- name: ListAllDatabaseConnections :many
+SELECT * FROM database_connections
+ORDER BY id;
@@ -0,0 +1,22 @@
+-- name: ListOrgDiagnosticRules :many
+SELECT * FROM org_diagnostic_rules
+WHERE org_id = $1
+ORDER BY rule;
+
+-- name: UpsertOrgDiagnosticRule :one
+INSERT INTO org_diagnostic_rules (org_id, rule, enabled, threshold)
+VALUES ($1, $2, $3, $4)
+ON CONFLICT (org_id, rule) DO UPDATE
+SET enabled = EXCLUDED.enabled,
+    threshold = EXCLUDED.threshold,
+    updated_at = CURRENT_TIMESTAMP
+RETURNING *;
+
+-- name: CreateClusterDiagnosticFinding :one
+INSERT INTO cluster_diagnostic_findings (diagnostic_id, cluster_id, rule, severity, message, value, threshold, details)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8) RETURNING *;
+
+-- name: ListClusterDiagnosticFindings :many
+SELECT * FROM cluster_diagnostic_findings
+WHERE diagnostic_id = $1
+ORDER BY id;
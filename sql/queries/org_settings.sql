@@ -5,3 +5,11 @@ WHERE org_id = $1;
 -- name: CreateOrgSettings :exec
 INSERT INTO org_settings (org_id, timezone)
 VALUES ($1, $2);
+
+-- name: UpdateOrgSettings :one
+UPDATE org_settings
+SET max_statement_timeout = NULLIF(COALESCE(sqlc.narg('max_statement_timeout'), max_statement_timeout), ''),
+    auto_update_cluster_version = COALESCE(sqlc.narg('auto_update_cluster_version'), auto_update_cluster_version),
+    updated_at = CURRENT_TIMESTAMP
+WHERE org_id = $1
+RETURNING *;
@@ -0,0 +1,51 @@
+-- name: CreateRisectlExecution :one
+INSERT INTO risectl_executions (cluster_id, org_id, user_id, args, status)
+VALUES ($1, $2, $3, $4, $5) RETURNING *;
+
+-- name: GetRisectlExecution :one
+SELECT * FROM risectl_executions
+WHERE id = $1;
+
+-- name: GetOrgRisectlExecution :one
+SELECT * FROM risectl_executions
+WHERE id = $1 AND cluster_id = $2 AND org_id = $3;
+
+-- name: ListOrgRisectlExecutions :many
+SELECT * FROM risectl_executions
+WHERE cluster_id = $1 AND org_id = $2
+    AND (sqlc.narg('before')::INTEGER IS NULL OR id < sqlc.narg('before')::INTEGER)
+ORDER BY id DESC
+LIMIT $3;
+
+-- name: DeleteOldRisectlExecutions :execrows
+DELETE FROM risectl_executions
+WHERE finished_at < $1;
+
+-- name: StartRisectlExecution :exec
+UPDATE risectl_executions
+SET status = $2, risectl_version = $3, started_at = CURRENT_TIMESTAMP
+WHERE id = $1;
+
+-- name: FinishRisectlExecution :exec
+UPDATE risectl_executions
+SET status = $2, exit_code = $3, error = $4, finished_at = CURRENT_TIMESTAMP
+WHERE id = $1;
+
+-- name: RequestRisectlExecutionCancel :exec
+UPDATE risectl_executions
+SET cancel_requested = TRUE
+WHERE id = $1;
+
+-- name: IsRisectlExecutionCancelRequested :one
+SELECT cancel_requested FROM risectl_executions
+WHERE id = $1;
+
+-- name: CreateRisectlExecutionOutput :exec
+INSERT INTO risectl_execution_outputs (execution_id, seq, stream, content)
+VALUES ($1, $2, $3, $4)
+ON CONFLICT (execution_id, seq) DO NOTHING;
+
+-- name: ListRisectlExecutionOutputs :many
+SELECT * FROM risectl_execution_outputs
+WHERE execution_id = $1 AND seq > $2
+ORDER BY seq;
@@ -0,0 +1,31 @@
+-- name: CreateRisectlShellSession :one
+INSERT INTO risectl_shell_sessions (cluster_id, org_id, user_id, risectl_version)
+VALUES ($1, $2, $3, $4) RETURNING *;
+
+-- name: CloseRisectlShellSession :exec
+UPDATE risectl_shell_sessions
+SET closed_at = CURRENT_TIMESTAMP
+WHERE id = $1;
+
+-- name: GetOrgRisectlShellSession :one
+SELECT * FROM risectl_shell_sessions
+WHERE id = $1 AND cluster_id = $2 AND org_id = $3;
+
+-- name: ListOrgRisectlShellSessions :many
+SELECT * FROM risectl_shell_sessions
+WHERE cluster_id = $1 AND org_id = $2
+ORDER BY created_at DESC, id DESC;
+
+-- name: CreateRisectlShellCommand :one
+INSERT INTO risectl_shell_commands (session_id, seq, command, args, allowed)
+VALUES ($1, $2, $3, $4, $5) RETURNING *;
+
+-- name: FinishRisectlShellCommand :exec
+UPDATE risectl_shell_commands
+SET exit_code = $3, error = $4, finished_at = CURRENT_TIMESTAMP
+WHERE session_id = $1 AND seq = $2;
+
+-- name: ListRisectlShellCommands :many
+SELECT * FROM risectl_shell_commands
+WHERE session_id = $1
+ORDER BY seq;
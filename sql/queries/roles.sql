@@ -0,0 +1,5 @@
+-- name: IsOrgOwner :one
+SELECT EXISTS (
+    SELECT 1 FROM anchor.org_owners
+    WHERE org_id = $1 AND user_id = $2
+);
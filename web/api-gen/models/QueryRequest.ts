@@ -2,14 +2,16 @@
 /* istanbul ignore file */
 /* tslint:disable */
 /* eslint-disable */
+import type { SessionSettings } from './SessionSettings';
 export type QueryRequest = {
     /**
      * SQL query to execute
      */
     query: string;
+    sessionSettings?: SessionSettings;
     /**
-     * Whether to execute the query in background DDL mode
+     * Confirmation token from the impact analysis, required for CASCADE drops affecting many objects
      */
-    backgroundDDL?: boolean;
+    confirmationToken?: string;
 };
 
@@ -0,0 +1,11 @@
+/* generated using openapi-typescript-codegen -- do not edit */
+/* istanbul ignore file */
+/* tslint:disable */
+/* eslint-disable */
+/**
+ * Session variables applied with SET before the query runs, e.g. `streaming_parallelism`,
+ * `statement_timeout`, `query_mode`, `rw_streaming_enable_delta_join`, `search_path` and `background_ddl`.
+ * `statement_timeout` accepts a duration (e.g. 30s, 5m) or a number of seconds.
+ *
+ */
+export type SessionSettings = Record<string, string>;
@@ -281,7 +281,7 @@ export default function SQLConsole() {
     try {
       const result = await DefaultService.queryDatabase(Number(selectedDatabaseId), {
         query,
-        backgroundDDL,
+        sessionSettings: backgroundDDL ? { background_ddl: 'true' } : undefined,
       })
       if (result.error) {
         return {
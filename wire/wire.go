@@ -6,6 +6,7 @@ package wire
 import (
 	"github.com/google/wire"
 	"github.com/risingwavelabs/risingwave-console/pkg"
+	"github.com/risingwavelabs/risingwave-console/pkg/blobstore"
 	"github.com/risingwavelabs/risingwave-console/pkg/config"
 	"github.com/risingwavelabs/risingwave-console/pkg/conn/http"
 	"github.com/risingwavelabs/risingwave-console/pkg/conn/meta"
@@ -28,6 +29,7 @@ func InitializeApplication() (*pkg.App, error) {
 		injection.InjectTaskStore,
 		injection.InjectAnchorSvc,
 		config.NewConfig,
+		blobstore.NewBlobStore,
 		service.NewService,
 		service.NewInitService,
 		model.NewModel,
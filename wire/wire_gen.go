@@ -9,6 +9,7 @@ package wire
 import (
 	"github.com/cloudcarver/anchor/wire"
 	"github.com/risingwavelabs/risingwave-console/pkg"
+	"github.com/risingwavelabs/risingwave-console/pkg/blobstore"
 	"github.com/risingwavelabs/risingwave-console/pkg/config"
 	"github.com/risingwavelabs/risingwave-console/pkg/conn/http"
 	"github.com/risingwavelabs/risingwave-console/pkg/conn/meta"
@@ -51,16 +52,20 @@ func InitializeApplication() (*pkg.App, error) {
 	taskStoreInterface := injection.InjectTaskStore(application)
 	taskRunner := taskgen.NewTaskRunner(taskStoreInterface)
 	serviceInterface := injection.InjectAnchorSvc(application)
-	serviceServiceInterface, err := service.NewService(configConfig, modelInterface, authInterface, sqlConnectionManegerInterface, risectlManagerInterface, metricsManager, metaHttpManagerInterface, taskRunner, taskStoreInterface, serviceInterface)
+	blobStore, err := blobstore.NewBlobStore(configConfig)
+	if err != nil {
+		return nil, err
+	}
+	serviceServiceInterface, err := service.NewService(configConfig, modelInterface, authInterface, sqlConnectionManegerInterface, risectlManagerInterface, metricsManager, metaHttpManagerInterface, taskRunner, taskStoreInterface, serviceInterface, blobStore)
 	if err != nil {
 		return nil, err
 	}
 	serverInterface := controller.NewSeverInterface(serviceServiceInterface, authInterface)
 	validator := controller.NewValidator(modelInterface, authInterface)
-	executorInterface := task.NewTaskExecutor(taskRunner, modelInterface, risectlManagerInterface, metaHttpManagerInterface)
+	executorInterface := task.NewTaskExecutor(taskRunner, modelInterface, risectlManagerInterface, metaHttpManagerInterface, metricsManager, blobStore)
 	taskHandler := taskgen.NewTaskHandler(executorInterface)
 	plugin := pkg.NewPlugin(serverInterface, validator, taskHandler)
-	initService := service.NewInitService(modelInterface, serviceInterface)
+	initService := service.NewInitService(modelInterface, serviceInterface, taskRunner)
 	app, err := pkg.NewApp(application, configConfig, plugin, initService)
 	if err != nil {
 		return nil, err